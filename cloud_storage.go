@@ -1,8 +1,13 @@
 package dicomdeidentifier
 
+import "context"
+
 // StorageObject represents a single instance of a cloud storage object
 type CloudStorageObject struct {
 	Name string `json:"object-name"`
+
+	// Generation selects a version of the object, the live version being used when it is 0
+	Generation int64 `json:"generation,omitempty"`
 }
 
 type SignedBucketURL struct {
@@ -20,4 +25,18 @@ type CloudStorageService interface {
 
 	// Generates a presigned bucket URL with limited possible operations for a limited period of time
 	GeneratePresignedBucketURL(bucket *CloudStorageBucket, object *CloudStorageObject, method string) (*SignedBucketURL, error)
+
+	// Catalogues the attributes, private creators, SOP classes and modalities of every object of
+	// the bucket whose name starts with prefix. Objects that are not DICOM instances are skipped.
+	ProfileBucket(ctx context.Context, bucket *CloudStorageBucket, prefix string) (*DatasetProfile, error)
+
+	// Checks the conformance of every object of the bucket whose name starts with prefix, so
+	// files are rejected or flagged before they are imported into a DICOM store
+	ValidateBucket(ctx context.Context, bucket *CloudStorageBucket, prefix string) (*UploadValidation, error)
+
+	// Copies objects of the bucket, each at its generation, to the same names under prefix
+	CopyObjects(ctx context.Context, bucket *CloudStorageBucket, objects []*CloudStorageObject, prefix string) error
+
+	// Deletes every object of the bucket whose name starts with prefix
+	DeleteObjects(ctx context.Context, bucket *CloudStorageBucket, prefix string) error
 }
@@ -2,13 +2,19 @@ package main
 
 import (
 	"context"
+	"crypto/aes"
+	"encoding/base64"
 	"fmt"
+	"io"
+	"io/ioutil"
 	"log"
 	"os"
 	"os/signal"
 
 	"github.com/rollbar/rollbar-go"
 	dcmd "gitlab.com/medical-research/dicom-deidentifier"
+	"gitlab.com/medical-research/dicom-deidentifier/deid"
+	"gitlab.com/medical-research/dicom-deidentifier/filestore"
 	gcloudstorage "gitlab.com/medical-research/dicom-deidentifier/gcloudstorage"
 	"gitlab.com/medical-research/dicom-deidentifier/healthcare"
 	"gitlab.com/medical-research/dicom-deidentifier/http"
@@ -18,6 +24,27 @@ const (
 	RollBarToken = "ROLLBAR_TOKEN"
 	HTTPAddress  = "HTTP_ADDRESS"
 	Domain       = "DOMAIN"
+	DataDir      = "DATA_DIR"
+	UIDRoot      = "UID_ROOT"
+	UIDHashKey   = "UID_HASH_KEY"   // base64 encoded key UIDs are derived from
+	DateShiftKey = "DATE_SHIFT_KEY" // base64 encoded key of the patient keys of the date shift table
+
+	HealthcareDateShiftKey = "HEALTHCARE_DATE_SHIFT_KEY" // base64 encoded AES key the Healthcare API shifts dates with
+
+	PseudonymKey          = "PSEUDONYM_KEY"          // base64 encoded key pseudonyms are derived from
+	PseudonymFormat       = "PSEUDONYM_FORMAT"       // "hash" (default) or "sequential"
+	LinkageKey            = "LINKAGE_KEY"            // base64 encoded AES key sealing the linkage table
+	ReidentificationToken = "REIDENTIFICATION_TOKEN" // bearer token granting the re-identification role, also needed to store rosters
+	AttributeHashKey      = "ATTRIBUTE_HASH_KEY"     // base64 encoded key of the values replaced by hash rules
+
+	RecipientCertificates = "RECIPIENT_CERTIFICATES" // PEM file of the certificates original attributes are encrypted for
+
+	StaffNames    = "STAFF_NAMES"    // file of staff names removed from descriptors, one per line
+	ScrubPatterns = "SCRUB_PATTERNS" // JSON file of extra patterns removed from descriptors
+
+	SafePrivateTags = "SAFE_PRIVATE_TAGS" // JSON file of extra private attributes safe to retain, by private creator
+
+	Profiles = "PROFILES" // JSON file of the de-identification profiles available by name
 )
 
 // Build version, injected during build.
@@ -69,6 +96,9 @@ type Main struct {
 	HTTPServer   *http.Server
 	DicomAPI     *healthcare.GoogleDicomAPI
 	CloudStorage *gcloudstorage.GCloudStorage
+
+	// Local tables holding state that must survive between jobs, e.g. UID mappings
+	DB *filestore.DB
 }
 
 // NewMain returns a new instance of Main.
@@ -88,6 +118,7 @@ func NewMain(ctx context.Context) (*Main, error) {
 		DicomAPI:     dicomAPI,
 		CloudStorage: cloudStorage,
 		HTTPServer:   http.NewServer(),
+		DB:           filestore.NewDB(dcmd.MustGetEnvVar(DataDir)),
 	}, nil
 }
 
@@ -99,6 +130,12 @@ func (m *Main) Close() error {
 		}
 	}
 
+	if m.DB != nil {
+		if err := m.DB.Close(); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -113,11 +150,113 @@ func (m *Main) Run(ctx context.Context) (err error) {
 	rollbar.SetServerRoot("gitlab.com/medical-research/dicom-deidentifier")
 	log.Printf("rollbar error tracking enabled")
 
+	// Open the local tables before any service that depends on them.
+	if err := m.DB.Open(); err != nil {
+		return err
+	}
+
 	// Instantiate DicomAPI-backed services.
 	dicomService := healthcare.NewDicomService(m.DicomAPI)
 	dicomStoreService := healthcare.NewDicomStoreService(m.DicomAPI)
 	cloudStorageService := gcloudstorage.NewCloudStorageService(m.CloudStorage)
 
+	// Stores are only exported once their verification is on record.
+	verificationService := filestore.NewVerificationService(m.DB)
+	dicomService.VerificationService = verificationService
+	dicomStoreService.VerificationService = verificationService
+
+	// Instantiate the local de-identification engine.
+	uidRemapper, err := newUIDRemapper(m.DB)
+	if err != nil {
+		return err
+	}
+	deidentificationService := deid.NewDeidentificationService(uidRemapper)
+
+	// Date shifting is only available when a key is configured, see DateShiftKey.
+	if key, err := decodeKey(DateShiftKey); err != nil {
+		return err
+	} else if key != nil {
+		dateShifter, err := deid.NewTableDateShifter(key, filestore.NewDateShiftService(m.DB))
+		if err != nil {
+			return err
+		}
+		deidentificationService.DateShifter = dateShifter
+	}
+
+	// The Healthcare API only shifts dates when given its own key, its offsets are not those of
+	// the date shift table. Without it store jobs retaining dates run in the local engine.
+	if key, err := decodeKey(HealthcareDateShiftKey); err != nil {
+		return err
+	} else if key != nil {
+		if _, err := aes.NewCipher(key); err != nil {
+			return fmt.Errorf("invalid %s: %v", HealthcareDateShiftKey, err)
+		}
+		dicomStoreService.DateShiftCryptoKey = base64.StdEncoding.EncodeToString(key)
+	}
+
+	// Pseudonyms and enrolment rosters are only available when a linkage key is configured,
+	// as both tables link subjects back to patients, see LinkageKey.
+	if linkageKey, err := decodeKey(LinkageKey); err != nil {
+		return err
+	} else if linkageKey != nil {
+		rosterService, err := filestore.NewRosterService(m.DB, linkageKey)
+		if err != nil {
+			return err
+		}
+		deidentificationService.RosterService = rosterService
+		m.HTTPServer.RosterService = rosterService
+
+		if err := m.setupPseudonyms(deidentificationService, linkageKey); err != nil {
+			return err
+		}
+	}
+
+	// Hash rules are only available when a hash key is configured.
+	if key, err := decodeKey(AttributeHashKey); err != nil {
+		return err
+	} else if key != nil {
+		deidentificationService.HashKey = key
+	}
+
+	// Encrypting original attributes is only available when recipients are configured.
+	if path := os.Getenv(RecipientCertificates); path != "" {
+		b, err := ioutil.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("could not read %s: %v", RecipientCertificates, err)
+		}
+		if deidentificationService.RecipientCertificates, err = deid.ParseCertificates(b); err != nil {
+			return fmt.Errorf("invalid %s: %v", RecipientCertificates, err)
+		}
+	}
+
+	// Staff dictionaries and patterns extend the defaults used when cleaning descriptors,
+	// and private attributes the defaults of the safe private dictionary.
+	if err := readDictionaries(deidentificationService.DescriptorCleaner, deidentificationService.SafePrivateDictionary); err != nil {
+		return err
+	}
+
+	// Store jobs with options the Healthcare API cannot apply are run by the local engine.
+	dicomStoreService.DeidentificationService = deidentificationService
+	dicomStoreService.SafePrivateDictionary = deidentificationService.SafePrivateDictionary
+
+	// Profiles are only needed by the routes selecting a profile by name.
+	if path := os.Getenv(Profiles); path != "" {
+		f, err := os.Open(path)
+		if err != nil {
+			return fmt.Errorf("could not read %s: %v", Profiles, err)
+		}
+		m.HTTPServer.Profiles, err = dcmd.ReadProfiles(f)
+		f.Close()
+		if err != nil {
+			return fmt.Errorf("invalid %s: %v", Profiles, err)
+		}
+		for _, profile := range m.HTTPServer.Profiles {
+			if err := deid.ValidateProfile(profile); err != nil {
+				return fmt.Errorf("invalid %s: %s", Profiles, dcmd.ErrorMessage(err))
+			}
+		}
+	}
+
 	// Copy configuration settings to the HTTP server.
 	httpAddress := os.Getenv(HTTPAddress)
 	domain := os.Getenv(Domain)
@@ -127,6 +266,7 @@ func (m *Main) Run(ctx context.Context) (err error) {
 	m.HTTPServer.DicomService = dicomService
 	m.HTTPServer.DicomStoreService = dicomStoreService
 	m.HTTPServer.CloudStorageService = cloudStorageService
+	m.HTTPServer.DeidentificationService = deidentificationService
 
 	// Start the HTTP server.
 	if err := m.HTTPServer.Open(); err != nil {
@@ -145,6 +285,92 @@ func (m *Main) Run(ctx context.Context) (err error) {
 
 	port := dcmd.MustGetEnvVar(http.Port)
 	log.Printf("running: url=%q debug=http://localhost:%s", m.HTTPServer.URL(), port)
+	log.Printf("de-identification method: %s", deid.LocalDeidentificationMethod)
+
+	return nil
+}
+
+// minKeySize is the size in bytes of the shortest secret key accepted
+const minKeySize = 32
+
+// decodeKey returns the base64 encoded secret key of an environment variable, or nil when it is not
+// set. Every secret key is given the same way, keys shorter than minKeySize being rejected.
+func decodeKey(env string) ([]byte, error) {
+	v := os.Getenv(env)
+	if v == "" {
+		return nil, nil
+	}
+	key, err := base64.StdEncoding.DecodeString(v)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s: %v", env, err)
+	} else if len(key) < minKeySize {
+		return nil, fmt.Errorf("invalid %s: key of %d bytes, at least %d required", env, len(key), minKeySize)
+	}
+	return key, nil
+}
+
+// newUIDRemapper returns a remapper deriving UIDs from a keyed hash when a hash key is
+// configured, and one recording random UIDs in the local mapping table otherwise.
+func newUIDRemapper(db *filestore.DB) (dcmd.UIDRemapper, error) {
+	root := dcmd.MustGetEnvVar(UIDRoot)
+	if key, err := decodeKey(UIDHashKey); err != nil {
+		return nil, err
+	} else if key != nil {
+		return deid.NewHashUIDRemapper(root, key)
+	}
+	return deid.NewTableUIDRemapper(root, "", filestore.NewUIDMappingService(db))
+}
+
+// readDictionaries adds the configured staff names and patterns to the descriptor cleaner and
+// the configured private attributes to the safe private dictionary.
+func readDictionaries(cleaner *deid.DescriptorCleaner, dictionary *deid.SafePrivateDictionary) error {
+	for env, read := range map[string]func(io.Reader) error{
+		StaffNames:      cleaner.ReadStaffNames,
+		ScrubPatterns:   cleaner.ReadPatterns,
+		SafePrivateTags: dictionary.ReadJSON,
+	} {
+		path := os.Getenv(env)
+		if path == "" {
+			continue
+		}
+		f, err := os.Open(path)
+		if err != nil {
+			return fmt.Errorf("could not read %s: %v", env, err)
+		}
+		err = read(f)
+		f.Close()
+		if err != nil {
+			return fmt.Errorf("invalid %s: %v", env, err)
+		}
+	}
+	return nil
+}
+
+// setupPseudonyms attaches a pseudonymizer to the de-identification engine when a pseudonym key is configured.
+func (m *Main) setupPseudonyms(deidentificationService *deid.DeidentificationService, linkageKey []byte) error {
+	key, err := decodeKey(PseudonymKey)
+	if err != nil || key == nil {
+		return err
+	}
+
+	pseudonymService, err := filestore.NewPseudonymService(m.DB, linkageKey)
+	if err != nil {
+		return err
+	}
+	pseudonymizer, err := deid.NewTablePseudonymizer(key, pseudonymService)
+	if err != nil {
+		return err
+	}
+	switch format := os.Getenv(PseudonymFormat); format {
+	case "", "hash":
+	case "sequential":
+		pseudonymizer.Sequential = true
+	default:
+		return fmt.Errorf("invalid %s: %q", PseudonymFormat, format)
+	}
 
+	deidentificationService.Pseudonymizer = pseudonymizer
+	m.HTTPServer.PseudonymService = pseudonymService
+	m.HTTPServer.ReidentificationToken = os.Getenv(ReidentificationToken)
 	return nil
 }
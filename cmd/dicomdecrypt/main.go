@@ -0,0 +1,88 @@
+// Command dicomdecrypt recovers the original attributes an honest broker was given in the
+// Encrypted Attributes Sequence of a de-identified DICOM instance.
+//
+// Usage:
+//
+//	dicomdecrypt -cert broker.pem -key broker.key instance.dcm
+//	dicomdecrypt -cert broker.pem -key broker.key -o restored.dcm instance.dcm
+//
+// Without -o the original attributes are printed, with -o the instance is written with the
+// original attributes put back.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	dcmd "gitlab.com/medical-research/dicom-deidentifier"
+	"gitlab.com/medical-research/dicom-deidentifier/deid"
+	"gitlab.com/medical-research/dicom-deidentifier/dicom"
+)
+
+func main() {
+	if err := run(os.Args[1:]); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+// run parses the command line and decrypts a single instance
+func run(args []string) error {
+	fs := flag.NewFlagSet("dicomdecrypt", flag.ContinueOnError)
+	certPath := fs.String("cert", "", "PEM file holding the recipient certificate")
+	keyPath := fs.String("key", "", "PEM file holding the recipient private key")
+	outPath := fs.String("o", "", "write the instance with its original attributes restored to this file")
+	if err := fs.Parse(args); err != nil {
+		return err
+	} else if fs.NArg() != 1 || *certPath == "" || *keyPath == "" {
+		fs.Usage()
+		return fmt.Errorf("a certificate, a private key and a single instance are required")
+	}
+
+	b, err := ioutil.ReadFile(*certPath)
+	if err != nil {
+		return err
+	}
+	certs, err := deid.ParseCertificates(b)
+	if err != nil {
+		return fmt.Errorf("%s: %s", *certPath, dcmd.ErrorMessage(err))
+	}
+	if b, err = ioutil.ReadFile(*keyPath); err != nil {
+		return err
+	}
+	key, err := deid.ParsePrivateKey(b)
+	if err != nil {
+		return fmt.Errorf("%s: %v", *keyPath, err)
+	}
+
+	f, err := dicom.ReadFile(fs.Arg(0))
+	if err != nil {
+		return err
+	}
+	original, err := deid.DecryptOriginalAttributes(f.Dataset, certs[0], key)
+	if err != nil {
+		return fmt.Errorf("%s: %s", fs.Arg(0), dcmd.ErrorMessage(err))
+	}
+
+	if *outPath == "" {
+		return original.Walk(func(parent *dicom.Dataset, e *dicom.Element, path string) error {
+			keyword := dicom.Keyword(e.Tag)
+			if e.IsSequence() {
+				fmt.Printf("%s %s %s (%d items)\n", path, keyword, e.VR, len(e.Items))
+			} else if dicom.IsTextVR(e.VR) {
+				fmt.Printf("%s %s %s %q\n", path, keyword, e.VR, e.Strings())
+			} else {
+				fmt.Printf("%s %s %s (%d bytes)\n", path, keyword, e.VR, len(e.Value))
+			}
+			return nil
+		})
+	}
+
+	deid.RestoreOriginalAttributes(f.Dataset, original)
+	if uid := f.Dataset.GetString(dicom.SOPInstanceUID); uid != "" {
+		f.Meta.SetString(dicom.MediaStorageSOPInstanceUID, uid)
+	}
+	return dicom.WriteFile(*outPath, f)
+}
@@ -0,0 +1,108 @@
+// Command dicompolicy validates a de-identification policy written in YAML or JSON and shows
+// what it changes in sample instances, so rules can be tested before they are deployed.
+//
+// Usage:
+//
+//	dicompolicy policy.yaml
+//	dicompolicy -shift -30 policy.yaml sample1.dcm sample2.dcm
+//
+// Without samples the policy is only validated. With samples, the change made to every element
+// of each sample is printed as JSON. Nothing is persisted: UIDs and hashes are derived from a key
+// chosen for the run, dates are shifted by -shift days and patients are given a fixed pseudonym.
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+
+	dcmd "gitlab.com/medical-research/dicom-deidentifier"
+	"gitlab.com/medical-research/dicom-deidentifier/deid"
+)
+
+// samplePseudonym is the pseudonym every sample patient is given
+const samplePseudonym = "SAMPLE"
+
+// sampleUIDRoot roots the UIDs of the samples
+const sampleUIDRoot = "2.25"
+
+func main() {
+	if err := run(os.Args[1:]); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+// run parses the command line, validates the policy and previews it on every sample
+func run(args []string) error {
+	fs := flag.NewFlagSet("dicompolicy", flag.ContinueOnError)
+	shift := fs.Int("shift", 30, "days the dates of the samples are shifted by")
+	if err := fs.Parse(args); err != nil {
+		return err
+	} else if fs.NArg() < 1 {
+		fs.Usage()
+		return fmt.Errorf("a policy is required")
+	}
+
+	f, err := os.Open(fs.Arg(0))
+	if err != nil {
+		return err
+	}
+	profile, err := deid.ReadPolicy(f)
+	f.Close()
+	if err != nil {
+		return fmt.Errorf("%s: %s", fs.Arg(0), dcmd.ErrorMessage(err))
+	}
+	if fs.NArg() == 1 {
+		fmt.Printf("%s: policy %q is valid\n", fs.Arg(0), profile.Name)
+		return nil
+	}
+
+	key := make([]byte, 32)
+	if _, err := rand.Read(key); err != nil {
+		return err
+	}
+	remapper, err := deid.NewHashUIDRemapper(sampleUIDRoot, key)
+	if err != nil {
+		return err
+	}
+	s := deid.NewDeidentificationService(remapper)
+	s.DateShifter = sampleDateShifter(*shift)
+	s.Pseudonymizer = samplePseudonymizer{}
+	s.HashKey = key
+
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+	for _, path := range fs.Args()[1:] {
+		f, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		preview, err := s.PreviewInstance(context.Background(), profile, f)
+		f.Close()
+		if err != nil {
+			return fmt.Errorf("%s: %s", path, dcmd.ErrorMessage(err))
+		}
+		if err := enc.Encode(preview); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// sampleDateShifter shifts the dates of every sample patient by the same number of days
+type sampleDateShifter int
+
+func (s sampleDateShifter) PatientDateShift(ctx context.Context, issuer, patientID string) (int, error) {
+	return int(s), nil
+}
+
+// samplePseudonymizer gives every sample patient the same pseudonym
+type samplePseudonymizer struct{}
+
+func (samplePseudonymizer) Pseudonymize(ctx context.Context, project, issuer, patientID string) (string, error) {
+	return samplePseudonym, nil
+}
@@ -0,0 +1,111 @@
+package dicomdeidentifier
+
+// Rules of the conformance validator
+const (
+	// The file is not a readable DICOM Part 10 file: preamble, prefix, group lengths or element encoding
+	Part10Rule = "part-10"
+
+	// A File Meta Information element is missing or disagrees with the dataset
+	FileMetaRule = "file-meta"
+
+	// A value does not match the format or character repertoire of its VR, or the VR of the attribute
+	VRRule = "vr"
+
+	// The number of values does not match the value multiplicity of the attribute
+	VMRule = "vm"
+
+	// A value is longer than its VR allows
+	LengthRule = "length"
+
+	// A Type 1 attribute of a module of the IOD is missing or empty, or a Type 2 attribute is missing
+	RequiredAttributeRule = "required-attribute"
+)
+
+// ErrNonConformant is returned when files rejected by the conformance validator block an import
+var ErrNonConformant = Errorf(ECONFLICT, "non-conformant DICOM files, import blocked")
+
+// ConformanceIssue represents a way a file departs from the DICOM standard.
+// Critical issues make the file be rejected before import, warnings flag it for review.
+type ConformanceIssue struct {
+	// Path is the path of the element, e.g. "(0010,0010)" or "(0008,1115)[0].(0020,000E)".
+	// It is empty for issues of the file as a whole.
+	Path string `json:"path,omitempty"`
+
+	// Name is the name of the attribute in the data dictionary
+	Name string `json:"name,omitempty"`
+
+	Rule     string `json:"rule"`
+	Severity string `json:"severity"`
+	Message  string `json:"message"`
+}
+
+// ConformanceReport holds the result of the conformance validation of a single file
+type ConformanceReport struct {
+	// Name is the name of the file or object validated
+	Name string `json:"name"`
+
+	SOPClassUID    string `json:"sop-class-uid,omitempty"`
+	SOPClassName   string `json:"sop-class-name,omitempty"`
+	SOPInstanceUID string `json:"sop-instance-uid,omitempty"`
+	TransferSyntax string `json:"transfer-syntax,omitempty"`
+
+	// IOD names the IOD whose modules were checked, empty when the SOP class is not one of those known
+	IOD string `json:"iod,omitempty"`
+
+	Issues []*ConformanceIssue `json:"issues"`
+}
+
+// Rejected reports whether the file has a critical issue and must not be imported
+func (r *ConformanceReport) Rejected() bool {
+	for _, issue := range r.Issues {
+		if issue.Severity == SeverityCritical {
+			return true
+		}
+	}
+	return false
+}
+
+// Flagged reports whether the file can be imported but has warnings to review
+func (r *ConformanceReport) Flagged() bool {
+	if r.Rejected() {
+		return false
+	}
+	for _, issue := range r.Issues {
+		if issue.Severity == SeverityWarning {
+			return true
+		}
+	}
+	return false
+}
+
+// UploadValidation summarises the conformance of the files of an upload
+type UploadValidation struct {
+	Files    int `json:"files"`
+	Rejected int `json:"rejected"`
+	Flagged  int `json:"flagged"`
+
+	// Reports holds the report of every rejected or flagged file, conformant files being only counted
+	Reports []*ConformanceReport `json:"reports,omitempty"`
+
+	// Accepted lists the files that are not rejected and can be imported
+	Accepted []string `json:"accepted,omitempty"`
+
+	// Generations holds the generation validated of every accepted file of a bucket, by name,
+	// so a file overwritten since is not imported in its new version
+	Generations map[string]int64 `json:"generations,omitempty"`
+}
+
+// Add records the report of a file
+func (v *UploadValidation) Add(r *ConformanceReport) {
+	v.Files++
+	if r.Rejected() {
+		v.Rejected++
+		v.Reports = append(v.Reports, r)
+		return
+	}
+	v.Accepted = append(v.Accepted, r.Name)
+	if r.Flagged() {
+		v.Flagged++
+		v.Reports = append(v.Reports, r)
+	}
+}
@@ -0,0 +1,203 @@
+// Package conformance checks DICOM Part 10 files against the standard before they are imported
+// into a DICOM store: the Part 10 structure, the File Meta Information, the VR, VM and length of
+// every value and the attributes required by the modules of the IOD of common SOP classes.
+package conformance
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strings"
+
+	dcmd "gitlab.com/medical-research/dicom-deidentifier"
+	"gitlab.com/medical-research/dicom-deidentifier/dicom"
+	"gitlab.com/medical-research/dicom-deidentifier/dicom/dictionary"
+)
+
+// identifiers are the UIDs a DICOM store indexes instances by. Files where they are missing or
+// invalid are rejected whatever their SOP class.
+var identifiers = []dicom.Tag{dicom.StudyInstanceUID, dicom.SeriesInstanceUID, dicom.SOPInstanceUID, dicom.SOPClassUID}
+
+// validator holds the report of a file being validated
+type validator struct {
+	report *dcmd.ConformanceReport
+
+	// converted is set when text values were converted to UTF-8 from another character set,
+	// their length as read then differing from their length in the file
+	converted bool
+}
+
+// Validate reads a DICOM Part 10 file and returns the report of its conformance, name being
+// the name of the file in the report. Errors are only returned when r cannot be read; files that
+// cannot be parsed give a report with a critical issue.
+func Validate(name string, r io.Reader) (*dcmd.ConformanceReport, error) {
+	buf, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("could not read %s: %v", name, err)
+	}
+
+	v := &validator{report: &dcmd.ConformanceReport{Name: name, Issues: []*dcmd.ConformanceIssue{}}}
+	if len(buf) < 132 {
+		v.add("", dcmd.Part10Rule, dcmd.SeverityCritical, "file is shorter than the 128 byte preamble and DICM prefix")
+		return v.report, nil
+	}
+	if string(buf[128:132]) != "DICM" {
+		v.add("", dcmd.Part10Rule, dcmd.SeverityCritical, "missing DICM prefix after the 128 byte preamble")
+		return v.report, nil
+	}
+	f, err := dicom.Read(bytes.NewReader(buf))
+	if err != nil {
+		v.add("", dcmd.Part10Rule, dcmd.SeverityCritical, "file cannot be parsed: %v", err)
+		return v.report, nil
+	}
+	v.converted = f.CharacterSet != nil
+
+	v.report.SOPClassUID = f.Dataset.GetString(dicom.SOPClassUID)
+	v.report.SOPClassName = dicom.UIDName(v.report.SOPClassUID)
+	v.report.SOPInstanceUID = f.Dataset.GetString(dicom.SOPInstanceUID)
+	v.report.TransferSyntax = f.TransferSyntax()
+
+	v.checkMeta(f)
+	v.checkValues(f.Meta)
+	ds := v.checkOrder(f.Dataset, "")
+	v.checkValues(ds)
+	v.checkIdentifiers(ds)
+	v.checkIOD(ds)
+	return v.report, nil
+}
+
+// add records an issue of the element at path, or of the file as a whole when path is empty
+func (v *validator) add(path, rule, severity, format string, args ...interface{}) {
+	issue := &dcmd.ConformanceIssue{
+		Path:     path,
+		Rule:     rule,
+		Severity: severity,
+		Message:  fmt.Sprintf(format, args...),
+	}
+	if tag, err := dicom.ParseTag(lastTag(path)); err == nil && !tag.IsPrivate() {
+		if e, ok := dicom.Lookup(tag); ok {
+			issue.Name = e.Name
+		}
+	}
+	v.report.Issues = append(v.report.Issues, issue)
+}
+
+// lastTag returns the tag ending a path, e.g. "(0008,1155)" for "(0008,1115)[0].(0008,1155)"
+func lastTag(path string) string {
+	if i := strings.LastIndex(path, "."); i >= 0 {
+		return path[i+1:]
+	}
+	return path
+}
+
+// checkMeta checks the File Meta Information of PS3.10 section 7.1
+func (v *validator) checkMeta(f *dicom.File) {
+	if e := f.Meta.Get(dicom.FileMetaInformationGroupLength); e == nil {
+		v.add(dicom.FileMetaInformationGroupLength.String(), dcmd.FileMetaRule, dcmd.SeverityCritical, "missing File Meta Information Group Length")
+	} else if length, err := e.Ints(); err != nil || len(length) != 1 {
+		v.add(dicom.FileMetaInformationGroupLength.String(), dcmd.FileMetaRule, dcmd.SeverityCritical, "invalid File Meta Information Group Length")
+	} else if want := metaLength(f.Meta); length[0] != int64(want) {
+		v.add(dicom.FileMetaInformationGroupLength.String(), dcmd.Part10Rule, dcmd.SeverityCritical, "group length is %d, the file meta elements take %d bytes", length[0], want)
+	}
+
+	for _, tag := range []dicom.Tag{
+		dicom.FileMetaInformationVersion,
+		dicom.MediaStorageSOPClassUID,
+		dicom.MediaStorageSOPInstanceUID,
+		dicom.TransferSyntaxUID,
+		dicom.ImplementationClassUID,
+	} {
+		if e := f.Meta.Get(tag); e == nil || e.IsEmpty() {
+			v.add(tag.String(), dcmd.FileMetaRule, dcmd.SeverityCritical, "missing required file meta element")
+		}
+	}
+	if e := f.Meta.Get(dicom.FileMetaInformationVersion); e != nil && !e.IsEmpty() && !bytes.Equal(e.Value, []byte{0x00, 0x01}) {
+		v.add(dicom.FileMetaInformationVersion.String(), dcmd.FileMetaRule, dcmd.SeverityWarning, "version is %x, not 0001", e.Value)
+	}
+
+	// the meta elements must describe the instance the file holds
+	for _, pair := range [][2]dicom.Tag{
+		{dicom.MediaStorageSOPClassUID, dicom.SOPClassUID},
+		{dicom.MediaStorageSOPInstanceUID, dicom.SOPInstanceUID},
+	} {
+		meta, dataset := f.Meta.GetString(pair[0]), f.Dataset.GetString(pair[1])
+		if meta != "" && dataset != "" && meta != dataset {
+			v.add(pair[0].String(), dcmd.FileMetaRule, dcmd.SeverityCritical, "%s differs from %s %s of the dataset", meta, dicom.Keyword(pair[1]), dataset)
+		}
+	}
+
+	if ts := f.TransferSyntax(); ts != "" {
+		u, ok := dictionary.LookupUID(ts)
+		switch {
+		case ok && u.Type != dictionary.TransferSyntax:
+			v.add(dicom.TransferSyntaxUID.String(), dcmd.FileMetaRule, dcmd.SeverityCritical, "%s (%s) is not a transfer syntax", ts, u.Name)
+		case !ok && (len(ts) > 64 || !uid.MatchString(ts)):
+			v.add(dicom.TransferSyntaxUID.String(), dcmd.FileMetaRule, dcmd.SeverityCritical, "%s is not a valid transfer syntax UID", ts)
+		case !ok:
+			// the syntax may have been registered after the edition of the dictionary
+			v.add(dicom.TransferSyntaxUID.String(), dcmd.FileMetaRule, dcmd.SeverityWarning, "%s is not a transfer syntax known to this validator", ts)
+		case u.Retired:
+			v.add(dicom.TransferSyntaxUID.String(), dcmd.FileMetaRule, dcmd.SeverityWarning, "%s (%s) is retired", ts, u.Name)
+		}
+	}
+}
+
+// metaLength returns the length in bytes of the explicit VR encoding of the file meta elements
+// following the group length
+func metaLength(meta *dicom.Dataset) int {
+	n := 0
+	for _, e := range meta.Elements {
+		if e.Tag == dicom.FileMetaInformationGroupLength {
+			continue
+		}
+		switch e.VR {
+		case dicom.OB, dicom.OD, dicom.OF, dicom.OL, dicom.OV, dicom.OW, dicom.SQ, dicom.SV, dicom.UC, dicom.UN, dicom.UR, dicom.UT, dicom.UV:
+			n += 12
+		default:
+			n += 8
+		}
+		n += len(e.Value)
+	}
+	return n
+}
+
+// checkOrder checks that the elements of a dataset found at prefix, and of its items, are in
+// ascending tag order without duplicates. It returns the dataset sorted, so that the checks that
+// follow can look attributes up.
+func (v *validator) checkOrder(ds *dicom.Dataset, prefix string) *dicom.Dataset {
+	sorted := &dicom.Dataset{}
+	unordered := false
+	for i, e := range ds.Elements {
+		path := prefix + e.Tag.String()
+		if i > 0 {
+			previous := ds.Elements[i-1].Tag
+			if e.Tag == previous {
+				v.add(path, dcmd.Part10Rule, dcmd.SeverityCritical, "duplicate element")
+			} else if e.Tag < previous && !unordered {
+				v.add(path, dcmd.Part10Rule, dcmd.SeverityCritical, "element follows %s, elements are not in ascending tag order", previous)
+				unordered = true
+			}
+		}
+		if e.IsSequence() {
+			c := *e
+			c.Items = make([]*dicom.Dataset, len(e.Items))
+			for j, item := range e.Items {
+				c.Items[j] = v.checkOrder(item, fmt.Sprintf("%s[%d].", path, j))
+			}
+			e = &c
+		}
+		sorted.Set(e)
+	}
+	return sorted
+}
+
+// checkIdentifiers checks that the UIDs identifying the instance are present. Their values are
+// checked with the other values.
+func (v *validator) checkIdentifiers(ds *dicom.Dataset) {
+	for _, tag := range identifiers {
+		if ds.GetString(tag) == "" {
+			v.add(tag.String(), dcmd.RequiredAttributeRule, dcmd.SeverityCritical, "missing %s, the instance cannot be stored", dicom.Keyword(tag))
+		}
+	}
+}
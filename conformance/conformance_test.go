@@ -0,0 +1,213 @@
+package conformance_test
+
+import (
+	"bytes"
+	"testing"
+
+	dcmd "gitlab.com/medical-research/dicom-deidentifier"
+	"gitlab.com/medical-research/dicom-deidentifier/conformance"
+	"gitlab.com/medical-research/dicom-deidentifier/dicom"
+)
+
+// secondaryCapture is the SOP class of the test instances, whose IOD has few modules
+const secondaryCapture = "1.2.840.10008.5.1.4.1.1.7"
+
+// newTestFile returns a conformant Secondary Capture instance
+func newTestFile() *dicom.File {
+	return &dicom.File{
+		Meta: dicom.NewDataset(
+			&dicom.Element{Tag: dicom.FileMetaInformationVersion, VR: dicom.OB, Value: []byte{0x00, 0x01}},
+			dicom.NewElement(dicom.MediaStorageSOPClassUID, dicom.UI, secondaryCapture),
+			dicom.NewElement(dicom.MediaStorageSOPInstanceUID, dicom.UI, "1.2.3.4.5"),
+			dicom.NewElement(dicom.TransferSyntaxUID, dicom.UI, dicom.ExplicitVRLittleEndian),
+			dicom.NewElement(dicom.ImplementationClassUID, dicom.UI, "1.2.3.99"),
+		),
+		Dataset: dicom.NewDataset(
+			dicom.NewElement(dicom.ImageType, dicom.CS, "DERIVED", "SECONDARY"),
+			dicom.NewElement(dicom.SOPClassUID, dicom.UI, secondaryCapture),
+			dicom.NewElement(dicom.SOPInstanceUID, dicom.UI, "1.2.3.4.5"),
+			dicom.NewElement(dicom.StudyDate, dicom.DA, "20200131"),
+			dicom.NewElement(dicom.StudyTime, dicom.TM, "101500.25"),
+			dicom.NewElement(dicom.AccessionNumber, dicom.SH, "A123"),
+			dicom.NewElement(dicom.Modality, dicom.CS, "OT"),
+			dicom.NewElement(dicom.Tag(0x00080064), dicom.CS, "WSD"),
+			dicom.NewElement(dicom.Manufacturer, dicom.LO, "ACME"),
+			dicom.NewElement(dicom.ReferringPhysicianName, dicom.PN),
+			dicom.NewElement(dicom.PatientName, dicom.PN, "Doe^John"),
+			dicom.NewElement(dicom.PatientID, dicom.LO, "P1"),
+			dicom.NewElement(dicom.PatientBirthDate, dicom.DA),
+			dicom.NewElement(dicom.PatientSex, dicom.CS, "M"),
+			dicom.NewElement(dicom.StudyInstanceUID, dicom.UI, "1.2.3"),
+			dicom.NewElement(dicom.SeriesInstanceUID, dicom.UI, "1.2.3.4"),
+			dicom.NewElement(dicom.StudyID, dicom.SH, "S1"),
+			dicom.NewElement(dicom.Tag(0x00200011), dicom.IS, "1"),
+			dicom.NewElement(dicom.Tag(0x00200013), dicom.IS, "1"),
+			&dicom.Element{Tag: dicom.SamplesPerPixel, VR: dicom.US, Value: []byte{1, 0}},
+			dicom.NewElement(dicom.PhotometricInterpretation, dicom.CS, "MONOCHROME2"),
+			&dicom.Element{Tag: dicom.Rows, VR: dicom.US, Value: []byte{2, 0}},
+			&dicom.Element{Tag: dicom.Columns, VR: dicom.US, Value: []byte{1, 0}},
+			&dicom.Element{Tag: dicom.BitsAllocated, VR: dicom.US, Value: []byte{8, 0}},
+			&dicom.Element{Tag: dicom.BitsStored, VR: dicom.US, Value: []byte{8, 0}},
+			&dicom.Element{Tag: dicom.HighBit, VR: dicom.US, Value: []byte{7, 0}},
+			&dicom.Element{Tag: dicom.PixelRepresentation, VR: dicom.US, Value: []byte{0, 0}},
+			&dicom.Element{Tag: dicom.PixelData, VR: dicom.OB, Value: []byte{1, 2}},
+		),
+	}
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name     string
+		modify   func(f *dicom.File)
+		data     []byte
+		rule     string
+		severity string
+		rejected bool
+		flagged  bool
+	}{
+		{
+			name: "conformant",
+		},
+		{
+			name:     "not a part 10 file",
+			data:     []byte("not dicom"),
+			rule:     dcmd.Part10Rule,
+			severity: dcmd.SeverityCritical,
+			rejected: true,
+		},
+		{
+			name:     "meta instance UID differs",
+			modify:   func(f *dicom.File) { f.Meta.SetString(dicom.MediaStorageSOPInstanceUID, "1.2.3.4.6") },
+			rule:     dcmd.FileMetaRule,
+			severity: dcmd.SeverityCritical,
+			rejected: true,
+		},
+		{
+			name:     "missing implementation class UID",
+			modify:   func(f *dicom.File) { f.Meta.Remove(dicom.ImplementationClassUID) },
+			rule:     dcmd.FileMetaRule,
+			severity: dcmd.SeverityCritical,
+			rejected: true,
+		},
+		{
+			name:     "unknown transfer syntax",
+			modify:   func(f *dicom.File) { f.Meta.SetString(dicom.TransferSyntaxUID, "1.2.840.10008.1.2.4.999") },
+			rule:     dcmd.FileMetaRule,
+			severity: dcmd.SeverityWarning,
+			flagged:  true,
+		},
+		{
+			name:     "SOP class as transfer syntax",
+			modify:   func(f *dicom.File) { f.Meta.SetString(dicom.TransferSyntaxUID, secondaryCapture) },
+			rule:     dcmd.FileMetaRule,
+			severity: dcmd.SeverityCritical,
+			rejected: true,
+		},
+		{
+			name:     "missing study instance UID",
+			modify:   func(f *dicom.File) { f.Dataset.Remove(dicom.StudyInstanceUID) },
+			rule:     dcmd.RequiredAttributeRule,
+			severity: dcmd.SeverityCritical,
+			rejected: true,
+		},
+		{
+			name:     "invalid series instance UID",
+			modify:   func(f *dicom.File) { f.Dataset.SetString(dicom.SeriesInstanceUID, "1.2.03") },
+			rule:     dcmd.VRRule,
+			severity: dcmd.SeverityCritical,
+			rejected: true,
+		},
+		{
+			name: "elements out of order",
+			modify: func(f *dicom.File) {
+				f.Dataset.Elements[0], f.Dataset.Elements[1] = f.Dataset.Elements[1], f.Dataset.Elements[0]
+			},
+			rule:     dcmd.Part10Rule,
+			severity: dcmd.SeverityCritical,
+			rejected: true,
+		},
+		{
+			name: "binary value length",
+			modify: func(f *dicom.File) {
+				f.Dataset.Set(&dicom.Element{Tag: dicom.Tag(0x00189327), VR: dicom.FD, Value: make([]byte, 6)})
+			},
+			rule:     dcmd.LengthRule,
+			severity: dcmd.SeverityCritical,
+			rejected: true,
+		},
+		{
+			name:     "invalid date",
+			modify:   func(f *dicom.File) { f.Dataset.SetString(dicom.StudyDate, "20200231") },
+			rule:     dcmd.VRRule,
+			severity: dcmd.SeverityWarning,
+			flagged:  true,
+		},
+		{
+			name:     "value too long",
+			modify:   func(f *dicom.File) { f.Dataset.SetString(dicom.AccessionNumber, "A1234567890123456") },
+			rule:     dcmd.LengthRule,
+			severity: dcmd.SeverityWarning,
+			flagged:  true,
+		},
+		{
+			name:     "value multiplicity",
+			modify:   func(f *dicom.File) { f.Dataset.SetString(dicom.ImageType, "DERIVED") },
+			rule:     dcmd.VMRule,
+			severity: dcmd.SeverityWarning,
+			flagged:  true,
+		},
+		{
+			name:     "missing Type 1 attribute",
+			modify:   func(f *dicom.File) { f.Dataset.Remove(dicom.Tag(0x00080064)) },
+			rule:     dcmd.RequiredAttributeRule,
+			severity: dcmd.SeverityWarning,
+			flagged:  true,
+		},
+		{
+			name:     "missing Type 2 attribute",
+			modify:   func(f *dicom.File) { f.Dataset.Remove(dicom.ReferringPhysicianName) },
+			rule:     dcmd.RequiredAttributeRule,
+			severity: dcmd.SeverityInfo,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := tt.data
+			if data == nil {
+				f := newTestFile()
+				if tt.modify != nil {
+					tt.modify(f)
+				}
+				var buf bytes.Buffer
+				if err := dicom.Write(&buf, f); err != nil {
+					t.Fatalf("Write() error = %v", err)
+				}
+				data = buf.Bytes()
+			}
+
+			report, err := conformance.Validate("test.dcm", bytes.NewReader(data))
+			if err != nil {
+				t.Fatalf("Validate() error = %v", err)
+			}
+			if report.Rejected() != tt.rejected || report.Flagged() != tt.flagged {
+				t.Errorf("Rejected() = %v, Flagged() = %v, want %v, %v", report.Rejected(), report.Flagged(), tt.rejected, tt.flagged)
+			}
+			if tt.rule == "" {
+				for _, issue := range report.Issues {
+					t.Errorf("unexpected issue %+v", issue)
+				}
+				if report.IOD != "Secondary Capture Image" {
+					t.Errorf("IOD = %q", report.IOD)
+				}
+				return
+			}
+			found := false
+			for _, issue := range report.Issues {
+				found = found || (issue.Rule == tt.rule && issue.Severity == tt.severity)
+			}
+			if !found {
+				t.Errorf("no %s %s issue in %v", tt.severity, tt.rule, report.Issues)
+			}
+		})
+	}
+}
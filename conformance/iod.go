@@ -0,0 +1,197 @@
+package conformance
+
+import (
+	"fmt"
+
+	dcmd "gitlab.com/medical-research/dicom-deidentifier"
+	"gitlab.com/medical-research/dicom-deidentifier/dicom"
+	"gitlab.com/medical-research/dicom-deidentifier/dicom/dictionary"
+)
+
+// attribute is an attribute a module requires, of Type 1 (present with a value) or Type 2 (present,
+// possibly empty). Conditional attributes are not checked.
+type attribute struct {
+	keyword string
+	typ     int
+}
+
+// module is a module of PS3.3 with its unconditional Type 1 and Type 2 attributes
+type module struct {
+	name       string
+	attributes []attribute
+}
+
+// iod is the Information Object Definition of a SOP class with its mandatory modules
+type iod struct {
+	name    string
+	modules []*module
+}
+
+// Modules of PS3.3 shared by the image IODs
+var (
+	patientModule = &module{"Patient", []attribute{
+		{"PatientName", 2}, {"PatientID", 2}, {"PatientBirthDate", 2}, {"PatientSex", 2},
+	}}
+	generalStudyModule = &module{"General Study", []attribute{
+		{"StudyInstanceUID", 1}, {"StudyDate", 2}, {"StudyTime", 2}, {"ReferringPhysicianName", 2},
+		{"StudyID", 2}, {"AccessionNumber", 2},
+	}}
+	generalSeriesModule = &module{"General Series", []attribute{
+		{"Modality", 1}, {"SeriesInstanceUID", 1}, {"SeriesNumber", 2},
+	}}
+	frameOfReferenceModule = &module{"Frame of Reference", []attribute{
+		{"FrameOfReferenceUID", 1}, {"PositionReferenceIndicator", 2},
+	}}
+	generalEquipmentModule = &module{"General Equipment", []attribute{
+		{"Manufacturer", 2},
+	}}
+	generalImageModule = &module{"General Image", []attribute{
+		{"InstanceNumber", 2},
+	}}
+	imagePlaneModule = &module{"Image Plane", []attribute{
+		{"PixelSpacing", 1}, {"ImageOrientationPatient", 1}, {"ImagePositionPatient", 1}, {"SliceThickness", 2},
+	}}
+	imagePixelModule = &module{"Image Pixel", []attribute{
+		{"SamplesPerPixel", 1}, {"PhotometricInterpretation", 1}, {"Rows", 1}, {"Columns", 1},
+		{"BitsAllocated", 1}, {"BitsStored", 1}, {"HighBit", 1}, {"PixelRepresentation", 1}, {"PixelData", 1},
+	}}
+	sopCommonModule = &module{"SOP Common", []attribute{
+		{"SOPClassUID", 1}, {"SOPInstanceUID", 1},
+	}}
+)
+
+// Modules of PS3.3 specific to a modality
+var (
+	ctImageModule = &module{"CT Image", []attribute{
+		{"ImageType", 1}, {"RescaleIntercept", 1}, {"RescaleSlope", 1}, {"KVP", 2}, {"AcquisitionNumber", 2},
+	}}
+	mrImageModule = &module{"MR Image", []attribute{
+		{"ImageType", 1}, {"ScanningSequence", 1}, {"SequenceVariant", 1}, {"ScanOptions", 2},
+		{"MRAcquisitionType", 2}, {"EchoTime", 2}, {"EchoTrainLength", 2},
+	}}
+	crSeriesModule = &module{"CR Series", []attribute{
+		{"BodyPartExamined", 2}, {"ViewPosition", 2},
+	}}
+	dxSeriesModule = &module{"DX Series", []attribute{
+		{"Modality", 1}, {"PresentationIntentType", 1},
+	}}
+	dxImageModule = &module{"DX Image", []attribute{
+		{"ImageType", 1}, {"RescaleIntercept", 1}, {"RescaleSlope", 1}, {"RescaleType", 1},
+		{"LossyImageCompression", 1}, {"BurnedInAnnotation", 1},
+	}}
+	dxDetectorModule = &module{"DX Detector", []attribute{
+		{"ImagerPixelSpacing", 1},
+	}}
+	mammographyImageModule = &module{"Mammography Image", []attribute{
+		{"ImageType", 1}, {"ImageLaterality", 1},
+	}}
+	petImageModule = &module{"PET Image", []attribute{
+		{"ImageType", 1}, {"RescaleIntercept", 1}, {"RescaleSlope", 1},
+	}}
+	scEquipmentModule = &module{"SC Equipment", []attribute{
+		{"ConversionType", 1},
+	}}
+)
+
+// iods are the IODs checked by SOP class keyword. Other SOP classes are only checked for the
+// identifiers of the instance.
+var iods = map[string]*iod{
+	"CTImageStorage": {"CT Image", []*module{
+		patientModule, generalStudyModule, generalSeriesModule, frameOfReferenceModule, generalEquipmentModule,
+		generalImageModule, imagePlaneModule, imagePixelModule, ctImageModule, sopCommonModule,
+	}},
+	"MRImageStorage": {"MR Image", []*module{
+		patientModule, generalStudyModule, generalSeriesModule, frameOfReferenceModule, generalEquipmentModule,
+		generalImageModule, imagePlaneModule, imagePixelModule, mrImageModule, sopCommonModule,
+	}},
+	"PositronEmissionTomographyImageStorage": {"PET Image", []*module{
+		patientModule, generalStudyModule, generalSeriesModule, frameOfReferenceModule, generalEquipmentModule,
+		generalImageModule, imagePlaneModule, imagePixelModule, petImageModule, sopCommonModule,
+	}},
+	"ComputedRadiographyImageStorage": {"Computed Radiography Image", []*module{
+		patientModule, generalStudyModule, generalSeriesModule, crSeriesModule, generalEquipmentModule,
+		generalImageModule, imagePixelModule, sopCommonModule,
+	}},
+	"DigitalXRayImageStorageForPresentation": {"Digital X-Ray Image", []*module{
+		patientModule, generalStudyModule, generalSeriesModule, dxSeriesModule, generalEquipmentModule,
+		generalImageModule, imagePixelModule, dxImageModule, dxDetectorModule, sopCommonModule,
+	}},
+	"DigitalXRayImageStorageForProcessing": {"Digital X-Ray Image", []*module{
+		patientModule, generalStudyModule, generalSeriesModule, dxSeriesModule, generalEquipmentModule,
+		generalImageModule, imagePixelModule, dxImageModule, dxDetectorModule, sopCommonModule,
+	}},
+	"DigitalMammographyXRayImageStorageForPresentation": {"Digital Mammography X-Ray Image", []*module{
+		patientModule, generalStudyModule, generalSeriesModule, dxSeriesModule, generalEquipmentModule,
+		generalImageModule, imagePixelModule, dxImageModule, dxDetectorModule, mammographyImageModule, sopCommonModule,
+	}},
+	"UltrasoundImageStorage": {"Ultrasound Image", []*module{
+		patientModule, generalStudyModule, generalSeriesModule, generalEquipmentModule,
+		generalImageModule, imagePixelModule, sopCommonModule,
+	}},
+	"UltrasoundMultiFrameImageStorage": {"Ultrasound Multi-frame Image", []*module{
+		patientModule, generalStudyModule, generalSeriesModule, generalEquipmentModule,
+		generalImageModule, imagePixelModule, sopCommonModule,
+	}},
+	"SecondaryCaptureImageStorage": {"Secondary Capture Image", []*module{
+		patientModule, generalStudyModule, generalSeriesModule, generalEquipmentModule, scEquipmentModule,
+		generalImageModule, imagePixelModule, sopCommonModule,
+	}},
+}
+
+// iodsByUID are the IODs checked by SOP class UID
+var iodsByUID = map[string]*iod{}
+
+func init() {
+	for keyword, d := range iods {
+		u, ok := dictionary.LookupUIDKeyword(keyword)
+		if !ok {
+			panic(fmt.Sprintf("conformance: unknown SOP class %s", keyword))
+		}
+		iodsByUID[u.Value] = d
+		for _, m := range d.modules {
+			for _, a := range m.attributes {
+				if _, ok := dicom.TagForKeyword(a.keyword); !ok {
+					panic(fmt.Sprintf("conformance: unknown attribute %s of the %s module", a.keyword, m.name))
+				}
+			}
+		}
+	}
+}
+
+// checkIOD checks the attributes required by the modules of the IOD of the SOP class of a dataset.
+// The identifiers of the instance are left to checkIdentifiers.
+func (v *validator) checkIOD(ds *dicom.Dataset) {
+	sopClass := ds.GetString(dicom.SOPClassUID)
+	d, ok := iodsByUID[sopClass]
+	if !ok {
+		if sopClass != "" {
+			v.add("", dcmd.RequiredAttributeRule, dcmd.SeverityInfo, "modules of SOP class %s not checked", sopClass)
+		}
+		return
+	}
+	v.report.IOD = d.name
+
+	checked := map[dicom.Tag]bool{}
+	for _, tag := range identifiers {
+		checked[tag] = true
+	}
+	for _, m := range d.modules {
+		for _, a := range m.attributes {
+			tag, _ := dicom.TagForKeyword(a.keyword)
+			if checked[tag] {
+				continue
+			}
+			checked[tag] = true
+
+			e := ds.Get(tag)
+			switch {
+			case e == nil && a.typ == 1:
+				v.add(tag.String(), dcmd.RequiredAttributeRule, dcmd.SeverityWarning, "missing Type 1 attribute of the %s module", m.name)
+			case e == nil:
+				v.add(tag.String(), dcmd.RequiredAttributeRule, dcmd.SeverityInfo, "missing Type 2 attribute of the %s module", m.name)
+			case e.IsEmpty() && a.typ == 1:
+				v.add(tag.String(), dcmd.RequiredAttributeRule, dcmd.SeverityWarning, "empty Type 1 attribute of the %s module", m.name)
+			}
+		}
+	}
+}
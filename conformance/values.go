@@ -0,0 +1,251 @@
+package conformance
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	dcmd "gitlab.com/medical-research/dicom-deidentifier"
+	"gitlab.com/medical-research/dicom-deidentifier/dicom"
+	"gitlab.com/medical-research/dicom-deidentifier/dicom/dictionary"
+)
+
+// maxLengths are the maximum lengths of a single value of the VRs of PS3.5 Table 6.2-1, in
+// characters for the text VRs. The maximum of PN applies to each component group.
+var maxLengths = map[string]int{
+	dicom.AE: 16,
+	dicom.AS: 4,
+	dicom.CS: 16,
+	dicom.DA: 8,
+	dicom.DS: 16,
+	dicom.DT: 26,
+	dicom.IS: 12,
+	dicom.LO: 64,
+	dicom.LT: 10240,
+	dicom.PN: 64,
+	dicom.SH: 16,
+	dicom.ST: 1024,
+	dicom.TM: 14,
+	dicom.UI: 64,
+}
+
+// binarySizes are the sizes in bytes of a single value of the binary VRs
+var binarySizes = map[string]int{
+	dicom.AT: 4,
+	dicom.FD: 8,
+	dicom.FL: 4,
+	dicom.OD: 8,
+	dicom.OF: 4,
+	dicom.OL: 4,
+	dicom.OV: 8,
+	dicom.OW: 2,
+	dicom.SL: 4,
+	dicom.SS: 2,
+	dicom.SV: 8,
+	dicom.UL: 4,
+	dicom.US: 2,
+	dicom.UV: 8,
+}
+
+var (
+	ageString     = regexp.MustCompile(`^[0-9]{3}[DWMY]$`)
+	codeString    = regexp.MustCompile(`^[A-Z0-9 _]*$`)
+	decimalString = regexp.MustCompile(`^[+-]?([0-9]+\.?[0-9]*|\.[0-9]+)([eE][+-]?[0-9]+)?$`)
+	integerString = regexp.MustCompile(`^[+-]?[0-9]+$`)
+	dateTime      = regexp.MustCompile(`^[0-9]{4}([0-9]{2}([0-9]{2}([0-9]{2}([0-9]{2}([0-9]{2}(\.[0-9]{1,6})?)?)?)?)?)?([+-][0-9]{4})?$`)
+	timeString    = regexp.MustCompile(`^([01][0-9]|2[0-3])([0-5][0-9]([0-5][0-9]|60)?)?(\.[0-9]{1,6})?$`)
+	uid           = regexp.MustCompile(`^(0|[1-9][0-9]*)(\.(0|[1-9][0-9]*))*$`)
+)
+
+// checkValues checks the VR, VM and length of the values of every element of a dataset
+func (v *validator) checkValues(ds *dicom.Dataset) {
+	ds.Walk(func(parent *dicom.Dataset, e *dicom.Element, path string) error {
+		v.checkElement(e, path)
+		return nil
+	})
+}
+
+// checkElement checks the values of a single element found at path
+func (v *validator) checkElement(e *dicom.Element, path string) {
+	std, standard := dictionary.Lookup(uint32(e.Tag))
+	standard = standard && !e.Tag.IsPrivate()
+
+	switch {
+	case e.Tag.IsPrivateCreator() && e.VR != dicom.LO:
+		v.add(path, dcmd.VRRule, dcmd.SeverityWarning, "private creator has VR %s, not LO", e.VR)
+	case standard && e.VR == dicom.UN:
+		v.add(path, dcmd.VRRule, dcmd.SeverityInfo, "standard attribute encoded with VR UN")
+	case standard && !allowedVR(std.VR, e.VR):
+		v.add(path, dcmd.VRRule, dcmd.SeverityWarning, "VR %s, the data dictionary gives %s", e.VR, std.VR)
+	}
+	if e.IsSequence() || e.IsEncapsulated() || e.IsEmpty() {
+		return
+	}
+
+	// text values converted to UTF-8 no longer have their encoded length
+	if len(e.Value)%2 == 1 && !(v.converted && dicom.IsTextVR(e.VR)) {
+		v.add(path, dcmd.Part10Rule, dcmd.SeverityWarning, "odd value length %d", len(e.Value))
+	}
+
+	if size, ok := binarySizes[e.VR]; ok {
+		if len(e.Value)%size != 0 {
+			v.add(path, dcmd.LengthRule, dcmd.SeverityCritical, "value length %d is not a multiple of %d for VR %s", len(e.Value), size, e.VR)
+			return
+		}
+		if standard && e.VR != dicom.OD && e.VR != dicom.OF && e.VR != dicom.OL && e.VR != dicom.OV && e.VR != dicom.OW {
+			v.checkVM(path, std.VM, len(e.Value)/size)
+		}
+		return
+	}
+	if !dicom.IsTextVR(e.VR) {
+		return
+	}
+
+	values := e.Strings()
+	for i, value := range values {
+		if value == "" {
+			continue
+		}
+		if rule, message := checkValue(e.VR, value); rule != "" {
+			severity := dcmd.SeverityWarning
+			if e.VR == dicom.UI && isIdentifier(path) {
+				severity = dcmd.SeverityCritical
+			}
+			if len(values) > 1 {
+				message = "value " + strconv.Itoa(i+1) + ": " + message
+			}
+			v.add(path, rule, severity, "%s", message)
+		}
+	}
+	if standard && dicom.IsStringListVR(e.VR) {
+		v.checkVM(path, std.VM, len(values))
+	}
+}
+
+// allowedVR reports whether vr is one of the choice of VRs of the data dictionary, e.g. "US or SS"
+func allowedVR(choice, vr string) bool {
+	for _, c := range strings.Split(choice, " or ") {
+		if c == vr {
+			return true
+		}
+	}
+	// the dictionary has no VR for the item and delimitation tags
+	return choice == ""
+}
+
+// isIdentifier reports whether path is one of the top level UIDs identifying the instance
+func isIdentifier(path string) bool {
+	for _, tag := range identifiers {
+		if path == tag.String() {
+			return true
+		}
+	}
+	return false
+}
+
+// checkVM checks the number of values of an element against the value multiplicity of the data
+// dictionary: "1", "1-3", "1-n" or "2-2n"
+func (v *validator) checkVM(path, vm string, n int) {
+	min, max, step, ok := parseVM(vm)
+	if !ok {
+		return
+	}
+	if n < min || (max > 0 && n > max) || (n-min)%step != 0 {
+		v.add(path, dcmd.VMRule, dcmd.SeverityWarning, "%d values, the value multiplicity is %s", n, vm)
+	}
+}
+
+// parseVM returns the minimum and maximum number of values of a value multiplicity, max being
+// 0 when unbounded, and the multiple the number of values goes up by
+func parseVM(vm string) (min, max, step int, ok bool) {
+	parts := strings.SplitN(vm, "-", 2)
+	min, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return 0, 0, 0, false
+	}
+	if len(parts) == 1 {
+		return min, min, 1, true
+	}
+	switch upper := parts[1]; {
+	case upper == "n":
+		return min, 0, 1, true
+	case strings.HasSuffix(upper, "n"):
+		step, err := strconv.Atoi(strings.TrimSuffix(upper, "n"))
+		if err != nil || step == 0 {
+			return 0, 0, 0, false
+		}
+		return min, 0, step, true
+	default:
+		max, err := strconv.Atoi(upper)
+		if err != nil {
+			return 0, 0, 0, false
+		}
+		return min, max, 1, true
+	}
+}
+
+// checkValue checks a single text value against the length and format of its VR. It returns the
+// rule broken with a message, or "" if the value is valid.
+func checkValue(vr, value string) (rule, message string) {
+	if max, ok := maxLengths[vr]; ok {
+		if vr == dicom.PN {
+			for _, group := range strings.Split(value, "=") {
+				if n := utf8.RuneCountInString(group); n > max {
+					return dcmd.LengthRule, "component group of " + strconv.Itoa(n) + " characters, VR PN allows " + strconv.Itoa(max)
+				}
+			}
+		} else if n := utf8.RuneCountInString(value); n > max {
+			return dcmd.LengthRule, strconv.Itoa(n) + " characters, VR " + vr + " allows " + strconv.Itoa(max)
+		}
+	}
+
+	valid := true
+	switch vr {
+	case dicom.AE:
+		valid = !hasControl(value, false)
+	case dicom.AS:
+		valid = ageString.MatchString(value)
+	case dicom.CS:
+		valid = codeString.MatchString(value)
+	case dicom.DA:
+		_, err := time.Parse("20060102", value)
+		valid = err == nil
+	case dicom.DS:
+		valid = decimalString.MatchString(value)
+	case dicom.DT:
+		valid = dateTime.MatchString(value)
+	case dicom.IS:
+		if valid = integerString.MatchString(value); valid {
+			_, err := strconv.ParseInt(value, 10, 32)
+			valid = err == nil
+		}
+	case dicom.TM:
+		valid = timeString.MatchString(value)
+	case dicom.UI:
+		valid = uid.MatchString(value)
+	case dicom.LO, dicom.SH, dicom.PN, dicom.UC:
+		valid = !hasControl(value, false)
+	case dicom.LT, dicom.ST, dicom.UT:
+		valid = !hasControl(value, true)
+	}
+	if !valid {
+		return dcmd.VRRule, "invalid " + vr + " value " + strconv.Quote(value)
+	}
+	return "", ""
+}
+
+// hasControl reports whether a value holds control characters other than the escape of the
+// character set extensions, and the line and page breaks and tabs of texts when text is set
+func hasControl(value string, text bool) bool {
+	for _, r := range value {
+		switch {
+		case r == 0x1B:
+		case text && (r == '\t' || r == '\n' || r == '\f' || r == '\r'):
+		case r < 0x20 || r == 0x7F:
+			return true
+		}
+	}
+	return false
+}
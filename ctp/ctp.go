@@ -0,0 +1,246 @@
+// Package ctp translates RSNA CTP DicomAnonymizer scripts into de-identification profiles
+package ctp
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+
+	dcmd "gitlab.com/medical-research/dicom-deidentifier"
+)
+
+// script is the XML document of a DicomAnonymizer script
+type script struct {
+	Params   []*scriptEntry `xml:"p"`
+	Elements []*scriptEntry `xml:"e"`
+	Keeps    []*scriptEntry `xml:"k"`
+	Removes  []*scriptEntry `xml:"r"`
+}
+
+// scriptEntry is a single parameter, element rule, keep or remove setting of a script
+type scriptEntry struct {
+	Enabled string `xml:"en,attr"`
+	Tag     string `xml:"t,attr"`
+	Name    string `xml:"n,attr"`
+	Script  string `xml:",chardata"`
+}
+
+// enabled reports whether the entry is checked in the script, entries without "en" always being
+func (e *scriptEntry) enabled() bool {
+	return e.Enabled != "F"
+}
+
+var (
+	// call matches a single function call such as "@incrementdate(this,@DATEINC)"
+	call = regexp.MustCompile(`^@(\w+)\(([^()]*)\)$`)
+
+	// elementTag matches the tag of an element rule, e.g. "00100010"
+	elementTag = regexp.MustCompile(`^[0-9A-Fa-f]{8}$`)
+
+	// groupTag matches the group of a keep setting, e.g. "0018"
+	groupTag = regexp.MustCompile(`^[0-9A-Fa-f]{4}$`)
+)
+
+// translator holds the state of a script being translated
+type translator struct {
+	params  map[string]string
+	profile *dcmd.Profile
+	issues  []*dcmd.ScriptIssue
+}
+
+// ReadScript reads a DicomAnonymizer script and returns the profile named name applying it,
+// with the constructs the profile does not reproduce. Element rules become attribute rules of the
+// profile; attributes the script has no rule for are left to the basic profile.
+// Returns EINVALID if the script is not well-formed XML.
+func ReadScript(name string, r io.Reader) (*dcmd.ScriptImport, error) {
+	var s script
+	if err := xml.NewDecoder(r).Decode(&s); err != nil {
+		return nil, dcmd.Errorf(dcmd.EINVALID, "invalid ctp script: %v", err)
+	}
+
+	t := &translator{params: map[string]string{}, profile: &dcmd.Profile{Name: name}}
+	for _, p := range s.Params {
+		t.params[p.Tag] = strings.TrimSpace(p.Script)
+	}
+	if _, ok := t.params["UIDROOT"]; ok {
+		t.issue("", "@UIDROOT", "UIDs are remapped under the UID root of the service rather than the root of the script")
+	}
+	for _, e := range s.Elements {
+		if e.enabled() {
+			t.element(e)
+		}
+	}
+	for _, k := range s.Keeps {
+		t.keep(k)
+	}
+	for _, r := range s.Removes {
+		t.remove(r)
+	}
+	return &dcmd.ScriptImport{Profile: t.profile, Issues: t.issues}, nil
+}
+
+// issue records a construct the profile does not reproduce exactly
+func (t *translator) issue(element, construct, format string, args ...interface{}) {
+	t.issues = append(t.issues, &dcmd.ScriptIssue{
+		Element:   element,
+		Construct: construct,
+		Message:   fmt.Sprintf(format, args...),
+	})
+}
+
+// addOption adds an option to the profile once
+func (t *translator) addOption(option string) {
+	if !t.profile.HasOption(option) {
+		t.profile.Options = append(t.profile.Options, option)
+	}
+}
+
+// addRule adds an attribute rule to the profile
+func (t *translator) addRule(tag, action, value string) {
+	t.profile.Rules = append(t.profile.Rules, &dcmd.AttributeRule{Tag: tag, Action: action, Value: value})
+}
+
+// element translates the rule of a single element
+func (t *translator) element(e *scriptEntry) {
+	if !elementTag.MatchString(e.Tag) {
+		t.issue(e.Tag, e.Tag, "only elements given as 8 hexadecimal digits are supported, the element is left to the basic profile")
+		return
+	}
+	tag := fmt.Sprintf("(%s,%s)", strings.ToUpper(e.Tag[:4]), strings.ToUpper(e.Tag[4:]))
+
+	text := strings.TrimSpace(e.Script)
+	if strings.HasPrefix(text, "@always()") {
+		text = strings.TrimPrefix(text, "@always()")
+		t.issue(tag, "@always()", "missing elements are not created, the rule only applies to elements present")
+	}
+	switch {
+	case text == "":
+		t.addRule(tag, dcmd.RuleEmpty, "")
+		return
+	case !strings.Contains(text, "@"):
+		t.addRule(tag, dcmd.RuleReplace, text)
+		return
+	}
+
+	m := call.FindStringSubmatch(text)
+	if m == nil {
+		t.issue(tag, text, "only a single function call or a literal value is supported, the element is left to the basic profile")
+		return
+	}
+	function, args := m[1], splitArgs(m[2])
+	switch function {
+	case "keep", "process":
+		// the items of sequences are always processed
+		t.addRule(tag, dcmd.RuleKeep, "")
+	case "remove":
+		t.addRule(tag, dcmd.RuleRemove, "")
+	case "empty", "blank":
+		t.addRule(tag, dcmd.RuleEmpty, "")
+	case "hashuid":
+		t.addRule(tag, dcmd.RuleRemapUID, "")
+
+	case "incrementdate":
+		if len(args) != 2 {
+			t.issue(tag, text, "@incrementdate takes the element and a number of days, the element is left to the basic profile")
+			return
+		}
+		days := t.resolve(args[1])
+		if _, err := strconv.Atoi(days); err != nil {
+			t.issue(tag, text, "the increment %q is not a number of days, the element is left to the basic profile", days)
+			return
+		}
+		t.addRule(tag, dcmd.RuleIncrementDate, days)
+
+	case "param":
+		if len(args) != 1 {
+			t.issue(tag, text, "@param takes a single parameter, the element is left to the basic profile")
+			return
+		}
+		value, ok := t.params[strings.TrimPrefix(args[0], "@")]
+		if !ok {
+			t.issue(tag, text, "the script has no parameter %s, the element is left to the basic profile", args[0])
+			return
+		}
+		t.addRule(tag, dcmd.RuleReplace, value)
+
+	case "require":
+		t.addRule(tag, dcmd.RuleKeep, "")
+		t.issue(tag, text, "the element is kept but not created when missing")
+
+	case "hash":
+		if tag != "(0010,0020)" {
+			t.issue(tag, text, "@hash is only supported for PatientID, the element is left to the basic profile")
+			return
+		}
+		// the patient is given the pseudonym of the project rather than a hash of the ID
+		t.addOption(dcmd.PseudonymizePatientIDOption)
+		t.issue(tag, text, "PatientID and PatientName are replaced by the pseudonym of the patient within the project")
+
+	default:
+		t.issue(tag, text, "@%s is not supported, the element is left to the basic profile", function)
+	}
+}
+
+// keep translates a keep setting: a whole group or the safe private elements
+func (t *translator) keep(k *scriptEntry) {
+	if !k.enabled() {
+		return
+	}
+	switch {
+	case k.Tag == "safeprivateelements":
+		t.addOption(dcmd.RetainSafePrivateOption)
+	case groupTag.MatchString(k.Tag):
+		t.addRule(fmt.Sprintf("(%s,xxxx)", strings.ToUpper(k.Tag)), dcmd.RuleKeep, "")
+	default:
+		t.issue("", k.Tag, "unknown keep setting")
+	}
+}
+
+// remove translates a remove setting
+func (t *translator) remove(r *scriptEntry) {
+	switch r.Tag {
+	case "privategroups":
+		if !r.enabled() {
+			t.issue("", r.Tag, "private groups are always removed, except for the safe private elements")
+		}
+	case "curves":
+		if !r.enabled() {
+			t.issue("", r.Tag, "curves are always removed")
+		}
+	case "overlays":
+		if r.enabled() {
+			t.addOption(dcmd.RemoveOverlaysOption)
+		}
+	case "unspecifiedelements":
+		if r.enabled() {
+			t.issue("", r.Tag, "elements without a rule are left to the basic profile rather than removed")
+		}
+	default:
+		if r.enabled() {
+			t.issue("", r.Tag, "unknown remove setting")
+		}
+	}
+}
+
+// resolve returns the value of a function argument, parameters such as "@DATEINC" being replaced by their value
+func (t *translator) resolve(arg string) string {
+	if strings.HasPrefix(arg, "@") {
+		return t.params[strings.TrimPrefix(arg, "@")]
+	}
+	return strings.Trim(arg, `"`)
+}
+
+// splitArgs returns the trimmed arguments of a function call
+func splitArgs(s string) []string {
+	if strings.TrimSpace(s) == "" {
+		return nil
+	}
+	args := strings.Split(s, ",")
+	for i, a := range args {
+		args[i] = strings.TrimSpace(a)
+	}
+	return args
+}
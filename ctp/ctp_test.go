@@ -0,0 +1,88 @@
+package ctp_test
+
+import (
+	"strings"
+	"testing"
+
+	dcmd "gitlab.com/medical-research/dicom-deidentifier"
+	"gitlab.com/medical-research/dicom-deidentifier/ctp"
+)
+
+const testScript = `<script>
+  <p t="DATEINC">-3210</p>
+  <p t="SITENAME">Site 12</p>
+  <p t="UIDROOT">1.2.840.113654.2.70.1</p>
+  <e en="T" t="00080020" n="StudyDate">@incrementdate(this,@DATEINC)</e>
+  <e en="T" t="00080080" n="InstitutionName">@param(@SITENAME)</e>
+  <e en="T" t="0020000D" n="StudyInstanceUID">@hashuid(@UIDROOT,this)</e>
+  <e en="T" t="00100010" n="PatientName">@empty()</e>
+  <e en="T" t="00100020" n="PatientID">@hash(this,10)</e>
+  <e en="T" t="00101010" n="PatientAge">@keep()</e>
+  <e en="T" t="00081030" n="StudyDescription">@lookup(this,StudyDescription)</e>
+  <e en="F" t="00081040" n="InstitutionalDepartmentName">@keep()</e>
+  <e en="T" t="00181030" n="ProtocolName">RESEARCH</e>
+  <k en="T" t="0028" n="Keep group 0028"/>
+  <k en="T" t="safeprivateelements">Keep safe private elements</k>
+  <r en="T" t="privategroups">Remove private groups</r>
+  <r en="T" t="overlays">Remove overlays</r>
+  <r en="T" t="unspecifiedelements">Remove unchecked elements</r>
+</script>`
+
+func TestReadScript(t *testing.T) {
+	imported, err := ctp.ReadScript("site-12", strings.NewReader(testScript))
+	if err != nil {
+		t.Fatalf("ReadScript() error = %v", err)
+	}
+	profile := imported.Profile
+
+	rules := map[string]*dcmd.AttributeRule{}
+	for _, r := range profile.Rules {
+		rules[r.Tag] = r
+	}
+	tests := []struct {
+		tag    string
+		action string
+		value  string
+	}{
+		{"(0008,0020)", dcmd.RuleIncrementDate, "-3210"},
+		{"(0008,0080)", dcmd.RuleReplace, "Site 12"},
+		{"(0020,000D)", dcmd.RuleRemapUID, ""},
+		{"(0010,0010)", dcmd.RuleEmpty, ""},
+		{"(0010,1010)", dcmd.RuleKeep, ""},
+		{"(0018,1030)", dcmd.RuleReplace, "RESEARCH"},
+		{"(0028,xxxx)", dcmd.RuleKeep, ""},
+	}
+	for _, tt := range tests {
+		r, ok := rules[tt.tag]
+		if !ok {
+			t.Errorf("ReadScript() has no rule for %s", tt.tag)
+			continue
+		}
+		if r.Action != tt.action || r.Value != tt.value {
+			t.Errorf("ReadScript() rule for %s = %s %q, want %s %q", tt.tag, r.Action, r.Value, tt.action, tt.value)
+		}
+	}
+	if len(profile.Rules) != len(tests) {
+		t.Errorf("ReadScript() = %d rules, want %d", len(profile.Rules), len(tests))
+	}
+
+	for _, option := range []string{dcmd.PseudonymizePatientIDOption, dcmd.RetainSafePrivateOption, dcmd.RemoveOverlaysOption} {
+		if !profile.HasOption(option) {
+			t.Errorf("ReadScript() profile has no %s option", option)
+		}
+	}
+
+	issues := map[string]bool{}
+	for _, issue := range imported.Issues {
+		issues[issue.Construct] = true
+	}
+	for _, construct := range []string{"@UIDROOT", "@hash(this,10)", "@lookup(this,StudyDescription)", "unspecifiedelements"} {
+		if !issues[construct] {
+			t.Errorf("ReadScript() has no issue for %s", construct)
+		}
+	}
+
+	if _, err := ctp.ReadScript("broken", strings.NewReader("<script><e>")); dcmd.ErrorCode(err) != dcmd.EINVALID {
+		t.Errorf("ReadScript() error = %v, want EINVALID", err)
+	}
+}
@@ -0,0 +1,111 @@
+package dicomdeidentifier
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// DatasetProfile catalogues the attributes found across the instances of a store or bucket prefix,
+// used to design the profile of a new site before onboarding it. Values are never given as they
+// are: samples of identifying attributes are hashed and all others truncated.
+type DatasetProfile struct {
+	Instances int `json:"instances"`
+
+	// Skipped is the number of objects that could not be read as DICOM instances
+	Skipped int `json:"skipped,omitempty"`
+
+	// Attributes are ordered by path, nested attributes being found under the path of their
+	// sequence without item numbers, e.g. "(0008,1115).(0008,1155)"
+	Attributes []*AttributeProfile `json:"attributes"`
+
+	PrivateCreators []*PrivateCreatorProfile `json:"private-creators,omitempty"`
+
+	// SOPClasses and Modalities count the instances per SOP Class UID and per modality
+	SOPClasses map[string]int `json:"sop-classes"`
+	Modalities map[string]int `json:"modalities"`
+
+	// SOPClassNames names the registered SOP classes, e.g. "CT Image Storage"
+	SOPClassNames map[string]string `json:"sop-class-names,omitempty"`
+}
+
+// AttributeProfile represents the occurrences of a single attribute
+type AttributeProfile struct {
+	Path           string `json:"path"`
+	Keyword        string `json:"keyword,omitempty"`
+	Name           string `json:"name,omitempty"`
+	PrivateCreator string `json:"private-creator,omitempty"`
+
+	// VRs counts the occurrences per VR, as implicit VR instances may disagree with the dictionary
+	VRs map[string]int `json:"vrs"`
+
+	// Count is the number of occurrences, Empty the number of those with a zero length value
+	Count int `json:"count"`
+	Empty int `json:"empty,omitempty"`
+
+	// Patterns counts the shapes of text values, letters becoming "A" or "a" and digits "9",
+	// e.g. "Doe^John" has the pattern "Aaa^Aaaa"
+	Patterns map[string]int `json:"patterns,omitempty"`
+
+	// Samples are distinct values either hashed, e.g. "sha256:1f2e3d4c", or truncated
+	Samples []string `json:"samples,omitempty"`
+}
+
+// PrivateCreatorProfile represents the occurrences of a private creator in a single group
+type PrivateCreatorProfile struct {
+	Creator string `json:"creator"`
+	Group   string `json:"group"`
+
+	// Instances is the number of instances holding the creator, Elements the number of private
+	// elements of its blocks
+	Instances int `json:"instances"`
+	Elements  int `json:"elements"`
+}
+
+// WriteCSV writes the attributes of the profile as CSV, one row per attribute. Maps are written
+// as "key=count" pairs and lists separated by "|".
+func (p *DatasetProfile) WriteCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write([]string{"path", "keyword", "name", "private-creator", "vrs", "count", "empty", "patterns", "samples"}); err != nil {
+		return err
+	}
+	for _, a := range p.Attributes {
+		if err := cw.Write([]string{
+			a.Path,
+			a.Keyword,
+			a.Name,
+			a.PrivateCreator,
+			formatCounts(a.VRs),
+			strconv.Itoa(a.Count),
+			strconv.Itoa(a.Empty),
+			formatCounts(a.Patterns),
+			strings.Join(a.Samples, "|"),
+		}); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// formatCounts returns counts as "key=count" pairs, most frequent first
+func formatCounts(counts map[string]int) string {
+	keys := make([]string, 0, len(counts))
+	for k := range counts {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if counts[keys[i]] != counts[keys[j]] {
+			return counts[keys[i]] > counts[keys[j]]
+		}
+		return keys[i] < keys[j]
+	})
+	pairs := make([]string, len(keys))
+	for i, k := range keys {
+		pairs[i] = fmt.Sprintf("%s=%d", k, counts[k])
+	}
+	return strings.Join(pairs, "|")
+}
@@ -0,0 +1,34 @@
+package dicomdeidentifier
+
+import (
+	"context"
+	"time"
+)
+
+// DateShift represents the secret offset applied to every date of a single patient
+type DateShift struct {
+	// PatientKey is a keyed hash of the patient's issuer and ID, so the table holds no identifiers
+	PatientKey string    `json:"patient-key"`
+	Days       int       `json:"days"`
+	CreatedAt  time.Time `json:"created-at"`
+}
+
+// DateShifter is an impentable interface that chooses the offset applied to the dates of a patient
+// Every upload for the same patient must be shifted by the same offset so intervals are preserved
+type DateShifter interface {
+
+	// Returns the number of days the dates of the patient are shifted by
+	PatientDateShift(ctx context.Context, issuer, patientID string) (int, error)
+}
+
+// DateShiftService is an impentable interface for persisting per-patient date shifts
+type DateShiftService interface {
+
+	// Finds the date shift recorded for a patient
+	// Returns ENOTFOUND if the patient has not been seen yet
+	FindDateShift(ctx context.Context, patientKey string) (*DateShift, error)
+
+	// Records the date shift of a new patient
+	// Returns ECONFLICT if the patient already has a date shift
+	CreateDateShift(ctx context.Context, shift *DateShift) error
+}
@@ -0,0 +1,98 @@
+package deid
+
+import "gitlab.com/medical-research/dicom-deidentifier/dicom"
+
+// Actions of PS3.15 Table E.1-1
+const (
+	// ActionRemove removes the attribute
+	ActionRemove = "X"
+	// ActionZero replaces the value with a zero length value
+	ActionZero = "Z"
+	// ActionDummy replaces the value with a non-zero length dummy value consistent with the VR
+	ActionDummy = "D"
+	// ActionKeep keeps the attribute unchanged
+	ActionKeep = "K"
+	// ActionClean replaces the value with values of similar meaning known not to contain identifying information
+	ActionClean = "C"
+	// ActionUID replaces the UID with a non-zero length UID consistently within the set of instances
+	ActionUID = "U"
+)
+
+// Option columns of PS3.15 Table E.1-1, indexing tableEntry.options
+const (
+	columnRetainSafePrivate = iota
+	columnRetainUIDs
+	columnRetainDeviceIdentity
+	columnRetainInstitutionIdentity
+	columnRetainPatientCharacteristics
+	columnRetainLongitudinalFullDates
+	columnRetainLongitudinalModifiedDates
+	columnCleanDescriptors
+	columnCleanStructuredContent
+	columnCleanGraphics
+	optionColumns
+)
+
+// tableEntry is the row of an attribute in PS3.15 Table E.1-1. Options leaving the
+// action of the Basic Profile unchanged are blank.
+type tableEntry struct {
+	name    string
+	basic   string
+	options [optionColumns]string
+}
+
+// The table is kept in basic_profile.csv. Regenerating it from the standard reports the
+// actions that changed from the previous edition.
+//go:generate go run ./internal/tablegen -xml https://dicom.nema.org/medical/dicom/current/source/docbook/part15/part15.xml -table basic_profile.csv -out basic_profile_table.go
+
+// classUIDs are UI attributes that identify a class or encoding rather than an instance and are never remapped
+var classUIDs = map[dicom.Tag]bool{
+	dicom.MediaStorageSOPClassUID: true,
+	dicom.TransferSyntaxUID:       true,
+	dicom.ImplementationClassUID:  true,
+	dicom.SOPClassUID:             true,
+	dicom.ReferencedSOPClassUID:   true,
+	dicom.CodingSchemeUID:         true,
+	0x0008001A:                    true, // Related General SOP Class UID
+	0x0008001B:                    true, // Original Specialized SOP Class UID
+	0x00081151:                    true, // Referenced Related General SOP Class UID
+	0x00041510:                    true, // Referenced SOP Class UID in File
+	0x00041512:                    true, // Referenced Transfer Syntax UID in File
+	0x04000010:                    true, // MAC Calculation Transfer Syntax UID
+	0x04000510:                    true, // Encrypted Content Transfer Syntax UID
+}
+
+// basicProfileAction returns the Basic Profile action for a standard attribute, or "" if the
+// attribute is not listed. Curve (50xx) and overlay (60xx) repeating groups are looked up by their base group.
+func basicProfileAction(tag dicom.Tag) string {
+	if tag.Group()&0xFF00 == 0x5000 {
+		return ActionRemove
+	}
+	return tableLookup(tag).basic
+}
+
+// tableLookup returns the row of a standard attribute in Table E.1-1, which is blank if the
+// attribute is not listed. Overlay (60xx) repeating groups are looked up by their base group.
+func tableLookup(tag dicom.Tag) tableEntry {
+	if e, ok := basicProfile[tag]; ok {
+		return e
+	}
+	if g := tag.Group() & 0xFF00; g == 0x6000 {
+		return basicProfile[dicom.NewTag(g, tag.Element())]
+	}
+	return tableEntry{}
+}
+
+// optionAttributes returns the attributes of Table E.1-1 given action by an option column, with extra
+func optionAttributes(column int, action string, extra ...dicom.Tag) map[dicom.Tag]bool {
+	attributes := map[dicom.Tag]bool{}
+	for tag, e := range basicProfile {
+		if e.options[column] == action {
+			attributes[tag] = true
+		}
+	}
+	for _, tag := range extra {
+		attributes[tag] = true
+	}
+	return attributes
+}
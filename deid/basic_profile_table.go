@@ -0,0 +1,263 @@
+// Code generated by tablegen from basic_profile.csv; DO NOT EDIT.
+
+package deid
+
+import "gitlab.com/medical-research/dicom-deidentifier/dicom"
+
+// BasicProfileEdition is the edition of PS3.15 the Basic Profile table was generated from.
+// It is empty when the table was not generated from the standard.
+const BasicProfileEdition = ""
+
+// basicProfile holds the actions of PS3.15 Table E.1-1. Repeating groups are registered under
+// their base group and compound actions such as "X/Z/D" are resolved by resolveAction.
+var basicProfile = map[dicom.Tag]tableEntry{
+	0x00080012: {name: "Instance Creation Date", basic: "X/D"},
+	0x00080013: {name: "Instance Creation Time", basic: "X/Z/D"},
+	0x00080014: {name: "Instance Creator UID", basic: "U", options: [optionColumns]string{columnRetainUIDs: "K"}},
+	0x00080015: {name: "Instance Coercion DateTime", basic: "X"},
+	0x00080018: {name: "SOP Instance UID", basic: "U", options: [optionColumns]string{columnRetainUIDs: "K"}},
+	0x00080020: {name: "Study Date", basic: "Z"},
+	0x00080021: {name: "Series Date", basic: "X/D"},
+	0x00080022: {name: "Acquisition Date", basic: "X/Z"},
+	0x00080023: {name: "Content Date", basic: "Z/D"},
+	0x00080024: {name: "Overlay Date", basic: "X"},
+	0x00080025: {name: "Curve Date", basic: "X"},
+	0x0008002A: {name: "Acquisition DateTime", basic: "X/Z/D"},
+	0x00080030: {name: "Study Time", basic: "Z"},
+	0x00080031: {name: "Series Time", basic: "X/D"},
+	0x00080032: {name: "Acquisition Time", basic: "X/Z"},
+	0x00080033: {name: "Content Time", basic: "Z/D"},
+	0x00080034: {name: "Overlay Time", basic: "X"},
+	0x00080035: {name: "Curve Time", basic: "X"},
+	0x00080050: {name: "Accession Number", basic: "Z"},
+	0x00080058: {name: "Failed SOP Instance UID List", basic: "U", options: [optionColumns]string{columnRetainUIDs: "K"}},
+	0x00080080: {name: "Institution Name", basic: "X/Z/D"},
+	0x00080081: {name: "Institution Address", basic: "X"},
+	0x00080082: {name: "Institution Code Sequence", basic: "X/Z/D"},
+	0x00080090: {name: "Referring Physician's Name", basic: "Z"},
+	0x00080092: {name: "Referring Physician's Address", basic: "X"},
+	0x00080094: {name: "Referring Physician's Telephone Numbers", basic: "X"},
+	0x00080096: {name: "Referring Physician Identification Sequence", basic: "X"},
+	0x0008010D: {name: "Context Group Extension Creator UID", basic: "U", options: [optionColumns]string{columnRetainUIDs: "K"}},
+	0x00080201: {name: "Timezone Offset From UTC", basic: "X"},
+	0x00081010: {name: "Station Name", basic: "X/Z/D"},
+	0x00081030: {name: "Study Description", basic: "X", options: [optionColumns]string{columnCleanDescriptors: "C"}},
+	0x0008103E: {name: "Series Description", basic: "X", options: [optionColumns]string{columnCleanDescriptors: "C"}},
+	0x00081040: {name: "Institutional Department Name", basic: "X"},
+	0x00081048: {name: "Physician(s) of Record", basic: "X"},
+	0x00081049: {name: "Physician(s) of Record Identification Sequence", basic: "X"},
+	0x00081050: {name: "Performing Physicians' Name", basic: "X"},
+	0x00081052: {name: "Performing Physician Identification Sequence", basic: "X"},
+	0x00081060: {name: "Name of Physician(s) Reading Study", basic: "X"},
+	0x00081062: {name: "Physician(s) Reading Study Identification Sequence", basic: "X"},
+	0x00081070: {name: "Operators' Name", basic: "X/Z/D"},
+	0x00081072: {name: "Operators' Identification Sequence", basic: "X/D"},
+	0x00081080: {name: "Admitting Diagnoses Description", basic: "X", options: [optionColumns]string{columnCleanDescriptors: "C"}},
+	0x00081084: {name: "Admitting Diagnoses Code Sequence", basic: "X"},
+	0x00081110: {name: "Referenced Study Sequence", basic: "X/Z"},
+	0x00081111: {name: "Referenced Performed Procedure Step Sequence", basic: "X/Z/D"},
+	0x00081120: {name: "Referenced Patient Sequence", basic: "X"},
+	0x00081140: {name: "Referenced Image Sequence", basic: "X/Z/U*"},
+	0x00081155: {name: "Referenced SOP Instance UID", basic: "U", options: [optionColumns]string{columnRetainUIDs: "K"}},
+	0x00081195: {name: "Transaction UID", basic: "U", options: [optionColumns]string{columnRetainUIDs: "K"}},
+	0x00082111: {name: "Derivation Description", basic: "X", options: [optionColumns]string{columnCleanDescriptors: "C"}},
+	0x00082112: {name: "Source Image Sequence", basic: "X/Z/U*"},
+	0x00083010: {name: "Irradiation Event UID", basic: "U", options: [optionColumns]string{columnRetainUIDs: "K"}},
+	0x00084000: {name: "Identifying Comments", basic: "X"},
+	0x00089123: {name: "Creator-Version UID", basic: "U", options: [optionColumns]string{columnRetainUIDs: "K"}},
+	0x00100010: {name: "Patient's Name", basic: "Z"},
+	0x00100020: {name: "Patient ID", basic: "Z"},
+	0x00100021: {name: "Issuer of Patient ID", basic: "X"},
+	0x00100030: {name: "Patient's Birth Date", basic: "Z"},
+	0x00100032: {name: "Patient's Birth Time", basic: "X"},
+	0x00100040: {name: "Patient's Sex", basic: "Z"},
+	0x00100050: {name: "Patient's Insurance Plan Code Sequence", basic: "X"},
+	0x00100101: {name: "Patient's Primary Language Code Sequence", basic: "X"},
+	0x00100102: {name: "Patient's Primary Language Modifier Code Sequence", basic: "X"},
+	0x00101000: {name: "Other Patient IDs", basic: "X"},
+	0x00101001: {name: "Other Patient Names", basic: "X"},
+	0x00101002: {name: "Other Patient IDs Sequence", basic: "X"},
+	0x00101005: {name: "Patient's Birth Name", basic: "X"},
+	0x00101010: {name: "Patient's Age", basic: "X"},
+	0x00101020: {name: "Patient's Size", basic: "X"},
+	0x00101030: {name: "Patient's Weight", basic: "X"},
+	0x00101040: {name: "Patient's Address", basic: "X"},
+	0x00101050: {name: "Insurance Plan Identification", basic: "X"},
+	0x00101060: {name: "Patient's Mother's Birth Name", basic: "X"},
+	0x00101080: {name: "Military Rank", basic: "X"},
+	0x00101081: {name: "Branch of Service", basic: "X"},
+	0x00101090: {name: "Medical Record Locator", basic: "X"},
+	0x00101100: {name: "Referenced Patient Photo Sequence", basic: "X"},
+	0x00102000: {name: "Medical Alerts", basic: "X", options: [optionColumns]string{columnCleanDescriptors: "C"}},
+	0x00102110: {name: "Allergies", basic: "X", options: [optionColumns]string{columnCleanDescriptors: "C"}},
+	0x00102150: {name: "Country of Residence", basic: "X"},
+	0x00102152: {name: "Region of Residence", basic: "X"},
+	0x00102154: {name: "Patient's Telephone Numbers", basic: "X"},
+	0x00102160: {name: "Ethnic Group", basic: "X"},
+	0x00102180: {name: "Occupation", basic: "X", options: [optionColumns]string{columnCleanDescriptors: "C"}},
+	0x001021A0: {name: "Smoking Status", basic: "X"},
+	0x001021B0: {name: "Additional Patient History", basic: "X", options: [optionColumns]string{columnCleanDescriptors: "C"}},
+	0x001021C0: {name: "Pregnancy Status", basic: "X"},
+	0x001021D0: {name: "Last Menstrual Date", basic: "X"},
+	0x001021F0: {name: "Patient's Religious Preference", basic: "X"},
+	0x00102203: {name: "Patient's Sex Neutered", basic: "X/Z"},
+	0x00102297: {name: "Responsible Person", basic: "X"},
+	0x00102299: {name: "Responsible Organization", basic: "X"},
+	0x00104000: {name: "Patient Comments", basic: "X", options: [optionColumns]string{columnCleanDescriptors: "C"}},
+	0x00180010: {name: "Contrast/Bolus Agent", basic: "Z/D", options: [optionColumns]string{columnCleanDescriptors: "C"}},
+	0x00181000: {name: "Device Serial Number", basic: "X/Z/D"},
+	0x00181002: {name: "Device UID", basic: "U", options: [optionColumns]string{columnRetainUIDs: "K"}},
+	0x00181004: {name: "Plate ID", basic: "X"},
+	0x00181005: {name: "Generator ID", basic: "X"},
+	0x00181007: {name: "Cassette ID", basic: "X"},
+	0x00181008: {name: "Gantry ID", basic: "X"},
+	0x00181030: {name: "Protocol Name", basic: "X/D", options: [optionColumns]string{columnCleanDescriptors: "C"}},
+	0x00181400: {name: "Acquisition Device Processing Description", basic: "X/D"},
+	0x00184000: {name: "Acquisition Comments", basic: "X", options: [optionColumns]string{columnCleanDescriptors: "C"}},
+	0x0018700A: {name: "Detector ID", basic: "X/D"},
+	0x00189424: {name: "Acquisition Protocol Description", basic: "X"},
+	0x0018A003: {name: "Contribution Description", basic: "X"},
+	0x0020000D: {name: "Study Instance UID", basic: "U", options: [optionColumns]string{columnRetainUIDs: "K"}},
+	0x0020000E: {name: "Series Instance UID", basic: "U", options: [optionColumns]string{columnRetainUIDs: "K"}},
+	0x00200010: {name: "Study ID", basic: "Z"},
+	0x00200052: {name: "Frame of Reference UID", basic: "U", options: [optionColumns]string{columnRetainUIDs: "K"}},
+	0x00200200: {name: "Synchronization Frame of Reference UID", basic: "U", options: [optionColumns]string{columnRetainUIDs: "K"}},
+	0x00203401: {name: "Modifying Device ID", basic: "X"},
+	0x00203404: {name: "Modifying Device Manufacturer", basic: "X"},
+	0x00203406: {name: "Modified Image Description", basic: "X"},
+	0x00204000: {name: "Image Comments", basic: "X", options: [optionColumns]string{columnCleanDescriptors: "C"}},
+	0x00209158: {name: "Frame Comments", basic: "X"},
+	0x00209161: {name: "Concatenation UID", basic: "U", options: [optionColumns]string{columnRetainUIDs: "K"}},
+	0x00209164: {name: "Dimension Organization UID", basic: "U", options: [optionColumns]string{columnRetainUIDs: "K"}},
+	0x00281199: {name: "Palette Color Lookup Table UID", basic: "U", options: [optionColumns]string{columnRetainUIDs: "K"}},
+	0x00281214: {name: "Large Palette Color Lookup Table UID", basic: "U", options: [optionColumns]string{columnRetainUIDs: "K"}},
+	0x00284000: {name: "Image Presentation Comments", basic: "X"},
+	0x00320012: {name: "Study ID Issuer", basic: "X"},
+	0x00321020: {name: "Scheduled Study Location", basic: "X"},
+	0x00321021: {name: "Scheduled Study Location AE Title", basic: "X"},
+	0x00321030: {name: "Reason for Study", basic: "X", options: [optionColumns]string{columnCleanDescriptors: "C"}},
+	0x00321032: {name: "Requesting Physician", basic: "X"},
+	0x00321033: {name: "Requesting Service", basic: "X"},
+	0x00321060: {name: "Requested Procedure Description", basic: "X/Z", options: [optionColumns]string{columnCleanDescriptors: "C"}},
+	0x00321070: {name: "Requested Contrast Agent", basic: "X"},
+	0x00324000: {name: "Study Comments", basic: "X", options: [optionColumns]string{columnCleanDescriptors: "C"}},
+	0x00380010: {name: "Admission ID", basic: "X"},
+	0x00380011: {name: "Issuer of Admission ID", basic: "X"},
+	0x0038001E: {name: "Scheduled Patient Institution Residence", basic: "X"},
+	0x00380020: {name: "Admitting Date", basic: "X"},
+	0x00380021: {name: "Admitting Time", basic: "X"},
+	0x00380040: {name: "Discharge Diagnosis Description", basic: "X", options: [optionColumns]string{columnCleanDescriptors: "C"}},
+	0x00380050: {name: "Special Needs", basic: "X"},
+	0x00380060: {name: "Service Episode ID", basic: "X"},
+	0x00380061: {name: "Issuer of Service Episode ID", basic: "X"},
+	0x00380062: {name: "Service Episode Description", basic: "X"},
+	0x00380300: {name: "Current Patient Location", basic: "X"},
+	0x00380400: {name: "Patient's Institution Residence", basic: "X"},
+	0x00380500: {name: "Patient State", basic: "X"},
+	0x00384000: {name: "Visit Comments", basic: "X", options: [optionColumns]string{columnCleanDescriptors: "C"}},
+	0x00400001: {name: "Scheduled Station AE Title", basic: "X"},
+	0x00400002: {name: "Scheduled Procedure Step Start Date", basic: "X"},
+	0x00400003: {name: "Scheduled Procedure Step Start Time", basic: "X"},
+	0x00400004: {name: "Scheduled Procedure Step End Date", basic: "X"},
+	0x00400005: {name: "Scheduled Procedure Step End Time", basic: "X"},
+	0x00400006: {name: "Scheduled Performing Physician Name", basic: "X"},
+	0x00400007: {name: "Scheduled Procedure Step Description", basic: "X", options: [optionColumns]string{columnCleanDescriptors: "C"}},
+	0x00400009: {name: "Scheduled Procedure Step ID", basic: "X"},
+	0x0040000B: {name: "Scheduled Performing Physician Identification Sequence", basic: "X"},
+	0x00400010: {name: "Scheduled Station Name", basic: "X"},
+	0x00400011: {name: "Scheduled Procedure Step Location", basic: "X"},
+	0x00400012: {name: "Pre-Medication", basic: "X"},
+	0x00400241: {name: "Performed Station AE Title", basic: "X"},
+	0x00400242: {name: "Performed Station Name", basic: "X"},
+	0x00400243: {name: "Performed Location", basic: "X"},
+	0x00400244: {name: "Performed Procedure Step Start Date", basic: "X"},
+	0x00400245: {name: "Performed Procedure Step Start Time", basic: "X"},
+	0x00400253: {name: "Performed Procedure Step ID", basic: "X"},
+	0x00400254: {name: "Performed Procedure Step Description", basic: "X", options: [optionColumns]string{columnCleanDescriptors: "C"}},
+	0x00400275: {name: "Request Attributes Sequence", basic: "X"},
+	0x00400280: {name: "Comments on the Performed Procedure Step", basic: "X", options: [optionColumns]string{columnCleanDescriptors: "C"}},
+	0x00400555: {name: "Acquisition Context Sequence", basic: "X"},
+	0x00401001: {name: "Requested Procedure ID", basic: "X"},
+	0x00401004: {name: "Patient Transport Arrangements", basic: "X"},
+	0x00401005: {name: "Requested Procedure Location", basic: "X"},
+	0x00401010: {name: "Names of Intended Recipients of Results", basic: "X"},
+	0x00401011: {name: "Intended Recipients of Results Identification Sequence", basic: "X"},
+	0x00401101: {name: "Person Identification Code Sequence", basic: "D"},
+	0x00401102: {name: "Person's Address", basic: "X"},
+	0x00401103: {name: "Person's Telephone Numbers", basic: "X"},
+	0x00401400: {name: "Requested Procedure Comments", basic: "X"},
+	0x00402001: {name: "Reason for the Imaging Service Request", basic: "X"},
+	0x00402008: {name: "Order Entered By", basic: "X"},
+	0x00402009: {name: "Order Enterer's Location", basic: "X"},
+	0x00402010: {name: "Order Callback Phone Number", basic: "X"},
+	0x00402016: {name: "Placer Order Number / Imaging Service Request", basic: "Z"},
+	0x00402017: {name: "Filler Order Number / Imaging Service Request", basic: "Z"},
+	0x00402400: {name: "Imaging Service Request Comments", basic: "X"},
+	0x00403001: {name: "Confidentiality Constraint on Patient Data Description", basic: "X"},
+	0x00404023: {name: "Referenced General Purpose Scheduled Procedure Step Transaction UID", basic: "U", options: [optionColumns]string{columnRetainUIDs: "K"}},
+	0x00404025: {name: "Scheduled Station Name Code Sequence", basic: "X"},
+	0x00404027: {name: "Scheduled Station Geographic Location Code Sequence", basic: "X"},
+	0x00404028: {name: "Performed Station Name Code Sequence", basic: "X"},
+	0x00404030: {name: "Performed Station Geographic Location Code Sequence", basic: "X"},
+	0x00404034: {name: "Scheduled Human Performers Sequence", basic: "X"},
+	0x00404035: {name: "Actual Human Performers Sequence", basic: "X"},
+	0x00404036: {name: "Human Performer's Organization", basic: "X"},
+	0x00404037: {name: "Human Performer's Name", basic: "X"},
+	0x0040A027: {name: "Verifying Organization", basic: "X"},
+	0x0040A073: {name: "Verifying Observer Sequence", basic: "D"},
+	0x0040A075: {name: "Verifying Observer Name", basic: "D"},
+	0x0040A078: {name: "Author Observer Sequence", basic: "X"},
+	0x0040A07A: {name: "Participant Sequence", basic: "X"},
+	0x0040A07C: {name: "Custodial Organization Sequence", basic: "X"},
+	0x0040A088: {name: "Verifying Observer Identification Code Sequence", basic: "Z"},
+	0x0040A123: {name: "Person Name", basic: "D"},
+	0x0040A124: {name: "UID", basic: "U", options: [optionColumns]string{columnRetainUIDs: "K"}},
+	0x0040A730: {name: "Content Sequence", basic: "X"},
+	0x0040DB0C: {name: "Template Extension Organization UID", basic: "U", options: [optionColumns]string{columnRetainUIDs: "K"}},
+	0x0040DB0D: {name: "Template Extension Creator UID", basic: "U", options: [optionColumns]string{columnRetainUIDs: "K"}},
+	0x00700001: {name: "Graphic Annotation Sequence", basic: "D"},
+	0x00700084: {name: "Content Creator's Name", basic: "Z"},
+	0x00700086: {name: "Content Creator's Identification Code Sequence", basic: "X"},
+	0x0070031A: {name: "Fiducial UID", basic: "U", options: [optionColumns]string{columnRetainUIDs: "K"}},
+	0x00880140: {name: "Storage Media File-set UID", basic: "U", options: [optionColumns]string{columnRetainUIDs: "K"}},
+	0x00880200: {name: "Icon Image Sequence", basic: "X"},
+	0x00880904: {name: "Topic Title", basic: "X"},
+	0x00880906: {name: "Topic Subject", basic: "X"},
+	0x00880910: {name: "Topic Author", basic: "X"},
+	0x00880912: {name: "Topic Keywords", basic: "X"},
+	0x04000100: {name: "Digital Signature UID", basic: "X"},
+	0x04000402: {name: "Referenced Digital Signature Sequence", basic: "X"},
+	0x04000403: {name: "Referenced SOP Instance MAC Sequence", basic: "X"},
+	0x04000404: {name: "MAC", basic: "X"},
+	0x04000550: {name: "Modified Attributes Sequence", basic: "X"},
+	0x04000561: {name: "Original Attributes Sequence", basic: "X"},
+	0x20300020: {name: "Text String", basic: "X"},
+	0x22000002: {name: "Label Text", basic: "X"},
+	0x22000005: {name: "Barcode Value", basic: "X"},
+	0x30060002: {name: "Structure Set Label", basic: "D"},
+	0x30060024: {name: "Referenced Frame of Reference UID", basic: "U", options: [optionColumns]string{columnRetainUIDs: "K"}},
+	0x300600C2: {name: "Related Frame of Reference UID", basic: "U", options: [optionColumns]string{columnRetainUIDs: "K"}},
+	0x300A0002: {name: "RT Plan Label", basic: "D"},
+	0x300A0013: {name: "Dose Reference UID", basic: "U", options: [optionColumns]string{columnRetainUIDs: "K"}},
+	0x300E0008: {name: "Reviewer Name", basic: "X/Z"},
+	0x40000010: {name: "Arbitrary", basic: "X"},
+	0x40004000: {name: "Text Comments", basic: "X", options: [optionColumns]string{columnCleanDescriptors: "C"}},
+	0x40080042: {name: "Results ID Issuer", basic: "X"},
+	0x40080102: {name: "Interpretation Recorder", basic: "X"},
+	0x4008010A: {name: "Interpretation Transcriber", basic: "X"},
+	0x4008010B: {name: "Interpretation Text", basic: "X", options: [optionColumns]string{columnCleanDescriptors: "C"}},
+	0x4008010C: {name: "Interpretation Author", basic: "X"},
+	0x40080111: {name: "Interpretation Approver Sequence", basic: "X"},
+	0x40080114: {name: "Physician Approving Interpretation", basic: "X"},
+	0x40080115: {name: "Interpretation Diagnosis Description", basic: "X"},
+	0x40080118: {name: "Results Distribution List Sequence", basic: "X"},
+	0x40080119: {name: "Distribution Name", basic: "X"},
+	0x4008011A: {name: "Distribution Address", basic: "X"},
+	0x40080202: {name: "Interpretation ID Issuer", basic: "X"},
+	0x40080300: {name: "Impressions", basic: "X", options: [optionColumns]string{columnCleanDescriptors: "C"}},
+	0x40084000: {name: "Results Comments", basic: "X", options: [optionColumns]string{columnCleanDescriptors: "C"}},
+	0x60003000: {name: "Overlay Data", basic: "X"},
+	0x60004000: {name: "Overlay Comments", basic: "X"},
+	0xFFFAFFFA: {name: "Digital Signatures Sequence", basic: "X"},
+	0xFFFCFFFC: {name: "Data Set Trailing Padding", basic: "X"},
+}
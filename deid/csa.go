@@ -0,0 +1,50 @@
+package deid
+
+import (
+	"regexp"
+
+	"gitlab.com/medical-research/dicom-deidentifier/dicom"
+)
+
+// csaIdentifyingElements are CSA elements describing the patient rather than the acquisition,
+// emptied like Patient's Weight is removed by the basic profile
+var csaIdentifyingElements = map[string]bool{
+	"PatReinPattern":    true, // patient position followed by the patient's weight
+	"UsedPatientWeight": true,
+}
+
+// ascconvUIDLine matches the lines of the MrPhoenixProtocol ASCCONV text assigning a UID, such as
+// the frame of reference, whose Siemens roots embed the scanner serial number and acquisition time
+var ascconvUIDLine = regexp.MustCompile(`(?m)^(\s*[\w.\[\]]+\s*=\s*)"[0-9]+(?:\.[0-9]+){3,}"`)
+
+// cleanCSAHeader removes the identifiers found in a Siemens CSA header and keeps the acquisition
+// parameters. The patient's names and IDs and staff names of the header are replaced by
+// placeholders but the scrub patterns are not applied, as acquisition parameters such as
+// "100.0000" would be taken for dates and phone numbers. Headers that cannot be parsed are emptied.
+func (s *descriptorScrubber) cleanCSAHeader(e *dicom.Element) {
+	h, err := dicom.ParseCSAHeader(e.Value)
+	if err != nil {
+		e.Value = nil
+		return
+	}
+	for _, ce := range h.Elements {
+		for i, v := range ce.Values {
+			switch {
+			case v == "":
+			case csaIdentifyingElements[ce.Name]:
+				ce.Values[i] = ""
+			default:
+				ce.Values[i] = s.scrubIdentifiers(ascconvUIDLine.ReplaceAllString(v, `$1""`))
+			}
+		}
+	}
+	e.Value = h.Bytes()
+}
+
+// scrubIdentifiers replaces the names and IDs of the instance, without applying the cleaner's patterns
+func (s *descriptorScrubber) scrubIdentifiers(v string) string {
+	for _, p := range s.replacements {
+		v = p.replace(v)
+	}
+	return v
+}
@@ -0,0 +1,227 @@
+package deid
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+
+	dcmd "gitlab.com/medical-research/dicom-deidentifier"
+	"gitlab.com/medical-research/dicom-deidentifier/dicom"
+)
+
+// Bounds of the values kept per attribute by the dataset profiler
+const (
+	maxProfileSamples  = 5
+	maxProfilePatterns = 20
+	maxSampleLength    = 16
+	maxPatternLength   = 32
+)
+
+// otherPatterns counts the values of attributes with more than maxProfilePatterns patterns
+const otherPatterns = "*"
+
+// itemNumbers matches the item numbers of element paths
+var itemNumbers = regexp.MustCompile(`\[\d+\]`)
+
+// DatasetProfiler catalogues the attributes, private creators, SOP classes and modalities of the
+// instances added to it. Samples of identifying attributes are hashed with a key of the profiler,
+// so equal values can be told apart within a profile but not looked up across profiles.
+type DatasetProfiler struct {
+	key []byte
+
+	instances  int
+	skipped    int
+	attributes map[string]*attributeProfile
+	creators   map[string]*dcmd.PrivateCreatorProfile
+	sopClasses map[string]int
+	modalities map[string]int
+}
+
+// attributeProfile holds an attribute profile with its distinct samples
+type attributeProfile struct {
+	*dcmd.AttributeProfile
+	samples map[string]bool
+}
+
+// NewDatasetProfiler returns a new instance of DatasetProfiler
+func NewDatasetProfiler() (*DatasetProfiler, error) {
+	key := make([]byte, 32)
+	if _, err := rand.Read(key); err != nil {
+		return nil, fmt.Errorf("could not generate sample key: %v", err)
+	}
+	return &DatasetProfiler{
+		key:        key,
+		attributes: map[string]*attributeProfile{},
+		creators:   map[string]*dcmd.PrivateCreatorProfile{},
+		sopClasses: map[string]int{},
+		modalities: map[string]int{},
+	}, nil
+}
+
+// Add catalogues the attributes of an instance
+func (p *DatasetProfiler) Add(ds *dicom.Dataset) {
+	p.instances++
+	if uid := ds.GetString(dicom.SOPClassUID); uid != "" {
+		p.sopClasses[uid]++
+	}
+	if modality := ds.GetString(dicom.Modality); modality != "" {
+		p.modalities[modality]++
+	}
+
+	seen := map[string]bool{}
+	_ = ds.Walk(func(parent *dicom.Dataset, e *dicom.Element, path string) error {
+		path = itemNumbers.ReplaceAllString(path, "")
+		creator := ""
+		if e.Tag.IsPrivate() && !e.Tag.IsPrivateCreator() {
+			creator = strings.TrimSpace(parent.PrivateCreator(e.Tag))
+			key := fmt.Sprintf("%s\x00%04X", creator, e.Tag.Group())
+			c, ok := p.creators[key]
+			if !ok {
+				c = &dcmd.PrivateCreatorProfile{Creator: creator, Group: fmt.Sprintf("%04X", e.Tag.Group())}
+				p.creators[key] = c
+			}
+			c.Elements++
+			if !seen[key] {
+				seen[key] = true
+				c.Instances++
+			}
+		}
+		p.addAttribute(path, creator, e)
+		return nil
+	})
+}
+
+// AddSkipped records an object that could not be read as a DICOM instance
+func (p *DatasetProfiler) AddSkipped() {
+	p.skipped++
+}
+
+// addAttribute records a single occurrence of an attribute
+func (p *DatasetProfiler) addAttribute(path, creator string, e *dicom.Element) {
+	a, ok := p.attributes[path]
+	if !ok {
+		a = &attributeProfile{
+			AttributeProfile: &dcmd.AttributeProfile{
+				Path:           path,
+				PrivateCreator: creator,
+				VRs:            map[string]int{},
+			},
+			samples: map[string]bool{},
+		}
+		if !e.Tag.IsPrivate() {
+			a.Keyword = dicom.Keyword(e.Tag)
+			a.Name = attributeName(e.Tag)
+		}
+		p.attributes[path] = a
+	}
+	a.Count++
+	a.VRs[e.VR]++
+	if e.IsEmpty() {
+		a.Empty++
+		return
+	}
+	if e.IsSequence() || !dicom.IsTextVR(e.VR) {
+		return
+	}
+
+	for _, v := range e.Strings() {
+		if a.Patterns == nil {
+			a.Patterns = map[string]int{}
+		}
+		pattern := valuePattern(v)
+		if _, ok := a.Patterns[pattern]; !ok && len(a.Patterns) >= maxProfilePatterns {
+			pattern = otherPatterns
+		}
+		a.Patterns[pattern]++
+
+		if len(a.samples) < maxProfileSamples && v != "" {
+			a.samples[p.sample(e, v)] = true
+		}
+	}
+}
+
+// sample returns the value shown for an attribute: a keyed hash for private attributes, names
+// and attributes the basic profile does not keep, and the value truncated for all others
+func (p *DatasetProfiler) sample(e *dicom.Element, v string) string {
+	if e.Tag.IsPrivate() || e.VR == dicom.PN || basicProfileAction(e.Tag) != "" {
+		mac := hmac.New(sha256.New, p.key)
+		mac.Write([]byte(v))
+		return "sha256:" + hex.EncodeToString(mac.Sum(nil))[:8]
+	}
+	if r := []rune(v); len(r) > maxSampleLength {
+		return string(r[:maxSampleLength]) + "…"
+	}
+	return v
+}
+
+// Profile returns the catalogue of the instances added so far
+func (p *DatasetProfiler) Profile() *dcmd.DatasetProfile {
+	profile := &dcmd.DatasetProfile{
+		Instances:  p.instances,
+		Skipped:    p.skipped,
+		Attributes: make([]*dcmd.AttributeProfile, 0, len(p.attributes)),
+		SOPClasses: p.sopClasses,
+		Modalities: p.modalities,
+	}
+	for uid := range p.sopClasses {
+		if name := dicom.UIDName(uid); name != "" {
+			if profile.SOPClassNames == nil {
+				profile.SOPClassNames = map[string]string{}
+			}
+			profile.SOPClassNames[uid] = name
+		}
+	}
+	for _, a := range p.attributes {
+		a.Samples = nil
+		for s := range a.samples {
+			a.Samples = append(a.Samples, s)
+		}
+		sort.Strings(a.Samples)
+		profile.Attributes = append(profile.Attributes, a.AttributeProfile)
+	}
+	sort.Slice(profile.Attributes, func(i, j int) bool {
+		return profile.Attributes[i].Path < profile.Attributes[j].Path
+	})
+
+	for _, c := range p.creators {
+		profile.PrivateCreators = append(profile.PrivateCreators, c)
+	}
+	sort.Slice(profile.PrivateCreators, func(i, j int) bool {
+		a, b := profile.PrivateCreators[i], profile.PrivateCreators[j]
+		if a.Creator != b.Creator {
+			return a.Creator < b.Creator
+		}
+		return a.Group < b.Group
+	})
+	return profile
+}
+
+// valuePattern returns the shape of a value, letters becoming "A" or "a" and digits "9"
+func valuePattern(v string) string {
+	var b strings.Builder
+	n := 0
+	for _, r := range v {
+		if n == maxPatternLength {
+			b.WriteString("…")
+			break
+		}
+		switch {
+		case unicode.IsUpper(r):
+			b.WriteByte('A')
+		case unicode.IsLetter(r):
+			b.WriteByte('a')
+		case unicode.IsDigit(r):
+			b.WriteByte('9')
+		default:
+			b.WriteRune(r)
+		}
+		n++
+	}
+	return b.String()
+}
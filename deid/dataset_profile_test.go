@@ -0,0 +1,67 @@
+package deid_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"gitlab.com/medical-research/dicom-deidentifier/deid"
+	"gitlab.com/medical-research/dicom-deidentifier/dicom"
+)
+
+func TestDatasetProfiler_Profile(t *testing.T) {
+	p, err := deid.NewDatasetProfiler()
+	if err != nil {
+		t.Fatalf("NewDatasetProfiler() error = %v", err)
+	}
+	for i, name := range []string{"Doe^John", "Roe^Jane"} {
+		f := newTestInstance("1.3.6.1.4.1.5962.1.1."+string(rune('1'+i)), "1.3.6.1.4.1.5962.1.1.9")
+		f.Dataset.SetString(dicom.PatientName, name)
+		f.Dataset.SetString(dicom.Modality, "RTPLAN")
+		f.Dataset.SetString(dicom.Tag(0x00081090), "Radiotherapy planning system") // Manufacturer's Model Name
+		p.Add(f.Dataset)
+	}
+	profile := p.Profile()
+
+	if profile.Instances != 2 || profile.Modalities["RTPLAN"] != 2 || profile.SOPClasses["1.2.840.10008.5.1.4.1.1.481.3"] != 2 {
+		t.Errorf("Profile() = %d instances, modalities %v, sop classes %v", profile.Instances, profile.Modalities, profile.SOPClasses)
+	}
+
+	attributes := map[string]int{}
+	for _, a := range profile.Attributes {
+		attributes[a.Path] = a.Count
+		switch a.Keyword {
+		case "PatientName":
+			if a.Patterns["Aaa^Aaaa"] != 2 {
+				t.Errorf("PatientName patterns = %v, want Aaa^Aaaa twice", a.Patterns)
+			}
+			for _, s := range a.Samples {
+				if !strings.HasPrefix(s, "sha256:") {
+					t.Errorf("PatientName sample %q is not hashed", s)
+				}
+			}
+		case "ManufacturerModelName":
+			if len(a.Samples) != 1 || a.Samples[0] != "Radiotherapy pla…" {
+				t.Errorf("ManufacturerModelName samples = %q, want the value truncated", a.Samples)
+			}
+		}
+	}
+	if got := attributes["(3006,0010).(3006,0012).(0008,1155)"]; got != 2 {
+		t.Errorf("nested attribute count = %d, want 2", got)
+	}
+
+	if len(profile.PrivateCreators) != 1 {
+		t.Fatalf("Profile() private creators = %d, want 1", len(profile.PrivateCreators))
+	}
+	if c := profile.PrivateCreators[0]; c.Creator != "ACME 1.1" || c.Group != "0009" || c.Instances != 2 || c.Elements != 2 {
+		t.Errorf("Profile() private creator = %+v", c)
+	}
+
+	var buf bytes.Buffer
+	if err := profile.WriteCSV(&buf); err != nil {
+		t.Fatalf("WriteCSV() error = %v", err)
+	}
+	if rows := strings.Count(buf.String(), "\n"); rows != len(profile.Attributes)+1 {
+		t.Errorf("WriteCSV() = %d rows, want %d", rows, len(profile.Attributes)+1)
+	}
+}
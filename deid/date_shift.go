@@ -0,0 +1,121 @@
+package deid
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	dcmd "gitlab.com/medical-research/dicom-deidentifier"
+	"gitlab.com/medical-research/dicom-deidentifier/dicom"
+)
+
+// DefaultMaxDateShiftDays bounds the random offset given to a new patient
+const DefaultMaxDateShiftDays = 365
+
+// Ensure shifter implements interface.
+var _ dcmd.DateShifter = (*TableDateShifter)(nil)
+
+// TableDateShifter gives every new patient a random offset and records it, so later
+// uploads for the same patient are shifted by the same number of days
+type TableDateShifter struct {
+	// Key is the secret used to derive the patient keys stored in the table
+	Key []byte
+
+	// MaxDays bounds the offset, which is chosen between -MaxDays and MaxDays excluding 0
+	MaxDays int
+
+	DateShiftService dcmd.DateShiftService
+}
+
+// NewTableDateShifter returns a new instance of TableDateShifter
+func NewTableDateShifter(key []byte, dateShiftService dcmd.DateShiftService) (*TableDateShifter, error) {
+	if len(key) == 0 {
+		return nil, dcmd.Errorf(dcmd.EINVALID, "date shift key must not be empty")
+	}
+	return &TableDateShifter{
+		Key:              key,
+		MaxDays:          DefaultMaxDateShiftDays,
+		DateShiftService: dateShiftService,
+	}, nil
+}
+
+// PatientDateShift returns the recorded offset of a patient, choosing one if the patient has not been seen before
+func (s *TableDateShifter) PatientDateShift(ctx context.Context, issuer, patientID string) (int, error) {
+	if patientID == "" {
+		return 0, dcmd.Errorf(dcmd.EINVALID, "patient id required to shift dates")
+	}
+
+	key := patientKey(s.Key, issuer, patientID)
+
+	shift, err := s.DateShiftService.FindDateShift(ctx, key)
+	if err == nil {
+		return shift.Days, nil
+	} else if dcmd.ErrorCode(err) != dcmd.ENOTFOUND {
+		return 0, err
+	}
+
+	n, err := rand.Int(rand.Reader, big.NewInt(int64(2*s.MaxDays)))
+	if err != nil {
+		return 0, fmt.Errorf("could not choose date shift: %v", err)
+	}
+	days := int(n.Int64()) - s.MaxDays
+	if days >= 0 {
+		days++
+	}
+
+	if dcmd.IsDryRun(ctx) {
+		return days, nil
+	}
+
+	shift = &dcmd.DateShift{PatientKey: key, Days: days, CreatedAt: time.Now()}
+	err = s.DateShiftService.CreateDateShift(ctx, shift)
+	if dcmd.ErrorCode(err) == dcmd.ECONFLICT {
+		// another instance of the same patient was processed first
+		return s.PatientDateShift(ctx, issuer, patientID)
+	} else if err != nil {
+		return 0, err
+	}
+	return days, nil
+}
+
+// shiftDates moves every date held by a DA or DT element by days. Times are left unchanged
+// as offsets are whole days. Values too imprecise to be shifted by a day are emptied.
+func shiftDates(e *dicom.Element, days int) {
+	values := e.Strings()
+	if len(values) == 0 {
+		return
+	}
+	for i, v := range values {
+		switch e.VR {
+		case dicom.DA:
+			values[i] = shiftDate(v, days)
+		case dicom.DT:
+			values[i] = shiftDateTime(v, days)
+		}
+	}
+	e.SetStrings(values...)
+}
+
+// shiftDate shifts a YYYYMMDD value
+func shiftDate(v string, days int) string {
+	t, err := time.Parse("20060102", v)
+	if err != nil {
+		return ""
+	}
+	return t.AddDate(0, 0, days).Format("20060102")
+}
+
+// shiftDateTime shifts the date part of a YYYYMMDDHHMMSS.FFFFFF&ZZXX value and keeps the rest
+func shiftDateTime(v string, days int) string {
+	if len(v) < 8 || strings.ContainsAny(v[:8], "+-") {
+		return ""
+	}
+	date := shiftDate(v[:8], days)
+	if date == "" {
+		return ""
+	}
+	return date + v[8:]
+}
@@ -0,0 +1,101 @@
+package deid_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	dcmd "gitlab.com/medical-research/dicom-deidentifier"
+	"gitlab.com/medical-research/dicom-deidentifier/deid"
+	"gitlab.com/medical-research/dicom-deidentifier/dicom"
+	"gitlab.com/medical-research/dicom-deidentifier/mock"
+)
+
+// newMemoryDateShiftService returns a mock DateShiftService keeping shifts in memory
+func newMemoryDateShiftService() *mock.DateShiftService {
+	shifts := map[string]*dcmd.DateShift{}
+	return &mock.DateShiftService{
+		FindDateShiftFn: func(ctx context.Context, patientKey string) (*dcmd.DateShift, error) {
+			if shift, ok := shifts[patientKey]; ok {
+				return shift, nil
+			}
+			return nil, dcmd.Errorf(dcmd.ENOTFOUND, "patient has no date shift")
+		},
+		CreateDateShiftFn: func(ctx context.Context, shift *dcmd.DateShift) error {
+			shifts[shift.PatientKey] = shift
+			return nil
+		},
+	}
+}
+
+func TestDeidentificationService_DeidentifyFile_ShiftDates(t *testing.T) {
+	shifter, err := deid.NewTableDateShifter([]byte("secret"), newMemoryDateShiftService())
+	if err != nil {
+		t.Fatalf("NewTableDateShifter() error = %v", err)
+	}
+	s := newTestService(t)
+	s.DateShifter = shifter
+	profile := &dcmd.Profile{Name: "longitudinal", Options: []string{dcmd.RetainLongitudinalModifiedDatesOption}}
+
+	// two uploads of the same patient, 30 days apart
+	baseline := newTestInstance("1.3.6.1.4.1.5962.1.1.1", "")
+	baseline.Dataset.Set(dicom.NewElement(dicom.StudyDate, dicom.DA, "20200115"))
+	baseline.Dataset.Set(dicom.NewElement(dicom.AcquisitionDateTime, dicom.DT, "20200115093000.000000+0100"))
+	baseline.Dataset.Set(dicom.NewElement(dicom.StudyTime, dicom.TM, "093000"))
+	baseline.Dataset.Set(dicom.NewElement(dicom.PatientBirthDate, dicom.DA, "19700101"))
+	followUp := newTestInstance("1.3.6.1.4.1.5962.1.1.2", "")
+	followUp.Dataset.Set(dicom.NewElement(dicom.StudyDate, dicom.DA, "20200214"))
+
+	for _, f := range []*dicom.File{baseline, followUp} {
+		if err := s.DeidentifyFile(context.Background(), profile, nil, f); err != nil {
+			t.Fatalf("DeidentifyFile() error = %v", err)
+		}
+	}
+
+	first, err := time.Parse("20060102", baseline.Dataset.GetString(dicom.StudyDate))
+	if err != nil {
+		t.Fatalf("shifted StudyDate is invalid: %v", err)
+	}
+	second, err := time.Parse("20060102", followUp.Dataset.GetString(dicom.StudyDate))
+	if err != nil {
+		t.Fatalf("shifted StudyDate is invalid: %v", err)
+	}
+	if first.Format("20060102") == "20200115" {
+		t.Errorf("StudyDate was not shifted")
+	}
+	if got := second.Sub(first); got != 30*24*time.Hour {
+		t.Errorf("interval between studies = %v, want %v", got, 30*24*time.Hour)
+	}
+
+	if got, want := baseline.Dataset.GetString(dicom.AcquisitionDateTime), first.Format("20060102")+"093000.000000+0100"; got != want {
+		t.Errorf("AcquisitionDateTime = %q, want %q", got, want)
+	}
+	if got := baseline.Dataset.GetString(dicom.StudyTime); got != "093000" {
+		t.Errorf("StudyTime = %q, want unchanged", got)
+	}
+	if got := baseline.Dataset.GetString(dicom.PatientBirthDate); got != "" {
+		t.Errorf("PatientBirthDate = %q, want zeroed by the basic profile", got)
+	}
+}
+
+func TestTableDateShifter_PatientDateShift(t *testing.T) {
+	ctx := context.Background()
+	shifter, err := deid.NewTableDateShifter([]byte("secret"), newMemoryDateShiftService())
+	if err != nil {
+		t.Fatalf("NewTableDateShifter() error = %v", err)
+	}
+
+	days, err := shifter.PatientDateShift(ctx, "HOSPITAL", "MRN0001")
+	if err != nil {
+		t.Fatalf("PatientDateShift() error = %v", err)
+	}
+	if days == 0 || days < -shifter.MaxDays || days > shifter.MaxDays {
+		t.Errorf("PatientDateShift() = %d, want non-zero within ±%d", days, shifter.MaxDays)
+	}
+	if again, _ := shifter.PatientDateShift(ctx, "HOSPITAL", "MRN0001"); again != days {
+		t.Errorf("PatientDateShift() second call = %d, want %d", again, days)
+	}
+	if _, err := shifter.PatientDateShift(ctx, "HOSPITAL", ""); dcmd.ErrorCode(err) != dcmd.EINVALID {
+		t.Errorf("PatientDateShift() without patient id error = %v, want %v", err, dcmd.EINVALID)
+	}
+}
@@ -0,0 +1,505 @@
+package deid
+
+import (
+	"bytes"
+	"context"
+	"crypto/x509"
+	"fmt"
+	"io"
+	"strings"
+
+	dcmd "gitlab.com/medical-research/dicom-deidentifier"
+	"gitlab.com/medical-research/dicom-deidentifier/dicom"
+)
+
+// Ensure service implements interface.
+var _ dcmd.DeidentificationService = (*DeidentificationService)(nil)
+
+// DeidentificationService represents a service that de-identifies DICOM instances locally,
+// without sending them to the Healthcare API
+type DeidentificationService struct {
+	// UIDRemapper replaces UIDs consistently across every instance processed by the service
+	UIDRemapper dcmd.UIDRemapper
+
+	// DateShifter chooses the per-patient offset of profiles retaining modified dates
+	DateShifter dcmd.DateShifter
+
+	// Pseudonymizer gives patients their research subject ID in profiles pseudonymizing patient IDs
+	Pseudonymizer dcmd.Pseudonymizer
+
+	// RosterService holds the enrolment rosters of profiles assigning roster subject IDs
+	RosterService dcmd.RosterService
+
+	// RecipientCertificates are the honest brokers able to recover the original attributes in
+	// profiles encrypting them. Only RSA certificates are supported.
+	RecipientCertificates []*x509.Certificate
+
+	// DescriptorCleaner scrubs free-text descriptors in profiles cleaning descriptors
+	DescriptorCleaner *DescriptorCleaner
+
+	// SafePrivateDictionary lists the private attributes kept by profiles retaining safe private attributes
+	SafePrivateDictionary *SafePrivateDictionary
+
+	// HashKey is the secret of the values replaced by hash rules
+	HashKey []byte
+}
+
+// NewDeidentificationService returns a new instance of DeidentificationService
+func NewDeidentificationService(uidRemapper dcmd.UIDRemapper) *DeidentificationService {
+	return &DeidentificationService{
+		UIDRemapper:           uidRemapper,
+		DescriptorCleaner:     NewDescriptorCleaner(),
+		SafePrivateDictionary: NewSafePrivateDictionary(),
+	}
+}
+
+// DeidentifyInstance reads a DICOM Part 10 instance from r, applies the profile and writes the
+// de-identified instance to w
+func (s *DeidentificationService) DeidentifyInstance(ctx context.Context, profile *dcmd.Profile, report *dcmd.JobReport, r io.Reader, w io.Writer) error {
+	f, err := dicom.Read(r)
+	if err != nil {
+		return dcmd.Errorf(dcmd.EINVALID, "invalid dicom instance: %v", err)
+	}
+
+	if err := s.DeidentifyFile(ctx, profile, report, f); err != nil {
+		return err
+	}
+
+	// encode fully before writing so that w never receives a partial instance
+	var buf bytes.Buffer
+	if err := dicom.Write(&buf, f); err != nil {
+		return fmt.Errorf("could not encode dicom instance: %v", err)
+	}
+	_, err = w.Write(buf.Bytes())
+	return err
+}
+
+// DeidentifyFile applies the profile to a parsed instance in place, adding findings to report
+// when it is not nil
+func (s *DeidentificationService) DeidentifyFile(ctx context.Context, profile *dcmd.Profile, report *dcmd.JobReport, f *dicom.File) error {
+	return s.deidentifyFile(ctx, profile, report, f, nil)
+}
+
+// deidentifyFile applies the profile to a parsed instance in place, recording in changes the
+// elements whose dates were shifted or UIDs remapped when it is not nil
+func (s *DeidentificationService) deidentifyFile(ctx context.Context, profile *dcmd.Profile, report *dcmd.JobReport, f *dicom.File, changes map[*dicom.Element]string) error {
+	d := &deidentifier{
+		ctx:     ctx,
+		service: s,
+		profile: profile,
+		report:  report,
+		changes: changes,
+	}
+
+	rules, err := compileRules(profile)
+	if err != nil {
+		return err
+	}
+	if rules.uses(dcmd.RuleHash) && len(s.HashKey) == 0 {
+		return dcmd.Errorf(dcmd.ENOTIMPLEMENTED, "attribute hashing is not configured")
+	}
+	// conditions are evaluated on the original values, whatever the rules do with them
+	d.rules, d.conditions = rules, rules.conditionValues(f.Dataset)
+
+	// slide labels are excluded before anything is recorded for the patient
+	if err := deidentifySlideImage(f, profile, report); err != nil {
+		return err
+	}
+	if profile.HasOption(dcmd.ConvertToUTF8Option) {
+		f.CharacterSet = nil
+	}
+
+	// the patient is identified before the walk removes the identifiers
+	issuer, patientID := f.Dataset.GetString(dicom.IssuerOfPatientID), f.Dataset.GetString(dicom.PatientID)
+
+	// patients not on the roster are rejected before anything is recorded for them
+	var roster *dcmd.Roster
+	var subject *dcmd.RosterEntry
+	if profile.HasOption(dcmd.RosterSubjectIDsOption) {
+		if s.RosterService == nil {
+			return dcmd.Errorf(dcmd.ENOTIMPLEMENTED, "enrolment rosters are not configured")
+		} else if profile.HasOption(dcmd.PseudonymizePatientIDOption) {
+			return dcmd.Errorf(dcmd.EINVALID, "roster subject ids and pseudonyms cannot be used together")
+		}
+		var err error
+		if roster, err = s.RosterService.FindRoster(ctx, profile.Project, profile.RosterVersion); err != nil {
+			return err
+		}
+		if subject = roster.FindEntry(issuer, patientID); subject == nil {
+			return dcmd.Errorf(dcmd.EINVALID, "patient is not on version %d of the roster of project %q", roster.Version, profile.Project)
+		}
+	}
+
+	if profile.HasOption(dcmd.RetainLongitudinalModifiedDatesOption) || rules.uses(dcmd.RuleShiftDate) {
+		if s.DateShifter == nil {
+			return dcmd.Errorf(dcmd.ENOTIMPLEMENTED, "date shifting is not configured")
+		}
+		days, err := s.DateShifter.PatientDateShift(ctx, issuer, patientID)
+		if err != nil {
+			return err
+		}
+		d.ruleShift = days
+		if profile.HasOption(dcmd.RetainLongitudinalModifiedDatesOption) {
+			d.shiftDates, d.dateShift = true, days
+		}
+	}
+
+	var pseudonym string
+	if profile.HasOption(dcmd.PseudonymizePatientIDOption) {
+		if s.Pseudonymizer == nil {
+			return dcmd.Errorf(dcmd.ENOTIMPLEMENTED, "pseudonymisation is not configured")
+		}
+		var err error
+		if pseudonym, err = s.Pseudonymizer.Pseudonymize(ctx, profile.Project, issuer, patientID); err != nil {
+			return err
+		}
+	}
+
+	if profile.HasOption(dcmd.CleanDescriptorsOption) {
+		d.descriptors = s.DescriptorCleaner.scrubber(f.Dataset)
+	}
+
+	var original *dicom.Dataset
+	if profile.HasOption(dcmd.EncryptOriginalAttributesOption) {
+		if len(s.RecipientCertificates) == 0 {
+			return dcmd.Errorf(dcmd.ENOTIMPLEMENTED, "no recipient certificates are configured")
+		}
+		original = copyOriginalAttributes(f.Dataset)
+	}
+
+	if profile.HasOption(dcmd.ScrubAnnotationsOption) {
+		d.annotations = s.DescriptorCleaner.scrubber(f.Dataset)
+	}
+
+	if profile.HasOption(dcmd.BurnInOverlaysOption) {
+		if err := burnInOverlays(f.Dataset); err != nil {
+			return err
+		}
+	}
+	if profile.HasOption(dcmd.RemoveOverlaysOption) || profile.HasOption(dcmd.BurnInOverlaysOption) {
+		clearEmbeddedOverlays(f.Dataset)
+		d.removeOverlays = true
+	}
+
+	if profile.HasOption(dcmd.RetainSafePrivateOption) {
+		if s.SafePrivateDictionary == nil {
+			return dcmd.Errorf(dcmd.ENOTIMPLEMENTED, "no safe private dictionary is configured")
+		}
+		// CSA headers are kept with the identifiers of the header removed from their values
+		d.retainSafePrivate, d.csa = true, s.DescriptorCleaner.scrubber(f.Dataset)
+	}
+
+	// quasi-identifiers are recorded before the walk removes them and set back generalised
+	quasi := newQuasiIdentifiers(f.Dataset, profile.Generalisation)
+
+	// the dates of functional groups are recorded to verify that frame timing stays consistent
+	d.frames = newFunctionalGroups(f.Dataset)
+	if !d.shiftDates {
+		d.frameShift = d.frames.rebaseDays()
+	}
+
+	if err := f.Dataset.Walk(d.element); err != nil {
+		return err
+	}
+	days, all := d.frameShift, false
+	if d.shiftDates {
+		days, all = d.dateShift, true
+	}
+	if err := d.frames.verify(f.Dataset, days, all); err != nil {
+		return err
+	}
+	if d.retainSafePrivate || d.rules.selectsPrivate() {
+		if err := removeUnusedPrivateCreators(f.Dataset); err != nil {
+			return err
+		}
+	}
+	quasi.apply(f.Dataset, d.dateShift, report)
+
+	if pseudonym != "" {
+		f.Dataset.Set(dicom.NewElement(dicom.PatientID, dicom.LO, pseudonym))
+		f.Dataset.Set(dicom.NewElement(dicom.PatientName, dicom.PN, pseudonym))
+	}
+	if subject != nil {
+		setClinicalTrialSubject(f.Dataset, roster, subject)
+	}
+	StampProvenance(f.Dataset, profile, LocalDeidentificationMethod)
+	stampMethodCodes(f.Dataset, profile)
+	if original != nil {
+		if err := encryptOriginalAttributes(f.Dataset, original, s.RecipientCertificates); err != nil {
+			return err
+		}
+	}
+
+	// keep the file meta information consistent with the de-identified dataset
+	if uid := f.Dataset.GetString(dicom.SOPInstanceUID); uid != "" {
+		f.Meta.SetString(dicom.MediaStorageSOPInstanceUID, uid)
+	}
+	f.Meta.Remove(dicom.SourceApplicationEntityTitle)
+
+	report.AddInstance()
+	return nil
+}
+
+// deidentifier holds the state of a single instance being de-identified
+type deidentifier struct {
+	ctx     context.Context
+	service *DeidentificationService
+	profile *dcmd.Profile
+	report  *dcmd.JobReport
+
+	// shiftDates is set when every date of the instance is shifted by dateShift days
+	shiftDates bool
+	dateShift  int
+
+	// descriptors is set when free-text descriptors are cleaned rather than removed
+	descriptors *descriptorScrubber
+
+	// retainSafePrivate is set when the private attributes listed as safe are kept
+	retainSafePrivate bool
+	csa               *descriptorScrubber
+
+	// removeOverlays is set when the overlay groups are removed entirely
+	removeOverlays bool
+
+	// annotations is set when presentation state text annotations are cleaned rather than kept
+	annotations *descriptorScrubber
+
+	// rules are the attribute rules of the profile, which take precedence over every other action.
+	// Their conditions read the original values of the instance, shift rules moving dates by ruleShift days.
+	rules      *attributeRules
+	conditions conditionValues
+	ruleShift  int
+
+	// frames holds the dates of the functional groups of enhanced multi-frame instances, whose frame
+	// timing is moved by frameShift days when dates are not shifted
+	frames     *functionalGroups
+	frameShift int
+
+	// changes records the elements whose dates were shifted or UIDs remapped, so previews can tell
+	// them from elements replaced. It is nil outside previews.
+	changes map[*dicom.Element]string
+}
+
+// record records the change made to an element for previews
+func (d *deidentifier) record(e *dicom.Element, change string) {
+	if d.changes != nil {
+		d.changes[e] = change
+	}
+}
+
+// element applies the profile to a single element, called for every element in the dataset
+func (d *deidentifier) element(parent *dicom.Dataset, e *dicom.Element, path string) error {
+	if e.Tag == dicom.ContentSequence {
+		// the observer context is removed before the walk descends into the content items
+		removeObserverContext(e)
+	}
+	var err error
+	if rule := d.rules.match(parent, e.Tag, d.conditions); rule != nil {
+		err = d.applyRule(parent, e, rule)
+		// the rule decides the value, so frame timing it keeps or replaces is not verified
+		d.frames.skip(e)
+	} else {
+		err = d.apply(parent, e, d.action(parent, e))
+	}
+	if err != nil {
+		return fmt.Errorf("%s: %v", path, err)
+	}
+	return nil
+}
+
+// action returns the resolved action the profile takes for an element of parent
+func (d *deidentifier) action(parent *dicom.Dataset, e *dicom.Element) string {
+	if e.Tag.IsPrivate() {
+		if d.retainSafePrivate {
+			return d.privateAction(parent, e)
+		}
+		if e.Tag.IsPrivateCreator() && d.rules.selectsPrivate() {
+			// the creator is needed to match the private rules, unused creators are removed after the walk
+			return ActionKeep
+		}
+		return ActionRemove
+	}
+
+	if e.Tag == dicom.ContentSequence {
+		// the SR content tree is kept and de-identified item by item, see contentItemAction
+		return ActionKeep
+	}
+
+	if d.removeOverlays && isOverlayGroup(e.Tag) {
+		return ActionRemove
+	}
+
+	action := resolveAction(basicProfileAction(e.Tag))
+	if action == "" && e.VR == dicom.UI && !classUIDs[e.Tag] {
+		// UIDs not listed in the table may still be referenced by other instances
+		action = ActionUID
+	}
+	if action == "" && isTemporalVR(e.VR) {
+		// Table E.1-1 lists the dates and times of the standard, those missing from the table
+		// data are removed rather than kept
+		action = ActionRemove
+	}
+
+	if action == ActionUID && d.profile.HasOption(dcmd.RetainUIDsOption) {
+		action = ActionKeep
+	}
+	if isContentItem(parent) {
+		action = contentItemAction(e, action)
+	}
+	if !d.shiftDates && d.frames.isFrameTiming(e) {
+		action = ActionClean
+	}
+	if d.shiftDates && isTemporalVR(e.VR) && !birthDates[e.Tag] {
+		// the Retain Longitudinal Temporal Information with Modified Dates option cleans every date
+		action = ActionClean
+	}
+	if d.descriptors != nil && descriptorAttributes[e.Tag] {
+		action = ActionClean
+	}
+	if d.annotations != nil && annotationAttributes[e.Tag] {
+		action = ActionClean
+	}
+	if action == "" {
+		action = ActionKeep
+	}
+	return action
+}
+
+// apply performs a resolved action on an element of parent
+func (d *deidentifier) apply(parent *dicom.Dataset, e *dicom.Element, action string) error {
+	switch action {
+	case ActionRemove:
+		parent.Remove(e.Tag)
+
+	case ActionZero:
+		e.Value, e.Items, e.Fragments = nil, nil, nil
+
+	case ActionDummy:
+		// the items of a sequence are de-identified when the walk descends into them
+		if !e.IsSequence() {
+			e.Value = dummyValue(e)
+		}
+
+	case ActionClean:
+		d.clean(e)
+
+	case ActionUID:
+		if !e.IsSequence() {
+			return d.remapUIDs(e)
+		}
+	}
+	return nil
+}
+
+// clean replaces the value of an element with one of similar meaning that does not identify the patient
+func (d *deidentifier) clean(e *dicom.Element) {
+	switch {
+	case e.IsSequence():
+	case isTemporalVR(e.VR) && d.shiftDates:
+		shiftDates(e, d.dateShift)
+		d.record(e, dcmd.ElementShifted)
+	case d.frames.isFrameTiming(e):
+		shiftDates(e, d.frameShift)
+		d.record(e, dcmd.ElementShifted)
+	case d.descriptors != nil && descriptorAttributes[e.Tag]:
+		d.descriptors.cleanDescriptor(e)
+	case d.annotations != nil && annotationAttributes[e.Tag]:
+		d.annotations.cleanDescriptor(e)
+	case e.Tag.IsPrivate():
+		// only CSA headers are cleaned among private elements
+		d.csa.cleanCSAHeader(e)
+	default:
+		e.Value = dummyValue(e)
+	}
+}
+
+// remapUIDs replaces every instance UID held by a UI element
+func (d *deidentifier) remapUIDs(e *dicom.Element) error {
+	if e.VR != dicom.UI {
+		e.Value = dummyValue(e)
+		return nil
+	}
+	values := e.Strings()
+	for i, uid := range values {
+		if uid == "" || isStandardUID(uid) {
+			continue
+		}
+		remapped, err := d.service.UIDRemapper.RemapUID(d.ctx, uid)
+		if err != nil {
+			return fmt.Errorf("could not remap uid: %v", err)
+		}
+		values[i] = remapped
+	}
+	e.SetStrings(values...)
+	d.record(e, dcmd.ElementRemapped)
+	return nil
+}
+
+// setClinicalTrialSubject identifies the patient by their roster subject ID and fills in the
+// Clinical Trial Subject module
+func setClinicalTrialSubject(ds *dicom.Dataset, roster *dcmd.Roster, subject *dcmd.RosterEntry) {
+	ds.Set(dicom.NewElement(dicom.PatientID, dicom.LO, subject.SubjectID))
+	ds.Set(dicom.NewElement(dicom.PatientName, dicom.PN, subject.SubjectID))
+	ds.Set(dicom.NewElement(dicom.ClinicalTrialSponsorName, dicom.LO, roster.SponsorName))
+	ds.Set(dicom.NewElement(dicom.ClinicalTrialProtocolID, dicom.LO, roster.ProtocolID))
+	ds.Set(dicom.NewElement(dicom.ClinicalTrialSubjectID, dicom.LO, subject.SubjectID))
+
+	// Type 2 attributes are present even when the roster leaves them empty
+	ds.Set(dicom.NewElement(dicom.ClinicalTrialProtocolName, dicom.LO, roster.ProtocolName))
+	ds.Set(dicom.NewElement(dicom.ClinicalTrialSiteID, dicom.LO, subject.SiteID))
+	ds.Set(dicom.NewElement(dicom.ClinicalTrialSiteName, dicom.LO, subject.SiteName))
+}
+
+// birthDates are left to the basic profile when dates are shifted, as shifting them would not hide the age
+var birthDates = map[dicom.Tag]bool{
+	dicom.PatientBirthDate: true,
+	dicom.Tag(0x00100032):  true, // Patient's Birth Time
+}
+
+// isTemporalVR reports whether values of the VR are dates or times
+func isTemporalVR(vr string) bool {
+	return vr == dicom.DA || vr == dicom.DT || vr == dicom.TM
+}
+
+// resolveAction picks one action out of a compound Table E.1-1 action such as "X/Z/D".
+// Without knowing the IOD, the choice that keeps Type 1 and Type 2 attributes valid is taken.
+func resolveAction(action string) string {
+	switch {
+	case !strings.Contains(action, "/"):
+		return strings.TrimSuffix(action, "*")
+	case strings.Contains(action, ActionUID):
+		return ActionUID
+	case strings.Contains(action, ActionDummy):
+		return ActionDummy
+	case strings.Contains(action, ActionZero):
+		return ActionZero
+	}
+	return ActionRemove
+}
+
+// dummyValue returns a non-zero length value consistent with the VR of e
+func dummyValue(e *dicom.Element) []byte {
+	var v string
+	switch e.VR {
+	case dicom.DA:
+		v = "19000101"
+	case dicom.TM:
+		v = "000000"
+	case dicom.DT:
+		v = "19000101000000"
+	case dicom.AS:
+		v = "000Y"
+	case dicom.DS, dicom.IS:
+		v = "0"
+	case dicom.UI:
+		v = "2.25.0"
+	case dicom.PN, dicom.LO, dicom.SH, dicom.ST, dicom.LT, dicom.UT, dicom.UC, dicom.CS, dicom.AE:
+		v = "ANONYMIZED"
+	default:
+		// binary values keep their length so that multiplicity is preserved
+		return make([]byte, len(e.Value))
+	}
+	c := &dicom.Element{Tag: e.Tag, VR: e.VR}
+	c.SetStrings(v)
+	return c.Value
+}
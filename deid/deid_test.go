@@ -0,0 +1,235 @@
+package deid_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	dcmd "gitlab.com/medical-research/dicom-deidentifier"
+	"gitlab.com/medical-research/dicom-deidentifier/deid"
+	"gitlab.com/medical-research/dicom-deidentifier/dicom"
+)
+
+const testUIDRoot = "1.2.826.0.1.3680043.10.999"
+
+// newTestInstance returns an instance of a study referring to the instance referencedUID
+func newTestInstance(sopInstanceUID, referencedUID string) *dicom.File {
+	return &dicom.File{
+		Meta: dicom.NewDataset(
+			dicom.NewElement(dicom.MediaStorageSOPClassUID, dicom.UI, "1.2.840.10008.5.1.4.1.1.481.3"),
+			dicom.NewElement(dicom.MediaStorageSOPInstanceUID, dicom.UI, sopInstanceUID),
+			dicom.NewElement(dicom.TransferSyntaxUID, dicom.UI, dicom.ExplicitVRLittleEndian),
+		),
+		Dataset: dicom.NewDataset(
+			dicom.NewElement(dicom.SOPClassUID, dicom.UI, "1.2.840.10008.5.1.4.1.1.481.3"),
+			dicom.NewElement(dicom.SOPInstanceUID, dicom.UI, sopInstanceUID),
+			dicom.NewElement(dicom.StudyInstanceUID, dicom.UI, "1.3.6.1.4.1.5962.1.2.1"),
+			dicom.NewElement(dicom.PatientName, dicom.PN, "Doe^John"),
+			dicom.NewElement(dicom.PatientID, dicom.LO, "MRN0001"),
+			dicom.NewElement(dicom.InstitutionName, dicom.LO, "General Hospital"),
+			dicom.NewElement(dicom.Tag(0x00090010), dicom.LO, "ACME 1.1"),
+			dicom.NewElement(dicom.Tag(0x00091001), dicom.LO, "private"),
+			dicom.NewSequence(dicom.Tag(0x30060010), // Referenced Frame of Reference Sequence
+				dicom.NewDataset(
+					dicom.NewElement(dicom.FrameOfReferenceUID, dicom.UI, "1.3.6.1.4.1.5962.1.4.1"),
+					dicom.NewSequence(dicom.Tag(0x30060012), // RT Referenced Study Sequence
+						dicom.NewDataset(
+							dicom.NewElement(dicom.ReferencedSOPClassUID, dicom.UI, "1.2.840.10008.3.1.2.3.1"),
+							dicom.NewElement(dicom.ReferencedSOPInstanceUID, dicom.UI, referencedUID),
+						),
+					),
+				),
+			),
+		),
+	}
+}
+
+// newTestService returns a de-identification service remapping uids under testUIDRoot
+func newTestService(t *testing.T) *deid.DeidentificationService {
+	t.Helper()
+	remapper, err := deid.NewHashUIDRemapper(testUIDRoot, []byte("secret"))
+	if err != nil {
+		t.Fatalf("NewHashUIDRemapper() error = %v", err)
+	}
+	return deid.NewDeidentificationService(remapper)
+}
+
+func TestDeidentificationService_DeidentifyFile(t *testing.T) {
+	tests := []struct {
+		name       string
+		profile    *dcmd.Profile
+		retainUIDs bool
+	}{
+		{
+			name:    "basic profile remaps uids",
+			profile: &dcmd.Profile{Name: "basic"},
+		},
+		{
+			name:       "retain uids option keeps uids",
+			profile:    &dcmd.Profile{Name: "retain", Options: []string{dcmd.RetainUIDsOption}},
+			retainUIDs: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newTestService(t)
+
+			image := newTestInstance("1.3.6.1.4.1.5962.1.1.1", "")
+			plan := newTestInstance("1.3.6.1.4.1.5962.1.1.2", "1.3.6.1.4.1.5962.1.1.1")
+			for _, f := range []*dicom.File{image, plan} {
+				if err := s.DeidentifyFile(context.Background(), tt.profile, nil, f); err != nil {
+					t.Fatalf("DeidentifyFile() error = %v", err)
+				}
+			}
+
+			imageUID := image.Dataset.GetString(dicom.SOPInstanceUID)
+			referenced := plan.Dataset.Get(dicom.Tag(0x30060010)).Items[0].Get(dicom.Tag(0x30060012)).Items[0]
+			if got := referenced.GetString(dicom.ReferencedSOPInstanceUID); got != imageUID {
+				t.Errorf("nested reference = %v, want %v", got, imageUID)
+			}
+			if got := referenced.GetString(dicom.ReferencedSOPClassUID); got != "1.2.840.10008.3.1.2.3.1" {
+				t.Errorf("ReferencedSOPClassUID was changed to %v", got)
+			}
+			if got := image.Meta.GetString(dicom.MediaStorageSOPInstanceUID); got != imageUID {
+				t.Errorf("MediaStorageSOPInstanceUID = %v, want %v", got, imageUID)
+			}
+			if image.Dataset.GetString(dicom.StudyInstanceUID) != plan.Dataset.GetString(dicom.StudyInstanceUID) {
+				t.Errorf("StudyInstanceUID was not remapped consistently")
+			}
+			if remapped := strings.HasPrefix(imageUID, testUIDRoot+"."); remapped == tt.retainUIDs {
+				t.Errorf("SOPInstanceUID = %v, retainUIDs %v", imageUID, tt.retainUIDs)
+			}
+
+			if name := image.Dataset.Get(dicom.PatientName); name == nil || !name.IsEmpty() {
+				t.Errorf("PatientName was not emptied: %v", name)
+			}
+			if inst := image.Dataset.GetString(dicom.InstitutionName); inst == "General Hospital" {
+				t.Errorf("InstitutionName was kept")
+			}
+			if image.Dataset.Get(dicom.Tag(0x00091001)) != nil {
+				t.Errorf("private attribute was kept")
+			}
+		})
+	}
+}
+
+func TestDeidentificationService_DeidentifyFile_BasicProfile(t *testing.T) {
+	tests := []struct {
+		name    string
+		element *dicom.Element
+		dummy   bool
+	}{
+		{
+			name:    "referenced patient photo sequence",
+			element: dicom.NewSequence(dicom.Tag(0x00101100), dicom.NewDataset(dicom.NewElement(dicom.ReferencedSOPInstanceUID, dicom.UI, "1.2.3.9"))),
+		},
+		{
+			name:    "scheduled procedure step id",
+			element: dicom.NewElement(dicom.Tag(0x00400009), dicom.SH, "SPS0001"),
+		},
+		{
+			name:    "structure set label",
+			element: dicom.NewElement(dicom.Tag(0x30060002), dicom.SH, "DOE JOHN"),
+			dummy:   true,
+		},
+		{
+			name:    "rt plan label",
+			element: dicom.NewElement(dicom.Tag(0x300A0002), dicom.SH, "DOE JOHN"),
+			dummy:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newTestService(t)
+			f := newTestInstance("1.3.6.1.4.1.5962.1.1.1", "")
+			f.Dataset.Set(tt.element)
+			original := f.Dataset.GetString(tt.element.Tag)
+			if err := s.DeidentifyFile(context.Background(), &dcmd.Profile{Name: "basic"}, nil, f); err != nil {
+				t.Fatalf("DeidentifyFile() error = %v", err)
+			}
+
+			e := f.Dataset.Get(tt.element.Tag)
+			switch {
+			case !tt.dummy && e != nil:
+				t.Errorf("%s was kept: %v", tt.element.Tag, e)
+			case tt.dummy && (e == nil || e.IsEmpty() || f.Dataset.GetString(tt.element.Tag) == original):
+				t.Errorf("%s was not replaced by a dummy value: %v", tt.element.Tag, e)
+			}
+		})
+	}
+}
+
+// TestDeidentificationService_DeidentifyFile_UnlistedDates checks dates and times of Table E.1-1
+// missing from the table data
+func TestDeidentificationService_DeidentifyFile_UnlistedDates(t *testing.T) {
+	s := newTestService(t)
+	f := newTestInstance("1.3.6.1.4.1.5962.1.1.1", "")
+	tags := []dicom.Tag{
+		dicom.Tag(0x00181200), // Date of Last Calibration
+		dicom.Tag(0x00189516), // Start Acquisition DateTime
+	}
+	f.Dataset.Set(dicom.NewElement(tags[0], dicom.DA, "20200131"))
+	f.Dataset.Set(dicom.NewElement(tags[1], dicom.DT, "20200131101500"))
+	if err := s.DeidentifyFile(context.Background(), &dcmd.Profile{Name: "basic"}, nil, f); err != nil {
+		t.Fatalf("DeidentifyFile() error = %v", err)
+	}
+	for _, tag := range tags {
+		if e := f.Dataset.Get(tag); e != nil {
+			t.Errorf("%s was kept: %v", tag, e)
+		}
+	}
+}
+
+func TestHashUIDRemapper_RemapUID(t *testing.T) {
+	tests := []struct {
+		name string
+		root string
+	}{
+		{name: "short root", root: "2.25"},
+		{name: "long root", root: "1.2.826.0.1.3680043.10.999.123.45"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := deid.NewHashUIDRemapper(tt.root, []byte("secret"))
+			if err != nil {
+				t.Fatalf("NewHashUIDRemapper() error = %v", err)
+			}
+			uid := "1.3.6.1.4.1.5962.1.1.1"
+			got, _ := r.RemapUID(context.Background(), uid)
+			again, _ := r.RemapUID(context.Background(), uid)
+			other, _ := r.RemapUID(context.Background(), uid+"1")
+
+			if got != again {
+				t.Errorf("RemapUID() is not deterministic: %v != %v", got, again)
+			}
+			if got == other {
+				t.Errorf("RemapUID() gave the same uid for different inputs")
+			}
+			if !strings.HasPrefix(got, tt.root+".") || len(got) > 64 {
+				t.Errorf("RemapUID() = %v is not a valid uid under %v", got, tt.root)
+			}
+		})
+	}
+}
+
+func TestValidateUIDRoot(t *testing.T) {
+	tests := []struct {
+		name    string
+		root    string
+		wantErr bool
+	}{
+		{name: "valid root", root: "1.2.826.0.1.3680043.10.999"},
+		{name: "empty root", root: "", wantErr: true},
+		{name: "leading zero", root: "1.02.3", wantErr: true},
+		{name: "letters", root: "1.2.a", wantErr: true},
+		{name: "trailing dot", root: "1.2.", wantErr: true},
+		{name: "too long", root: strings.Repeat("1.", 30) + "1", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := deid.ValidateUIDRoot(tt.root); (err != nil) != tt.wantErr {
+				t.Errorf("ValidateUIDRoot() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,342 @@
+package deid
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	dcmd "gitlab.com/medical-research/dicom-deidentifier"
+	"gitlab.com/medical-research/dicom-deidentifier/dicom"
+)
+
+// Placeholders replacing the identifiers found in descriptors
+const (
+	PatientNamePlaceholder = "[PATIENT]"
+	IDPlaceholder          = "[ID]"
+	StaffNamePlaceholder   = "[STAFF]"
+	DatePlaceholder        = "[DATE]"
+	PhonePlaceholder       = "[PHONE]"
+)
+
+// minNameTokenLength skips initials and short name parts, which would match ordinary words.
+// Ideographs and kana count twice, so two character Japanese and Chinese names are matched.
+const minNameTokenLength = 3
+
+// descriptorAttributes are the attributes cleaned rather than removed under the
+// Clean Descriptors Option of Table E.1-1, and the Text Value of SR content items
+var descriptorAttributes = optionAttributes(columnCleanDescriptors, ActionClean, dicom.Tag(0x0040A160))
+
+// patientNameAttributes and patientIDAttributes identify the patient in the header of an instance
+var (
+	patientNameAttributes = []dicom.Tag{
+		dicom.PatientName,
+		dicom.OtherPatientNames,
+		dicom.Tag(0x00101005), // Patient's Birth Name
+		dicom.Tag(0x00101060), // Patient's Mother's Birth Name
+	}
+	patientIDAttributes = []dicom.Tag{
+		dicom.PatientID,
+		dicom.OtherPatientIDs,
+		dicom.AccessionNumber,
+		dicom.Tag(0x00101090), // Medical Record Locator
+	}
+)
+
+// staffNameAttributes name the clinicians and operators involved with an instance
+var staffNameAttributes = []dicom.Tag{
+	dicom.ReferringPhysicianName,
+	dicom.Tag(0x00081048), // Physician(s) of Record
+	dicom.Tag(0x00081050), // Performing Physicians' Name
+	dicom.Tag(0x00081060), // Name of Physician(s) Reading Study
+	dicom.Tag(0x00081070), // Operators' Name
+	dicom.Tag(0x00321032), // Requesting Physician
+}
+
+// ScrubPattern replaces every match of a regular expression with a placeholder. When the expression
+// has a subexpression named "value" only that part of the match is replaced, so the words that
+// made the match certain are kept.
+type ScrubPattern struct {
+	Name        string
+	Regexp      *regexp.Regexp
+	Placeholder string
+
+	// words restricts matches to whole words of any script, which \b cannot do as it only knows ASCII
+	words bool
+}
+
+// replace returns v with every match of the pattern replaced by its placeholder
+func (p *ScrubPattern) replace(v string) string {
+	if !p.words && p.Regexp.SubexpIndex("value") < 0 {
+		return p.Regexp.ReplaceAllLiteralString(v, p.Placeholder)
+	}
+	var out strings.Builder
+	last := 0
+	for _, m := range p.find(v) {
+		out.WriteString(v[last:m[0]])
+		out.WriteString(p.Placeholder)
+		last = m[1]
+	}
+	out.WriteString(v[last:])
+	return out.String()
+}
+
+// matches reports whether the pattern matches anywhere in v
+func (p *ScrubPattern) matches(v string) bool {
+	return len(p.find(v)) > 0
+}
+
+// find returns the index pairs of the matches of the pattern in v
+func (p *ScrubPattern) find(v string) [][]int {
+	var matches [][]int
+	if i := p.Regexp.SubexpIndex("value"); i > 0 {
+		for _, m := range p.Regexp.FindAllStringSubmatchIndex(v, -1) {
+			if m[2*i] >= 0 {
+				matches = append(matches, m[2*i:2*i+2])
+			}
+		}
+	} else {
+		matches = p.Regexp.FindAllStringIndex(v, -1)
+	}
+	if !p.words {
+		return matches
+	}
+	var words [][]int
+	for _, m := range matches {
+		if isWordBoundary(v, m[0]) && isWordBoundary(v, m[1]) {
+			words = append(words, m)
+		}
+	}
+	return words
+}
+
+// isWordBoundary reports whether position i of v separates two words. Japanese and Chinese are
+// written without spaces, so ideographs and kana are taken to start and end words on their own.
+func isWordBoundary(v string, i int) bool {
+	before, _ := utf8.DecodeLastRuneInString(v[:i])
+	after, _ := utf8.DecodeRuneInString(v[i:])
+	if i == 0 || i == len(v) {
+		return true
+	}
+	return !isWordRune(before) || !isWordRune(after) || isIdeographic(before) || isIdeographic(after)
+}
+
+// isWordRune reports whether r is part of a word
+func isWordRune(r rune) bool {
+	return r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+// isIdeographic reports whether r is written without spaces between words
+func isIdeographic(r rune) bool {
+	return unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana)
+}
+
+// DefaultScrubPatterns find identifiers that follow a recognisable format. Descriptors are full of
+// numbers, such as matrix sizes, b values and field strengths, so a format alone is only trusted when
+// it cannot be read as anything else: dates need a four digit year or a word announcing a date, phone
+// numbers a country code, an area code in parentheses or three groups of digits joined by the same
+// separator. Groups joined by spaces are lists of values as often as not, they are only taken for a
+// phone number after a trunk prefix 0.
+var DefaultScrubPatterns = []*ScrubPattern{
+	{
+		Name:        "date",
+		Regexp:      regexp.MustCompile(`\b(?:(?:19|20)\d{2}(?:-\d{1,2}-|/\d{1,2}/|\.\d{1,2}\.)\d{1,2}|\d{1,2}(?:-\d{1,2}-|/\d{1,2}/|\.\d{1,2}\.)(?:19|20)\d{2}|(?:19|20)\d{2}(?:0[1-9]|1[0-2])(?:0[1-9]|[12]\d|3[01]))\b`),
+		Placeholder: DatePlaceholder,
+	},
+	{
+		Name:        "date after a context word",
+		Regexp:      regexp.MustCompile(`(?i)\b(?:dob|born|dated?|on|seen|scanned|performed|acquired)[ :]+(?P<value>\d{1,2}(?:-\d{1,2}-|/\d{1,2}/|\.\d{1,2}\.)\d{2})\b`),
+		Placeholder: DatePlaceholder,
+	},
+	{
+		Name:        "phone",
+		Regexp:      regexp.MustCompile(`\+\d{1,3}(?:[ .-]?\d{2,4}){2,4}\b|\(\d{2,5}\)[ .-]?\d{3,4}[ .-]?\d{3,4}\b|\b\d{2,5}(?:-\d{3,4}-|\.\d{3,4}\.)\d{4}\b|\b0\d{1,4} \d{3,4} \d{4}\b`),
+		Placeholder: PhonePlaceholder,
+	},
+}
+
+// DescriptorCleaner finds identifiers in free-text descriptors and replaces them with placeholders,
+// keeping the rest of the text. Identifiers are found using the patient's own names and IDs from
+// the header, the staff names of the header and dictionaries, and regular expressions.
+type DescriptorCleaner struct {
+	// StaffNames are clinicians and operators whose names are removed from every instance
+	StaffNames []string
+
+	// Patterns are applied after names and IDs, in order
+	Patterns []*ScrubPattern
+}
+
+// NewDescriptorCleaner returns a new instance of DescriptorCleaner applying the default patterns
+func NewDescriptorCleaner() *DescriptorCleaner {
+	return &DescriptorCleaner{
+		Patterns: append([]*ScrubPattern{}, DefaultScrubPatterns...),
+	}
+}
+
+// AddPattern compiles a regular expression replaced by placeholder
+func (c *DescriptorCleaner) AddPattern(name, expr, placeholder string) error {
+	re, err := regexp.Compile(expr)
+	if err != nil {
+		return dcmd.Errorf(dcmd.EINVALID, "invalid %s pattern: %v", name, err)
+	}
+	c.Patterns = append(c.Patterns, &ScrubPattern{Name: name, Regexp: re, Placeholder: placeholder})
+	return nil
+}
+
+// ReadPatterns adds the patterns of a JSON array such as
+// [{"name": "mrn", "pattern": "\\bMRN\\d{6}\\b", "placeholder": "[ID]"}]
+func (c *DescriptorCleaner) ReadPatterns(r io.Reader) error {
+	var patterns []struct {
+		Name        string `json:"name"`
+		Pattern     string `json:"pattern"`
+		Placeholder string `json:"placeholder"`
+	}
+	if err := json.NewDecoder(r).Decode(&patterns); err != nil {
+		return dcmd.Errorf(dcmd.EINVALID, "invalid patterns: %v", err)
+	}
+	for _, p := range patterns {
+		if err := c.AddPattern(p.Name, p.Pattern, p.Placeholder); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ReadStaffNames adds a staff-name dictionary holding one name per line, either as a DICOM
+// person name (Family^Given) or as free text. Empty lines and lines starting with # are skipped.
+func (c *DescriptorCleaner) ReadStaffNames(r io.Reader) error {
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		c.StaffNames = append(c.StaffNames, line)
+	}
+	return scanner.Err()
+}
+
+// descriptorScrubber cleans the descriptors of a single instance
+type descriptorScrubber struct {
+	cleaner *DescriptorCleaner
+
+	// replacements are applied in order before the cleaner's patterns
+	replacements []*ScrubPattern
+}
+
+// scrubber returns a scrubber for the instance whose header is ds. It must be called before
+// the header identifiers are removed.
+func (c *DescriptorCleaner) scrubber(ds *dicom.Dataset) *descriptorScrubber {
+	var ids, patientNames, staffNames []string
+	for _, tag := range patientIDAttributes {
+		ids = append(ids, ds.GetStrings(tag)...)
+	}
+	for _, tag := range patientNameAttributes {
+		patientNames = append(patientNames, nameTokens(ds.GetStrings(tag)...)...)
+	}
+	for _, tag := range staffNameAttributes {
+		staffNames = append(staffNames, nameTokens(ds.GetStrings(tag)...)...)
+	}
+	staffNames = append(staffNames, nameTokens(c.StaffNames...)...)
+
+	s := &descriptorScrubber{cleaner: c}
+	for _, r := range []struct {
+		words       []string
+		placeholder string
+	}{
+		{ids, IDPlaceholder},
+		{patientNames, PatientNamePlaceholder},
+		{staffNames, StaffNamePlaceholder},
+	} {
+		if re := wordsRegexp(r.words); re != nil {
+			s.replacements = append(s.replacements, &ScrubPattern{Regexp: re, Placeholder: r.placeholder, words: true})
+		}
+	}
+	return s
+}
+
+// scrub replaces the identifiers found in a descriptor
+func (s *descriptorScrubber) scrub(v string) string {
+	for _, p := range s.replacements {
+		v = p.replace(v)
+	}
+	for _, p := range s.cleaner.Patterns {
+		v = p.replace(v)
+	}
+	return v
+}
+
+// cleanDescriptor scrubs every value of a descriptor element, keeping values within the VR's length
+func (s *descriptorScrubber) cleanDescriptor(e *dicom.Element) {
+	values := e.Strings()
+	for i, v := range values {
+		values[i] = truncate(s.scrub(v), maxTextLength(e.VR))
+	}
+	e.SetStrings(values...)
+}
+
+// nameTokens splits person names into the parts long enough to be matched on their own
+func nameTokens(names ...string) []string {
+	var tokens []string
+	for _, name := range names {
+		for _, t := range strings.FieldsFunc(name, func(r rune) bool {
+			return r == '^' || r == '=' || r == ',' || r == ' ' || r == '.'
+		}) {
+			if nameTokenLength(t) >= minNameTokenLength {
+				tokens = append(tokens, t)
+			}
+		}
+	}
+	return tokens
+}
+
+// nameTokenLength returns the length of a name part in characters, ideographs and kana counting twice
+func nameTokenLength(t string) int {
+	n := 0
+	for _, r := range t {
+		n++
+		if isIdeographic(r) {
+			n++
+		}
+	}
+	return n
+}
+
+// wordsRegexp returns a case-insensitive expression matching any of the words, longest first so
+// that names sharing a prefix are replaced completely. Whole words are selected by ScrubPattern.
+func wordsRegexp(words []string) *regexp.Regexp {
+	seen := map[string]bool{}
+	var quoted []string
+	for _, w := range words {
+		w = strings.TrimSpace(w)
+		if w == "" || seen[strings.ToLower(w)] {
+			continue
+		}
+		seen[strings.ToLower(w)] = true
+		quoted = append(quoted, regexp.QuoteMeta(w))
+	}
+	if len(quoted) == 0 {
+		return nil
+	}
+	sort.Slice(quoted, func(i, j int) bool { return len(quoted[i]) > len(quoted[j]) })
+	return regexp.MustCompile(`(?i)(?:` + strings.Join(quoted, "|") + `)`)
+}
+
+// maxTextLength returns the maximum length of a single value of a text VR
+func maxTextLength(vr string) int {
+	switch vr {
+	case dicom.SH:
+		return 16
+	case dicom.LO:
+		return 64
+	case dicom.ST:
+		return 1024
+	case dicom.LT:
+		return 10240
+	}
+	return 1<<31 - 1
+}
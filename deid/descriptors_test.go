@@ -0,0 +1,204 @@
+package deid_test
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	dcmd "gitlab.com/medical-research/dicom-deidentifier"
+	"gitlab.com/medical-research/dicom-deidentifier/dicom"
+)
+
+func TestDeidentificationService_DeidentifyFile_CleanDescriptors(t *testing.T) {
+	s := newTestService(t)
+	if err := s.DescriptorCleaner.ReadStaffNames(strings.NewReader("# radiologists\nJones^Peter\n")); err != nil {
+		t.Fatalf("ReadStaffNames() error = %v", err)
+	}
+	if err := s.DescriptorCleaner.ReadPatterns(strings.NewReader(`[{"name": "hospital number", "pattern": "\\bH\\d{5}\\b", "placeholder": "[ID]"}]`)); err != nil {
+		t.Fatalf("ReadPatterns() error = %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		profile *dcmd.Profile
+		tag     dicom.Tag
+		value   string
+		want    string
+	}{
+		{
+			name:    "patient name and id from the header",
+			profile: &dcmd.Profile{Name: "clean", Options: []string{dcmd.CleanDescriptorsOption}},
+			tag:     dicom.StudyDescription,
+			value:   "CT CHEST john doe MRN0001",
+			want:    "CT CHEST [PATIENT] [PATIENT] [ID]",
+		},
+		{
+			name:    "staff from the header and dictionary",
+			profile: &dcmd.Profile{Name: "clean", Options: []string{dcmd.CleanDescriptorsOption}},
+			tag:     dicom.ImageComments,
+			value:   "ordered by Dr Smith, read by P. Jones",
+			want:    "ordered by Dr [STAFF], read by P. [STAFF]",
+		},
+		{
+			name:    "dates, phone numbers and custom patterns",
+			profile: &dcmd.Profile{Name: "clean", Options: []string{dcmd.CleanDescriptorsOption}},
+			tag:     dicom.AdditionalPatientHistory,
+			value:   "prior scan 2019-03-02 at H12345, call 020 7946 0000 if worse",
+			want:    "prior scan [DATE] at [ID], call [PHONE] if worse",
+		},
+		{
+			name:    "clinical text is kept",
+			profile: &dcmd.Profile{Name: "clean", Options: []string{dcmd.CleanDescriptorsOption}},
+			tag:     dicom.SeriesDescription,
+			value:   "AX T2 FLAIR 5mm",
+			want:    "AX T2 FLAIR 5mm",
+		},
+		{
+			name:    "long multibyte text is cut between characters",
+			profile: &dcmd.Profile{Name: "clean", Options: []string{dcmd.CleanDescriptorsOption}},
+			tag:     dicom.SeriesDescription,
+			value:   strings.Repeat("ü", 70),
+			want:    strings.Repeat("ü", 64),
+		},
+		{
+			name:    "descriptors removed without the option",
+			profile: &dcmd.Profile{Name: "basic"},
+			tag:     dicom.StudyDescription,
+			value:   "CT CHEST",
+			want:    "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newTestInstance("1.3.6.1.4.1.5962.1.1.1", "")
+			f.Dataset.SetString(dicom.ReferringPhysicianName, "Smith^Anna")
+			f.Dataset.SetString(tt.tag, tt.value)
+
+			if err := s.DeidentifyFile(context.Background(), tt.profile, nil, f); err != nil {
+				t.Fatalf("DeidentifyFile() error = %v", err)
+			}
+			if got := f.Dataset.GetString(tt.tag); got != tt.want {
+				t.Errorf("%s = %q, want %q", tt.tag, got, tt.want)
+			}
+		})
+	}
+}
+
+// Numbers of acquisition protocols look like dates and phone numbers, only those that cannot be
+// read as anything else are replaced
+func TestDeidentificationService_DeidentifyFile_ScrubPatterns(t *testing.T) {
+	s := newTestService(t)
+	profile := &dcmd.Profile{Name: "clean", Options: []string{dcmd.CleanDescriptorsOption}}
+
+	tests := []struct {
+		value string
+		want  string
+	}{
+		{value: "T1 MPR matrix 512 512", want: "T1 MPR matrix 512 512"},
+		{value: "CT 120 140 kV", want: "CT 120 140 kV"},
+		{value: "DWI b 1000 2000", want: "DWI b 1000 2000"},
+		{value: "DWI b 0 500 1000 2000", want: "DWI b 0 500 1000 2000"},
+		{value: "T2 1.5.20 protocol", want: "T2 1.5.20 protocol"},
+		{value: "FOV 240 x 240 mm 3.0 T", want: "FOV 240 x 240 mm 3.0 T"},
+		{value: "TR 2000 TE 100-120", want: "TR 2000 TE 100-120"},
+		{value: "slices 1-20 of 160", want: "slices 1-20 of 160"},
+		{value: "scanned on 1.5.20", want: "scanned on [DATE]"},
+		{value: "DOB: 01/02/85", want: "DOB: [DATE]"},
+		{value: "prior 02.03.2019", want: "prior [DATE]"},
+		{value: "prior 20190302", want: "prior [DATE]"},
+		{value: "call +44 20 7946 0000", want: "call [PHONE]"},
+		{value: "call (555) 123-4567", want: "call [PHONE]"},
+		{value: "call 555-123-4567", want: "call [PHONE]"},
+		{value: "call 555.123.4567", want: "call [PHONE]"},
+		{value: "call 555-123 4567", want: "call 555-123 4567"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			f := newTestInstance("1.3.6.1.4.1.5962.1.1.1", "")
+			f.Dataset.SetString(dicom.ImageComments, tt.value)
+
+			if err := s.DeidentifyFile(context.Background(), profile, nil, f); err != nil {
+				t.Fatalf("DeidentifyFile() error = %v", err)
+			}
+			if got := f.Dataset.GetString(dicom.ImageComments); got != tt.want {
+				t.Errorf("ImageComments = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDeidentificationService_DeidentifyInstance_CharacterSets(t *testing.T) {
+	s := newTestService(t)
+
+	tests := []struct {
+		name        string
+		options     []string
+		charset     string
+		rawName     string
+		rawDesc     string
+		want        string
+		wantCharset string
+	}{
+		{
+			name:        "greek",
+			options:     []string{dcmd.CleanDescriptorsOption},
+			charset:     "ISO_IR 126",
+			rawName:     "\xc4\xe9\xef\xed\xf5\xf3\xe9\xef\xf2",
+			rawDesc:     "CT \xc4\xc9\xcf\xcd\xd5\xd3\xc9\xcf\xd3 \xe8\xf9\xf1\xe1\xea\xe1\xf2",
+			want:        "CT [PATIENT] θωρακας",
+			wantCharset: "ISO_IR 126",
+		},
+		{
+			name:        "japanese with code extensions",
+			options:     []string{dcmd.CleanDescriptorsOption},
+			charset:     `\ISO 2022 IR 87`,
+			rawName:     "Yamada^Tarou=\x1b$B;3ED\x1b(B^\x1b$BB@O:\x1b(B",
+			rawDesc:     "\x1b$B;3EDB@O:MM\x1b(B \x1b$B6;It\x1b(BCT",
+			want:        "[PATIENT][PATIENT]様 胸部CT",
+			wantCharset: `\ISO 2022 IR 87`,
+		},
+		{
+			name:        "converted to utf-8",
+			options:     []string{dcmd.CleanDescriptorsOption, dcmd.ConvertToUTF8Option},
+			charset:     `\ISO 2022 IR 87`,
+			rawName:     "Yamada^Tarou=\x1b$B;3ED\x1b(B^\x1b$BB@O:\x1b(B",
+			rawDesc:     "\x1b$B;3EDB@O:MM\x1b(B \x1b$B6;It\x1b(BCT",
+			want:        "[PATIENT][PATIENT]様 胸部CT",
+			wantCharset: dicom.UTF8CharacterSet,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newTestInstance("1.3.6.1.4.1.5962.1.1.1", "")
+			f.Dataset.SetString(dicom.SpecificCharacterSet, tt.charset)
+			f.Dataset.Set(&dicom.Element{Tag: dicom.PatientName, VR: dicom.PN, Value: []byte(tt.rawName)})
+			f.Dataset.Set(&dicom.Element{Tag: dicom.StudyDescription, VR: dicom.LO, Value: []byte(tt.rawDesc)})
+			var in, out bytes.Buffer
+			if err := dicom.Write(&in, f); err != nil {
+				t.Fatalf("Write() error = %v", err)
+			}
+
+			profile := &dcmd.Profile{Name: "clean", Options: tt.options}
+			if err := s.DeidentifyInstance(context.Background(), profile, nil, &in, &out); err != nil {
+				t.Fatalf("DeidentifyInstance() error = %v", err)
+			}
+
+			got, err := dicom.Read(&out)
+			if err != nil {
+				t.Fatalf("Read() error = %v", err)
+			}
+			if desc := got.Dataset.GetString(dicom.StudyDescription); desc != tt.want {
+				t.Errorf("StudyDescription = %q, want %q", desc, tt.want)
+			}
+			// the character set the output was encoded with, which Read converts from
+			charset := strings.Join(got.CharacterSet, `\`)
+			if charset == "" {
+				charset = got.Dataset.GetString(dicom.SpecificCharacterSet)
+			}
+			if charset != tt.wantCharset {
+				t.Errorf("SpecificCharacterSet = %q, want %q", charset, tt.wantCharset)
+			}
+		})
+	}
+}
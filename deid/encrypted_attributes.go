@@ -0,0 +1,193 @@
+package deid
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"sync"
+
+	dcmd "gitlab.com/medical-research/dicom-deidentifier"
+	"gitlab.com/medical-research/dicom-deidentifier/dicom"
+	"go.mozilla.org/pkcs7"
+)
+
+// pkcs7Mu serialises the encryptions of the pkcs7 package, which takes the content encryption
+// algorithm from a package variable rather than an argument
+var pkcs7Mu sync.Mutex
+
+// copyOriginalAttributes returns a copy of the top level attributes of a dataset before it is
+// de-identified. Pixel data is left out, the sequence records identifiers rather than images.
+func copyOriginalAttributes(ds *dicom.Dataset) *dicom.Dataset {
+	original := &dicom.Dataset{}
+	for _, e := range ds.Elements {
+		if e.Tag != dicom.PixelData {
+			original.Elements = append(original.Elements, e.Copy())
+		}
+	}
+	return original
+}
+
+// encryptOriginalAttributes adds an item to the Encrypted Attributes Sequence holding the original
+// value of every attribute the profile removed or modified, enveloped for the recipients as
+// described in PS3.15 E.1.1
+func encryptOriginalAttributes(ds, original *dicom.Dataset, recipients []*x509.Certificate) error {
+	modified := &dicom.Dataset{}
+	for _, e := range original.Elements {
+		if !elementsEqual(e, ds.Get(e.Tag)) {
+			modified.Elements = append(modified.Elements, e)
+		}
+	}
+
+	content, err := dicom.EncodeDataset(
+		dicom.NewDataset(dicom.NewSequence(dicom.ModifiedAttributesSequence, modified)),
+		dicom.ExplicitVRLittleEndian,
+	)
+	if err != nil {
+		return fmt.Errorf("could not encode modified attributes: %v", err)
+	}
+	enveloped, err := encryptAES(content, recipients)
+	if err != nil {
+		return fmt.Errorf("could not encrypt modified attributes: %v", err)
+	}
+
+	item := dicom.NewDataset(
+		dicom.NewElement(dicom.EncryptedContentTransferSyntaxUID, dicom.UI, dicom.ExplicitVRLittleEndian),
+		&dicom.Element{Tag: dicom.EncryptedContent, VR: dicom.OB, Value: enveloped},
+	)
+
+	// items added by earlier processing, e.g. for other recipients, are kept
+	seq := ds.Get(dicom.EncryptedAttributesSequence)
+	if seq == nil || !seq.IsSequence() {
+		seq = dicom.NewSequence(dicom.EncryptedAttributesSequence)
+		ds.Set(seq)
+	}
+	seq.Items = append(seq.Items, item)
+	return nil
+}
+
+// encryptAES envelopes content for the recipients with AES, as PS3.15 requires for the content
+// encryption of the Encrypted Attributes Sequence. The algorithm set before is restored afterwards,
+// so other users of the package are left as they were.
+func encryptAES(content []byte, recipients []*x509.Certificate) ([]byte, error) {
+	pkcs7Mu.Lock()
+	defer pkcs7Mu.Unlock()
+
+	previous := pkcs7.ContentEncryptionAlgorithm
+	pkcs7.ContentEncryptionAlgorithm = pkcs7.EncryptionAlgorithmAES256CBC
+	defer func() { pkcs7.ContentEncryptionAlgorithm = previous }()
+	return pkcs7.Encrypt(content, recipients)
+}
+
+// DecryptOriginalAttributes returns the original attributes recorded in the Encrypted Attributes
+// Sequence of a de-identified dataset for the recipient holding cert and key.
+// Returns ENOTFOUND if no item of the sequence was encrypted for the recipient.
+func DecryptOriginalAttributes(ds *dicom.Dataset, cert *x509.Certificate, key crypto.PrivateKey) (*dicom.Dataset, error) {
+	seq := ds.Get(dicom.EncryptedAttributesSequence)
+	if seq == nil || len(seq.Items) == 0 {
+		return nil, dcmd.Errorf(dcmd.ENOTFOUND, "instance has no encrypted attributes")
+	}
+
+	for _, item := range seq.Items {
+		e := item.Get(dicom.EncryptedContent)
+		if e == nil {
+			continue
+		}
+		p7, err := pkcs7.Parse(e.Value)
+		if err != nil {
+			return nil, dcmd.Errorf(dcmd.EINVALID, "invalid encrypted content: %v", err)
+		}
+		content, err := p7.Decrypt(cert, key)
+		if err == pkcs7.ErrNotEncryptedContent {
+			return nil, dcmd.Errorf(dcmd.EINVALID, "encrypted content is not enveloped data")
+		} else if err != nil {
+			// the item was encrypted for other recipients
+			continue
+		}
+
+		ts := item.GetString(dicom.EncryptedContentTransferSyntaxUID)
+		decrypted, err := dicom.ReadDataset(content, ts)
+		if err != nil {
+			return nil, dcmd.Errorf(dcmd.EINVALID, "invalid decrypted content: %v", err)
+		}
+		modified := decrypted.Get(dicom.ModifiedAttributesSequence)
+		if modified == nil || len(modified.Items) == 0 {
+			return nil, dcmd.Errorf(dcmd.EINVALID, "decrypted content has no modified attributes")
+		}
+		return modified.Items[0], nil
+	}
+	return nil, dcmd.Errorf(dcmd.ENOTFOUND, "no encrypted attributes for this recipient")
+}
+
+// RestoreOriginalAttributes puts the original attributes back into a de-identified dataset
+func RestoreOriginalAttributes(ds, original *dicom.Dataset) {
+	for _, e := range original.Elements {
+		ds.Set(e.Copy())
+	}
+}
+
+// ParseCertificates returns every certificate of a PEM file
+func ParseCertificates(b []byte) ([]*x509.Certificate, error) {
+	var certs []*x509.Certificate
+	for {
+		var block *pem.Block
+		if block, b = pem.Decode(b); block == nil {
+			break
+		} else if block.Type != "CERTIFICATE" {
+			continue
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, dcmd.Errorf(dcmd.EINVALID, "invalid certificate: %v", err)
+		}
+		certs = append(certs, cert)
+	}
+	if len(certs) == 0 {
+		return nil, dcmd.Errorf(dcmd.EINVALID, "no certificate found")
+	}
+	return certs, nil
+}
+
+// ParsePrivateKey returns the first PKCS #1 or PKCS #8 private key of a PEM file
+func ParsePrivateKey(b []byte) (crypto.PrivateKey, error) {
+	for {
+		var block *pem.Block
+		if block, b = pem.Decode(b); block == nil {
+			return nil, dcmd.Errorf(dcmd.EINVALID, "no private key found")
+		}
+		switch block.Type {
+		case "RSA PRIVATE KEY":
+			return x509.ParsePKCS1PrivateKey(block.Bytes)
+		case "PRIVATE KEY":
+			return x509.ParsePKCS8PrivateKey(block.Bytes)
+		}
+	}
+}
+
+// elementsEqual reports whether two elements hold the same value
+func elementsEqual(a, b *dicom.Element) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Tag != b.Tag || a.VR != b.VR || !bytes.Equal(a.Value, b.Value) ||
+		len(a.Items) != len(b.Items) || len(a.Fragments) != len(b.Fragments) {
+		return false
+	}
+	for i := range a.Fragments {
+		if !bytes.Equal(a.Fragments[i], b.Fragments[i]) {
+			return false
+		}
+	}
+	for i := range a.Items {
+		if len(a.Items[i].Elements) != len(b.Items[i].Elements) {
+			return false
+		}
+		for j := range a.Items[i].Elements {
+			if !elementsEqual(a.Items[i].Elements[j], b.Items[i].Elements[j]) {
+				return false
+			}
+		}
+	}
+	return true
+}
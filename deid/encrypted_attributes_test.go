@@ -0,0 +1,96 @@
+package deid_test
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"math/big"
+	"testing"
+	"time"
+
+	dcmd "gitlab.com/medical-research/dicom-deidentifier"
+	"gitlab.com/medical-research/dicom-deidentifier/deid"
+	"gitlab.com/medical-research/dicom-deidentifier/dicom"
+	"go.mozilla.org/pkcs7"
+)
+
+// mustGenerateRecipient returns a self-signed RSA certificate and its private key
+func mustGenerateRecipient(t *testing.T, name string) (*x509.Certificate, *rsa.PrivateKey) {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("GenerateKey() error = %v", err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageKeyEncipherment,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("CreateCertificate() error = %v", err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatalf("ParseCertificate() error = %v", err)
+	}
+	return cert, key
+}
+
+func TestDeidentificationService_DeidentifyFile_EncryptOriginalAttributes(t *testing.T) {
+	broker, brokerKey := mustGenerateRecipient(t, "broker")
+	other, otherKey := mustGenerateRecipient(t, "other")
+
+	s := newTestService(t)
+	s.RecipientCertificates = []*x509.Certificate{broker}
+	profile := &dcmd.Profile{Name: "reversible", Options: []string{dcmd.EncryptOriginalAttributesOption}}
+
+	f := newTestInstance("1.3.6.1.4.1.5962.1.1.1", "")
+	if err := s.DeidentifyFile(context.Background(), profile, nil, f); err != nil {
+		t.Fatalf("DeidentifyFile() error = %v", err)
+	}
+	if pkcs7.ContentEncryptionAlgorithm != pkcs7.EncryptionAlgorithmDESCBC {
+		t.Errorf("pkcs7.ContentEncryptionAlgorithm = %d, want the package default left as it was", pkcs7.ContentEncryptionAlgorithm)
+	}
+
+	// the sequence survives encoding and decoding
+	b, err := dicom.EncodeDataset(f.Dataset, dicom.ImplicitVRLittleEndian)
+	if err != nil {
+		t.Fatalf("EncodeDataset() error = %v", err)
+	}
+	ds, err := dicom.ReadDataset(b, dicom.ImplicitVRLittleEndian)
+	if err != nil {
+		t.Fatalf("ReadDataset() error = %v", err)
+	}
+
+	original, err := deid.DecryptOriginalAttributes(ds, broker, brokerKey)
+	if err != nil {
+		t.Fatalf("DecryptOriginalAttributes() error = %v", err)
+	}
+	for tag, want := range map[dicom.Tag]string{
+		dicom.PatientName:     "Doe^John",
+		dicom.PatientID:       "MRN0001",
+		dicom.InstitutionName: "General Hospital",
+		dicom.SOPInstanceUID:  "1.3.6.1.4.1.5962.1.1.1",
+	} {
+		if got := original.GetString(tag); got != want {
+			t.Errorf("original %s = %q, want %q", tag, got, want)
+		}
+	}
+	if original.Get(dicom.SOPClassUID) != nil {
+		t.Errorf("unmodified SOPClassUID was recorded")
+	}
+
+	if _, err := deid.DecryptOriginalAttributes(ds, other, otherKey); dcmd.ErrorCode(err) != dcmd.ENOTFOUND {
+		t.Errorf("DecryptOriginalAttributes() for another recipient error = %v, want %v", err, dcmd.ENOTFOUND)
+	}
+
+	deid.RestoreOriginalAttributes(ds, original)
+	if got := ds.GetString(dicom.PatientID); got != "MRN0001" {
+		t.Errorf("restored PatientID = %q, want MRN0001", got)
+	}
+}
@@ -0,0 +1,119 @@
+package deid
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"gitlab.com/medical-research/dicom-deidentifier/dicom"
+)
+
+// frameTemporalAttributes time the frames of enhanced multi-frame instances. When dates are not
+// shifted they are rebased onto the dummy date rather than replaced, so that the intervals between
+// frames are kept.
+var frameTemporalAttributes = map[dicom.Tag]bool{
+	dicom.FrameAcquisitionDateTime: true,
+	dicom.FrameReferenceDateTime:   true,
+	dicom.Tag(0x00189516):          true, // Start Acquisition DateTime
+	dicom.Tag(0x00189517):          true, // End Acquisition DateTime
+	dicom.Tag(0x00189701):          true, // Decay Correction DateTime
+	dicom.Tag(0x00189804):          true, // Exclusion Start DateTime
+}
+
+// dummyDate is the date the earliest frame is rebased onto, the dummy value of DA attributes
+const dummyDate = "19000101"
+
+// functionalGroups records the dates held by the functional groups of an enhanced multi-frame
+// instance before it is de-identified, to check afterwards that every one of them was shifted
+type functionalGroups struct {
+	original map[*dicom.Element][]string
+}
+
+// newFunctionalGroups records the dates of the functional groups of ds, it returns nil for
+// instances without functional groups
+func newFunctionalGroups(ds *dicom.Dataset) *functionalGroups {
+	g := &functionalGroups{original: map[*dicom.Element][]string{}}
+	for _, tag := range []dicom.Tag{dicom.SharedFunctionalGroupsSequence, dicom.PerFrameFunctionalGroupsSequence} {
+		e := ds.Get(tag)
+		if e == nil {
+			continue
+		}
+		for _, item := range e.Items {
+			_ = item.Walk(func(parent *dicom.Dataset, e *dicom.Element, path string) error {
+				if (e.VR == dicom.DA || e.VR == dicom.DT) && !e.Tag.IsPrivate() {
+					g.original[e] = e.Strings()
+				}
+				return nil
+			})
+		}
+	}
+	if len(g.original) == 0 {
+		return nil
+	}
+	return g
+}
+
+// isFrameTiming reports whether e is a frame timing attribute of the functional groups
+func (g *functionalGroups) isFrameTiming(e *dicom.Element) bool {
+	if g == nil || !frameTemporalAttributes[e.Tag] {
+		return false
+	}
+	_, ok := g.original[e]
+	return ok
+}
+
+// rebaseDays returns the offset moving the earliest frame timing date onto the dummy date
+func (g *functionalGroups) rebaseDays() int {
+	if g == nil {
+		return 0
+	}
+	var earliest time.Time
+	for e, values := range g.original {
+		if !frameTemporalAttributes[e.Tag] {
+			continue
+		}
+		for _, v := range values {
+			if len(v) < 8 {
+				continue
+			}
+			t, err := time.Parse("20060102", v[:8])
+			if err == nil && (earliest.IsZero() || t.Before(earliest)) {
+				earliest = t
+			}
+		}
+	}
+	if earliest.IsZero() {
+		return 0
+	}
+	base, _ := time.Parse("20060102", dummyDate)
+	return int(base.Sub(earliest).Hours() / 24)
+}
+
+// skip excludes e from verification, used for the elements an attribute rule decided on
+func (g *functionalGroups) skip(e *dicom.Element) {
+	if g != nil {
+		delete(g.original, e)
+	}
+}
+
+// verify checks that every recorded date still present in ds was moved by exactly days, all of
+// them when all is set and only the frame timing attributes otherwise, so that frame timing stays
+// consistent with the rest of the instance
+func (g *functionalGroups) verify(ds *dicom.Dataset, days int, all bool) error {
+	if g == nil {
+		return nil
+	}
+	return ds.Walk(func(parent *dicom.Dataset, e *dicom.Element, path string) error {
+		values, ok := g.original[e]
+		if !ok || (!all && !frameTemporalAttributes[e.Tag]) || birthDates[e.Tag] {
+			return nil
+		}
+		want := &dicom.Element{Tag: e.Tag, VR: e.VR}
+		want.SetStrings(values...)
+		shiftDates(want, days)
+		if got, expected := strings.Join(e.Strings(), `\`), strings.Join(want.Strings(), `\`); got != expected {
+			return fmt.Errorf("%s: functional group value %q was not shifted to %q", path, got, expected)
+		}
+		return nil
+	})
+}
@@ -0,0 +1,104 @@
+package deid_test
+
+import (
+	"context"
+	"testing"
+
+	dcmd "gitlab.com/medical-research/dicom-deidentifier"
+	"gitlab.com/medical-research/dicom-deidentifier/dicom"
+	"gitlab.com/medical-research/dicom-deidentifier/mock"
+)
+
+// newTestEnhancedInstance returns an enhanced multi-frame instance whose two frames were acquired a day apart
+func newTestEnhancedInstance() *dicom.File {
+	f := newTestInstance("1.3.6.1.4.1.5962.1.1.1", "")
+	frame := func(acquired string) *dicom.Dataset {
+		return dicom.NewDataset(
+			dicom.NewSequence(dicom.FrameContentSequence, dicom.NewDataset(
+				dicom.NewElement(dicom.FrameAcquisitionDateTime, dicom.DT, acquired),
+				dicom.NewElement(dicom.FrameReferenceDateTime, dicom.DT, acquired),
+			)),
+			dicom.NewSequence(dicom.Tag(0x00089124), dicom.NewDataset( // Derivation Image Sequence
+				dicom.NewSequence(dicom.Tag(0x00082112), dicom.NewDataset( // Source Image Sequence
+					dicom.NewElement(dicom.ReferencedSOPClassUID, dicom.UI, "1.2.840.10008.5.1.4.1.1.4.1"),
+					dicom.NewElement(dicom.ReferencedSOPInstanceUID, dicom.UI, "1.3.6.1.4.1.5962.1.1.9"),
+				)),
+			)),
+		)
+	}
+	f.Dataset.Set(dicom.NewSequence(dicom.SharedFunctionalGroupsSequence, dicom.NewDataset()))
+	f.Dataset.Set(dicom.NewSequence(dicom.PerFrameFunctionalGroupsSequence,
+		frame("20200115093000.500000"),
+		frame("20200116093010"),
+	))
+	return f
+}
+
+func TestDeidentificationService_DeidentifyFile_FunctionalGroups(t *testing.T) {
+	s := newTestService(t)
+	s.DateShifter = &mock.DateShifter{
+		PatientDateShiftFn: func(ctx context.Context, issuer, patientID string) (int, error) {
+			return -10, nil
+		},
+	}
+
+	tests := []struct {
+		name    string
+		profile *dcmd.Profile
+		want    []string
+	}{
+		{
+			name:    "dates shifted",
+			profile: &dcmd.Profile{Name: "longitudinal", Options: []string{dcmd.RetainLongitudinalModifiedDatesOption}},
+			want:    []string{"20200105093000.500000", "20200106093010"},
+		},
+		{
+			name:    "frame timing rebased",
+			profile: &dcmd.Profile{Name: "basic"},
+			want:    []string{"19000101093000.500000", "19000102093010"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newTestEnhancedInstance()
+			if err := s.DeidentifyFile(context.Background(), tt.profile, nil, f); err != nil {
+				t.Fatalf("DeidentifyFile() error = %v", err)
+			}
+
+			frames := f.Dataset.Get(dicom.PerFrameFunctionalGroupsSequence).Items
+			for i, frame := range frames {
+				content := frame.Get(dicom.FrameContentSequence).Items[0]
+				if got := content.GetString(dicom.FrameAcquisitionDateTime); got != tt.want[i] {
+					t.Errorf("frame %d FrameAcquisitionDateTime = %q, want %q", i+1, got, tt.want[i])
+				}
+				if got := content.GetString(dicom.FrameReferenceDateTime); got != tt.want[i] {
+					t.Errorf("frame %d FrameReferenceDateTime = %q, want %q", i+1, got, tt.want[i])
+				}
+				source := frame.Get(dicom.Tag(0x00089124)).Items[0].Get(dicom.Tag(0x00082112)).Items[0]
+				if got := source.GetString(dicom.ReferencedSOPInstanceUID); got == "1.3.6.1.4.1.5962.1.1.9" {
+					t.Errorf("frame %d ReferencedSOPInstanceUID was not remapped", i+1)
+				}
+			}
+		})
+	}
+}
+
+func TestDeidentificationService_DeidentifyFile_FunctionalGroupsRule(t *testing.T) {
+	s := newTestService(t)
+
+	f := newTestEnhancedInstance()
+	profile := &dcmd.Profile{Name: "rules", Rules: []*dcmd.AttributeRule{
+		{Tag: "FrameAcquisitionDateTime", Action: dcmd.RuleKeep},
+	}}
+	if err := s.DeidentifyFile(context.Background(), profile, nil, f); err != nil {
+		t.Fatalf("DeidentifyFile() error = %v", err)
+	}
+
+	want := []string{"20200115093000.500000", "20200116093010"}
+	for i, frame := range f.Dataset.Get(dicom.PerFrameFunctionalGroupsSequence).Items {
+		content := frame.Get(dicom.FrameContentSequence).Items[0]
+		if got := content.GetString(dicom.FrameAcquisitionDateTime); got != want[i] {
+			t.Errorf("frame %d FrameAcquisitionDateTime = %q, want %q", i+1, got, want[i])
+		}
+	}
+}
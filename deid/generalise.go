@@ -0,0 +1,195 @@
+package deid
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	dcmd "gitlab.com/medical-research/dicom-deidentifier"
+	"gitlab.com/medical-research/dicom-deidentifier/dicom"
+)
+
+// quasiIdentifiers holds the original values of the quasi-identifiers an instance keeps in a
+// coarsened form, recorded before the walk removes them
+type quasiIdentifiers struct {
+	rules *dcmd.Generalisation
+
+	age       string
+	birthDate string
+	studyDate string
+	size      string
+	weight    string
+}
+
+// newQuasiIdentifiers records the quasi-identifiers of ds, it returns nil when the profile
+// does not generalise them
+func newQuasiIdentifiers(ds *dicom.Dataset, rules *dcmd.Generalisation) *quasiIdentifiers {
+	if rules == nil {
+		return nil
+	}
+	return &quasiIdentifiers{
+		rules:     rules,
+		age:       ds.GetString(dicom.PatientAge),
+		birthDate: ds.GetString(dicom.PatientBirthDate),
+		studyDate: ds.GetString(dicom.StudyDate),
+		size:      ds.GetString(dicom.PatientSize),
+		weight:    ds.GetString(dicom.PatientWeight),
+	}
+}
+
+// apply sets the generalised quasi-identifiers in the de-identified dataset. The birth date is
+// shifted by dateShift days before being reduced to its year, so that it stays consistent with
+// the other dates of the instance.
+func (q *quasiIdentifiers) apply(ds *dicom.Dataset, dateShift int, report *dcmd.JobReport) {
+	if q == nil {
+		return
+	}
+	maxAge := q.rules.MaxAgeOrDefault()
+	years, known := q.ageInYears()
+	aggregated := known && years > maxAge
+	if aggregated {
+		report.AddAggregatedAge()
+	}
+
+	if q.rules.AgeBucket > 0 {
+		age := q.age
+		if _, _, ok := parseAge(age); !ok && q.rules.DeriveAge {
+			age = q.derivedAge()
+		}
+		if age != "" {
+			ds.Set(dicom.NewElement(dicom.PatientAge, dicom.AS, generaliseAge(age, q.rules.AgeBucket, maxAge)))
+			report.AddGeneralised("PatientAge")
+		}
+	}
+
+	if q.rules.BirthYear && !aggregated {
+		if date := shiftDate(q.birthDate, dateShift); date != "" {
+			ds.Set(dicom.NewElement(dicom.PatientBirthDate, dicom.DA, date[:4]+"0101"))
+			report.AddGeneralised("PatientBirthDate")
+		}
+	}
+
+	for _, r := range []struct {
+		tag   dicom.Tag
+		value string
+		step  float64
+	}{
+		{dicom.PatientSize, q.size, q.rules.SizeStep},
+		{dicom.PatientWeight, q.weight, q.rules.WeightStep},
+	} {
+		if r.step <= 0 || r.value == "" {
+			continue
+		}
+		if v, ok := roundToStep(r.value, r.step); ok {
+			ds.Set(dicom.NewElement(r.tag, dicom.DS, v))
+			report.AddGeneralised(dicom.Keyword(r.tag))
+		}
+	}
+}
+
+// ageInYears returns the age of the patient in whole years, from Patient's Age or else from the
+// birth date. Birth dates without a study date are measured against today, so that the ages
+// of patients too old to keep their birth year are always known.
+func (q *quasiIdentifiers) ageInYears() (int, bool) {
+	if n, unit, ok := parseAge(q.age); ok {
+		return ageYears(n, unit), true
+	}
+	n, unit, ok := parseAge(q.derivedAge())
+	if !ok {
+		return 0, false
+	}
+	return ageYears(n, unit), true
+}
+
+// derivedAge returns the age of the patient at the study as an AS value, or "" when the birth
+// date is unknown
+func (q *quasiIdentifiers) derivedAge() string {
+	birth, err := time.Parse("20060102", q.birthDate)
+	if err != nil {
+		return ""
+	}
+	ref, err := time.Parse("20060102", q.studyDate)
+	if err != nil {
+		ref = time.Now().UTC()
+	}
+	if ref.Before(birth) {
+		return ""
+	}
+
+	months := (ref.Year()-birth.Year())*12 + int(ref.Month()-birth.Month())
+	if ref.Day() < birth.Day() {
+		months--
+	}
+	switch {
+	case months >= 12:
+		return formatAge(months/12, 'Y')
+	case months >= 1:
+		return formatAge(months, 'M')
+	}
+	return formatAge(int(ref.Sub(birth).Hours()/24), 'D')
+}
+
+// generaliseAge reduces an AS value to the start of its range of bucket years, or to maxAge+1 for
+// older patients. Ages kept in whole years with a bucket of 1 are left as they are.
+func generaliseAge(age string, bucket, maxAge int) string {
+	n, unit, ok := parseAge(age)
+	if !ok {
+		return ""
+	}
+	years := ageYears(n, unit)
+	switch {
+	case years > maxAge:
+		return formatAge(maxAge+1, 'Y')
+	case bucket > 1:
+		return formatAge(years/bucket*bucket, 'Y')
+	}
+	return age
+}
+
+// parseAge parses an AS value such as "045Y"
+func parseAge(age string) (int, byte, bool) {
+	if len(age) != 4 || !strings.ContainsRune("DWMY", rune(age[3])) {
+		return 0, 0, false
+	}
+	n, err := strconv.Atoi(age[:3])
+	if err != nil || n < 0 {
+		return 0, 0, false
+	}
+	return n, age[3], true
+}
+
+// ageYears converts an age in days, weeks, months or years to whole years
+func ageYears(n int, unit byte) int {
+	switch unit {
+	case 'D':
+		return n / 365
+	case 'W':
+		return n / 52
+	case 'M':
+		return n / 12
+	}
+	return n
+}
+
+// formatAge returns the AS value of an age, capped at the largest value AS can hold
+func formatAge(n int, unit byte) string {
+	if n > 999 {
+		n = 999
+	}
+	return fmt.Sprintf("%03d%c", n, unit)
+}
+
+// roundToStep rounds a DS value to the nearest multiple of step, written with as many decimals as the step
+func roundToStep(value string, step float64) (string, bool) {
+	v, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+	if err != nil {
+		return "", false
+	}
+	decimals := 0
+	if s := strconv.FormatFloat(step, 'f', -1, 64); strings.Contains(s, ".") {
+		decimals = len(s) - strings.Index(s, ".") - 1
+	}
+	return strconv.FormatFloat(math.Round(v/step)*step, 'f', decimals, 64), true
+}
@@ -0,0 +1,84 @@
+package deid_test
+
+import (
+	"context"
+	"testing"
+
+	dcmd "gitlab.com/medical-research/dicom-deidentifier"
+	"gitlab.com/medical-research/dicom-deidentifier/dicom"
+)
+
+func TestDeidentificationService_DeidentifyFile_Generalisation(t *testing.T) {
+	s := newTestService(t)
+
+	tests := []struct {
+		name           string
+		rules          *dcmd.Generalisation
+		age, birthDate string
+		want           map[dicom.Tag]string
+		wantAggregated int
+	}{
+		{
+			name:      "age bucketed, birth date reduced to year, size and weight rounded",
+			rules:     &dcmd.Generalisation{AgeBucket: 5, BirthYear: true, SizeStep: 0.05, WeightStep: 5},
+			age:       "042Y",
+			birthDate: "19780412",
+			want: map[dicom.Tag]string{
+				dicom.PatientAge:       "040Y",
+				dicom.PatientBirthDate: "19780101",
+				dicom.PatientSize:      "1.75",
+				dicom.PatientWeight:    "70",
+			},
+		},
+		{
+			name:      "age derived from the birth date",
+			rules:     &dcmd.Generalisation{AgeBucket: 1, DeriveAge: true},
+			birthDate: "19780412",
+			want:      map[dicom.Tag]string{dicom.PatientAge: "041Y", dicom.PatientBirthDate: "", dicom.PatientSize: ""},
+		},
+		{
+			name:      "ages over 89 aggregated and their birth date removed",
+			rules:     &dcmd.Generalisation{AgeBucket: 10, BirthYear: true},
+			age:       "093Y",
+			birthDate: "19270301",
+			want:      map[dicom.Tag]string{dicom.PatientAge: "090Y", dicom.PatientBirthDate: ""},
+
+			wantAggregated: 1,
+		},
+		{
+			name:      "without rules the basic profile applies",
+			age:       "042Y",
+			birthDate: "19780412",
+			want:      map[dicom.Tag]string{dicom.PatientAge: "", dicom.PatientBirthDate: "", dicom.PatientWeight: ""},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newTestInstance("1.3.6.1.4.1.5962.1.1.1", "")
+			f.Dataset.SetString(dicom.StudyDate, "20200110")
+			f.Dataset.SetString(dicom.PatientBirthDate, tt.birthDate)
+			f.Dataset.SetString(dicom.PatientSize, "1.76")
+			f.Dataset.SetString(dicom.PatientWeight, "71.5")
+			if tt.age != "" {
+				f.Dataset.Set(dicom.NewElement(dicom.PatientAge, dicom.AS, tt.age))
+			}
+
+			report := dcmd.NewJobReport()
+			profile := &dcmd.Profile{Name: "generalised", Generalisation: tt.rules}
+			if err := s.DeidentifyFile(context.Background(), profile, report, f); err != nil {
+				t.Fatalf("DeidentifyFile() error = %v", err)
+			}
+			for tag, want := range tt.want {
+				if got := f.Dataset.GetString(tag); got != want {
+					t.Errorf("%s = %q, want %q", dicom.Keyword(tag), got, want)
+				}
+				if want != "" && report.Generalised[dicom.Keyword(tag)] != 1 {
+					t.Errorf("Generalised[%s] = %d, want 1", dicom.Keyword(tag), report.Generalised[dicom.Keyword(tag)])
+				}
+			}
+			if report.AggregatedAges != tt.wantAggregated {
+				t.Errorf("AggregatedAges = %d, want %d", report.AggregatedAges, tt.wantAggregated)
+			}
+		})
+	}
+}
@@ -0,0 +1,470 @@
+// Command tablegen maintains the Basic Profile action table of PS3.15 Table E.1-1.
+//
+// The table is kept as CSV data next to the profile engine and compiled into a Go map, so that
+// the edition the engine follows is recorded and the changes between editions can be reviewed.
+//
+// Usage:
+//
+//	tablegen -table basic_profile.csv -out basic_profile_table.go
+//	tablegen -xml part15.xml -table basic_profile.csv -out basic_profile_table.go
+//	tablegen -compare old.csv -table basic_profile.csv
+//
+// With -xml, Table E.1-1 is extracted from the DocBook source of PS3.15, given as a file or a
+// URL, the actions that changed from the current table are reported on standard output and the
+// table is replaced. With -compare, the changes from an older table are reported and nothing is
+// written. The Go map is always generated from the table.
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/xml"
+	"flag"
+	"fmt"
+	"go/format"
+	"html"
+	"io"
+	"net/http"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// columns are the CSV headings of the action columns, in the order of Table E.1-1
+var columns = []string{
+	"basic-profile",
+	"retain-safe-private",
+	"retain-uids",
+	"retain-device-identity",
+	"retain-institution-identity",
+	"retain-patient-characteristics",
+	"retain-longitudinal-full-dates",
+	"retain-longitudinal-modified-dates",
+	"clean-descriptors",
+	"clean-structured-content",
+	"clean-graphics",
+}
+
+// goColumns are the constants of the deid package indexing the option columns, columns[1:]
+var goColumns = []string{
+	"columnRetainSafePrivate",
+	"columnRetainUIDs",
+	"columnRetainDeviceIdentity",
+	"columnRetainInstitutionIdentity",
+	"columnRetainPatientCharacteristics",
+	"columnRetainLongitudinalFullDates",
+	"columnRetainLongitudinalModifiedDates",
+	"columnCleanDescriptors",
+	"columnCleanStructuredContent",
+	"columnCleanGraphics",
+}
+
+// headings are the abbreviated headings of the action columns in the standard
+var headings = []string{
+	"Basic Prof.",
+	"Rtn. Safe Priv. Opt.",
+	"Rtn. UIDs Opt.",
+	"Rtn. Dev. Id. Opt.",
+	"Rtn. Inst. Id. Opt.",
+	"Rtn. Pat. Chars. Opt.",
+	"Rtn. Long. Full Dates Opt.",
+	"Rtn. Long. Modif. Dates Opt.",
+	"Clean Desc. Opt.",
+	"Clean Struct. Cont. Opt.",
+	"Clean Graph. Opt.",
+}
+
+// tableID is the xml:id of Table E.1-1 in the DocBook source of PS3.15
+const tableID = "table_E.1-1"
+
+// editionPrefix starts the line of the CSV table recording the edition of the standard
+const editionPrefix = "# edition "
+
+var (
+	// tagPattern matches the tags of the table, repeating groups being written "60xx"
+	tagPattern = regexp.MustCompile(`^\(([0-9A-F]{2}(?:[0-9A-F]{2}|xx)),([0-9A-F]{4})\)$`)
+
+	// editionPattern matches the edition in the subtitle of the standard, e.g. "DICOM PS3.15 2024b"
+	editionPattern = regexp.MustCompile(`\b(\d{4}[a-z])\b`)
+
+	// markup matches the elements within a cell
+	markup = regexp.MustCompile(`<[^>]*>`)
+)
+
+// row is a single attribute of the table
+type row struct {
+	tag     string
+	name    string
+	actions []string
+}
+
+// table is Table E.1-1 of an edition of the standard
+type table struct {
+	edition string
+	rows    []*row
+}
+
+func main() {
+	if err := run(os.Args[1:]); err != nil {
+		fmt.Fprintln(os.Stderr, "tablegen:", err)
+		os.Exit(1)
+	}
+}
+
+// run parses the command line, refreshes the table when a source is given and generates the Go map
+func run(args []string) error {
+	fs := flag.NewFlagSet("tablegen", flag.ContinueOnError)
+	source := fs.String("xml", "", "DocBook source of PS3.15, as a file or URL")
+	path := fs.String("table", "basic_profile.csv", "CSV table")
+	out := fs.String("out", "basic_profile_table.go", "generated Go file")
+	compare := fs.String("compare", "", "older CSV table to report the changes from")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	current, err := readTableFile(*path)
+	if err != nil {
+		return err
+	}
+	if *compare != "" {
+		old, err := readTableFile(*compare)
+		if err != nil {
+			return err
+		}
+		return writeReport(os.Stdout, old, current)
+	}
+
+	if *source != "" {
+		updated, err := readStandard(*source)
+		if err != nil {
+			return err
+		}
+		if err := writeReport(os.Stdout, current, updated); err != nil {
+			return err
+		}
+		var buf bytes.Buffer
+		if err := writeTable(&buf, updated); err != nil {
+			return err
+		}
+		if err := os.WriteFile(*path, buf.Bytes(), 0644); err != nil {
+			return err
+		}
+		current = updated
+	}
+
+	src, err := generate(current, *path)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(*out, src, 0644)
+}
+
+// readTableFile reads the CSV table at path
+func readTableFile(path string) (*table, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	t, err := readTable(f)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", path, err)
+	}
+	return t, nil
+}
+
+// readTable reads a CSV table, the edition being given by an optional first line "# edition 2024b"
+func readTable(r io.Reader) (*table, error) {
+	br := bufio.NewReader(r)
+	t := &table{}
+	if b, err := br.Peek(len(editionPrefix)); err == nil && string(b) == editionPrefix {
+		line, err := br.ReadString('\n')
+		if err != nil {
+			return nil, err
+		}
+		t.edition = strings.TrimSpace(strings.TrimPrefix(line, editionPrefix))
+	}
+
+	records, err := csv.NewReader(br).ReadAll()
+	if err != nil {
+		return nil, err
+	} else if len(records) == 0 || strings.Join(records[0], ",") != "tag,name,"+strings.Join(columns, ",") {
+		return nil, fmt.Errorf("unexpected header, want tag,name,%s", strings.Join(columns, ","))
+	}
+	for i, record := range records[1:] {
+		if !tagPattern.MatchString(record[0]) {
+			return nil, fmt.Errorf("line %d: invalid tag %q", i+2, record[0])
+		}
+		t.rows = append(t.rows, &row{tag: record[0], name: record[1], actions: record[2:]})
+	}
+	return t, nil
+}
+
+// writeTable writes a table as CSV
+func writeTable(w io.Writer, t *table) error {
+	if t.edition != "" {
+		if _, err := fmt.Fprintf(w, "%s%s\n", editionPrefix, t.edition); err != nil {
+			return err
+		}
+	}
+	cw := csv.NewWriter(w)
+	cw.Write(append([]string{"tag", "name"}, columns...))
+	for _, r := range t.rows {
+		cw.Write(append([]string{r.tag, r.name}, r.actions...))
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// docbookCell is a th or td cell of a DocBook table
+type docbookCell struct {
+	XMLName xml.Name
+	Inner   string `xml:",innerxml"`
+}
+
+// text returns the text of the cell with its markup removed and spaces collapsed
+func (c *docbookCell) text() string {
+	return strings.Join(strings.Fields(html.UnescapeString(markup.ReplaceAllString(c.Inner, " "))), " ")
+}
+
+// docbookTable is a DocBook table, the cells of a row being either th or td
+type docbookTable struct {
+	Head []struct {
+		Cells []*docbookCell `xml:",any"`
+	} `xml:"thead>tr"`
+	Body []struct {
+		Cells []*docbookCell `xml:",any"`
+	} `xml:"tbody>tr"`
+}
+
+// readStandard reads Table E.1-1 and the edition from the DocBook source of PS3.15
+func readStandard(source string) (*table, error) {
+	var r io.Reader
+	if strings.HasPrefix(source, "http://") || strings.HasPrefix(source, "https://") {
+		resp, err := http.Get(source)
+		if err != nil {
+			return nil, err
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			return nil, fmt.Errorf("%s: %s", source, resp.Status)
+		}
+		r = resp.Body
+	} else {
+		f, err := os.Open(source)
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+		r = f
+	}
+	t, err := parseStandard(r)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", source, err)
+	}
+	return t, nil
+}
+
+// parseStandard parses the DocBook source of PS3.15
+func parseStandard(r io.Reader) (*table, error) {
+	t := &table{}
+	dec := xml.NewDecoder(r)
+	for {
+		tok, err := dec.Token()
+		if err == io.EOF {
+			return nil, fmt.Errorf("table %s not found", tableID)
+		} else if err != nil {
+			return nil, err
+		}
+		start, ok := tok.(xml.StartElement)
+		if !ok {
+			continue
+		}
+
+		switch {
+		case start.Name.Local == "subtitle" && t.edition == "":
+			var subtitle string
+			if err := dec.DecodeElement(&subtitle, &start); err != nil {
+				return nil, err
+			}
+			if m := editionPattern.FindStringSubmatch(subtitle); m != nil {
+				t.edition = m[1]
+			}
+
+		case start.Name.Local == "table" && attr(start, "id") == tableID:
+			var dt docbookTable
+			if err := dec.DecodeElement(&dt, &start); err != nil {
+				return nil, err
+			}
+			if t.edition == "" {
+				return nil, fmt.Errorf("edition not found")
+			}
+			rows, err := tableRows(&dt)
+			if err != nil {
+				return nil, err
+			}
+			t.rows = rows
+			return t, nil
+		}
+	}
+}
+
+// attr returns the value of the attribute of an element with the given local name
+func attr(start xml.StartElement, name string) string {
+	for _, a := range start.Attr {
+		if a.Name.Local == name {
+			return a.Value
+		}
+	}
+	return ""
+}
+
+// tableRows returns the rows of Table E.1-1, locating the action columns by their heading. Rows
+// whose tag does not name attributes, such as "(gggg,eeee) where gggg is odd", are skipped.
+func tableRows(dt *docbookTable) ([]*row, error) {
+	if len(dt.Head) == 0 {
+		return nil, fmt.Errorf("table has no heading")
+	}
+	index := map[string]int{}
+	for i, c := range dt.Head[0].Cells {
+		index[c.text()] = i
+	}
+	name, ok := index["Attribute Name"]
+	if !ok {
+		return nil, fmt.Errorf("no Attribute Name column")
+	}
+	tag, ok := index["Tag"]
+	if !ok {
+		return nil, fmt.Errorf("no Tag column")
+	}
+	positions := make([]int, len(headings))
+	for i, h := range headings {
+		if positions[i], ok = index[h]; !ok {
+			return nil, fmt.Errorf("no %s column", h)
+		}
+	}
+
+	var rows []*row
+	for _, tr := range dt.Body {
+		cell := func(i int) string {
+			if i < len(tr.Cells) {
+				return tr.Cells[i].text()
+			}
+			return ""
+		}
+		r := &row{tag: strings.ToUpper(cell(tag)), name: cell(name)}
+		r.tag = strings.Replace(r.tag, "XX,", "xx,", 1)
+		if !tagPattern.MatchString(r.tag) {
+			fmt.Fprintf(os.Stderr, "tablegen: skipping %s %s\n", cell(tag), r.name)
+			continue
+		}
+		for _, p := range positions {
+			r.actions = append(r.actions, cell(p))
+		}
+		rows = append(rows, r)
+	}
+	sort.Slice(rows, func(i, j int) bool { return rows[i].tag < rows[j].tag })
+	return rows, nil
+}
+
+// writeReport writes the attributes added, removed and whose actions changed from old to updated
+func writeReport(w io.Writer, old, updated *table) error {
+	fmt.Fprintf(w, "Table E.1-1 changes from edition %s to %s\n", editionName(old), editionName(updated))
+
+	rows := map[string]*row{}
+	for _, r := range old.rows {
+		rows[r.tag] = r
+	}
+	changes := 0
+	for _, r := range updated.rows {
+		o, ok := rows[r.tag]
+		delete(rows, r.tag)
+		if !ok {
+			fmt.Fprintf(w, "  added   %s %s: %s\n", r.tag, r.name, describe(r.actions))
+			changes++
+			continue
+		}
+		var diffs []string
+		for i, a := range r.actions {
+			if a != o.actions[i] {
+				diffs = append(diffs, fmt.Sprintf("%s %s -> %s", columns[i], quote(o.actions[i]), quote(a)))
+			}
+		}
+		if len(diffs) > 0 {
+			fmt.Fprintf(w, "  changed %s %s: %s\n", r.tag, r.name, strings.Join(diffs, ", "))
+			changes++
+		}
+	}
+	for _, r := range old.rows {
+		if _, ok := rows[r.tag]; ok {
+			fmt.Fprintf(w, "  removed %s %s\n", r.tag, r.name)
+			changes++
+		}
+	}
+	_, err := fmt.Fprintf(w, "%d attributes changed\n", changes)
+	return err
+}
+
+// editionName returns the edition of a table for reports
+func editionName(t *table) string {
+	if t.edition == "" {
+		return "unknown"
+	}
+	return t.edition
+}
+
+// describe returns the actions of a row that are set, for reports
+func describe(actions []string) string {
+	var s []string
+	for i, a := range actions {
+		if a != "" {
+			s = append(s, columns[i]+" "+a)
+		}
+	}
+	return strings.Join(s, ", ")
+}
+
+// quote returns an action for reports, blank actions being shown as "-"
+func quote(action string) string {
+	if action == "" {
+		return "-"
+	}
+	return action
+}
+
+// generate returns the Go source of the table map of the deid package
+func generate(t *table, path string) ([]byte, error) {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by tablegen from %s; DO NOT EDIT.\n\n", path)
+	fmt.Fprintf(&buf, "package deid\n\n")
+	fmt.Fprintf(&buf, "import \"gitlab.com/medical-research/dicom-deidentifier/dicom\"\n\n")
+	fmt.Fprintf(&buf, "// BasicProfileEdition is the edition of PS3.15 the Basic Profile table was generated from.\n")
+	fmt.Fprintf(&buf, "// It is empty when the table was not generated from the standard.\n")
+	fmt.Fprintf(&buf, "const BasicProfileEdition = %q\n\n", t.edition)
+	fmt.Fprintf(&buf, "// basicProfile holds the actions of PS3.15 Table E.1-1. Repeating groups are registered under\n")
+	fmt.Fprintf(&buf, "// their base group and compound actions such as \"X/Z/D\" are resolved by resolveAction.\n")
+	fmt.Fprintf(&buf, "var basicProfile = map[dicom.Tag]tableEntry{\n")
+	for _, r := range t.rows {
+		m := tagPattern.FindStringSubmatch(r.tag)
+		group := strings.Replace(m[1], "xx", "00", 1)
+		if _, err := strconv.ParseUint(group+m[2], 16, 32); err != nil {
+			return nil, fmt.Errorf("invalid tag %s", r.tag)
+		}
+		var options []string
+		for i, a := range r.actions[1:] {
+			if a != "" {
+				options = append(options, fmt.Sprintf("%s: %q", goColumns[i], a))
+			}
+		}
+		fmt.Fprintf(&buf, "0x%s%s: {name: %q, basic: %q", group, m[2], r.name, r.actions[0])
+		if len(options) > 0 {
+			fmt.Fprintf(&buf, ", options: [optionColumns]string{%s}", strings.Join(options, ", "))
+		}
+		fmt.Fprintf(&buf, "},\n")
+	}
+	fmt.Fprintf(&buf, "}\n")
+	return format.Source(buf.Bytes())
+}
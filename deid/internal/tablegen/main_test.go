@@ -0,0 +1,95 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+const testStandard = `<?xml version="1.0" encoding="utf-8"?>
+<book xmlns="http://docbook.org/ns/docbook" xmlns:xl="http://www.w3.org/1999/xlink">
+<info><subtitle>DICOM PS3.15 2024b - Security and System Management Profiles</subtitle></info>
+<chapter><section>
+<table xml:id="table_E.1-1">
+<caption>Application Level Confidentiality Profile Attributes</caption>
+<thead><tr>
+<th><para><emphasis role="bold">Attribute Name</emphasis></para></th>
+<th><para><emphasis role="bold">Tag</emphasis></para></th>
+<th><para><emphasis role="bold">Retd. (from PS3.6)</emphasis></para></th>
+<th><para><emphasis role="bold">In Std. Comp. IOD (from PS3.3)</emphasis></para></th>
+<th><para><emphasis role="bold">Basic Prof.</emphasis></para></th>
+<th><para><emphasis role="bold">Rtn. Safe Priv. Opt.</emphasis></para></th>
+<th><para><emphasis role="bold">Rtn. UIDs Opt.</emphasis></para></th>
+<th><para><emphasis role="bold">Rtn. Dev. Id. Opt.</emphasis></para></th>
+<th><para><emphasis role="bold">Rtn. Inst. Id. Opt.</emphasis></para></th>
+<th><para><emphasis role="bold">Rtn. Pat. Chars. Opt.</emphasis></para></th>
+<th><para><emphasis role="bold">Rtn. Long. Full Dates Opt.</emphasis></para></th>
+<th><para><emphasis role="bold">Rtn. Long. Modif. Dates Opt.</emphasis></para></th>
+<th><para><emphasis role="bold">Clean Desc. Opt.</emphasis></para></th>
+<th><para><emphasis role="bold">Clean Struct. Cont. Opt.</emphasis></para></th>
+<th><para><emphasis role="bold">Clean Graph. Opt.</emphasis></para></th>
+</tr></thead>
+<tbody>
+<tr><td><para>Patient&apos;s Age</para></td><td><para>(0010,1010)</para></td><td><para>N</para></td><td><para>Y</para></td><td><para>X</para></td><td/><td/><td/><td/><td><para>K</para></td><td/><td/><td/><td/><td/></tr>
+<tr><td><para>Overlay Data</para></td><td><para>(60xx,3000)</para></td><td><para>N</para></td><td><para>Y</para></td><td><para>X</para></td><td/><td/><td/><td/><td/><td/><td/><td/><td/><td><para>C</para></td></tr>
+<tr><td><para>Private attributes</para></td><td><para>(gggg,eeee) where gggg is odd</para></td><td/><td/><td><para>X</para></td><td><para>C</para></td><td/><td/><td/><td/><td/><td/><td/><td/><td/></tr>
+<tr><td><para>Accession Number</para></td><td><para>(0008,0050)</para></td><td><para>N</para></td><td><para>Y</para></td><td><para>Z</para></td><td/><td/><td/><td/><td/><td/><td/><td/><td/><td/></tr>
+</tbody>
+</table>
+</section></chapter>
+</book>`
+
+const testTable = `tag,name,basic-profile,retain-safe-private,retain-uids,retain-device-identity,retain-institution-identity,retain-patient-characteristics,retain-longitudinal-full-dates,retain-longitudinal-modified-dates,clean-descriptors,clean-structured-content,clean-graphics
+"(0008,0050)",Accession Number,Z,,,,,,,,,,
+"(0010,1010)",Patient's Age,X,,,,,,,,,,
+"(0010,2160)",Ethnic Group,X,,,,,,,,,,
+`
+
+func TestParseStandard(t *testing.T) {
+	updated, err := parseStandard(strings.NewReader(testStandard))
+	if err != nil {
+		t.Fatalf("parseStandard() error = %v", err)
+	}
+	if updated.edition != "2024b" {
+		t.Errorf("edition = %q, want 2024b", updated.edition)
+	}
+
+	var csv strings.Builder
+	if err := writeTable(&csv, updated); err != nil {
+		t.Fatalf("writeTable() error = %v", err)
+	}
+	want := "# edition 2024b\n" + strings.SplitAfter(testTable, "\n")[0] +
+		`"(0008,0050)",Accession Number,Z,,,,,,,,,,` + "\n" +
+		`"(0010,1010)",Patient's Age,X,,,,,K,,,,,` + "\n" +
+		`"(60xx,3000)",Overlay Data,X,,,,,,,,,,C` + "\n"
+	if csv.String() != want {
+		t.Errorf("writeTable() =\n%s\nwant\n%s", csv.String(), want)
+	}
+
+	old, err := readTable(strings.NewReader(testTable))
+	if err != nil {
+		t.Fatalf("readTable() error = %v", err)
+	}
+	var report strings.Builder
+	if err := writeReport(&report, old, updated); err != nil {
+		t.Fatalf("writeReport() error = %v", err)
+	}
+	for _, line := range []string{
+		"from edition unknown to 2024b",
+		"changed (0010,1010) Patient's Age: retain-patient-characteristics - -> K",
+		"added   (60xx,3000) Overlay Data: basic-profile X, clean-graphics C",
+		"removed (0010,2160) Ethnic Group",
+		"3 attributes changed",
+	} {
+		if !strings.Contains(report.String(), line) {
+			t.Errorf("report missing %q:\n%s", line, report.String())
+		}
+	}
+
+	src, err := generate(updated, "basic_profile.csv")
+	if err != nil {
+		t.Fatalf("generate() error = %v", err)
+	}
+	if !strings.Contains(string(src), `0x60003000: {name: "Overlay Data", basic: "X", options: [optionColumns]string{columnCleanGraphics: "C"}},`) {
+		t.Errorf("generate() missing overlay entry:\n%s", src)
+	}
+}
@@ -0,0 +1,234 @@
+package deid
+
+import (
+	"sort"
+	"strings"
+
+	dcmd "gitlab.com/medical-research/dicom-deidentifier"
+	"gitlab.com/medical-research/dicom-deidentifier/dicom"
+)
+
+// KAnonymityAssessor computes the equivalence classes of a de-identified cohort over its
+// quasi-identifiers. Instances are added one at a time and grouped by study, the values of
+// every series of a study being combined, e.g. a study of CT and PT series has modality "CT/PT".
+type KAnonymityAssessor struct {
+	k        int
+	keywords []string
+	tags     []dicom.Tag
+
+	studies map[string]*kRecord
+
+	// unlinked is set once a study without a Patient ID is added, classes are then sized by study
+	unlinked bool
+}
+
+// kRecord is a single study of the cohort
+type kRecord struct {
+	study   string
+	patient string
+	values  []map[string]bool
+}
+
+// NewKAnonymityAssessor returns a new instance of KAnonymityAssessor.
+// Returns EINVALID if a quasi-identifier is not a keyword of the dictionary.
+func NewKAnonymityAssessor(config *dcmd.KAnonymity) (*KAnonymityAssessor, error) {
+	a := &KAnonymityAssessor{
+		k:        config.KOrDefault(),
+		keywords: config.QuasiIdentifiersOrDefault(),
+		studies:  map[string]*kRecord{},
+	}
+	for _, keyword := range a.keywords {
+		tag, ok := dicom.TagForKeyword(keyword)
+		if !ok {
+			return nil, dcmd.Errorf(dcmd.EINVALID, "unknown quasi-identifier %q", keyword)
+		}
+		a.tags = append(a.tags, tag)
+	}
+	return a, nil
+}
+
+// Add records the quasi-identifiers of a de-identified instance
+func (a *KAnonymityAssessor) Add(ds *dicom.Dataset) {
+	study := ds.GetString(dicom.StudyInstanceUID)
+	r, ok := a.studies[study]
+	if !ok {
+		r = &kRecord{study: study, patient: ds.GetString(dicom.PatientID)}
+		if r.patient == "" {
+			// studies cannot be linked to their patients, counting each as a patient would make them up
+			a.unlinked = true
+		}
+		for range a.tags {
+			r.values = append(r.values, map[string]bool{})
+		}
+		a.studies[study] = r
+	}
+	for i, tag := range a.tags {
+		r.values[i][strings.Join(ds.GetStrings(tag), "\\")] = true
+	}
+}
+
+// Report returns the equivalence classes of the instances added so far, with the attributes whose
+// generalisation leaves the fewest records below k when any are
+func (a *KAnonymityAssessor) Report() *dcmd.KAnonymityReport {
+	report := &dcmd.KAnonymityReport{
+		K:                a.k,
+		QuasiIdentifiers: a.keywords,
+		Records:          len(a.studies),
+		ClassesByStudy:   a.unlinked,
+	}
+
+	records := a.records()
+	keys := make([]string, len(records))
+	for i, r := range records {
+		keys[i] = a.classKey(r, -1)
+	}
+	sizes := classSizes(records, keys, a.unlinked)
+	report.Classes = len(sizes)
+	for _, size := range sizes {
+		if report.SmallestClass == 0 || size < report.SmallestClass {
+			report.SmallestClass = size
+		}
+	}
+
+	for i, r := range records {
+		size := sizes[keys[i]]
+		if size >= a.k {
+			continue
+		}
+		values := map[string]string{}
+		for j, keyword := range a.keywords {
+			values[keyword] = recordValue(r, j)
+		}
+		report.AtRisk = append(report.AtRisk, &dcmd.RiskRecord{
+			StudyInstanceUID: r.study,
+			ClassSize:        size,
+			Values:           values,
+		})
+	}
+	sort.SliceStable(report.AtRisk, func(i, j int) bool {
+		return report.AtRisk[i].ClassSize < report.AtRisk[j].ClassSize
+	})
+
+	if len(report.AtRisk) > 0 {
+		report.Suggestions = a.suggestions(records, len(report.AtRisk))
+	}
+	return report
+}
+
+// suggestions coarsens every quasi-identifier in turn, returning those leaving fewer records
+// below k than atRisk, best first
+func (a *KAnonymityAssessor) suggestions(records []*kRecord, atRisk int) []*dcmd.GeneralisationSuggestion {
+	var suggestions []*dcmd.GeneralisationSuggestion
+	for i, keyword := range a.keywords {
+		keys := make([]string, len(records))
+		for j, r := range records {
+			keys[j] = a.classKey(r, i)
+		}
+		sizes := classSizes(records, keys, a.unlinked)
+		n := 0
+		for _, key := range keys {
+			if sizes[key] < a.k {
+				n++
+			}
+		}
+		if n < atRisk {
+			suggestions = append(suggestions, &dcmd.GeneralisationSuggestion{
+				Attribute:     keyword,
+				Method:        coarseningMethod(dicom.LookupVR(a.tags[i])),
+				RecordsAtRisk: n,
+			})
+		}
+	}
+	sort.SliceStable(suggestions, func(i, j int) bool {
+		return suggestions[i].RecordsAtRisk < suggestions[j].RecordsAtRisk
+	})
+	return suggestions
+}
+
+// records returns the studies ordered by their UID
+func (a *KAnonymityAssessor) records() []*kRecord {
+	records := make([]*kRecord, 0, len(a.studies))
+	for _, r := range a.studies {
+		records = append(records, r)
+	}
+	sort.Slice(records, func(i, j int) bool { return records[i].study < records[j].study })
+	return records
+}
+
+// classKey returns the equivalence class of a record, with the quasi-identifier at index coarsen
+// in its coarsened form unless coarsen is negative
+func (a *KAnonymityAssessor) classKey(r *kRecord, coarsen int) string {
+	values := make([]string, len(a.tags))
+	for i := range a.tags {
+		values[i] = recordValue(r, i)
+		if i == coarsen {
+			values[i] = coarsenValue(dicom.LookupVR(a.tags[i]), values[i])
+		}
+	}
+	return strings.Join(values, "\x00")
+}
+
+// classSizes returns the number of distinct patients of every equivalence class, or the number of
+// studies when byStudy is set
+func classSizes(records []*kRecord, keys []string, byStudy bool) map[string]int {
+	members := map[string]map[string]bool{}
+	for i, r := range records {
+		if members[keys[i]] == nil {
+			members[keys[i]] = map[string]bool{}
+		}
+		if byStudy {
+			members[keys[i]][r.study] = true
+		} else {
+			members[keys[i]][r.patient] = true
+		}
+	}
+	sizes := map[string]int{}
+	for key, m := range members {
+		sizes[key] = len(m)
+	}
+	return sizes
+}
+
+// recordValue returns the values of a quasi-identifier across the instances of a study
+func recordValue(r *kRecord, i int) string {
+	var values []string
+	for v := range r.values[i] {
+		values = append(values, v)
+	}
+	sort.Strings(values)
+	return strings.Join(values, "/")
+}
+
+// coarseningMethod describes how values of a VR are coarsened in generalisation suggestions
+func coarseningMethod(vr string) string {
+	switch vr {
+	case dicom.AS:
+		return "10 year age ranges"
+	case dicom.DA, dicom.DT:
+		return "year only"
+	}
+	return "remove"
+}
+
+// coarsenValue returns the coarsened form of the values of a study described by coarseningMethod
+func coarsenValue(vr, value string) string {
+	if vr != dicom.AS && vr != dicom.DA && vr != dicom.DT {
+		return ""
+	}
+	coarsened := map[string]bool{}
+	for _, v := range strings.Split(value, "/") {
+		switch {
+		case vr == dicom.AS:
+			v = generaliseAge(v, 10, dcmd.DefaultMaxAge)
+		case len(v) >= 4:
+			v = v[:4]
+		}
+		coarsened[v] = true
+	}
+	values := make([]string, 0, len(coarsened))
+	for v := range coarsened {
+		values = append(values, v)
+	}
+	sort.Strings(values)
+	return strings.Join(values, "/")
+}
@@ -0,0 +1,110 @@
+package deid_test
+
+import (
+	"fmt"
+	"testing"
+
+	dcmd "gitlab.com/medical-research/dicom-deidentifier"
+	"gitlab.com/medical-research/dicom-deidentifier/deid"
+	"gitlab.com/medical-research/dicom-deidentifier/dicom"
+)
+
+func TestKAnonymityAssessor_Report(t *testing.T) {
+	study := func(n int, sex, age, date string) *dicom.Dataset {
+		return dicom.NewDataset(
+			dicom.NewElement(dicom.StudyInstanceUID, dicom.UI, fmt.Sprintf("2.25.%d", n)),
+			dicom.NewElement(dicom.PatientID, dicom.LO, fmt.Sprintf("P%d", n)),
+			dicom.NewElement(dicom.PatientSex, dicom.CS, sex),
+			dicom.NewElement(dicom.PatientAge, dicom.AS, age),
+			dicom.NewElement(dicom.StudyDate, dicom.DA, date),
+		)
+	}
+	config := &dcmd.KAnonymity{K: 2, QuasiIdentifiers: []string{"PatientSex", "PatientAge", "StudyDate"}}
+
+	tests := []struct {
+		name            string
+		studies         []*dicom.Dataset
+		wantClasses     int
+		wantAtRisk      int
+		wantSuggestion  string
+		wantStillAtRisk int
+	}{
+		{
+			name: "k-anonymous",
+			studies: []*dicom.Dataset{
+				study(1, "F", "045Y", "20200110"),
+				study(2, "F", "045Y", "20200110"),
+			},
+			wantClasses: 1,
+		},
+		{
+			name: "ages single out patients",
+			studies: []*dicom.Dataset{
+				study(1, "F", "041Y", "20200110"),
+				study(2, "F", "043Y", "20200110"),
+				study(3, "M", "050Y", "20200110"),
+				study(4, "M", "050Y", "20200110"),
+			},
+			wantClasses:    3,
+			wantAtRisk:     2,
+			wantSuggestion: "PatientAge",
+		},
+		{
+			name: "dates single out patients",
+			studies: []*dicom.Dataset{
+				study(1, "F", "045Y", "20200110"),
+				study(2, "F", "045Y", "20200612"),
+				study(3, "F", "045Y", "20190612"),
+			},
+			wantClasses:     3,
+			wantAtRisk:      3,
+			wantSuggestion:  "StudyDate",
+			wantStillAtRisk: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, err := deid.NewKAnonymityAssessor(config)
+			if err != nil {
+				t.Fatalf("NewKAnonymityAssessor() error = %v", err)
+			}
+			for _, ds := range tt.studies {
+				a.Add(ds)
+			}
+			report := a.Report()
+			if report.Classes != tt.wantClasses || len(report.AtRisk) != tt.wantAtRisk {
+				t.Fatalf("Report() = %d classes, %d at risk, want %d, %d", report.Classes, len(report.AtRisk), tt.wantClasses, tt.wantAtRisk)
+			}
+			if tt.wantSuggestion == "" {
+				if len(report.Suggestions) != 0 {
+					t.Errorf("Report() suggestions = %d, want none", len(report.Suggestions))
+				}
+				return
+			}
+			if len(report.Suggestions) == 0 {
+				t.Fatalf("Report() suggestions = none, want %s", tt.wantSuggestion)
+			}
+			if got := report.Suggestions[0]; got.Attribute != tt.wantSuggestion || got.RecordsAtRisk != tt.wantStillAtRisk {
+				t.Errorf("Report() suggestion = %s leaving %d, want %s leaving %d", got.Attribute, got.RecordsAtRisk, tt.wantSuggestion, tt.wantStillAtRisk)
+			}
+		})
+	}
+
+	// studies without a Patient ID cannot be linked to patients, classes are sized by study instead
+	a, err := deid.NewKAnonymityAssessor(config)
+	if err != nil {
+		t.Fatalf("NewKAnonymityAssessor() error = %v", err)
+	}
+	for n := 1; n <= 2; n++ {
+		ds := study(n, "F", "045Y", "20200110")
+		ds.Remove(dicom.PatientID)
+		a.Add(ds)
+	}
+	if report := a.Report(); !report.ClassesByStudy || report.SmallestClass != 2 || len(report.AtRisk) != 0 {
+		t.Errorf("Report() without patient ids = by study %v, smallest class %d, %d at risk, want true, 2, 0", report.ClassesByStudy, report.SmallestClass, len(report.AtRisk))
+	}
+
+	if _, err := deid.NewKAnonymityAssessor(&dcmd.KAnonymity{QuasiIdentifiers: []string{"NotAKeyword"}}); dcmd.ErrorCode(err) != dcmd.EINVALID {
+		t.Errorf("NewKAnonymityAssessor() error = %v, want EINVALID", err)
+	}
+}
@@ -0,0 +1,231 @@
+package deid
+
+import (
+	"encoding/binary"
+
+	dcmd "gitlab.com/medical-research/dicom-deidentifier"
+	"gitlab.com/medical-research/dicom-deidentifier/dicom"
+)
+
+// annotationAttributes are the free-text attributes of presentation state annotations, cleaned
+// under the Scrub Annotations option
+var annotationAttributes = map[dicom.Tag]bool{
+	0x00700006: true, // Unformatted Text Value
+	0x00700068: true, // Graphic Layer Description
+	0x00700081: true, // Content Description
+}
+
+// isOverlayGroup reports whether a tag belongs to one of the overlay repeating groups 6000-601E
+func isOverlayGroup(tag dicom.Tag) bool {
+	g := tag.Group()
+	return g >= 0x6000 && g <= 0x601E && g%2 == 0
+}
+
+// overlayPlane describes an overlay plane of repeating group 60xx
+type overlayPlane struct {
+	rows, columns int
+	originRow     int
+	originColumn  int
+	frames        int
+	firstFrame    int
+	bitPosition   int
+	embedded      bool
+	data          []byte
+}
+
+// overlayPlanes returns the overlay planes of a dataset, either stored in Overlay Data or
+// embedded in the unused high bits of the pixel data, a retired encoding still found in old files
+func overlayPlanes(ds *dicom.Dataset) []*overlayPlane {
+	var planes []*overlayPlane
+	highBit := intValue(ds, dicom.HighBit, intValue(ds, dicom.BitsStored, 16)-1)
+	for g := uint16(0x6000); g <= 0x601E; g += 2 {
+		rows := intValue(ds, dicom.NewTag(g, 0x0010), 0)
+		columns := intValue(ds, dicom.NewTag(g, 0x0011), 0)
+		if rows == 0 || columns == 0 {
+			continue
+		}
+		p := &overlayPlane{
+			rows:         rows,
+			columns:      columns,
+			originRow:    1,
+			originColumn: 1,
+			frames:       intValue(ds, dicom.NewTag(g, 0x0015), 1),
+			firstFrame:   intValue(ds, dicom.NewTag(g, 0x0051), 1),
+			bitPosition:  intValue(ds, dicom.NewTag(g, 0x0102), 0),
+		}
+		if e := ds.Get(dicom.NewTag(g, 0x0050)); e != nil {
+			if origin, err := e.Ints(); err == nil && len(origin) == 2 {
+				p.originRow, p.originColumn = int(origin[0]), int(origin[1])
+			}
+		}
+		if e := ds.Get(dicom.NewTag(g, 0x3000)); e != nil && len(e.Value) > 0 {
+			p.data = e.Value
+		} else if intValue(ds, dicom.NewTag(g, 0x0100), 1) > 1 && p.bitPosition > highBit && p.bitPosition < 16 {
+			// bits within the stored range are image data, whatever the overlay claims
+			p.embedded = true
+		} else {
+			continue
+		}
+		planes = append(planes, p)
+	}
+	return planes
+}
+
+// nativePixels gives access to the samples of native monochrome pixel data
+type nativePixels struct {
+	data          []byte
+	rows, columns int
+	frames        int
+	bitsAllocated int
+	bitsStored    int
+	signed        bool
+	inverted      bool
+}
+
+// newNativePixels returns the pixel data of a dataset. Overlays cannot be burnt into encapsulated
+// or colour pixel data without decompressing it, which the engine does not do.
+func newNativePixels(ds *dicom.Dataset) (*nativePixels, error) {
+	e := ds.Get(dicom.PixelData)
+	if e == nil {
+		return nil, dcmd.Errorf(dcmd.EINVALID, "instance has no pixel data to burn overlays into")
+	} else if e.IsEncapsulated() {
+		return nil, dcmd.Errorf(dcmd.EINVALID, "overlays cannot be burnt into compressed pixel data")
+	} else if n := intValue(ds, dicom.SamplesPerPixel, 1); n != 1 {
+		return nil, dcmd.Errorf(dcmd.EINVALID, "overlays can only be burnt into monochrome pixel data, not %d samples per pixel", n)
+	}
+
+	px := &nativePixels{
+		data:          e.Value,
+		rows:          intValue(ds, dicom.Rows, 0),
+		columns:       intValue(ds, dicom.Columns, 0),
+		frames:        intValue(ds, dicom.NumberOfFrames, 1),
+		bitsAllocated: intValue(ds, dicom.BitsAllocated, 0),
+		signed:        intValue(ds, dicom.PixelRepresentation, 0) == 1,
+		inverted:      ds.GetString(dicom.PhotometricInterpretation) == "MONOCHROME1",
+	}
+	px.bitsStored = intValue(ds, dicom.BitsStored, px.bitsAllocated)
+	if px.bitsAllocated != 8 && px.bitsAllocated != 16 {
+		return nil, dcmd.Errorf(dcmd.EINVALID, "overlays cannot be burnt into %d bit pixel data", px.bitsAllocated)
+	} else if px.bitsStored < 1 || px.bitsStored > px.bitsAllocated {
+		return nil, dcmd.Errorf(dcmd.EINVALID, "bits stored %d is outside 1 to bits allocated %d", px.bitsStored, px.bitsAllocated)
+	}
+	if len(px.data) < px.rows*px.columns*px.frames*px.bitsAllocated/8 {
+		return nil, dcmd.Errorf(dcmd.EINVALID, "pixel data is shorter than its rows, columns and frames")
+	}
+	return px, nil
+}
+
+// get returns the raw sample of a pixel, including any bits outside the stored range
+func (px *nativePixels) get(i int) uint16 {
+	if px.bitsAllocated == 8 {
+		return uint16(px.data[i])
+	}
+	return binary.LittleEndian.Uint16(px.data[2*i:])
+}
+
+// set stores the raw sample of a pixel
+func (px *nativePixels) set(i int, v uint16) {
+	if px.bitsAllocated == 8 {
+		px.data[i] = byte(v)
+		return
+	}
+	binary.LittleEndian.PutUint16(px.data[2*i:], v)
+}
+
+// brightest returns the raw sample displayed as white, which annotations are burnt with
+func (px *nativePixels) brightest() uint16 {
+	switch {
+	case px.inverted && px.signed:
+		return uint16(1) << (px.bitsStored - 1)
+	case px.inverted:
+		return 0
+	case px.signed:
+		return uint16(1)<<(px.bitsStored-1) - 1
+	}
+	return uint16(1)<<px.bitsStored - 1
+}
+
+// burnInOverlays draws the overlay planes of a dataset into its pixel data. Embedded overlay
+// bits are cleared, the overlay groups themselves are removed by the profile afterwards.
+func burnInOverlays(ds *dicom.Dataset) error {
+	planes := overlayPlanes(ds)
+	if len(planes) == 0 {
+		return nil
+	}
+	px, err := newNativePixels(ds)
+	if err != nil {
+		return err
+	}
+
+	white := px.brightest()
+	perFrame := px.rows * px.columns
+	for _, p := range planes {
+		if p.embedded {
+			bit := uint16(1) << p.bitPosition
+			for i := 0; i < perFrame*px.frames; i++ {
+				if v := px.get(i); v&bit != 0 {
+					px.set(i, white)
+				}
+			}
+			continue
+		}
+
+		for f := 0; f < p.frames; f++ {
+			frame := p.firstFrame - 1 + f
+			if frame < 0 || frame >= px.frames {
+				continue
+			}
+			for r := 0; r < p.rows; r++ {
+				row := p.originRow - 1 + r
+				if row < 0 || row >= px.rows {
+					continue
+				}
+				for c := 0; c < p.columns; c++ {
+					column := p.originColumn - 1 + c
+					if column < 0 || column >= px.columns {
+						continue
+					}
+					bit := (f*p.rows+r)*p.columns + c
+					if bit/8 < len(p.data) && p.data[bit/8]&(1<<(bit%8)) != 0 {
+						px.set(frame*perFrame+row*px.columns+column, white)
+					}
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// clearEmbeddedOverlays clears the pixel data bits holding embedded overlays
+func clearEmbeddedOverlays(ds *dicom.Dataset) {
+	var mask uint16
+	for _, p := range overlayPlanes(ds) {
+		if p.embedded {
+			mask |= 1 << p.bitPosition
+		}
+	}
+	e := ds.Get(dicom.PixelData)
+	if mask == 0 || e == nil || e.IsEncapsulated() {
+		return
+	}
+	px := &nativePixels{data: e.Value, bitsAllocated: intValue(ds, dicom.BitsAllocated, 16)}
+	if px.bitsAllocated != 8 && px.bitsAllocated != 16 {
+		return
+	}
+	for i := 0; i < len(px.data)*8/px.bitsAllocated; i++ {
+		px.set(i, px.get(i)&^mask)
+	}
+}
+
+// intValue returns the first value of an integer attribute, or def when it is missing or invalid
+func intValue(ds *dicom.Dataset, tag dicom.Tag, def int) int {
+	e := ds.Get(tag)
+	if e == nil {
+		return def
+	}
+	values, err := e.Ints()
+	if err != nil || len(values) == 0 {
+		return def
+	}
+	return int(values[0])
+}
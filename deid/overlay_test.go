@@ -0,0 +1,157 @@
+package deid_test
+
+import (
+	"context"
+	"encoding/binary"
+	"testing"
+
+	dcmd "gitlab.com/medical-research/dicom-deidentifier"
+	"gitlab.com/medical-research/dicom-deidentifier/dicom"
+)
+
+// newUS returns a US or SS element holding the supplied values
+func newUS(tag dicom.Tag, vr string, values ...uint16) *dicom.Element {
+	b := make([]byte, 2*len(values))
+	for i, v := range values {
+		binary.LittleEndian.PutUint16(b[2*i:], v)
+	}
+	return &dicom.Element{Tag: tag, VR: vr, Value: b}
+}
+
+// newTestImage returns a 4x4 monochrome instance with the given pixel depth
+func newTestImage(bitsAllocated, bitsStored uint16, pixels []byte) *dicom.File {
+	f := newTestInstance("1.3.6.1.4.1.5962.1.1.1", "")
+	f.Dataset.Set(newUS(dicom.SamplesPerPixel, dicom.US, 1))
+	f.Dataset.Set(dicom.NewElement(dicom.PhotometricInterpretation, dicom.CS, "MONOCHROME2"))
+	f.Dataset.Set(newUS(dicom.Rows, dicom.US, 4))
+	f.Dataset.Set(newUS(dicom.Columns, dicom.US, 4))
+	f.Dataset.Set(newUS(dicom.BitsAllocated, dicom.US, bitsAllocated))
+	f.Dataset.Set(newUS(dicom.BitsStored, dicom.US, bitsStored))
+	f.Dataset.Set(newUS(dicom.HighBit, dicom.US, bitsStored-1))
+	f.Dataset.Set(newUS(dicom.PixelRepresentation, dicom.US, 0))
+	f.Dataset.Set(&dicom.Element{Tag: dicom.PixelData, VR: dicom.OW, Value: pixels})
+	return f
+}
+
+func TestDeidentificationService_DeidentifyFile_BurnInOverlays(t *testing.T) {
+	s := newTestService(t)
+
+	// a 2x2 overlay at row 2, column 2 with its diagonal set
+	f := newTestImage(8, 8, make([]byte, 16))
+	f.Dataset.Set(newUS(dicom.Tag(0x60000010), dicom.US, 2))
+	f.Dataset.Set(newUS(dicom.Tag(0x60000011), dicom.US, 2))
+	f.Dataset.Set(dicom.NewElement(dicom.Tag(0x60000040), dicom.CS, "G"))
+	f.Dataset.Set(newUS(dicom.Tag(0x60000050), dicom.SS, 2, 2))
+	f.Dataset.Set(newUS(dicom.Tag(0x60000100), dicom.US, 1))
+	f.Dataset.Set(newUS(dicom.Tag(0x60000102), dicom.US, 0))
+	f.Dataset.Set(&dicom.Element{Tag: dicom.Tag(0x60003000), VR: dicom.OW, Value: []byte{0x09, 0x00}})
+
+	profile := &dcmd.Profile{Name: "burn", Options: []string{dcmd.BurnInOverlaysOption}}
+	if err := s.DeidentifyFile(context.Background(), profile, nil, f); err != nil {
+		t.Fatalf("DeidentifyFile() error = %v", err)
+	}
+
+	want := []byte{
+		0, 0, 0, 0,
+		0, 255, 0, 0,
+		0, 0, 255, 0,
+		0, 0, 0, 0,
+	}
+	if got := f.Dataset.Get(dicom.PixelData).Value; string(got) != string(want) {
+		t.Errorf("PixelData = %v, want %v", got, want)
+	}
+	for _, e := range f.Dataset.Elements {
+		if e.Tag.Group() == 0x6000 {
+			t.Errorf("overlay attribute %s was kept", e.Tag)
+		}
+	}
+}
+
+func TestDeidentificationService_DeidentifyFile_BurnInOverlays_InvalidPixels(t *testing.T) {
+	s := newTestService(t)
+
+	tests := []struct {
+		name   string
+		modify func(ds *dicom.Dataset)
+	}{
+		{
+			name: "no bits stored",
+			modify: func(ds *dicom.Dataset) {
+				ds.Set(newUS(dicom.BitsStored, dicom.US, 0))
+				ds.Set(newUS(dicom.PixelRepresentation, dicom.US, 1))
+			},
+		},
+		{
+			name:   "more bits stored than allocated",
+			modify: func(ds *dicom.Dataset) { ds.Set(newUS(dicom.BitsStored, dicom.US, 12)) },
+		},
+		{
+			name:   "colour",
+			modify: func(ds *dicom.Dataset) { ds.Set(newUS(dicom.SamplesPerPixel, dicom.US, 3)) },
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newTestImage(8, 8, make([]byte, 48))
+			f.Dataset.Set(newUS(dicom.Tag(0x60000010), dicom.US, 2))
+			f.Dataset.Set(newUS(dicom.Tag(0x60000011), dicom.US, 2))
+			f.Dataset.Set(&dicom.Element{Tag: dicom.Tag(0x60003000), VR: dicom.OW, Value: []byte{0x09, 0x00}})
+			tt.modify(f.Dataset)
+
+			profile := &dcmd.Profile{Name: "burn", Options: []string{dcmd.BurnInOverlaysOption}}
+			if err := s.DeidentifyFile(context.Background(), profile, nil, f); dcmd.ErrorCode(err) != dcmd.EINVALID {
+				t.Errorf("DeidentifyFile() error = %v, want %s", err, dcmd.EINVALID)
+			}
+		})
+	}
+}
+
+func TestDeidentificationService_DeidentifyFile_RemoveOverlays(t *testing.T) {
+	s := newTestService(t)
+
+	// 12 bit pixels with an overlay embedded in bit 12
+	pixels := make([]byte, 32)
+	binary.LittleEndian.PutUint16(pixels, 0x1ABC)
+	f := newTestImage(16, 12, pixels)
+	f.Dataset.Set(newUS(dicom.Tag(0x60000010), dicom.US, 4))
+	f.Dataset.Set(newUS(dicom.Tag(0x60000011), dicom.US, 4))
+	f.Dataset.Set(newUS(dicom.Tag(0x60000100), dicom.US, 16))
+	f.Dataset.Set(newUS(dicom.Tag(0x60000102), dicom.US, 12))
+	f.Dataset.Set(dicom.NewElement(dicom.Tag(0x60000022), dicom.LO, "Doe^John"))
+
+	profile := &dcmd.Profile{Name: "remove", Options: []string{dcmd.RemoveOverlaysOption}}
+	if err := s.DeidentifyFile(context.Background(), profile, nil, f); err != nil {
+		t.Fatalf("DeidentifyFile() error = %v", err)
+	}
+
+	if got := binary.LittleEndian.Uint16(f.Dataset.Get(dicom.PixelData).Value); got != 0x0ABC {
+		t.Errorf("first pixel = %#x, want %#x", got, 0x0ABC)
+	}
+	for _, e := range f.Dataset.Elements {
+		if e.Tag.Group() == 0x6000 {
+			t.Errorf("overlay attribute %s was kept", e.Tag)
+		}
+	}
+}
+
+func TestDeidentificationService_DeidentifyFile_ScrubAnnotations(t *testing.T) {
+	s := newTestService(t)
+
+	f := newTestInstance("1.3.6.1.4.1.5962.1.1.1", "")
+	f.Dataset.Set(dicom.NewSequence(dicom.Tag(0x00700001), dicom.NewDataset( // Graphic Annotation Sequence
+		dicom.NewElement(dicom.Tag(0x00700002), dicom.CS, "TEXT"),
+		dicom.NewSequence(dicom.Tag(0x00700008), dicom.NewDataset( // Text Object Sequence
+			dicom.NewElement(dicom.Tag(0x00700006), dicom.ST, "DOE JOHN MRN0001 lesion 12mm"),
+		)),
+	)))
+
+	profile := &dcmd.Profile{Name: "annotations", Options: []string{dcmd.ScrubAnnotationsOption}}
+	if err := s.DeidentifyFile(context.Background(), profile, nil, f); err != nil {
+		t.Fatalf("DeidentifyFile() error = %v", err)
+	}
+
+	text := f.Dataset.Get(dicom.Tag(0x00700001)).Items[0].Get(dicom.Tag(0x00700008)).Items[0]
+	if got, want := text.GetString(dicom.Tag(0x00700006)), "[PATIENT] [PATIENT] [ID] lesion 12mm"; got != want {
+		t.Errorf("UnformattedTextValue = %q, want %q", got, want)
+	}
+}
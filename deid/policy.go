@@ -0,0 +1,72 @@
+package deid
+
+import (
+	"io"
+	"math"
+
+	dcmd "gitlab.com/medical-research/dicom-deidentifier"
+	"gopkg.in/yaml.v3"
+)
+
+// profileOptions are the options profiles may apply
+var profileOptions = map[string]bool{
+	dcmd.RetainUIDsOption:                      true,
+	dcmd.CleanDescriptorsOption:                true,
+	dcmd.RetainLongitudinalModifiedDatesOption: true,
+	dcmd.PseudonymizePatientIDOption:           true,
+	dcmd.RosterSubjectIDsOption:                true,
+	dcmd.EncryptOriginalAttributesOption:       true,
+	dcmd.RetainSafePrivateOption:               true,
+	dcmd.RemoveOverlaysOption:                  true,
+	dcmd.BurnInOverlaysOption:                  true,
+	dcmd.ScrubAnnotationsOption:                true,
+	dcmd.RemoveSlideLabelsOption:               true,
+	dcmd.BlankSlideLabelsOption:                true,
+	dcmd.BlankMacroLabelOption:                 true,
+	dcmd.ConvertToUTF8Option:                   true,
+}
+
+// ReadPolicy reads a profile written in YAML or JSON, with the same field names in both, and validates it.
+// The profile is decoded through its yaml field names, so unquoted numbers are read as text where text is expected.
+func ReadPolicy(r io.Reader) (*dcmd.Profile, error) {
+	var profile dcmd.Profile
+	if err := yaml.NewDecoder(r).Decode(&profile); err != nil {
+		return nil, dcmd.Errorf(dcmd.EINVALID, "invalid policy: %v", err)
+	}
+
+	if err := ValidateProfile(&profile); err != nil {
+		return nil, err
+	}
+	return &profile, nil
+}
+
+// ValidateProfile checks the name, options and rules of a profile before it is used.
+// Returns EINVALID describing the first problem found.
+func ValidateProfile(profile *dcmd.Profile) error {
+	if profile.Name == "" {
+		return dcmd.Errorf(dcmd.EINVALID, "profile name required")
+	}
+	for _, option := range profile.Options {
+		if !profileOptions[option] {
+			return dcmd.Errorf(dcmd.EINVALID, "unknown option %q", option)
+		}
+	}
+	if r := profile.MacroLabelRegion; r != nil && !(isFraction(r.Left) && isFraction(r.Top) && isFraction(r.Width) && isFraction(r.Height)) {
+		return dcmd.Errorf(dcmd.EINVALID, "macro label region must be given in fractions between 0 and 1")
+	}
+	if g := profile.Generalisation; g != nil && !(isStep(g.SizeStep) && isStep(g.WeightStep)) {
+		return dcmd.Errorf(dcmd.EINVALID, "size and weight steps must be positive numbers")
+	}
+	_, err := compileRules(profile)
+	return err
+}
+
+// isFraction reports whether v is a number between 0 and 1, which excludes NaN
+func isFraction(v float64) bool {
+	return v >= 0 && v <= 1
+}
+
+// isStep reports whether v is a finite positive step, or 0 when unset
+func isStep(v float64) bool {
+	return v >= 0 && !math.IsInf(v, 1)
+}
@@ -0,0 +1,109 @@
+package deid_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	dcmd "gitlab.com/medical-research/dicom-deidentifier"
+	"gitlab.com/medical-research/dicom-deidentifier/deid"
+	"gitlab.com/medical-research/dicom-deidentifier/dicom"
+)
+
+const testPolicy = `
+name: ultrasound
+project: liver
+variables:
+  site-code: S12
+rules:
+  - tag: InstitutionName
+    action: replace
+    value: "{site-code}"
+    if:
+      - tag: Modality
+        equals: US
+  - tag: AccessionNumber
+    action: hash
+  - tag: "(0009,xx01)"
+    private-creator: ACME 1.1
+    action: keep
+  - tag: PatientAge
+    action: generalise
+    value: 10
+  - tag: StudyDate
+    action: increment-date
+    value: -30
+    if:
+      - tag: SeriesNumber
+        in: [1, 2]
+  - tag: StudyDescription
+    action: replace
+    value: "{site-code}"
+    if:
+      - tag: SeriesNumber
+        equals: 1
+`
+
+func TestReadPolicy(t *testing.T) {
+	profile, err := deid.ReadPolicy(strings.NewReader(testPolicy))
+	if err != nil {
+		t.Fatalf("ReadPolicy() error = %v", err)
+	}
+	s := newTestService(t)
+	s.HashKey = []byte("secret")
+
+	tests := []struct {
+		modality    string
+		institution string
+	}{
+		{"US", "S12"},
+		{"CT", "basic profile"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.modality, func(t *testing.T) {
+			f := newTestInstance("1.3.6.1.4.1.5962.1.1.1", "")
+			f.Dataset.SetString(dicom.Modality, tt.modality)
+			f.Dataset.SetString(dicom.AccessionNumber, "A123456")
+			f.Dataset.SetString(dicom.PatientAge, "047Y")
+			f.Dataset.SetString(dicom.StudyDate, "20200131")
+			f.Dataset.SetString(dicom.Tag(0x00200011), "1")
+			f.Dataset.SetString(dicom.StudyDescription, "CT CHEST")
+			if err := s.DeidentifyFile(context.Background(), profile, nil, f); err != nil {
+				t.Fatalf("DeidentifyFile() error = %v", err)
+			}
+
+			if got := f.Dataset.GetString(dicom.InstitutionName); (got == "S12") != (tt.institution == "S12") {
+				t.Errorf("InstitutionName = %q, want %q", got, tt.institution)
+			}
+			if got := f.Dataset.GetString(dicom.AccessionNumber); len(got) != 16 || got == "A123456" {
+				t.Errorf("AccessionNumber = %q, want a hash", got)
+			}
+			if got := f.Dataset.GetString(dicom.Tag(0x00091001)); got != "private" {
+				t.Errorf("private attribute = %q, want it kept", got)
+			}
+			if got := f.Dataset.GetString(dicom.PatientAge); got != "040Y" {
+				t.Errorf("PatientAge = %q, want 040Y", got)
+			}
+			if got := f.Dataset.GetString(dicom.StudyDate); got != "20200101" {
+				t.Errorf("StudyDate = %q, want 20200101", got)
+			}
+			if got := f.Dataset.GetString(dicom.StudyDescription); got != "S12" {
+				t.Errorf("StudyDescription = %q, want S12", got)
+			}
+		})
+	}
+
+	for name, policy := range map[string]string{
+		"unknown action":     "name: p\nrules:\n  - tag: PatientName\n    action: scramble\n",
+		"unknown variable":   "name: p\nrules:\n  - tag: InstitutionName\n    action: replace\n    value: \"{site}\"\n",
+		"two comparisons":    "name: p\nrules:\n  - tag: PatientName\n    action: keep\n    if:\n      - tag: Modality\n        equals: US\n        not-equals: CT\n",
+		"no private creator": "name: p\nrules:\n  - tag: \"(0009,xx01)\"\n    action: keep\n",
+		"unknown option":     "name: p\noptions: [retain-everything]\n",
+		"region outside":     "name: p\nmacro-label-region: {left: 0, top: 0, width: 1.5, height: 1}\n",
+		"infinite step":      "name: p\ngeneralisation: {size-step: .inf}\n",
+	} {
+		if _, err := deid.ReadPolicy(strings.NewReader(policy)); dcmd.ErrorCode(err) != dcmd.EINVALID {
+			t.Errorf("ReadPolicy() %s error = %v, want EINVALID", name, err)
+		}
+	}
+}
@@ -0,0 +1,186 @@
+package deid
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	dcmd "gitlab.com/medical-research/dicom-deidentifier"
+	"gitlab.com/medical-research/dicom-deidentifier/dicom"
+)
+
+// PreviewInstance reads a DICOM Part 10 instance from r and returns the change the profile makes
+// to every element, de-identifying the instance in a dry run so nothing is persisted
+func (s *DeidentificationService) PreviewInstance(ctx context.Context, profile *dcmd.Profile, r io.Reader) (*dcmd.Preview, error) {
+	f, err := dicom.Read(r)
+	if err != nil {
+		return nil, dcmd.Errorf(dcmd.EINVALID, "invalid dicom instance: %v", err)
+	}
+	before := snapshotElements(f.Dataset)
+
+	preview := &dcmd.Preview{
+		Profile:     profile.Name,
+		ProfileHash: profile.Hash(),
+		Changes:     []*dcmd.ElementChange{},
+		Report:      dcmd.NewJobReport(),
+	}
+	changes := map[*dicom.Element]string{}
+	err = s.deidentifyFile(dcmd.NewContextWithDryRun(ctx), profile, preview.Report, f, changes)
+	if err == dcmd.ErrInstanceExcluded {
+		preview.Excluded = true
+		return preview, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	preview.Changes = diffDatasets(before, f.Dataset, changes)
+	return preview, nil
+}
+
+// elementKey identifies an element by the dataset holding it and its tag. Items keep their dataset
+// when others are removed from a sequence, so elements are matched whatever their index.
+type elementKey struct {
+	parent *dicom.Dataset
+	tag    dicom.Tag
+}
+
+// elementSnapshot is a copy of an element before de-identification, with its original path
+type elementSnapshot struct {
+	path    string
+	element *dicom.Element
+}
+
+// snapshotElements copies every element of a dataset other than sequences, which are compared item by item
+func snapshotElements(ds *dicom.Dataset) map[elementKey]*elementSnapshot {
+	elements := map[elementKey]*elementSnapshot{}
+	_ = ds.Walk(func(parent *dicom.Dataset, e *dicom.Element, path string) error {
+		if !e.IsSequence() {
+			elements[elementKey{parent, e.Tag}] = &elementSnapshot{path, &dicom.Element{
+				Tag:       e.Tag,
+				VR:        e.VR,
+				Value:     append([]byte(nil), e.Value...),
+				Fragments: append([][]byte(nil), e.Fragments...),
+			}}
+		}
+		return nil
+	})
+	return elements
+}
+
+// diffDatasets returns the change of every element of before, at its original path, and of every
+// element only in after, ordered by path. Elements recorded in changes were shifted or remapped,
+// other elements whose value differs were replaced.
+func diffDatasets(before map[elementKey]*elementSnapshot, after *dicom.Dataset, changes map[*dicom.Element]string) []*dcmd.ElementChange {
+	var list []*dcmd.ElementChange
+	seen := map[elementKey]bool{}
+	_ = after.Walk(func(parent *dicom.Dataset, e *dicom.Element, path string) error {
+		if e.IsSequence() {
+			return nil
+		}
+		key := elementKey{parent, e.Tag}
+		old, ok := before[key]
+		if !ok {
+			list = append(list, &dcmd.ElementChange{
+				Path:     path,
+				Keyword:  dicom.Keyword(e.Tag),
+				Name:     attributeName(e.Tag),
+				VR:       e.VR,
+				Change:   dcmd.ElementAdded,
+				NewValue: previewValue(e),
+			})
+			return nil
+		}
+		seen[key] = true
+		list = append(list, &dcmd.ElementChange{
+			Path:     old.path,
+			Keyword:  dicom.Keyword(e.Tag),
+			Name:     attributeName(e.Tag),
+			VR:       old.element.VR,
+			Change:   elementChange(old.element, e, changes[e]),
+			OldValue: previewValue(old.element),
+			NewValue: previewValue(e),
+		})
+		return nil
+	})
+	for key, old := range before {
+		if seen[key] {
+			continue
+		}
+		list = append(list, &dcmd.ElementChange{
+			Path:     old.path,
+			Keyword:  dicom.Keyword(old.element.Tag),
+			Name:     attributeName(old.element.Tag),
+			VR:       old.element.VR,
+			Change:   dcmd.ElementRemoved,
+			OldValue: previewValue(old.element),
+		})
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].Path < list[j].Path })
+	return list
+}
+
+// elementChange classifies the change between two versions of an element present in both datasets,
+// applied being the change recorded while de-identifying, if any
+func elementChange(old, e *dicom.Element, applied string) string {
+	if old.VR == e.VR && bytes.Equal(old.Value, e.Value) && equalFragments(old.Fragments, e.Fragments) {
+		return dcmd.ElementKept
+	}
+	if applied != "" {
+		return applied
+	}
+	return dcmd.ElementReplaced
+}
+
+// equalFragments reports whether two encapsulated pixel data hold the same fragments
+func equalFragments(a, b [][]byte) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !bytes.Equal(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// attributeName returns the dictionary name of a standard attribute, or "" for private and unknown attributes
+func attributeName(tag dicom.Tag) string {
+	if e, ok := dicom.Lookup(tag); ok && !tag.IsPrivate() {
+		return e.Name
+	}
+	return ""
+}
+
+// previewValue returns the value of an element as shown in previews, binary values other than
+// numbers being described by their length
+func previewValue(e *dicom.Element) string {
+	switch {
+	case e.IsEncapsulated():
+		return fmt.Sprintf("<%d fragments>", len(e.Fragments))
+	case dicom.IsTextVR(e.VR):
+		return strings.Join(e.Strings(), `\`)
+	case len(e.Value) == 0:
+		return ""
+	}
+	var values []string
+	switch e.VR {
+	case dicom.US, dicom.SS, dicom.UL, dicom.SL:
+		ints, _ := e.Ints()
+		for _, v := range ints {
+			values = append(values, strconv.FormatInt(v, 10))
+		}
+	case dicom.FL, dicom.FD:
+		floats, _ := e.Floats()
+		for _, v := range floats {
+			values = append(values, strconv.FormatFloat(v, 'g', -1, 64))
+		}
+	default:
+		return fmt.Sprintf("<%d bytes>", len(e.Value))
+	}
+	return strings.Join(values, `\`)
+}
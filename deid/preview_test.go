@@ -0,0 +1,116 @@
+package deid_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	dcmd "gitlab.com/medical-research/dicom-deidentifier"
+	"gitlab.com/medical-research/dicom-deidentifier/deid"
+	"gitlab.com/medical-research/dicom-deidentifier/dicom"
+	"gitlab.com/medical-research/dicom-deidentifier/mock"
+)
+
+func TestDeidentificationService_PreviewInstance(t *testing.T) {
+	uidMappingService := &mock.UIDMappingService{
+		FindUIDMappingFn: func(ctx context.Context, namespace, originalUID string) (*dcmd.UIDMapping, error) {
+			return nil, dcmd.Errorf(dcmd.ENOTFOUND, "uid not mapped")
+		},
+		CreateUIDMappingFn: func(ctx context.Context, mapping *dcmd.UIDMapping) error {
+			t.Errorf("preview persisted a uid mapping")
+			return nil
+		},
+	}
+	remapper, err := deid.NewTableUIDRemapper(testUIDRoot, "", uidMappingService)
+	if err != nil {
+		t.Fatalf("NewTableUIDRemapper() error = %v", err)
+	}
+	dateShiftService := newMemoryDateShiftService()
+	dateShiftService.CreateDateShiftFn = func(ctx context.Context, shift *dcmd.DateShift) error {
+		t.Errorf("preview persisted a date shift")
+		return nil
+	}
+	shifter, err := deid.NewTableDateShifter([]byte("secret"), dateShiftService)
+	if err != nil {
+		t.Fatalf("NewTableDateShifter() error = %v", err)
+	}
+	s := deid.NewDeidentificationService(remapper)
+	s.DateShifter = shifter
+	profile := &dcmd.Profile{Name: "longitudinal", Options: []string{dcmd.RetainLongitudinalModifiedDatesOption}}
+
+	f := newTestInstance("1.3.6.1.4.1.5962.1.1.1", "1.3.6.1.4.1.5962.1.1.2")
+	f.Dataset.Set(dicom.NewElement(dicom.StudyDate, dicom.DA, "20200115"))
+	var buf bytes.Buffer
+	if err := dicom.Write(&buf, f); err != nil {
+		t.Fatalf("Write() error = %v", err)
+	}
+
+	preview, err := s.PreviewInstance(context.Background(), profile, &buf)
+	if err != nil {
+		t.Fatalf("PreviewInstance() error = %v", err)
+	}
+	changes := map[string]*dcmd.ElementChange{}
+	for _, c := range preview.Changes {
+		changes[c.Path] = c
+	}
+
+	for path, want := range map[string]string{
+		"(0008,0016)": dcmd.ElementKept,
+		"(0008,0018)": dcmd.ElementRemapped,
+		"(0008,0020)": dcmd.ElementShifted,
+		"(0008,0080)": dcmd.ElementReplaced,
+		"(0009,1001)": dcmd.ElementRemoved,
+		"(0010,0020)": dcmd.ElementReplaced,
+		"(0012,0062)": dcmd.ElementAdded,
+		"(3006,0010)[0].(3006,0012)[0].(0008,1155)": dcmd.ElementRemapped,
+	} {
+		c, ok := changes[path]
+		if !ok {
+			t.Errorf("PreviewInstance() has no change for %s", path)
+			continue
+		}
+		if c.Change != want {
+			t.Errorf("PreviewInstance() %s %s = %s, want %s", path, c.Keyword, c.Change, want)
+		}
+	}
+	if c := changes["(0008,0080)"]; c != nil && c.OldValue != "General Hospital" {
+		t.Errorf("PreviewInstance() InstitutionName old value = %q", c.OldValue)
+	}
+}
+
+func TestDeidentificationService_PreviewInstance_StructuredReport(t *testing.T) {
+	s := newTestService(t)
+
+	var buf bytes.Buffer
+	if err := dicom.Write(&buf, newTestReport()); err != nil {
+		t.Fatalf("Write() error = %v", err)
+	}
+	preview, err := s.PreviewInstance(context.Background(), &dcmd.Profile{Name: "basic"}, &buf)
+	if err != nil {
+		t.Fatalf("PreviewInstance() error = %v", err)
+	}
+	changes := map[string]*dcmd.ElementChange{}
+	for _, c := range preview.Changes {
+		changes[c.Path] = c
+	}
+
+	// the observer context items 0 and 1 are removed, later items keep their original paths
+	for path, want := range map[string]string{
+		"(0040,A730)[1].(0040,A123)":                dcmd.ElementRemoved,
+		"(0040,A730)[2].(0040,A160)":                dcmd.ElementReplaced,
+		"(0040,A730)[3].(0040,A730)[1].(0040,A121)": dcmd.ElementReplaced,
+		"(0040,A730)[3].(0040,A730)[2].(0040,A124)": dcmd.ElementRemapped,
+	} {
+		c, ok := changes[path]
+		if !ok {
+			t.Errorf("PreviewInstance() has no change for %s", path)
+			continue
+		}
+		if c.Change != want {
+			t.Errorf("PreviewInstance() %s %s = %s, want %s", path, c.Keyword, c.Change, want)
+		}
+	}
+	if c := changes["(0040,A730)[2].(0040,A160)"]; c != nil && c.OldValue != "lung-ai 2.1" {
+		t.Errorf("PreviewInstance() TextValue old value = %q", c.OldValue)
+	}
+}
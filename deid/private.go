@@ -0,0 +1,181 @@
+package deid
+
+import (
+	"encoding/json"
+	"io"
+	"strconv"
+	"strings"
+
+	dcmd "gitlab.com/medical-research/dicom-deidentifier"
+	"gitlab.com/medical-research/dicom-deidentifier/dicom"
+)
+
+// privateAttribute identifies a private attribute independently of the block its creator reserved,
+// e.g. (0019,xx0C) of "SIEMENS MR HEADER"
+type privateAttribute struct {
+	creator string
+	group   uint16
+	element uint8
+}
+
+// defaultSafePrivateAttributes are vendor attributes holding acquisition parameters rather than
+// identifiers, in the "gggg,xxee" notation of PS3.15 Table E.3.10-1
+var defaultSafePrivateAttributes = map[string][]string{
+	"SIEMENS MR HEADER": {
+		"0019,xx08", // CSA Image Header Type
+		"0019,xx09", // CSA Image Header Version
+		"0019,xx0A", // Number Of Images In Mosaic
+		"0019,xx0B", // Slice Measurement Duration
+		"0019,xx0C", // B Value
+		"0019,xx0D", // Diffusion Directionality
+		"0019,xx0E", // Diffusion Gradient Direction
+		"0019,xx0F", // Gradient Mode
+		"0019,xx27", // B Matrix
+		"0019,xx28", // Bandwidth Per Pixel Phase Encode
+		"0019,xx29", // Mosaic Ref Acq Times
+		"0051,xx0B", // Acquisition Matrix Text
+		"0051,xx0E", // Slice Orientation
+	},
+	"SIEMENS CSA HEADER": {
+		"0029,xx08", // CSA Image Header Type
+		"0029,xx09", // CSA Image Header Version
+		"0029,xx10", // CSA Image Header Info, cleaned
+		"0029,xx18", // CSA Series Header Type
+		"0029,xx19", // CSA Series Header Version
+		"0029,xx20", // CSA Series Header Info, cleaned
+	},
+	"GEMS_ACQU_01": {
+		"0019,xx9E", // Internal Pulse Sequence Name
+		"0019,xxBB", // User Data 20, diffusion direction
+		"0019,xxBC", // User Data 21, diffusion direction
+		"0019,xxBD", // User Data 22, diffusion direction
+	},
+	"GEMS_RELA_01": {
+		"0021,xx5E", // RTIA Timer, slice timing
+	},
+	"GEMS_PARM_01": {
+		"0043,xx2C", // Effective Echo Spacing
+		"0043,xx39", // Slop Integers 6-9, b-value
+		"0043,xx6F", // Scanner Table Entries, acceleration factors
+	},
+	"Philips Imaging DD 001": {
+		"2001,xx03", // Diffusion B-Factor
+		"2001,xx04", // Diffusion Direction
+	},
+	"Philips MR Imaging DD 001": {
+		"2005,xx0D", // Scale Intercept
+		"2005,xx0E", // Scale Slope
+	},
+}
+
+// SafePrivateDictionary lists the private attributes known to be safe to retain, keyed by
+// private creator. Private attributes of creators that are not listed are unknown.
+type SafePrivateDictionary struct {
+	attributes map[privateAttribute]bool
+	creators   map[string]bool
+}
+
+// NewSafePrivateDictionary returns a new instance of SafePrivateDictionary holding the default vendor attributes
+func NewSafePrivateDictionary() *SafePrivateDictionary {
+	d := &SafePrivateDictionary{
+		attributes: map[privateAttribute]bool{},
+		creators:   map[string]bool{},
+	}
+	for creator, attrs := range defaultSafePrivateAttributes {
+		for _, attr := range attrs {
+			if err := d.Add(creator, attr); err != nil {
+				panic(err)
+			}
+		}
+	}
+	return d
+}
+
+// Add lists a private attribute of creator as safe, in the "gggg,xxee" notation
+func (d *SafePrivateDictionary) Add(creator, attr string) error {
+	parts := strings.Split(strings.Trim(attr, "()"), ",")
+	if len(parts) != 2 || len(parts[0]) != 4 || len(parts[1]) != 4 || !strings.EqualFold(parts[1][:2], "xx") {
+		return dcmd.Errorf(dcmd.EINVALID, "invalid private attribute %q, want gggg,xxee", attr)
+	}
+	group, err := strconv.ParseUint(parts[0], 16, 16)
+	if err != nil || group%2 == 0 {
+		return dcmd.Errorf(dcmd.EINVALID, "invalid private group in %q", attr)
+	}
+	element, err := strconv.ParseUint(parts[1][2:], 16, 8)
+	if err != nil {
+		return dcmd.Errorf(dcmd.EINVALID, "invalid private element in %q", attr)
+	}
+
+	creator = strings.TrimSpace(creator)
+	d.attributes[privateAttribute{creator, uint16(group), uint8(element)}] = true
+	d.creators[creator] = true
+	return nil
+}
+
+// ReadJSON adds the attributes of a JSON object mapping private creators to attributes,
+// e.g. {"SIEMENS MR HEADER": ["0019,xx0C"]}
+func (d *SafePrivateDictionary) ReadJSON(r io.Reader) error {
+	var creators map[string][]string
+	if err := json.NewDecoder(r).Decode(&creators); err != nil {
+		return dcmd.Errorf(dcmd.EINVALID, "invalid safe private dictionary: %v", err)
+	}
+	for creator, attrs := range creators {
+		for _, attr := range attrs {
+			if err := d.Add(creator, attr); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// IsSafe reports whether a private element reserved by creator is safe to retain, and whether
+// the creator is listed in the dictionary at all
+func (d *SafePrivateDictionary) IsSafe(creator string, tag dicom.Tag) (safe, known bool) {
+	creator = strings.TrimSpace(creator)
+	attr := privateAttribute{creator, tag.Group(), uint8(tag.Element() & 0xFF)}
+	return d.attributes[attr], d.creators[creator]
+}
+
+// privateAction returns the action taken for a private element of parent when safe private
+// attributes are retained. Creator elements are kept until the walk is over, as the elements
+// of their block have not been visited yet.
+func (d *deidentifier) privateAction(parent *dicom.Dataset, e *dicom.Element) string {
+	if e.Tag.IsPrivateCreator() {
+		return ActionKeep
+	}
+	creator := parent.PrivateCreator(e.Tag)
+	if creator == "" {
+		// group lengths and elements outside a reserved block
+		return ActionRemove
+	}
+
+	safe, known := d.service.SafePrivateDictionary.IsSafe(creator, e.Tag)
+	if !known {
+		d.report.AddUnknownPrivateCreator(creator)
+	}
+	if safe && dicom.IsCSAHeader(parent, e.Tag) {
+		return ActionClean
+	}
+	if safe {
+		return ActionKeep
+	}
+	return ActionRemove
+}
+
+// removeUnusedPrivateCreators removes the creator elements whose block no longer holds any element
+func removeUnusedPrivateCreators(ds *dicom.Dataset) error {
+	return ds.Walk(func(parent *dicom.Dataset, e *dicom.Element, path string) error {
+		if !e.Tag.IsPrivateCreator() {
+			return nil
+		}
+		first := dicom.NewTag(e.Tag.Group(), e.Tag.Element()<<8)
+		for _, other := range parent.Elements {
+			if other.Tag >= first && other.Tag <= first+0xFF {
+				return nil
+			}
+		}
+		parent.Remove(e.Tag)
+		return nil
+	})
+}
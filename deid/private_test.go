@@ -0,0 +1,133 @@
+package deid_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	dcmd "gitlab.com/medical-research/dicom-deidentifier"
+	"gitlab.com/medical-research/dicom-deidentifier/deid"
+	"gitlab.com/medical-research/dicom-deidentifier/dicom"
+)
+
+func TestDeidentificationService_DeidentifyFile_RetainSafePrivate(t *testing.T) {
+	s := newTestService(t)
+	if err := s.SafePrivateDictionary.ReadJSON(strings.NewReader(`{"SITE RESEARCH 1.0": ["0029,xx01"]}`)); err != nil {
+		t.Fatalf("ReadJSON() error = %v", err)
+	}
+
+	profile := &dcmd.Profile{Name: "private", Options: []string{dcmd.RetainSafePrivateOption}}
+	f := newTestInstance("1.3.6.1.4.1.5962.1.1.1", "")
+	f.Dataset.Set(dicom.NewElement(dicom.Tag(0x00190010), dicom.LO, "SIEMENS MR HEADER"))
+	f.Dataset.Set(dicom.NewElement(dicom.Tag(0x0019100C), dicom.IS, "1000"))
+	f.Dataset.Set(dicom.NewElement(dicom.Tag(0x00191011), dicom.SH, "Doe^John"))
+	f.Dataset.Set(dicom.NewElement(dicom.Tag(0x00290011), dicom.LO, "SITE RESEARCH 1.0"))
+	f.Dataset.Set(dicom.NewElement(dicom.Tag(0x00291101), dicom.LO, "arm B"))
+	f.Dataset.Set(dicom.NewElement(dicom.Tag(0x00291102), dicom.LO, "MRN0001"))
+
+	report := dcmd.NewJobReport()
+	if err := s.DeidentifyFile(context.Background(), profile, report, f); err != nil {
+		t.Fatalf("DeidentifyFile() error = %v", err)
+	}
+
+	tests := []struct {
+		name string
+		tag  dicom.Tag
+		want string
+	}{
+		{name: "siemens creator", tag: dicom.Tag(0x00190010), want: "SIEMENS MR HEADER"},
+		{name: "siemens b value", tag: dicom.Tag(0x0019100C), want: "1000"},
+		{name: "siemens attribute not listed", tag: dicom.Tag(0x00191011), want: ""},
+		{name: "configured attribute", tag: dicom.Tag(0x00291101), want: "arm B"},
+		{name: "configured creator attribute not listed", tag: dicom.Tag(0x00291102), want: ""},
+		{name: "unknown creator", tag: dicom.Tag(0x00090010), want: ""},
+		{name: "unknown creator attribute", tag: dicom.Tag(0x00091001), want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := f.Dataset.GetString(tt.tag); got != tt.want {
+				t.Errorf("%s = %q, want %q", tt.tag, got, tt.want)
+			}
+		})
+	}
+
+	if got := report.UnknownPrivateCreators["ACME 1.1"]; got != 1 {
+		t.Errorf("UnknownPrivateCreators[ACME 1.1] = %d, want 1", got)
+	}
+	if _, ok := report.UnknownPrivateCreators["SIEMENS MR HEADER"]; ok {
+		t.Errorf("UnknownPrivateCreators reports a known creator: %v", report.UnknownPrivateCreators)
+	}
+}
+
+func TestSafePrivateDictionary_ReadJSON(t *testing.T) {
+	tests := []struct {
+		name    string
+		json    string
+		wantErr bool
+	}{
+		{name: "valid", json: `{"VENDOR": ["0019,xx0C", "(0021,XX5E)"]}`},
+		{name: "even group", json: `{"VENDOR": ["0018,xx0C"]}`, wantErr: true},
+		{name: "fixed element", json: `{"VENDOR": ["0019,100C"]}`, wantErr: true},
+		{name: "not an object", json: `["0019,xx0C"]`, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := deid.NewSafePrivateDictionary().ReadJSON(strings.NewReader(tt.json))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ReadJSON() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && dcmd.ErrorCode(err) != dcmd.EINVALID {
+				t.Errorf("ErrorCode() = %q, want %q", dcmd.ErrorCode(err), dcmd.EINVALID)
+			}
+		})
+	}
+}
+
+func TestDeidentificationService_DeidentifyFile_CSAHeader(t *testing.T) {
+	s := newTestService(t)
+
+	csa := &dicom.CSAHeader{
+		Version: 2,
+		Elements: []*dicom.CSAElement{
+			{Name: "B_value", VM: 1, VR: "IS", SyngoDT: 6, Values: []string{"1000"}},
+			{Name: "SliceMeasurementDuration", VM: 1, VR: "DS", SyngoDT: 3, Values: []string{"100.0000"}},
+			{Name: "UsedPatientWeight", VM: 1, VR: "IS", SyngoDT: 6, Values: []string{"83"}},
+			{Name: "MrPhoenixProtocol", VM: 1, VR: "UN", SyngoDT: 0, Values: []string{
+				"### ASCCONV BEGIN ###\ntProtocolName = \"DOE MRN0001 dwi\"\n" +
+					"tFrameOfReference = \"1.3.12.2.1107.5.2.32.35162.1.20190302101010\"\n### ASCCONV END ###",
+			}},
+		},
+	}
+	f := newTestInstance("1.3.6.1.4.1.5962.1.1.1", "")
+	f.Dataset.Set(dicom.NewElement(dicom.Tag(0x00290010), dicom.LO, dicom.CSACreator))
+	f.Dataset.Set(&dicom.Element{Tag: dicom.Tag(0x00291020), VR: dicom.OB, Value: csa.Bytes()})
+	f.Dataset.Set(&dicom.Element{Tag: dicom.Tag(0x00291030), VR: dicom.OB, Value: []byte("private")})
+
+	profile := &dcmd.Profile{Name: "private", Options: []string{dcmd.RetainSafePrivateOption}}
+	if err := s.DeidentifyFile(context.Background(), profile, nil, f); err != nil {
+		t.Fatalf("DeidentifyFile() error = %v", err)
+	}
+
+	if f.Dataset.Get(dicom.Tag(0x00291030)) != nil {
+		t.Errorf("(0029,1030) not listed as safe was kept")
+	}
+	headers := dicom.CSAHeaders(f.Dataset)
+	if headers["series"] == nil {
+		t.Fatalf("CSAHeaders() = %v, want the series header", headers)
+	}
+	got := map[string]string{}
+	for _, e := range headers["series"].Elements {
+		got[e.Name] = e.Values[0]
+	}
+	want := map[string]string{
+		"B_value":                  "1000",
+		"SliceMeasurementDuration": "100.0000",
+		"UsedPatientWeight":        "",
+		"MrPhoenixProtocol":        "### ASCCONV BEGIN ###\ntProtocolName = \"[PATIENT] [ID] dwi\"\ntFrameOfReference = \"\"\n### ASCCONV END ###",
+	}
+	for name, v := range want {
+		if got[name] != v {
+			t.Errorf("%s = %q, want %q", name, got[name], v)
+		}
+	}
+}
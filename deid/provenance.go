@@ -0,0 +1,81 @@
+package deid
+
+import (
+	"unicode/utf8"
+
+	dcmd "gitlab.com/medical-research/dicom-deidentifier"
+	"gitlab.com/medical-research/dicom-deidentifier/dicom"
+)
+
+// LocalDeidentificationMethod describes the local engine in the De-identification Method attribute,
+// with the edition of PS3.15 its Basic Profile table was generated from
+var LocalDeidentificationMethod = deidentificationMethod(BasicProfileEdition)
+
+// deidentificationMethod returns the description of the local engine following an edition of the standard
+func deidentificationMethod(edition string) string {
+	if edition == "" {
+		return "PS3.15 Basic Application Level Confidentiality Profile"
+	}
+	return "PS3.15 " + edition + " Basic Application Level Confidentiality Profile"
+}
+
+// methodCode is a code of CID 7050 De-identification Method
+type methodCode struct {
+	value   string
+	meaning string
+}
+
+// basicProfileCode is recorded for every profile the local engine applies, as every profile
+// applies the basic profile
+var basicProfileCode = methodCode{"113100", "Basic Application Confidentiality Profile"}
+
+// optionCodes are the CID 7050 codes of the profile options defined by PS3.15. Options of this
+// project without a code, such as pseudonyms, are only recorded through the profile hash.
+var optionCodes = map[string]methodCode{
+	dcmd.CleanDescriptorsOption:                {"113105", "Clean Descriptors Option"},
+	dcmd.RetainUIDsOption:                      {"113110", "Retain UIDs Option"},
+	dcmd.RetainSafePrivateOption:               {"113111", "Retain Safe Private Option"},
+	dcmd.RetainLongitudinalModifiedDatesOption: {"113107", "Retain Longitudinal Temporal Information Modified Dates Option"},
+}
+
+// StampProvenance records in a de-identified dataset that the patient identity was removed, which
+// method removed it and the hash of the profile used, as required by PS3.15 E.1.1. The method is
+// only described in text, the codes of CID 7050 standing for the profiles of PS3.15 as the local
+// engine applies them, see stampMethodCodes.
+func StampProvenance(ds *dicom.Dataset, profile *dcmd.Profile, method string) {
+	ds.Set(dicom.NewElement(dicom.PatientIdentityRemoved, dicom.CS, "YES"))
+	ds.Set(dicom.NewElement(dicom.DeidentificationMethod, dicom.LO,
+		truncate(method, 64),
+		truncate("profile "+profile.Name, 64),
+		"sha256:"+profile.Hash(),
+	))
+}
+
+// stampMethodCodes records the CID 7050 codes of the basic profile and of the options of the profile
+// applied by the local engine
+func stampMethodCodes(ds *dicom.Dataset, profile *dcmd.Profile) {
+	items := []*dicom.Dataset{basicProfileCode.dataset()}
+	for _, option := range profile.Options {
+		if code, ok := optionCodes[option]; ok {
+			items = append(items, code.dataset())
+		}
+	}
+	ds.Set(dicom.NewSequence(dicom.DeidentificationMethodCodeSequence, items...))
+}
+
+// dataset returns the code sequence item of a code
+func (c methodCode) dataset() *dicom.Dataset {
+	return dicom.NewDataset(
+		dicom.NewElement(dicom.CodeValue, dicom.SH, c.value),
+		dicom.NewElement(dicom.CodingSchemeDesignator, dicom.SH, "DCM"),
+		dicom.NewElement(dicom.CodeMeaning, dicom.LO, c.meaning),
+	)
+}
+
+// truncate shortens s to at most n characters, as the lengths of the text VRs are counted in characters
+func truncate(s string, n int) string {
+	if utf8.RuneCountInString(s) <= n {
+		return s
+	}
+	return string([]rune(s)[:n])
+}
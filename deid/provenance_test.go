@@ -0,0 +1,58 @@
+package deid_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	dcmd "gitlab.com/medical-research/dicom-deidentifier"
+	"gitlab.com/medical-research/dicom-deidentifier/deid"
+	"gitlab.com/medical-research/dicom-deidentifier/dicom"
+)
+
+func TestDeidentificationService_DeidentifyFile_Provenance(t *testing.T) {
+	tests := []struct {
+		name      string
+		profile   *dcmd.Profile
+		wantCodes []string
+	}{
+		{
+			name:      "basic profile",
+			profile:   &dcmd.Profile{Name: "basic"},
+			wantCodes: []string{"113100"},
+		},
+		{
+			name:      "retain uids option",
+			profile:   &dcmd.Profile{Name: "retain", Options: []string{dcmd.RetainUIDsOption}},
+			wantCodes: []string{"113100", "113110"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newTestService(t)
+			f := newTestInstance("1.3.6.1.4.1.5962.1.1.1", "")
+			if err := s.DeidentifyFile(context.Background(), tt.profile, nil, f); err != nil {
+				t.Fatalf("DeidentifyFile() error = %v", err)
+			}
+
+			if got := f.Dataset.GetString(dicom.PatientIdentityRemoved); got != "YES" {
+				t.Errorf("PatientIdentityRemoved = %q, want YES", got)
+			}
+			method := f.Dataset.GetStrings(dicom.DeidentificationMethod)
+			if len(method) != 3 || method[0] != deid.LocalDeidentificationMethod || method[2] != "sha256:"+tt.profile.Hash() {
+				t.Errorf("DeidentificationMethod = %q, want method, profile name and hash", method)
+			}
+
+			var codes []string
+			for _, item := range f.Dataset.Get(dicom.DeidentificationMethodCodeSequence).Items {
+				codes = append(codes, item.GetString(dicom.CodeValue))
+				if scheme := item.GetString(dicom.CodingSchemeDesignator); scheme != "DCM" {
+					t.Errorf("CodingSchemeDesignator = %q, want DCM", scheme)
+				}
+			}
+			if !reflect.DeepEqual(codes, tt.wantCodes) {
+				t.Errorf("DeidentificationMethodCodeSequence = %v, want %v", codes, tt.wantCodes)
+			}
+		})
+	}
+}
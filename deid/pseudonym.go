@@ -0,0 +1,114 @@
+package deid
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	dcmd "gitlab.com/medical-research/dicom-deidentifier"
+)
+
+// DefaultPseudonymPrefix starts every pseudonym so they cannot be mistaken for hospital IDs
+const DefaultPseudonymPrefix = "SUBJ"
+
+// maxPseudonymAttempts bounds the retries when concurrent uploads race for the next sequential ID
+const maxPseudonymAttempts = 10
+
+// Ensure pseudonymizer implements interface.
+var _ dcmd.Pseudonymizer = (*TablePseudonymizer)(nil)
+
+// TablePseudonymizer gives every new patient of a project a pseudonym and records it in the
+// linkage table, so later uploads for the same patient are given the same pseudonym
+type TablePseudonymizer struct {
+	// Key is the managed secret pseudonyms and patient keys are derived from
+	Key []byte
+
+	// Prefix starts every pseudonym
+	Prefix string
+
+	// Sequential gives patients study-specific IDs numbered in the order they are first seen
+	// instead of IDs derived from a keyed hash
+	Sequential bool
+
+	PseudonymService dcmd.PseudonymService
+}
+
+// NewTablePseudonymizer returns a new instance of TablePseudonymizer
+func NewTablePseudonymizer(key []byte, pseudonymService dcmd.PseudonymService) (*TablePseudonymizer, error) {
+	if len(key) == 0 {
+		return nil, dcmd.Errorf(dcmd.EINVALID, "pseudonym key must not be empty")
+	}
+	return &TablePseudonymizer{
+		Key:              key,
+		Prefix:           DefaultPseudonymPrefix,
+		PseudonymService: pseudonymService,
+	}, nil
+}
+
+// Pseudonymize returns the recorded pseudonym of a patient, giving one if the patient has not been seen before
+func (p *TablePseudonymizer) Pseudonymize(ctx context.Context, project, issuer, patientID string) (string, error) {
+	if patientID == "" {
+		return "", dcmd.Errorf(dcmd.EINVALID, "patient id required to pseudonymize")
+	}
+	key := patientKey(p.Key, issuer, patientID)
+
+	for attempt := 0; attempt < maxPseudonymAttempts; attempt++ {
+		found, err := p.PseudonymService.FindPseudonym(ctx, project, key)
+		if err == nil {
+			return found.Pseudonym, nil
+		} else if dcmd.ErrorCode(err) != dcmd.ENOTFOUND {
+			return "", err
+		}
+
+		value, err := p.next(ctx, project, issuer, patientID)
+		if err != nil {
+			return "", err
+		}
+		if dcmd.IsDryRun(ctx) {
+			return value, nil
+		}
+		err = p.PseudonymService.CreatePseudonym(ctx, &dcmd.Pseudonym{
+			Project:    project,
+			PatientKey: key,
+			Pseudonym:  value,
+			Issuer:     issuer,
+			PatientID:  patientID,
+			CreatedAt:  time.Now(),
+		})
+		if err == nil {
+			return value, nil
+		} else if dcmd.ErrorCode(err) != dcmd.ECONFLICT || !p.Sequential {
+			// a keyed hash gives the same value on retry, so only sequential IDs are retried
+			return "", err
+		}
+	}
+	return "", fmt.Errorf("could not give a pseudonym after %d attempts", maxPseudonymAttempts)
+}
+
+// next returns the pseudonym a new patient is given
+func (p *TablePseudonymizer) next(ctx context.Context, project, issuer, patientID string) (string, error) {
+	if p.Sequential {
+		n, err := p.PseudonymService.CountPseudonyms(ctx, project)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("%s%06d", p.Prefix, n+1), nil
+	}
+
+	// the project is part of the hash so pseudonyms cannot be linked across projects
+	mac := hmac.New(sha256.New, p.Key)
+	mac.Write([]byte("pseudonym\x00" + project + "\x00" + issuer + "\x00" + patientID))
+	return p.Prefix + strings.ToUpper(hex.EncodeToString(mac.Sum(nil))[:16]), nil
+}
+
+// patientKey returns a keyed hash of a patient's issuer and ID, so tables can be indexed by
+// patient without holding identifiers
+func patientKey(secret []byte, issuer, patientID string) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(issuer + "\x00" + patientID))
+	return hex.EncodeToString(mac.Sum(nil))
+}
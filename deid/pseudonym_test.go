@@ -0,0 +1,104 @@
+package deid_test
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	dcmd "gitlab.com/medical-research/dicom-deidentifier"
+	"gitlab.com/medical-research/dicom-deidentifier/deid"
+	"gitlab.com/medical-research/dicom-deidentifier/dicom"
+	"gitlab.com/medical-research/dicom-deidentifier/filestore"
+)
+
+// mustOpenPseudonymService returns a linkage table in a temporary directory
+func mustOpenPseudonymService(t *testing.T) *filestore.PseudonymService {
+	t.Helper()
+	db := filestore.NewDB(t.TempDir())
+	if err := db.Open(); err != nil {
+		t.Fatalf("Open() error = %v", err)
+	}
+	s, err := filestore.NewPseudonymService(db, bytes.Repeat([]byte{7}, 32))
+	if err != nil {
+		t.Fatalf("NewPseudonymService() error = %v", err)
+	}
+	return s
+}
+
+func TestTablePseudonymizer_Pseudonymize(t *testing.T) {
+	ctx := context.Background()
+	tests := []struct {
+		name       string
+		sequential bool
+		want       string
+	}{
+		{
+			name: "keyed hash",
+		},
+		{
+			name:       "sequential",
+			sequential: true,
+			want:       "SUBJ000001",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := deid.NewTablePseudonymizer([]byte("secret"), mustOpenPseudonymService(t))
+			if err != nil {
+				t.Fatalf("NewTablePseudonymizer() error = %v", err)
+			}
+			p.Sequential = tt.sequential
+
+			first, err := p.Pseudonymize(ctx, "project-a", "HOSPITAL", "MRN0001")
+			if err != nil {
+				t.Fatalf("Pseudonymize() error = %v", err)
+			}
+			if tt.want != "" && first != tt.want {
+				t.Errorf("Pseudonymize() = %q, want %q", first, tt.want)
+			}
+			if !strings.HasPrefix(first, deid.DefaultPseudonymPrefix) || strings.Contains(first, "MRN0001") {
+				t.Errorf("Pseudonymize() = %q, want an unrelated pseudonym", first)
+			}
+
+			if again, _ := p.Pseudonymize(ctx, "project-a", "HOSPITAL", "MRN0001"); again != first {
+				t.Errorf("Pseudonymize() later upload = %q, want %q", again, first)
+			}
+			if other, _ := p.Pseudonymize(ctx, "project-a", "HOSPITAL", "MRN0002"); other == first {
+				t.Errorf("Pseudonymize() another patient = %q, want a different pseudonym", other)
+			}
+			if _, err := p.Pseudonymize(ctx, "project-a", "HOSPITAL", ""); dcmd.ErrorCode(err) != dcmd.EINVALID {
+				t.Errorf("Pseudonymize() without patient id error = %v, want %v", err, dcmd.EINVALID)
+			}
+		})
+	}
+}
+
+func TestDeidentificationService_DeidentifyFile_Pseudonymize(t *testing.T) {
+	pseudonymizer, err := deid.NewTablePseudonymizer([]byte("secret"), mustOpenPseudonymService(t))
+	if err != nil {
+		t.Fatalf("NewTablePseudonymizer() error = %v", err)
+	}
+	s := newTestService(t)
+	s.Pseudonymizer = pseudonymizer
+	profile := &dcmd.Profile{Name: "linked", Project: "project-a", Options: []string{dcmd.PseudonymizePatientIDOption}}
+
+	baseline := newTestInstance("1.3.6.1.4.1.5962.1.1.1", "")
+	followUp := newTestInstance("1.3.6.1.4.1.5962.1.1.2", "")
+	for _, f := range []*dicom.File{baseline, followUp} {
+		if err := s.DeidentifyFile(context.Background(), profile, nil, f); err != nil {
+			t.Fatalf("DeidentifyFile() error = %v", err)
+		}
+	}
+
+	got := baseline.Dataset.GetString(dicom.PatientID)
+	if got == "" || got == "MRN0001" {
+		t.Fatalf("PatientID = %q, want a pseudonym", got)
+	}
+	if name := baseline.Dataset.GetString(dicom.PatientName); name != got {
+		t.Errorf("PatientName = %q, want %q", name, got)
+	}
+	if later := followUp.Dataset.GetString(dicom.PatientID); later != got {
+		t.Errorf("PatientID of later upload = %q, want %q", later, got)
+	}
+}
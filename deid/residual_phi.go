@@ -0,0 +1,282 @@
+package deid
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	dcmd "gitlab.com/medical-research/dicom-deidentifier"
+	"gitlab.com/medical-research/dicom-deidentifier/dicom"
+)
+
+// sourceDateAttributes are the dates of the source header looked for in the output, besides the birth date
+var sourceDateAttributes = []dicom.Tag{
+	dicom.StudyDate,
+	dicom.Tag(0x00080021), // Series Date
+	dicom.Tag(0x00080022), // Acquisition Date
+	dicom.Tag(0x00080023), // Content Date
+}
+
+// Dates looked for in free text, in their ISO 8601 and DICOM forms
+var (
+	isoDate     = regexp.MustCompile(`\b(\d{4})-(\d{2})-(\d{2})\b`)
+	compactDate = regexp.MustCompile(`\b(?:19|20)\d{6}\b`)
+)
+
+// SourceIdentifiers collects the identifiers of the source instances of a job, which must not be
+// found in any of the de-identified instances
+type SourceIdentifiers struct {
+	patientNames []string
+	patientIDs   []string
+	staffNames   []string
+	birthDates   map[string]bool
+	dates        map[string]bool
+	uids         map[string]bool
+}
+
+// NewSourceIdentifiers returns a new instance of SourceIdentifiers
+func NewSourceIdentifiers() *SourceIdentifiers {
+	return &SourceIdentifiers{
+		birthDates: map[string]bool{},
+		dates:      map[string]bool{},
+		uids:       map[string]bool{},
+	}
+}
+
+// Add records the identifiers of a source instance
+func (s *SourceIdentifiers) Add(ds *dicom.Dataset) {
+	for _, tag := range patientNameAttributes {
+		s.patientNames = append(s.patientNames, nameTokens(ds.GetStrings(tag)...)...)
+	}
+	for _, tag := range patientIDAttributes {
+		s.patientIDs = append(s.patientIDs, ds.GetStrings(tag)...)
+	}
+	for _, tag := range staffNameAttributes {
+		s.staffNames = append(s.staffNames, nameTokens(ds.GetStrings(tag)...)...)
+	}
+	if date := ds.GetString(dicom.PatientBirthDate); len(date) == 8 {
+		s.birthDates[date] = true
+	}
+	for _, tag := range sourceDateAttributes {
+		if date := ds.GetString(tag); len(date) == 8 {
+			s.dates[date] = true
+		}
+	}
+
+	_ = ds.Walk(func(parent *dicom.Dataset, e *dicom.Element, path string) error {
+		if e.VR != dicom.UI || classUIDs[e.Tag] {
+			return nil
+		}
+		for _, uid := range e.Strings() {
+			if uid != "" && !isStandardUID(uid) {
+				s.uids[uid] = true
+			}
+		}
+		return nil
+	})
+}
+
+// ResidualPHIScanner scans de-identified instances for identifiers their profile should have
+// removed: identifiers of the source instances, attributes the profile removes, private
+// attributes not on the allow list and UIDs that were not remapped
+type ResidualPHIScanner struct {
+	profile    *dcmd.Profile
+	source     *SourceIdentifiers
+	dictionary *SafePrivateDictionary
+	rules      *attributeRules
+
+	// RedactedVRs are the value representations of the attributes kept with their identifiers
+	// redacted from the contents, as the Healthcare API does when it inspects tag contents. Their
+	// presence is not a finding, their values are still scanned for identifiers of the source.
+	RedactedVRs map[string]bool
+
+	// words match the names and IDs of the source instances, with the severity of a match
+	words []*scannerWords
+}
+
+// scannerWords are identifiers matched as whole words in text elements
+type scannerWords struct {
+	pattern  *ScrubPattern
+	what     string
+	severity string
+}
+
+// NewResidualPHIScanner returns a scanner of the output of profile for the identifiers of source.
+// The safe private dictionary is only used for profiles retaining safe private attributes.
+func NewResidualPHIScanner(profile *dcmd.Profile, source *SourceIdentifiers, dictionary *SafePrivateDictionary) *ResidualPHIScanner {
+	s := &ResidualPHIScanner{profile: profile, source: source, dictionary: dictionary}
+	// profiles with invalid rules cannot have been applied, so their rules are ignored
+	s.rules, _ = compileRules(profile)
+	if s.dictionary == nil {
+		s.dictionary = &SafePrivateDictionary{}
+	}
+	for _, w := range []struct {
+		words    []string
+		what     string
+		severity string
+	}{
+		{source.patientNames, "a patient name", dcmd.SeverityCritical},
+		{source.patientIDs, "a patient ID", dcmd.SeverityCritical},
+		{source.staffNames, "a staff name", dcmd.SeverityWarning},
+	} {
+		if re := wordsRegexp(w.words); re != nil {
+			s.words = append(s.words, &scannerWords{
+				pattern:  &ScrubPattern{Regexp: re, words: true},
+				what:     w.what,
+				severity: w.severity,
+			})
+		}
+	}
+	return s
+}
+
+// Scan returns the findings of a single de-identified instance
+func (s *ResidualPHIScanner) Scan(ds *dicom.Dataset) []*dcmd.PHIFinding {
+	var findings []*dcmd.PHIFinding
+	sopInstanceUID := ds.GetString(dicom.SOPInstanceUID)
+	add := func(path, rule, severity, format string, args ...interface{}) {
+		findings = append(findings, &dcmd.PHIFinding{
+			SOPInstanceUID: sopInstanceUID,
+			Path:           path,
+			Rule:           rule,
+			Severity:       severity,
+			Message:        fmt.Sprintf(format, args...),
+		})
+	}
+
+	// conditions can only be evaluated on the de-identified values
+	conditions := s.rules.conditionValues(ds)
+	_ = ds.Walk(func(parent *dicom.Dataset, e *dicom.Element, path string) error {
+		// attributes a rule keeps on purpose are still scanned for identifiers of the source
+		rule := s.rules.match(parent, e.Tag, conditions)
+		kept := rule != nil && rule.action == dcmd.RuleKeep
+		if e.Tag.IsPrivate() && !kept {
+			if !s.isSafePrivate(parent, e) {
+				add(path, dcmd.PrivateAttributeRule, dcmd.SeverityCritical, "private attribute of %q is not on the safe private allow list", strings.TrimSpace(parent.PrivateCreator(e.Tag)))
+			}
+			return nil
+		}
+
+		if s.isForbidden(parent, e, rule) {
+			if e.IsEmpty() {
+				add(path, dcmd.ForbiddenAttributeRule, dcmd.SeverityWarning, "%s is removed by the profile but present with an empty value", dicom.Keyword(e.Tag))
+			} else {
+				add(path, dcmd.ForbiddenAttributeRule, dcmd.SeverityCritical, "%s is removed by the profile but present", dicom.Keyword(e.Tag))
+			}
+		}
+
+		switch {
+		case e.VR == dicom.UI:
+			if s.profile.HasOption(dcmd.RetainUIDsOption) || kept {
+				return nil
+			}
+			for _, uid := range e.Strings() {
+				if s.source.uids[uid] {
+					add(path, dcmd.SourceUIDRule, dcmd.SeverityCritical, "UID of a source instance was not remapped")
+				}
+			}
+
+		case dicom.IsTextVR(e.VR):
+			for _, v := range e.Strings() {
+				if severity, what := s.sourceIdentifier(e.VR, v); severity != "" {
+					add(path, dcmd.SourceIdentifierRule, severity, "value holds %s of the source instances", what)
+				}
+			}
+		}
+		return nil
+	})
+	return findings
+}
+
+// sourceIdentifier returns the severity and a description of the most severe identifier of the
+// source instances found in a text value
+func (s *ResidualPHIScanner) sourceIdentifier(vr, v string) (severity, what string) {
+	if v == "" {
+		return "", ""
+	}
+	if vr != dicom.DA && vr != dicom.DT && vr != dicom.TM {
+		for _, w := range s.words {
+			if w.pattern.matches(v) && (severity == "" || w.severity == dcmd.SeverityCritical) {
+				severity, what = w.severity, w.what
+				if severity == dcmd.SeverityCritical {
+					return severity, what
+				}
+			}
+		}
+	}
+
+	// dates are looked for in their DICOM form and their ISO 8601 form
+	for _, date := range dateCandidates(vr, v) {
+		if s.source.birthDates[date] {
+			return dcmd.SeverityCritical, "a birth date"
+		} else if s.source.dates[date] && severity == "" {
+			// a shifted date of one patient may be the original date of another, hence only a warning
+			severity, what = dcmd.SeverityWarning, "a study date"
+		}
+	}
+	return severity, what
+}
+
+// dateCandidates returns the YYYYMMDD dates a value may hold
+func dateCandidates(vr, v string) []string {
+	switch vr {
+	case dicom.DA, dicom.DT:
+		if len(v) >= 8 {
+			return []string{v[:8]}
+		}
+		return nil
+	case dicom.TM, dicom.CS, dicom.AE, dicom.AS, dicom.DS, dicom.IS:
+		return nil
+	}
+	var dates []string
+	for _, m := range isoDate.FindAllStringSubmatch(v, -1) {
+		dates = append(dates, m[1]+m[2]+m[3])
+	}
+	for _, m := range compactDate.FindAllString(v, -1) {
+		dates = append(dates, m)
+	}
+	return dates
+}
+
+// isSafePrivate reports whether a private element may be present in the output of the profile
+func (s *ResidualPHIScanner) isSafePrivate(parent *dicom.Dataset, e *dicom.Element) bool {
+	if !s.profile.HasOption(dcmd.RetainSafePrivateOption) {
+		return false
+	}
+	if e.Tag.IsPrivateCreator() {
+		return true
+	}
+	safe, _ := s.dictionary.IsSafe(parent.PrivateCreator(e.Tag), e.Tag)
+	return safe
+}
+
+// isForbidden reports whether the profile removes an element of parent, rule being the attribute rule selecting it if any
+func (s *ResidualPHIScanner) isForbidden(parent *dicom.Dataset, e *dicom.Element, rule *attributeRule) bool {
+	if rule != nil {
+		return rule.action == dcmd.RuleRemove
+	}
+	if s.RedactedVRs[e.VR] {
+		return false
+	}
+	if isContentItem(parent) || resolveAction(basicProfileAction(e.Tag)) != ActionRemove {
+		// content items are de-identified item by item rather than by Table E.1-1
+		return false
+	}
+	switch {
+	case descriptorAttributes[e.Tag] && s.profile.HasOption(dcmd.CleanDescriptorsOption):
+		return false
+	case annotationAttributes[e.Tag] && s.profile.HasOption(dcmd.ScrubAnnotationsOption):
+		return false
+	}
+	if g := s.profile.Generalisation; g != nil {
+		switch e.Tag {
+		case dicom.PatientAge:
+			return g.AgeBucket == 0
+		case dicom.PatientSize:
+			return g.SizeStep == 0
+		case dicom.PatientWeight:
+			return g.WeightStep == 0
+		}
+	}
+	return true
+}
@@ -0,0 +1,92 @@
+package deid_test
+
+import (
+	"context"
+	"testing"
+
+	dcmd "gitlab.com/medical-research/dicom-deidentifier"
+	"gitlab.com/medical-research/dicom-deidentifier/deid"
+	"gitlab.com/medical-research/dicom-deidentifier/dicom"
+)
+
+func TestResidualPHIScanner_Scan(t *testing.T) {
+	s := newTestService(t)
+	profile := &dcmd.Profile{Name: "clean", Options: []string{dcmd.CleanDescriptorsOption}}
+
+	tests := []struct {
+		name      string
+		leak      func(ds *dicom.Dataset)
+		wantRule  string
+		wantLevel string
+	}{
+		{
+			name: "clean output",
+		},
+		{
+			name:      "patient name in a descriptor",
+			leak:      func(ds *dicom.Dataset) { ds.SetString(dicom.StudyDescription, "CT for JOHN") },
+			wantRule:  dcmd.SourceIdentifierRule,
+			wantLevel: dcmd.SeverityCritical,
+		},
+		{
+			name:      "birth date in a comment",
+			leak:      func(ds *dicom.Dataset) { ds.SetString(dicom.StudyDescription, "born 1961-04-02") },
+			wantRule:  dcmd.SourceIdentifierRule,
+			wantLevel: dcmd.SeverityCritical,
+		},
+		{
+			name:      "study date in a descriptor",
+			leak:      func(ds *dicom.Dataset) { ds.SetString(dicom.StudyDescription, "prior 20200110") },
+			wantRule:  dcmd.SourceIdentifierRule,
+			wantLevel: dcmd.SeverityWarning,
+		},
+		{
+			name:      "attribute removed by the profile",
+			leak:      func(ds *dicom.Dataset) { ds.Set(dicom.NewElement(dicom.Tag(0x00101040), dicom.LO, "1 High Street")) },
+			wantRule:  dcmd.ForbiddenAttributeRule,
+			wantLevel: dcmd.SeverityCritical,
+		},
+		{
+			name:      "private attribute",
+			leak:      func(ds *dicom.Dataset) { ds.Set(dicom.NewElement(dicom.Tag(0x00091001), dicom.LO, "private")) },
+			wantRule:  dcmd.PrivateAttributeRule,
+			wantLevel: dcmd.SeverityCritical,
+		},
+		{
+			name:      "source uid",
+			leak:      func(ds *dicom.Dataset) { ds.SetString(dicom.StudyInstanceUID, "1.3.6.1.4.1.5962.1.2.1") },
+			wantRule:  dcmd.SourceUIDRule,
+			wantLevel: dcmd.SeverityCritical,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newTestInstance("1.3.6.1.4.1.5962.1.1.1", "")
+			f.Dataset.SetString(dicom.PatientBirthDate, "19610402")
+			f.Dataset.SetString(dicom.StudyDate, "20200110")
+			source := deid.NewSourceIdentifiers()
+			source.Add(f.Dataset)
+
+			if err := s.DeidentifyFile(context.Background(), profile, nil, f); err != nil {
+				t.Fatalf("DeidentifyFile() error = %v", err)
+			}
+			if tt.leak != nil {
+				tt.leak(f.Dataset)
+			}
+
+			findings := deid.NewResidualPHIScanner(profile, source, nil).Scan(f.Dataset)
+			if tt.wantRule == "" {
+				for _, finding := range findings {
+					t.Errorf("unexpected finding %+v", finding)
+				}
+				return
+			}
+			if len(findings) != 1 {
+				t.Fatalf("Scan() = %d findings, want 1", len(findings))
+			}
+			if got := findings[0]; got.Rule != tt.wantRule || got.Severity != tt.wantLevel {
+				t.Errorf("Scan() = %s %s, want %s %s", got.Severity, got.Rule, tt.wantLevel, tt.wantRule)
+			}
+		})
+	}
+}
@@ -0,0 +1,52 @@
+package deid_test
+
+import (
+	"context"
+	"testing"
+
+	dcmd "gitlab.com/medical-research/dicom-deidentifier"
+	"gitlab.com/medical-research/dicom-deidentifier/dicom"
+	"gitlab.com/medical-research/dicom-deidentifier/mock"
+)
+
+func TestDeidentificationService_DeidentifyFile_Roster(t *testing.T) {
+	s := newTestService(t)
+	s.RosterService = &mock.RosterService{
+		FindRosterFn: func(ctx context.Context, project string, version int) (*dcmd.Roster, error) {
+			return &dcmd.Roster{
+				Project:     project,
+				Version:     1,
+				SponsorName: "ACME",
+				ProtocolID:  "P-1",
+				Entries: []*dcmd.RosterEntry{
+					{PatientID: "MRN0001", SubjectID: "TRIAL-001", SiteID: "01", SiteName: "General Hospital"},
+				},
+			}, nil
+		},
+	}
+	profile := &dcmd.Profile{Name: "trial", Project: "trial", Options: []string{dcmd.RosterSubjectIDsOption}}
+
+	enrolled := newTestInstance("1.3.6.1.4.1.5962.1.1.1", "")
+	if err := s.DeidentifyFile(context.Background(), profile, nil, enrolled); err != nil {
+		t.Fatalf("DeidentifyFile() error = %v", err)
+	}
+	for tag, want := range map[dicom.Tag]string{
+		dicom.PatientID:                "TRIAL-001",
+		dicom.PatientName:              "TRIAL-001",
+		dicom.ClinicalTrialSubjectID:   "TRIAL-001",
+		dicom.ClinicalTrialSponsorName: "ACME",
+		dicom.ClinicalTrialProtocolID:  "P-1",
+		dicom.ClinicalTrialSiteID:      "01",
+		dicom.ClinicalTrialSiteName:    "General Hospital",
+	} {
+		if got := enrolled.Dataset.GetString(tag); got != want {
+			t.Errorf("%s = %q, want %q", tag, got, want)
+		}
+	}
+
+	unknown := newTestInstance("1.3.6.1.4.1.5962.1.1.2", "")
+	unknown.Dataset.SetString(dicom.PatientID, "MRN0002")
+	if err := s.DeidentifyFile(context.Background(), profile, nil, unknown); dcmd.ErrorCode(err) != dcmd.EINVALID {
+		t.Errorf("DeidentifyFile() of patient not on roster error = %v, want %v", err, dcmd.EINVALID)
+	}
+}
@@ -0,0 +1,414 @@
+package deid
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	dcmd "gitlab.com/medical-research/dicom-deidentifier"
+	"gitlab.com/medical-research/dicom-deidentifier/dicom"
+)
+
+// hashLength is the number of hexadecimal digits kept of hashed values, short enough for SH and CS values
+const hashLength = 16
+
+// variable matches the variables of replacement values, e.g. "{site-code}"
+var variable = regexp.MustCompile(`\{([\w-]+)\}`)
+
+// attributeRules are the rules of a profile indexed by the attributes they select. Rules for a tag
+// take precedence over rules for a private block, which take precedence over rules for a group.
+type attributeRules struct {
+	tags    map[dicom.Tag][]*attributeRule
+	private []*attributeRule
+	groups  map[uint16][]*attributeRule
+
+	// conditionTags are the attributes the conditions of the rules read
+	conditionTags []dicom.Tag
+	actions       map[string]bool
+}
+
+// attributeRule is a validated rule of a profile
+type attributeRule struct {
+	action string
+	value  string
+
+	// days is the offset of RuleIncrementDate, step and datePrecision the precision of RuleGeneralise
+	days          int
+	step          float64
+	datePrecision string
+
+	// creator, group and element select the private attributes of a block
+	creator string
+	group   uint16
+	element uint16
+
+	conditions []*ruleCondition
+}
+
+// ruleCondition is a validated condition of a rule
+type ruleCondition struct {
+	tag       dicom.Tag
+	equals    *string
+	notEquals *string
+	in        []string
+	matches   *regexp.Regexp
+	present   *bool
+}
+
+// conditionValues holds the original values of the attributes read by conditions, attributes
+// missing from the instance having no entry
+type conditionValues map[dicom.Tag][]string
+
+// compileRules validates the rules of a profile and resolves the variables of replacement values.
+// Returns EINVALID if a rule has an unknown tag, action or variable, or a value its action cannot use.
+func compileRules(profile *dcmd.Profile) (*attributeRules, error) {
+	compiled := &attributeRules{
+		tags:    map[dicom.Tag][]*attributeRule{},
+		groups:  map[uint16][]*attributeRule{},
+		actions: map[string]bool{},
+	}
+	conditionTags := map[dicom.Tag]bool{}
+	for i, r := range profile.Rules {
+		rule, err := compileRule(profile, r)
+		if err != nil {
+			return nil, dcmd.Errorf(dcmd.EINVALID, "rule %d for %s: %s", i+1, r.Tag, dcmd.ErrorMessage(err))
+		}
+		compiled.actions[rule.action] = true
+
+		tag, group, err := parseRuleTag(r.Tag)
+		if err != nil {
+			return nil, dcmd.Errorf(dcmd.EINVALID, "rule %d: %s", i+1, dcmd.ErrorMessage(err))
+		}
+		if r.PrivateCreator == "" && !group && strings.Contains(strings.ToLower(r.Tag), "xx") {
+			return nil, dcmd.Errorf(dcmd.EINVALID, "rule %d: a private creator is required to select %s", i+1, r.Tag)
+		}
+		switch {
+		case r.PrivateCreator != "":
+			if tag.Group()%2 == 0 || tag.Element()>>8 != 0 {
+				return nil, dcmd.Errorf(dcmd.EINVALID, "rule %d: private attributes are selected as (gggg,xxee) with an odd group", i+1)
+			}
+			rule.creator, rule.group, rule.element = strings.TrimSpace(r.PrivateCreator), tag.Group(), tag.Element()
+			compiled.private = append(compiled.private, rule)
+		case group:
+			compiled.groups[tag.Group()] = append(compiled.groups[tag.Group()], rule)
+		default:
+			compiled.tags[tag] = append(compiled.tags[tag], rule)
+		}
+
+		for _, c := range rule.conditions {
+			if !conditionTags[c.tag] {
+				conditionTags[c.tag] = true
+				compiled.conditionTags = append(compiled.conditionTags, c.tag)
+			}
+		}
+	}
+	return compiled, nil
+}
+
+// compileRule validates the action, value and conditions of a single rule
+func compileRule(profile *dcmd.Profile, r *dcmd.AttributeRule) (*attributeRule, error) {
+	rule := &attributeRule{action: r.Action, value: r.Value}
+	switch r.Action {
+	case dcmd.RuleKeep, dcmd.RuleRemove, dcmd.RuleEmpty, dcmd.RuleDummy, dcmd.RuleRemapUID, dcmd.RuleHash, dcmd.RuleShiftDate:
+
+	case dcmd.RuleIncrementDate:
+		days, err := strconv.Atoi(r.Value)
+		if err != nil {
+			return nil, dcmd.Errorf(dcmd.EINVALID, "invalid number of days %q", r.Value)
+		}
+		rule.days = days
+
+	case dcmd.RuleReplace:
+		var missing string
+		rule.value = variable.ReplaceAllStringFunc(r.Value, func(v string) string {
+			name := v[1 : len(v)-1]
+			if value, ok := profile.Variables[name]; ok {
+				return value
+			} else if name == "project" {
+				return profile.Project
+			}
+			missing = name
+			return v
+		})
+		if missing != "" {
+			return nil, dcmd.Errorf(dcmd.EINVALID, "unknown variable %q", missing)
+		}
+
+	case dcmd.RuleGeneralise:
+		switch r.Value {
+		case "year", "month":
+			rule.datePrecision = r.Value
+		default:
+			step, err := strconv.ParseFloat(r.Value, 64)
+			if err != nil || step <= 0 {
+				return nil, dcmd.Errorf(dcmd.EINVALID, "generalise takes \"year\", \"month\" or a positive step, not %q", r.Value)
+			}
+			rule.step = step
+		}
+
+	default:
+		return nil, dcmd.Errorf(dcmd.EINVALID, "unknown action %q", r.Action)
+	}
+
+	for _, c := range r.If {
+		condition, err := compileCondition(c)
+		if err != nil {
+			return nil, err
+		}
+		rule.conditions = append(rule.conditions, condition)
+	}
+	return rule, nil
+}
+
+// compileCondition validates a condition, which must set exactly one comparison
+func compileCondition(c *dcmd.RuleCondition) (*ruleCondition, error) {
+	tag, group, err := parseRuleTag(c.Tag)
+	if err != nil {
+		return nil, err
+	} else if group {
+		return nil, dcmd.Errorf(dcmd.EINVALID, "conditions apply to a single attribute, not %q", c.Tag)
+	}
+
+	condition := &ruleCondition{tag: tag, present: c.Present}
+	n := 0
+	if c.Equals != "" {
+		condition.equals = &c.Equals
+		n++
+	}
+	if c.NotEquals != "" {
+		condition.notEquals = &c.NotEquals
+		n++
+	}
+	if len(c.In) > 0 {
+		condition.in = c.In
+		n++
+	}
+	if c.Matches != "" {
+		if condition.matches, err = regexp.Compile(c.Matches); err != nil {
+			return nil, dcmd.Errorf(dcmd.EINVALID, "invalid pattern %q: %v", c.Matches, err)
+		}
+		n++
+	}
+	if c.Present != nil {
+		n++
+	}
+	if n != 1 {
+		return nil, dcmd.Errorf(dcmd.EINVALID, "condition on %s must have exactly one comparison", c.Tag)
+	}
+	return condition, nil
+}
+
+// parseRuleTag parses the tag of an attribute rule: a keyword, "(gggg,eeee)", "ggggeeee", a
+// whole group "(gggg,xxxx)", in which case group is set, or a private element "(gggg,xxee)"
+func parseRuleTag(s string) (tag dicom.Tag, group bool, err error) {
+	if t, ok := dicom.TagForKeyword(s); ok {
+		return t, false, nil
+	}
+	hex := strings.ToLower(strings.NewReplacer("(", "", ")", "", ",", "").Replace(s))
+	if len(hex) != 8 {
+		return 0, false, dcmd.Errorf(dcmd.EINVALID, "invalid attribute %q", s)
+	}
+	switch {
+	case hex[4:] == "xxxx":
+		group = true
+		hex = hex[:4] + "0000"
+	case hex[4:6] == "xx":
+		hex = hex[:4] + "00" + hex[6:]
+	}
+	n, err := strconv.ParseUint(hex, 16, 32)
+	if err != nil {
+		return 0, false, dcmd.Errorf(dcmd.EINVALID, "invalid attribute %q", s)
+	}
+	return dicom.Tag(n), group, nil
+}
+
+// uses reports whether any rule takes the action
+func (r *attributeRules) uses(action string) bool {
+	return r != nil && r.actions[action]
+}
+
+// conditionValues returns the values of the attributes read by the conditions of the rules
+func (r *attributeRules) conditionValues(ds *dicom.Dataset) conditionValues {
+	values := conditionValues{}
+	if r == nil {
+		return values
+	}
+	for _, tag := range r.conditionTags {
+		if e := ds.Get(tag); e != nil {
+			values[tag] = e.Strings()
+		}
+	}
+	return values
+}
+
+// selectsPrivate reports whether any rule selects private attributes by creator
+func (r *attributeRules) selectsPrivate() bool {
+	return r != nil && len(r.private) > 0
+}
+
+// match returns the first rule selecting an element of parent whose conditions hold
+func (r *attributeRules) match(parent *dicom.Dataset, tag dicom.Tag, values conditionValues) *attributeRule {
+	if r == nil {
+		return nil
+	}
+	for _, rule := range r.tags[tag] {
+		if rule.holds(values) {
+			return rule
+		}
+	}
+	if tag.IsPrivate() && !tag.IsPrivateCreator() {
+		creator := strings.TrimSpace(parent.PrivateCreator(tag))
+		for _, rule := range r.private {
+			if rule.creator == creator && tag.Group() == rule.group && tag.Element()&0xFF == rule.element && rule.holds(values) {
+				return rule
+			}
+		}
+	}
+	for _, rule := range r.groups[tag.Group()] {
+		if rule.holds(values) {
+			return rule
+		}
+	}
+	return nil
+}
+
+// holds reports whether every condition of the rule holds
+func (rule *attributeRule) holds(values conditionValues) bool {
+	for _, c := range rule.conditions {
+		if !c.holds(values) {
+			return false
+		}
+	}
+	return true
+}
+
+// holds reports whether the condition holds for the original values of the instance
+func (c *ruleCondition) holds(values conditionValues) bool {
+	v, present := values[c.tag]
+	if c.present != nil {
+		return present == *c.present
+	}
+	if c.notEquals != nil {
+		return !contains(v, *c.notEquals)
+	}
+	for _, s := range v {
+		switch {
+		case c.equals != nil && s == *c.equals:
+			return true
+		case c.matches != nil && c.matches.MatchString(s):
+			return true
+		case c.in != nil && contains(c.in, s):
+			return true
+		}
+	}
+	return false
+}
+
+// contains reports whether values holds v
+func contains(values []string, v string) bool {
+	for _, s := range values {
+		if s == v {
+			return true
+		}
+	}
+	return false
+}
+
+// applyRule performs the action of a rule on an element of parent
+func (d *deidentifier) applyRule(parent *dicom.Dataset, e *dicom.Element, rule *attributeRule) error {
+	switch rule.action {
+	case dcmd.RuleKeep:
+	case dcmd.RuleRemove:
+		parent.Remove(e.Tag)
+	case dcmd.RuleEmpty:
+		return d.apply(parent, e, ActionZero)
+	case dcmd.RuleDummy:
+		return d.apply(parent, e, ActionDummy)
+	case dcmd.RuleRemapUID:
+		return d.apply(parent, e, ActionUID)
+
+	case dcmd.RuleIncrementDate, dcmd.RuleShiftDate:
+		if e.VR != dicom.DA && e.VR != dicom.DT {
+			return fmt.Errorf("dates cannot be shifted in a %s element", e.VR)
+		}
+		days := rule.days
+		if rule.action == dcmd.RuleShiftDate {
+			days = d.ruleShift
+		}
+		shiftDates(e, days)
+		d.record(e, dcmd.ElementShifted)
+
+	case dcmd.RuleReplace:
+		if !dicom.IsTextVR(e.VR) {
+			return fmt.Errorf("a %s element cannot be replaced by text", e.VR)
+		}
+		e.SetStrings(rule.value)
+
+	case dcmd.RuleHash:
+		if !dicom.IsTextVR(e.VR) {
+			return fmt.Errorf("a %s element cannot be hashed", e.VR)
+		}
+		values := e.Strings()
+		for i, v := range values {
+			if v != "" {
+				values[i] = d.hash(v)
+			}
+		}
+		e.SetStrings(values...)
+
+	case dcmd.RuleGeneralise:
+		return generaliseElement(e, rule, d.profile.Generalisation)
+	}
+	return nil
+}
+
+// hash returns the keyed hash of a value salted with the project of the profile, so equal values
+// can be linked within a project but not across projects
+func (d *deidentifier) hash(v string) string {
+	mac := hmac.New(sha256.New, d.service.HashKey)
+	mac.Write([]byte("attribute\x00" + d.profile.Project + "\x00" + v))
+	return strings.ToUpper(hex.EncodeToString(mac.Sum(nil))[:hashLength])
+}
+
+// generaliseElement coarsens the values of an element as a generalise rule describes
+func generaliseElement(e *dicom.Element, rule *attributeRule, g *dcmd.Generalisation) error {
+	values := e.Strings()
+	for i, v := range values {
+		switch {
+		case v == "":
+		case e.VR == dicom.AS && rule.step > 0:
+			maxAge := dcmd.DefaultMaxAge
+			if g != nil {
+				maxAge = g.MaxAgeOrDefault()
+			}
+			values[i] = generaliseAge(v, int(rule.step), maxAge)
+		case (e.VR == dicom.DA || e.VR == dicom.DT) && rule.datePrecision != "":
+			values[i] = truncateDate(v, rule.datePrecision)
+		case (e.VR == dicom.DS || e.VR == dicom.IS) && rule.step > 0:
+			rounded, ok := roundToStep(v, rule.step)
+			if !ok {
+				return fmt.Errorf("invalid %s value", e.VR)
+			}
+			values[i] = rounded
+		default:
+			return fmt.Errorf("a %s element cannot be generalised to %q", e.VR, rule.value)
+		}
+	}
+	e.SetStrings(values...)
+	return nil
+}
+
+// truncateDate reduces a DA or DT value to the first day of its year or month
+func truncateDate(v, precision string) string {
+	if len(v) < 8 {
+		return ""
+	}
+	if precision == "year" {
+		return v[:4] + "0101"
+	}
+	return v[:6] + "01"
+}
@@ -0,0 +1,91 @@
+package deid_test
+
+import (
+	"context"
+	"testing"
+
+	dcmd "gitlab.com/medical-research/dicom-deidentifier"
+	"gitlab.com/medical-research/dicom-deidentifier/dicom"
+)
+
+func TestDeidentificationService_DeidentifyFile_Rules(t *testing.T) {
+	s := newTestService(t)
+
+	tests := []struct {
+		name    string
+		rule    *dcmd.AttributeRule
+		tag     dicom.Tag
+		want    string
+		removed bool
+		wantErr string
+	}{
+		{
+			name: "keep by keyword",
+			rule: &dcmd.AttributeRule{Tag: "InstitutionName", Action: dcmd.RuleKeep},
+			tag:  dicom.InstitutionName,
+			want: "General Hospital",
+		},
+		{
+			name: "replace",
+			rule: &dcmd.AttributeRule{Tag: "(0008,0080)", Action: dcmd.RuleReplace, Value: "Site 12"},
+			tag:  dicom.InstitutionName,
+			want: "Site 12",
+		},
+		{
+			name: "increment date",
+			rule: &dcmd.AttributeRule{Tag: "00080020", Action: dcmd.RuleIncrementDate, Value: "-10"},
+			tag:  dicom.StudyDate,
+			want: "20200105",
+		},
+		{
+			name: "keep group",
+			rule: &dcmd.AttributeRule{Tag: "(0010,xxxx)", Action: dcmd.RuleKeep},
+			tag:  dicom.PatientName,
+			want: "Doe^John",
+		},
+		{
+			name:    "remove",
+			rule:    &dcmd.AttributeRule{Tag: "SOPClassUID", Action: dcmd.RuleRemove},
+			tag:     dicom.SOPClassUID,
+			removed: true,
+		},
+		{
+			name:    "unknown attribute",
+			rule:    &dcmd.AttributeRule{Tag: "NotAKeyword", Action: dcmd.RuleKeep},
+			wantErr: dcmd.EINVALID,
+		},
+		{
+			name:    "invalid days",
+			rule:    &dcmd.AttributeRule{Tag: "StudyDate", Action: dcmd.RuleIncrementDate, Value: "soon"},
+			wantErr: dcmd.EINVALID,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newTestInstance("1.3.6.1.4.1.5962.1.1.1", "")
+			f.Dataset.Set(dicom.NewElement(dicom.StudyDate, dicom.DA, "20200115"))
+			profile := &dcmd.Profile{Name: "rules", Rules: []*dcmd.AttributeRule{tt.rule}}
+
+			err := s.DeidentifyFile(context.Background(), profile, nil, f)
+			if tt.wantErr != "" {
+				if dcmd.ErrorCode(err) != tt.wantErr {
+					t.Errorf("DeidentifyFile() error = %v, want %s", err, tt.wantErr)
+				}
+				return
+			} else if err != nil {
+				t.Fatalf("DeidentifyFile() error = %v", err)
+			}
+
+			e := f.Dataset.Get(tt.tag)
+			if tt.removed {
+				if e != nil {
+					t.Errorf("DeidentifyFile() kept %s", dicom.Keyword(tt.tag))
+				}
+				return
+			}
+			if e == nil || e.String() != tt.want {
+				t.Errorf("DeidentifyFile() %s = %v, want %q", dicom.Keyword(tt.tag), e, tt.want)
+			}
+		})
+	}
+}
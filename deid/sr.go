@@ -0,0 +1,77 @@
+package deid
+
+import (
+	"gitlab.com/medical-research/dicom-deidentifier/dicom"
+)
+
+// observerContextConcepts are the DCM concepts of the observer context, PS3.16 TID 1002, whose
+// content items describe the person who made the observations or locate the device used
+var observerContextConcepts = map[string]bool{
+	"121008": true, // Person Observer Name
+	"121009": true, // Person Observer's Organization Name
+	"121010": true, // Person Observer's Role in the Organization
+	"121011": true, // Person Observer's Role in this Procedure
+	"121016": true, // Device Observer Serial Number
+	"121017": true, // Device Observer Physical Location During Observation
+}
+
+// observerTypeConcept is the Observer Type content item, removed along with the person observer
+// it introduces, observerTypePerson
+const (
+	observerTypeConcept = "121005"
+	observerTypePerson  = "121006"
+)
+
+// contentItemTemporalAttributes are the date and time values of content items
+var contentItemTemporalAttributes = map[dicom.Tag]bool{
+	dicom.DateTime:        true,
+	dicom.Date:            true,
+	dicom.Time:            true,
+	dicom.Tag(0x0040A032): true, // Observation DateTime
+}
+
+// isContentItem reports whether ds is an SR content item, such as the items of a Content Sequence
+// or of an Acquisition Context Sequence
+func isContentItem(ds *dicom.Dataset) bool {
+	return ds.Get(dicom.ValueType) != nil
+}
+
+// contentItemAction returns the action taken for an element of a content item, whose values are
+// mandatory so they are replaced rather than removed when the table removes or ignores them.
+// Dates are shifted and text cleaned by the options as for any other attribute.
+func contentItemAction(e *dicom.Element, action string) string {
+	switch {
+	case e.Tag == dicom.TextValue, e.Tag == dicom.PersonName, contentItemTemporalAttributes[e.Tag]:
+		return ActionDummy
+	}
+	return action
+}
+
+// removeObserverContext removes the content items identifying the observer from a Content Sequence
+func removeObserverContext(e *dicom.Element) {
+	items := e.Items[:0]
+	for _, item := range e.Items {
+		if !isObserverContextItem(item) {
+			items = append(items, item)
+		}
+	}
+	e.Items = items
+}
+
+// isObserverContextItem reports whether a content item belongs to the observer context
+func isObserverContextItem(item *dicom.Dataset) bool {
+	concept := codeValue(item, dicom.ConceptNameCodeSequence)
+	if concept == observerTypeConcept {
+		return codeValue(item, dicom.ConceptCodeSequence) == observerTypePerson
+	}
+	return observerContextConcepts[concept]
+}
+
+// codeValue returns the DCM code value of the first item of a code sequence
+func codeValue(ds *dicom.Dataset, tag dicom.Tag) string {
+	e := ds.Get(tag)
+	if e == nil || len(e.Items) == 0 || e.Items[0].GetString(dicom.CodingSchemeDesignator) != "DCM" {
+		return ""
+	}
+	return e.Items[0].GetString(dicom.CodeValue)
+}
@@ -0,0 +1,100 @@
+package deid_test
+
+import (
+	"context"
+	"testing"
+
+	dcmd "gitlab.com/medical-research/dicom-deidentifier"
+	"gitlab.com/medical-research/dicom-deidentifier/dicom"
+	"gitlab.com/medical-research/dicom-deidentifier/mock"
+)
+
+// newContentItem returns an SR content item of the given value type and DCM concept name
+func newContentItem(valueType, concept string, elements ...*dicom.Element) *dicom.Dataset {
+	item := dicom.NewDataset(elements...)
+	item.Set(dicom.NewElement(dicom.Tag(0x0040A010), dicom.CS, "HAS OBS CONTEXT"))
+	item.Set(dicom.NewElement(dicom.ValueType, dicom.CS, valueType))
+	item.Set(dicom.NewSequence(dicom.ConceptNameCodeSequence, dicom.NewDataset(
+		dicom.NewElement(dicom.CodeValue, dicom.SH, concept),
+		dicom.NewElement(dicom.CodingSchemeDesignator, dicom.SH, "DCM"),
+	)))
+	return item
+}
+
+func newTestReport() *dicom.File {
+	f := newTestInstance("1.3.6.1.4.1.5962.1.1.1", "")
+	f.Dataset.Set(dicom.NewSequence(dicom.ContentSequence,
+		newContentItem("CODE", "121005", dicom.NewSequence(dicom.ConceptCodeSequence, dicom.NewDataset(
+			dicom.NewElement(dicom.CodeValue, dicom.SH, "121006"),
+			dicom.NewElement(dicom.CodingSchemeDesignator, dicom.SH, "DCM"),
+		))),
+		newContentItem("PNAME", "121008", dicom.NewElement(dicom.PersonName, dicom.PN, "Smith^Anna")),
+		newContentItem("TEXT", "121013", dicom.NewElement(dicom.TextValue, dicom.UT, "lung-ai 2.1")),
+		newContentItem("CONTAINER", "126010",
+			dicom.NewSequence(dicom.ContentSequence,
+				newContentItem("TEXT", "121071", dicom.NewElement(dicom.TextValue, dicom.UT, "nodule seen by Dr Smith on 2019-03-02")),
+				newContentItem("DATE", "111018", dicom.NewElement(dicom.Date, dicom.DA, "20190302")),
+				newContentItem("UIDREF", "121232", dicom.NewElement(dicom.UID, dicom.UI, "1.3.6.1.4.1.5962.1.9.1")),
+				newContentItem("PNAME", "121025", dicom.NewElement(dicom.PersonName, dicom.PN, "Doe^John")),
+			),
+		),
+	))
+	return f
+}
+
+func TestDeidentificationService_DeidentifyFile_StructuredReport(t *testing.T) {
+	s := newTestService(t)
+	s.DateShifter = &mock.DateShifter{
+		PatientDateShiftFn: func(ctx context.Context, issuer, patientID string) (int, error) {
+			return -10, nil
+		},
+	}
+
+	tests := []struct {
+		name     string
+		profile  *dcmd.Profile
+		wantText string
+		wantDate string
+	}{
+		{
+			name:     "basic",
+			profile:  &dcmd.Profile{Name: "basic"},
+			wantText: "ANONYMIZED",
+			wantDate: "19000101",
+		},
+		{
+			name:     "clean descriptors and shift dates",
+			profile:  &dcmd.Profile{Name: "sr", Options: []string{dcmd.CleanDescriptorsOption, dcmd.RetainLongitudinalModifiedDatesOption}},
+			wantText: "nodule seen by Dr [STAFF] on [DATE]",
+			wantDate: "20190220",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newTestReport()
+			f.Dataset.SetString(dicom.ReferringPhysicianName, "Smith^Anna")
+			if err := s.DeidentifyFile(context.Background(), tt.profile, nil, f); err != nil {
+				t.Fatalf("DeidentifyFile() error = %v", err)
+			}
+
+			root := f.Dataset.Get(dicom.ContentSequence)
+			if root == nil || len(root.Items) != 2 {
+				t.Fatalf("ContentSequence = %v, want the person observer removed", root)
+			}
+
+			items := root.Items[1].Get(dicom.ContentSequence).Items
+			if v := items[0].GetString(dicom.TextValue); v != tt.wantText {
+				t.Errorf("TextValue = %q, want %q", v, tt.wantText)
+			}
+			if v := items[1].GetString(dicom.Date); v != tt.wantDate {
+				t.Errorf("Date = %q, want %q", v, tt.wantDate)
+			}
+			if v := items[2].GetString(dicom.UID); v == "" || v == "1.3.6.1.4.1.5962.1.9.1" {
+				t.Errorf("UID = %q, want a remapped UID", v)
+			}
+			if v := items[3].GetString(dicom.PersonName); v != "ANONYMIZED" {
+				t.Errorf("PersonName = %q, want %q", v, "ANONYMIZED")
+			}
+		})
+	}
+}
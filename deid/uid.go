@@ -0,0 +1,134 @@
+package deid
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	dcmd "gitlab.com/medical-research/dicom-deidentifier"
+)
+
+// dicomRoot prefixes the UIDs defined by the standard itself, e.g. SOP classes and transfer syntaxes
+const dicomRoot = "1.2.840.10008."
+
+// maxUIDLength is the maximum length of a UI value
+const maxUIDLength = 64
+
+// Ensure remappers implement interface.
+var _ dcmd.UIDRemapper = (*HashUIDRemapper)(nil)
+var _ dcmd.UIDRemapper = (*TableUIDRemapper)(nil)
+
+// HashUIDRemapper derives replacement UIDs under an organisation root from a keyed hash of the
+// original UID. The same UID and key always give the same replacement, so no state is kept.
+type HashUIDRemapper struct {
+	Root string
+	Key  []byte
+}
+
+// NewHashUIDRemapper returns a new instance of HashUIDRemapper
+func NewHashUIDRemapper(root string, key []byte) (*HashUIDRemapper, error) {
+	if err := ValidateUIDRoot(root); err != nil {
+		return nil, err
+	}
+	if len(key) == 0 {
+		return nil, dcmd.Errorf(dcmd.EINVALID, "uid hash key must not be empty")
+	}
+	return &HashUIDRemapper{Root: root, Key: key}, nil
+}
+
+// RemapUID returns the replacement for an original UID
+func (r *HashUIDRemapper) RemapUID(ctx context.Context, uid string) (string, error) {
+	mac := hmac.New(sha256.New, r.Key)
+	mac.Write([]byte(uid))
+	return uidFromNumber(r.Root, new(big.Int).SetBytes(mac.Sum(nil))), nil
+}
+
+// TableUIDRemapper replaces UIDs with random UIDs under an organisation root and records every
+// replacement, so the original UID can later be looked up by whoever holds the mapping table
+type TableUIDRemapper struct {
+	Root      string
+	Namespace string
+
+	UIDMappingService dcmd.UIDMappingService
+}
+
+// NewTableUIDRemapper returns a new instance of TableUIDRemapper
+func NewTableUIDRemapper(root, namespace string, uidMappingService dcmd.UIDMappingService) (*TableUIDRemapper, error) {
+	if err := ValidateUIDRoot(root); err != nil {
+		return nil, err
+	}
+	return &TableUIDRemapper{
+		Root:              root,
+		Namespace:         namespace,
+		UIDMappingService: uidMappingService,
+	}, nil
+}
+
+// RemapUID returns the recorded replacement for an original UID, creating one if it has not been seen before
+func (r *TableUIDRemapper) RemapUID(ctx context.Context, uid string) (string, error) {
+	m, err := r.UIDMappingService.FindUIDMapping(ctx, r.Namespace, uid)
+	if err == nil {
+		return m.ReplacementUID, nil
+	} else if dcmd.ErrorCode(err) != dcmd.ENOTFOUND {
+		return "", err
+	}
+
+	n, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return "", fmt.Errorf("could not generate uid: %v", err)
+	}
+	m = &dcmd.UIDMapping{
+		Namespace:      r.Namespace,
+		OriginalUID:    uid,
+		ReplacementUID: uidFromNumber(r.Root, n),
+		CreatedAt:      time.Now(),
+	}
+	if dcmd.IsDryRun(ctx) {
+		return m.ReplacementUID, nil
+	}
+	err = r.UIDMappingService.CreateUIDMapping(ctx, m)
+	if dcmd.ErrorCode(err) == dcmd.ECONFLICT {
+		// another instance of the job mapped the same UID first
+		return r.RemapUID(ctx, uid)
+	} else if err != nil {
+		return "", err
+	}
+	return m.ReplacementUID, nil
+}
+
+// ValidateUIDRoot checks that root can prefix generated UIDs
+func ValidateUIDRoot(root string) error {
+	if root == "" {
+		return dcmd.Errorf(dcmd.EINVALID, "uid root must not be empty")
+	}
+	// leave room for at least a 20 digit component
+	if len(root) > maxUIDLength-21 {
+		return dcmd.Errorf(dcmd.EINVALID, "uid root %q is too long", root)
+	}
+	for _, c := range strings.Split(root, ".") {
+		if c == "" || strings.Trim(c, "0123456789") != "" || (len(c) > 1 && c[0] == '0') {
+			return dcmd.Errorf(dcmd.EINVALID, "uid root %q is not a valid uid", root)
+		}
+	}
+	return nil
+}
+
+// uidFromNumber appends n to root as a final component, reduced to fit within the maximum UID length
+func uidFromNumber(root string, n *big.Int) string {
+	digits := maxUIDLength - len(root) - 1
+	if digits > 39 {
+		digits = 39
+	}
+	n = new(big.Int).Mod(n, new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(digits)), nil))
+	return root + "." + n.String()
+}
+
+// isStandardUID reports whether uid is defined by the DICOM standard and must never be replaced
+func isStandardUID(uid string) bool {
+	return strings.HasPrefix(uid, dicomRoot)
+}
@@ -0,0 +1,188 @@
+package deid
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/jpeg"
+	"math"
+
+	dcmd "gitlab.com/medical-research/dicom-deidentifier"
+	"gitlab.com/medical-research/dicom-deidentifier/dicom"
+)
+
+// WholeSlideMicroscopyImageStorage is the SOP class of VL Whole Slide Microscopy images
+const WholeSlideMicroscopyImageStorage = "1.2.840.10008.5.1.4.1.1.77.1.6"
+
+// Flavors of whole slide images, value 3 of Image Type. Some scanners still write MACRO for overviews.
+const (
+	slideLabelImage    = "LABEL"
+	slideOverviewImage = "OVERVIEW"
+	slideMacroImage    = "MACRO"
+)
+
+// DefaultMacroLabelRegion is the region of overview images blanked when the profile does not give one,
+// the end of the slide where most scanners place the label
+var DefaultMacroLabelRegion = dcmd.Region{Left: 0, Top: 0, Width: 0.35, Height: 1}
+
+// macroJPEGQuality is the quality overview images are encoded with after their label is blanked
+const macroJPEGQuality = 90
+
+// slideImageFlavor returns the flavor of a whole slide image, or "" for any other instance
+func slideImageFlavor(ds *dicom.Dataset) string {
+	if ds.GetString(dicom.SOPClassUID) != WholeSlideMicroscopyImageStorage {
+		return ""
+	}
+	imageType := ds.GetStrings(dicom.ImageType)
+	if len(imageType) < 3 {
+		return ""
+	}
+	return imageType[2]
+}
+
+// deidentifySlideImage applies the profile options about the label and overview images of a
+// whole slide. Pyramid levels and thumbnails are left untouched.
+func deidentifySlideImage(f *dicom.File, profile *dcmd.Profile, report *dcmd.JobReport) error {
+	switch slideImageFlavor(f.Dataset) {
+	case slideLabelImage:
+		if profile.HasOption(dcmd.RemoveSlideLabelsOption) {
+			report.AddExcludedInstance()
+			return dcmd.ErrInstanceExcluded
+		} else if profile.HasOption(dcmd.BlankSlideLabelsOption) {
+			return blankPixelData(f)
+		}
+	case slideOverviewImage, slideMacroImage:
+		if profile.HasOption(dcmd.BlankMacroLabelOption) {
+			region := DefaultMacroLabelRegion
+			if profile.MacroLabelRegion != nil {
+				region = *profile.MacroLabelRegion
+			}
+			return blankRegion(f, region)
+		}
+	}
+	return nil
+}
+
+// blankPixelData replaces every pixel with zero. Compressed pixel data is replaced by native
+// pixel data, as blanking it would require an encoder for every transfer syntax.
+func blankPixelData(f *dicom.File) error {
+	e := f.Dataset.Get(dicom.PixelData)
+	if e == nil {
+		return nil
+	}
+	if !e.IsEncapsulated() {
+		e.Value = make([]byte, len(e.Value))
+		return nil
+	}
+
+	bitsAllocated := intValue(f.Dataset, dicom.BitsAllocated, 8)
+	samples := intValue(f.Dataset, dicom.SamplesPerPixel, 1)
+	size := intValue(f.Dataset, dicom.Rows, 0) * intValue(f.Dataset, dicom.Columns, 0) *
+		intValue(f.Dataset, dicom.NumberOfFrames, 1) * samples * bitsAllocated / 8
+
+	vr := dicom.OW
+	if bitsAllocated <= 8 {
+		vr = dicom.OB
+	}
+	f.Dataset.Set(&dicom.Element{Tag: dicom.PixelData, VR: vr, Value: make([]byte, size+size%2)})
+	if samples == 3 {
+		f.Dataset.SetString(dicom.PhotometricInterpretation, "RGB")
+		planar := &dicom.Element{Tag: dicom.PlanarConfiguration, VR: dicom.US}
+		planar.SetInts(0)
+		f.Dataset.Set(planar)
+	} else {
+		f.Dataset.SetString(dicom.PhotometricInterpretation, "MONOCHROME2")
+	}
+	f.Meta.SetString(dicom.TransferSyntaxUID, dicom.ExplicitVRLittleEndian)
+	return nil
+}
+
+// blankRegion fills a region of every frame with zero. Native pixel data and baseline JPEG single
+// frame images are supported, which covers the overview images of the scanners we have seen.
+func blankRegion(f *dicom.File, region dcmd.Region) error {
+	e := f.Dataset.Get(dicom.PixelData)
+	if e == nil {
+		return nil
+	}
+	rows, columns := intValue(f.Dataset, dicom.Rows, 0), intValue(f.Dataset, dicom.Columns, 0)
+	rect := image.Rect(
+		int(math.Floor(region.Left*float64(columns))),
+		int(math.Floor(region.Top*float64(rows))),
+		int(math.Ceil((region.Left+region.Width)*float64(columns))),
+		int(math.Ceil((region.Top+region.Height)*float64(rows))),
+	).Intersect(image.Rect(0, 0, columns, rows))
+	if rect.Empty() {
+		return nil
+	}
+
+	if !e.IsEncapsulated() {
+		return blankNativeRegion(f.Dataset, e, rect)
+	}
+	if ts := f.TransferSyntax(); ts != dicom.JPEGBaseline8Bit {
+		return dcmd.Errorf(dcmd.EINVALID, "cannot blank the label of overview images encoded with %s", ts)
+	} else if intValue(f.Dataset, dicom.NumberOfFrames, 1) != 1 || len(e.Fragments) < 2 {
+		return dcmd.Errorf(dcmd.EINVALID, "cannot blank the label of multi-frame overview images")
+	}
+
+	img, err := jpeg.Decode(bytes.NewReader(bytes.Join(e.Fragments[1:], nil)))
+	if err != nil {
+		return dcmd.Errorf(dcmd.EINVALID, "could not decode overview image: %v", err)
+	}
+	var dst draw.Image
+	if gray, ok := img.(*image.Gray); ok {
+		dst = gray
+	} else {
+		dst = image.NewRGBA(img.Bounds())
+		draw.Draw(dst, dst.Bounds(), img, img.Bounds().Min, draw.Src)
+		f.Dataset.SetString(dicom.PhotometricInterpretation, "YBR_FULL_422")
+	}
+	draw.Draw(dst, rect.Add(img.Bounds().Min), image.NewUniform(color.Black), image.Point{}, draw.Src)
+
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, dst, &jpeg.Options{Quality: macroJPEGQuality}); err != nil {
+		return fmt.Errorf("could not encode overview image: %v", err)
+	}
+	frame := buf.Bytes()
+	if len(frame)%2 == 1 {
+		frame = append(frame, 0)
+	}
+	e.Fragments = [][]byte{{}, frame}
+	return nil
+}
+
+// blankNativeRegion fills a region of every frame of native pixel data with zero
+func blankNativeRegion(ds *dicom.Dataset, e *dicom.Element, rect image.Rectangle) error {
+	rows, columns := intValue(ds, dicom.Rows, 0), intValue(ds, dicom.Columns, 0)
+	bytesPerSample := intValue(ds, dicom.BitsAllocated, 8) / 8
+	samples := intValue(ds, dicom.SamplesPerPixel, 1)
+	planar := intValue(ds, dicom.PlanarConfiguration, 0) == 1
+	frames := intValue(ds, dicom.NumberOfFrames, 1)
+	if bytesPerSample != 1 && bytesPerSample != 2 {
+		return dcmd.Errorf(dcmd.EINVALID, "cannot blank the label of overview images with packed pixels")
+	}
+	if len(e.Value) < rows*columns*samples*bytesPerSample*frames {
+		return dcmd.Errorf(dcmd.EINVALID, "pixel data is shorter than its rows, columns and frames")
+	}
+
+	// each plane holds one sample of every pixel, interleaved samples form a single wider plane
+	planes, width := samples, bytesPerSample
+	if !planar {
+		planes, width = 1, samples*bytesPerSample
+	}
+	planeSize := rows * columns * width
+	for frame := 0; frame < frames; frame++ {
+		for plane := 0; plane < planes; plane++ {
+			base := (frame*planes + plane) * planeSize
+			for y := rect.Min.Y; y < rect.Max.Y; y++ {
+				start := base + (y*columns+rect.Min.X)*width
+				end := base + (y*columns+rect.Max.X)*width
+				for i := start; i < end; i++ {
+					e.Value[i] = 0
+				}
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,107 @@
+package deid_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/jpeg"
+	"testing"
+
+	dcmd "gitlab.com/medical-research/dicom-deidentifier"
+	"gitlab.com/medical-research/dicom-deidentifier/deid"
+	"gitlab.com/medical-research/dicom-deidentifier/dicom"
+)
+
+// newTestSlideImage returns a whole slide image of the given flavor holding a white 40x20 baseline JPEG
+func newTestSlideImage(t *testing.T, flavor string) *dicom.File {
+	img := image.NewRGBA(image.Rect(0, 0, 40, 20))
+	draw.Draw(img, img.Bounds(), image.NewUniform(color.White), image.Point{}, draw.Src)
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, img, nil); err != nil {
+		t.Fatalf("jpeg.Encode() error = %v", err)
+	}
+	frame := buf.Bytes()
+	if len(frame)%2 == 1 {
+		frame = append(frame, 0)
+	}
+
+	f := newTestInstance("1.3.6.1.4.1.5962.1.1.1", "")
+	f.Meta.SetString(dicom.TransferSyntaxUID, dicom.JPEGBaseline8Bit)
+	f.Dataset.SetString(dicom.SOPClassUID, deid.WholeSlideMicroscopyImageStorage)
+	f.Dataset.SetString(dicom.ImageType, "ORIGINAL", "PRIMARY", flavor, "NONE")
+	f.Dataset.Set(newUS(dicom.SamplesPerPixel, dicom.US, 3))
+	f.Dataset.Set(dicom.NewElement(dicom.PhotometricInterpretation, dicom.CS, "YBR_FULL_422"))
+	f.Dataset.Set(newUS(dicom.Rows, dicom.US, 20))
+	f.Dataset.Set(newUS(dicom.Columns, dicom.US, 40))
+	f.Dataset.Set(newUS(dicom.BitsAllocated, dicom.US, 8))
+	f.Dataset.Set(newUS(dicom.BitsStored, dicom.US, 8))
+	f.Dataset.Set(dicom.NewElement(dicom.Tag(0x22000005), dicom.LT, "MRN0001"))
+	f.Dataset.Set(&dicom.Element{Tag: dicom.PixelData, VR: dicom.OB, Fragments: [][]byte{{}, frame}})
+	return f
+}
+
+func TestDeidentificationService_DeidentifyFile_SlideImages(t *testing.T) {
+	s := newTestService(t)
+	profile := &dcmd.Profile{Name: "slides", Options: []string{dcmd.BlankMacroLabelOption}}
+
+	t.Run("label removed", func(t *testing.T) {
+		report := dcmd.NewJobReport()
+		p := &dcmd.Profile{Name: "slides", Options: []string{dcmd.RemoveSlideLabelsOption}}
+		err := s.DeidentifyFile(context.Background(), p, report, newTestSlideImage(t, "LABEL"))
+		if !errors.Is(err, dcmd.ErrInstanceExcluded) {
+			t.Fatalf("DeidentifyFile() error = %v, want %v", err, dcmd.ErrInstanceExcluded)
+		}
+		if report.Excluded != 1 || report.Instances != 0 {
+			t.Errorf("report = %d excluded and %d instances, want 1 and 0", report.Excluded, report.Instances)
+		}
+	})
+
+	t.Run("label blanked", func(t *testing.T) {
+		f := newTestSlideImage(t, "LABEL")
+		p := &dcmd.Profile{Name: "slides", Options: []string{dcmd.BlankSlideLabelsOption}}
+		if err := s.DeidentifyFile(context.Background(), p, nil, f); err != nil {
+			t.Fatalf("DeidentifyFile() error = %v", err)
+		}
+		px := f.Dataset.Get(dicom.PixelData)
+		if px.IsEncapsulated() || len(px.Value) != 40*20*3 || bytes.Count(px.Value, []byte{0}) != len(px.Value) {
+			t.Errorf("PixelData is not blank native pixel data")
+		}
+		if ts := f.TransferSyntax(); ts != dicom.ExplicitVRLittleEndian {
+			t.Errorf("TransferSyntax() = %s, want %s", ts, dicom.ExplicitVRLittleEndian)
+		}
+		if f.Dataset.Get(dicom.Tag(0x22000005)) != nil {
+			t.Errorf("Barcode Value was kept")
+		}
+	})
+
+	t.Run("overview label region blanked", func(t *testing.T) {
+		f := newTestSlideImage(t, "OVERVIEW")
+		if err := s.DeidentifyFile(context.Background(), profile, nil, f); err != nil {
+			t.Fatalf("DeidentifyFile() error = %v", err)
+		}
+		img, err := jpeg.Decode(bytes.NewReader(f.Dataset.Get(dicom.PixelData).Fragments[1]))
+		if err != nil {
+			t.Fatalf("jpeg.Decode() error = %v", err)
+		}
+		if r, _, _, _ := img.At(4, 10).RGBA(); r > 0x2000 {
+			t.Errorf("label region pixel = %#x, want black", r)
+		}
+		if r, _, _, _ := img.At(30, 10).RGBA(); r < 0xE000 {
+			t.Errorf("slide pixel = %#x, want white", r)
+		}
+	})
+
+	t.Run("pyramid level untouched", func(t *testing.T) {
+		f := newTestSlideImage(t, "VOLUME")
+		want := f.Dataset.Get(dicom.PixelData).Fragments[1]
+		if err := s.DeidentifyFile(context.Background(), profile, nil, f); err != nil {
+			t.Fatalf("DeidentifyFile() error = %v", err)
+		}
+		if got := f.Dataset.Get(dicom.PixelData).Fragments[1]; !bytes.Equal(got, want) {
+			t.Errorf("PixelData of a pyramid level changed")
+		}
+	})
+}
@@ -0,0 +1,487 @@
+package dicom
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/encoding/charmap"
+	"golang.org/x/text/encoding/japanese"
+	"golang.org/x/text/encoding/korean"
+	"golang.org/x/text/encoding/simplifiedchinese"
+	"golang.org/x/text/encoding/unicode"
+)
+
+// UTF8CharacterSet is the Specific Character Set of datasets encoded in UTF-8
+const UTF8CharacterSet = "ISO_IR 192"
+
+// escape starts the ISO 2022 escape sequences designating a graphic set
+const escape = 0x1B
+
+// graphicSet is a character set that can be designated to G0 (bytes below 0x80)
+// or G1 (bytes from 0x80) by an ISO 2022 escape sequence
+type graphicSet struct {
+	escape string
+	g1     bool
+	// width is the number of bytes of every character
+	width int
+
+	decode func(b []byte) string
+	encode func(r rune) ([]byte, bool)
+}
+
+// asciiSet is ISO 646, the default character repertoire
+var asciiSet = &graphicSet{
+	escape: "\x1b(B",
+	width:  1,
+	decode: func(b []byte) string { return string(b) },
+	encode: func(r rune) ([]byte, bool) { return []byte{byte(r)}, r < 0x80 },
+}
+
+// romajiSet is the JIS X 0201 Romaji set. It only differs from ASCII on the yen sign and
+// overline, which DICOM implementations read as backslash and tilde, as the backslash is the
+// value delimiter.
+var romajiSet = &graphicSet{
+	escape: "\x1b(J",
+	width:  1,
+	decode: asciiSet.decode,
+	encode: asciiSet.encode,
+}
+
+// katakanaSet is the JIS X 0201 Katakana set, half-width katakana
+var katakanaSet = &graphicSet{
+	escape: "\x1b)I",
+	g1:     true,
+	width:  1,
+	decode: func(b []byte) string {
+		var s strings.Builder
+		for _, c := range b {
+			if c >= 0xA1 && c <= 0xDF {
+				s.WriteRune(0xFF61 + rune(c-0xA1))
+			} else {
+				s.WriteRune(utf8.RuneError)
+			}
+		}
+		return s.String()
+	},
+	encode: func(r rune) ([]byte, bool) {
+		if r < 0xFF61 || r > 0xFF9F {
+			return nil, false
+		}
+		return []byte{byte(r-0xFF61) + 0xA1}, true
+	},
+}
+
+// isoLatinSet returns the upper half of an ISO 8859 or TIS 620 character set
+func isoLatinSet(final byte, m *charmap.Charmap) *graphicSet {
+	return &graphicSet{
+		escape: "\x1b-" + string(final),
+		g1:     true,
+		width:  1,
+		decode: func(b []byte) string {
+			var s strings.Builder
+			for _, c := range b {
+				s.WriteRune(m.DecodeByte(c))
+			}
+			return s.String()
+		},
+		encode: func(r rune) ([]byte, bool) {
+			c, ok := m.EncodeRune(r)
+			return []byte{c}, ok && c >= 0x80
+		},
+	}
+}
+
+// multiByteSet returns a double byte set whose characters are decoded and encoded by enc once
+// the bytes are converted by toEnc and fromEnc
+func multiByteSet(esc string, g1 bool, enc encoding.Encoding, toEnc func([]byte) []byte, fromEnc func([]byte) ([]byte, bool)) *graphicSet {
+	return &graphicSet{
+		escape: esc,
+		g1:     g1,
+		width:  2,
+		decode: func(b []byte) string {
+			s, err := enc.NewDecoder().Bytes(toEnc(b))
+			if err != nil {
+				return strings.Repeat(string(utf8.RuneError), len(b)/2)
+			}
+			return string(s)
+		},
+		encode: func(r rune) ([]byte, bool) {
+			b, err := enc.NewEncoder().Bytes([]byte(string(r)))
+			if err != nil {
+				return nil, false
+			}
+			return fromEnc(b)
+		},
+	}
+}
+
+// euc returns the bytes of a double byte EUC character, when b holds exactly one
+func euc(b []byte) ([]byte, bool) {
+	return b, len(b) == 2 && b[0] >= 0xA1 && b[1] >= 0xA1
+}
+
+// Double byte sets, held in EUC form by the Go encodings: G0 sets have the high bit of both
+// bytes cleared and JIS X 0212 characters are prefixed by 0x8F
+var (
+	jisX0208Set = multiByteSet("\x1b$B", false, japanese.EUCJP,
+		func(b []byte) []byte {
+			out := make([]byte, len(b))
+			for i, c := range b {
+				out[i] = c | 0x80
+			}
+			return out
+		},
+		func(b []byte) ([]byte, bool) {
+			if _, ok := euc(b); !ok {
+				return nil, false
+			}
+			return []byte{b[0] &^ 0x80, b[1] &^ 0x80}, true
+		},
+	)
+	jisX0212Set = multiByteSet("\x1b$(D", false, japanese.EUCJP,
+		func(b []byte) []byte {
+			var out []byte
+			for i := 0; i+1 < len(b); i += 2 {
+				out = append(out, 0x8F, b[i]|0x80, b[i+1]|0x80)
+			}
+			return out
+		},
+		func(b []byte) ([]byte, bool) {
+			if len(b) != 3 || b[0] != 0x8F {
+				return nil, false
+			}
+			return []byte{b[1] &^ 0x80, b[2] &^ 0x80}, true
+		},
+	)
+	ksX1001Set = multiByteSet("\x1b$)C", true, korean.EUCKR, func(b []byte) []byte { return b }, euc)
+	gb2312Set  = multiByteSet("\x1b$)A", true, simplifiedchinese.GBK, func(b []byte) []byte { return b }, euc)
+)
+
+// codeElement is the pair of graphic sets a defined term of Specific Character Set designates
+type codeElement struct {
+	g0, g1 *graphicSet
+}
+
+// codeElements are the character sets of PS3.3 C.12.1.1.2 usable with code extensions, keyed
+// by the number of their ISO registration. Defined terms without code extensions use the same sets.
+var codeElements = map[string]codeElement{
+	"6":   {g0: asciiSet},
+	"100": {g0: asciiSet, g1: isoLatinSet('A', charmap.ISO8859_1)},
+	"101": {g0: asciiSet, g1: isoLatinSet('B', charmap.ISO8859_2)},
+	"109": {g0: asciiSet, g1: isoLatinSet('C', charmap.ISO8859_3)},
+	"110": {g0: asciiSet, g1: isoLatinSet('D', charmap.ISO8859_4)},
+	"144": {g0: asciiSet, g1: isoLatinSet('L', charmap.ISO8859_5)},
+	"127": {g0: asciiSet, g1: isoLatinSet('G', charmap.ISO8859_6)},
+	"126": {g0: asciiSet, g1: isoLatinSet('F', charmap.ISO8859_7)},
+	"138": {g0: asciiSet, g1: isoLatinSet('H', charmap.ISO8859_8)},
+	"148": {g0: asciiSet, g1: isoLatinSet('M', charmap.ISO8859_9)},
+	"203": {g0: asciiSet, g1: isoLatinSet('b', charmap.ISO8859_15)},
+	"166": {g0: asciiSet, g1: isoLatinSet('T', charmap.Windows874)},
+	"13":  {g0: romajiSet, g1: katakanaSet},
+	"87":  {g0: jisX0208Set},
+	"159": {g0: jisX0212Set},
+	"149": {g1: ksX1001Set},
+	"58":  {g1: gb2312Set},
+}
+
+// wholeEncodings are the character sets that cannot be combined with others and decode values as a whole
+var wholeEncodings = map[string]encoding.Encoding{
+	UTF8CharacterSet: unicode.UTF8,
+	"GB18030":        simplifiedchinese.GB18030,
+	"GBK":            simplifiedchinese.GBK,
+}
+
+// characterSet decodes and encodes the text values of a dataset according to its Specific
+// Character Set (0008,0005), including code extensions switched by ISO 2022 escape sequences
+type characterSet struct {
+	terms []string
+
+	// whole is set for the character sets decoding values as a whole
+	whole encoding.Encoding
+
+	// initial are the G0 and G1 sets designated at the start of every value and after delimiters
+	initial [2]*graphicSet
+
+	// sets are the sets the values may switch to, in the order of the defined terms
+	sets []*graphicSet
+}
+
+// newCharacterSet returns the character set of the defined terms of a Specific Character Set
+func newCharacterSet(terms []string) (*characterSet, error) {
+	cs := &characterSet{terms: terms}
+	if len(terms) == 0 {
+		cs.initial[0] = asciiSet
+		return cs, nil
+	}
+	if enc, ok := wholeEncodings[terms[0]]; ok {
+		if len(terms) > 1 {
+			return nil, fmt.Errorf("character set %s cannot be combined with code extensions", terms[0])
+		}
+		cs.whole = enc
+		return cs, nil
+	}
+
+	extensions := len(terms) > 1 || strings.HasPrefix(terms[0], "ISO 2022")
+	for i, term := range terms {
+		var number string
+		switch {
+		case term == "" && i == 0:
+			number = "6"
+		case strings.HasPrefix(term, "ISO 2022 IR "):
+			number = strings.TrimPrefix(term, "ISO 2022 IR ")
+		case strings.HasPrefix(term, "ISO_IR ") && !extensions:
+			number = strings.TrimPrefix(term, "ISO_IR ")
+		}
+		el, ok := codeElements[number]
+		if !ok {
+			return nil, fmt.Errorf("unsupported character set %q", term)
+		}
+		if i == 0 {
+			cs.initial = [2]*graphicSet{el.g0, el.g1}
+			if el.g0 == nil {
+				cs.initial[0] = asciiSet
+			}
+		}
+		for _, set := range []*graphicSet{el.g0, el.g1} {
+			if set != nil {
+				cs.sets = append(cs.sets, set)
+			}
+		}
+	}
+	if extensions && !cs.hasSet(asciiSet) {
+		// the default repertoire may always be designated back
+		cs.sets = append(cs.sets, asciiSet)
+	}
+	return cs, nil
+}
+
+// hasSet reports whether values may switch to the given set
+func (cs *characterSet) hasSet(set *graphicSet) bool {
+	for _, s := range cs.sets {
+		if s == set {
+			return true
+		}
+	}
+	return false
+}
+
+// isUnicode reports whether values are held as they are, either ASCII or UTF-8
+func (cs *characterSet) isUnicode() bool {
+	return cs.whole == unicode.UTF8 || (cs.whole == nil && len(cs.sets) <= 1 && cs.initial == [2]*graphicSet{asciiSet, nil})
+}
+
+// isDelimiter reports whether c switches a value back to the initial sets
+func isDelimiter(c byte, vr string) bool {
+	switch c {
+	case '\\', '\r', '\n', '\f', '\t':
+		return true
+	case '^', '=':
+		return vr == PN
+	}
+	return false
+}
+
+// decode returns the value of a text element in UTF-8
+func (cs *characterSet) decode(b []byte, vr string) string {
+	if cs.whole != nil {
+		s, err := cs.whole.NewDecoder().Bytes(b)
+		if err != nil {
+			return string(b)
+		}
+		return string(s)
+	}
+
+	var out strings.Builder
+	g := cs.initial
+	for i := 0; i < len(b); {
+		c := b[i]
+		switch {
+		case c == escape:
+			if set := designation(b[i:]); set != nil {
+				if set.g1 {
+					g[1] = set
+				} else {
+					g[0] = set
+				}
+				i += len(set.escape)
+				continue
+			}
+			out.WriteByte(c)
+			i++
+
+		case c >= 0x80:
+			j := i
+			for j < len(b) && b[j] >= 0x80 {
+				j++
+			}
+			if g[1] == nil {
+				out.WriteString(strings.Repeat(string(utf8.RuneError), j-i))
+			} else {
+				out.WriteString(g[1].decode(b[i:j]))
+			}
+			i = j
+
+		case g[0].width == 2:
+			j := i
+			for j < len(b) && b[j] >= 0x21 && b[j] <= 0x7E {
+				j++
+			}
+			if j == i {
+				// control characters and spaces are single bytes in every set
+				out.WriteByte(c)
+				j++
+			} else {
+				out.WriteString(g[0].decode(b[i:j]))
+			}
+			i = j
+
+		default:
+			j := i
+			for j < len(b) && b[j] < 0x80 && b[j] != escape && !isDelimiter(b[j], vr) {
+				j++
+			}
+			out.WriteString(g[0].decode(b[i:j]))
+			if j < len(b) && isDelimiter(b[j], vr) {
+				out.WriteByte(b[j])
+				g = cs.initial
+				j++
+			}
+			i = j
+		}
+	}
+	return out.String()
+}
+
+// designation returns the graphic set designated by the escape sequence b starts with
+func designation(b []byte) *graphicSet {
+	for _, el := range codeElements {
+		for _, set := range []*graphicSet{el.g0, el.g1} {
+			if set != nil && bytes.HasPrefix(b, []byte(set.escape)) {
+				return set
+			}
+		}
+	}
+	if bytes.HasPrefix(b, []byte(asciiSet.escape)) {
+		return asciiSet
+	}
+	return nil
+}
+
+// encode returns the value of a text element encoded in the character set. Every value and
+// person name component starts with the initial sets, as required by PS3.5 6.1.2.5.3.
+func (cs *characterSet) encode(s, vr string) ([]byte, error) {
+	if cs.whole != nil {
+		b, err := cs.whole.NewEncoder().Bytes([]byte(s))
+		if err != nil {
+			return nil, fmt.Errorf("cannot encode %q in %s: %v", s, cs.terms[0], err)
+		}
+		return b, nil
+	}
+
+	var out bytes.Buffer
+	g := cs.initial
+	for _, r := range s {
+		if r < 0x80 && (isDelimiter(byte(r), vr) || r < 0x20) {
+			if g[0] != cs.initial[0] {
+				out.WriteString(cs.initial[0].escape)
+			}
+			g = cs.initial
+			out.WriteRune(r)
+			continue
+		}
+
+		b, set := cs.encodeRune(r, g)
+		if set == nil {
+			return nil, fmt.Errorf("cannot encode %q in %s", s, strings.Join(cs.terms, `\`))
+		}
+		i := 0
+		if set.g1 {
+			i = 1
+		}
+		if g[i] != set {
+			out.WriteString(set.escape)
+			g[i] = set
+		}
+		out.Write(b)
+	}
+	if g[0] != cs.initial[0] {
+		out.WriteString(cs.initial[0].escape)
+	}
+	return out.Bytes(), nil
+}
+
+// encodeRune returns the encoding of r in the first set able to represent it, preferring the
+// sets currently designated to avoid needless escape sequences
+func (cs *characterSet) encodeRune(r rune, g [2]*graphicSet) ([]byte, *graphicSet) {
+	for _, set := range append([]*graphicSet{g[0], g[1]}, cs.sets...) {
+		if set == nil {
+			continue
+		}
+		if b, ok := set.encode(r); ok {
+			return b, set
+		}
+	}
+	return nil, nil
+}
+
+// decodeText converts the text values of ds and its sequence items from the Specific Character Set
+// they were encoded with to UTF-8, updating Specific Character Set accordingly. It returns the
+// defined terms of the character set of ds, or nil when its values were already held in ASCII or
+// UTF-8 or use a character set that is not supported, in which case they are left as they are.
+func decodeText(ds *Dataset, inherited *characterSet) []string {
+	cs := inherited
+	var terms []string
+	if e := ds.Get(SpecificCharacterSet); e != nil {
+		values := e.Strings()
+		var err error
+		if cs, err = newCharacterSet(values); err != nil {
+			cs = nil
+		} else if !cs.isUnicode() {
+			terms = values
+			e.SetStrings(UTF8CharacterSet)
+		}
+	}
+
+	for _, e := range ds.Elements {
+		if e.IsSequence() {
+			for _, item := range e.Items {
+				decodeText(item, cs)
+			}
+			continue
+		}
+		if cs == nil || cs.isUnicode() || !usesSpecificCharacterSet(e.VR) || !needsDecoding(e.Value) {
+			continue
+		}
+		e.Value = pad([]byte(cs.decode(e.Value, e.VR)), padByte(e.VR))
+	}
+	return terms
+}
+
+// needsDecoding reports whether a value holds bytes outside of the default repertoire
+func needsDecoding(b []byte) bool {
+	for _, c := range b {
+		if c >= 0x80 || c == escape {
+			return true
+		}
+	}
+	return false
+}
+
+// encodeText returns the value of e encoded in the character set, or the value itself for
+// elements not affected by Specific Character Set
+func encodeText(cs *characterSet, e *Element) ([]byte, error) {
+	if e.Tag == SpecificCharacterSet {
+		return pad([]byte(strings.Join(cs.terms, `\`)), padByte(CS)), nil
+	}
+	if !usesSpecificCharacterSet(e.VR) || !needsDecoding(e.Value) {
+		return e.Value, nil
+	}
+	b, err := cs.encode(strings.TrimRight(string(e.Value), " "), e.VR)
+	if err != nil {
+		return nil, err
+	}
+	return pad(b, padByte(e.VR)), nil
+}
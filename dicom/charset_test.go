@@ -0,0 +1,158 @@
+package dicom_test
+
+import (
+	"bytes"
+	"testing"
+
+	"gitlab.com/medical-research/dicom-deidentifier/dicom"
+)
+
+// newCharsetFile returns an instance whose patient name and study description are encoded
+// with the given Specific Character Set
+func newCharsetFile(charset string, name, description []byte) *dicom.File {
+	f := newTestFile(dicom.ExplicitVRLittleEndian)
+	f.Dataset.Set(dicom.NewElement(dicom.SpecificCharacterSet, dicom.CS, charset))
+	f.Dataset.Set(&dicom.Element{Tag: dicom.PatientName, VR: dicom.PN, Value: name})
+	f.Dataset.Set(&dicom.Element{Tag: dicom.StudyDescription, VR: dicom.LO, Value: description})
+	return f
+}
+
+func TestReadWrite_CharacterSets(t *testing.T) {
+	tests := []struct {
+		name     string
+		charset  string
+		rawName  string
+		rawDesc  string
+		wantName string
+		wantDesc string
+	}{
+		{
+			name:     "latin-1",
+			charset:  "ISO_IR 100",
+			rawName:  "Buc^J\xe9r\xf4me",
+			rawDesc:  "Cr\xe2ne ",
+			wantName: "Buc^Jérôme",
+			wantDesc: "Crâne",
+		},
+		{
+			name:     "greek",
+			charset:  "ISO_IR 126",
+			rawName:  "\xc4\xe9\xef\xed\xf5\xf3\xe9\xef\xf2 ",
+			rawDesc:  "\xe8\xf9\xf1\xe1\xea\xe1\xf2",
+			wantName: "Διονυσιος",
+			wantDesc: "θωρακας",
+		},
+		{
+			name:     "japanese with code extensions",
+			charset:  `\ISO 2022 IR 87`,
+			rawName:  "Yamada^Tarou=\x1b$B;3ED\x1b(B^\x1b$BB@O:\x1b(B=\x1b$B$d$^$@\x1b(B^\x1b$B$?$m$&\x1b(B",
+			rawDesc:  "\x1b$B6;It\x1b(B CT",
+			wantName: "Yamada^Tarou=山田^太郎=やまだ^たろう",
+			wantDesc: "胸部 CT",
+		},
+		{
+			name:     "japanese katakana",
+			charset:  `ISO 2022 IR 13\ISO 2022 IR 87`,
+			rawName:  "\xd4\xcf\xc0\xde^\xc0\xdb\xb3=\x1b$B;3ED\x1b(J^\x1b$BB@O:\x1b(J=\x1b$B$d$^$@\x1b(J^\x1b$B$?$m$&\x1b(J",
+			rawDesc:  "CT",
+			wantName: "ﾔﾏﾀﾞ^ﾀﾛｳ=山田^太郎=やまだ^たろう",
+			wantDesc: "CT",
+		},
+		{
+			name:     "korean",
+			charset:  `\ISO 2022 IR 149`,
+			rawName:  "Hong^Gildong=\x1b$)C\xfb\xf3^\x1b$)C\xd1\xce\xd4\xd7=\x1b$)C\xc8\xab^\x1b$)C\xb1\xe6\xb5\xbf",
+			rawDesc:  "CT",
+			wantName: "Hong^Gildong=洪^吉洞=홍^길동",
+			wantDesc: "CT",
+		},
+		{
+			name:     "gb18030",
+			charset:  "GB18030",
+			rawName:  "Wang^XiaoDong=\xcd\xf5^\xd0\xa1\xb6\xab=",
+			rawDesc:  "\xd0\xd8\xb2\xbf",
+			wantName: "Wang^XiaoDong=王^小东=",
+			wantDesc: "胸部",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var raw bytes.Buffer
+			if err := dicom.Write(&raw, newCharsetFile(tt.charset, []byte(tt.rawName), []byte(tt.rawDesc))); err != nil {
+				t.Fatalf("Write() error = %v", err)
+			}
+			original := raw.Bytes()
+
+			f, err := dicom.Read(bytes.NewReader(original))
+			if err != nil {
+				t.Fatalf("Read() error = %v", err)
+			}
+			if got := f.Dataset.GetString(dicom.PatientName); got != tt.wantName {
+				t.Errorf("PatientName = %q, want %q", got, tt.wantName)
+			}
+			if got := f.Dataset.GetString(dicom.StudyDescription); got != tt.wantDesc {
+				t.Errorf("StudyDescription = %q, want %q", got, tt.wantDesc)
+			}
+			if got := f.Dataset.GetString(dicom.SpecificCharacterSet); got != dicom.UTF8CharacterSet {
+				t.Errorf("SpecificCharacterSet = %q, want %q", got, dicom.UTF8CharacterSet)
+			}
+
+			// written back in the original character set
+			var buf bytes.Buffer
+			if err := dicom.Write(&buf, f); err != nil {
+				t.Fatalf("Write() error = %v", err)
+			}
+			if !bytes.Equal(buf.Bytes(), original) {
+				t.Errorf("Write() did not re-encode the original values")
+			}
+
+			// converted to UTF-8
+			f.CharacterSet = nil
+			buf.Reset()
+			if err := dicom.Write(&buf, f); err != nil {
+				t.Fatalf("Write() error = %v", err)
+			}
+			converted, err := dicom.Read(&buf)
+			if err != nil {
+				t.Fatalf("Read() error = %v", err)
+			}
+			if converted.CharacterSet != nil {
+				t.Errorf("CharacterSet = %v, want nil", converted.CharacterSet)
+			}
+			if got := converted.Dataset.GetString(dicom.PatientName); got != tt.wantName {
+				t.Errorf("converted PatientName = %q, want %q", got, tt.wantName)
+			}
+		})
+	}
+}
+
+func TestWrite_UnrepresentableCharacters(t *testing.T) {
+	var raw bytes.Buffer
+	if err := dicom.Write(&raw, newCharsetFile("ISO_IR 100", []byte("Buc^J\xe9r\xf4me"), []byte("CT"))); err != nil {
+		t.Fatalf("Write() error = %v", err)
+	}
+	f, err := dicom.Read(&raw)
+	if err != nil {
+		t.Fatalf("Read() error = %v", err)
+	}
+
+	// Greek cannot be written in Latin-1, so the instance is converted to UTF-8
+	f.Dataset.SetString(dicom.StudyDescription, "θωρακας")
+	var buf bytes.Buffer
+	if err := dicom.Write(&buf, f); err != nil {
+		t.Fatalf("Write() error = %v", err)
+	}
+	got, err := dicom.Read(&buf)
+	if err != nil {
+		t.Fatalf("Read() error = %v", err)
+	}
+	if cs := got.Dataset.GetString(dicom.SpecificCharacterSet); cs != dicom.UTF8CharacterSet || got.CharacterSet != nil {
+		t.Errorf("SpecificCharacterSet = %q, CharacterSet = %v", cs, got.CharacterSet)
+	}
+	if desc := got.Dataset.GetString(dicom.StudyDescription); desc != "θωρακας" {
+		t.Errorf("StudyDescription = %q", desc)
+	}
+	if name := got.Dataset.GetString(dicom.PatientName); name != "Buc^Jérôme" {
+		t.Errorf("PatientName = %q", name)
+	}
+}
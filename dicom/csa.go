@@ -0,0 +1,236 @@
+package dicom
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"strings"
+)
+
+// CSACreator is the private creator of the Siemens CSA headers
+const CSACreator = "SIEMENS CSA HEADER"
+
+// Element offsets of the CSA headers within the block reserved by CSACreator
+const (
+	CSAImageHeaderInfo  = 0x10
+	CSASeriesHeaderInfo = 0x20
+)
+
+// csa2Signature starts CSA2 headers, CSA1 headers start directly with the number of elements
+var csa2Signature = []byte("SV10\x04\x03\x02\x01")
+
+// limits rejecting headers that are not CSA, as the format has no checksum
+const (
+	maxCSAElements = 128
+	maxCSAItems    = 1000
+)
+
+// CSAHeader represents a Siemens CSA1 or CSA2 header, a binary list of named elements
+// stored in the (0029,xx10) image and (0029,xx20) series header info attributes
+type CSAHeader struct {
+	// Version is 1 for CSA1 and 2 for CSA2 headers
+	Version int `json:"version"`
+
+	Elements []*CSAElement `json:"elements"`
+
+	// unused is the word following the number of elements, usually 77
+	unused uint32
+}
+
+// CSAElement represents a single named element of a CSA header
+type CSAElement struct {
+	Name    string `json:"name"`
+	VM      int32  `json:"vm"`
+	VR      string `json:"vr"`
+	SyngoDT int32  `json:"syngo-dt"`
+
+	// Values holds the value of every item, which may include empty items beyond the VM
+	Values []string `json:"values,omitempty"`
+
+	// unused is the word following the number of items, 77 or 205
+	unused int32
+	items  []csaItem
+}
+
+// csaItem keeps the encoding of an item so unchanged items are written back as they were read
+type csaItem struct {
+	// words are the four item header words, the length being held in the first, second and fourth
+	words [4]int32
+	raw   []byte
+	value string
+}
+
+// ParseCSAHeader parses the value of a CSA header attribute
+func ParseCSAHeader(b []byte) (*CSAHeader, error) {
+	h := &CSAHeader{Version: 1}
+	pos := 0
+	if bytes.HasPrefix(b, csa2Signature) {
+		h.Version, pos = 2, len(csa2Signature)
+	}
+
+	word := func() (int32, error) {
+		if pos+4 > len(b) {
+			return 0, fmt.Errorf("csa offset %d: unexpected end of header", pos)
+		}
+		v := int32(binary.LittleEndian.Uint32(b[pos:]))
+		pos += 4
+		return v, nil
+	}
+
+	n, err := word()
+	if err != nil {
+		return nil, err
+	} else if n < 1 || n > maxCSAElements {
+		return nil, fmt.Errorf("not a csa header: %d elements", n)
+	}
+	unused, err := word()
+	if err != nil {
+		return nil, err
+	}
+	h.unused = uint32(unused)
+
+	// CSA1 item lengths are offset by the number of items of the second element
+	var lengthOffset int32
+	for i := 0; i < int(n); i++ {
+		if pos+84 > len(b) {
+			return nil, fmt.Errorf("csa offset %d: unexpected end of header", pos)
+		}
+		e := &CSAElement{
+			Name: nullTerminated(b[pos : pos+64]),
+			VM:   int32(binary.LittleEndian.Uint32(b[pos+64:])),
+			VR:   nullTerminated(b[pos+68 : pos+72]),
+		}
+		e.SyngoDT = int32(binary.LittleEndian.Uint32(b[pos+72:]))
+		nItems := int32(binary.LittleEndian.Uint32(b[pos+76:]))
+		e.unused = int32(binary.LittleEndian.Uint32(b[pos+80:]))
+		pos += 84
+		if nItems < 0 || nItems > maxCSAItems {
+			return nil, fmt.Errorf("csa element %q: %d items", e.Name, nItems)
+		}
+		if i == 1 {
+			lengthOffset = nItems
+		}
+
+		for j := 0; j < int(nItems); j++ {
+			item := csaItem{}
+			for k := range item.words {
+				if item.words[k], err = word(); err != nil {
+					return nil, err
+				}
+			}
+			length := item.words[1]
+			if h.Version == 1 {
+				length = item.words[0] - lengthOffset
+			}
+			if length < 0 || pos+int(length) > len(b) {
+				return nil, fmt.Errorf("csa element %q: item %d exceeds the header", e.Name, j)
+			}
+			item.raw = b[pos : pos+int(length)]
+			item.value = strings.TrimSpace(nullTerminated(item.raw))
+			pos += (int(length) + 3) / 4 * 4
+
+			e.items = append(e.items, item)
+			e.Values = append(e.Values, item.value)
+		}
+		h.Elements = append(h.Elements, e)
+	}
+	return h, nil
+}
+
+// Element returns the element with the given name or nil if there is none
+func (h *CSAHeader) Element(name string) *CSAElement {
+	for _, e := range h.Elements {
+		if e.Name == name {
+			return e
+		}
+	}
+	return nil
+}
+
+// Bytes encodes the header, writing back unchanged items exactly as they were read
+func (h *CSAHeader) Bytes() []byte {
+	var buf bytes.Buffer
+	word := func(v int32) {
+		_ = binary.Write(&buf, binary.LittleEndian, v)
+	}
+
+	if h.Version == 2 {
+		buf.Write(csa2Signature)
+	}
+	word(int32(len(h.Elements)))
+	word(int32(h.unused))
+
+	for n, e := range h.Elements {
+		var name [64]byte
+		copy(name[:63], e.Name)
+		buf.Write(name[:])
+		word(e.VM)
+		var vr [4]byte
+		copy(vr[:3], e.VR)
+		buf.Write(vr[:])
+		word(e.SyngoDT)
+		word(int32(len(e.Values)))
+		word(e.unused)
+
+		for i, v := range e.Values {
+			item := csaItem{words: [4]int32{0, 0, 77, 0}}
+			if i < len(e.items) {
+				item = e.items[i]
+			} else if h.Version == 1 && n >= 1 {
+				// mirrors the length offset of ParseCSAHeader
+				item.words[0] = int32(len(h.Elements[1].Values))
+			}
+			if item.raw == nil || v != item.value {
+				delta := int32(len(v)+1) - int32(len(item.raw))
+				item.raw = append([]byte(v), 0)
+				item.words[0] += delta
+				item.words[1] += delta
+				item.words[3] += delta
+			}
+			for _, w := range item.words {
+				word(w)
+			}
+			buf.Write(item.raw)
+			buf.Write(make([]byte, (4-len(item.raw)%4)%4))
+		}
+	}
+	return buf.Bytes()
+}
+
+// CSAHeaders returns the parsed CSA headers of a dataset keyed by "image" and "series".
+// Headers that cannot be parsed are skipped.
+func CSAHeaders(ds *Dataset) map[string]*CSAHeader {
+	headers := map[string]*CSAHeader{}
+	for _, e := range ds.Elements {
+		if !IsCSAHeader(ds, e.Tag) {
+			continue
+		}
+		h, err := ParseCSAHeader(e.Value)
+		if err != nil {
+			continue
+		}
+		if e.Tag.Element()&0xFF == CSAImageHeaderInfo {
+			headers["image"] = h
+		} else {
+			headers["series"] = h
+		}
+	}
+	return headers
+}
+
+// IsCSAHeader reports whether the element of ds with the given tag holds a CSA header
+func IsCSAHeader(ds *Dataset, tag Tag) bool {
+	if tag.Group() != 0x0029 || strings.TrimSpace(ds.PrivateCreator(tag)) != CSACreator {
+		return false
+	}
+	offset := tag.Element() & 0xFF
+	return offset == CSAImageHeaderInfo || offset == CSASeriesHeaderInfo
+}
+
+// nullTerminated returns the string held by b up to its first NUL byte
+func nullTerminated(b []byte) string {
+	if i := bytes.IndexByte(b, 0); i >= 0 {
+		b = b[:i]
+	}
+	return string(b)
+}
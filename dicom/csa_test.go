@@ -0,0 +1,76 @@
+package dicom_test
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"gitlab.com/medical-research/dicom-deidentifier/dicom"
+)
+
+func newTestCSAHeader(version int) *dicom.CSAHeader {
+	return &dicom.CSAHeader{
+		Version: version,
+		Elements: []*dicom.CSAElement{
+			{Name: "B_value", VM: 1, VR: "IS", SyngoDT: 6, Values: []string{"1000"}},
+			{Name: "DiffusionGradientDirection", VM: 3, VR: "FD", SyngoDT: 4, Values: []string{"0.5", "-0.5", "0.70710678", ""}},
+			{Name: "MrPhoenixProtocol", VM: 1, VR: "UN", SyngoDT: 0, Values: []string{"### ASCCONV BEGIN ###\ntProtocolName = \"ep2d_diff\"\n### ASCCONV END ###"}},
+			{Name: "ImaCoilString", VM: 1, VR: "LO", SyngoDT: 19},
+		},
+	}
+}
+
+func TestParseCSAHeader(t *testing.T) {
+	for _, version := range []int{1, 2} {
+		want := newTestCSAHeader(version)
+		b := want.Bytes()
+
+		got, err := dicom.ParseCSAHeader(b)
+		if err != nil {
+			t.Fatalf("CSA%d: ParseCSAHeader() error = %v", version, err)
+		}
+		if got.Version != version {
+			t.Errorf("CSA%d: Version = %d", version, got.Version)
+		}
+		for i, e := range want.Elements {
+			g := got.Elements[i]
+			if g.Name != e.Name || g.VM != e.VM || g.VR != e.VR || g.SyngoDT != e.SyngoDT || !reflect.DeepEqual(g.Values, e.Values) {
+				t.Errorf("CSA%d: element %d = %+v, want %+v", version, i, g, e)
+			}
+		}
+		if !bytes.Equal(got.Bytes(), b) {
+			t.Errorf("CSA%d: Bytes() of an unchanged header differ from the parsed bytes", version)
+		}
+
+		got.Element("B_value").Values[0] = "3000"
+		reparsed, err := dicom.ParseCSAHeader(got.Bytes())
+		if err != nil {
+			t.Fatalf("CSA%d: ParseCSAHeader() of a changed header error = %v", version, err)
+		}
+		if v := reparsed.Element("B_value").Values[0]; v != "3000" {
+			t.Errorf("CSA%d: B_value = %q, want %q", version, v, "3000")
+		}
+		if v := reparsed.Element("MrPhoenixProtocol").Values; !reflect.DeepEqual(v, want.Elements[2].Values) {
+			t.Errorf("CSA%d: MrPhoenixProtocol = %q", version, v)
+		}
+	}
+}
+
+func TestParseCSAHeader_Invalid(t *testing.T) {
+	b := newTestCSAHeader(2).Bytes()
+	tests := []struct {
+		name string
+		b    []byte
+	}{
+		{name: "empty", b: nil},
+		{name: "not csa", b: []byte("ORIGINAL\\PRIMARY\\M\\ND")},
+		{name: "truncated", b: b[:len(b)-40]},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := dicom.ParseCSAHeader(tt.b); err == nil {
+				t.Errorf("ParseCSAHeader() error = nil, want error")
+			}
+		})
+	}
+}
@@ -0,0 +1,338 @@
+package dicom
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Element represents a single DICOM data element
+type Element struct {
+	Tag Tag
+	VR  string
+
+	// Value holds the little endian encoded value of any non sequence element
+	Value []byte
+
+	// Items holds the nested datasets of a sequence (SQ) element
+	Items []*Dataset
+
+	// Fragments holds encapsulated pixel data, the first fragment being the basic offset table
+	Fragments [][]byte
+}
+
+// NewElement returns a new element holding the supplied string values
+func NewElement(tag Tag, vr string, values ...string) *Element {
+	e := &Element{Tag: tag, VR: vr}
+	e.SetStrings(values...)
+	return e
+}
+
+// NewSequence returns a new sequence element holding the supplied items
+func NewSequence(tag Tag, items ...*Dataset) *Element {
+	return &Element{Tag: tag, VR: SQ, Items: items}
+}
+
+// IsSequence reports whether the element holds nested datasets
+func (e *Element) IsSequence() bool {
+	return e.VR == SQ
+}
+
+// IsEncapsulated reports whether the element holds encapsulated (compressed) pixel data
+func (e *Element) IsEncapsulated() bool {
+	return e.Fragments != nil
+}
+
+// IsEmpty reports whether the element has a zero length value
+func (e *Element) IsEmpty() bool {
+	return len(e.Value) == 0 && len(e.Items) == 0 && len(e.Fragments) == 0
+}
+
+// Strings returns the values of a character string element with the padding removed
+func (e *Element) Strings() []string {
+	if !IsTextVR(e.VR) || len(e.Value) == 0 {
+		return nil
+	}
+	text := strings.TrimRight(string(e.Value), " \x00")
+	if !IsStringListVR(e.VR) {
+		return []string{text}
+	}
+	values := strings.Split(text, `\`)
+	for i, v := range values {
+		values[i] = trimValue(e.VR, v)
+	}
+	return values
+}
+
+// String returns the first value of a character string element
+func (e *Element) String() string {
+	values := e.Strings()
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
+
+// SetStrings replaces the value of a character string element
+func (e *Element) SetStrings(values ...string) {
+	e.Items, e.Fragments = nil, nil
+	if len(values) == 0 {
+		e.Value = nil
+		return
+	}
+	e.Value = pad([]byte(strings.Join(values, `\`)), padByte(e.VR))
+}
+
+// Ints returns the values of an integer element (IS, US, SS, UL, SL)
+func (e *Element) Ints() ([]int64, error) {
+	switch e.VR {
+	case IS:
+		values := []int64{}
+		for _, s := range e.Strings() {
+			if s == "" {
+				continue
+			}
+			n, err := strconv.ParseInt(s, 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("%s: invalid IS value %q", describeTag(e.Tag), s)
+			}
+			values = append(values, n)
+		}
+		return values, nil
+	case US, SS:
+		values := make([]int64, 0, len(e.Value)/2)
+		for i := 0; i+2 <= len(e.Value); i += 2 {
+			v := binary.LittleEndian.Uint16(e.Value[i:])
+			if e.VR == SS {
+				values = append(values, int64(int16(v)))
+			} else {
+				values = append(values, int64(v))
+			}
+		}
+		return values, nil
+	case UL, SL:
+		values := make([]int64, 0, len(e.Value)/4)
+		for i := 0; i+4 <= len(e.Value); i += 4 {
+			v := binary.LittleEndian.Uint32(e.Value[i:])
+			if e.VR == SL {
+				values = append(values, int64(int32(v)))
+			} else {
+				values = append(values, int64(v))
+			}
+		}
+		return values, nil
+	}
+	return nil, fmt.Errorf("%s: VR %s does not hold integers", describeTag(e.Tag), e.VR)
+}
+
+// SetInts replaces the value of an integer element (IS, US, SS, UL, SL)
+func (e *Element) SetInts(values ...int64) {
+	switch e.VR {
+	case IS:
+		s := make([]string, len(values))
+		for i, v := range values {
+			s[i] = strconv.FormatInt(v, 10)
+		}
+		e.SetStrings(s...)
+	case US, SS:
+		e.Value = make([]byte, 2*len(values))
+		for i, v := range values {
+			binary.LittleEndian.PutUint16(e.Value[2*i:], uint16(v))
+		}
+	case UL, SL:
+		e.Value = make([]byte, 4*len(values))
+		for i, v := range values {
+			binary.LittleEndian.PutUint32(e.Value[4*i:], uint32(v))
+		}
+	}
+}
+
+// Floats returns the values of a decimal element (DS, FL, FD)
+func (e *Element) Floats() ([]float64, error) {
+	switch e.VR {
+	case DS:
+		values := []float64{}
+		for _, s := range e.Strings() {
+			if s == "" {
+				continue
+			}
+			f, err := strconv.ParseFloat(s, 64)
+			if err != nil {
+				return nil, fmt.Errorf("%s: invalid DS value %q", describeTag(e.Tag), s)
+			}
+			values = append(values, f)
+		}
+		return values, nil
+	case FL:
+		values := make([]float64, 0, len(e.Value)/4)
+		for i := 0; i+4 <= len(e.Value); i += 4 {
+			values = append(values, float64(math.Float32frombits(binary.LittleEndian.Uint32(e.Value[i:]))))
+		}
+		return values, nil
+	case FD:
+		values := make([]float64, 0, len(e.Value)/8)
+		for i := 0; i+8 <= len(e.Value); i += 8 {
+			values = append(values, math.Float64frombits(binary.LittleEndian.Uint64(e.Value[i:])))
+		}
+		return values, nil
+	}
+	return nil, fmt.Errorf("%s: VR %s does not hold decimals", describeTag(e.Tag), e.VR)
+}
+
+// Copy returns a deep copy of the element
+func (e *Element) Copy() *Element {
+	c := &Element{Tag: e.Tag, VR: e.VR}
+	if e.Value != nil {
+		c.Value = append([]byte{}, e.Value...)
+	}
+	for _, item := range e.Items {
+		c.Items = append(c.Items, item.Copy())
+	}
+	for _, f := range e.Fragments {
+		c.Fragments = append(c.Fragments, append([]byte{}, f...))
+	}
+	return c
+}
+
+// Dataset represents an ordered collection of data elements
+type Dataset struct {
+	Elements []*Element
+}
+
+// NewDataset returns a new dataset holding the supplied elements
+func NewDataset(elements ...*Element) *Dataset {
+	ds := &Dataset{}
+	for _, e := range elements {
+		ds.Set(e)
+	}
+	return ds
+}
+
+// index returns the position of tag within the dataset, or where it would be inserted
+func (ds *Dataset) index(tag Tag) (int, bool) {
+	i := sort.Search(len(ds.Elements), func(i int) bool {
+		return ds.Elements[i].Tag >= tag
+	})
+	return i, i < len(ds.Elements) && ds.Elements[i].Tag == tag
+}
+
+// Get returns the element with the given tag or nil if it is not present
+func (ds *Dataset) Get(tag Tag) *Element {
+	if i, ok := ds.index(tag); ok {
+		return ds.Elements[i]
+	}
+	return nil
+}
+
+// GetString returns the first value of a character string element or "" if it is not present
+func (ds *Dataset) GetString(tag Tag) string {
+	if e := ds.Get(tag); e != nil {
+		return e.String()
+	}
+	return ""
+}
+
+// GetStrings returns all the values of a character string element
+func (ds *Dataset) GetStrings(tag Tag) []string {
+	if e := ds.Get(tag); e != nil {
+		return e.Strings()
+	}
+	return nil
+}
+
+// Set adds an element to the dataset replacing any element with the same tag
+func (ds *Dataset) Set(e *Element) {
+	i, ok := ds.index(e.Tag)
+	if ok {
+		ds.Elements[i] = e
+		return
+	}
+	ds.Elements = append(ds.Elements, nil)
+	copy(ds.Elements[i+1:], ds.Elements[i:])
+	ds.Elements[i] = e
+}
+
+// SetString adds or replaces a character string element, using the dictionary VR when the element is new
+func (ds *Dataset) SetString(tag Tag, values ...string) {
+	if e := ds.Get(tag); e != nil {
+		e.SetStrings(values...)
+		return
+	}
+	ds.Set(NewElement(tag, LookupVR(tag), values...))
+}
+
+// Remove deletes the element with the given tag and reports whether it was present
+func (ds *Dataset) Remove(tag Tag) bool {
+	i, ok := ds.index(tag)
+	if !ok {
+		return false
+	}
+	ds.Elements = append(ds.Elements[:i], ds.Elements[i+1:]...)
+	return true
+}
+
+// PrivateCreator returns the private creator string reserving the block of a private element
+func (ds *Dataset) PrivateCreator(tag Tag) string {
+	if !tag.IsPrivate() || tag.Element() < 0x1000 {
+		return ""
+	}
+	return ds.GetString(NewTag(tag.Group(), tag.Element()>>8))
+}
+
+// Copy returns a deep copy of the dataset
+func (ds *Dataset) Copy() *Dataset {
+	c := &Dataset{Elements: make([]*Element, 0, len(ds.Elements))}
+	for _, e := range ds.Elements {
+		c.Elements = append(c.Elements, e.Copy())
+	}
+	return c
+}
+
+// WalkFunc is called by Walk for every element in a dataset. parent is the dataset holding
+// the element and path locates it from the root, e.g. "(0008,1115)[0].(0008,1155)"
+type WalkFunc func(parent *Dataset, e *Element, path string) error
+
+// Walk visits every element of the dataset depth first, descending into sequence items.
+// Elements removed from their parent by fn are not descended into.
+func (ds *Dataset) Walk(fn WalkFunc) error {
+	return ds.walk("", fn)
+}
+
+func (ds *Dataset) walk(prefix string, fn WalkFunc) error {
+	elements := append([]*Element{}, ds.Elements...)
+	for _, e := range elements {
+		path := prefix + e.Tag.String()
+		if err := fn(ds, e, path); err != nil {
+			return err
+		}
+		if !e.IsSequence() || ds.Get(e.Tag) != e {
+			continue
+		}
+		for i, item := range e.Items {
+			if err := item.walk(fmt.Sprintf("%s[%d].", path, i), fn); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// trimValue removes the insignificant spaces around a single string value
+func trimValue(vr, v string) string {
+	switch vr {
+	case LT, ST, UT, PN, LO, SH, UC:
+		return strings.TrimRight(v, " ")
+	}
+	return strings.Trim(v, " \x00")
+}
+
+// pad appends p to b when needed so the value has an even length
+func pad(b []byte, p byte) []byte {
+	if len(b)%2 == 1 {
+		b = append(b, p)
+	}
+	return b
+}
@@ -0,0 +1,228 @@
+package dicom_test
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"gitlab.com/medical-research/dicom-deidentifier/dicom"
+)
+
+// newTestFile returns an instance with nested sequences and pixel data
+func newTestFile(transferSyntax string) *dicom.File {
+	return &dicom.File{
+		Meta: dicom.NewDataset(
+			dicom.NewElement(dicom.MediaStorageSOPClassUID, dicom.UI, "1.2.840.10008.5.1.4.1.1.2"),
+			dicom.NewElement(dicom.MediaStorageSOPInstanceUID, dicom.UI, "1.2.3.4.5"),
+			dicom.NewElement(dicom.TransferSyntaxUID, dicom.UI, transferSyntax),
+		),
+		Dataset: dicom.NewDataset(
+			dicom.NewElement(dicom.SOPClassUID, dicom.UI, "1.2.840.10008.5.1.4.1.1.2"),
+			dicom.NewElement(dicom.SOPInstanceUID, dicom.UI, "1.2.3.4.5"),
+			dicom.NewElement(dicom.PatientName, dicom.PN, "Doe^John"),
+			dicom.NewElement(dicom.ImageType, dicom.CS, "ORIGINAL", "PRIMARY", "AXIAL"),
+			dicom.NewSequence(dicom.Tag(0x00081115),
+				dicom.NewDataset(
+					dicom.NewElement(dicom.SeriesInstanceUID, dicom.UI, "1.2.3.4"),
+					dicom.NewSequence(dicom.Tag(0x00081199),
+						dicom.NewDataset(dicom.NewElement(dicom.ReferencedSOPInstanceUID, dicom.UI, "1.2.3.4.6")),
+					),
+				),
+			),
+			&dicom.Element{Tag: dicom.Rows, VR: dicom.US, Value: []byte{0x02, 0x00}},
+			&dicom.Element{Tag: dicom.PixelData, VR: dicom.OW, Value: []byte{1, 2, 3, 4}},
+		),
+	}
+}
+
+func TestWriteRead(t *testing.T) {
+	tests := []struct {
+		name           string
+		transferSyntax string
+	}{
+		{
+			name:           "implicit VR little endian",
+			transferSyntax: dicom.ImplicitVRLittleEndian,
+		},
+		{
+			name:           "explicit VR little endian",
+			transferSyntax: dicom.ExplicitVRLittleEndian,
+		},
+		{
+			name:           "deflated explicit VR little endian",
+			transferSyntax: dicom.DeflatedExplicitVRLittleEndian,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			want := newTestFile(tt.transferSyntax)
+
+			var buf bytes.Buffer
+			if err := dicom.Write(&buf, want); err != nil {
+				t.Fatalf("Write() error = %v", err)
+			}
+			got, err := dicom.Read(&buf)
+			if err != nil {
+				t.Fatalf("Read() error = %v", err)
+			}
+
+			if got.TransferSyntax() != tt.transferSyntax {
+				t.Errorf("TransferSyntax() = %v, want %v", got.TransferSyntax(), tt.transferSyntax)
+			}
+			if name := got.Dataset.GetString(dicom.PatientName); name != "Doe^John" {
+				t.Errorf("PatientName = %q, want %q", name, "Doe^John")
+			}
+			if types := got.Dataset.GetStrings(dicom.ImageType); !reflect.DeepEqual(types, []string{"ORIGINAL", "PRIMARY", "AXIAL"}) {
+				t.Errorf("ImageType = %v", types)
+			}
+			series := got.Dataset.Get(dicom.Tag(0x00081115))
+			if series == nil || len(series.Items) != 1 {
+				t.Fatalf("ReferencedSeriesSequence was not read: %v", series)
+			}
+			sops := series.Items[0].Get(dicom.Tag(0x00081199))
+			if sops == nil || len(sops.Items) != 1 || sops.Items[0].GetString(dicom.ReferencedSOPInstanceUID) != "1.2.3.4.6" {
+				t.Errorf("nested ReferencedSOPSequence was not read: %v", sops)
+			}
+			if rows, err := got.Dataset.Get(dicom.Rows).Ints(); err != nil || !reflect.DeepEqual(rows, []int64{2}) {
+				t.Errorf("Rows = %v, %v", rows, err)
+			}
+			if px := got.Dataset.Get(dicom.PixelData); px == nil || !bytes.Equal(px.Value, []byte{1, 2, 3, 4}) {
+				t.Errorf("PixelData = %v", px)
+			}
+		})
+	}
+}
+
+func TestRead_Encapsulated(t *testing.T) {
+	f := newTestFile("1.2.840.10008.1.2.4.50")
+	f.Dataset.Set(&dicom.Element{Tag: dicom.PixelData, VR: dicom.OB, Fragments: [][]byte{{}, {0xFF, 0xD8, 0xFF, 0xD9}}})
+
+	var buf bytes.Buffer
+	if err := dicom.Write(&buf, f); err != nil {
+		t.Fatalf("Write() error = %v", err)
+	}
+	got, err := dicom.Read(&buf)
+	if err != nil {
+		t.Fatalf("Read() error = %v", err)
+	}
+	px := got.Dataset.Get(dicom.PixelData)
+	if px == nil || !px.IsEncapsulated() || len(px.Fragments) != 2 {
+		t.Fatalf("PixelData fragments were not read: %v", px)
+	}
+	if !bytes.Equal(px.Fragments[1], []byte{0xFF, 0xD8, 0xFF, 0xD9}) {
+		t.Errorf("fragment = %v", px.Fragments[1])
+	}
+}
+
+func TestRead_Invalid(t *testing.T) {
+	tests := []struct {
+		name string
+		data []byte
+	}{
+		{
+			name: "empty input",
+			data: []byte{},
+		},
+		{
+			name: "missing DICM prefix",
+			data: make([]byte, 256),
+		},
+		{
+			name: "missing transfer syntax",
+			data: append(make([]byte, 128), []byte("DICM")...),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := dicom.Read(bytes.NewReader(tt.data)); err == nil {
+				t.Errorf("Read() expected an error")
+			}
+		})
+	}
+}
+
+func TestReadDataset_UnknownSequence(t *testing.T) {
+	// implicit VR item holding PatientName, as an explicit VR UN element of defined length
+	name := []byte{0x10, 0x00, 0x10, 0x00, 0x08, 0x00, 0x00, 0x00, 'D', 'o', 'e', '^', 'J', 'o', 'h', 'n'}
+	item := append([]byte{0xFE, 0xFF, 0x00, 0xE0, byte(len(name)), 0x00, 0x00, 0x00}, name...)
+	unknown := func(value []byte) []byte {
+		return append([]byte{0x09, 0x00, 0x10, 0x10, 'U', 'N', 0x00, 0x00, byte(len(value)), 0x00, 0x00, 0x00}, value...)
+	}
+
+	t.Run("items", func(t *testing.T) {
+		ds, err := dicom.ReadDataset(unknown(item), dicom.ExplicitVRLittleEndian)
+		if err != nil {
+			t.Fatalf("ReadDataset() error = %v", err)
+		}
+		e := ds.Get(dicom.Tag(0x00091010))
+		if e == nil || e.VR != dicom.SQ || len(e.Items) != 1 {
+			t.Fatalf("element was not read as a sequence: %v", e)
+		}
+		if got := e.Items[0].GetString(dicom.PatientName); got != "Doe^John" {
+			t.Errorf("nested PatientName = %q, want %q", got, "Doe^John")
+		}
+	})
+
+	t.Run("truncated items", func(t *testing.T) {
+		value := item[:len(item)-4]
+		ds, err := dicom.ReadDataset(unknown(value), dicom.ExplicitVRLittleEndian)
+		if err != nil {
+			t.Fatalf("ReadDataset() error = %v", err)
+		}
+		e := ds.Get(dicom.Tag(0x00091010))
+		if e == nil || e.VR != dicom.UN || !bytes.Equal(e.Value, value) {
+			t.Errorf("element was not kept as UN: %v", e)
+		}
+	})
+}
+
+func TestParseTag(t *testing.T) {
+	tests := []struct {
+		name    string
+		s       string
+		want    dicom.Tag
+		wantErr bool
+	}{
+		{name: "parenthesised", s: "(0010,0010)", want: dicom.PatientName},
+		{name: "comma separated", s: "0020,000D", want: dicom.StudyInstanceUID},
+		{name: "plain", s: "7FE00010", want: dicom.PixelData},
+		{name: "too short", s: "0010", wantErr: true},
+		{name: "not hex", s: "(00G0,0010)", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := dicom.ParseTag(tt.s)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseTag() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("ParseTag() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLookupVR(t *testing.T) {
+	tests := []struct {
+		tag  dicom.Tag
+		want string
+	}{
+		{dicom.PatientName, dicom.PN},
+		{dicom.Tag(0x00280106), dicom.US}, // US or SS
+		{dicom.PixelData, dicom.OW},       // OB or OW
+		{dicom.Tag(0x60023000), dicom.OW}, // overlay repeating group
+		{dicom.Tag(0x00181200), dicom.DA}, // Date of Last Calibration
+		{dicom.Tag(0x00321066), dicom.UT}, // Reason for Visit
+		{dicom.Tag(0x00091001), dicom.UN},
+		{dicom.Tag(0x00090010), dicom.LO},
+	}
+	for _, tt := range tests {
+		if got := dicom.LookupVR(tt.tag); got != tt.want {
+			t.Errorf("LookupVR(%s) = %s, want %s", tt.tag, got, tt.want)
+		}
+	}
+	if got := dicom.Name(dicom.PatientName); got != "Patient's Name" {
+		t.Errorf("Name() = %q, want Patient's Name", got)
+	}
+}
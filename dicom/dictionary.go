@@ -0,0 +1,106 @@
+package dicom
+
+import (
+	"strings"
+
+	"gitlab.com/medical-research/dicom-deidentifier/dicom/dictionary"
+)
+
+// DictionaryEntry describes a standard attribute
+type DictionaryEntry struct {
+	Tag     Tag
+	VR      string
+	Keyword string
+	Name    string
+	VM      string
+	Retired bool
+}
+
+// Lookup returns the dictionary entry of a tag. Where the VR of the attribute depends on the
+// dataset, e.g. "US or SS", VR is the one used when reading implicit VR datasets.
+func Lookup(tag Tag) (DictionaryEntry, bool) {
+	e, ok := dictionary.Lookup(uint32(tag))
+	if !ok {
+		return DictionaryEntry{}, false
+	}
+	return DictionaryEntry{
+		Tag:     Tag(e.Tag),
+		VR:      implicitVR(e.VR),
+		Keyword: e.Keyword,
+		Name:    e.Name,
+		VM:      e.VM,
+		Retired: e.Retired,
+	}, true
+}
+
+// implicitVR returns the VR read for a choice of VRs: OW for pixel, overlay and LUT data and the first VR otherwise
+func implicitVR(vr string) string {
+	vrs := strings.Split(vr, " or ")
+	for _, v := range vrs {
+		if v == OW {
+			return OW
+		}
+	}
+	if !IsKnownVR(vrs[0]) {
+		return UN
+	}
+	return vrs[0]
+}
+
+// LookupVR returns the VR of a tag as used when reading implicit VR datasets
+func LookupVR(tag Tag) string {
+	switch {
+	case tag.IsGroupLength():
+		return UL
+	case tag.IsPrivateCreator():
+		return LO
+	}
+	if e, ok := Lookup(tag); ok {
+		return e.VR
+	}
+	return UN
+}
+
+// Keyword returns the keyword of a tag, or the tag number if it is not in the dictionary
+func Keyword(tag Tag) string {
+	if e, ok := Lookup(tag); ok && e.Keyword != "" {
+		return e.Keyword
+	}
+	return tag.String()
+}
+
+// Name returns the name of a tag, e.g. "Patient's Name", or the tag number if it is not in the dictionary
+func Name(tag Tag) string {
+	if e, ok := Lookup(tag); ok {
+		return e.Name
+	}
+	return tag.String()
+}
+
+// TagForKeyword returns the tag with the given keyword
+func TagForKeyword(keyword string) (Tag, bool) {
+	e, ok := dictionary.LookupKeyword(keyword)
+	return Tag(e.Tag), ok
+}
+
+// UIDName returns the name of a UID of the registry, e.g. "CT Image Storage", or "" if it is not registered
+func UIDName(uid string) string {
+	u, _ := dictionary.LookupUID(uid)
+	return u.Name
+}
+
+// describeTag returns a tag with its keyword for error messages, e.g. "(0010,0010) PatientName"
+func describeTag(tag Tag) string {
+	if e, ok := Lookup(tag); ok && e.Keyword != "" {
+		return tag.String() + " " + e.Keyword
+	}
+	return tag.String()
+}
+
+// describeUID returns a UID with its name for error messages, e.g. "1.2.840.10008.1.2.2 (Explicit VR Big Endian)"
+func describeUID(uid string) string {
+	if name := UIDName(uid); name != "" {
+		return uid + " (" + name + ")"
+	}
+	return uid
+}
@@ -0,0 +1,115 @@
+// Package dictionary is the DICOM data dictionary of PS3.6: the public data elements with their
+// name, keyword, VR, VM and retired flag, and the registry of UIDs such as SOP classes and
+// transfer syntaxes.
+//
+// Tags are uint32 values, the group number in the high 16 bits, so that the package can be used
+// by the dicom package reading datasets.
+package dictionary
+
+// The registry is kept in elements.csv and uids.csv and compiled into dictionary_table.go.
+//go:generate go run ./internal/dictgen -xml https://dicom.nema.org/medical/dicom/current/source/docbook/part06/part06.xml -elements elements.csv -uids uids.csv -out dictionary_table.go
+
+// Element describes a public data element
+type Element struct {
+	Tag     uint32
+	Name    string
+	Keyword string
+	// VR is the value representation, or the choice of VRs such as "US or SS" where it depends on the dataset
+	VR string
+	// VM is the value multiplicity, e.g. "1", "2-n" or "1-32"
+	VM      string
+	Retired bool
+}
+
+// repeatingElement describes an element defined for a range of tags, such as the overlay
+// repeating groups (60xx,eeee). Tags match when they equal the element's tag under the mask.
+type repeatingElement struct {
+	mask uint32
+	Element
+}
+
+// Types of UIDs in the registry
+const (
+	TransferSyntax       = "Transfer Syntax"
+	SOPClass             = "SOP Class"
+	MetaSOPClass         = "Meta SOP Class"
+	WellKnownSOPInstance = "Well-known SOP Instance"
+)
+
+// UID describes a UID of the registry of PS3.6 Annex A
+type UID struct {
+	Value   string
+	Name    string
+	Keyword string
+	Type    string
+	Retired bool
+}
+
+var (
+	byTag        = map[uint32]*Element{}
+	byKeyword    = map[string]*Element{}
+	byUID        = map[string]*UID{}
+	byUIDKeyword = map[string]*UID{}
+)
+
+func init() {
+	for i := range elements {
+		e := &elements[i]
+		byTag[e.Tag] = e
+		if e.Keyword != "" {
+			byKeyword[e.Keyword] = e
+		}
+	}
+	for i := range repeatingElements {
+		if e := &repeatingElements[i].Element; e.Keyword != "" {
+			byKeyword[e.Keyword] = e
+		}
+	}
+	for i := range uids {
+		u := &uids[i]
+		byUID[u.Value] = u
+		if u.Keyword != "" {
+			byUIDKeyword[u.Keyword] = u
+		}
+	}
+}
+
+// Lookup returns the element of a tag. Elements of repeating groups are returned with the tag looked up.
+func Lookup(tag uint32) (Element, bool) {
+	if e, ok := byTag[tag]; ok {
+		return *e, true
+	}
+	for _, r := range repeatingElements {
+		if tag&r.mask == r.Tag {
+			e := r.Element
+			e.Tag = tag
+			return e, true
+		}
+	}
+	return Element{}, false
+}
+
+// LookupKeyword returns the element with a keyword. Elements of repeating groups are returned
+// with their first tag, e.g. (6000,3000) for OverlayData.
+func LookupKeyword(keyword string) (Element, bool) {
+	if e, ok := byKeyword[keyword]; ok {
+		return *e, true
+	}
+	return Element{}, false
+}
+
+// LookupUID returns the registry entry of a UID
+func LookupUID(uid string) (UID, bool) {
+	if u, ok := byUID[uid]; ok {
+		return *u, true
+	}
+	return UID{}, false
+}
+
+// LookupUIDKeyword returns the registry entry of a UID with a keyword, e.g. "CTImageStorage"
+func LookupUIDKeyword(keyword string) (UID, bool) {
+	if u, ok := byUIDKeyword[keyword]; ok {
+		return *u, true
+	}
+	return UID{}, false
+}
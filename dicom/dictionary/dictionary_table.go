@@ -0,0 +1,5251 @@
+// Code generated by dictgen from elements.csv and uids.csv; DO NOT EDIT.
+
+package dictionary
+
+// Edition is the edition of PS3.6 the dictionary was generated from.
+// It is empty when the dictionary was not generated from the standard.
+const Edition = "2024b"
+
+// elements are the public data elements with a single tag
+var elements = []Element{
+	{Tag: 0x00020000, Name: "File Meta Information Group Length", Keyword: "FileMetaInformationGroupLength", VR: "UL", VM: "1"},
+	{Tag: 0x00020001, Name: "File Meta Information Version", Keyword: "FileMetaInformationVersion", VR: "OB", VM: "1"},
+	{Tag: 0x00020002, Name: "Media Storage SOP Class UID", Keyword: "MediaStorageSOPClassUID", VR: "UI", VM: "1"},
+	{Tag: 0x00020003, Name: "Media Storage SOP Instance UID", Keyword: "MediaStorageSOPInstanceUID", VR: "UI", VM: "1"},
+	{Tag: 0x00020010, Name: "Transfer Syntax UID", Keyword: "TransferSyntaxUID", VR: "UI", VM: "1"},
+	{Tag: 0x00020012, Name: "Implementation Class UID", Keyword: "ImplementationClassUID", VR: "UI", VM: "1"},
+	{Tag: 0x00020013, Name: "Implementation Version Name", Keyword: "ImplementationVersionName", VR: "SH", VM: "1"},
+	{Tag: 0x00020016, Name: "Source Application Entity Title", Keyword: "SourceApplicationEntityTitle", VR: "AE", VM: "1"},
+	{Tag: 0x00020017, Name: "Sending Application Entity Title", Keyword: "SendingApplicationEntityTitle", VR: "AE", VM: "1"},
+	{Tag: 0x00020018, Name: "Receiving Application Entity Title", Keyword: "ReceivingApplicationEntityTitle", VR: "AE", VM: "1"},
+	{Tag: 0x00020026, Name: "Source Presentation Address", Keyword: "SourcePresentationAddress", VR: "UR", VM: "1"},
+	{Tag: 0x00020027, Name: "Sending Presentation Address", Keyword: "SendingPresentationAddress", VR: "UR", VM: "1"},
+	{Tag: 0x00020028, Name: "Receiving Presentation Address", Keyword: "ReceivingPresentationAddress", VR: "UR", VM: "1"},
+	{Tag: 0x00020031, Name: "RTV Meta Information Version", Keyword: "RTVMetaInformationVersion", VR: "OB", VM: "1"},
+	{Tag: 0x00020032, Name: "RTV Communication SOP Class UID", Keyword: "RTVCommunicationSOPClassUID", VR: "UI", VM: "1"},
+	{Tag: 0x00020033, Name: "RTV Communication SOP Instance UID", Keyword: "RTVCommunicationSOPInstanceUID", VR: "UI", VM: "1"},
+	{Tag: 0x00020035, Name: "RTV Source Identifier", Keyword: "RTVSourceIdentifier", VR: "OB", VM: "1"},
+	{Tag: 0x00020036, Name: "RTV Flow Identifier", Keyword: "RTVFlowIdentifier", VR: "OB", VM: "1"},
+	{Tag: 0x00020037, Name: "RTV Flow RTP Sampling Rate", Keyword: "RTVFlowRTPSamplingRate", VR: "UL", VM: "1"},
+	{Tag: 0x00020038, Name: "RTV Flow Actual Frame Duration", Keyword: "RTVFlowActualFrameDuration", VR: "FD", VM: "1"},
+	{Tag: 0x00020100, Name: "Private Information Creator UID", Keyword: "PrivateInformationCreatorUID", VR: "UI", VM: "1"},
+	{Tag: 0x00020102, Name: "Private Information", Keyword: "PrivateInformation", VR: "OB", VM: "1"},
+	{Tag: 0x00041130, Name: "File-set ID", Keyword: "FileSetID", VR: "CS", VM: "1"},
+	{Tag: 0x00041141, Name: "File-set Descriptor File ID", Keyword: "FileSetDescriptorFileID", VR: "CS", VM: "1-8"},
+	{Tag: 0x00041142, Name: "Specific Character Set of File-set Descriptor File", Keyword: "SpecificCharacterSetOfFileSetDescriptorFile", VR: "CS", VM: "1"},
+	{Tag: 0x00041200, Name: "Offset of the First Directory Record of the Root Directory Entity", Keyword: "OffsetOfTheFirstDirectoryRecordOfTheRootDirectoryEntity", VR: "UL", VM: "1"},
+	{Tag: 0x00041202, Name: "Offset of the Last Directory Record of the Root Directory Entity", Keyword: "OffsetOfTheLastDirectoryRecordOfTheRootDirectoryEntity", VR: "UL", VM: "1"},
+	{Tag: 0x00041212, Name: "File-set Consistency Flag", Keyword: "FileSetConsistencyFlag", VR: "US", VM: "1"},
+	{Tag: 0x00041220, Name: "Directory Record Sequence", Keyword: "DirectoryRecordSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00041400, Name: "Offset of the Next Directory Record", Keyword: "OffsetOfTheNextDirectoryRecord", VR: "UL", VM: "1"},
+	{Tag: 0x00041410, Name: "Record In-use Flag", Keyword: "RecordInUseFlag", VR: "US", VM: "1"},
+	{Tag: 0x00041420, Name: "Offset of Referenced Lower-Level Directory Entity", Keyword: "OffsetOfReferencedLowerLevelDirectoryEntity", VR: "UL", VM: "1"},
+	{Tag: 0x00041430, Name: "Directory Record Type", Keyword: "DirectoryRecordType", VR: "CS", VM: "1"},
+	{Tag: 0x00041432, Name: "Private Record UID", Keyword: "PrivateRecordUID", VR: "UI", VM: "1"},
+	{Tag: 0x00041500, Name: "Referenced File ID", Keyword: "ReferencedFileID", VR: "CS", VM: "1-8"},
+	{Tag: 0x00041504, Name: "MRDR Directory Record Offset", Keyword: "MRDRDirectoryRecordOffset", VR: "UL", VM: "1", Retired: true},
+	{Tag: 0x00041510, Name: "Referenced SOP Class UID in File", Keyword: "ReferencedSOPClassUIDInFile", VR: "UI", VM: "1"},
+	{Tag: 0x00041511, Name: "Referenced SOP Instance UID in File", Keyword: "ReferencedSOPInstanceUIDInFile", VR: "UI", VM: "1"},
+	{Tag: 0x00041512, Name: "Referenced Transfer Syntax UID in File", Keyword: "ReferencedTransferSyntaxUIDInFile", VR: "UI", VM: "1"},
+	{Tag: 0x0004151A, Name: "Referenced Related General SOP Class UID in File", Keyword: "ReferencedRelatedGeneralSOPClassUIDInFile", VR: "UI", VM: "1-n"},
+	{Tag: 0x00041600, Name: "Number of References", Keyword: "NumberOfReferences", VR: "UL", VM: "1", Retired: true},
+	{Tag: 0x00060001, Name: "Current Frame Functional Groups Sequence", Keyword: "CurrentFrameFunctionalGroupsSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00080001, Name: "Length to End", Keyword: "LengthToEnd", VR: "UL", VM: "1", Retired: true},
+	{Tag: 0x00080005, Name: "Specific Character Set", Keyword: "SpecificCharacterSet", VR: "CS", VM: "1-n"},
+	{Tag: 0x00080006, Name: "Language Code Sequence", Keyword: "LanguageCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00080008, Name: "Image Type", Keyword: "ImageType", VR: "CS", VM: "2-n"},
+	{Tag: 0x00080010, Name: "Recognition Code", Keyword: "RecognitionCode", VR: "SH", VM: "1", Retired: true},
+	{Tag: 0x00080012, Name: "Instance Creation Date", Keyword: "InstanceCreationDate", VR: "DA", VM: "1"},
+	{Tag: 0x00080013, Name: "Instance Creation Time", Keyword: "InstanceCreationTime", VR: "TM", VM: "1"},
+	{Tag: 0x00080014, Name: "Instance Creator UID", Keyword: "InstanceCreatorUID", VR: "UI", VM: "1"},
+	{Tag: 0x00080015, Name: "Instance Coercion DateTime", Keyword: "InstanceCoercionDateTime", VR: "DT", VM: "1"},
+	{Tag: 0x00080016, Name: "SOP Class UID", Keyword: "SOPClassUID", VR: "UI", VM: "1"},
+	{Tag: 0x00080017, Name: "Acquisition UID", Keyword: "AcquisitionUID", VR: "UI", VM: "1"},
+	{Tag: 0x00080018, Name: "SOP Instance UID", Keyword: "SOPInstanceUID", VR: "UI", VM: "1"},
+	{Tag: 0x00080019, Name: "Pyramid UID", Keyword: "PyramidUID", VR: "UI", VM: "1"},
+	{Tag: 0x0008001A, Name: "Related General SOP Class UID", Keyword: "RelatedGeneralSOPClassUID", VR: "UI", VM: "1-n"},
+	{Tag: 0x0008001B, Name: "Original Specialized SOP Class UID", Keyword: "OriginalSpecializedSOPClassUID", VR: "UI", VM: "1"},
+	{Tag: 0x0008001C, Name: "Synthetic Data", Keyword: "SyntheticData", VR: "CS", VM: "1"},
+	{Tag: 0x00080020, Name: "Study Date", Keyword: "StudyDate", VR: "DA", VM: "1"},
+	{Tag: 0x00080021, Name: "Series Date", Keyword: "SeriesDate", VR: "DA", VM: "1"},
+	{Tag: 0x00080022, Name: "Acquisition Date", Keyword: "AcquisitionDate", VR: "DA", VM: "1"},
+	{Tag: 0x00080023, Name: "Content Date", Keyword: "ContentDate", VR: "DA", VM: "1"},
+	{Tag: 0x00080024, Name: "Overlay Date", Keyword: "OverlayDate", VR: "DA", VM: "1", Retired: true},
+	{Tag: 0x00080025, Name: "Curve Date", Keyword: "CurveDate", VR: "DA", VM: "1", Retired: true},
+	{Tag: 0x0008002A, Name: "Acquisition DateTime", Keyword: "AcquisitionDateTime", VR: "DT", VM: "1"},
+	{Tag: 0x00080030, Name: "Study Time", Keyword: "StudyTime", VR: "TM", VM: "1"},
+	{Tag: 0x00080031, Name: "Series Time", Keyword: "SeriesTime", VR: "TM", VM: "1"},
+	{Tag: 0x00080032, Name: "Acquisition Time", Keyword: "AcquisitionTime", VR: "TM", VM: "1"},
+	{Tag: 0x00080033, Name: "Content Time", Keyword: "ContentTime", VR: "TM", VM: "1"},
+	{Tag: 0x00080034, Name: "Overlay Time", Keyword: "OverlayTime", VR: "TM", VM: "1", Retired: true},
+	{Tag: 0x00080035, Name: "Curve Time", Keyword: "CurveTime", VR: "TM", VM: "1", Retired: true},
+	{Tag: 0x00080040, Name: "Data Set Type", Keyword: "DataSetType", VR: "US", VM: "1", Retired: true},
+	{Tag: 0x00080041, Name: "Data Set Subtype", Keyword: "DataSetSubtype", VR: "LO", VM: "1", Retired: true},
+	{Tag: 0x00080042, Name: "Nuclear Medicine Series Type", Keyword: "NuclearMedicineSeriesType", VR: "CS", VM: "1", Retired: true},
+	{Tag: 0x00080050, Name: "Accession Number", Keyword: "AccessionNumber", VR: "SH", VM: "1"},
+	{Tag: 0x00080051, Name: "Issuer of Accession Number Sequence", Keyword: "IssuerOfAccessionNumberSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00080052, Name: "Query/Retrieve Level", Keyword: "QueryRetrieveLevel", VR: "CS", VM: "1"},
+	{Tag: 0x00080053, Name: "Query/Retrieve View", Keyword: "QueryRetrieveView", VR: "CS", VM: "1"},
+	{Tag: 0x00080054, Name: "Retrieve AE Title", Keyword: "RetrieveAETitle", VR: "AE", VM: "1-n"},
+	{Tag: 0x00080055, Name: "Station AE Title", Keyword: "StationAETitle", VR: "AE", VM: "1"},
+	{Tag: 0x00080056, Name: "Instance Availability", Keyword: "InstanceAvailability", VR: "CS", VM: "1"},
+	{Tag: 0x00080058, Name: "Failed SOP Instance UID List", Keyword: "FailedSOPInstanceUIDList", VR: "UI", VM: "1-n"},
+	{Tag: 0x00080060, Name: "Modality", Keyword: "Modality", VR: "CS", VM: "1"},
+	{Tag: 0x00080061, Name: "Modalities in Study", Keyword: "ModalitiesInStudy", VR: "CS", VM: "1-n"},
+	{Tag: 0x00080062, Name: "SOP Classes in Study", Keyword: "SOPClassesInStudy", VR: "UI", VM: "1-n"},
+	{Tag: 0x00080063, Name: "Anatomic Regions in Study Code Sequence", Keyword: "AnatomicRegionsInStudyCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00080064, Name: "Conversion Type", Keyword: "ConversionType", VR: "CS", VM: "1"},
+	{Tag: 0x00080068, Name: "Presentation Intent Type", Keyword: "PresentationIntentType", VR: "CS", VM: "1"},
+	{Tag: 0x00080070, Name: "Manufacturer", Keyword: "Manufacturer", VR: "LO", VM: "1"},
+	{Tag: 0x00080080, Name: "Institution Name", Keyword: "InstitutionName", VR: "LO", VM: "1"},
+	{Tag: 0x00080081, Name: "Institution Address", Keyword: "InstitutionAddress", VR: "ST", VM: "1"},
+	{Tag: 0x00080082, Name: "Institution Code Sequence", Keyword: "InstitutionCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00080090, Name: "Referring Physician's Name", Keyword: "ReferringPhysicianName", VR: "PN", VM: "1"},
+	{Tag: 0x00080092, Name: "Referring Physician's Address", Keyword: "ReferringPhysicianAddress", VR: "ST", VM: "1"},
+	{Tag: 0x00080094, Name: "Referring Physician's Telephone Numbers", Keyword: "ReferringPhysicianTelephoneNumbers", VR: "SH", VM: "1-n"},
+	{Tag: 0x00080096, Name: "Referring Physician Identification Sequence", Keyword: "ReferringPhysicianIdentificationSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x0008009C, Name: "Consulting Physician's Name", Keyword: "ConsultingPhysicianName", VR: "PN", VM: "1-n"},
+	{Tag: 0x0008009D, Name: "Consulting Physician Identification Sequence", Keyword: "ConsultingPhysicianIdentificationSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00080100, Name: "Code Value", Keyword: "CodeValue", VR: "SH", VM: "1"},
+	{Tag: 0x00080101, Name: "Extended Code Value", Keyword: "ExtendedCodeValue", VR: "LO", VM: "1"},
+	{Tag: 0x00080102, Name: "Coding Scheme Designator", Keyword: "CodingSchemeDesignator", VR: "SH", VM: "1"},
+	{Tag: 0x00080103, Name: "Coding Scheme Version", Keyword: "CodingSchemeVersion", VR: "SH", VM: "1"},
+	{Tag: 0x00080104, Name: "Code Meaning", Keyword: "CodeMeaning", VR: "LO", VM: "1"},
+	{Tag: 0x00080105, Name: "Mapping Resource", Keyword: "MappingResource", VR: "CS", VM: "1"},
+	{Tag: 0x00080106, Name: "Context Group Version", Keyword: "ContextGroupVersion", VR: "DT", VM: "1"},
+	{Tag: 0x00080107, Name: "Context Group Local Version", Keyword: "ContextGroupLocalVersion", VR: "DT", VM: "1"},
+	{Tag: 0x00080108, Name: "Extended Code Meaning", Keyword: "ExtendedCodeMeaning", VR: "LT", VM: "1"},
+	{Tag: 0x00080109, Name: "Coding Scheme Resources Sequence", Keyword: "CodingSchemeResourcesSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x0008010A, Name: "Coding Scheme URL Type", Keyword: "CodingSchemeURLType", VR: "CS", VM: "1"},
+	{Tag: 0x0008010B, Name: "Context Group Extension Flag", Keyword: "ContextGroupExtensionFlag", VR: "CS", VM: "1"},
+	{Tag: 0x0008010C, Name: "Coding Scheme UID", Keyword: "CodingSchemeUID", VR: "UI", VM: "1"},
+	{Tag: 0x0008010D, Name: "Context Group Extension Creator UID", Keyword: "ContextGroupExtensionCreatorUID", VR: "UI", VM: "1"},
+	{Tag: 0x0008010E, Name: "Coding Scheme URL", Keyword: "CodingSchemeURL", VR: "UR", VM: "1"},
+	{Tag: 0x0008010F, Name: "Context Identifier", Keyword: "ContextIdentifier", VR: "CS", VM: "1"},
+	{Tag: 0x00080110, Name: "Coding Scheme Identification Sequence", Keyword: "CodingSchemeIdentificationSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00080112, Name: "Coding Scheme Registry", Keyword: "CodingSchemeRegistry", VR: "LO", VM: "1"},
+	{Tag: 0x00080114, Name: "Coding Scheme External ID", Keyword: "CodingSchemeExternalID", VR: "ST", VM: "1"},
+	{Tag: 0x00080115, Name: "Coding Scheme Name", Keyword: "CodingSchemeName", VR: "ST", VM: "1"},
+	{Tag: 0x00080116, Name: "Coding Scheme Responsible Organization", Keyword: "CodingSchemeResponsibleOrganization", VR: "ST", VM: "1"},
+	{Tag: 0x00080117, Name: "Context UID", Keyword: "ContextUID", VR: "UI", VM: "1"},
+	{Tag: 0x00080118, Name: "Mapping Resource UID", Keyword: "MappingResourceUID", VR: "UI", VM: "1"},
+	{Tag: 0x00080119, Name: "Long Code Value", Keyword: "LongCodeValue", VR: "UC", VM: "1"},
+	{Tag: 0x00080120, Name: "URN Code Value", Keyword: "URNCodeValue", VR: "UR", VM: "1"},
+	{Tag: 0x00080121, Name: "Equivalent Code Sequence", Keyword: "EquivalentCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00080122, Name: "Mapping Resource Name", Keyword: "MappingResourceName", VR: "LO", VM: "1"},
+	{Tag: 0x00080123, Name: "Context Group Identification Sequence", Keyword: "ContextGroupIdentificationSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00080124, Name: "Mapping Resource Identification Sequence", Keyword: "MappingResourceIdentificationSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00080201, Name: "Timezone Offset From UTC", Keyword: "TimezoneOffsetFromUTC", VR: "SH", VM: "1"},
+	{Tag: 0x00080220, Name: "Responsible Group Code Sequence", Keyword: "ResponsibleGroupCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00080221, Name: "Equipment Modality", Keyword: "EquipmentModality", VR: "CS", VM: "1"},
+	{Tag: 0x00080222, Name: "Manufacturer's Related Model Group", Keyword: "ManufacturerRelatedModelGroup", VR: "LO", VM: "1"},
+	{Tag: 0x00080300, Name: "Private Data Element Characteristics Sequence", Keyword: "PrivateDataElementCharacteristicsSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00080301, Name: "Private Group Reference", Keyword: "PrivateGroupReference", VR: "US", VM: "1"},
+	{Tag: 0x00080302, Name: "Private Creator Reference", Keyword: "PrivateCreatorReference", VR: "LO", VM: "1"},
+	{Tag: 0x00080303, Name: "Block Identifying Information Status", Keyword: "BlockIdentifyingInformationStatus", VR: "CS", VM: "1"},
+	{Tag: 0x00080304, Name: "Nonidentifying Private Elements", Keyword: "NonidentifyingPrivateElements", VR: "US", VM: "1-n"},
+	{Tag: 0x00080305, Name: "Deidentification Action Sequence", Keyword: "DeidentificationActionSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00080306, Name: "Identifying Private Elements", Keyword: "IdentifyingPrivateElements", VR: "US", VM: "1-n"},
+	{Tag: 0x00080307, Name: "Deidentification Action", Keyword: "DeidentificationAction", VR: "CS", VM: "1"},
+	{Tag: 0x00080308, Name: "Private Data Element", Keyword: "PrivateDataElement", VR: "US", VM: "1"},
+	{Tag: 0x00080309, Name: "Private Data Element Value Multiplicity", Keyword: "PrivateDataElementValueMultiplicity", VR: "UL", VM: "1-3"},
+	{Tag: 0x0008030A, Name: "Private Data Element Value Representation", Keyword: "PrivateDataElementValueRepresentation", VR: "CS", VM: "1"},
+	{Tag: 0x0008030B, Name: "Private Data Element Number of Items", Keyword: "PrivateDataElementNumberOfItems", VR: "UL", VM: "1-2"},
+	{Tag: 0x0008030C, Name: "Private Data Element Name", Keyword: "PrivateDataElementName", VR: "UC", VM: "1"},
+	{Tag: 0x0008030D, Name: "Private Data Element Keyword", Keyword: "PrivateDataElementKeyword", VR: "UC", VM: "1"},
+	{Tag: 0x0008030E, Name: "Private Data Element Description", Keyword: "PrivateDataElementDescription", VR: "UT", VM: "1"},
+	{Tag: 0x0008030F, Name: "Private Data Element Encoding", Keyword: "PrivateDataElementEncoding", VR: "UT", VM: "1"},
+	{Tag: 0x00080310, Name: "Private Data Element Definition Sequence", Keyword: "PrivateDataElementDefinitionSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00080400, Name: "Scope of Inventory Sequence", Keyword: "ScopeOfInventorySequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00080401, Name: "Inventory Purpose", Keyword: "InventoryPurpose", VR: "LT", VM: "1"},
+	{Tag: 0x00080402, Name: "Inventory Instance Description", Keyword: "InventoryInstanceDescription", VR: "LT", VM: "1"},
+	{Tag: 0x00080403, Name: "Inventory Level", Keyword: "InventoryLevel", VR: "CS", VM: "1"},
+	{Tag: 0x00080404, Name: "Item Inventory DateTime", Keyword: "ItemInventoryDateTime", VR: "DT", VM: "1"},
+	{Tag: 0x00080405, Name: "Removed from Operational Use", Keyword: "RemovedFromOperationalUse", VR: "CS", VM: "1"},
+	{Tag: 0x00080406, Name: "Reason for Removal Code Sequence", Keyword: "ReasonForRemovalCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00080407, Name: "Stored Instance Base URI", Keyword: "StoredInstanceBaseURI", VR: "UR", VM: "1"},
+	{Tag: 0x00080408, Name: "Folder Access URI", Keyword: "FolderAccessURI", VR: "UR", VM: "1"},
+	{Tag: 0x00080409, Name: "File Access URI", Keyword: "FileAccessURI", VR: "UR", VM: "1"},
+	{Tag: 0x0008040A, Name: "Container File Type", Keyword: "ContainerFileType", VR: "CS", VM: "1"},
+	{Tag: 0x0008040B, Name: "Filename in Container", Keyword: "FilenameInContainer", VR: "UR", VM: "1"},
+	{Tag: 0x0008040C, Name: "File Offset in Container", Keyword: "FileOffsetInContainer", VR: "UV", VM: "1"},
+	{Tag: 0x0008040D, Name: "File Length in Container", Keyword: "FileLengthInContainer", VR: "UV", VM: "1"},
+	{Tag: 0x0008040E, Name: "Stored Instance Transfer Syntax UID", Keyword: "StoredInstanceTransferSyntaxUID", VR: "UI", VM: "1"},
+	{Tag: 0x0008040F, Name: "Extended Matching Mechanisms", Keyword: "ExtendedMatchingMechanisms", VR: "CS", VM: "1-n"},
+	{Tag: 0x00080410, Name: "Range Matching Sequence", Keyword: "RangeMatchingSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00080411, Name: "List of UID Matching Sequence", Keyword: "ListOfUIDMatchingSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00080412, Name: "Empty Value Matching Sequence", Keyword: "EmptyValueMatchingSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00080413, Name: "General Matching Sequence", Keyword: "GeneralMatchingSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00080414, Name: "Requested Status Interval", Keyword: "RequestedStatusInterval", VR: "US", VM: "1"},
+	{Tag: 0x00080415, Name: "Retain Instances", Keyword: "RetainInstances", VR: "CS", VM: "1"},
+	{Tag: 0x00080416, Name: "Expiration DateTime", Keyword: "ExpirationDateTime", VR: "DT", VM: "1"},
+	{Tag: 0x00080417, Name: "Transaction Status", Keyword: "TransactionStatus", VR: "CS", VM: "1"},
+	{Tag: 0x00080418, Name: "Transaction Status Comment", Keyword: "TransactionStatusComment", VR: "LT", VM: "1"},
+	{Tag: 0x00080419, Name: "File Set Access Sequence", Keyword: "FileSetAccessSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x0008041A, Name: "File Access Sequence", Keyword: "FileAccessSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x0008041B, Name: "Record Key", Keyword: "RecordKey", VR: "OB", VM: "1"},
+	{Tag: 0x0008041C, Name: "Prior Record Key", Keyword: "PriorRecordKey", VR: "OB", VM: "1"},
+	{Tag: 0x0008041D, Name: "Metadata Sequence", Keyword: "MetadataSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x0008041E, Name: "Updated Metadata Sequence", Keyword: "UpdatedMetadataSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x0008041F, Name: "Study Update DateTime", Keyword: "StudyUpdateDateTime", VR: "DT", VM: "1"},
+	{Tag: 0x00080420, Name: "Inventory Access End Points Sequence", Keyword: "InventoryAccessEndPointsSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00080421, Name: "Study Access End Points Sequence", Keyword: "StudyAccessEndPointsSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00080422, Name: "Incorporated Inventory Instance Sequence", Keyword: "IncorporatedInventoryInstanceSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00080423, Name: "Inventoried Studies Sequence", Keyword: "InventoriedStudiesSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00080424, Name: "Inventoried Series Sequence", Keyword: "InventoriedSeriesSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00080425, Name: "Inventoried Instances Sequence", Keyword: "InventoriedInstancesSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00080426, Name: "Inventory Completion Status", Keyword: "InventoryCompletionStatus", VR: "CS", VM: "1"},
+	{Tag: 0x00080427, Name: "Number of Study Records in Instance", Keyword: "NumberOfStudyRecordsInInstance", VR: "UL", VM: "1"},
+	{Tag: 0x00080428, Name: "Total Number of Study Records", Keyword: "TotalNumberOfStudyRecords", VR: "UV", VM: "1"},
+	{Tag: 0x00080429, Name: "Maximum Number of Records", Keyword: "MaximumNumberOfRecords", VR: "UV", VM: "1"},
+	{Tag: 0x00081000, Name: "Network ID", Keyword: "NetworkID", VR: "AE", VM: "1", Retired: true},
+	{Tag: 0x00081010, Name: "Station Name", Keyword: "StationName", VR: "SH", VM: "1"},
+	{Tag: 0x00081030, Name: "Study Description", Keyword: "StudyDescription", VR: "LO", VM: "1"},
+	{Tag: 0x00081032, Name: "Procedure Code Sequence", Keyword: "ProcedureCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x0008103E, Name: "Series Description", Keyword: "SeriesDescription", VR: "LO", VM: "1"},
+	{Tag: 0x0008103F, Name: "Series Description Code Sequence", Keyword: "SeriesDescriptionCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00081040, Name: "Institutional Department Name", Keyword: "InstitutionalDepartmentName", VR: "LO", VM: "1"},
+	{Tag: 0x00081041, Name: "Institutional Department Type Code Sequence", Keyword: "InstitutionalDepartmentTypeCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00081048, Name: "Physician(s) of Record", Keyword: "PhysiciansOfRecord", VR: "PN", VM: "1-n"},
+	{Tag: 0x00081049, Name: "Physician(s) of Record Identification Sequence", Keyword: "PhysiciansOfRecordIdentificationSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00081050, Name: "Performing Physician's Name", Keyword: "PerformingPhysicianName", VR: "PN", VM: "1-n"},
+	{Tag: 0x00081052, Name: "Performing Physician Identification Sequence", Keyword: "PerformingPhysicianIdentificationSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00081060, Name: "Name of Physician(s) Reading Study", Keyword: "NameOfPhysiciansReadingStudy", VR: "PN", VM: "1-n"},
+	{Tag: 0x00081062, Name: "Physician(s) Reading Study Identification Sequence", Keyword: "PhysiciansReadingStudyIdentificationSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00081070, Name: "Operators' Name", Keyword: "OperatorsName", VR: "PN", VM: "1-n"},
+	{Tag: 0x00081072, Name: "Operator Identification Sequence", Keyword: "OperatorIdentificationSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00081080, Name: "Admitting Diagnoses Description", Keyword: "AdmittingDiagnosesDescription", VR: "LO", VM: "1-n"},
+	{Tag: 0x00081084, Name: "Admitting Diagnoses Code Sequence", Keyword: "AdmittingDiagnosesCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00081088, Name: "Pyramid Description", Keyword: "PyramidDescription", VR: "LO", VM: "1"},
+	{Tag: 0x00081090, Name: "Manufacturer's Model Name", Keyword: "ManufacturerModelName", VR: "LO", VM: "1"},
+	{Tag: 0x00081100, Name: "Referenced Results Sequence", Keyword: "ReferencedResultsSequence", VR: "SQ", VM: "1", Retired: true},
+	{Tag: 0x00081110, Name: "Referenced Study Sequence", Keyword: "ReferencedStudySequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00081111, Name: "Referenced Performed Procedure Step Sequence", Keyword: "ReferencedPerformedProcedureStepSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00081112, Name: "Referenced Instances by SOP Class Sequence", Keyword: "ReferencedInstancesBySOPClassSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00081115, Name: "Referenced Series Sequence", Keyword: "ReferencedSeriesSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00081120, Name: "Referenced Patient Sequence", Keyword: "ReferencedPatientSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00081125, Name: "Referenced Visit Sequence", Keyword: "ReferencedVisitSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00081130, Name: "Referenced Overlay Sequence", Keyword: "ReferencedOverlaySequence", VR: "SQ", VM: "1", Retired: true},
+	{Tag: 0x00081134, Name: "Referenced Stereometric Instance Sequence", Keyword: "ReferencedStereometricInstanceSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x0008113A, Name: "Referenced Waveform Sequence", Keyword: "ReferencedWaveformSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00081140, Name: "Referenced Image Sequence", Keyword: "ReferencedImageSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00081145, Name: "Referenced Curve Sequence", Keyword: "ReferencedCurveSequence", VR: "SQ", VM: "1", Retired: true},
+	{Tag: 0x0008114A, Name: "Referenced Instance Sequence", Keyword: "ReferencedInstanceSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x0008114B, Name: "Referenced Real World Value Mapping Instance Sequence", Keyword: "ReferencedRealWorldValueMappingInstanceSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00081150, Name: "Referenced SOP Class UID", Keyword: "ReferencedSOPClassUID", VR: "UI", VM: "1"},
+	{Tag: 0x00081155, Name: "Referenced SOP Instance UID", Keyword: "ReferencedSOPInstanceUID", VR: "UI", VM: "1"},
+	{Tag: 0x00081156, Name: "Definition Source Sequence", Keyword: "DefinitionSourceSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x0008115A, Name: "SOP Classes Supported", Keyword: "SOPClassesSupported", VR: "UI", VM: "1-n"},
+	{Tag: 0x00081160, Name: "Referenced Frame Number", Keyword: "ReferencedFrameNumber", VR: "IS", VM: "1-n"},
+	{Tag: 0x00081161, Name: "Simple Frame List", Keyword: "SimpleFrameList", VR: "UL", VM: "1-n"},
+	{Tag: 0x00081162, Name: "Calculated Frame List", Keyword: "CalculatedFrameList", VR: "UL", VM: "3-3n"},
+	{Tag: 0x00081163, Name: "Time Range", Keyword: "TimeRange", VR: "FD", VM: "2"},
+	{Tag: 0x00081164, Name: "Frame Extraction Sequence", Keyword: "FrameExtractionSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00081167, Name: "Multi-frame Source SOP Instance UID", Keyword: "MultiFrameSourceSOPInstanceUID", VR: "UI", VM: "1"},
+	{Tag: 0x00081190, Name: "Retrieve URL", Keyword: "RetrieveURL", VR: "UR", VM: "1"},
+	{Tag: 0x00081195, Name: "Transaction UID", Keyword: "TransactionUID", VR: "UI", VM: "1"},
+	{Tag: 0x00081196, Name: "Warning Reason", Keyword: "WarningReason", VR: "US", VM: "1"},
+	{Tag: 0x00081197, Name: "Failure Reason", Keyword: "FailureReason", VR: "US", VM: "1"},
+	{Tag: 0x00081198, Name: "Failed SOP Sequence", Keyword: "FailedSOPSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00081199, Name: "Referenced SOP Sequence", Keyword: "ReferencedSOPSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x0008119A, Name: "Other Failures Sequence", Keyword: "OtherFailuresSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x0008119B, Name: "Failed Study Sequence", Keyword: "FailedStudySequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00081200, Name: "Studies Containing Other Referenced Instances Sequence", Keyword: "StudiesContainingOtherReferencedInstancesSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00081250, Name: "Related Series Sequence", Keyword: "RelatedSeriesSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00082110, Name: "Lossy Image Compression (Retired)", Keyword: "LossyImageCompressionRetired", VR: "CS", VM: "1", Retired: true},
+	{Tag: 0x00082111, Name: "Derivation Description", Keyword: "DerivationDescription", VR: "ST", VM: "1"},
+	{Tag: 0x00082112, Name: "Source Image Sequence", Keyword: "SourceImageSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00082120, Name: "Stage Name", Keyword: "StageName", VR: "SH", VM: "1"},
+	{Tag: 0x00082122, Name: "Stage Number", Keyword: "StageNumber", VR: "IS", VM: "1"},
+	{Tag: 0x00082124, Name: "Number of Stages", Keyword: "NumberOfStages", VR: "IS", VM: "1"},
+	{Tag: 0x00082127, Name: "View Name", Keyword: "ViewName", VR: "SH", VM: "1"},
+	{Tag: 0x00082128, Name: "View Number", Keyword: "ViewNumber", VR: "IS", VM: "1"},
+	{Tag: 0x00082129, Name: "Number of Event Timers", Keyword: "NumberOfEventTimers", VR: "IS", VM: "1"},
+	{Tag: 0x0008212A, Name: "Number of Views in Stage", Keyword: "NumberOfViewsInStage", VR: "IS", VM: "1"},
+	{Tag: 0x00082130, Name: "Event Elapsed Time(s)", Keyword: "EventElapsedTimes", VR: "DS", VM: "1-n"},
+	{Tag: 0x00082132, Name: "Event Timer Name(s)", Keyword: "EventTimerNames", VR: "LO", VM: "1-n"},
+	{Tag: 0x00082133, Name: "Event Timer Sequence", Keyword: "EventTimerSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00082134, Name: "Event Time Offset", Keyword: "EventTimeOffset", VR: "FD", VM: "1"},
+	{Tag: 0x00082135, Name: "Event Code Sequence", Keyword: "EventCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00082142, Name: "Start Trim", Keyword: "StartTrim", VR: "IS", VM: "1"},
+	{Tag: 0x00082143, Name: "Stop Trim", Keyword: "StopTrim", VR: "IS", VM: "1"},
+	{Tag: 0x00082144, Name: "Recommended Display Frame Rate", Keyword: "RecommendedDisplayFrameRate", VR: "IS", VM: "1"},
+	{Tag: 0x00082200, Name: "Transducer Position", Keyword: "TransducerPosition", VR: "CS", VM: "1", Retired: true},
+	{Tag: 0x00082204, Name: "Transducer Orientation", Keyword: "TransducerOrientation", VR: "CS", VM: "1", Retired: true},
+	{Tag: 0x00082208, Name: "Anatomic Structure", Keyword: "AnatomicStructure", VR: "CS", VM: "1", Retired: true},
+	{Tag: 0x00082218, Name: "Anatomic Region Sequence", Keyword: "AnatomicRegionSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00082220, Name: "Anatomic Region Modifier Sequence", Keyword: "AnatomicRegionModifierSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00082228, Name: "Primary Anatomic Structure Sequence", Keyword: "PrimaryAnatomicStructureSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00082229, Name: "Anatomic Structure, Space or Region Sequence", Keyword: "AnatomicStructureSpaceOrRegionSequence", VR: "SQ", VM: "1", Retired: true},
+	{Tag: 0x00082230, Name: "Primary Anatomic Structure Modifier Sequence", Keyword: "PrimaryAnatomicStructureModifierSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00082240, Name: "Transducer Position Sequence", Keyword: "TransducerPositionSequence", VR: "SQ", VM: "1", Retired: true},
+	{Tag: 0x00082242, Name: "Transducer Position Modifier Sequence", Keyword: "TransducerPositionModifierSequence", VR: "SQ", VM: "1", Retired: true},
+	{Tag: 0x00082244, Name: "Transducer Orientation Sequence", Keyword: "TransducerOrientationSequence", VR: "SQ", VM: "1", Retired: true},
+	{Tag: 0x00082246, Name: "Transducer Orientation Modifier Sequence", Keyword: "TransducerOrientationModifierSequence", VR: "SQ", VM: "1", Retired: true},
+	{Tag: 0x00082251, Name: "Anatomic Structure Space Or Region Code Sequence (Trial)", Keyword: "AnatomicStructureSpaceOrRegionCodeSequenceTrial", VR: "SQ", VM: "1", Retired: true},
+	{Tag: 0x00082253, Name: "Anatomic Portal Of Entrance Code Sequence (Trial)", Keyword: "AnatomicPortalOfEntranceCodeSequenceTrial", VR: "SQ", VM: "1", Retired: true},
+	{Tag: 0x00082255, Name: "Anatomic Approach Direction Code Sequence (Trial)", Keyword: "AnatomicApproachDirectionCodeSequenceTrial", VR: "SQ", VM: "1", Retired: true},
+	{Tag: 0x00082256, Name: "Anatomic Perspective Description (Trial)", Keyword: "AnatomicPerspectiveDescriptionTrial", VR: "ST", VM: "1", Retired: true},
+	{Tag: 0x00082257, Name: "Anatomic Perspective Code Sequence (Trial)", Keyword: "AnatomicPerspectiveCodeSequenceTrial", VR: "SQ", VM: "1", Retired: true},
+	{Tag: 0x00082258, Name: "Anatomic Location Of Examining Instrument Description (Trial)", Keyword: "AnatomicLocationOfExaminingInstrumentDescriptionTrial", VR: "ST", VM: "1", Retired: true},
+	{Tag: 0x00082259, Name: "Anatomic Location Of Examining Instrument Code Sequence (Trial)", Keyword: "AnatomicLocationOfExaminingInstrumentCodeSequenceTrial", VR: "SQ", VM: "1", Retired: true},
+	{Tag: 0x0008225A, Name: "Anatomic Structure Space Or Region Modifier Code Sequence (Trial)", Keyword: "AnatomicStructureSpaceOrRegionModifierCodeSequenceTrial", VR: "SQ", VM: "1", Retired: true},
+	{Tag: 0x0008225C, Name: "On Axis Background Anatomic Structure Code Sequence (Trial)", Keyword: "OnAxisBackgroundAnatomicStructureCodeSequenceTrial", VR: "SQ", VM: "1", Retired: true},
+	{Tag: 0x00083001, Name: "Alternate Representation Sequence", Keyword: "AlternateRepresentationSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00083002, Name: "Available Transfer Syntax UID", Keyword: "AvailableTransferSyntaxUID", VR: "UI", VM: "1-n"},
+	{Tag: 0x00083010, Name: "Irradiation Event UID", Keyword: "IrradiationEventUID", VR: "UI", VM: "1-n"},
+	{Tag: 0x00083011, Name: "Source Irradiation Event Sequence", Keyword: "SourceIrradiationEventSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00083012, Name: "Radiopharmaceutical Administration Event UID", Keyword: "RadiopharmaceuticalAdministrationEventUID", VR: "UI", VM: "1"},
+	{Tag: 0x00084000, Name: "Identifying Comments", Keyword: "IdentifyingComments", VR: "LT", VM: "1", Retired: true},
+	{Tag: 0x00089007, Name: "Frame Type", Keyword: "FrameType", VR: "CS", VM: "4-5"},
+	{Tag: 0x00089092, Name: "Referenced Image Evidence Sequence", Keyword: "ReferencedImageEvidenceSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00089121, Name: "Referenced Raw Data Sequence", Keyword: "ReferencedRawDataSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00089123, Name: "Creator-Version UID", Keyword: "CreatorVersionUID", VR: "UI", VM: "1"},
+	{Tag: 0x00089124, Name: "Derivation Image Sequence", Keyword: "DerivationImageSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00089154, Name: "Source Image Evidence Sequence", Keyword: "SourceImageEvidenceSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00089205, Name: "Pixel Presentation", Keyword: "PixelPresentation", VR: "CS", VM: "1"},
+	{Tag: 0x00089206, Name: "Volumetric Properties", Keyword: "VolumetricProperties", VR: "CS", VM: "1"},
+	{Tag: 0x00089207, Name: "Volume Based Calculation Technique", Keyword: "VolumeBasedCalculationTechnique", VR: "CS", VM: "1"},
+	{Tag: 0x00089208, Name: "Complex Image Component", Keyword: "ComplexImageComponent", VR: "CS", VM: "1"},
+	{Tag: 0x00089209, Name: "Acquisition Contrast", Keyword: "AcquisitionContrast", VR: "CS", VM: "1"},
+	{Tag: 0x00089215, Name: "Derivation Code Sequence", Keyword: "DerivationCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00089237, Name: "Referenced Presentation State Sequence", Keyword: "ReferencedPresentationStateSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00089410, Name: "Referenced Other Plane Sequence", Keyword: "ReferencedOtherPlaneSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00089458, Name: "Frame Display Sequence", Keyword: "FrameDisplaySequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00089459, Name: "Recommended Display Frame Rate in Float", Keyword: "RecommendedDisplayFrameRateInFloat", VR: "FL", VM: "1"},
+	{Tag: 0x00089460, Name: "Skip Frame Range Flag", Keyword: "SkipFrameRangeFlag", VR: "CS", VM: "1"},
+	{Tag: 0x00100010, Name: "Patient's Name", Keyword: "PatientName", VR: "PN", VM: "1"},
+	{Tag: 0x00100020, Name: "Patient ID", Keyword: "PatientID", VR: "LO", VM: "1"},
+	{Tag: 0x00100021, Name: "Issuer of Patient ID", Keyword: "IssuerOfPatientID", VR: "LO", VM: "1"},
+	{Tag: 0x00100022, Name: "Type of Patient ID", Keyword: "TypeOfPatientID", VR: "CS", VM: "1"},
+	{Tag: 0x00100024, Name: "Issuer of Patient ID Qualifiers Sequence", Keyword: "IssuerOfPatientIDQualifiersSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00100026, Name: "Source Patient Group Identification Sequence", Keyword: "SourcePatientGroupIdentificationSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00100027, Name: "Group of Patients Identification Sequence", Keyword: "GroupOfPatientsIdentificationSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00100028, Name: "Subject Relative Position in Image", Keyword: "SubjectRelativePositionInImage", VR: "US", VM: "3"},
+	{Tag: 0x00100030, Name: "Patient's Birth Date", Keyword: "PatientBirthDate", VR: "DA", VM: "1"},
+	{Tag: 0x00100032, Name: "Patient's Birth Time", Keyword: "PatientBirthTime", VR: "TM", VM: "1"},
+	{Tag: 0x00100033, Name: "Patient's Birth Date in Alternative Calendar", Keyword: "PatientBirthDateInAlternativeCalendar", VR: "LO", VM: "1"},
+	{Tag: 0x00100034, Name: "Patient's Death Date in Alternative Calendar", Keyword: "PatientDeathDateInAlternativeCalendar", VR: "LO", VM: "1"},
+	{Tag: 0x00100035, Name: "Patient's Alternative Calendar", Keyword: "PatientAlternativeCalendar", VR: "CS", VM: "1"},
+	{Tag: 0x00100040, Name: "Patient's Sex", Keyword: "PatientSex", VR: "CS", VM: "1"},
+	{Tag: 0x00100050, Name: "Patient's Insurance Plan Code Sequence", Keyword: "PatientInsurancePlanCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00100101, Name: "Patient's Primary Language Code Sequence", Keyword: "PatientPrimaryLanguageCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00100102, Name: "Patient's Primary Language Modifier Code Sequence", Keyword: "PatientPrimaryLanguageModifierCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00100200, Name: "Quality Control Subject", Keyword: "QualityControlSubject", VR: "CS", VM: "1"},
+	{Tag: 0x00100201, Name: "Quality Control Subject Type Code Sequence", Keyword: "QualityControlSubjectTypeCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00100212, Name: "Strain Description", Keyword: "StrainDescription", VR: "UC", VM: "1"},
+	{Tag: 0x00100213, Name: "Strain Nomenclature", Keyword: "StrainNomenclature", VR: "LO", VM: "1"},
+	{Tag: 0x00100214, Name: "Strain Stock Number", Keyword: "StrainStockNumber", VR: "LO", VM: "1"},
+	{Tag: 0x00100215, Name: "Strain Source Registry Code Sequence", Keyword: "StrainSourceRegistryCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00100216, Name: "Strain Stock Sequence", Keyword: "StrainStockSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00100217, Name: "Strain Source", Keyword: "StrainSource", VR: "LO", VM: "1"},
+	{Tag: 0x00100218, Name: "Strain Additional Information", Keyword: "StrainAdditionalInformation", VR: "UT", VM: "1"},
+	{Tag: 0x00100219, Name: "Strain Code Sequence", Keyword: "StrainCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00100221, Name: "Genetic Modifications Sequence", Keyword: "GeneticModificationsSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00100222, Name: "Genetic Modifications Description", Keyword: "GeneticModificationsDescription", VR: "UC", VM: "1"},
+	{Tag: 0x00100223, Name: "Genetic Modifications Nomenclature", Keyword: "GeneticModificationsNomenclature", VR: "LO", VM: "1"},
+	{Tag: 0x00100229, Name: "Genetic Modifications Code Sequence", Keyword: "GeneticModificationsCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00101000, Name: "Other Patient IDs", Keyword: "OtherPatientIDs", VR: "LO", VM: "1-n", Retired: true},
+	{Tag: 0x00101001, Name: "Other Patient Names", Keyword: "OtherPatientNames", VR: "PN", VM: "1-n"},
+	{Tag: 0x00101002, Name: "Other Patient IDs Sequence", Keyword: "OtherPatientIDsSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00101005, Name: "Patient's Birth Name", Keyword: "PatientBirthName", VR: "PN", VM: "1"},
+	{Tag: 0x00101010, Name: "Patient's Age", Keyword: "PatientAge", VR: "AS", VM: "1"},
+	{Tag: 0x00101020, Name: "Patient's Size", Keyword: "PatientSize", VR: "DS", VM: "1"},
+	{Tag: 0x00101021, Name: "Patient's Size Code Sequence", Keyword: "PatientSizeCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00101022, Name: "Patient's Body Mass Index", Keyword: "PatientBodyMassIndex", VR: "DS", VM: "1"},
+	{Tag: 0x00101023, Name: "Measured AP Dimension", Keyword: "MeasuredAPDimension", VR: "DS", VM: "1"},
+	{Tag: 0x00101024, Name: "Measured Lateral Dimension", Keyword: "MeasuredLateralDimension", VR: "DS", VM: "1"},
+	{Tag: 0x00101030, Name: "Patient's Weight", Keyword: "PatientWeight", VR: "DS", VM: "1"},
+	{Tag: 0x00101040, Name: "Patient's Address", Keyword: "PatientAddress", VR: "LO", VM: "1"},
+	{Tag: 0x00101050, Name: "Insurance Plan Identification", Keyword: "InsurancePlanIdentification", VR: "LO", VM: "1-n", Retired: true},
+	{Tag: 0x00101060, Name: "Patient's Mother's Birth Name", Keyword: "PatientMotherBirthName", VR: "PN", VM: "1"},
+	{Tag: 0x00101080, Name: "Military Rank", Keyword: "MilitaryRank", VR: "LO", VM: "1"},
+	{Tag: 0x00101081, Name: "Branch of Service", Keyword: "BranchOfService", VR: "LO", VM: "1"},
+	{Tag: 0x00101090, Name: "Medical Record Locator", Keyword: "MedicalRecordLocator", VR: "LO", VM: "1", Retired: true},
+	{Tag: 0x00101100, Name: "Referenced Patient Photo Sequence", Keyword: "ReferencedPatientPhotoSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00102000, Name: "Medical Alerts", Keyword: "MedicalAlerts", VR: "LO", VM: "1-n"},
+	{Tag: 0x00102110, Name: "Allergies", Keyword: "Allergies", VR: "LO", VM: "1-n"},
+	{Tag: 0x00102150, Name: "Country of Residence", Keyword: "CountryOfResidence", VR: "LO", VM: "1"},
+	{Tag: 0x00102152, Name: "Region of Residence", Keyword: "RegionOfResidence", VR: "LO", VM: "1"},
+	{Tag: 0x00102154, Name: "Patient's Telephone Numbers", Keyword: "PatientTelephoneNumbers", VR: "SH", VM: "1-n"},
+	{Tag: 0x00102155, Name: "Patient's Telecom Information", Keyword: "PatientTelecomInformation", VR: "LT", VM: "1"},
+	{Tag: 0x00102160, Name: "Ethnic Group", Keyword: "EthnicGroup", VR: "SH", VM: "1"},
+	{Tag: 0x00102180, Name: "Occupation", Keyword: "Occupation", VR: "SH", VM: "1"},
+	{Tag: 0x001021A0, Name: "Smoking Status", Keyword: "SmokingStatus", VR: "CS", VM: "1"},
+	{Tag: 0x001021B0, Name: "Additional Patient History", Keyword: "AdditionalPatientHistory", VR: "LT", VM: "1"},
+	{Tag: 0x001021C0, Name: "Pregnancy Status", Keyword: "PregnancyStatus", VR: "US", VM: "1"},
+	{Tag: 0x001021D0, Name: "Last Menstrual Date", Keyword: "LastMenstrualDate", VR: "DA", VM: "1"},
+	{Tag: 0x001021F0, Name: "Patient's Religious Preference", Keyword: "PatientReligiousPreference", VR: "LO", VM: "1"},
+	{Tag: 0x00102201, Name: "Patient Species Description", Keyword: "PatientSpeciesDescription", VR: "LO", VM: "1"},
+	{Tag: 0x00102202, Name: "Patient Species Code Sequence", Keyword: "PatientSpeciesCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00102203, Name: "Patient's Sex Neutered", Keyword: "PatientSexNeutered", VR: "CS", VM: "1"},
+	{Tag: 0x00102210, Name: "Anatomical Orientation Type", Keyword: "AnatomicalOrientationType", VR: "CS", VM: "1"},
+	{Tag: 0x00102292, Name: "Patient Breed Description", Keyword: "PatientBreedDescription", VR: "LO", VM: "1"},
+	{Tag: 0x00102293, Name: "Patient Breed Code Sequence", Keyword: "PatientBreedCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00102294, Name: "Breed Registration Sequence", Keyword: "BreedRegistrationSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00102295, Name: "Breed Registration Number", Keyword: "BreedRegistrationNumber", VR: "LO", VM: "1"},
+	{Tag: 0x00102296, Name: "Breed Registry Code Sequence", Keyword: "BreedRegistryCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00102297, Name: "Responsible Person", Keyword: "ResponsiblePerson", VR: "PN", VM: "1"},
+	{Tag: 0x00102298, Name: "Responsible Person Role", Keyword: "ResponsiblePersonRole", VR: "CS", VM: "1"},
+	{Tag: 0x00102299, Name: "Responsible Organization", Keyword: "ResponsibleOrganization", VR: "LO", VM: "1"},
+	{Tag: 0x00104000, Name: "Patient Comments", Keyword: "PatientComments", VR: "LT", VM: "1"},
+	{Tag: 0x00109431, Name: "Examined Body Thickness", Keyword: "ExaminedBodyThickness", VR: "FL", VM: "1"},
+	{Tag: 0x00120010, Name: "Clinical Trial Sponsor Name", Keyword: "ClinicalTrialSponsorName", VR: "LO", VM: "1"},
+	{Tag: 0x00120020, Name: "Clinical Trial Protocol ID", Keyword: "ClinicalTrialProtocolID", VR: "LO", VM: "1"},
+	{Tag: 0x00120021, Name: "Clinical Trial Protocol Name", Keyword: "ClinicalTrialProtocolName", VR: "LO", VM: "1"},
+	{Tag: 0x00120022, Name: "Issuer of Clinical Trial Protocol ID", Keyword: "IssuerOfClinicalTrialProtocolID", VR: "LO", VM: "1"},
+	{Tag: 0x00120023, Name: "Other Clinical Trial Protocol IDs Sequence", Keyword: "OtherClinicalTrialProtocolIDsSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00120030, Name: "Clinical Trial Site ID", Keyword: "ClinicalTrialSiteID", VR: "LO", VM: "1"},
+	{Tag: 0x00120031, Name: "Clinical Trial Site Name", Keyword: "ClinicalTrialSiteName", VR: "LO", VM: "1"},
+	{Tag: 0x00120032, Name: "Issuer of Clinical Trial Site ID", Keyword: "IssuerOfClinicalTrialSiteID", VR: "LO", VM: "1"},
+	{Tag: 0x00120040, Name: "Clinical Trial Subject ID", Keyword: "ClinicalTrialSubjectID", VR: "LO", VM: "1"},
+	{Tag: 0x00120041, Name: "Issuer of Clinical Trial Subject ID", Keyword: "IssuerOfClinicalTrialSubjectID", VR: "LO", VM: "1"},
+	{Tag: 0x00120042, Name: "Clinical Trial Subject Reading ID", Keyword: "ClinicalTrialSubjectReadingID", VR: "LO", VM: "1"},
+	{Tag: 0x00120043, Name: "Issuer of Clinical Trial Subject Reading ID", Keyword: "IssuerOfClinicalTrialSubjectReadingID", VR: "LO", VM: "1"},
+	{Tag: 0x00120050, Name: "Clinical Trial Time Point ID", Keyword: "ClinicalTrialTimePointID", VR: "LO", VM: "1"},
+	{Tag: 0x00120051, Name: "Clinical Trial Time Point Description", Keyword: "ClinicalTrialTimePointDescription", VR: "ST", VM: "1"},
+	{Tag: 0x00120052, Name: "Longitudinal Temporal Offset from Event", Keyword: "LongitudinalTemporalOffsetFromEvent", VR: "FD", VM: "1"},
+	{Tag: 0x00120053, Name: "Longitudinal Temporal Event Type", Keyword: "LongitudinalTemporalEventType", VR: "CS", VM: "1"},
+	{Tag: 0x00120054, Name: "Clinical Trial Time Point Type Code Sequence", Keyword: "ClinicalTrialTimePointTypeCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00120055, Name: "Issuer of Clinical Trial Time Point ID", Keyword: "IssuerOfClinicalTrialTimePointID", VR: "LO", VM: "1"},
+	{Tag: 0x00120060, Name: "Clinical Trial Coordinating Center Name", Keyword: "ClinicalTrialCoordinatingCenterName", VR: "LO", VM: "1"},
+	{Tag: 0x00120062, Name: "Patient Identity Removed", Keyword: "PatientIdentityRemoved", VR: "CS", VM: "1"},
+	{Tag: 0x00120063, Name: "De-identification Method", Keyword: "DeidentificationMethod", VR: "LO", VM: "1-n"},
+	{Tag: 0x00120064, Name: "De-identification Method Code Sequence", Keyword: "DeidentificationMethodCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00120071, Name: "Clinical Trial Series ID", Keyword: "ClinicalTrialSeriesID", VR: "LO", VM: "1"},
+	{Tag: 0x00120072, Name: "Clinical Trial Series Description", Keyword: "ClinicalTrialSeriesDescription", VR: "LO", VM: "1"},
+	{Tag: 0x00120073, Name: "Issuer of Clinical Trial Series ID", Keyword: "IssuerOfClinicalTrialSeriesID", VR: "LO", VM: "1"},
+	{Tag: 0x00120081, Name: "Clinical Trial Protocol Ethics Committee Name", Keyword: "ClinicalTrialProtocolEthicsCommitteeName", VR: "LO", VM: "1"},
+	{Tag: 0x00120082, Name: "Clinical Trial Protocol Ethics Committee Approval Number", Keyword: "ClinicalTrialProtocolEthicsCommitteeApprovalNumber", VR: "LO", VM: "1"},
+	{Tag: 0x00120083, Name: "Consent for Clinical Trial Use Sequence", Keyword: "ConsentForClinicalTrialUseSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00120084, Name: "Distribution Type", Keyword: "DistributionType", VR: "CS", VM: "1"},
+	{Tag: 0x00120085, Name: "Consent for Distribution Flag", Keyword: "ConsentForDistributionFlag", VR: "CS", VM: "1"},
+	{Tag: 0x00120086, Name: "Ethics Committee Approval Effectiveness Start Date", Keyword: "EthicsCommitteeApprovalEffectivenessStartDate", VR: "DA", VM: "1"},
+	{Tag: 0x00120087, Name: "Ethics Committee Approval Effectiveness End Date", Keyword: "EthicsCommitteeApprovalEffectivenessEndDate", VR: "DA", VM: "1"},
+	{Tag: 0x00140023, Name: "CAD File Format", Keyword: "CADFileFormat", VR: "ST", VM: "1", Retired: true},
+	{Tag: 0x00140024, Name: "Component Reference System", Keyword: "ComponentReferenceSystem", VR: "ST", VM: "1", Retired: true},
+	{Tag: 0x00140025, Name: "Component Manufacturing Procedure", Keyword: "ComponentManufacturingProcedure", VR: "ST", VM: "1"},
+	{Tag: 0x00140028, Name: "Component Manufacturer", Keyword: "ComponentManufacturer", VR: "ST", VM: "1"},
+	{Tag: 0x00140030, Name: "Material Thickness", Keyword: "MaterialThickness", VR: "DS", VM: "1-n"},
+	{Tag: 0x00140032, Name: "Material Pipe Diameter", Keyword: "MaterialPipeDiameter", VR: "DS", VM: "1-n"},
+	{Tag: 0x00140034, Name: "Material Isolation Diameter", Keyword: "MaterialIsolationDiameter", VR: "DS", VM: "1-n"},
+	{Tag: 0x00140042, Name: "Material Grade", Keyword: "MaterialGrade", VR: "ST", VM: "1"},
+	{Tag: 0x00140044, Name: "Material Properties Description", Keyword: "MaterialPropertiesDescription", VR: "ST", VM: "1"},
+	{Tag: 0x00140045, Name: "Material Properties File Format (Retired)", Keyword: "MaterialPropertiesFileFormatRetired", VR: "ST", VM: "1", Retired: true},
+	{Tag: 0x00140046, Name: "Material Notes", Keyword: "MaterialNotes", VR: "LT", VM: "1"},
+	{Tag: 0x00140050, Name: "Component Shape", Keyword: "ComponentShape", VR: "CS", VM: "1"},
+	{Tag: 0x00140052, Name: "Curvature Type", Keyword: "CurvatureType", VR: "CS", VM: "1"},
+	{Tag: 0x00140054, Name: "Outer Diameter", Keyword: "OuterDiameter", VR: "DS", VM: "1"},
+	{Tag: 0x00140056, Name: "Inner Diameter", Keyword: "InnerDiameter", VR: "DS", VM: "1"},
+	{Tag: 0x00140100, Name: "Component Welder IDs", Keyword: "ComponentWelderIDs", VR: "LO", VM: "1-n"},
+	{Tag: 0x00140101, Name: "Secondary Approval Status", Keyword: "SecondaryApprovalStatus", VR: "CS", VM: "1"},
+	{Tag: 0x00140102, Name: "Secondary Review Date", Keyword: "SecondaryReviewDate", VR: "DA", VM: "1"},
+	{Tag: 0x00140103, Name: "Secondary Review Time", Keyword: "SecondaryReviewTime", VR: "TM", VM: "1"},
+	{Tag: 0x00140104, Name: "Secondary Reviewer Name", Keyword: "SecondaryReviewerName", VR: "PN", VM: "1"},
+	{Tag: 0x00140105, Name: "Repair ID", Keyword: "RepairID", VR: "ST", VM: "1"},
+	{Tag: 0x00140106, Name: "Multiple Component Approval Sequence", Keyword: "MultipleComponentApprovalSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00140107, Name: "Other Approval Status", Keyword: "OtherApprovalStatus", VR: "CS", VM: "1-n"},
+	{Tag: 0x00140108, Name: "Other Secondary Approval Status", Keyword: "OtherSecondaryApprovalStatus", VR: "CS", VM: "1-n"},
+	{Tag: 0x00140200, Name: "Data Element Label Sequence", Keyword: "DataElementLabelSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00140201, Name: "Data Element Label Item Sequence", Keyword: "DataElementLabelItemSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00140202, Name: "Data Element", Keyword: "DataElement", VR: "AT", VM: "1"},
+	{Tag: 0x00140203, Name: "Data Element Name", Keyword: "DataElementName", VR: "LO", VM: "1"},
+	{Tag: 0x00140204, Name: "Data Element Description", Keyword: "DataElementDescription", VR: "LO", VM: "1"},
+	{Tag: 0x00140205, Name: "Data Element Conditionality", Keyword: "DataElementConditionality", VR: "CS", VM: "1"},
+	{Tag: 0x00140206, Name: "Data Element Minimum Characters", Keyword: "DataElementMinimumCharacters", VR: "IS", VM: "1"},
+	{Tag: 0x00140207, Name: "Data Element Maximum Characters", Keyword: "DataElementMaximumCharacters", VR: "IS", VM: "1"},
+	{Tag: 0x00141010, Name: "Actual Environmental Conditions", Keyword: "ActualEnvironmentalConditions", VR: "ST", VM: "1"},
+	{Tag: 0x00141020, Name: "Expiry Date", Keyword: "ExpiryDate", VR: "DA", VM: "1"},
+	{Tag: 0x00141040, Name: "Environmental Conditions", Keyword: "EnvironmentalConditions", VR: "ST", VM: "1"},
+	{Tag: 0x00142002, Name: "Evaluator Sequence", Keyword: "EvaluatorSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00142004, Name: "Evaluator Number", Keyword: "EvaluatorNumber", VR: "IS", VM: "1"},
+	{Tag: 0x00142006, Name: "Evaluator Name", Keyword: "EvaluatorName", VR: "PN", VM: "1"},
+	{Tag: 0x00142008, Name: "Evaluation Attempt", Keyword: "EvaluationAttempt", VR: "IS", VM: "1"},
+	{Tag: 0x00142012, Name: "Indication Sequence", Keyword: "IndicationSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00142014, Name: "Indication Number", Keyword: "IndicationNumber", VR: "IS", VM: "1"},
+	{Tag: 0x00142016, Name: "Indication Label", Keyword: "IndicationLabel", VR: "SH", VM: "1"},
+	{Tag: 0x00142018, Name: "Indication Description", Keyword: "IndicationDescription", VR: "ST", VM: "1"},
+	{Tag: 0x0014201A, Name: "Indication Type", Keyword: "IndicationType", VR: "CS", VM: "1-n"},
+	{Tag: 0x0014201C, Name: "Indication Disposition", Keyword: "IndicationDisposition", VR: "CS", VM: "1"},
+	{Tag: 0x0014201E, Name: "Indication ROI Sequence", Keyword: "IndicationROISequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00142030, Name: "Indication Physical Property Sequence", Keyword: "IndicationPhysicalPropertySequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00142032, Name: "Property Label", Keyword: "PropertyLabel", VR: "SH", VM: "1"},
+	{Tag: 0x00142202, Name: "Coordinate System Number of Axes", Keyword: "CoordinateSystemNumberOfAxes", VR: "IS", VM: "1"},
+	{Tag: 0x00142204, Name: "Coordinate System Axes Sequence", Keyword: "CoordinateSystemAxesSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00142206, Name: "Coordinate System Axis Description", Keyword: "CoordinateSystemAxisDescription", VR: "ST", VM: "1"},
+	{Tag: 0x00142208, Name: "Coordinate System Data Set Mapping", Keyword: "CoordinateSystemDataSetMapping", VR: "CS", VM: "1"},
+	{Tag: 0x0014220A, Name: "Coordinate System Axis Number", Keyword: "CoordinateSystemAxisNumber", VR: "IS", VM: "1"},
+	{Tag: 0x0014220C, Name: "Coordinate System Axis Type", Keyword: "CoordinateSystemAxisType", VR: "CS", VM: "1"},
+	{Tag: 0x0014220E, Name: "Coordinate System Axis Units", Keyword: "CoordinateSystemAxisUnits", VR: "CS", VM: "1"},
+	{Tag: 0x00142210, Name: "Coordinate System Axis Values", Keyword: "CoordinateSystemAxisValues", VR: "OB", VM: "1"},
+	{Tag: 0x00142220, Name: "Coordinate System Transform Sequence", Keyword: "CoordinateSystemTransformSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00142222, Name: "Transform Description", Keyword: "TransformDescription", VR: "ST", VM: "1"},
+	{Tag: 0x00142224, Name: "Transform Number of Axes", Keyword: "TransformNumberOfAxes", VR: "IS", VM: "1"},
+	{Tag: 0x00142226, Name: "Transform Order of Axes", Keyword: "TransformOrderOfAxes", VR: "IS", VM: "1-n"},
+	{Tag: 0x00142228, Name: "Transformed Axis Units", Keyword: "TransformedAxisUnits", VR: "CS", VM: "1"},
+	{Tag: 0x0014222A, Name: "Coordinate System Transform Rotation and Scale Matrix", Keyword: "CoordinateSystemTransformRotationAndScaleMatrix", VR: "DS", VM: "1-n"},
+	{Tag: 0x0014222C, Name: "Coordinate System Transform Translation Matrix", Keyword: "CoordinateSystemTransformTranslationMatrix", VR: "DS", VM: "1-n"},
+	{Tag: 0x00143011, Name: "Internal Detector Frame Time", Keyword: "InternalDetectorFrameTime", VR: "DS", VM: "1"},
+	{Tag: 0x00143012, Name: "Number of Frames Integrated", Keyword: "NumberOfFramesIntegrated", VR: "DS", VM: "1"},
+	{Tag: 0x00143020, Name: "Detector Temperature Sequence", Keyword: "DetectorTemperatureSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00143022, Name: "Sensor Name", Keyword: "SensorName", VR: "ST", VM: "1"},
+	{Tag: 0x00143024, Name: "Horizontal Offset of Sensor", Keyword: "HorizontalOffsetOfSensor", VR: "DS", VM: "1"},
+	{Tag: 0x00143026, Name: "Vertical Offset of Sensor", Keyword: "VerticalOffsetOfSensor", VR: "DS", VM: "1"},
+	{Tag: 0x00143028, Name: "Sensor Temperature", Keyword: "SensorTemperature", VR: "DS", VM: "1"},
+	{Tag: 0x00143040, Name: "Dark Current Sequence", Keyword: "DarkCurrentSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00143050, Name: "Dark Current Counts", Keyword: "DarkCurrentCounts", VR: "OB or OW", VM: "1"},
+	{Tag: 0x00143060, Name: "Gain Correction Reference Sequence", Keyword: "GainCorrectionReferenceSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00143070, Name: "Air Counts", Keyword: "AirCounts", VR: "OB or OW", VM: "1"},
+	{Tag: 0x00143071, Name: "KV Used in Gain Calibration", Keyword: "KVUsedInGainCalibration", VR: "DS", VM: "1"},
+	{Tag: 0x00143072, Name: "MA Used in Gain Calibration", Keyword: "MAUsedInGainCalibration", VR: "DS", VM: "1"},
+	{Tag: 0x00143073, Name: "Number of Frames Used for Integration", Keyword: "NumberOfFramesUsedForIntegration", VR: "DS", VM: "1"},
+	{Tag: 0x00143074, Name: "Filter Material Used in Gain Calibration", Keyword: "FilterMaterialUsedInGainCalibration", VR: "LO", VM: "1"},
+	{Tag: 0x00143075, Name: "Filter Thickness Used in Gain Calibration", Keyword: "FilterThicknessUsedInGainCalibration", VR: "DS", VM: "1"},
+	{Tag: 0x00143076, Name: "Date of Gain Calibration", Keyword: "DateOfGainCalibration", VR: "DA", VM: "1"},
+	{Tag: 0x00143077, Name: "Time of Gain Calibration", Keyword: "TimeOfGainCalibration", VR: "TM", VM: "1"},
+	{Tag: 0x00143080, Name: "Bad Pixel Image", Keyword: "BadPixelImage", VR: "OB", VM: "1"},
+	{Tag: 0x00143099, Name: "Calibration Notes", Keyword: "CalibrationNotes", VR: "LT", VM: "1"},
+	{Tag: 0x00143100, Name: "Linearity Correction Technique", Keyword: "LinearityCorrectionTechnique", VR: "LT", VM: "1"},
+	{Tag: 0x00143101, Name: "Beam Hardening Correction Technique", Keyword: "BeamHardeningCorrectionTechnique", VR: "LT", VM: "1"},
+	{Tag: 0x00144002, Name: "Pulser Equipment Sequence", Keyword: "PulserEquipmentSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00144004, Name: "Pulser Type", Keyword: "PulserType", VR: "CS", VM: "1"},
+	{Tag: 0x00144006, Name: "Pulser Notes", Keyword: "PulserNotes", VR: "LT", VM: "1"},
+	{Tag: 0x00144008, Name: "Receiver Equipment Sequence", Keyword: "ReceiverEquipmentSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x0014400A, Name: "Amplifier Type", Keyword: "AmplifierType", VR: "CS", VM: "1"},
+	{Tag: 0x0014400C, Name: "Receiver Notes", Keyword: "ReceiverNotes", VR: "LT", VM: "1"},
+	{Tag: 0x0014400E, Name: "Pre-Amplifier Equipment Sequence", Keyword: "PreAmplifierEquipmentSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x0014400F, Name: "Pre-Amplifier Notes", Keyword: "PreAmplifierNotes", VR: "LT", VM: "1"},
+	{Tag: 0x00144010, Name: "Transmit Transducer Sequence", Keyword: "TransmitTransducerSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00144011, Name: "Receive Transducer Sequence", Keyword: "ReceiveTransducerSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00144012, Name: "Number of Elements", Keyword: "NumberOfElements", VR: "US", VM: "1"},
+	{Tag: 0x00144013, Name: "Element Shape", Keyword: "ElementShape", VR: "CS", VM: "1"},
+	{Tag: 0x00144014, Name: "Element Dimension A", Keyword: "ElementDimensionA", VR: "DS", VM: "1"},
+	{Tag: 0x00144015, Name: "Element Dimension B", Keyword: "ElementDimensionB", VR: "DS", VM: "1"},
+	{Tag: 0x00144016, Name: "Element Pitch A", Keyword: "ElementPitchA", VR: "DS", VM: "1"},
+	{Tag: 0x00144017, Name: "Measured Beam Dimension A", Keyword: "MeasuredBeamDimensionA", VR: "DS", VM: "1"},
+	{Tag: 0x00144018, Name: "Measured Beam Dimension B", Keyword: "MeasuredBeamDimensionB", VR: "DS", VM: "1"},
+	{Tag: 0x00144019, Name: "Location of Measured Beam Diameter", Keyword: "LocationOfMeasuredBeamDiameter", VR: "DS", VM: "1"},
+	{Tag: 0x0014401A, Name: "Nominal Frequency", Keyword: "NominalFrequency", VR: "DS", VM: "1"},
+	{Tag: 0x0014401B, Name: "Measured Center Frequency", Keyword: "MeasuredCenterFrequency", VR: "DS", VM: "1"},
+	{Tag: 0x0014401C, Name: "Measured Bandwidth", Keyword: "MeasuredBandwidth", VR: "DS", VM: "1"},
+	{Tag: 0x0014401D, Name: "Element Pitch B", Keyword: "ElementPitchB", VR: "DS", VM: "1"},
+	{Tag: 0x00144020, Name: "Pulser Settings Sequence", Keyword: "PulserSettingsSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00144022, Name: "Pulse Width", Keyword: "PulseWidth", VR: "DS", VM: "1"},
+	{Tag: 0x00144024, Name: "Excitation Frequency", Keyword: "ExcitationFrequency", VR: "DS", VM: "1"},
+	{Tag: 0x00144026, Name: "Modulation Type", Keyword: "ModulationType", VR: "CS", VM: "1"},
+	{Tag: 0x00144028, Name: "Damping", Keyword: "Damping", VR: "DS", VM: "1"},
+	{Tag: 0x00144030, Name: "Receiver Settings Sequence", Keyword: "ReceiverSettingsSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00144031, Name: "Acquired Soundpath Length", Keyword: "AcquiredSoundpathLength", VR: "DS", VM: "1"},
+	{Tag: 0x00144032, Name: "Acquisition Compression Type", Keyword: "AcquisitionCompressionType", VR: "CS", VM: "1"},
+	{Tag: 0x00144033, Name: "Acquisition Sample Size", Keyword: "AcquisitionSampleSize", VR: "IS", VM: "1"},
+	{Tag: 0x00144034, Name: "Rectifier Smoothing", Keyword: "RectifierSmoothing", VR: "DS", VM: "1"},
+	{Tag: 0x00144035, Name: "DAC Sequence", Keyword: "DACSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00144036, Name: "DAC Type", Keyword: "DACType", VR: "CS", VM: "1"},
+	{Tag: 0x00144038, Name: "DAC Gain Points", Keyword: "DACGainPoints", VR: "DS", VM: "1-n"},
+	{Tag: 0x0014403A, Name: "DAC Time Points", Keyword: "DACTimePoints", VR: "DS", VM: "1-n"},
+	{Tag: 0x0014403C, Name: "DAC Amplitude", Keyword: "DACAmplitude", VR: "DS", VM: "1-n"},
+	{Tag: 0x00144040, Name: "Pre-Amplifier Settings Sequence", Keyword: "PreAmplifierSettingsSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00144050, Name: "Transmit Transducer Settings Sequence", Keyword: "TransmitTransducerSettingsSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00144051, Name: "Receive Transducer Settings Sequence", Keyword: "ReceiveTransducerSettingsSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00144052, Name: "Incident Angle", Keyword: "IncidentAngle", VR: "DS", VM: "1"},
+	{Tag: 0x00144054, Name: "Coupling Technique", Keyword: "CouplingTechnique", VR: "ST", VM: "1"},
+	{Tag: 0x00144056, Name: "Coupling Medium", Keyword: "CouplingMedium", VR: "ST", VM: "1"},
+	{Tag: 0x00144057, Name: "Coupling Velocity", Keyword: "CouplingVelocity", VR: "DS", VM: "1"},
+	{Tag: 0x00144058, Name: "Probe Center Location X", Keyword: "ProbeCenterLocationX", VR: "DS", VM: "1"},
+	{Tag: 0x00144059, Name: "Probe Center Location Z", Keyword: "ProbeCenterLocationZ", VR: "DS", VM: "1"},
+	{Tag: 0x0014405A, Name: "Sound Path Length", Keyword: "SoundPathLength", VR: "DS", VM: "1"},
+	{Tag: 0x0014405C, Name: "Delay Law Identifier", Keyword: "DelayLawIdentifier", VR: "ST", VM: "1"},
+	{Tag: 0x00144060, Name: "Gate Settings Sequence", Keyword: "GateSettingsSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00144062, Name: "Gate Threshold", Keyword: "GateThreshold", VR: "DS", VM: "1"},
+	{Tag: 0x00144064, Name: "Velocity of Sound", Keyword: "VelocityOfSound", VR: "DS", VM: "1"},
+	{Tag: 0x00144070, Name: "Calibration Settings Sequence", Keyword: "CalibrationSettingsSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00144072, Name: "Calibration Procedure", Keyword: "CalibrationProcedure", VR: "ST", VM: "1"},
+	{Tag: 0x00144074, Name: "Procedure Version", Keyword: "ProcedureVersion", VR: "SH", VM: "1"},
+	{Tag: 0x00144076, Name: "Procedure Creation Date", Keyword: "ProcedureCreationDate", VR: "DA", VM: "1"},
+	{Tag: 0x00144078, Name: "Procedure Expiration Date", Keyword: "ProcedureExpirationDate", VR: "DA", VM: "1"},
+	{Tag: 0x0014407A, Name: "Procedure Last Modified Date", Keyword: "ProcedureLastModifiedDate", VR: "DA", VM: "1"},
+	{Tag: 0x0014407C, Name: "Calibration Time", Keyword: "CalibrationTime", VR: "TM", VM: "1-n"},
+	{Tag: 0x0014407E, Name: "Calibration Date", Keyword: "CalibrationDate", VR: "DA", VM: "1-n"},
+	{Tag: 0x00144080, Name: "Probe Drive Equipment Sequence", Keyword: "ProbeDriveEquipmentSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00144081, Name: "Drive Type", Keyword: "DriveType", VR: "CS", VM: "1"},
+	{Tag: 0x00144082, Name: "Probe Drive Notes", Keyword: "ProbeDriveNotes", VR: "LT", VM: "1"},
+	{Tag: 0x00144083, Name: "Drive Probe Sequence", Keyword: "DriveProbeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00144084, Name: "Probe Inductance", Keyword: "ProbeInductance", VR: "DS", VM: "1"},
+	{Tag: 0x00144085, Name: "Probe Resistance", Keyword: "ProbeResistance", VR: "DS", VM: "1"},
+	{Tag: 0x00144086, Name: "Receive Probe Sequence", Keyword: "ReceiveProbeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00144087, Name: "Probe Drive Settings Sequence", Keyword: "ProbeDriveSettingsSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00144088, Name: "Bridge Resistors", Keyword: "BridgeResistors", VR: "DS", VM: "1"},
+	{Tag: 0x00144089, Name: "Probe Orientation Angle", Keyword: "ProbeOrientationAngle", VR: "DS", VM: "1"},
+	{Tag: 0x0014408B, Name: "User Selected Gain Y", Keyword: "UserSelectedGainY", VR: "DS", VM: "1"},
+	{Tag: 0x0014408C, Name: "User Selected Phase", Keyword: "UserSelectedPhase", VR: "DS", VM: "1"},
+	{Tag: 0x0014408D, Name: "User Selected Offset X", Keyword: "UserSelectedOffsetX", VR: "DS", VM: "1"},
+	{Tag: 0x0014408E, Name: "User Selected Offset Y", Keyword: "UserSelectedOffsetY", VR: "DS", VM: "1"},
+	{Tag: 0x00144091, Name: "Channel Settings Sequence", Keyword: "ChannelSettingsSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00144092, Name: "Channel Threshold", Keyword: "ChannelThreshold", VR: "DS", VM: "1"},
+	{Tag: 0x0014409A, Name: "Scanner Settings Sequence", Keyword: "ScannerSettingsSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x0014409B, Name: "Scan Procedure", Keyword: "ScanProcedure", VR: "ST", VM: "1"},
+	{Tag: 0x0014409C, Name: "Translation Rate X", Keyword: "TranslationRateX", VR: "DS", VM: "1"},
+	{Tag: 0x0014409D, Name: "Translation Rate Y", Keyword: "TranslationRateY", VR: "DS", VM: "1"},
+	{Tag: 0x0014409F, Name: "Channel Overlap", Keyword: "ChannelOverlap", VR: "DS", VM: "1"},
+	{Tag: 0x001440A0, Name: "Image Quality Indicator Type", Keyword: "ImageQualityIndicatorType", VR: "LO", VM: "1-n"},
+	{Tag: 0x001440A1, Name: "Image Quality Indicator Material", Keyword: "ImageQualityIndicatorMaterial", VR: "LO", VM: "1-n"},
+	{Tag: 0x001440A2, Name: "Image Quality Indicator Size", Keyword: "ImageQualityIndicatorSize", VR: "LO", VM: "1-n"},
+	{Tag: 0x00145002, Name: "LINAC Energy", Keyword: "LINACEnergy", VR: "IS", VM: "1"},
+	{Tag: 0x00145004, Name: "LINAC Output", Keyword: "LINACOutput", VR: "IS", VM: "1"},
+	{Tag: 0x00145100, Name: "Active Aperture", Keyword: "ActiveAperture", VR: "US", VM: "1"},
+	{Tag: 0x00145101, Name: "Total Aperture", Keyword: "TotalAperture", VR: "DS", VM: "1"},
+	{Tag: 0x00145102, Name: "Aperture Elevation", Keyword: "ApertureElevation", VR: "DS", VM: "1"},
+	{Tag: 0x00145103, Name: "Main Lobe Angle", Keyword: "MainLobeAngle", VR: "DS", VM: "1"},
+	{Tag: 0x00145104, Name: "Main Roof Angle", Keyword: "MainRoofAngle", VR: "DS", VM: "1"},
+	{Tag: 0x00145105, Name: "Connector Type", Keyword: "ConnectorType", VR: "CS", VM: "1"},
+	{Tag: 0x00145106, Name: "Wedge Model Number", Keyword: "WedgeModelNumber", VR: "SH", VM: "1"},
+	{Tag: 0x00145107, Name: "Wedge Angle Float", Keyword: "WedgeAngleFloat", VR: "DS", VM: "1"},
+	{Tag: 0x00145108, Name: "Wedge Roof Angle", Keyword: "WedgeRoofAngle", VR: "DS", VM: "1"},
+	{Tag: 0x00145109, Name: "Wedge Element 1 Position", Keyword: "WedgeElement1Position", VR: "CS", VM: "1"},
+	{Tag: 0x0014510A, Name: "Wedge Material Velocity", Keyword: "WedgeMaterialVelocity", VR: "DS", VM: "1"},
+	{Tag: 0x0014510B, Name: "Wedge Material", Keyword: "WedgeMaterial", VR: "SH", VM: "1"},
+	{Tag: 0x0014510C, Name: "Wedge Offset Z", Keyword: "WedgeOffsetZ", VR: "DS", VM: "1"},
+	{Tag: 0x0014510D, Name: "Wedge Origin Offset X", Keyword: "WedgeOriginOffsetX", VR: "DS", VM: "1"},
+	{Tag: 0x0014510E, Name: "Wedge Time Delay", Keyword: "WedgeTimeDelay", VR: "DS", VM: "1"},
+	{Tag: 0x0014510F, Name: "Wedge Name", Keyword: "WedgeName", VR: "SH", VM: "1"},
+	{Tag: 0x00145110, Name: "Wedge Manufacturer Name", Keyword: "WedgeManufacturerName", VR: "SH", VM: "1"},
+	{Tag: 0x00145111, Name: "Wedge Description", Keyword: "WedgeDescription", VR: "LO", VM: "1"},
+	{Tag: 0x00145112, Name: "Nominal Beam Angle", Keyword: "NominalBeamAngle", VR: "DS", VM: "1"},
+	{Tag: 0x00145113, Name: "Wedge Offset X", Keyword: "WedgeOffsetX", VR: "DS", VM: "1"},
+	{Tag: 0x00145114, Name: "Wedge Offset Y", Keyword: "WedgeOffsetY", VR: "DS", VM: "1"},
+	{Tag: 0x00145115, Name: "Wedge Total Length", Keyword: "WedgeTotalLength", VR: "DS", VM: "1"},
+	{Tag: 0x00145116, Name: "Wedge In Contact Length", Keyword: "WedgeInContactLength", VR: "DS", VM: "1"},
+	{Tag: 0x00145117, Name: "Wedge Front Gap", Keyword: "WedgeFrontGap", VR: "DS", VM: "1"},
+	{Tag: 0x00145118, Name: "Wedge Total Height", Keyword: "WedgeTotalHeight", VR: "DS", VM: "1"},
+	{Tag: 0x00145119, Name: "Wedge Front Height", Keyword: "WedgeFrontHeight", VR: "DS", VM: "1"},
+	{Tag: 0x0014511A, Name: "Wedge Rear Height", Keyword: "WedgeRearHeight", VR: "DS", VM: "1"},
+	{Tag: 0x0014511B, Name: "Wedge Total Width", Keyword: "WedgeTotalWidth", VR: "DS", VM: "1"},
+	{Tag: 0x0014511C, Name: "Wedge In Contact Width", Keyword: "WedgeInContactWidth", VR: "DS", VM: "1"},
+	{Tag: 0x0014511D, Name: "Wedge Chamfer Height", Keyword: "WedgeChamferHeight", VR: "DS", VM: "1"},
+	{Tag: 0x0014511E, Name: "Wedge Curve", Keyword: "WedgeCurve", VR: "CS", VM: "1"},
+	{Tag: 0x0014511F, Name: "Radius Along the Wedge", Keyword: "RadiusAlongWedge", VR: "DS", VM: "1"},
+	{Tag: 0x00160001, Name: "White Point", Keyword: "WhitePoint", VR: "DS", VM: "1"},
+	{Tag: 0x00160002, Name: "Primary Chromaticities", Keyword: "PrimaryChromaticities", VR: "DS", VM: "3"},
+	{Tag: 0x00160003, Name: "Battery Level", Keyword: "BatteryLevel", VR: "UT", VM: "1"},
+	{Tag: 0x00160004, Name: "Exposure Time in Seconds", Keyword: "ExposureTimeInSeconds", VR: "DS", VM: "1"},
+	{Tag: 0x00160005, Name: "F-Number", Keyword: "FNumber", VR: "DS", VM: "1"},
+	{Tag: 0x00160006, Name: "OECF Rows", Keyword: "OECFRows", VR: "IS", VM: "1"},
+	{Tag: 0x00160007, Name: "OECF Columns", Keyword: "OECFColumns", VR: "IS", VM: "1"},
+	{Tag: 0x00160008, Name: "OECF Column Names", Keyword: "OECFColumnNames", VR: "UC", VM: "1-n"},
+	{Tag: 0x00160009, Name: "OECF Values", Keyword: "OECFValues", VR: "DS", VM: "1-n"},
+	{Tag: 0x0016000A, Name: "Spatial Frequency Response Rows", Keyword: "SpatialFrequencyResponseRows", VR: "IS", VM: "1"},
+	{Tag: 0x0016000B, Name: "Spatial Frequency Response Columns", Keyword: "SpatialFrequencyResponseColumns", VR: "IS", VM: "1"},
+	{Tag: 0x0016000C, Name: "Spatial Frequency Response Column Names", Keyword: "SpatialFrequencyResponseColumnNames", VR: "UC", VM: "1-n"},
+	{Tag: 0x0016000D, Name: "Spatial Frequency Response Values", Keyword: "SpatialFrequencyResponseValues", VR: "DS", VM: "1-n"},
+	{Tag: 0x0016000E, Name: "Color Filter Array Pattern Rows", Keyword: "ColorFilterArrayPatternRows", VR: "IS", VM: "1"},
+	{Tag: 0x0016000F, Name: "Color Filter Array Pattern Columns", Keyword: "ColorFilterArrayPatternColumns", VR: "IS", VM: "1"},
+	{Tag: 0x00160010, Name: "Color Filter Array Pattern Values", Keyword: "ColorFilterArrayPatternValues", VR: "DS", VM: "1-n"},
+	{Tag: 0x00160011, Name: "Flash Firing Status", Keyword: "FlashFiringStatus", VR: "US", VM: "1"},
+	{Tag: 0x00160012, Name: "Flash Return Status", Keyword: "FlashReturnStatus", VR: "US", VM: "1"},
+	{Tag: 0x00160013, Name: "Flash Mode", Keyword: "FlashMode", VR: "US", VM: "1"},
+	{Tag: 0x00160014, Name: "Flash Function Present", Keyword: "FlashFunctionPresent", VR: "US", VM: "1"},
+	{Tag: 0x00160015, Name: "Flash Red Eye Mode", Keyword: "FlashRedEyeMode", VR: "US", VM: "1"},
+	{Tag: 0x00160016, Name: "Exposure Program", Keyword: "ExposureProgram", VR: "US", VM: "1"},
+	{Tag: 0x00160017, Name: "Spectral Sensitivity", Keyword: "SpectralSensitivity", VR: "UT", VM: "1"},
+	{Tag: 0x00160018, Name: "Photographic Sensitivity", Keyword: "PhotographicSensitivity", VR: "IS", VM: "1"},
+	{Tag: 0x00160019, Name: "Self Timer Mode", Keyword: "SelfTimerMode", VR: "IS", VM: "1"},
+	{Tag: 0x0016001A, Name: "Sensitivity Type", Keyword: "SensitivityType", VR: "US", VM: "1"},
+	{Tag: 0x0016001B, Name: "Standard Output Sensitivity", Keyword: "StandardOutputSensitivity", VR: "IS", VM: "1"},
+	{Tag: 0x0016001C, Name: "Recommended Exposure Index", Keyword: "RecommendedExposureIndex", VR: "IS", VM: "1"},
+	{Tag: 0x0016001D, Name: "ISO Speed", Keyword: "ISOSpeed", VR: "IS", VM: "1"},
+	{Tag: 0x0016001E, Name: "ISO Speed Latitude yyy", Keyword: "ISOSpeedLatitudeyyy", VR: "IS", VM: "1"},
+	{Tag: 0x0016001F, Name: "ISO Speed Latitude zzz", Keyword: "ISOSpeedLatitudezzz", VR: "IS", VM: "1"},
+	{Tag: 0x00160020, Name: "EXIF Version", Keyword: "EXIFVersion", VR: "UT", VM: "1"},
+	{Tag: 0x00160021, Name: "Shutter Speed Value", Keyword: "ShutterSpeedValue", VR: "DS", VM: "1"},
+	{Tag: 0x00160022, Name: "Aperture Value", Keyword: "ApertureValue", VR: "DS", VM: "1"},
+	{Tag: 0x00160023, Name: "Brightness Value", Keyword: "BrightnessValue", VR: "DS", VM: "1"},
+	{Tag: 0x00160024, Name: "Exposure Bias Value", Keyword: "ExposureBiasValue", VR: "DS", VM: "1"},
+	{Tag: 0x00160025, Name: "Max Aperture Value", Keyword: "MaxApertureValue", VR: "DS", VM: "1"},
+	{Tag: 0x00160026, Name: "Subject Distance", Keyword: "SubjectDistance", VR: "DS", VM: "1"},
+	{Tag: 0x00160027, Name: "Metering Mode", Keyword: "MeteringMode", VR: "US", VM: "1"},
+	{Tag: 0x00160028, Name: "Light Source", Keyword: "LightSource", VR: "US", VM: "1"},
+	{Tag: 0x00160029, Name: "Focal Length", Keyword: "FocalLength", VR: "DS", VM: "1"},
+	{Tag: 0x0016002A, Name: "Subject Area", Keyword: "SubjectArea", VR: "IS", VM: "2-4"},
+	{Tag: 0x0016002B, Name: "Maker Note", Keyword: "MakerNote", VR: "OB", VM: "1"},
+	{Tag: 0x00160030, Name: "Temperature", Keyword: "Temperature", VR: "DS", VM: "1"},
+	{Tag: 0x00160031, Name: "Humidity", Keyword: "Humidity", VR: "DS", VM: "1"},
+	{Tag: 0x00160032, Name: "Pressure", Keyword: "Pressure", VR: "DS", VM: "1"},
+	{Tag: 0x00160033, Name: "Water Depth", Keyword: "WaterDepth", VR: "DS", VM: "1"},
+	{Tag: 0x00160034, Name: "Acceleration", Keyword: "Acceleration", VR: "DS", VM: "1"},
+	{Tag: 0x00160035, Name: "Camera Elevation Angle", Keyword: "CameraElevationAngle", VR: "DS", VM: "1"},
+	{Tag: 0x00160036, Name: "Flash Energy", Keyword: "FlashEnergy", VR: "DS", VM: "1-2"},
+	{Tag: 0x00160037, Name: "Subject Location", Keyword: "SubjectLocation", VR: "IS", VM: "2"},
+	{Tag: 0x00160038, Name: "Photographic Exposure Index", Keyword: "PhotographicExposureIndex", VR: "DS", VM: "1"},
+	{Tag: 0x00160039, Name: "Sensing Method", Keyword: "SensingMethod", VR: "US", VM: "1"},
+	{Tag: 0x0016003A, Name: "File Source", Keyword: "FileSource", VR: "US", VM: "1"},
+	{Tag: 0x0016003B, Name: "Scene Type", Keyword: "SceneType", VR: "US", VM: "1"},
+	{Tag: 0x00160041, Name: "Custom Rendered", Keyword: "CustomRendered", VR: "US", VM: "1"},
+	{Tag: 0x00160042, Name: "Exposure Mode", Keyword: "ExposureMode", VR: "US", VM: "1"},
+	{Tag: 0x00160043, Name: "White Balance", Keyword: "WhiteBalance", VR: "US", VM: "1"},
+	{Tag: 0x00160044, Name: "Digital Zoom Ratio", Keyword: "DigitalZoomRatio", VR: "DS", VM: "1"},
+	{Tag: 0x00160045, Name: "Focal Length In 35mm Film", Keyword: "FocalLengthIn35mmFilm", VR: "IS", VM: "1"},
+	{Tag: 0x00160046, Name: "Scene Capture Type", Keyword: "SceneCaptureType", VR: "US", VM: "1"},
+	{Tag: 0x00160047, Name: "Gain Control", Keyword: "GainControl", VR: "US", VM: "1"},
+	{Tag: 0x00160048, Name: "Contrast", Keyword: "Contrast", VR: "US", VM: "1"},
+	{Tag: 0x00160049, Name: "Saturation", Keyword: "Saturation", VR: "US", VM: "1"},
+	{Tag: 0x0016004A, Name: "Sharpness", Keyword: "Sharpness", VR: "US", VM: "1"},
+	{Tag: 0x0016004B, Name: "Device Setting Description", Keyword: "DeviceSettingDescription", VR: "OB", VM: "1"},
+	{Tag: 0x0016004C, Name: "Subject Distance Range", Keyword: "SubjectDistanceRange", VR: "US", VM: "1"},
+	{Tag: 0x0016004D, Name: "Camera Owner Name", Keyword: "CameraOwnerName", VR: "UT", VM: "1"},
+	{Tag: 0x0016004E, Name: "Lens Specification", Keyword: "LensSpecification", VR: "DS", VM: "4"},
+	{Tag: 0x0016004F, Name: "Lens Make", Keyword: "LensMake", VR: "UT", VM: "1"},
+	{Tag: 0x00160050, Name: "Lens Model", Keyword: "LensModel", VR: "UT", VM: "1"},
+	{Tag: 0x00160051, Name: "Lens Serial Number", Keyword: "LensSerialNumber", VR: "UT", VM: "1"},
+	{Tag: 0x00160061, Name: "Interoperability Index", Keyword: "InteroperabilityIndex", VR: "CS", VM: "1"},
+	{Tag: 0x00160062, Name: "Interoperability Version", Keyword: "InteroperabilityVersion", VR: "OB", VM: "1"},
+	{Tag: 0x00160070, Name: "GPS Version ID", Keyword: "GPSVersionID", VR: "OB", VM: "1"},
+	{Tag: 0x00160071, Name: "GPS Latitude Ref", Keyword: "GPSLatitudeRef", VR: "CS", VM: "1"},
+	{Tag: 0x00160072, Name: "GPS Latitude", Keyword: "GPSLatitude", VR: "DS", VM: "3"},
+	{Tag: 0x00160073, Name: "GPS Longitude Ref", Keyword: "GPSLongitudeRef", VR: "CS", VM: "1"},
+	{Tag: 0x00160074, Name: "GPS Longitude", Keyword: "GPSLongitude", VR: "DS", VM: "3"},
+	{Tag: 0x00160075, Name: "GPS Altitude Ref", Keyword: "GPSAltitudeRef", VR: "US", VM: "1"},
+	{Tag: 0x00160076, Name: "GPS Altitude", Keyword: "GPSAltitude", VR: "DS", VM: "1"},
+	{Tag: 0x00160077, Name: "GPS Time Stamp", Keyword: "GPSTimeStamp", VR: "DT", VM: "1"},
+	{Tag: 0x00160078, Name: "GPS Satellites", Keyword: "GPSSatellites", VR: "UT", VM: "1"},
+	{Tag: 0x00160079, Name: "GPS Status", Keyword: "GPSStatus", VR: "CS", VM: "1"},
+	{Tag: 0x0016007A, Name: "GPS Measure Mode", Keyword: "GPSMeasureMode", VR: "CS", VM: "1"},
+	{Tag: 0x0016007B, Name: "GPS DOP", Keyword: "GPSDOP", VR: "DS", VM: "1"},
+	{Tag: 0x0016007C, Name: "GPS Speed Ref", Keyword: "GPSSpeedRef", VR: "CS", VM: "1"},
+	{Tag: 0x0016007D, Name: "GPS Speed", Keyword: "GPSSpeed", VR: "DS", VM: "1"},
+	{Tag: 0x0016007E, Name: "GPS Track Ref", Keyword: "GPSTrackRef", VR: "CS", VM: "1"},
+	{Tag: 0x0016007F, Name: "GPS Track", Keyword: "GPSTrack", VR: "DS", VM: "1"},
+	{Tag: 0x00160080, Name: "GPS Img Direction Ref", Keyword: "GPSImgDirectionRef", VR: "CS", VM: "1"},
+	{Tag: 0x00160081, Name: "GPS Img Direction", Keyword: "GPSImgDirection", VR: "DS", VM: "1"},
+	{Tag: 0x00160082, Name: "GPS Map Datum", Keyword: "GPSMapDatum", VR: "UT", VM: "1"},
+	{Tag: 0x00160083, Name: "GPS Dest Latitude Ref", Keyword: "GPSDestLatitudeRef", VR: "CS", VM: "1"},
+	{Tag: 0x00160084, Name: "GPS Dest Latitude", Keyword: "GPSDestLatitude", VR: "DS", VM: "3"},
+	{Tag: 0x00160085, Name: "GPS Dest Longitude Ref", Keyword: "GPSDestLongitudeRef", VR: "CS", VM: "1"},
+	{Tag: 0x00160086, Name: "GPS Dest Longitude", Keyword: "GPSDestLongitude", VR: "DS", VM: "3"},
+	{Tag: 0x00160087, Name: "GPS Dest Bearing Ref", Keyword: "GPSDestBearingRef", VR: "CS", VM: "1"},
+	{Tag: 0x00160088, Name: "GPS Dest Bearing", Keyword: "GPSDestBearing", VR: "DS", VM: "1"},
+	{Tag: 0x00160089, Name: "GPS Dest Distance Ref", Keyword: "GPSDestDistanceRef", VR: "CS", VM: "1"},
+	{Tag: 0x0016008A, Name: "GPS Dest Distance", Keyword: "GPSDestDistance", VR: "DS", VM: "1"},
+	{Tag: 0x0016008B, Name: "GPS Processing Method", Keyword: "GPSProcessingMethod", VR: "OB", VM: "1"},
+	{Tag: 0x0016008C, Name: "GPS Area Information", Keyword: "GPSAreaInformation", VR: "OB", VM: "1"},
+	{Tag: 0x0016008D, Name: "GPS Date Stamp", Keyword: "GPSDateStamp", VR: "DT", VM: "1"},
+	{Tag: 0x0016008E, Name: "GPS Differential", Keyword: "GPSDifferential", VR: "IS", VM: "1"},
+	{Tag: 0x00161001, Name: "Light Source Polarization", Keyword: "LightSourcePolarization", VR: "CS", VM: "1"},
+	{Tag: 0x00161002, Name: "Emitter Color Temperature", Keyword: "EmitterColorTemperature", VR: "DS", VM: "1"},
+	{Tag: 0x00161003, Name: "Contact Method", Keyword: "ContactMethod", VR: "CS", VM: "1"},
+	{Tag: 0x00161004, Name: "Immersion Media", Keyword: "ImmersionMedia", VR: "CS", VM: "1-n"},
+	{Tag: 0x00161005, Name: "Optical Magnification Factor", Keyword: "OpticalMagnificationFactor", VR: "DS", VM: "1"},
+	{Tag: 0x00180010, Name: "Contrast/Bolus Agent", Keyword: "ContrastBolusAgent", VR: "LO", VM: "1"},
+	{Tag: 0x00180012, Name: "Contrast/Bolus Agent Sequence", Keyword: "ContrastBolusAgentSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00180013, Name: "Contrast/Bolus T1 Relaxivity", Keyword: "ContrastBolusT1Relaxivity", VR: "FL", VM: "1"},
+	{Tag: 0x00180014, Name: "Contrast/Bolus Administration Route Sequence", Keyword: "ContrastBolusAdministrationRouteSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00180015, Name: "Body Part Examined", Keyword: "BodyPartExamined", VR: "CS", VM: "1"},
+	{Tag: 0x00180020, Name: "Scanning Sequence", Keyword: "ScanningSequence", VR: "CS", VM: "1-n"},
+	{Tag: 0x00180021, Name: "Sequence Variant", Keyword: "SequenceVariant", VR: "CS", VM: "1-n"},
+	{Tag: 0x00180022, Name: "Scan Options", Keyword: "ScanOptions", VR: "CS", VM: "1-n"},
+	{Tag: 0x00180023, Name: "MR Acquisition Type", Keyword: "MRAcquisitionType", VR: "CS", VM: "1"},
+	{Tag: 0x00180024, Name: "Sequence Name", Keyword: "SequenceName", VR: "SH", VM: "1"},
+	{Tag: 0x00180025, Name: "Angio Flag", Keyword: "AngioFlag", VR: "CS", VM: "1"},
+	{Tag: 0x00180026, Name: "Intervention Drug Information Sequence", Keyword: "InterventionDrugInformationSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00180027, Name: "Intervention Drug Stop Time", Keyword: "InterventionDrugStopTime", VR: "TM", VM: "1"},
+	{Tag: 0x00180028, Name: "Intervention Drug Dose", Keyword: "InterventionDrugDose", VR: "DS", VM: "1"},
+	{Tag: 0x00180029, Name: "Intervention Drug Code Sequence", Keyword: "InterventionDrugCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x0018002A, Name: "Additional Drug Sequence", Keyword: "AdditionalDrugSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00180030, Name: "Radionuclide", Keyword: "Radionuclide", VR: "LO", VM: "1-n", Retired: true},
+	{Tag: 0x00180031, Name: "Radiopharmaceutical", Keyword: "Radiopharmaceutical", VR: "LO", VM: "1"},
+	{Tag: 0x00180032, Name: "Energy Window Centerline", Keyword: "EnergyWindowCenterline", VR: "DS", VM: "1", Retired: true},
+	{Tag: 0x00180033, Name: "Energy Window Total Width", Keyword: "EnergyWindowTotalWidth", VR: "DS", VM: "1-n", Retired: true},
+	{Tag: 0x00180034, Name: "Intervention Drug Name", Keyword: "InterventionDrugName", VR: "LO", VM: "1"},
+	{Tag: 0x00180035, Name: "Intervention Drug Start Time", Keyword: "InterventionDrugStartTime", VR: "TM", VM: "1"},
+	{Tag: 0x00180036, Name: "Intervention Sequence", Keyword: "InterventionSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00180037, Name: "Therapy Type", Keyword: "TherapyType", VR: "CS", VM: "1", Retired: true},
+	{Tag: 0x00180038, Name: "Intervention Status", Keyword: "InterventionStatus", VR: "CS", VM: "1"},
+	{Tag: 0x00180039, Name: "Therapy Description", Keyword: "TherapyDescription", VR: "CS", VM: "1", Retired: true},
+	{Tag: 0x0018003A, Name: "Intervention Description", Keyword: "InterventionDescription", VR: "ST", VM: "1"},
+	{Tag: 0x00180040, Name: "Cine Rate", Keyword: "CineRate", VR: "IS", VM: "1"},
+	{Tag: 0x00180042, Name: "Initial Cine Run State", Keyword: "InitialCineRunState", VR: "CS", VM: "1"},
+	{Tag: 0x00180050, Name: "Slice Thickness", Keyword: "SliceThickness", VR: "DS", VM: "1"},
+	{Tag: 0x00180060, Name: "KVP", Keyword: "KVP", VR: "DS", VM: "1"},
+	{Tag: 0x00180070, Name: "Counts Accumulated", Keyword: "CountsAccumulated", VR: "IS", VM: "1"},
+	{Tag: 0x00180071, Name: "Acquisition Termination Condition", Keyword: "AcquisitionTerminationCondition", VR: "CS", VM: "1"},
+	{Tag: 0x00180072, Name: "Effective Duration", Keyword: "EffectiveDuration", VR: "DS", VM: "1"},
+	{Tag: 0x00180073, Name: "Acquisition Start Condition", Keyword: "AcquisitionStartCondition", VR: "CS", VM: "1"},
+	{Tag: 0x00180074, Name: "Acquisition Start Condition Data", Keyword: "AcquisitionStartConditionData", VR: "IS", VM: "1"},
+	{Tag: 0x00180075, Name: "Acquisition Termination Condition Data", Keyword: "AcquisitionTerminationConditionData", VR: "IS", VM: "1"},
+	{Tag: 0x00180080, Name: "Repetition Time", Keyword: "RepetitionTime", VR: "DS", VM: "1"},
+	{Tag: 0x00180081, Name: "Echo Time", Keyword: "EchoTime", VR: "DS", VM: "1"},
+	{Tag: 0x00180082, Name: "Inversion Time", Keyword: "InversionTime", VR: "DS", VM: "1"},
+	{Tag: 0x00180083, Name: "Number of Averages", Keyword: "NumberOfAverages", VR: "DS", VM: "1"},
+	{Tag: 0x00180084, Name: "Imaging Frequency", Keyword: "ImagingFrequency", VR: "DS", VM: "1"},
+	{Tag: 0x00180085, Name: "Imaged Nucleus", Keyword: "ImagedNucleus", VR: "SH", VM: "1"},
+	{Tag: 0x00180086, Name: "Echo Number(s)", Keyword: "EchoNumbers", VR: "IS", VM: "1-n"},
+	{Tag: 0x00180087, Name: "Magnetic Field Strength", Keyword: "MagneticFieldStrength", VR: "DS", VM: "1"},
+	{Tag: 0x00180088, Name: "Spacing Between Slices", Keyword: "SpacingBetweenSlices", VR: "DS", VM: "1"},
+	{Tag: 0x00180089, Name: "Number of Phase Encoding Steps", Keyword: "NumberOfPhaseEncodingSteps", VR: "IS", VM: "1"},
+	{Tag: 0x00180090, Name: "Data Collection Diameter", Keyword: "DataCollectionDiameter", VR: "DS", VM: "1"},
+	{Tag: 0x00180091, Name: "Echo Train Length", Keyword: "EchoTrainLength", VR: "IS", VM: "1"},
+	{Tag: 0x00180093, Name: "Percent Sampling", Keyword: "PercentSampling", VR: "DS", VM: "1"},
+	{Tag: 0x00180094, Name: "Percent Phase Field of View", Keyword: "PercentPhaseFieldOfView", VR: "DS", VM: "1"},
+	{Tag: 0x00180095, Name: "Pixel Bandwidth", Keyword: "PixelBandwidth", VR: "DS", VM: "1"},
+	{Tag: 0x00181000, Name: "Device Serial Number", Keyword: "DeviceSerialNumber", VR: "LO", VM: "1"},
+	{Tag: 0x00181002, Name: "Device UID", Keyword: "DeviceUID", VR: "UI", VM: "1"},
+	{Tag: 0x00181003, Name: "Device ID", Keyword: "DeviceID", VR: "LO", VM: "1"},
+	{Tag: 0x00181004, Name: "Plate ID", Keyword: "PlateID", VR: "LO", VM: "1"},
+	{Tag: 0x00181005, Name: "Generator ID", Keyword: "GeneratorID", VR: "LO", VM: "1"},
+	{Tag: 0x00181006, Name: "Grid ID", Keyword: "GridID", VR: "LO", VM: "1"},
+	{Tag: 0x00181007, Name: "Cassette ID", Keyword: "CassetteID", VR: "LO", VM: "1"},
+	{Tag: 0x00181008, Name: "Gantry ID", Keyword: "GantryID", VR: "LO", VM: "1"},
+	{Tag: 0x00181009, Name: "Unique Device Identifier", Keyword: "UniqueDeviceIdentifier", VR: "UT", VM: "1"},
+	{Tag: 0x0018100A, Name: "UDI Sequence", Keyword: "UDISequence", VR: "SQ", VM: "1"},
+	{Tag: 0x0018100B, Name: "Manufacturer's Device Class UID", Keyword: "ManufacturerDeviceClassUID", VR: "UI", VM: "1-n"},
+	{Tag: 0x00181010, Name: "Secondary Capture Device ID", Keyword: "SecondaryCaptureDeviceID", VR: "LO", VM: "1"},
+	{Tag: 0x00181011, Name: "Hardcopy Creation Device ID", Keyword: "HardcopyCreationDeviceID", VR: "LO", VM: "1", Retired: true},
+	{Tag: 0x00181012, Name: "Date of Secondary Capture", Keyword: "DateOfSecondaryCapture", VR: "DA", VM: "1"},
+	{Tag: 0x00181014, Name: "Time of Secondary Capture", Keyword: "TimeOfSecondaryCapture", VR: "TM", VM: "1"},
+	{Tag: 0x00181016, Name: "Secondary Capture Device Manufacturer", Keyword: "SecondaryCaptureDeviceManufacturer", VR: "LO", VM: "1"},
+	{Tag: 0x00181017, Name: "Hardcopy Device Manufacturer", Keyword: "HardcopyDeviceManufacturer", VR: "LO", VM: "1", Retired: true},
+	{Tag: 0x00181018, Name: "Secondary Capture Device Manufacturer's Model Name", Keyword: "SecondaryCaptureDeviceManufacturerModelName", VR: "LO", VM: "1"},
+	{Tag: 0x00181019, Name: "Secondary Capture Device Software Versions", Keyword: "SecondaryCaptureDeviceSoftwareVersions", VR: "LO", VM: "1-n"},
+	{Tag: 0x0018101A, Name: "Hardcopy Device Software Version", Keyword: "HardcopyDeviceSoftwareVersion", VR: "LO", VM: "1-n", Retired: true},
+	{Tag: 0x0018101B, Name: "Hardcopy Device Manufacturer's Model Name", Keyword: "HardcopyDeviceManufacturerModelName", VR: "LO", VM: "1", Retired: true},
+	{Tag: 0x00181020, Name: "Software Versions", Keyword: "SoftwareVersions", VR: "LO", VM: "1-n"},
+	{Tag: 0x00181022, Name: "Video Image Format Acquired", Keyword: "VideoImageFormatAcquired", VR: "SH", VM: "1"},
+	{Tag: 0x00181023, Name: "Digital Image Format Acquired", Keyword: "DigitalImageFormatAcquired", VR: "LO", VM: "1"},
+	{Tag: 0x00181030, Name: "Protocol Name", Keyword: "ProtocolName", VR: "LO", VM: "1"},
+	{Tag: 0x00181040, Name: "Contrast/Bolus Route", Keyword: "ContrastBolusRoute", VR: "LO", VM: "1"},
+	{Tag: 0x00181041, Name: "Contrast/Bolus Volume", Keyword: "ContrastBolusVolume", VR: "DS", VM: "1"},
+	{Tag: 0x00181042, Name: "Contrast/Bolus Start Time", Keyword: "ContrastBolusStartTime", VR: "TM", VM: "1"},
+	{Tag: 0x00181043, Name: "Contrast/Bolus Stop Time", Keyword: "ContrastBolusStopTime", VR: "TM", VM: "1"},
+	{Tag: 0x00181044, Name: "Contrast/Bolus Total Dose", Keyword: "ContrastBolusTotalDose", VR: "DS", VM: "1"},
+	{Tag: 0x00181045, Name: "Syringe Counts", Keyword: "SyringeCounts", VR: "IS", VM: "1"},
+	{Tag: 0x00181046, Name: "Contrast Flow Rate", Keyword: "ContrastFlowRate", VR: "DS", VM: "1-n"},
+	{Tag: 0x00181047, Name: "Contrast Flow Duration", Keyword: "ContrastFlowDuration", VR: "DS", VM: "1-n"},
+	{Tag: 0x00181048, Name: "Contrast/Bolus Ingredient", Keyword: "ContrastBolusIngredient", VR: "CS", VM: "1"},
+	{Tag: 0x00181049, Name: "Contrast/Bolus Ingredient Concentration", Keyword: "ContrastBolusIngredientConcentration", VR: "DS", VM: "1"},
+	{Tag: 0x00181050, Name: "Spatial Resolution", Keyword: "SpatialResolution", VR: "DS", VM: "1"},
+	{Tag: 0x00181060, Name: "Trigger Time", Keyword: "TriggerTime", VR: "DS", VM: "1"},
+	{Tag: 0x00181061, Name: "Trigger Source or Type", Keyword: "TriggerSourceOrType", VR: "LO", VM: "1"},
+	{Tag: 0x00181062, Name: "Nominal Interval", Keyword: "NominalInterval", VR: "IS", VM: "1"},
+	{Tag: 0x00181063, Name: "Frame Time", Keyword: "FrameTime", VR: "DS", VM: "1"},
+	{Tag: 0x00181064, Name: "Cardiac Framing Type", Keyword: "CardiacFramingType", VR: "LO", VM: "1"},
+	{Tag: 0x00181065, Name: "Frame Time Vector", Keyword: "FrameTimeVector", VR: "DS", VM: "1-n"},
+	{Tag: 0x00181066, Name: "Frame Delay", Keyword: "FrameDelay", VR: "DS", VM: "1"},
+	{Tag: 0x00181067, Name: "Image Trigger Delay", Keyword: "ImageTriggerDelay", VR: "DS", VM: "1"},
+	{Tag: 0x00181068, Name: "Multiplex Group Time Offset", Keyword: "MultiplexGroupTimeOffset", VR: "DS", VM: "1"},
+	{Tag: 0x00181069, Name: "Trigger Time Offset", Keyword: "TriggerTimeOffset", VR: "DS", VM: "1"},
+	{Tag: 0x0018106A, Name: "Synchronization Trigger", Keyword: "SynchronizationTrigger", VR: "CS", VM: "1"},
+	{Tag: 0x0018106C, Name: "Synchronization Channel", Keyword: "SynchronizationChannel", VR: "US", VM: "2"},
+	{Tag: 0x0018106E, Name: "Trigger Sample Position", Keyword: "TriggerSamplePosition", VR: "UL", VM: "1"},
+	{Tag: 0x00181070, Name: "Radiopharmaceutical Route", Keyword: "RadiopharmaceuticalRoute", VR: "LO", VM: "1"},
+	{Tag: 0x00181071, Name: "Radiopharmaceutical Volume", Keyword: "RadiopharmaceuticalVolume", VR: "DS", VM: "1"},
+	{Tag: 0x00181072, Name: "Radiopharmaceutical Start Time", Keyword: "RadiopharmaceuticalStartTime", VR: "TM", VM: "1"},
+	{Tag: 0x00181073, Name: "Radiopharmaceutical Stop Time", Keyword: "RadiopharmaceuticalStopTime", VR: "TM", VM: "1"},
+	{Tag: 0x00181074, Name: "Radionuclide Total Dose", Keyword: "RadionuclideTotalDose", VR: "DS", VM: "1"},
+	{Tag: 0x00181075, Name: "Radionuclide Half Life", Keyword: "RadionuclideHalfLife", VR: "DS", VM: "1"},
+	{Tag: 0x00181076, Name: "Radionuclide Positron Fraction", Keyword: "RadionuclidePositronFraction", VR: "DS", VM: "1"},
+	{Tag: 0x00181077, Name: "Radiopharmaceutical Specific Activity", Keyword: "RadiopharmaceuticalSpecificActivity", VR: "DS", VM: "1"},
+	{Tag: 0x00181078, Name: "Radiopharmaceutical Start DateTime", Keyword: "RadiopharmaceuticalStartDateTime", VR: "DT", VM: "1"},
+	{Tag: 0x00181079, Name: "Radiopharmaceutical Stop DateTime", Keyword: "RadiopharmaceuticalStopDateTime", VR: "DT", VM: "1"},
+	{Tag: 0x00181080, Name: "Beat Rejection Flag", Keyword: "BeatRejectionFlag", VR: "CS", VM: "1"},
+	{Tag: 0x00181081, Name: "Low R-R Value", Keyword: "LowRRValue", VR: "IS", VM: "1"},
+	{Tag: 0x00181082, Name: "High R-R Value", Keyword: "HighRRValue", VR: "IS", VM: "1"},
+	{Tag: 0x00181083, Name: "Intervals Acquired", Keyword: "IntervalsAcquired", VR: "IS", VM: "1"},
+	{Tag: 0x00181084, Name: "Intervals Rejected", Keyword: "IntervalsRejected", VR: "IS", VM: "1"},
+	{Tag: 0x00181085, Name: "PVC Rejection", Keyword: "PVCRejection", VR: "LO", VM: "1"},
+	{Tag: 0x00181086, Name: "Skip Beats", Keyword: "SkipBeats", VR: "IS", VM: "1"},
+	{Tag: 0x00181088, Name: "Heart Rate", Keyword: "HeartRate", VR: "IS", VM: "1"},
+	{Tag: 0x00181090, Name: "Cardiac Number of Images", Keyword: "CardiacNumberOfImages", VR: "IS", VM: "1"},
+	{Tag: 0x00181094, Name: "Trigger Window", Keyword: "TriggerWindow", VR: "IS", VM: "1"},
+	{Tag: 0x00181100, Name: "Reconstruction Diameter", Keyword: "ReconstructionDiameter", VR: "DS", VM: "1"},
+	{Tag: 0x00181110, Name: "Distance Source to Detector", Keyword: "DistanceSourceToDetector", VR: "DS", VM: "1"},
+	{Tag: 0x00181111, Name: "Distance Source to Patient", Keyword: "DistanceSourceToPatient", VR: "DS", VM: "1"},
+	{Tag: 0x00181114, Name: "Estimated Radiographic Magnification Factor", Keyword: "EstimatedRadiographicMagnificationFactor", VR: "DS", VM: "1"},
+	{Tag: 0x00181120, Name: "Gantry/Detector Tilt", Keyword: "GantryDetectorTilt", VR: "DS", VM: "1"},
+	{Tag: 0x00181121, Name: "Gantry/Detector Slew", Keyword: "GantryDetectorSlew", VR: "DS", VM: "1"},
+	{Tag: 0x00181130, Name: "Table Height", Keyword: "TableHeight", VR: "DS", VM: "1"},
+	{Tag: 0x00181131, Name: "Table Traverse", Keyword: "TableTraverse", VR: "DS", VM: "1"},
+	{Tag: 0x00181134, Name: "Table Motion", Keyword: "TableMotion", VR: "CS", VM: "1"},
+	{Tag: 0x00181135, Name: "Table Vertical Increment", Keyword: "TableVerticalIncrement", VR: "DS", VM: "1-n"},
+	{Tag: 0x00181136, Name: "Table Lateral Increment", Keyword: "TableLateralIncrement", VR: "DS", VM: "1-n"},
+	{Tag: 0x00181137, Name: "Table Longitudinal Increment", Keyword: "TableLongitudinalIncrement", VR: "DS", VM: "1-n"},
+	{Tag: 0x00181138, Name: "Table Angle", Keyword: "TableAngle", VR: "DS", VM: "1"},
+	{Tag: 0x0018113A, Name: "Table Type", Keyword: "TableType", VR: "CS", VM: "1"},
+	{Tag: 0x00181140, Name: "Rotation Direction", Keyword: "RotationDirection", VR: "CS", VM: "1"},
+	{Tag: 0x00181141, Name: "Angular Position", Keyword: "AngularPosition", VR: "DS", VM: "1", Retired: true},
+	{Tag: 0x00181142, Name: "Radial Position", Keyword: "RadialPosition", VR: "DS", VM: "1-n"},
+	{Tag: 0x00181143, Name: "Scan Arc", Keyword: "ScanArc", VR: "DS", VM: "1"},
+	{Tag: 0x00181144, Name: "Angular Step", Keyword: "AngularStep", VR: "DS", VM: "1"},
+	{Tag: 0x00181145, Name: "Center of Rotation Offset", Keyword: "CenterOfRotationOffset", VR: "DS", VM: "1"},
+	{Tag: 0x00181146, Name: "Rotation Offset", Keyword: "RotationOffset", VR: "DS", VM: "1-n", Retired: true},
+	{Tag: 0x00181147, Name: "Field of View Shape", Keyword: "FieldOfViewShape", VR: "CS", VM: "1"},
+	{Tag: 0x00181149, Name: "Field of View Dimension(s)", Keyword: "FieldOfViewDimensions", VR: "IS", VM: "1-2"},
+	{Tag: 0x00181150, Name: "Exposure Time", Keyword: "ExposureTime", VR: "IS", VM: "1"},
+	{Tag: 0x00181151, Name: "X-Ray Tube Current", Keyword: "XRayTubeCurrent", VR: "IS", VM: "1"},
+	{Tag: 0x00181152, Name: "Exposure", Keyword: "Exposure", VR: "IS", VM: "1"},
+	{Tag: 0x00181153, Name: "Exposure in µAs", Keyword: "ExposureInuAs", VR: "IS", VM: "1"},
+	{Tag: 0x00181154, Name: "Average Pulse Width", Keyword: "AveragePulseWidth", VR: "DS", VM: "1"},
+	{Tag: 0x00181155, Name: "Radiation Setting", Keyword: "RadiationSetting", VR: "CS", VM: "1"},
+	{Tag: 0x00181156, Name: "Rectification Type", Keyword: "RectificationType", VR: "CS", VM: "1"},
+	{Tag: 0x0018115A, Name: "Radiation Mode", Keyword: "RadiationMode", VR: "CS", VM: "1"},
+	{Tag: 0x0018115E, Name: "Image and Fluoroscopy Area Dose Product", Keyword: "ImageAndFluoroscopyAreaDoseProduct", VR: "DS", VM: "1"},
+	{Tag: 0x00181160, Name: "Filter Type", Keyword: "FilterType", VR: "SH", VM: "1"},
+	{Tag: 0x00181161, Name: "Type of Filters", Keyword: "TypeOfFilters", VR: "LO", VM: "1-n"},
+	{Tag: 0x00181162, Name: "Intensifier Size", Keyword: "IntensifierSize", VR: "DS", VM: "1"},
+	{Tag: 0x00181164, Name: "Imager Pixel Spacing", Keyword: "ImagerPixelSpacing", VR: "DS", VM: "2"},
+	{Tag: 0x00181166, Name: "Grid", Keyword: "Grid", VR: "CS", VM: "1-n"},
+	{Tag: 0x00181170, Name: "Generator Power", Keyword: "GeneratorPower", VR: "IS", VM: "1"},
+	{Tag: 0x00181180, Name: "Collimator/grid Name", Keyword: "CollimatorGridName", VR: "SH", VM: "1"},
+	{Tag: 0x00181181, Name: "Collimator Type", Keyword: "CollimatorType", VR: "CS", VM: "1"},
+	{Tag: 0x00181182, Name: "Focal Distance", Keyword: "FocalDistance", VR: "IS", VM: "1-2"},
+	{Tag: 0x00181183, Name: "X Focus Center", Keyword: "XFocusCenter", VR: "DS", VM: "1-2"},
+	{Tag: 0x00181184, Name: "Y Focus Center", Keyword: "YFocusCenter", VR: "DS", VM: "1-2"},
+	{Tag: 0x00181190, Name: "Focal Spot(s)", Keyword: "FocalSpots", VR: "DS", VM: "1-n"},
+	{Tag: 0x00181191, Name: "Anode Target Material", Keyword: "AnodeTargetMaterial", VR: "CS", VM: "1"},
+	{Tag: 0x001811A0, Name: "Body Part Thickness", Keyword: "BodyPartThickness", VR: "DS", VM: "1"},
+	{Tag: 0x001811A2, Name: "Compression Force", Keyword: "CompressionForce", VR: "DS", VM: "1"},
+	{Tag: 0x001811A3, Name: "Compression Pressure", Keyword: "CompressionPressure", VR: "DS", VM: "1"},
+	{Tag: 0x001811A4, Name: "Paddle Description", Keyword: "PaddleDescription", VR: "LO", VM: "1"},
+	{Tag: 0x001811A5, Name: "Compression Contact Area", Keyword: "CompressionContactArea", VR: "DS", VM: "1"},
+	{Tag: 0x001811B0, Name: "Acquisition Mode", Keyword: "AcquisitionMode", VR: "LO", VM: "1"},
+	{Tag: 0x001811B1, Name: "Dose Mode Name", Keyword: "DoseModeName", VR: "LO", VM: "1"},
+	{Tag: 0x001811B2, Name: "Acquired Subtraction Mask Flag", Keyword: "AcquiredSubtractionMaskFlag", VR: "CS", VM: "1"},
+	{Tag: 0x001811B3, Name: "Fluoroscopy Persistence Flag", Keyword: "FluoroscopyPersistenceFlag", VR: "CS", VM: "1"},
+	{Tag: 0x001811B4, Name: "Fluoroscopy Last Image Hold Persistence Flag", Keyword: "FluoroscopyLastImageHoldPersistenceFlag", VR: "CS", VM: "1"},
+	{Tag: 0x001811B5, Name: "Upper Limit Number Of Persistent Fluoroscopy Frames", Keyword: "UpperLimitNumberOfPersistentFluoroscopyFrames", VR: "IS", VM: "1"},
+	{Tag: 0x001811B6, Name: "Contrast/Bolus Auto Injection Trigger Flag", Keyword: "ContrastBolusAutoInjectionTriggerFlag", VR: "CS", VM: "1"},
+	{Tag: 0x001811B7, Name: "Contrast/Bolus Injection Delay", Keyword: "ContrastBolusInjectionDelay", VR: "FD", VM: "1"},
+	{Tag: 0x001811B8, Name: "XA Acquisition Phase Details Sequence", Keyword: "XAAcquisitionPhaseDetailsSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x001811B9, Name: "XA Acquisition Frame Rate", Keyword: "XAAcquisitionFrameRate", VR: "FD", VM: "1"},
+	{Tag: 0x001811BA, Name: "XA Plane Details Sequence", Keyword: "XAPlaneDetailsSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x001811BB, Name: "Acquisition Field of View Label", Keyword: "AcquisitionFieldOfViewLabel", VR: "LO", VM: "1"},
+	{Tag: 0x001811BC, Name: "X-Ray Filter Details Sequence", Keyword: "XRayFilterDetailsSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x001811BD, Name: "XA Acquisition Duration", Keyword: "XAAcquisitionDuration", VR: "FD", VM: "1"},
+	{Tag: 0x001811BE, Name: "Reconstruction Pipeline Type", Keyword: "ReconstructionPipelineType", VR: "CS", VM: "1"},
+	{Tag: 0x001811BF, Name: "Image Filter Details Sequence", Keyword: "ImageFilterDetailsSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x001811C0, Name: "Applied Mask Subtraction Flag", Keyword: "AppliedMaskSubtractionFlag", VR: "CS", VM: "1"},
+	{Tag: 0x001811C1, Name: "Requested Series Description Code Sequence", Keyword: "RequestedSeriesDescriptionCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00181200, Name: "Date of Last Calibration", Keyword: "DateOfLastCalibration", VR: "DA", VM: "1-n"},
+	{Tag: 0x00181201, Name: "Time of Last Calibration", Keyword: "TimeOfLastCalibration", VR: "TM", VM: "1-n"},
+	{Tag: 0x00181202, Name: "DateTime of Last Calibration", Keyword: "DateTimeOfLastCalibration", VR: "DT", VM: "1"},
+	{Tag: 0x00181203, Name: "Calibration DateTime", Keyword: "CalibrationDateTime", VR: "DT", VM: "1"},
+	{Tag: 0x00181204, Name: "Date of Manufacture", Keyword: "DateOfManufacture", VR: "DA", VM: "1"},
+	{Tag: 0x00181205, Name: "Date of Installation", Keyword: "DateOfInstallation", VR: "DA", VM: "1"},
+	{Tag: 0x00181210, Name: "Convolution Kernel", Keyword: "ConvolutionKernel", VR: "SH", VM: "1-n"},
+	{Tag: 0x00181240, Name: "Upper/Lower Pixel Values", Keyword: "UpperLowerPixelValues", VR: "IS", VM: "1-n", Retired: true},
+	{Tag: 0x00181242, Name: "Actual Frame Duration", Keyword: "ActualFrameDuration", VR: "IS", VM: "1"},
+	{Tag: 0x00181243, Name: "Count Rate", Keyword: "CountRate", VR: "IS", VM: "1"},
+	{Tag: 0x00181244, Name: "Preferred Playback Sequencing", Keyword: "PreferredPlaybackSequencing", VR: "US", VM: "1"},
+	{Tag: 0x00181250, Name: "Receive Coil Name", Keyword: "ReceiveCoilName", VR: "SH", VM: "1"},
+	{Tag: 0x00181251, Name: "Transmit Coil Name", Keyword: "TransmitCoilName", VR: "SH", VM: "1"},
+	{Tag: 0x00181260, Name: "Plate Type", Keyword: "PlateType", VR: "SH", VM: "1"},
+	{Tag: 0x00181261, Name: "Phosphor Type", Keyword: "PhosphorType", VR: "LO", VM: "1"},
+	{Tag: 0x00181271, Name: "Water Equivalent Diameter", Keyword: "WaterEquivalentDiameter", VR: "FD", VM: "1"},
+	{Tag: 0x00181272, Name: "Water Equivalent Diameter Calculation Method Code Sequence", Keyword: "WaterEquivalentDiameterCalculationMethodCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00181300, Name: "Scan Velocity", Keyword: "ScanVelocity", VR: "DS", VM: "1"},
+	{Tag: 0x00181301, Name: "Whole Body Technique", Keyword: "WholeBodyTechnique", VR: "CS", VM: "1-n"},
+	{Tag: 0x00181302, Name: "Scan Length", Keyword: "ScanLength", VR: "IS", VM: "1"},
+	{Tag: 0x00181310, Name: "Acquisition Matrix", Keyword: "AcquisitionMatrix", VR: "US", VM: "4"},
+	{Tag: 0x00181312, Name: "In-plane Phase Encoding Direction", Keyword: "InPlanePhaseEncodingDirection", VR: "CS", VM: "1"},
+	{Tag: 0x00181314, Name: "Flip Angle", Keyword: "FlipAngle", VR: "DS", VM: "1"},
+	{Tag: 0x00181315, Name: "Variable Flip Angle Flag", Keyword: "VariableFlipAngleFlag", VR: "CS", VM: "1"},
+	{Tag: 0x00181316, Name: "SAR", Keyword: "SAR", VR: "DS", VM: "1"},
+	{Tag: 0x00181318, Name: "dB/dt", Keyword: "dBdt", VR: "DS", VM: "1"},
+	{Tag: 0x00181320, Name: "B1rms", Keyword: "B1rms", VR: "FL", VM: "1"},
+	{Tag: 0x00181400, Name: "Acquisition Device Processing Description", Keyword: "AcquisitionDeviceProcessingDescription", VR: "LO", VM: "1"},
+	{Tag: 0x00181401, Name: "Acquisition Device Processing Code", Keyword: "AcquisitionDeviceProcessingCode", VR: "LO", VM: "1"},
+	{Tag: 0x00181402, Name: "Cassette Orientation", Keyword: "CassetteOrientation", VR: "CS", VM: "1"},
+	{Tag: 0x00181403, Name: "Cassette Size", Keyword: "CassetteSize", VR: "CS", VM: "1"},
+	{Tag: 0x00181404, Name: "Exposures on Plate", Keyword: "ExposuresOnPlate", VR: "US", VM: "1"},
+	{Tag: 0x00181405, Name: "Relative X-Ray Exposure", Keyword: "RelativeXRayExposure", VR: "IS", VM: "1"},
+	{Tag: 0x00181411, Name: "Exposure Index", Keyword: "ExposureIndex", VR: "DS", VM: "1"},
+	{Tag: 0x00181412, Name: "Target Exposure Index", Keyword: "TargetExposureIndex", VR: "DS", VM: "1"},
+	{Tag: 0x00181413, Name: "Deviation Index", Keyword: "DeviationIndex", VR: "DS", VM: "1"},
+	{Tag: 0x00181450, Name: "Column Angulation", Keyword: "ColumnAngulation", VR: "DS", VM: "1"},
+	{Tag: 0x00181460, Name: "Tomo Layer Height", Keyword: "TomoLayerHeight", VR: "DS", VM: "1"},
+	{Tag: 0x00181470, Name: "Tomo Angle", Keyword: "TomoAngle", VR: "DS", VM: "1"},
+	{Tag: 0x00181480, Name: "Tomo Time", Keyword: "TomoTime", VR: "DS", VM: "1"},
+	{Tag: 0x00181490, Name: "Tomo Type", Keyword: "TomoType", VR: "CS", VM: "1"},
+	{Tag: 0x00181491, Name: "Tomo Class", Keyword: "TomoClass", VR: "CS", VM: "1"},
+	{Tag: 0x00181495, Name: "Number of Tomosynthesis Source Images", Keyword: "NumberOfTomosynthesisSourceImages", VR: "IS", VM: "1"},
+	{Tag: 0x00181500, Name: "Positioner Motion", Keyword: "PositionerMotion", VR: "CS", VM: "1"},
+	{Tag: 0x00181508, Name: "Positioner Type", Keyword: "PositionerType", VR: "CS", VM: "1"},
+	{Tag: 0x00181510, Name: "Positioner Primary Angle", Keyword: "PositionerPrimaryAngle", VR: "DS", VM: "1"},
+	{Tag: 0x00181511, Name: "Positioner Secondary Angle", Keyword: "PositionerSecondaryAngle", VR: "DS", VM: "1"},
+	{Tag: 0x00181520, Name: "Positioner Primary Angle Increment", Keyword: "PositionerPrimaryAngleIncrement", VR: "DS", VM: "1-n"},
+	{Tag: 0x00181521, Name: "Positioner Secondary Angle Increment", Keyword: "PositionerSecondaryAngleIncrement", VR: "DS", VM: "1-n"},
+	{Tag: 0x00181530, Name: "Detector Primary Angle", Keyword: "DetectorPrimaryAngle", VR: "DS", VM: "1"},
+	{Tag: 0x00181531, Name: "Detector Secondary Angle", Keyword: "DetectorSecondaryAngle", VR: "DS", VM: "1"},
+	{Tag: 0x00181600, Name: "Shutter Shape", Keyword: "ShutterShape", VR: "CS", VM: "1-3"},
+	{Tag: 0x00181602, Name: "Shutter Left Vertical Edge", Keyword: "ShutterLeftVerticalEdge", VR: "IS", VM: "1"},
+	{Tag: 0x00181604, Name: "Shutter Right Vertical Edge", Keyword: "ShutterRightVerticalEdge", VR: "IS", VM: "1"},
+	{Tag: 0x00181606, Name: "Shutter Upper Horizontal Edge", Keyword: "ShutterUpperHorizontalEdge", VR: "IS", VM: "1"},
+	{Tag: 0x00181608, Name: "Shutter Lower Horizontal Edge", Keyword: "ShutterLowerHorizontalEdge", VR: "IS", VM: "1"},
+	{Tag: 0x00181610, Name: "Center of Circular Shutter", Keyword: "CenterOfCircularShutter", VR: "IS", VM: "2"},
+	{Tag: 0x00181612, Name: "Radius of Circular Shutter", Keyword: "RadiusOfCircularShutter", VR: "IS", VM: "1"},
+	{Tag: 0x00181620, Name: "Vertices of the Polygonal Shutter", Keyword: "VerticesOfThePolygonalShutter", VR: "IS", VM: "2-2n"},
+	{Tag: 0x00181622, Name: "Shutter Presentation Value", Keyword: "ShutterPresentationValue", VR: "US", VM: "1"},
+	{Tag: 0x00181623, Name: "Shutter Overlay Group", Keyword: "ShutterOverlayGroup", VR: "US", VM: "1"},
+	{Tag: 0x00181624, Name: "Shutter Presentation Color CIELab Value", Keyword: "ShutterPresentationColorCIELabValue", VR: "US", VM: "3"},
+	{Tag: 0x00181630, Name: "Outline Shape Type", Keyword: "OutlineShapeType", VR: "CS", VM: "1"},
+	{Tag: 0x00181631, Name: "Outline Left Vertical Edge", Keyword: "OutlineLeftVerticalEdge", VR: "FD", VM: "1"},
+	{Tag: 0x00181632, Name: "Outline Right Vertical Edge", Keyword: "OutlineRightVerticalEdge", VR: "FD", VM: "1"},
+	{Tag: 0x00181633, Name: "Outline Upper Horizontal Edge", Keyword: "OutlineUpperHorizontalEdge", VR: "FD", VM: "1"},
+	{Tag: 0x00181634, Name: "Outline Lower Horizontal Edge", Keyword: "OutlineLowerHorizontalEdge", VR: "FD", VM: "1"},
+	{Tag: 0x00181635, Name: "Center of Circular Outline", Keyword: "CenterOfCircularOutline", VR: "FD", VM: "2"},
+	{Tag: 0x00181636, Name: "Diameter of Circular Outline", Keyword: "DiameterOfCircularOutline", VR: "FD", VM: "1"},
+	{Tag: 0x00181637, Name: "Number of Polygonal Vertices", Keyword: "NumberOfPolygonalVertices", VR: "UL", VM: "1"},
+	{Tag: 0x00181638, Name: "Vertices of the Polygonal Outline", Keyword: "VerticesOfThePolygonalOutline", VR: "OF", VM: "1"},
+	{Tag: 0x00181700, Name: "Collimator Shape", Keyword: "CollimatorShape", VR: "CS", VM: "1-3"},
+	{Tag: 0x00181702, Name: "Collimator Left Vertical Edge", Keyword: "CollimatorLeftVerticalEdge", VR: "IS", VM: "1"},
+	{Tag: 0x00181704, Name: "Collimator Right Vertical Edge", Keyword: "CollimatorRightVerticalEdge", VR: "IS", VM: "1"},
+	{Tag: 0x00181706, Name: "Collimator Upper Horizontal Edge", Keyword: "CollimatorUpperHorizontalEdge", VR: "IS", VM: "1"},
+	{Tag: 0x00181708, Name: "Collimator Lower Horizontal Edge", Keyword: "CollimatorLowerHorizontalEdge", VR: "IS", VM: "1"},
+	{Tag: 0x00181710, Name: "Center of Circular Collimator", Keyword: "CenterOfCircularCollimator", VR: "IS", VM: "2"},
+	{Tag: 0x00181712, Name: "Radius of Circular Collimator", Keyword: "RadiusOfCircularCollimator", VR: "IS", VM: "1"},
+	{Tag: 0x00181720, Name: "Vertices of the Polygonal Collimator", Keyword: "VerticesOfThePolygonalCollimator", VR: "IS", VM: "2-2n"},
+	{Tag: 0x00181800, Name: "Acquisition Time Synchronized", Keyword: "AcquisitionTimeSynchronized", VR: "CS", VM: "1"},
+	{Tag: 0x00181801, Name: "Time Source", Keyword: "TimeSource", VR: "SH", VM: "1"},
+	{Tag: 0x00181802, Name: "Time Distribution Protocol", Keyword: "TimeDistributionProtocol", VR: "CS", VM: "1"},
+	{Tag: 0x00181803, Name: "NTP Source Address", Keyword: "NTPSourceAddress", VR: "LO", VM: "1"},
+	{Tag: 0x00182001, Name: "Page Number Vector", Keyword: "PageNumberVector", VR: "IS", VM: "1-n"},
+	{Tag: 0x00182002, Name: "Frame Label Vector", Keyword: "FrameLabelVector", VR: "SH", VM: "1-n"},
+	{Tag: 0x00182003, Name: "Frame Primary Angle Vector", Keyword: "FramePrimaryAngleVector", VR: "DS", VM: "1-n"},
+	{Tag: 0x00182004, Name: "Frame Secondary Angle Vector", Keyword: "FrameSecondaryAngleVector", VR: "DS", VM: "1-n"},
+	{Tag: 0x00182005, Name: "Slice Location Vector", Keyword: "SliceLocationVector", VR: "DS", VM: "1-n"},
+	{Tag: 0x00182006, Name: "Display Window Label Vector", Keyword: "DisplayWindowLabelVector", VR: "SH", VM: "1-n"},
+	{Tag: 0x00182010, Name: "Nominal Scanned Pixel Spacing", Keyword: "NominalScannedPixelSpacing", VR: "DS", VM: "2"},
+	{Tag: 0x00182020, Name: "Digitizing Device Transport Direction", Keyword: "DigitizingDeviceTransportDirection", VR: "CS", VM: "1"},
+	{Tag: 0x00182030, Name: "Rotation of Scanned Film", Keyword: "RotationOfScannedFilm", VR: "DS", VM: "1"},
+	{Tag: 0x00182041, Name: "Biopsy Target Sequence", Keyword: "BiopsyTargetSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00182042, Name: "Target UID", Keyword: "TargetUID", VR: "UI", VM: "1"},
+	{Tag: 0x00182043, Name: "Localizing Cursor Position", Keyword: "LocalizingCursorPosition", VR: "FL", VM: "2"},
+	{Tag: 0x00182044, Name: "Calculated Target Position", Keyword: "CalculatedTargetPosition", VR: "FL", VM: "3"},
+	{Tag: 0x00182045, Name: "Target Label", Keyword: "TargetLabel", VR: "SH", VM: "1"},
+	{Tag: 0x00182046, Name: "Displayed Z Value", Keyword: "DisplayedZValue", VR: "FL", VM: "1"},
+	{Tag: 0x00183100, Name: "IVUS Acquisition", Keyword: "IVUSAcquisition", VR: "CS", VM: "1"},
+	{Tag: 0x00183101, Name: "IVUS Pullback Rate", Keyword: "IVUSPullbackRate", VR: "DS", VM: "1"},
+	{Tag: 0x00183102, Name: "IVUS Gated Rate", Keyword: "IVUSGatedRate", VR: "DS", VM: "1"},
+	{Tag: 0x00183103, Name: "IVUS Pullback Start Frame Number", Keyword: "IVUSPullbackStartFrameNumber", VR: "IS", VM: "1"},
+	{Tag: 0x00183104, Name: "IVUS Pullback Stop Frame Number", Keyword: "IVUSPullbackStopFrameNumber", VR: "IS", VM: "1"},
+	{Tag: 0x00183105, Name: "Lesion Number", Keyword: "LesionNumber", VR: "IS", VM: "1-n"},
+	{Tag: 0x00184000, Name: "Acquisition Comments", Keyword: "AcquisitionComments", VR: "LT", VM: "1", Retired: true},
+	{Tag: 0x00185000, Name: "Output Power", Keyword: "OutputPower", VR: "SH", VM: "1-n"},
+	{Tag: 0x00185010, Name: "Transducer Data", Keyword: "TransducerData", VR: "LO", VM: "1-n"},
+	{Tag: 0x00185011, Name: "Transducer Identification Sequence", Keyword: "TransducerIdentificationSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00185012, Name: "Focus Depth", Keyword: "FocusDepth", VR: "DS", VM: "1"},
+	{Tag: 0x00185020, Name: "Processing Function", Keyword: "ProcessingFunction", VR: "LO", VM: "1"},
+	{Tag: 0x00185021, Name: "Postprocessing Function", Keyword: "PostprocessingFunction", VR: "LO", VM: "1", Retired: true},
+	{Tag: 0x00185022, Name: "Mechanical Index", Keyword: "MechanicalIndex", VR: "DS", VM: "1"},
+	{Tag: 0x00185024, Name: "Bone Thermal Index", Keyword: "BoneThermalIndex", VR: "DS", VM: "1"},
+	{Tag: 0x00185026, Name: "Cranial Thermal Index", Keyword: "CranialThermalIndex", VR: "DS", VM: "1"},
+	{Tag: 0x00185027, Name: "Soft Tissue Thermal Index", Keyword: "SoftTissueThermalIndex", VR: "DS", VM: "1"},
+	{Tag: 0x00185028, Name: "Soft Tissue-focus Thermal Index", Keyword: "SoftTissueFocusThermalIndex", VR: "DS", VM: "1"},
+	{Tag: 0x00185029, Name: "Soft Tissue-surface Thermal Index", Keyword: "SoftTissueSurfaceThermalIndex", VR: "DS", VM: "1"},
+	{Tag: 0x00185030, Name: "Dynamic Range", Keyword: "DynamicRange", VR: "DS", VM: "1", Retired: true},
+	{Tag: 0x00185040, Name: "Total Gain", Keyword: "TotalGain", VR: "DS", VM: "1", Retired: true},
+	{Tag: 0x00185050, Name: "Depth of Scan Field", Keyword: "DepthOfScanField", VR: "IS", VM: "1"},
+	{Tag: 0x00185100, Name: "Patient Position", Keyword: "PatientPosition", VR: "CS", VM: "1"},
+	{Tag: 0x00185101, Name: "View Position", Keyword: "ViewPosition", VR: "CS", VM: "1"},
+	{Tag: 0x00185104, Name: "Projection Eponymous Name Code Sequence", Keyword: "ProjectionEponymousNameCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00185210, Name: "Image Transformation Matrix", Keyword: "ImageTransformationMatrix", VR: "DS", VM: "6", Retired: true},
+	{Tag: 0x00185212, Name: "Image Translation Vector", Keyword: "ImageTranslationVector", VR: "DS", VM: "3", Retired: true},
+	{Tag: 0x00186000, Name: "Sensitivity", Keyword: "Sensitivity", VR: "DS", VM: "1"},
+	{Tag: 0x00186011, Name: "Sequence of Ultrasound Regions", Keyword: "SequenceOfUltrasoundRegions", VR: "SQ", VM: "1"},
+	{Tag: 0x00186012, Name: "Region Spatial Format", Keyword: "RegionSpatialFormat", VR: "US", VM: "1"},
+	{Tag: 0x00186014, Name: "Region Data Type", Keyword: "RegionDataType", VR: "US", VM: "1"},
+	{Tag: 0x00186016, Name: "Region Flags", Keyword: "RegionFlags", VR: "UL", VM: "1"},
+	{Tag: 0x00186018, Name: "Region Location Min X0", Keyword: "RegionLocationMinX0", VR: "UL", VM: "1"},
+	{Tag: 0x0018601A, Name: "Region Location Min Y0", Keyword: "RegionLocationMinY0", VR: "UL", VM: "1"},
+	{Tag: 0x0018601C, Name: "Region Location Max X1", Keyword: "RegionLocationMaxX1", VR: "UL", VM: "1"},
+	{Tag: 0x0018601E, Name: "Region Location Max Y1", Keyword: "RegionLocationMaxY1", VR: "UL", VM: "1"},
+	{Tag: 0x00186020, Name: "Reference Pixel X0", Keyword: "ReferencePixelX0", VR: "SL", VM: "1"},
+	{Tag: 0x00186022, Name: "Reference Pixel Y0", Keyword: "ReferencePixelY0", VR: "SL", VM: "1"},
+	{Tag: 0x00186024, Name: "Physical Units X Direction", Keyword: "PhysicalUnitsXDirection", VR: "US", VM: "1"},
+	{Tag: 0x00186026, Name: "Physical Units Y Direction", Keyword: "PhysicalUnitsYDirection", VR: "US", VM: "1"},
+	{Tag: 0x00186028, Name: "Reference Pixel Physical Value X", Keyword: "ReferencePixelPhysicalValueX", VR: "FD", VM: "1"},
+	{Tag: 0x0018602A, Name: "Reference Pixel Physical Value Y", Keyword: "ReferencePixelPhysicalValueY", VR: "FD", VM: "1"},
+	{Tag: 0x0018602C, Name: "Physical Delta X", Keyword: "PhysicalDeltaX", VR: "FD", VM: "1"},
+	{Tag: 0x0018602E, Name: "Physical Delta Y", Keyword: "PhysicalDeltaY", VR: "FD", VM: "1"},
+	{Tag: 0x00186030, Name: "Transducer Frequency", Keyword: "TransducerFrequency", VR: "UL", VM: "1"},
+	{Tag: 0x00186031, Name: "Transducer Type", Keyword: "TransducerType", VR: "CS", VM: "1"},
+	{Tag: 0x00186032, Name: "Pulse Repetition Frequency", Keyword: "PulseRepetitionFrequency", VR: "UL", VM: "1"},
+	{Tag: 0x00186034, Name: "Doppler Correction Angle", Keyword: "DopplerCorrectionAngle", VR: "FD", VM: "1"},
+	{Tag: 0x00186036, Name: "Steering Angle", Keyword: "SteeringAngle", VR: "FD", VM: "1"},
+	{Tag: 0x00186038, Name: "Doppler Sample Volume X Position (Retired)", Keyword: "DopplerSampleVolumeXPositionRetired", VR: "UL", VM: "1", Retired: true},
+	{Tag: 0x00186039, Name: "Doppler Sample Volume X Position", Keyword: "DopplerSampleVolumeXPosition", VR: "SL", VM: "1"},
+	{Tag: 0x0018603A, Name: "Doppler Sample Volume Y Position (Retired)", Keyword: "DopplerSampleVolumeYPositionRetired", VR: "UL", VM: "1", Retired: true},
+	{Tag: 0x0018603B, Name: "Doppler Sample Volume Y Position", Keyword: "DopplerSampleVolumeYPosition", VR: "SL", VM: "1"},
+	{Tag: 0x0018603C, Name: "TM-Line Position X0 (Retired)", Keyword: "TMLinePositionX0Retired", VR: "UL", VM: "1", Retired: true},
+	{Tag: 0x0018603D, Name: "TM-Line Position X0", Keyword: "TMLinePositionX0", VR: "SL", VM: "1"},
+	{Tag: 0x0018603E, Name: "TM-Line Position Y0 (Retired)", Keyword: "TMLinePositionY0Retired", VR: "UL", VM: "1", Retired: true},
+	{Tag: 0x0018603F, Name: "TM-Line Position Y0", Keyword: "TMLinePositionY0", VR: "SL", VM: "1"},
+	{Tag: 0x00186040, Name: "TM-Line Position X1 (Retired)", Keyword: "TMLinePositionX1Retired", VR: "UL", VM: "1", Retired: true},
+	{Tag: 0x00186041, Name: "TM-Line Position X1", Keyword: "TMLinePositionX1", VR: "SL", VM: "1"},
+	{Tag: 0x00186042, Name: "TM-Line Position Y1 (Retired)", Keyword: "TMLinePositionY1Retired", VR: "UL", VM: "1", Retired: true},
+	{Tag: 0x00186043, Name: "TM-Line Position Y1", Keyword: "TMLinePositionY1", VR: "SL", VM: "1"},
+	{Tag: 0x00186044, Name: "Pixel Component Organization", Keyword: "PixelComponentOrganization", VR: "US", VM: "1"},
+	{Tag: 0x00186046, Name: "Pixel Component Mask", Keyword: "PixelComponentMask", VR: "UL", VM: "1"},
+	{Tag: 0x00186048, Name: "Pixel Component Range Start", Keyword: "PixelComponentRangeStart", VR: "UL", VM: "1"},
+	{Tag: 0x0018604A, Name: "Pixel Component Range Stop", Keyword: "PixelComponentRangeStop", VR: "UL", VM: "1"},
+	{Tag: 0x0018604C, Name: "Pixel Component Physical Units", Keyword: "PixelComponentPhysicalUnits", VR: "US", VM: "1"},
+	{Tag: 0x0018604E, Name: "Pixel Component Data Type", Keyword: "PixelComponentDataType", VR: "US", VM: "1"},
+	{Tag: 0x00186050, Name: "Number of Table Break Points", Keyword: "NumberOfTableBreakPoints", VR: "UL", VM: "1"},
+	{Tag: 0x00186052, Name: "Table of X Break Points", Keyword: "TableOfXBreakPoints", VR: "UL", VM: "1-n"},
+	{Tag: 0x00186054, Name: "Table of Y Break Points", Keyword: "TableOfYBreakPoints", VR: "FD", VM: "1-n"},
+	{Tag: 0x00186056, Name: "Number of Table Entries", Keyword: "NumberOfTableEntries", VR: "UL", VM: "1"},
+	{Tag: 0x00186058, Name: "Table of Pixel Values", Keyword: "TableOfPixelValues", VR: "UL", VM: "1-n"},
+	{Tag: 0x0018605A, Name: "Table of Parameter Values", Keyword: "TableOfParameterValues", VR: "FL", VM: "1-n"},
+	{Tag: 0x00186060, Name: "R Wave Time Vector", Keyword: "RWaveTimeVector", VR: "FL", VM: "1-n"},
+	{Tag: 0x00186070, Name: "Active Image Area Overlay Group", Keyword: "ActiveImageAreaOverlayGroup", VR: "US", VM: "1"},
+	{Tag: 0x00187000, Name: "Detector Conditions Nominal Flag", Keyword: "DetectorConditionsNominalFlag", VR: "CS", VM: "1"},
+	{Tag: 0x00187001, Name: "Detector Temperature", Keyword: "DetectorTemperature", VR: "DS", VM: "1"},
+	{Tag: 0x00187004, Name: "Detector Type", Keyword: "DetectorType", VR: "CS", VM: "1"},
+	{Tag: 0x00187005, Name: "Detector Configuration", Keyword: "DetectorConfiguration", VR: "CS", VM: "1"},
+	{Tag: 0x00187006, Name: "Detector Description", Keyword: "DetectorDescription", VR: "LT", VM: "1"},
+	{Tag: 0x00187008, Name: "Detector Mode", Keyword: "DetectorMode", VR: "LT", VM: "1"},
+	{Tag: 0x0018700A, Name: "Detector ID", Keyword: "DetectorID", VR: "SH", VM: "1"},
+	{Tag: 0x0018700C, Name: "Date of Last Detector Calibration", Keyword: "DateOfLastDetectorCalibration", VR: "DA", VM: "1"},
+	{Tag: 0x0018700E, Name: "Time of Last Detector Calibration", Keyword: "TimeOfLastDetectorCalibration", VR: "TM", VM: "1"},
+	{Tag: 0x00187010, Name: "Exposures on Detector Since Last Calibration", Keyword: "ExposuresOnDetectorSinceLastCalibration", VR: "IS", VM: "1"},
+	{Tag: 0x00187011, Name: "Exposures on Detector Since Manufactured", Keyword: "ExposuresOnDetectorSinceManufactured", VR: "IS", VM: "1"},
+	{Tag: 0x00187012, Name: "Detector Time Since Last Exposure", Keyword: "DetectorTimeSinceLastExposure", VR: "DS", VM: "1"},
+	{Tag: 0x00187014, Name: "Detector Active Time", Keyword: "DetectorActiveTime", VR: "DS", VM: "1"},
+	{Tag: 0x00187016, Name: "Detector Activation Offset From Exposure", Keyword: "DetectorActivationOffsetFromExposure", VR: "DS", VM: "1"},
+	{Tag: 0x0018701A, Name: "Detector Binning", Keyword: "DetectorBinning", VR: "DS", VM: "2"},
+	{Tag: 0x00187020, Name: "Detector Element Physical Size", Keyword: "DetectorElementPhysicalSize", VR: "DS", VM: "2"},
+	{Tag: 0x00187022, Name: "Detector Element Spacing", Keyword: "DetectorElementSpacing", VR: "DS", VM: "2"},
+	{Tag: 0x00187024, Name: "Detector Active Shape", Keyword: "DetectorActiveShape", VR: "CS", VM: "1"},
+	{Tag: 0x00187026, Name: "Detector Active Dimension(s)", Keyword: "DetectorActiveDimensions", VR: "DS", VM: "1-2"},
+	{Tag: 0x00187028, Name: "Detector Active Origin", Keyword: "DetectorActiveOrigin", VR: "DS", VM: "2"},
+	{Tag: 0x0018702A, Name: "Detector Manufacturer Name", Keyword: "DetectorManufacturerName", VR: "LO", VM: "1"},
+	{Tag: 0x0018702B, Name: "Detector Manufacturer's Model Name", Keyword: "DetectorManufacturerModelName", VR: "LO", VM: "1"},
+	{Tag: 0x00187030, Name: "Field of View Origin", Keyword: "FieldOfViewOrigin", VR: "DS", VM: "2"},
+	{Tag: 0x00187032, Name: "Field of View Rotation", Keyword: "FieldOfViewRotation", VR: "DS", VM: "1"},
+	{Tag: 0x00187034, Name: "Field of View Horizontal Flip", Keyword: "FieldOfViewHorizontalFlip", VR: "CS", VM: "1"},
+	{Tag: 0x00187036, Name: "Pixel Data Area Origin Relative To FOV", Keyword: "PixelDataAreaOriginRelativeToFOV", VR: "FL", VM: "2"},
+	{Tag: 0x00187038, Name: "Pixel Data Area Rotation Angle Relative To FOV", Keyword: "PixelDataAreaRotationAngleRelativeToFOV", VR: "FL", VM: "1"},
+	{Tag: 0x00187040, Name: "Grid Absorbing Material", Keyword: "GridAbsorbingMaterial", VR: "LT", VM: "1"},
+	{Tag: 0x00187041, Name: "Grid Spacing Material", Keyword: "GridSpacingMaterial", VR: "LT", VM: "1"},
+	{Tag: 0x00187042, Name: "Grid Thickness", Keyword: "GridThickness", VR: "DS", VM: "1"},
+	{Tag: 0x00187044, Name: "Grid Pitch", Keyword: "GridPitch", VR: "DS", VM: "1"},
+	{Tag: 0x00187046, Name: "Grid Aspect Ratio", Keyword: "GridAspectRatio", VR: "IS", VM: "2"},
+	{Tag: 0x00187048, Name: "Grid Period", Keyword: "GridPeriod", VR: "DS", VM: "1"},
+	{Tag: 0x0018704C, Name: "Grid Focal Distance", Keyword: "GridFocalDistance", VR: "DS", VM: "1"},
+	{Tag: 0x00187050, Name: "Filter Material", Keyword: "FilterMaterial", VR: "CS", VM: "1-n"},
+	{Tag: 0x00187052, Name: "Filter Thickness Minimum", Keyword: "FilterThicknessMinimum", VR: "DS", VM: "1-n"},
+	{Tag: 0x00187054, Name: "Filter Thickness Maximum", Keyword: "FilterThicknessMaximum", VR: "DS", VM: "1-n"},
+	{Tag: 0x00187056, Name: "Filter Beam Path Length Minimum", Keyword: "FilterBeamPathLengthMinimum", VR: "FL", VM: "1-n"},
+	{Tag: 0x00187058, Name: "Filter Beam Path Length Maximum", Keyword: "FilterBeamPathLengthMaximum", VR: "FL", VM: "1-n"},
+	{Tag: 0x00187060, Name: "Exposure Control Mode", Keyword: "ExposureControlMode", VR: "CS", VM: "1"},
+	{Tag: 0x00187062, Name: "Exposure Control Mode Description", Keyword: "ExposureControlModeDescription", VR: "LT", VM: "1"},
+	{Tag: 0x00187064, Name: "Exposure Status", Keyword: "ExposureStatus", VR: "CS", VM: "1"},
+	{Tag: 0x00187065, Name: "Phototimer Setting", Keyword: "PhototimerSetting", VR: "DS", VM: "1"},
+	{Tag: 0x00188150, Name: "Exposure Time in µS", Keyword: "ExposureTimeInuS", VR: "DS", VM: "1"},
+	{Tag: 0x00188151, Name: "X-Ray Tube Current in µA", Keyword: "XRayTubeCurrentInuA", VR: "DS", VM: "1"},
+	{Tag: 0x00189004, Name: "Content Qualification", Keyword: "ContentQualification", VR: "CS", VM: "1"},
+	{Tag: 0x00189005, Name: "Pulse Sequence Name", Keyword: "PulseSequenceName", VR: "SH", VM: "1"},
+	{Tag: 0x00189006, Name: "MR Imaging Modifier Sequence", Keyword: "MRImagingModifierSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00189008, Name: "Echo Pulse Sequence", Keyword: "EchoPulseSequence", VR: "CS", VM: "1"},
+	{Tag: 0x00189009, Name: "Inversion Recovery", Keyword: "InversionRecovery", VR: "CS", VM: "1"},
+	{Tag: 0x00189010, Name: "Flow Compensation", Keyword: "FlowCompensation", VR: "CS", VM: "1"},
+	{Tag: 0x00189011, Name: "Multiple Spin Echo", Keyword: "MultipleSpinEcho", VR: "CS", VM: "1"},
+	{Tag: 0x00189012, Name: "Multi-planar Excitation", Keyword: "MultiPlanarExcitation", VR: "CS", VM: "1"},
+	{Tag: 0x00189014, Name: "Phase Contrast", Keyword: "PhaseContrast", VR: "CS", VM: "1"},
+	{Tag: 0x00189015, Name: "Time of Flight Contrast", Keyword: "TimeOfFlightContrast", VR: "CS", VM: "1"},
+	{Tag: 0x00189016, Name: "Spoiling", Keyword: "Spoiling", VR: "CS", VM: "1"},
+	{Tag: 0x00189017, Name: "Steady State Pulse Sequence", Keyword: "SteadyStatePulseSequence", VR: "CS", VM: "1"},
+	{Tag: 0x00189018, Name: "Echo Planar Pulse Sequence", Keyword: "EchoPlanarPulseSequence", VR: "CS", VM: "1"},
+	{Tag: 0x00189019, Name: "Tag Angle First Axis", Keyword: "TagAngleFirstAxis", VR: "FD", VM: "1"},
+	{Tag: 0x00189020, Name: "Magnetization Transfer", Keyword: "MagnetizationTransfer", VR: "CS", VM: "1"},
+	{Tag: 0x00189021, Name: "T2 Preparation", Keyword: "T2Preparation", VR: "CS", VM: "1"},
+	{Tag: 0x00189022, Name: "Blood Signal Nulling", Keyword: "BloodSignalNulling", VR: "CS", VM: "1"},
+	{Tag: 0x00189024, Name: "Saturation Recovery", Keyword: "SaturationRecovery", VR: "CS", VM: "1"},
+	{Tag: 0x00189025, Name: "Spectrally Selected Suppression", Keyword: "SpectrallySelectedSuppression", VR: "CS", VM: "1"},
+	{Tag: 0x00189026, Name: "Spectrally Selected Excitation", Keyword: "SpectrallySelectedExcitation", VR: "CS", VM: "1"},
+	{Tag: 0x00189027, Name: "Spatial Pre-saturation", Keyword: "SpatialPresaturation", VR: "CS", VM: "1"},
+	{Tag: 0x00189028, Name: "Tagging", Keyword: "Tagging", VR: "CS", VM: "1"},
+	{Tag: 0x00189029, Name: "Oversampling Phase", Keyword: "OversamplingPhase", VR: "CS", VM: "1"},
+	{Tag: 0x00189030, Name: "Tag Spacing First Dimension", Keyword: "TagSpacingFirstDimension", VR: "FD", VM: "1"},
+	{Tag: 0x00189032, Name: "Geometry of k-Space Traversal", Keyword: "GeometryOfKSpaceTraversal", VR: "CS", VM: "1"},
+	{Tag: 0x00189033, Name: "Segmented k-Space Traversal", Keyword: "SegmentedKSpaceTraversal", VR: "CS", VM: "1"},
+	{Tag: 0x00189034, Name: "Rectilinear Phase Encode Reordering", Keyword: "RectilinearPhaseEncodeReordering", VR: "CS", VM: "1"},
+	{Tag: 0x00189035, Name: "Tag Thickness", Keyword: "TagThickness", VR: "FD", VM: "1"},
+	{Tag: 0x00189036, Name: "Partial Fourier Direction", Keyword: "PartialFourierDirection", VR: "CS", VM: "1"},
+	{Tag: 0x00189037, Name: "Cardiac Synchronization Technique", Keyword: "CardiacSynchronizationTechnique", VR: "CS", VM: "1"},
+	{Tag: 0x00189041, Name: "Receive Coil Manufacturer Name", Keyword: "ReceiveCoilManufacturerName", VR: "LO", VM: "1"},
+	{Tag: 0x00189042, Name: "MR Receive Coil Sequence", Keyword: "MRReceiveCoilSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00189043, Name: "Receive Coil Type", Keyword: "ReceiveCoilType", VR: "CS", VM: "1"},
+	{Tag: 0x00189044, Name: "Quadrature Receive Coil", Keyword: "QuadratureReceiveCoil", VR: "CS", VM: "1"},
+	{Tag: 0x00189045, Name: "Multi-Coil Definition Sequence", Keyword: "MultiCoilDefinitionSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00189046, Name: "Multi-Coil Configuration", Keyword: "MultiCoilConfiguration", VR: "LO", VM: "1"},
+	{Tag: 0x00189047, Name: "Multi-Coil Element Name", Keyword: "MultiCoilElementName", VR: "SH", VM: "1"},
+	{Tag: 0x00189048, Name: "Multi-Coil Element Used", Keyword: "MultiCoilElementUsed", VR: "CS", VM: "1"},
+	{Tag: 0x00189049, Name: "MR Transmit Coil Sequence", Keyword: "MRTransmitCoilSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00189050, Name: "Transmit Coil Manufacturer Name", Keyword: "TransmitCoilManufacturerName", VR: "LO", VM: "1"},
+	{Tag: 0x00189051, Name: "Transmit Coil Type", Keyword: "TransmitCoilType", VR: "CS", VM: "1"},
+	{Tag: 0x00189052, Name: "Spectral Width", Keyword: "SpectralWidth", VR: "FD", VM: "1-2"},
+	{Tag: 0x00189053, Name: "Chemical Shift Reference", Keyword: "ChemicalShiftReference", VR: "FD", VM: "1-2"},
+	{Tag: 0x00189054, Name: "Volume Localization Technique", Keyword: "VolumeLocalizationTechnique", VR: "CS", VM: "1"},
+	{Tag: 0x00189058, Name: "MR Acquisition Frequency Encoding Steps", Keyword: "MRAcquisitionFrequencyEncodingSteps", VR: "US", VM: "1"},
+	{Tag: 0x00189059, Name: "De-coupling", Keyword: "Decoupling", VR: "CS", VM: "1"},
+	{Tag: 0x00189060, Name: "De-coupled Nucleus", Keyword: "DecoupledNucleus", VR: "CS", VM: "1-2"},
+	{Tag: 0x00189061, Name: "De-coupling Frequency", Keyword: "DecouplingFrequency", VR: "FD", VM: "1-2"},
+	{Tag: 0x00189062, Name: "De-coupling Method", Keyword: "DecouplingMethod", VR: "CS", VM: "1"},
+	{Tag: 0x00189063, Name: "De-coupling Chemical Shift Reference", Keyword: "DecouplingChemicalShiftReference", VR: "FD", VM: "1-2"},
+	{Tag: 0x00189064, Name: "k-space Filtering", Keyword: "KSpaceFiltering", VR: "CS", VM: "1"},
+	{Tag: 0x00189065, Name: "Time Domain Filtering", Keyword: "TimeDomainFiltering", VR: "CS", VM: "1-2"},
+	{Tag: 0x00189066, Name: "Number of Zero Fills", Keyword: "NumberOfZeroFills", VR: "US", VM: "1-2"},
+	{Tag: 0x00189067, Name: "Baseline Correction", Keyword: "BaselineCorrection", VR: "CS", VM: "1"},
+	{Tag: 0x00189069, Name: "Parallel Reduction Factor In-plane", Keyword: "ParallelReductionFactorInPlane", VR: "FD", VM: "1"},
+	{Tag: 0x00189070, Name: "Cardiac R-R Interval Specified", Keyword: "CardiacRRIntervalSpecified", VR: "FD", VM: "1"},
+	{Tag: 0x00189073, Name: "Acquisition Duration", Keyword: "AcquisitionDuration", VR: "FD", VM: "1"},
+	{Tag: 0x00189074, Name: "Frame Acquisition DateTime", Keyword: "FrameAcquisitionDateTime", VR: "DT", VM: "1"},
+	{Tag: 0x00189075, Name: "Diffusion Directionality", Keyword: "DiffusionDirectionality", VR: "CS", VM: "1"},
+	{Tag: 0x00189076, Name: "Diffusion Gradient Direction Sequence", Keyword: "DiffusionGradientDirectionSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00189077, Name: "Parallel Acquisition", Keyword: "ParallelAcquisition", VR: "CS", VM: "1"},
+	{Tag: 0x00189078, Name: "Parallel Acquisition Technique", Keyword: "ParallelAcquisitionTechnique", VR: "CS", VM: "1"},
+	{Tag: 0x00189079, Name: "Inversion Times", Keyword: "InversionTimes", VR: "FD", VM: "1-n"},
+	{Tag: 0x00189080, Name: "Metabolite Map Description", Keyword: "MetaboliteMapDescription", VR: "ST", VM: "1"},
+	{Tag: 0x00189081, Name: "Partial Fourier", Keyword: "PartialFourier", VR: "CS", VM: "1"},
+	{Tag: 0x00189082, Name: "Effective Echo Time", Keyword: "EffectiveEchoTime", VR: "FD", VM: "1"},
+	{Tag: 0x00189083, Name: "Metabolite Map Code Sequence", Keyword: "MetaboliteMapCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00189084, Name: "Chemical Shift Sequence", Keyword: "ChemicalShiftSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00189085, Name: "Cardiac Signal Source", Keyword: "CardiacSignalSource", VR: "CS", VM: "1"},
+	{Tag: 0x00189087, Name: "Diffusion b-value", Keyword: "DiffusionBValue", VR: "FD", VM: "1"},
+	{Tag: 0x00189089, Name: "Diffusion Gradient Orientation", Keyword: "DiffusionGradientOrientation", VR: "FD", VM: "3"},
+	{Tag: 0x00189090, Name: "Velocity Encoding Direction", Keyword: "VelocityEncodingDirection", VR: "FD", VM: "3"},
+	{Tag: 0x00189091, Name: "Velocity Encoding Minimum Value", Keyword: "VelocityEncodingMinimumValue", VR: "FD", VM: "1"},
+	{Tag: 0x00189092, Name: "Velocity Encoding Acquisition Sequence", Keyword: "VelocityEncodingAcquisitionSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00189093, Name: "Number of k-Space Trajectories", Keyword: "NumberOfKSpaceTrajectories", VR: "US", VM: "1"},
+	{Tag: 0x00189094, Name: "Coverage of k-Space", Keyword: "CoverageOfKSpace", VR: "CS", VM: "1"},
+	{Tag: 0x00189095, Name: "Spectroscopy Acquisition Phase Rows", Keyword: "SpectroscopyAcquisitionPhaseRows", VR: "UL", VM: "1"},
+	{Tag: 0x00189096, Name: "Parallel Reduction Factor In-plane (Retired)", Keyword: "ParallelReductionFactorInPlaneRetired", VR: "FD", VM: "1", Retired: true},
+	{Tag: 0x00189098, Name: "Transmitter Frequency", Keyword: "TransmitterFrequency", VR: "FD", VM: "1-2"},
+	{Tag: 0x00189100, Name: "Resonant Nucleus", Keyword: "ResonantNucleus", VR: "CS", VM: "1-2"},
+	{Tag: 0x00189101, Name: "Frequency Correction", Keyword: "FrequencyCorrection", VR: "CS", VM: "1"},
+	{Tag: 0x00189103, Name: "MR Spectroscopy FOV/Geometry Sequence", Keyword: "MRSpectroscopyFOVGeometrySequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00189104, Name: "Slab Thickness", Keyword: "SlabThickness", VR: "FD", VM: "1"},
+	{Tag: 0x00189105, Name: "Slab Orientation", Keyword: "SlabOrientation", VR: "FD", VM: "3"},
+	{Tag: 0x00189106, Name: "Mid Slab Position", Keyword: "MidSlabPosition", VR: "FD", VM: "3"},
+	{Tag: 0x00189107, Name: "MR Spatial Saturation Sequence", Keyword: "MRSpatialSaturationSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00189112, Name: "MR Timing and Related Parameters Sequence", Keyword: "MRTimingAndRelatedParametersSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00189114, Name: "MR Echo Sequence", Keyword: "MREchoSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00189115, Name: "MR Modifier Sequence", Keyword: "MRModifierSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00189117, Name: "MR Diffusion Sequence", Keyword: "MRDiffusionSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00189118, Name: "Cardiac Synchronization Sequence", Keyword: "CardiacSynchronizationSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00189119, Name: "MR Averages Sequence", Keyword: "MRAveragesSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00189125, Name: "MR FOV/Geometry Sequence", Keyword: "MRFOVGeometrySequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00189126, Name: "Volume Localization Sequence", Keyword: "VolumeLocalizationSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00189127, Name: "Spectroscopy Acquisition Data Columns", Keyword: "SpectroscopyAcquisitionDataColumns", VR: "UL", VM: "1"},
+	{Tag: 0x00189147, Name: "Diffusion Anisotropy Type", Keyword: "DiffusionAnisotropyType", VR: "CS", VM: "1"},
+	{Tag: 0x00189151, Name: "Frame Reference DateTime", Keyword: "FrameReferenceDateTime", VR: "DT", VM: "1"},
+	{Tag: 0x00189152, Name: "MR Metabolite Map Sequence", Keyword: "MRMetaboliteMapSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00189155, Name: "Parallel Reduction Factor out-of-plane", Keyword: "ParallelReductionFactorOutOfPlane", VR: "FD", VM: "1"},
+	{Tag: 0x00189159, Name: "Spectroscopy Acquisition Out-of-plane Phase Steps", Keyword: "SpectroscopyAcquisitionOutOfPlanePhaseSteps", VR: "UL", VM: "1"},
+	{Tag: 0x00189166, Name: "Bulk Motion Status", Keyword: "BulkMotionStatus", VR: "CS", VM: "1", Retired: true},
+	{Tag: 0x00189168, Name: "Parallel Reduction Factor Second In-plane", Keyword: "ParallelReductionFactorSecondInPlane", VR: "FD", VM: "1"},
+	{Tag: 0x00189169, Name: "Cardiac Beat Rejection Technique", Keyword: "CardiacBeatRejectionTechnique", VR: "CS", VM: "1"},
+	{Tag: 0x00189170, Name: "Respiratory Motion Compensation Technique", Keyword: "RespiratoryMotionCompensationTechnique", VR: "CS", VM: "1"},
+	{Tag: 0x00189171, Name: "Respiratory Signal Source", Keyword: "RespiratorySignalSource", VR: "CS", VM: "1"},
+	{Tag: 0x00189172, Name: "Bulk Motion Compensation Technique", Keyword: "BulkMotionCompensationTechnique", VR: "CS", VM: "1"},
+	{Tag: 0x00189173, Name: "Bulk Motion Signal Source", Keyword: "BulkMotionSignalSource", VR: "CS", VM: "1"},
+	{Tag: 0x00189174, Name: "Applicable Safety Standard Agency", Keyword: "ApplicableSafetyStandardAgency", VR: "CS", VM: "1"},
+	{Tag: 0x00189175, Name: "Applicable Safety Standard Description", Keyword: "ApplicableSafetyStandardDescription", VR: "LO", VM: "1"},
+	{Tag: 0x00189176, Name: "Operating Mode Sequence", Keyword: "OperatingModeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00189177, Name: "Operating Mode Type", Keyword: "OperatingModeType", VR: "CS", VM: "1"},
+	{Tag: 0x00189178, Name: "Operating Mode", Keyword: "OperatingMode", VR: "CS", VM: "1"},
+	{Tag: 0x00189179, Name: "Specific Absorption Rate Definition", Keyword: "SpecificAbsorptionRateDefinition", VR: "CS", VM: "1"},
+	{Tag: 0x00189180, Name: "Gradient Output Type", Keyword: "GradientOutputType", VR: "CS", VM: "1"},
+	{Tag: 0x00189181, Name: "Specific Absorption Rate Value", Keyword: "SpecificAbsorptionRateValue", VR: "FD", VM: "1"},
+	{Tag: 0x00189182, Name: "Gradient Output", Keyword: "GradientOutput", VR: "FD", VM: "1"},
+	{Tag: 0x00189183, Name: "Flow Compensation Direction", Keyword: "FlowCompensationDirection", VR: "CS", VM: "1"},
+	{Tag: 0x00189184, Name: "Tagging Delay", Keyword: "TaggingDelay", VR: "FD", VM: "1"},
+	{Tag: 0x00189185, Name: "Respiratory Motion Compensation Technique Description", Keyword: "RespiratoryMotionCompensationTechniqueDescription", VR: "ST", VM: "1"},
+	{Tag: 0x00189186, Name: "Respiratory Signal Source ID", Keyword: "RespiratorySignalSourceID", VR: "SH", VM: "1"},
+	{Tag: 0x00189195, Name: "Chemical Shift Minimum Integration Limit in Hz", Keyword: "ChemicalShiftMinimumIntegrationLimitInHz", VR: "FD", VM: "1", Retired: true},
+	{Tag: 0x00189196, Name: "Chemical Shift Maximum Integration Limit in Hz", Keyword: "ChemicalShiftMaximumIntegrationLimitInHz", VR: "FD", VM: "1", Retired: true},
+	{Tag: 0x00189197, Name: "MR Velocity Encoding Sequence", Keyword: "MRVelocityEncodingSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00189198, Name: "First Order Phase Correction", Keyword: "FirstOrderPhaseCorrection", VR: "CS", VM: "1"},
+	{Tag: 0x00189199, Name: "Water Referenced Phase Correction", Keyword: "WaterReferencedPhaseCorrection", VR: "CS", VM: "1"},
+	{Tag: 0x00189200, Name: "MR Spectroscopy Acquisition Type", Keyword: "MRSpectroscopyAcquisitionType", VR: "CS", VM: "1"},
+	{Tag: 0x00189214, Name: "Respiratory Cycle Position", Keyword: "RespiratoryCyclePosition", VR: "CS", VM: "1"},
+	{Tag: 0x00189217, Name: "Velocity Encoding Maximum Value", Keyword: "VelocityEncodingMaximumValue", VR: "FD", VM: "1"},
+	{Tag: 0x00189218, Name: "Tag Spacing Second Dimension", Keyword: "TagSpacingSecondDimension", VR: "FD", VM: "1"},
+	{Tag: 0x00189219, Name: "Tag Angle Second Axis", Keyword: "TagAngleSecondAxis", VR: "SS", VM: "1"},
+	{Tag: 0x00189220, Name: "Frame Acquisition Duration", Keyword: "FrameAcquisitionDuration", VR: "FD", VM: "1"},
+	{Tag: 0x00189226, Name: "MR Image Frame Type Sequence", Keyword: "MRImageFrameTypeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00189227, Name: "MR Spectroscopy Frame Type Sequence", Keyword: "MRSpectroscopyFrameTypeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00189231, Name: "MR Acquisition Phase Encoding Steps in-plane", Keyword: "MRAcquisitionPhaseEncodingStepsInPlane", VR: "US", VM: "1"},
+	{Tag: 0x00189232, Name: "MR Acquisition Phase Encoding Steps out-of-plane", Keyword: "MRAcquisitionPhaseEncodingStepsOutOfPlane", VR: "US", VM: "1"},
+	{Tag: 0x00189234, Name: "Spectroscopy Acquisition Phase Columns", Keyword: "SpectroscopyAcquisitionPhaseColumns", VR: "UL", VM: "1"},
+	{Tag: 0x00189236, Name: "Cardiac Cycle Position", Keyword: "CardiacCyclePosition", VR: "CS", VM: "1"},
+	{Tag: 0x00189239, Name: "Specific Absorption Rate Sequence", Keyword: "SpecificAbsorptionRateSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00189240, Name: "RF Echo Train Length", Keyword: "RFEchoTrainLength", VR: "US", VM: "1"},
+	{Tag: 0x00189241, Name: "Gradient Echo Train Length", Keyword: "GradientEchoTrainLength", VR: "US", VM: "1"},
+	{Tag: 0x00189250, Name: "Arterial Spin Labeling Contrast", Keyword: "ArterialSpinLabelingContrast", VR: "CS", VM: "1"},
+	{Tag: 0x00189251, Name: "MR Arterial Spin Labeling Sequence", Keyword: "MRArterialSpinLabelingSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00189252, Name: "ASL Technique Description", Keyword: "ASLTechniqueDescription", VR: "LO", VM: "1"},
+	{Tag: 0x00189253, Name: "ASL Slab Number", Keyword: "ASLSlabNumber", VR: "US", VM: "1"},
+	{Tag: 0x00189254, Name: "ASL Slab Thickness", Keyword: "ASLSlabThickness", VR: "FD", VM: "1"},
+	{Tag: 0x00189255, Name: "ASL Slab Orientation", Keyword: "ASLSlabOrientation", VR: "FD", VM: "3"},
+	{Tag: 0x00189256, Name: "ASL Mid Slab Position", Keyword: "ASLMidSlabPosition", VR: "FD", VM: "3"},
+	{Tag: 0x00189257, Name: "ASL Context", Keyword: "ASLContext", VR: "CS", VM: "1"},
+	{Tag: 0x00189258, Name: "ASL Pulse Train Duration", Keyword: "ASLPulseTrainDuration", VR: "UL", VM: "1"},
+	{Tag: 0x00189259, Name: "ASL Crusher Flag", Keyword: "ASLCrusherFlag", VR: "CS", VM: "1"},
+	{Tag: 0x0018925A, Name: "ASL Crusher Flow Limit", Keyword: "ASLCrusherFlowLimit", VR: "FD", VM: "1"},
+	{Tag: 0x0018925B, Name: "ASL Crusher Description", Keyword: "ASLCrusherDescription", VR: "LO", VM: "1"},
+	{Tag: 0x0018925C, Name: "ASL Bolus Cut-off Flag", Keyword: "ASLBolusCutoffFlag", VR: "CS", VM: "1"},
+	{Tag: 0x0018925D, Name: "ASL Bolus Cut-off Timing Sequence", Keyword: "ASLBolusCutoffTimingSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x0018925E, Name: "ASL Bolus Cut-off Technique", Keyword: "ASLBolusCutoffTechnique", VR: "LO", VM: "1"},
+	{Tag: 0x0018925F, Name: "ASL Bolus Cut-off Delay Time", Keyword: "ASLBolusCutoffDelayTime", VR: "UL", VM: "1"},
+	{Tag: 0x00189260, Name: "ASL Slab Sequence", Keyword: "ASLSlabSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00189295, Name: "Chemical Shift Minimum Integration Limit in ppm", Keyword: "ChemicalShiftMinimumIntegrationLimitInppm", VR: "FD", VM: "1"},
+	{Tag: 0x00189296, Name: "Chemical Shift Maximum Integration Limit in ppm", Keyword: "ChemicalShiftMaximumIntegrationLimitInppm", VR: "FD", VM: "1"},
+	{Tag: 0x00189297, Name: "Water Reference Acquisition", Keyword: "WaterReferenceAcquisition", VR: "CS", VM: "1"},
+	{Tag: 0x00189298, Name: "Echo Peak Position", Keyword: "EchoPeakPosition", VR: "IS", VM: "1"},
+	{Tag: 0x00189301, Name: "CT Acquisition Type Sequence", Keyword: "CTAcquisitionTypeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00189302, Name: "Acquisition Type", Keyword: "AcquisitionType", VR: "CS", VM: "1"},
+	{Tag: 0x00189303, Name: "Tube Angle", Keyword: "TubeAngle", VR: "FD", VM: "1"},
+	{Tag: 0x00189304, Name: "CT Acquisition Details Sequence", Keyword: "CTAcquisitionDetailsSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00189305, Name: "Revolution Time", Keyword: "RevolutionTime", VR: "FD", VM: "1"},
+	{Tag: 0x00189306, Name: "Single Collimation Width", Keyword: "SingleCollimationWidth", VR: "FD", VM: "1"},
+	{Tag: 0x00189307, Name: "Total Collimation Width", Keyword: "TotalCollimationWidth", VR: "FD", VM: "1"},
+	{Tag: 0x00189308, Name: "CT Table Dynamics Sequence", Keyword: "CTTableDynamicsSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00189309, Name: "Table Speed", Keyword: "TableSpeed", VR: "FD", VM: "1"},
+	{Tag: 0x00189310, Name: "Table Feed per Rotation", Keyword: "TableFeedPerRotation", VR: "FD", VM: "1"},
+	{Tag: 0x00189311, Name: "Spiral Pitch Factor", Keyword: "SpiralPitchFactor", VR: "FD", VM: "1"},
+	{Tag: 0x00189312, Name: "CT Geometry Sequence", Keyword: "CTGeometrySequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00189313, Name: "Data Collection Center (Patient)", Keyword: "DataCollectionCenterPatient", VR: "FD", VM: "3"},
+	{Tag: 0x00189314, Name: "CT Reconstruction Sequence", Keyword: "CTReconstructionSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00189315, Name: "Reconstruction Algorithm", Keyword: "ReconstructionAlgorithm", VR: "CS", VM: "1"},
+	{Tag: 0x00189316, Name: "Convolution Kernel Group", Keyword: "ConvolutionKernelGroup", VR: "CS", VM: "1"},
+	{Tag: 0x00189317, Name: "Reconstruction Field of View", Keyword: "ReconstructionFieldOfView", VR: "FD", VM: "2"},
+	{Tag: 0x00189318, Name: "Reconstruction Target Center (Patient)", Keyword: "ReconstructionTargetCenterPatient", VR: "FD", VM: "3"},
+	{Tag: 0x00189319, Name: "Reconstruction Angle", Keyword: "ReconstructionAngle", VR: "FD", VM: "1"},
+	{Tag: 0x00189320, Name: "Image Filter", Keyword: "ImageFilter", VR: "SH", VM: "1"},
+	{Tag: 0x00189321, Name: "CT Exposure Sequence", Keyword: "CTExposureSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00189322, Name: "Reconstruction Pixel Spacing", Keyword: "ReconstructionPixelSpacing", VR: "FD", VM: "2"},
+	{Tag: 0x00189323, Name: "Exposure Modulation Type", Keyword: "ExposureModulationType", VR: "CS", VM: "1-n"},
+	{Tag: 0x00189324, Name: "Estimated Dose Saving", Keyword: "EstimatedDoseSaving", VR: "FD", VM: "1", Retired: true},
+	{Tag: 0x00189325, Name: "CT X-Ray Details Sequence", Keyword: "CTXRayDetailsSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00189326, Name: "CT Position Sequence", Keyword: "CTPositionSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00189327, Name: "Table Position", Keyword: "TablePosition", VR: "FD", VM: "1"},
+	{Tag: 0x00189328, Name: "Exposure Time in ms", Keyword: "ExposureTimeInms", VR: "FD", VM: "1"},
+	{Tag: 0x00189329, Name: "CT Image Frame Type Sequence", Keyword: "CTImageFrameTypeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00189330, Name: "X-Ray Tube Current in mA", Keyword: "XRayTubeCurrentInmA", VR: "FD", VM: "1"},
+	{Tag: 0x00189332, Name: "Exposure in mAs", Keyword: "ExposureInmAs", VR: "FD", VM: "1"},
+	{Tag: 0x00189333, Name: "Constant Volume Flag", Keyword: "ConstantVolumeFlag", VR: "CS", VM: "1"},
+	{Tag: 0x00189334, Name: "Fluoroscopy Flag", Keyword: "FluoroscopyFlag", VR: "CS", VM: "1"},
+	{Tag: 0x00189335, Name: "Distance Source to Data Collection Center", Keyword: "DistanceSourceToDataCollectionCenter", VR: "FD", VM: "1"},
+	{Tag: 0x00189337, Name: "Contrast/Bolus Agent Number", Keyword: "ContrastBolusAgentNumber", VR: "US", VM: "1"},
+	{Tag: 0x00189338, Name: "Contrast/Bolus Ingredient Code Sequence", Keyword: "ContrastBolusIngredientCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00189340, Name: "Contrast Administration Profile Sequence", Keyword: "ContrastAdministrationProfileSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00189341, Name: "Contrast/Bolus Usage Sequence", Keyword: "ContrastBolusUsageSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00189342, Name: "Contrast/Bolus Agent Administered", Keyword: "ContrastBolusAgentAdministered", VR: "CS", VM: "1"},
+	{Tag: 0x00189343, Name: "Contrast/Bolus Agent Detected", Keyword: "ContrastBolusAgentDetected", VR: "CS", VM: "1"},
+	{Tag: 0x00189344, Name: "Contrast/Bolus Agent Phase", Keyword: "ContrastBolusAgentPhase", VR: "CS", VM: "1"},
+	{Tag: 0x00189345, Name: "CTDIvol", Keyword: "CTDIvol", VR: "FD", VM: "1"},
+	{Tag: 0x00189346, Name: "CTDI Phantom Type Code Sequence", Keyword: "CTDIPhantomTypeCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00189351, Name: "Calcium Scoring Mass Factor Patient", Keyword: "CalciumScoringMassFactorPatient", VR: "FL", VM: "1"},
+	{Tag: 0x00189352, Name: "Calcium Scoring Mass Factor Device", Keyword: "CalciumScoringMassFactorDevice", VR: "FL", VM: "3"},
+	{Tag: 0x00189353, Name: "Energy Weighting Factor", Keyword: "EnergyWeightingFactor", VR: "FL", VM: "1"},
+	{Tag: 0x00189360, Name: "CT Additional X-Ray Source Sequence", Keyword: "CTAdditionalXRaySourceSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00189361, Name: "Multi-energy CT Acquisition", Keyword: "MultienergyCTAcquisition", VR: "CS", VM: "1"},
+	{Tag: 0x00189362, Name: "Multi-energy CT Acquisition Sequence", Keyword: "MultienergyCTAcquisitionSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00189363, Name: "Multi-energy CT Processing Sequence", Keyword: "MultienergyCTProcessingSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00189364, Name: "Multi-energy CT Characteristics Sequence", Keyword: "MultienergyCTCharacteristicsSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00189365, Name: "Multi-energy CT X-Ray Source Sequence", Keyword: "MultienergyCTXRaySourceSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00189366, Name: "X-Ray Source Index", Keyword: "XRaySourceIndex", VR: "US", VM: "1"},
+	{Tag: 0x00189367, Name: "X-Ray Source ID", Keyword: "XRaySourceID", VR: "UC", VM: "1"},
+	{Tag: 0x00189368, Name: "Multi-energy Source Technique", Keyword: "MultienergySourceTechnique", VR: "CS", VM: "1"},
+	{Tag: 0x00189369, Name: "Source Start DateTime", Keyword: "SourceStartDateTime", VR: "DT", VM: "1"},
+	{Tag: 0x0018936A, Name: "Source End DateTime", Keyword: "SourceEndDateTime", VR: "DT", VM: "1"},
+	{Tag: 0x0018936B, Name: "Switching Phase Number", Keyword: "SwitchingPhaseNumber", VR: "US", VM: "1"},
+	{Tag: 0x0018936C, Name: "Switching Phase Nominal Duration", Keyword: "SwitchingPhaseNominalDuration", VR: "DS", VM: "1"},
+	{Tag: 0x0018936D, Name: "Switching Phase Transition Duration", Keyword: "SwitchingPhaseTransitionDuration", VR: "DS", VM: "1"},
+	{Tag: 0x0018936E, Name: "Effective Bin Energy", Keyword: "EffectiveBinEnergy", VR: "DS", VM: "1"},
+	{Tag: 0x0018936F, Name: "Multi-energy CT X-Ray Detector Sequence", Keyword: "MultienergyCTXRayDetectorSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00189370, Name: "X-Ray Detector Index", Keyword: "XRayDetectorIndex", VR: "US", VM: "1"},
+	{Tag: 0x00189371, Name: "X-Ray Detector ID", Keyword: "XRayDetectorID", VR: "UC", VM: "1"},
+	{Tag: 0x00189372, Name: "Multi-energy Detector Type", Keyword: "MultienergyDetectorType", VR: "CS", VM: "1"},
+	{Tag: 0x00189373, Name: "X-Ray Detector Label", Keyword: "XRayDetectorLabel", VR: "ST", VM: "1"},
+	{Tag: 0x00189374, Name: "Nominal Max Energy", Keyword: "NominalMaxEnergy", VR: "DS", VM: "1"},
+	{Tag: 0x00189375, Name: "Nominal Min Energy", Keyword: "NominalMinEnergy", VR: "DS", VM: "1"},
+	{Tag: 0x00189376, Name: "Referenced X-Ray Detector Index", Keyword: "ReferencedXRayDetectorIndex", VR: "US", VM: "1-n"},
+	{Tag: 0x00189377, Name: "Referenced X-Ray Source Index", Keyword: "ReferencedXRaySourceIndex", VR: "US", VM: "1-n"},
+	{Tag: 0x00189378, Name: "Referenced Path Index", Keyword: "ReferencedPathIndex", VR: "US", VM: "1-n"},
+	{Tag: 0x00189379, Name: "Multi-energy CT Path Sequence", Keyword: "MultienergyCTPathSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x0018937A, Name: "Multi-energy CT Path Index", Keyword: "MultienergyCTPathIndex", VR: "US", VM: "1"},
+	{Tag: 0x0018937B, Name: "Multi-energy Acquisition Description", Keyword: "MultienergyAcquisitionDescription", VR: "UT", VM: "1"},
+	{Tag: 0x0018937C, Name: "Monoenergetic Energy Equivalent", Keyword: "MonoenergeticEnergyEquivalent", VR: "FD", VM: "1"},
+	{Tag: 0x0018937D, Name: "Material Code Sequence", Keyword: "MaterialCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x0018937E, Name: "Decomposition Method", Keyword: "DecompositionMethod", VR: "CS", VM: "1"},
+	{Tag: 0x0018937F, Name: "Decomposition Description", Keyword: "DecompositionDescription", VR: "UT", VM: "1"},
+	{Tag: 0x00189380, Name: "Decomposition Algorithm Identification Sequence", Keyword: "DecompositionAlgorithmIdentificationSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00189381, Name: "Decomposition Material Sequence", Keyword: "DecompositionMaterialSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00189382, Name: "Material Attenuation Sequence", Keyword: "MaterialAttenuationSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00189383, Name: "Photon Energy", Keyword: "PhotonEnergy", VR: "DS", VM: "1"},
+	{Tag: 0x00189384, Name: "X-Ray Mass Attenuation Coefficient", Keyword: "XRayMassAttenuationCoefficient", VR: "DS", VM: "1"},
+	{Tag: 0x00189401, Name: "Projection Pixel Calibration Sequence", Keyword: "ProjectionPixelCalibrationSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00189402, Name: "Distance Source to Isocenter", Keyword: "DistanceSourceToIsocenter", VR: "FL", VM: "1"},
+	{Tag: 0x00189403, Name: "Distance Object to Table Top", Keyword: "DistanceObjectToTableTop", VR: "FL", VM: "1"},
+	{Tag: 0x00189404, Name: "Object Pixel Spacing in Center of Beam", Keyword: "ObjectPixelSpacingInCenterOfBeam", VR: "FL", VM: "2"},
+	{Tag: 0x00189405, Name: "Positioner Position Sequence", Keyword: "PositionerPositionSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00189406, Name: "Table Position Sequence", Keyword: "TablePositionSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00189407, Name: "Collimator Shape Sequence", Keyword: "CollimatorShapeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00189410, Name: "Planes in Acquisition", Keyword: "PlanesInAcquisition", VR: "CS", VM: "1"},
+	{Tag: 0x00189412, Name: "XA/XRF Frame Characteristics Sequence", Keyword: "XAXRFFrameCharacteristicsSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00189417, Name: "Frame Acquisition Sequence", Keyword: "FrameAcquisitionSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00189420, Name: "X-Ray Receptor Type", Keyword: "XRayReceptorType", VR: "CS", VM: "1"},
+	{Tag: 0x00189423, Name: "Acquisition Protocol Name", Keyword: "AcquisitionProtocolName", VR: "LO", VM: "1"},
+	{Tag: 0x00189424, Name: "Acquisition Protocol Description", Keyword: "AcquisitionProtocolDescription", VR: "LT", VM: "1"},
+	{Tag: 0x00189425, Name: "Contrast/Bolus Ingredient Opaque", Keyword: "ContrastBolusIngredientOpaque", VR: "CS", VM: "1"},
+	{Tag: 0x00189426, Name: "Distance Receptor Plane to Detector Housing", Keyword: "DistanceReceptorPlaneToDetectorHousing", VR: "FL", VM: "1"},
+	{Tag: 0x00189427, Name: "Intensifier Active Shape", Keyword: "IntensifierActiveShape", VR: "CS", VM: "1"},
+	{Tag: 0x00189428, Name: "Intensifier Active Dimension(s)", Keyword: "IntensifierActiveDimensions", VR: "FL", VM: "1-2"},
+	{Tag: 0x00189429, Name: "Physical Detector Size", Keyword: "PhysicalDetectorSize", VR: "FL", VM: "2"},
+	{Tag: 0x00189430, Name: "Position of Isocenter Projection", Keyword: "PositionOfIsocenterProjection", VR: "FL", VM: "2"},
+	{Tag: 0x00189432, Name: "Field of View Sequence", Keyword: "FieldOfViewSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00189433, Name: "Field of View Description", Keyword: "FieldOfViewDescription", VR: "LO", VM: "1"},
+	{Tag: 0x00189434, Name: "Exposure Control Sensing Regions Sequence", Keyword: "ExposureControlSensingRegionsSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00189435, Name: "Exposure Control Sensing Region Shape", Keyword: "ExposureControlSensingRegionShape", VR: "CS", VM: "1"},
+	{Tag: 0x00189436, Name: "Exposure Control Sensing Region Left Vertical Edge", Keyword: "ExposureControlSensingRegionLeftVerticalEdge", VR: "SS", VM: "1"},
+	{Tag: 0x00189437, Name: "Exposure Control Sensing Region Right Vertical Edge", Keyword: "ExposureControlSensingRegionRightVerticalEdge", VR: "SS", VM: "1"},
+	{Tag: 0x00189438, Name: "Exposure Control Sensing Region Upper Horizontal Edge", Keyword: "ExposureControlSensingRegionUpperHorizontalEdge", VR: "SS", VM: "1"},
+	{Tag: 0x00189439, Name: "Exposure Control Sensing Region Lower Horizontal Edge", Keyword: "ExposureControlSensingRegionLowerHorizontalEdge", VR: "SS", VM: "1"},
+	{Tag: 0x00189440, Name: "Center of Circular Exposure Control Sensing Region", Keyword: "CenterOfCircularExposureControlSensingRegion", VR: "SS", VM: "2"},
+	{Tag: 0x00189441, Name: "Radius of Circular Exposure Control Sensing Region", Keyword: "RadiusOfCircularExposureControlSensingRegion", VR: "US", VM: "1"},
+	{Tag: 0x00189442, Name: "Vertices of the Polygonal Exposure Control Sensing Region", Keyword: "VerticesOfThePolygonalExposureControlSensingRegion", VR: "SS", VM: "2-n"},
+	{Tag: 0x00189447, Name: "Column Angulation (Patient)", Keyword: "ColumnAngulationPatient", VR: "FL", VM: "1"},
+	{Tag: 0x00189449, Name: "Beam Angle", Keyword: "BeamAngle", VR: "FL", VM: "1"},
+	{Tag: 0x00189451, Name: "Frame Detector Parameters Sequence", Keyword: "FrameDetectorParametersSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00189452, Name: "Calculated Anatomy Thickness", Keyword: "CalculatedAnatomyThickness", VR: "FL", VM: "1"},
+	{Tag: 0x00189455, Name: "Calibration Sequence", Keyword: "CalibrationSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00189456, Name: "Object Thickness Sequence", Keyword: "ObjectThicknessSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00189457, Name: "Plane Identification", Keyword: "PlaneIdentification", VR: "CS", VM: "1"},
+	{Tag: 0x00189461, Name: "Field of View Dimension(s) in Float", Keyword: "FieldOfViewDimensionsInFloat", VR: "FL", VM: "1-2"},
+	{Tag: 0x00189462, Name: "Isocenter Reference System Sequence", Keyword: "IsocenterReferenceSystemSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00189463, Name: "Positioner Isocenter Primary Angle", Keyword: "PositionerIsocenterPrimaryAngle", VR: "FL", VM: "1"},
+	{Tag: 0x00189464, Name: "Positioner Isocenter Secondary Angle", Keyword: "PositionerIsocenterSecondaryAngle", VR: "FL", VM: "1"},
+	{Tag: 0x00189465, Name: "Positioner Isocenter Detector Rotation Angle", Keyword: "PositionerIsocenterDetectorRotationAngle", VR: "FL", VM: "1"},
+	{Tag: 0x00189466, Name: "Table X Position to Isocenter", Keyword: "TableXPositionToIsocenter", VR: "FL", VM: "1"},
+	{Tag: 0x00189467, Name: "Table Y Position to Isocenter", Keyword: "TableYPositionToIsocenter", VR: "FL", VM: "1"},
+	{Tag: 0x00189468, Name: "Table Z Position to Isocenter", Keyword: "TableZPositionToIsocenter", VR: "FL", VM: "1"},
+	{Tag: 0x00189469, Name: "Table Horizontal Rotation Angle", Keyword: "TableHorizontalRotationAngle", VR: "FL", VM: "1"},
+	{Tag: 0x00189470, Name: "Table Head Tilt Angle", Keyword: "TableHeadTiltAngle", VR: "FL", VM: "1"},
+	{Tag: 0x00189471, Name: "Table Cradle Tilt Angle", Keyword: "TableCradleTiltAngle", VR: "FL", VM: "1"},
+	{Tag: 0x00189472, Name: "Frame Display Shutter Sequence", Keyword: "FrameDisplayShutterSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00189473, Name: "Acquired Image Area Dose Product", Keyword: "AcquiredImageAreaDoseProduct", VR: "FL", VM: "1"},
+	{Tag: 0x00189474, Name: "C-arm Positioner Tabletop Relationship", Keyword: "CArmPositionerTabletopRelationship", VR: "CS", VM: "1"},
+	{Tag: 0x00189476, Name: "X-Ray Geometry Sequence", Keyword: "XRayGeometrySequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00189477, Name: "Irradiation Event Identification Sequence", Keyword: "IrradiationEventIdentificationSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00189504, Name: "X-Ray 3D Frame Type Sequence", Keyword: "XRay3DFrameTypeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00189506, Name: "Contributing Sources Sequence", Keyword: "ContributingSourcesSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00189507, Name: "X-Ray 3D Acquisition Sequence", Keyword: "XRay3DAcquisitionSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00189508, Name: "Primary Positioner Scan Arc", Keyword: "PrimaryPositionerScanArc", VR: "FL", VM: "1"},
+	{Tag: 0x00189509, Name: "Secondary Positioner Scan Arc", Keyword: "SecondaryPositionerScanArc", VR: "FL", VM: "1"},
+	{Tag: 0x00189510, Name: "Primary Positioner Scan Start Angle", Keyword: "PrimaryPositionerScanStartAngle", VR: "FL", VM: "1"},
+	{Tag: 0x00189511, Name: "Secondary Positioner Scan Start Angle", Keyword: "SecondaryPositionerScanStartAngle", VR: "FL", VM: "1"},
+	{Tag: 0x00189514, Name: "Primary Positioner Increment", Keyword: "PrimaryPositionerIncrement", VR: "FL", VM: "1"},
+	{Tag: 0x00189515, Name: "Secondary Positioner Increment", Keyword: "SecondaryPositionerIncrement", VR: "FL", VM: "1"},
+	{Tag: 0x00189516, Name: "Start Acquisition DateTime", Keyword: "StartAcquisitionDateTime", VR: "DT", VM: "1"},
+	{Tag: 0x00189517, Name: "End Acquisition DateTime", Keyword: "EndAcquisitionDateTime", VR: "DT", VM: "1"},
+	{Tag: 0x00189518, Name: "Primary Positioner Increment Sign", Keyword: "PrimaryPositionerIncrementSign", VR: "SS", VM: "1"},
+	{Tag: 0x00189519, Name: "Secondary Positioner Increment Sign", Keyword: "SecondaryPositionerIncrementSign", VR: "SS", VM: "1"},
+	{Tag: 0x00189524, Name: "Application Name", Keyword: "ApplicationName", VR: "LO", VM: "1"},
+	{Tag: 0x00189525, Name: "Application Version", Keyword: "ApplicationVersion", VR: "LO", VM: "1"},
+	{Tag: 0x00189526, Name: "Application Manufacturer", Keyword: "ApplicationManufacturer", VR: "LO", VM: "1"},
+	{Tag: 0x00189527, Name: "Algorithm Type", Keyword: "AlgorithmType", VR: "CS", VM: "1"},
+	{Tag: 0x00189528, Name: "Algorithm Description", Keyword: "AlgorithmDescription", VR: "LO", VM: "1"},
+	{Tag: 0x00189530, Name: "X-Ray 3D Reconstruction Sequence", Keyword: "XRay3DReconstructionSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00189531, Name: "Reconstruction Description", Keyword: "ReconstructionDescription", VR: "LO", VM: "1"},
+	{Tag: 0x00189538, Name: "Per Projection Acquisition Sequence", Keyword: "PerProjectionAcquisitionSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00189541, Name: "Detector Position Sequence", Keyword: "DetectorPositionSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00189542, Name: "X-Ray Acquisition Dose Sequence", Keyword: "XRayAcquisitionDoseSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00189543, Name: "X-Ray Source Isocenter Primary Angle", Keyword: "XRaySourceIsocenterPrimaryAngle", VR: "FD", VM: "1"},
+	{Tag: 0x00189544, Name: "X-Ray Source Isocenter Secondary Angle", Keyword: "XRaySourceIsocenterSecondaryAngle", VR: "FD", VM: "1"},
+	{Tag: 0x00189545, Name: "Breast Support Isocenter Primary Angle", Keyword: "BreastSupportIsocenterPrimaryAngle", VR: "FD", VM: "1"},
+	{Tag: 0x00189546, Name: "Breast Support Isocenter Secondary Angle", Keyword: "BreastSupportIsocenterSecondaryAngle", VR: "FD", VM: "1"},
+	{Tag: 0x00189547, Name: "Breast Support X Position to Isocenter", Keyword: "BreastSupportXPositionToIsocenter", VR: "FD", VM: "1"},
+	{Tag: 0x00189548, Name: "Breast Support Y Position to Isocenter", Keyword: "BreastSupportYPositionToIsocenter", VR: "FD", VM: "1"},
+	{Tag: 0x00189549, Name: "Breast Support Z Position to Isocenter", Keyword: "BreastSupportZPositionToIsocenter", VR: "FD", VM: "1"},
+	{Tag: 0x00189550, Name: "Detector Isocenter Primary Angle", Keyword: "DetectorIsocenterPrimaryAngle", VR: "FD", VM: "1"},
+	{Tag: 0x00189551, Name: "Detector Isocenter Secondary Angle", Keyword: "DetectorIsocenterSecondaryAngle", VR: "FD", VM: "1"},
+	{Tag: 0x00189552, Name: "Detector X Position to Isocenter", Keyword: "DetectorXPositionToIsocenter", VR: "FD", VM: "1"},
+	{Tag: 0x00189553, Name: "Detector Y Position to Isocenter", Keyword: "DetectorYPositionToIsocenter", VR: "FD", VM: "1"},
+	{Tag: 0x00189554, Name: "Detector Z Position to Isocenter", Keyword: "DetectorZPositionToIsocenter", VR: "FD", VM: "1"},
+	{Tag: 0x00189555, Name: "X-Ray Grid Sequence", Keyword: "XRayGridSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00189556, Name: "X-Ray Filter Sequence", Keyword: "XRayFilterSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00189557, Name: "Detector Active Area TLHC Position", Keyword: "DetectorActiveAreaTLHCPosition", VR: "FD", VM: "3"},
+	{Tag: 0x00189558, Name: "Detector Active Area Orientation", Keyword: "DetectorActiveAreaOrientation", VR: "FD", VM: "6"},
+	{Tag: 0x00189559, Name: "Positioner Primary Angle Direction", Keyword: "PositionerPrimaryAngleDirection", VR: "CS", VM: "1"},
+	{Tag: 0x00189601, Name: "Diffusion b-matrix Sequence", Keyword: "DiffusionBMatrixSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00189602, Name: "Diffusion b-value XX", Keyword: "DiffusionBValueXX", VR: "FD", VM: "1"},
+	{Tag: 0x00189603, Name: "Diffusion b-value XY", Keyword: "DiffusionBValueXY", VR: "FD", VM: "1"},
+	{Tag: 0x00189604, Name: "Diffusion b-value XZ", Keyword: "DiffusionBValueXZ", VR: "FD", VM: "1"},
+	{Tag: 0x00189605, Name: "Diffusion b-value YY", Keyword: "DiffusionBValueYY", VR: "FD", VM: "1"},
+	{Tag: 0x00189606, Name: "Diffusion b-value YZ", Keyword: "DiffusionBValueYZ", VR: "FD", VM: "1"},
+	{Tag: 0x00189607, Name: "Diffusion b-value ZZ", Keyword: "DiffusionBValueZZ", VR: "FD", VM: "1"},
+	{Tag: 0x00189621, Name: "Functional MR Sequence", Keyword: "FunctionalMRSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00189622, Name: "Functional Settling Phase Frames Present", Keyword: "FunctionalSettlingPhaseFramesPresent", VR: "CS", VM: "1"},
+	{Tag: 0x00189623, Name: "Functional Sync Pulse", Keyword: "FunctionalSyncPulse", VR: "DT", VM: "1"},
+	{Tag: 0x00189624, Name: "Settling Phase Frame", Keyword: "SettlingPhaseFrame", VR: "CS", VM: "1"},
+	{Tag: 0x00189701, Name: "Decay Correction DateTime", Keyword: "DecayCorrectionDateTime", VR: "DT", VM: "1"},
+	{Tag: 0x00189715, Name: "Start Density Threshold", Keyword: "StartDensityThreshold", VR: "FD", VM: "1"},
+	{Tag: 0x00189716, Name: "Start Relative Density Difference Threshold", Keyword: "StartRelativeDensityDifferenceThreshold", VR: "FD", VM: "1"},
+	{Tag: 0x00189717, Name: "Start Cardiac Trigger Count Threshold", Keyword: "StartCardiacTriggerCountThreshold", VR: "FD", VM: "1"},
+	{Tag: 0x00189718, Name: "Start Respiratory Trigger Count Threshold", Keyword: "StartRespiratoryTriggerCountThreshold", VR: "FD", VM: "1"},
+	{Tag: 0x00189719, Name: "Termination Counts Threshold", Keyword: "TerminationCountsThreshold", VR: "FD", VM: "1"},
+	{Tag: 0x00189720, Name: "Termination Density Threshold", Keyword: "TerminationDensityThreshold", VR: "FD", VM: "1"},
+	{Tag: 0x00189721, Name: "Termination Relative Density Threshold", Keyword: "TerminationRelativeDensityThreshold", VR: "FD", VM: "1"},
+	{Tag: 0x00189722, Name: "Termination Time Threshold", Keyword: "TerminationTimeThreshold", VR: "FD", VM: "1"},
+	{Tag: 0x00189723, Name: "Termination Cardiac Trigger Count Threshold", Keyword: "TerminationCardiacTriggerCountThreshold", VR: "FD", VM: "1"},
+	{Tag: 0x00189724, Name: "Termination Respiratory Trigger Count Threshold", Keyword: "TerminationRespiratoryTriggerCountThreshold", VR: "FD", VM: "1"},
+	{Tag: 0x00189725, Name: "Detector Geometry", Keyword: "DetectorGeometry", VR: "CS", VM: "1"},
+	{Tag: 0x00189726, Name: "Transverse Detector Separation", Keyword: "TransverseDetectorSeparation", VR: "FD", VM: "1"},
+	{Tag: 0x00189727, Name: "Axial Detector Dimension", Keyword: "AxialDetectorDimension", VR: "FD", VM: "1"},
+	{Tag: 0x00189729, Name: "Radiopharmaceutical Agent Number", Keyword: "RadiopharmaceuticalAgentNumber", VR: "US", VM: "1"},
+	{Tag: 0x00189732, Name: "PET Frame Acquisition Sequence", Keyword: "PETFrameAcquisitionSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00189733, Name: "PET Detector Motion Details Sequence", Keyword: "PETDetectorMotionDetailsSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00189734, Name: "PET Table Dynamics Sequence", Keyword: "PETTableDynamicsSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00189735, Name: "PET Position Sequence", Keyword: "PETPositionSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00189736, Name: "PET Frame Correction Factors Sequence", Keyword: "PETFrameCorrectionFactorsSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00189737, Name: "Radiopharmaceutical Usage Sequence", Keyword: "RadiopharmaceuticalUsageSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00189738, Name: "Attenuation Correction Source", Keyword: "AttenuationCorrectionSource", VR: "CS", VM: "1"},
+	{Tag: 0x00189739, Name: "Number of Iterations", Keyword: "NumberOfIterations", VR: "US", VM: "1"},
+	{Tag: 0x00189740, Name: "Number of Subsets", Keyword: "NumberOfSubsets", VR: "US", VM: "1"},
+	{Tag: 0x00189749, Name: "PET Reconstruction Sequence", Keyword: "PETReconstructionSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00189751, Name: "PET Frame Type Sequence", Keyword: "PETFrameTypeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00189755, Name: "Time of Flight Information Used", Keyword: "TimeOfFlightInformationUsed", VR: "CS", VM: "1"},
+	{Tag: 0x00189756, Name: "Reconstruction Type", Keyword: "ReconstructionType", VR: "CS", VM: "1"},
+	{Tag: 0x00189758, Name: "Decay Corrected", Keyword: "DecayCorrected", VR: "CS", VM: "1"},
+	{Tag: 0x00189759, Name: "Attenuation Corrected", Keyword: "AttenuationCorrected", VR: "CS", VM: "1"},
+	{Tag: 0x00189760, Name: "Scatter Corrected", Keyword: "ScatterCorrected", VR: "CS", VM: "1"},
+	{Tag: 0x00189761, Name: "Dead Time Corrected", Keyword: "DeadTimeCorrected", VR: "CS", VM: "1"},
+	{Tag: 0x00189762, Name: "Gantry Motion Corrected", Keyword: "GantryMotionCorrected", VR: "CS", VM: "1"},
+	{Tag: 0x00189763, Name: "Patient Motion Corrected", Keyword: "PatientMotionCorrected", VR: "CS", VM: "1"},
+	{Tag: 0x00189764, Name: "Count Loss Normalization Corrected", Keyword: "CountLossNormalizationCorrected", VR: "CS", VM: "1"},
+	{Tag: 0x00189765, Name: "Randoms Corrected", Keyword: "RandomsCorrected", VR: "CS", VM: "1"},
+	{Tag: 0x00189766, Name: "Non-uniform Radial Sampling Corrected", Keyword: "NonUniformRadialSamplingCorrected", VR: "CS", VM: "1"},
+	{Tag: 0x00189767, Name: "Sensitivity Calibrated", Keyword: "SensitivityCalibrated", VR: "CS", VM: "1"},
+	{Tag: 0x00189768, Name: "Detector Normalization Correction", Keyword: "DetectorNormalizationCorrection", VR: "CS", VM: "1"},
+	{Tag: 0x00189769, Name: "Iterative Reconstruction Method", Keyword: "IterativeReconstructionMethod", VR: "CS", VM: "1"},
+	{Tag: 0x00189770, Name: "Attenuation Correction Temporal Relationship", Keyword: "AttenuationCorrectionTemporalRelationship", VR: "CS", VM: "1"},
+	{Tag: 0x00189771, Name: "Patient Physiological State Sequence", Keyword: "PatientPhysiologicalStateSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00189772, Name: "Patient Physiological State Code Sequence", Keyword: "PatientPhysiologicalStateCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00189801, Name: "Depth(s) of Focus", Keyword: "DepthsOfFocus", VR: "FD", VM: "1-n"},
+	{Tag: 0x00189803, Name: "Excluded Intervals Sequence", Keyword: "ExcludedIntervalsSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00189804, Name: "Exclusion Start DateTime", Keyword: "ExclusionStartDateTime", VR: "DT", VM: "1"},
+	{Tag: 0x00189805, Name: "Exclusion Duration", Keyword: "ExclusionDuration", VR: "FD", VM: "1"},
+	{Tag: 0x00189806, Name: "US Image Description Sequence", Keyword: "USImageDescriptionSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00189807, Name: "Image Data Type Sequence", Keyword: "ImageDataTypeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00189808, Name: "Data Type", Keyword: "DataType", VR: "CS", VM: "1"},
+	{Tag: 0x00189809, Name: "Transducer Scan Pattern Code Sequence", Keyword: "TransducerScanPatternCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x0018980B, Name: "Aliased Data Type", Keyword: "AliasedDataType", VR: "CS", VM: "1"},
+	{Tag: 0x0018980C, Name: "Position Measuring Device Used", Keyword: "PositionMeasuringDeviceUsed", VR: "CS", VM: "1"},
+	{Tag: 0x0018980D, Name: "Transducer Geometry Code Sequence", Keyword: "TransducerGeometryCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x0018980E, Name: "Transducer Beam Steering Code Sequence", Keyword: "TransducerBeamSteeringCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x0018980F, Name: "Transducer Application Code Sequence", Keyword: "TransducerApplicationCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00189810, Name: "Zero Velocity Pixel Value", Keyword: "ZeroVelocityPixelValue", VR: "US or SS", VM: "1"},
+	{Tag: 0x00189821, Name: "Photoacoustic Excitation Characteristics Sequence", Keyword: "PhotoacousticExcitationCharacteristicsSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00189822, Name: "Excitation Spectral Width", Keyword: "ExcitationSpectralWidth", VR: "FD", VM: "1"},
+	{Tag: 0x00189823, Name: "Excitation Energy", Keyword: "ExcitationEnergy", VR: "FD", VM: "1"},
+	{Tag: 0x00189824, Name: "Excitation Pulse Duration", Keyword: "ExcitationPulseDuration", VR: "FD", VM: "1"},
+	{Tag: 0x00189825, Name: "Excitation Wavelength Sequence", Keyword: "ExcitationWavelengthSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00189826, Name: "Excitation Wavelength", Keyword: "ExcitationWavelength", VR: "FD", VM: "1"},
+	{Tag: 0x00189828, Name: "Illumination Translation Flag", Keyword: "IlluminationTranslationFlag", VR: "CS", VM: "1"},
+	{Tag: 0x00189829, Name: "Acoustic Coupling Medium Flag", Keyword: "AcousticCouplingMediumFlag", VR: "CS", VM: "1"},
+	{Tag: 0x0018982A, Name: "Acoustic Coupling Medium Code Sequence", Keyword: "AcousticCouplingMediumCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x0018982B, Name: "Acoustic Coupling Medium Temperature", Keyword: "AcousticCouplingMediumTemperature", VR: "FD", VM: "1"},
+	{Tag: 0x0018982C, Name: "Transducer Response Sequence", Keyword: "TransducerResponseSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x0018982D, Name: "Center Frequency", Keyword: "CenterFrequency", VR: "FD", VM: "1"},
+	{Tag: 0x0018982E, Name: "Fractional Bandwidth", Keyword: "FractionalBandwidth", VR: "FD", VM: "1"},
+	{Tag: 0x0018982F, Name: "Lower Cutoff Frequency", Keyword: "LowerCutoffFrequency", VR: "FD", VM: "1"},
+	{Tag: 0x00189830, Name: "Upper Cutoff Frequency", Keyword: "UpperCutoffFrequency", VR: "FD", VM: "1"},
+	{Tag: 0x00189831, Name: "Transducer Technology Sequence", Keyword: "TransducerTechnologySequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00189832, Name: "Sound Speed Correction Mechanism Code Sequence", Keyword: "SoundSpeedCorrectionMechanismCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00189833, Name: "Object Sound Speed", Keyword: "ObjectSoundSpeed", VR: "FD", VM: "1"},
+	{Tag: 0x00189834, Name: "Acoustic Coupling Medium Sound Speed", Keyword: "AcousticCouplingMediumSoundSpeed", VR: "FD", VM: "1"},
+	{Tag: 0x00189835, Name: "Photoacoustic Image Frame Type Sequence", Keyword: "PhotoacousticImageFrameTypeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00189836, Name: "Image Data Type Code Sequence", Keyword: "ImageDataTypeCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00189900, Name: "Reference Location Label", Keyword: "ReferenceLocationLabel", VR: "LO", VM: "1"},
+	{Tag: 0x00189901, Name: "Reference Location Description", Keyword: "ReferenceLocationDescription", VR: "UT", VM: "1"},
+	{Tag: 0x00189902, Name: "Reference Basis Code Sequence", Keyword: "ReferenceBasisCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00189903, Name: "Reference Geometry Code Sequence", Keyword: "ReferenceGeometryCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00189904, Name: "Offset Distance", Keyword: "OffsetDistance", VR: "DS", VM: "1"},
+	{Tag: 0x00189905, Name: "Offset Direction", Keyword: "OffsetDirection", VR: "CS", VM: "1"},
+	{Tag: 0x00189906, Name: "Potential Scheduled Protocol Code Sequence", Keyword: "PotentialScheduledProtocolCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00189907, Name: "Potential Requested Procedure Code Sequence", Keyword: "PotentialRequestedProcedureCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00189908, Name: "Potential Reasons for Procedure", Keyword: "PotentialReasonsForProcedure", VR: "UC", VM: "1-n"},
+	{Tag: 0x00189909, Name: "Potential Reasons for Procedure Code Sequence", Keyword: "PotentialReasonsForProcedureCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x0018990A, Name: "Potential Diagnostic Tasks", Keyword: "PotentialDiagnosticTasks", VR: "UC", VM: "1-n"},
+	{Tag: 0x0018990B, Name: "Contraindications Code Sequence", Keyword: "ContraindicationsCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x0018990C, Name: "Referenced Defined Protocol Sequence", Keyword: "ReferencedDefinedProtocolSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x0018990D, Name: "Referenced Performed Protocol Sequence", Keyword: "ReferencedPerformedProtocolSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x0018990E, Name: "Predecessor Protocol Sequence", Keyword: "PredecessorProtocolSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x0018990F, Name: "Protocol Planning Information", Keyword: "ProtocolPlanningInformation", VR: "UT", VM: "1"},
+	{Tag: 0x00189910, Name: "Protocol Design Rationale", Keyword: "ProtocolDesignRationale", VR: "UT", VM: "1"},
+	{Tag: 0x00189911, Name: "Patient Specification Sequence", Keyword: "PatientSpecificationSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00189912, Name: "Model Specification Sequence", Keyword: "ModelSpecificationSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00189913, Name: "Parameters Specification Sequence", Keyword: "ParametersSpecificationSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00189914, Name: "Instruction Sequence", Keyword: "InstructionSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00189915, Name: "Instruction Index", Keyword: "InstructionIndex", VR: "US", VM: "1"},
+	{Tag: 0x00189916, Name: "Instruction Text", Keyword: "InstructionText", VR: "LO", VM: "1"},
+	{Tag: 0x00189917, Name: "Instruction Description", Keyword: "InstructionDescription", VR: "UT", VM: "1"},
+	{Tag: 0x00189918, Name: "Instruction Performed Flag", Keyword: "InstructionPerformedFlag", VR: "CS", VM: "1"},
+	{Tag: 0x00189919, Name: "Instruction Performed DateTime", Keyword: "InstructionPerformedDateTime", VR: "DT", VM: "1"},
+	{Tag: 0x0018991A, Name: "Instruction Performance Comment", Keyword: "InstructionPerformanceComment", VR: "UT", VM: "1"},
+	{Tag: 0x0018991B, Name: "Patient Positioning Instruction Sequence", Keyword: "PatientPositioningInstructionSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x0018991C, Name: "Positioning Method Code Sequence", Keyword: "PositioningMethodCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x0018991D, Name: "Positioning Landmark Sequence", Keyword: "PositioningLandmarkSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x0018991E, Name: "Target Frame of Reference UID", Keyword: "TargetFrameOfReferenceUID", VR: "UI", VM: "1"},
+	{Tag: 0x0018991F, Name: "Acquisition Protocol Element Specification Sequence", Keyword: "AcquisitionProtocolElementSpecificationSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00189920, Name: "Acquisition Protocol Element Sequence", Keyword: "AcquisitionProtocolElementSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00189921, Name: "Protocol Element Number", Keyword: "ProtocolElementNumber", VR: "US", VM: "1"},
+	{Tag: 0x00189922, Name: "Protocol Element Name", Keyword: "ProtocolElementName", VR: "LO", VM: "1"},
+	{Tag: 0x00189923, Name: "Protocol Element Characteristics Summary", Keyword: "ProtocolElementCharacteristicsSummary", VR: "UT", VM: "1"},
+	{Tag: 0x00189924, Name: "Protocol Element Purpose", Keyword: "ProtocolElementPurpose", VR: "UT", VM: "1"},
+	{Tag: 0x00189930, Name: "Acquisition Motion", Keyword: "AcquisitionMotion", VR: "CS", VM: "1"},
+	{Tag: 0x00189931, Name: "Acquisition Start Location Sequence", Keyword: "AcquisitionStartLocationSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00189932, Name: "Acquisition End Location Sequence", Keyword: "AcquisitionEndLocationSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00189933, Name: "Reconstruction Protocol Element Specification Sequence", Keyword: "ReconstructionProtocolElementSpecificationSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00189934, Name: "Reconstruction Protocol Element Sequence", Keyword: "ReconstructionProtocolElementSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00189935, Name: "Storage Protocol Element Specification Sequence", Keyword: "StorageProtocolElementSpecificationSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00189936, Name: "Storage Protocol Element Sequence", Keyword: "StorageProtocolElementSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00189937, Name: "Requested Series Description", Keyword: "RequestedSeriesDescription", VR: "LO", VM: "1"},
+	{Tag: 0x00189938, Name: "Source Acquisition Protocol Element Number", Keyword: "SourceAcquisitionProtocolElementNumber", VR: "US", VM: "1-n"},
+	{Tag: 0x00189939, Name: "Source Acquisition Beam Number", Keyword: "SourceAcquisitionBeamNumber", VR: "US", VM: "1-n"},
+	{Tag: 0x0018993A, Name: "Source Reconstruction Protocol Element Number", Keyword: "SourceReconstructionProtocolElementNumber", VR: "US", VM: "1-n"},
+	{Tag: 0x0018993B, Name: "Reconstruction Start Location Sequence", Keyword: "ReconstructionStartLocationSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x0018993C, Name: "Reconstruction End Location Sequence", Keyword: "ReconstructionEndLocationSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x0018993D, Name: "Reconstruction Algorithm Sequence", Keyword: "ReconstructionAlgorithmSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x0018993E, Name: "Reconstruction Target Center Location Sequence", Keyword: "ReconstructionTargetCenterLocationSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00189941, Name: "Image Filter Description", Keyword: "ImageFilterDescription", VR: "UT", VM: "1"},
+	{Tag: 0x00189942, Name: "CTDIvol Notification Trigger", Keyword: "CTDIvolNotificationTrigger", VR: "FD", VM: "1"},
+	{Tag: 0x00189943, Name: "DLP Notification Trigger", Keyword: "DLPNotificationTrigger", VR: "FD", VM: "1"},
+	{Tag: 0x00189944, Name: "Auto KVP Selection Type", Keyword: "AutoKVPSelectionType", VR: "CS", VM: "1"},
+	{Tag: 0x00189945, Name: "Auto KVP Upper Bound", Keyword: "AutoKVPUpperBound", VR: "FD", VM: "1"},
+	{Tag: 0x00189946, Name: "Auto KVP Lower Bound", Keyword: "AutoKVPLowerBound", VR: "FD", VM: "1"},
+	{Tag: 0x00189947, Name: "Protocol Defined Patient Position", Keyword: "ProtocolDefinedPatientPosition", VR: "CS", VM: "1"},
+	{Tag: 0x0018A001, Name: "Contributing Equipment Sequence", Keyword: "ContributingEquipmentSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x0018A002, Name: "Contribution DateTime", Keyword: "ContributionDateTime", VR: "DT", VM: "1"},
+	{Tag: 0x0018A003, Name: "Contribution Description", Keyword: "ContributionDescription", VR: "ST", VM: "1"},
+	{Tag: 0x0020000D, Name: "Study Instance UID", Keyword: "StudyInstanceUID", VR: "UI", VM: "1"},
+	{Tag: 0x0020000E, Name: "Series Instance UID", Keyword: "SeriesInstanceUID", VR: "UI", VM: "1"},
+	{Tag: 0x00200010, Name: "Study ID", Keyword: "StudyID", VR: "SH", VM: "1"},
+	{Tag: 0x00200011, Name: "Series Number", Keyword: "SeriesNumber", VR: "IS", VM: "1"},
+	{Tag: 0x00200012, Name: "Acquisition Number", Keyword: "AcquisitionNumber", VR: "IS", VM: "1"},
+	{Tag: 0x00200013, Name: "Instance Number", Keyword: "InstanceNumber", VR: "IS", VM: "1"},
+	{Tag: 0x00200014, Name: "Isotope Number", Keyword: "IsotopeNumber", VR: "IS", VM: "1", Retired: true},
+	{Tag: 0x00200015, Name: "Phase Number", Keyword: "PhaseNumber", VR: "IS", VM: "1", Retired: true},
+	{Tag: 0x00200016, Name: "Interval Number", Keyword: "IntervalNumber", VR: "IS", VM: "1", Retired: true},
+	{Tag: 0x00200017, Name: "Time Slot Number", Keyword: "TimeSlotNumber", VR: "IS", VM: "1", Retired: true},
+	{Tag: 0x00200018, Name: "Angle Number", Keyword: "AngleNumber", VR: "IS", VM: "1", Retired: true},
+	{Tag: 0x00200019, Name: "Item Number", Keyword: "ItemNumber", VR: "IS", VM: "1"},
+	{Tag: 0x00200020, Name: "Patient Orientation", Keyword: "PatientOrientation", VR: "CS", VM: "2"},
+	{Tag: 0x00200022, Name: "Overlay Number", Keyword: "OverlayNumber", VR: "IS", VM: "1", Retired: true},
+	{Tag: 0x00200024, Name: "Curve Number", Keyword: "CurveNumber", VR: "IS", VM: "1", Retired: true},
+	{Tag: 0x00200026, Name: "LUT Number", Keyword: "LUTNumber", VR: "IS", VM: "1", Retired: true},
+	{Tag: 0x00200027, Name: "Pyramid Label", Keyword: "PyramidLabel", VR: "LO", VM: "1"},
+	{Tag: 0x00200030, Name: "Image Position", Keyword: "ImagePosition", VR: "DS", VM: "3", Retired: true},
+	{Tag: 0x00200032, Name: "Image Position (Patient)", Keyword: "ImagePositionPatient", VR: "DS", VM: "3"},
+	{Tag: 0x00200035, Name: "Image Orientation", Keyword: "ImageOrientation", VR: "DS", VM: "6", Retired: true},
+	{Tag: 0x00200037, Name: "Image Orientation (Patient)", Keyword: "ImageOrientationPatient", VR: "DS", VM: "6"},
+	{Tag: 0x00200050, Name: "Location", Keyword: "Location", VR: "DS", VM: "1", Retired: true},
+	{Tag: 0x00200052, Name: "Frame of Reference UID", Keyword: "FrameOfReferenceUID", VR: "UI", VM: "1"},
+	{Tag: 0x00200060, Name: "Laterality", Keyword: "Laterality", VR: "CS", VM: "1"},
+	{Tag: 0x00200062, Name: "Image Laterality", Keyword: "ImageLaterality", VR: "CS", VM: "1"},
+	{Tag: 0x00200070, Name: "Image Geometry Type", Keyword: "ImageGeometryType", VR: "LO", VM: "1", Retired: true},
+	{Tag: 0x00200080, Name: "Masking Image", Keyword: "MaskingImage", VR: "CS", VM: "1-n", Retired: true},
+	{Tag: 0x002000AA, Name: "Report Number", Keyword: "ReportNumber", VR: "IS", VM: "1", Retired: true},
+	{Tag: 0x00200100, Name: "Temporal Position Identifier", Keyword: "TemporalPositionIdentifier", VR: "IS", VM: "1"},
+	{Tag: 0x00200105, Name: "Number of Temporal Positions", Keyword: "NumberOfTemporalPositions", VR: "IS", VM: "1"},
+	{Tag: 0x00200110, Name: "Temporal Resolution", Keyword: "TemporalResolution", VR: "DS", VM: "1"},
+	{Tag: 0x00200200, Name: "Synchronization Frame of Reference UID", Keyword: "SynchronizationFrameOfReferenceUID", VR: "UI", VM: "1"},
+	{Tag: 0x00200242, Name: "SOP Instance UID of Concatenation Source", Keyword: "SOPInstanceUIDOfConcatenationSource", VR: "UI", VM: "1"},
+	{Tag: 0x00201000, Name: "Series in Study", Keyword: "SeriesInStudy", VR: "IS", VM: "1", Retired: true},
+	{Tag: 0x00201001, Name: "Acquisitions in Series", Keyword: "AcquisitionsInSeries", VR: "IS", VM: "1", Retired: true},
+	{Tag: 0x00201002, Name: "Images in Acquisition", Keyword: "ImagesInAcquisition", VR: "IS", VM: "1"},
+	{Tag: 0x00201003, Name: "Images in Series", Keyword: "ImagesInSeries", VR: "IS", VM: "1", Retired: true},
+	{Tag: 0x00201004, Name: "Acquisitions in Study", Keyword: "AcquisitionsInStudy", VR: "IS", VM: "1", Retired: true},
+	{Tag: 0x00201005, Name: "Images in Study", Keyword: "ImagesInStudy", VR: "IS", VM: "1", Retired: true},
+	{Tag: 0x00201020, Name: "Reference", Keyword: "Reference", VR: "LO", VM: "1-n", Retired: true},
+	{Tag: 0x0020103F, Name: "Target Position Reference Indicator", Keyword: "TargetPositionReferenceIndicator", VR: "LO", VM: "1"},
+	{Tag: 0x00201040, Name: "Position Reference Indicator", Keyword: "PositionReferenceIndicator", VR: "LO", VM: "1"},
+	{Tag: 0x00201041, Name: "Slice Location", Keyword: "SliceLocation", VR: "DS", VM: "1"},
+	{Tag: 0x00201070, Name: "Other Study Numbers", Keyword: "OtherStudyNumbers", VR: "IS", VM: "1-n", Retired: true},
+	{Tag: 0x00201200, Name: "Number of Patient Related Studies", Keyword: "NumberOfPatientRelatedStudies", VR: "IS", VM: "1"},
+	{Tag: 0x00201202, Name: "Number of Patient Related Series", Keyword: "NumberOfPatientRelatedSeries", VR: "IS", VM: "1"},
+	{Tag: 0x00201204, Name: "Number of Patient Related Instances", Keyword: "NumberOfPatientRelatedInstances", VR: "IS", VM: "1"},
+	{Tag: 0x00201206, Name: "Number of Study Related Series", Keyword: "NumberOfStudyRelatedSeries", VR: "IS", VM: "1"},
+	{Tag: 0x00201208, Name: "Number of Study Related Instances", Keyword: "NumberOfStudyRelatedInstances", VR: "IS", VM: "1"},
+	{Tag: 0x00201209, Name: "Number of Series Related Instances", Keyword: "NumberOfSeriesRelatedInstances", VR: "IS", VM: "1"},
+	{Tag: 0x00203401, Name: "Modifying Device ID", Keyword: "ModifyingDeviceID", VR: "CS", VM: "1", Retired: true},
+	{Tag: 0x00203402, Name: "Modified Image ID", Keyword: "ModifiedImageID", VR: "CS", VM: "1", Retired: true},
+	{Tag: 0x00203403, Name: "Modified Image Date", Keyword: "ModifiedImageDate", VR: "DA", VM: "1", Retired: true},
+	{Tag: 0x00203404, Name: "Modifying Device Manufacturer", Keyword: "ModifyingDeviceManufacturer", VR: "LO", VM: "1", Retired: true},
+	{Tag: 0x00203405, Name: "Modified Image Time", Keyword: "ModifiedImageTime", VR: "TM", VM: "1", Retired: true},
+	{Tag: 0x00203406, Name: "Modified Image Description", Keyword: "ModifiedImageDescription", VR: "LO", VM: "1", Retired: true},
+	{Tag: 0x00204000, Name: "Image Comments", Keyword: "ImageComments", VR: "LT", VM: "1"},
+	{Tag: 0x00205000, Name: "Original Image Identification", Keyword: "OriginalImageIdentification", VR: "AT", VM: "1-n", Retired: true},
+	{Tag: 0x00205002, Name: "Original Image Identification Nomenclature", Keyword: "OriginalImageIdentificationNomenclature", VR: "LO", VM: "1-n", Retired: true},
+	{Tag: 0x00209056, Name: "Stack ID", Keyword: "StackID", VR: "SH", VM: "1"},
+	{Tag: 0x00209057, Name: "In-Stack Position Number", Keyword: "InStackPositionNumber", VR: "UL", VM: "1"},
+	{Tag: 0x00209071, Name: "Frame Anatomy Sequence", Keyword: "FrameAnatomySequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00209072, Name: "Frame Laterality", Keyword: "FrameLaterality", VR: "CS", VM: "1"},
+	{Tag: 0x00209111, Name: "Frame Content Sequence", Keyword: "FrameContentSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00209113, Name: "Plane Position Sequence", Keyword: "PlanePositionSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00209116, Name: "Plane Orientation Sequence", Keyword: "PlaneOrientationSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00209128, Name: "Temporal Position Index", Keyword: "TemporalPositionIndex", VR: "UL", VM: "1"},
+	{Tag: 0x00209153, Name: "Nominal Cardiac Trigger Delay Time", Keyword: "NominalCardiacTriggerDelayTime", VR: "FD", VM: "1"},
+	{Tag: 0x00209154, Name: "Nominal Cardiac Trigger Time Prior To R-Peak", Keyword: "NominalCardiacTriggerTimePriorToRPeak", VR: "FL", VM: "1"},
+	{Tag: 0x00209155, Name: "Actual Cardiac Trigger Time Prior To R-Peak", Keyword: "ActualCardiacTriggerTimePriorToRPeak", VR: "FL", VM: "1"},
+	{Tag: 0x00209156, Name: "Frame Acquisition Number", Keyword: "FrameAcquisitionNumber", VR: "US", VM: "1"},
+	{Tag: 0x00209157, Name: "Dimension Index Values", Keyword: "DimensionIndexValues", VR: "UL", VM: "1-n"},
+	{Tag: 0x00209158, Name: "Frame Comments", Keyword: "FrameComments", VR: "LT", VM: "1"},
+	{Tag: 0x00209161, Name: "Concatenation UID", Keyword: "ConcatenationUID", VR: "UI", VM: "1"},
+	{Tag: 0x00209162, Name: "In-concatenation Number", Keyword: "InConcatenationNumber", VR: "US", VM: "1"},
+	{Tag: 0x00209163, Name: "In-concatenation Total Number", Keyword: "InConcatenationTotalNumber", VR: "US", VM: "1"},
+	{Tag: 0x00209164, Name: "Dimension Organization UID", Keyword: "DimensionOrganizationUID", VR: "UI", VM: "1"},
+	{Tag: 0x00209165, Name: "Dimension Index Pointer", Keyword: "DimensionIndexPointer", VR: "AT", VM: "1"},
+	{Tag: 0x00209167, Name: "Functional Group Pointer", Keyword: "FunctionalGroupPointer", VR: "AT", VM: "1"},
+	{Tag: 0x00209170, Name: "Unassigned Shared Converted Attributes Sequence", Keyword: "UnassignedSharedConvertedAttributesSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00209171, Name: "Unassigned Per-Frame Converted Attributes Sequence", Keyword: "UnassignedPerFrameConvertedAttributesSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00209172, Name: "Conversion Source Attributes Sequence", Keyword: "ConversionSourceAttributesSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00209213, Name: "Dimension Index Private Creator", Keyword: "DimensionIndexPrivateCreator", VR: "LO", VM: "1"},
+	{Tag: 0x00209221, Name: "Dimension Organization Sequence", Keyword: "DimensionOrganizationSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00209222, Name: "Dimension Index Sequence", Keyword: "DimensionIndexSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00209228, Name: "Concatenation Frame Offset Number", Keyword: "ConcatenationFrameOffsetNumber", VR: "UL", VM: "1"},
+	{Tag: 0x00209238, Name: "Functional Group Private Creator", Keyword: "FunctionalGroupPrivateCreator", VR: "LO", VM: "1"},
+	{Tag: 0x00209241, Name: "Nominal Percentage of Cardiac Phase", Keyword: "NominalPercentageOfCardiacPhase", VR: "FL", VM: "1"},
+	{Tag: 0x00209245, Name: "Nominal Percentage of Respiratory Phase", Keyword: "NominalPercentageOfRespiratoryPhase", VR: "FL", VM: "1"},
+	{Tag: 0x00209246, Name: "Starting Respiratory Amplitude", Keyword: "StartingRespiratoryAmplitude", VR: "FL", VM: "1"},
+	{Tag: 0x00209247, Name: "Starting Respiratory Phase", Keyword: "StartingRespiratoryPhase", VR: "CS", VM: "1"},
+	{Tag: 0x00209248, Name: "Ending Respiratory Amplitude", Keyword: "EndingRespiratoryAmplitude", VR: "FL", VM: "1"},
+	{Tag: 0x00209249, Name: "Ending Respiratory Phase", Keyword: "EndingRespiratoryPhase", VR: "CS", VM: "1"},
+	{Tag: 0x00209250, Name: "Respiratory Trigger Type", Keyword: "RespiratoryTriggerType", VR: "CS", VM: "1"},
+	{Tag: 0x00209251, Name: "R-R Interval Time Nominal", Keyword: "RRIntervalTimeNominal", VR: "FD", VM: "1"},
+	{Tag: 0x00209252, Name: "Actual Cardiac Trigger Delay Time", Keyword: "ActualCardiacTriggerDelayTime", VR: "FD", VM: "1"},
+	{Tag: 0x00209253, Name: "Respiratory Synchronization Sequence", Keyword: "RespiratorySynchronizationSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00209254, Name: "Respiratory Interval Time", Keyword: "RespiratoryIntervalTime", VR: "FD", VM: "1"},
+	{Tag: 0x00209255, Name: "Nominal Respiratory Trigger Delay Time", Keyword: "NominalRespiratoryTriggerDelayTime", VR: "FD", VM: "1"},
+	{Tag: 0x00209256, Name: "Respiratory Trigger Delay Threshold", Keyword: "RespiratoryTriggerDelayThreshold", VR: "FD", VM: "1"},
+	{Tag: 0x00209257, Name: "Actual Respiratory Trigger Delay Time", Keyword: "ActualRespiratoryTriggerDelayTime", VR: "FD", VM: "1"},
+	{Tag: 0x00209301, Name: "Image Position (Volume)", Keyword: "ImagePositionVolume", VR: "FD", VM: "3"},
+	{Tag: 0x00209302, Name: "Image Orientation (Volume)", Keyword: "ImageOrientationVolume", VR: "FD", VM: "6"},
+	{Tag: 0x00209307, Name: "Ultrasound Acquisition Geometry", Keyword: "UltrasoundAcquisitionGeometry", VR: "CS", VM: "1"},
+	{Tag: 0x00209308, Name: "Apex Position", Keyword: "ApexPosition", VR: "FD", VM: "3"},
+	{Tag: 0x00209309, Name: "Volume to Transducer Mapping Matrix", Keyword: "VolumeToTransducerMappingMatrix", VR: "FD", VM: "16"},
+	{Tag: 0x0020930A, Name: "Volume to Table Mapping Matrix", Keyword: "VolumeToTableMappingMatrix", VR: "FD", VM: "16"},
+	{Tag: 0x0020930B, Name: "Volume to Transducer Relationship", Keyword: "VolumeToTransducerRelationship", VR: "CS", VM: "1"},
+	{Tag: 0x0020930C, Name: "Patient Frame of Reference Source", Keyword: "PatientFrameOfReferenceSource", VR: "CS", VM: "1"},
+	{Tag: 0x0020930D, Name: "Temporal Position Time Offset", Keyword: "TemporalPositionTimeOffset", VR: "FD", VM: "1"},
+	{Tag: 0x0020930E, Name: "Plane Position (Volume) Sequence", Keyword: "PlanePositionVolumeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x0020930F, Name: "Plane Orientation (Volume) Sequence", Keyword: "PlaneOrientationVolumeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00209310, Name: "Temporal Position Sequence", Keyword: "TemporalPositionSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00209311, Name: "Dimension Organization Type", Keyword: "DimensionOrganizationType", VR: "CS", VM: "1"},
+	{Tag: 0x00209312, Name: "Volume Frame of Reference UID", Keyword: "VolumeFrameOfReferenceUID", VR: "UI", VM: "1"},
+	{Tag: 0x00209313, Name: "Table Frame of Reference UID", Keyword: "TableFrameOfReferenceUID", VR: "UI", VM: "1"},
+	{Tag: 0x00209421, Name: "Dimension Description Label", Keyword: "DimensionDescriptionLabel", VR: "LO", VM: "1"},
+	{Tag: 0x00209450, Name: "Patient Orientation in Frame Sequence", Keyword: "PatientOrientationInFrameSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00209453, Name: "Frame Label", Keyword: "FrameLabel", VR: "LO", VM: "1"},
+	{Tag: 0x00209518, Name: "Acquisition Index", Keyword: "AcquisitionIndex", VR: "US", VM: "1-n"},
+	{Tag: 0x00209529, Name: "Contributing SOP Instances Reference Sequence", Keyword: "ContributingSOPInstancesReferenceSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00209536, Name: "Reconstruction Index", Keyword: "ReconstructionIndex", VR: "US", VM: "1"},
+	{Tag: 0x00220001, Name: "Light Path Filter Pass-Through Wavelength", Keyword: "LightPathFilterPassThroughWavelength", VR: "US", VM: "1"},
+	{Tag: 0x00220002, Name: "Light Path Filter Pass Band", Keyword: "LightPathFilterPassBand", VR: "US", VM: "2"},
+	{Tag: 0x00220003, Name: "Image Path Filter Pass-Through Wavelength", Keyword: "ImagePathFilterPassThroughWavelength", VR: "US", VM: "1"},
+	{Tag: 0x00220004, Name: "Image Path Filter Pass Band", Keyword: "ImagePathFilterPassBand", VR: "US", VM: "2"},
+	{Tag: 0x00220005, Name: "Patient Eye Movement Commanded", Keyword: "PatientEyeMovementCommanded", VR: "CS", VM: "1"},
+	{Tag: 0x00220006, Name: "Patient Eye Movement Command Code Sequence", Keyword: "PatientEyeMovementCommandCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00220007, Name: "Spherical Lens Power", Keyword: "SphericalLensPower", VR: "FL", VM: "1"},
+	{Tag: 0x00220008, Name: "Cylinder Lens Power", Keyword: "CylinderLensPower", VR: "FL", VM: "1"},
+	{Tag: 0x00220009, Name: "Cylinder Axis", Keyword: "CylinderAxis", VR: "FL", VM: "1"},
+	{Tag: 0x0022000A, Name: "Emmetropic Magnification", Keyword: "EmmetropicMagnification", VR: "FL", VM: "1"},
+	{Tag: 0x0022000B, Name: "Intra Ocular Pressure", Keyword: "IntraOcularPressure", VR: "FL", VM: "1"},
+	{Tag: 0x0022000C, Name: "Horizontal Field of View", Keyword: "HorizontalFieldOfView", VR: "FL", VM: "1"},
+	{Tag: 0x0022000D, Name: "Pupil Dilated", Keyword: "PupilDilated", VR: "CS", VM: "1"},
+	{Tag: 0x0022000E, Name: "Degree of Dilation", Keyword: "DegreeOfDilation", VR: "FL", VM: "1"},
+	{Tag: 0x0022000F, Name: "Vertex Distance", Keyword: "VertexDistance", VR: "FD", VM: "1"},
+	{Tag: 0x00220010, Name: "Stereo Baseline Angle", Keyword: "StereoBaselineAngle", VR: "FL", VM: "1"},
+	{Tag: 0x00220011, Name: "Stereo Baseline Displacement", Keyword: "StereoBaselineDisplacement", VR: "FL", VM: "1"},
+	{Tag: 0x00220012, Name: "Stereo Horizontal Pixel Offset", Keyword: "StereoHorizontalPixelOffset", VR: "FL", VM: "1"},
+	{Tag: 0x00220013, Name: "Stereo Vertical Pixel Offset", Keyword: "StereoVerticalPixelOffset", VR: "FL", VM: "1"},
+	{Tag: 0x00220014, Name: "Stereo Rotation", Keyword: "StereoRotation", VR: "FL", VM: "1"},
+	{Tag: 0x00220015, Name: "Acquisition Device Type Code Sequence", Keyword: "AcquisitionDeviceTypeCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00220016, Name: "Illumination Type Code Sequence", Keyword: "IlluminationTypeCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00220017, Name: "Light Path Filter Type Stack Code Sequence", Keyword: "LightPathFilterTypeStackCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00220018, Name: "Image Path Filter Type Stack Code Sequence", Keyword: "ImagePathFilterTypeStackCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00220019, Name: "Lenses Code Sequence", Keyword: "LensesCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x0022001A, Name: "Channel Description Code Sequence", Keyword: "ChannelDescriptionCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x0022001B, Name: "Refractive State Sequence", Keyword: "RefractiveStateSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x0022001C, Name: "Mydriatic Agent Code Sequence", Keyword: "MydriaticAgentCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x0022001D, Name: "Relative Image Position Code Sequence", Keyword: "RelativeImagePositionCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x0022001E, Name: "Camera Angle of View", Keyword: "CameraAngleOfView", VR: "FL", VM: "1"},
+	{Tag: 0x00220020, Name: "Stereo Pairs Sequence", Keyword: "StereoPairsSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00220021, Name: "Left Image Sequence", Keyword: "LeftImageSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00220022, Name: "Right Image Sequence", Keyword: "RightImageSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00220028, Name: "Stereo Pairs Present", Keyword: "StereoPairsPresent", VR: "CS", VM: "1"},
+	{Tag: 0x00220030, Name: "Axial Length of the Eye", Keyword: "AxialLengthOfTheEye", VR: "FL", VM: "1"},
+	{Tag: 0x00220031, Name: "Ophthalmic Frame Location Sequence", Keyword: "OphthalmicFrameLocationSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00220032, Name: "Reference Coordinates", Keyword: "ReferenceCoordinates", VR: "FL", VM: "2-2n"},
+	{Tag: 0x00220035, Name: "Depth Spatial Resolution", Keyword: "DepthSpatialResolution", VR: "FL", VM: "1"},
+	{Tag: 0x00220036, Name: "Maximum Depth Distortion", Keyword: "MaximumDepthDistortion", VR: "FL", VM: "1"},
+	{Tag: 0x00220037, Name: "Along-scan Spatial Resolution", Keyword: "AlongScanSpatialResolution", VR: "FL", VM: "1"},
+	{Tag: 0x00220038, Name: "Maximum Along-scan Distortion", Keyword: "MaximumAlongScanDistortion", VR: "FL", VM: "1"},
+	{Tag: 0x00220039, Name: "Ophthalmic Image Orientation", Keyword: "OphthalmicImageOrientation", VR: "CS", VM: "1"},
+	{Tag: 0x00220041, Name: "Depth of Transverse Image", Keyword: "DepthOfTransverseImage", VR: "FL", VM: "1"},
+	{Tag: 0x00220042, Name: "Mydriatic Agent Concentration Units Sequence", Keyword: "MydriaticAgentConcentrationUnitsSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00220048, Name: "Across-scan Spatial Resolution", Keyword: "AcrossScanSpatialResolution", VR: "FL", VM: "1"},
+	{Tag: 0x00220049, Name: "Maximum Across-scan Distortion", Keyword: "MaximumAcrossScanDistortion", VR: "FL", VM: "1"},
+	{Tag: 0x0022004E, Name: "Mydriatic Agent Concentration", Keyword: "MydriaticAgentConcentration", VR: "DS", VM: "1"},
+	{Tag: 0x00220055, Name: "Illumination Wave Length", Keyword: "IlluminationWaveLength", VR: "FL", VM: "1"},
+	{Tag: 0x00220056, Name: "Illumination Power", Keyword: "IlluminationPower", VR: "FL", VM: "1"},
+	{Tag: 0x00220057, Name: "Illumination Bandwidth", Keyword: "IlluminationBandwidth", VR: "FL", VM: "1"},
+	{Tag: 0x00220058, Name: "Mydriatic Agent Sequence", Keyword: "MydriaticAgentSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00221007, Name: "Ophthalmic Axial Measurements Right Eye Sequence", Keyword: "OphthalmicAxialMeasurementsRightEyeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00221008, Name: "Ophthalmic Axial Measurements Left Eye Sequence", Keyword: "OphthalmicAxialMeasurementsLeftEyeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00221009, Name: "Ophthalmic Axial Measurements Device Type", Keyword: "OphthalmicAxialMeasurementsDeviceType", VR: "CS", VM: "1"},
+	{Tag: 0x00221010, Name: "Ophthalmic Axial Length Measurements Type", Keyword: "OphthalmicAxialLengthMeasurementsType", VR: "CS", VM: "1"},
+	{Tag: 0x00221012, Name: "Ophthalmic Axial Length Sequence", Keyword: "OphthalmicAxialLengthSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00221019, Name: "Ophthalmic Axial Length", Keyword: "OphthalmicAxialLength", VR: "FL", VM: "1"},
+	{Tag: 0x00221024, Name: "Lens Status Code Sequence", Keyword: "LensStatusCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00221025, Name: "Vitreous Status Code Sequence", Keyword: "VitreousStatusCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00221028, Name: "IOL Formula Code Sequence", Keyword: "IOLFormulaCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00221029, Name: "IOL Formula Detail", Keyword: "IOLFormulaDetail", VR: "LO", VM: "1"},
+	{Tag: 0x00221033, Name: "Keratometer Index", Keyword: "KeratometerIndex", VR: "FL", VM: "1"},
+	{Tag: 0x00221035, Name: "Source of Ophthalmic Axial Length Code Sequence", Keyword: "SourceOfOphthalmicAxialLengthCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00221036, Name: "Source of Corneal Size Data Code Sequence", Keyword: "SourceOfCornealSizeDataCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00221037, Name: "Target Refraction", Keyword: "TargetRefraction", VR: "FL", VM: "1"},
+	{Tag: 0x00221039, Name: "Refractive Procedure Occurred", Keyword: "RefractiveProcedureOccurred", VR: "CS", VM: "1"},
+	{Tag: 0x00221040, Name: "Refractive Surgery Type Code Sequence", Keyword: "RefractiveSurgeryTypeCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00221044, Name: "Ophthalmic Ultrasound Method Code Sequence", Keyword: "OphthalmicUltrasoundMethodCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00221045, Name: "Surgically Induced Astigmatism Sequence", Keyword: "SurgicallyInducedAstigmatismSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00221046, Name: "Type of Optical Correction", Keyword: "TypeOfOpticalCorrection", VR: "CS", VM: "1"},
+	{Tag: 0x00221047, Name: "Toric IOL Power Sequence", Keyword: "ToricIOLPowerSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00221048, Name: "Predicted Toric Error Sequence", Keyword: "PredictedToricErrorSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00221049, Name: "Pre-Selected for Implantation", Keyword: "PreSelectedForImplantation", VR: "CS", VM: "1"},
+	{Tag: 0x0022104A, Name: "Toric IOL Power for Exact Emmetropia Sequence", Keyword: "ToricIOLPowerForExactEmmetropiaSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x0022104B, Name: "Toric IOL Power for Exact Target Refraction Sequence", Keyword: "ToricIOLPowerForExactTargetRefractionSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00221050, Name: "Ophthalmic Axial Length Measurements Sequence", Keyword: "OphthalmicAxialLengthMeasurementsSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00221053, Name: "IOL Power", Keyword: "IOLPower", VR: "FL", VM: "1"},
+	{Tag: 0x00221054, Name: "Predicted Refractive Error", Keyword: "PredictedRefractiveError", VR: "FL", VM: "1"},
+	{Tag: 0x00221059, Name: "Ophthalmic Axial Length Velocity", Keyword: "OphthalmicAxialLengthVelocity", VR: "FL", VM: "1"},
+	{Tag: 0x00221065, Name: "Lens Status Description", Keyword: "LensStatusDescription", VR: "LO", VM: "1"},
+	{Tag: 0x00221066, Name: "Vitreous Status Description", Keyword: "VitreousStatusDescription", VR: "LO", VM: "1"},
+	{Tag: 0x00221090, Name: "IOL Power Sequence", Keyword: "IOLPowerSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00221092, Name: "Lens Constant Sequence", Keyword: "LensConstantSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00221093, Name: "IOL Manufacturer", Keyword: "IOLManufacturer", VR: "LO", VM: "1"},
+	{Tag: 0x00221094, Name: "Lens Constant Description", Keyword: "LensConstantDescription", VR: "LO", VM: "1", Retired: true},
+	{Tag: 0x00221095, Name: "Implant Name", Keyword: "ImplantName", VR: "LO", VM: "1"},
+	{Tag: 0x00221096, Name: "Keratometry Measurement Type Code Sequence", Keyword: "KeratometryMeasurementTypeCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00221097, Name: "Implant Part Number", Keyword: "ImplantPartNumber", VR: "LO", VM: "1"},
+	{Tag: 0x00221100, Name: "Referenced Ophthalmic Axial Measurements Sequence", Keyword: "ReferencedOphthalmicAxialMeasurementsSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00221101, Name: "Ophthalmic Axial Length Measurements Segment Name Code Sequence", Keyword: "OphthalmicAxialLengthMeasurementsSegmentNameCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00221103, Name: "Refractive Error Before Refractive Surgery Code Sequence", Keyword: "RefractiveErrorBeforeRefractiveSurgeryCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00221121, Name: "IOL Power For Exact Emmetropia", Keyword: "IOLPowerForExactEmmetropia", VR: "FL", VM: "1"},
+	{Tag: 0x00221122, Name: "IOL Power For Exact Target Refraction", Keyword: "IOLPowerForExactTargetRefraction", VR: "FL", VM: "1"},
+	{Tag: 0x00221125, Name: "Anterior Chamber Depth Definition Code Sequence", Keyword: "AnteriorChamberDepthDefinitionCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00221127, Name: "Lens Thickness Sequence", Keyword: "LensThicknessSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00221128, Name: "Anterior Chamber Depth Sequence", Keyword: "AnteriorChamberDepthSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x0022112A, Name: "Calculation Comment Sequence", Keyword: "CalculationCommentSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x0022112B, Name: "Calculation Comment Type", Keyword: "CalculationCommentType", VR: "CS", VM: "1"},
+	{Tag: 0x0022112C, Name: "Calculation Comment", Keyword: "CalculationComment", VR: "LT", VM: "1"},
+	{Tag: 0x00221130, Name: "Lens Thickness", Keyword: "LensThickness", VR: "FL", VM: "1"},
+	{Tag: 0x00221131, Name: "Anterior Chamber Depth", Keyword: "AnteriorChamberDepth", VR: "FL", VM: "1"},
+	{Tag: 0x00221132, Name: "Source of Lens Thickness Data Code Sequence", Keyword: "SourceOfLensThicknessDataCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00221133, Name: "Source of Anterior Chamber Depth Data Code Sequence", Keyword: "SourceOfAnteriorChamberDepthDataCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00221134, Name: "Source of Refractive Measurements Sequence", Keyword: "SourceOfRefractiveMeasurementsSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00221135, Name: "Source of Refractive Measurements Code Sequence", Keyword: "SourceOfRefractiveMeasurementsCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00221140, Name: "Ophthalmic Axial Length Measurement Modified", Keyword: "OphthalmicAxialLengthMeasurementModified", VR: "CS", VM: "1"},
+	{Tag: 0x00221150, Name: "Ophthalmic Axial Length Data Source Code Sequence", Keyword: "OphthalmicAxialLengthDataSourceCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00221153, Name: "Ophthalmic Axial Length Acquisition Method Code Sequence", Keyword: "OphthalmicAxialLengthAcquisitionMethodCodeSequence", VR: "SQ", VM: "1", Retired: true},
+	{Tag: 0x00221155, Name: "Signal to Noise Ratio", Keyword: "SignalToNoiseRatio", VR: "FL", VM: "1"},
+	{Tag: 0x00221159, Name: "Ophthalmic Axial Length Data Source Description", Keyword: "OphthalmicAxialLengthDataSourceDescription", VR: "LO", VM: "1"},
+	{Tag: 0x00221210, Name: "Ophthalmic Axial Length Measurements Total Length Sequence", Keyword: "OphthalmicAxialLengthMeasurementsTotalLengthSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00221211, Name: "Ophthalmic Axial Length Measurements Segmental Length Sequence", Keyword: "OphthalmicAxialLengthMeasurementsSegmentalLengthSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00221212, Name: "Ophthalmic Axial Length Measurements Length Summation Sequence", Keyword: "OphthalmicAxialLengthMeasurementsLengthSummationSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00221220, Name: "Ultrasound Ophthalmic Axial Length Measurements Sequence", Keyword: "UltrasoundOphthalmicAxialLengthMeasurementsSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00221225, Name: "Optical Ophthalmic Axial Length Measurements Sequence", Keyword: "OpticalOphthalmicAxialLengthMeasurementsSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00221230, Name: "Ultrasound Selected Ophthalmic Axial Length Sequence", Keyword: "UltrasoundSelectedOphthalmicAxialLengthSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00221250, Name: "Ophthalmic Axial Length Selection Method Code Sequence", Keyword: "OphthalmicAxialLengthSelectionMethodCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00221255, Name: "Optical Selected Ophthalmic Axial Length Sequence", Keyword: "OpticalSelectedOphthalmicAxialLengthSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00221257, Name: "Selected Segmental Ophthalmic Axial Length Sequence", Keyword: "SelectedSegmentalOphthalmicAxialLengthSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00221260, Name: "Selected Total Ophthalmic Axial Length Sequence", Keyword: "SelectedTotalOphthalmicAxialLengthSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00221262, Name: "Ophthalmic Axial Length Quality Metric Sequence", Keyword: "OphthalmicAxialLengthQualityMetricSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00221265, Name: "Ophthalmic Axial Length Quality Metric Type Code Sequence", Keyword: "OphthalmicAxialLengthQualityMetricTypeCodeSequence", VR: "SQ", VM: "1", Retired: true},
+	{Tag: 0x00221273, Name: "Ophthalmic Axial Length Quality Metric Type Description", Keyword: "OphthalmicAxialLengthQualityMetricTypeDescription", VR: "LO", VM: "1", Retired: true},
+	{Tag: 0x00221300, Name: "Intraocular Lens Calculations Right Eye Sequence", Keyword: "IntraocularLensCalculationsRightEyeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00221310, Name: "Intraocular Lens Calculations Left Eye Sequence", Keyword: "IntraocularLensCalculationsLeftEyeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00221330, Name: "Referenced Ophthalmic Axial Length Measurement QC Image Sequence", Keyword: "ReferencedOphthalmicAxialLengthMeasurementQCImageSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00221415, Name: "Ophthalmic Mapping Device Type", Keyword: "OphthalmicMappingDeviceType", VR: "CS", VM: "1"},
+	{Tag: 0x00221420, Name: "Acquisition Method Code Sequence", Keyword: "AcquisitionMethodCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00221423, Name: "Acquisition Method Algorithm Sequence", Keyword: "AcquisitionMethodAlgorithmSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00221436, Name: "Ophthalmic Thickness Map Type Code Sequence", Keyword: "OphthalmicThicknessMapTypeCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00221443, Name: "Ophthalmic Thickness Mapping Normals Sequence", Keyword: "OphthalmicThicknessMappingNormalsSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00221445, Name: "Retinal Thickness Definition Code Sequence", Keyword: "RetinalThicknessDefinitionCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00221450, Name: "Pixel Value Mapping to Coded Concept Sequence", Keyword: "PixelValueMappingToCodedConceptSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00221452, Name: "Mapped Pixel Value", Keyword: "MappedPixelValue", VR: "US or SS", VM: "1"},
+	{Tag: 0x00221454, Name: "Pixel Value Mapping Explanation", Keyword: "PixelValueMappingExplanation", VR: "LO", VM: "1"},
+	{Tag: 0x00221458, Name: "Ophthalmic Thickness Map Quality Threshold Sequence", Keyword: "OphthalmicThicknessMapQualityThresholdSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00221460, Name: "Ophthalmic Thickness Map Threshold Quality Rating", Keyword: "OphthalmicThicknessMapThresholdQualityRating", VR: "FL", VM: "1"},
+	{Tag: 0x00221463, Name: "Anatomic Structure Reference Point", Keyword: "AnatomicStructureReferencePoint", VR: "FL", VM: "2"},
+	{Tag: 0x00221465, Name: "Registration to Localizer Sequence", Keyword: "RegistrationToLocalizerSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00221466, Name: "Registered Localizer Units", Keyword: "RegisteredLocalizerUnits", VR: "CS", VM: "1"},
+	{Tag: 0x00221467, Name: "Registered Localizer Top Left Hand Corner", Keyword: "RegisteredLocalizerTopLeftHandCorner", VR: "FL", VM: "2"},
+	{Tag: 0x00221468, Name: "Registered Localizer Bottom Right Hand Corner", Keyword: "RegisteredLocalizerBottomRightHandCorner", VR: "FL", VM: "2"},
+	{Tag: 0x00221470, Name: "Ophthalmic Thickness Map Quality Rating Sequence", Keyword: "OphthalmicThicknessMapQualityRatingSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00221472, Name: "Relevant OPT Attributes Sequence", Keyword: "RelevantOPTAttributesSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00221512, Name: "Transformation Method Code Sequence", Keyword: "TransformationMethodCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00221513, Name: "Transformation Algorithm Sequence", Keyword: "TransformationAlgorithmSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00221515, Name: "Ophthalmic Axial Length Method", Keyword: "OphthalmicAxialLengthMethod", VR: "CS", VM: "1"},
+	{Tag: 0x00221517, Name: "Ophthalmic FOV", Keyword: "OphthalmicFOV", VR: "FL", VM: "1"},
+	{Tag: 0x00221518, Name: "Two Dimensional to Three Dimensional Map Sequence", Keyword: "TwoDimensionalToThreeDimensionalMapSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00221525, Name: "Wide Field Ophthalmic Photography Quality Rating Sequence", Keyword: "WideFieldOphthalmicPhotographyQualityRatingSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00221526, Name: "Wide Field Ophthalmic Photography Quality Threshold Sequence", Keyword: "WideFieldOphthalmicPhotographyQualityThresholdSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00221527, Name: "Wide Field Ophthalmic Photography Threshold Quality Rating", Keyword: "WideFieldOphthalmicPhotographyThresholdQualityRating", VR: "FL", VM: "1"},
+	{Tag: 0x00221528, Name: "X Coordinates Center Pixel View Angle", Keyword: "XCoordinatesCenterPixelViewAngle", VR: "FL", VM: "1"},
+	{Tag: 0x00221529, Name: "Y Coordinates Center Pixel View Angle", Keyword: "YCoordinatesCenterPixelViewAngle", VR: "FL", VM: "1"},
+	{Tag: 0x00221530, Name: "Number of Map Points", Keyword: "NumberOfMapPoints", VR: "UL", VM: "1"},
+	{Tag: 0x00221531, Name: "Two Dimensional to Three Dimensional Map Data", Keyword: "TwoDimensionalToThreeDimensionalMapData", VR: "OF", VM: "1"},
+	{Tag: 0x00221612, Name: "Derivation Algorithm Sequence", Keyword: "DerivationAlgorithmSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00221615, Name: "Ophthalmic Image Type Code Sequence", Keyword: "OphthalmicImageTypeCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00221616, Name: "Ophthalmic Image Type Description", Keyword: "OphthalmicImageTypeDescription", VR: "LO", VM: "1"},
+	{Tag: 0x00221618, Name: "Scan Pattern Type Code Sequence", Keyword: "ScanPatternTypeCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00221620, Name: "Referenced Surface Mesh Identification Sequence", Keyword: "ReferencedSurfaceMeshIdentificationSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00221622, Name: "Ophthalmic Volumetric Properties Flag", Keyword: "OphthalmicVolumetricPropertiesFlag", VR: "CS", VM: "1"},
+	{Tag: 0x00221624, Name: "Ophthalmic Anatomic Reference Point X-Coordinate", Keyword: "OphthalmicAnatomicReferencePointXCoordinate", VR: "FL", VM: "1"},
+	{Tag: 0x00221626, Name: "Ophthalmic Anatomic Reference Point Y-Coordinate", Keyword: "OphthalmicAnatomicReferencePointYCoordinate", VR: "FL", VM: "1"},
+	{Tag: 0x00221628, Name: "Ophthalmic En Face Image Quality Rating Sequence", Keyword: "OphthalmicEnFaceImageQualityRatingSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00221630, Name: "Quality Threshold", Keyword: "QualityThreshold", VR: "DS", VM: "1"},
+	{Tag: 0x00221640, Name: "OCT B-scan Analysis Acquisition Parameters Sequence", Keyword: "OCTBscanAnalysisAcquisitionParametersSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00221642, Name: "Number of B-scans Per Frame", Keyword: "NumberOfBscansPerFrame", VR: "UL", VM: "1"},
+	{Tag: 0x00221643, Name: "B-scan Slab Thickness", Keyword: "BscanSlabThickness", VR: "FL", VM: "1"},
+	{Tag: 0x00221644, Name: "Distance Between B-scan Slabs", Keyword: "DistanceBetweenBscanSlabs", VR: "FL", VM: "1"},
+	{Tag: 0x00221645, Name: "B-scan Cycle Time", Keyword: "BscanCycleTime", VR: "FL", VM: "1"},
+	{Tag: 0x00221646, Name: "B-scan Cycle Time Vector", Keyword: "BscanCycleTimeVector", VR: "FL", VM: "1-n"},
+	{Tag: 0x00221649, Name: "A-scan Rate", Keyword: "AscanRate", VR: "FL", VM: "1"},
+	{Tag: 0x00221650, Name: "B-scan Rate", Keyword: "BscanRate", VR: "FL", VM: "1"},
+	{Tag: 0x00221658, Name: "Surface Mesh Z-Pixel Offset", Keyword: "SurfaceMeshZPixelOffset", VR: "UL", VM: "1"},
+	{Tag: 0x00240010, Name: "Visual Field Horizontal Extent", Keyword: "VisualFieldHorizontalExtent", VR: "FL", VM: "1"},
+	{Tag: 0x00240011, Name: "Visual Field Vertical Extent", Keyword: "VisualFieldVerticalExtent", VR: "FL", VM: "1"},
+	{Tag: 0x00240012, Name: "Visual Field Shape", Keyword: "VisualFieldShape", VR: "CS", VM: "1"},
+	{Tag: 0x00240016, Name: "Screening Test Mode Code Sequence", Keyword: "ScreeningTestModeCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00240018, Name: "Maximum Stimulus Luminance", Keyword: "MaximumStimulusLuminance", VR: "FL", VM: "1"},
+	{Tag: 0x00240020, Name: "Background Luminance", Keyword: "BackgroundLuminance", VR: "FL", VM: "1"},
+	{Tag: 0x00240021, Name: "Stimulus Color Code Sequence", Keyword: "StimulusColorCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00240024, Name: "Background Illumination Color Code Sequence", Keyword: "BackgroundIlluminationColorCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00240025, Name: "Stimulus Area", Keyword: "StimulusArea", VR: "FL", VM: "1"},
+	{Tag: 0x00240028, Name: "Stimulus Presentation Time", Keyword: "StimulusPresentationTime", VR: "FL", VM: "1"},
+	{Tag: 0x00240032, Name: "Fixation Sequence", Keyword: "FixationSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00240033, Name: "Fixation Monitoring Code Sequence", Keyword: "FixationMonitoringCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00240034, Name: "Visual Field Catch Trial Sequence", Keyword: "VisualFieldCatchTrialSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00240035, Name: "Fixation Checked Quantity", Keyword: "FixationCheckedQuantity", VR: "US", VM: "1"},
+	{Tag: 0x00240036, Name: "Patient Not Properly Fixated Quantity", Keyword: "PatientNotProperlyFixatedQuantity", VR: "US", VM: "1"},
+	{Tag: 0x00240037, Name: "Presented Visual Stimuli Data Flag", Keyword: "PresentedVisualStimuliDataFlag", VR: "CS", VM: "1"},
+	{Tag: 0x00240038, Name: "Number of Visual Stimuli", Keyword: "NumberOfVisualStimuli", VR: "US", VM: "1"},
+	{Tag: 0x00240039, Name: "Excessive Fixation Losses Data Flag", Keyword: "ExcessiveFixationLossesDataFlag", VR: "CS", VM: "1"},
+	{Tag: 0x00240040, Name: "Excessive Fixation Losses", Keyword: "ExcessiveFixationLosses", VR: "CS", VM: "1"},
+	{Tag: 0x00240042, Name: "Stimuli Retesting Quantity", Keyword: "StimuliRetestingQuantity", VR: "US", VM: "1"},
+	{Tag: 0x00240044, Name: "Comments on Patient's Performance of Visual Field", Keyword: "CommentsOnPatientPerformanceOfVisualField", VR: "LT", VM: "1"},
+	{Tag: 0x00240045, Name: "False Negatives Estimate Flag", Keyword: "FalseNegativesEstimateFlag", VR: "CS", VM: "1"},
+	{Tag: 0x00240046, Name: "False Negatives Estimate", Keyword: "FalseNegativesEstimate", VR: "FL", VM: "1"},
+	{Tag: 0x00240048, Name: "Negative Catch Trials Quantity", Keyword: "NegativeCatchTrialsQuantity", VR: "US", VM: "1"},
+	{Tag: 0x00240050, Name: "False Negatives Quantity", Keyword: "FalseNegativesQuantity", VR: "US", VM: "1"},
+	{Tag: 0x00240051, Name: "Excessive False Negatives Data Flag", Keyword: "ExcessiveFalseNegativesDataFlag", VR: "CS", VM: "1"},
+	{Tag: 0x00240052, Name: "Excessive False Negatives", Keyword: "ExcessiveFalseNegatives", VR: "CS", VM: "1"},
+	{Tag: 0x00240053, Name: "False Positives Estimate Flag", Keyword: "FalsePositivesEstimateFlag", VR: "CS", VM: "1"},
+	{Tag: 0x00240054, Name: "False Positives Estimate", Keyword: "FalsePositivesEstimate", VR: "FL", VM: "1"},
+	{Tag: 0x00240055, Name: "Catch Trials Data Flag", Keyword: "CatchTrialsDataFlag", VR: "CS", VM: "1"},
+	{Tag: 0x00240056, Name: "Positive Catch Trials Quantity", Keyword: "PositiveCatchTrialsQuantity", VR: "US", VM: "1"},
+	{Tag: 0x00240057, Name: "Test Point Normals Data Flag", Keyword: "TestPointNormalsDataFlag", VR: "CS", VM: "1"},
+	{Tag: 0x00240058, Name: "Test Point Normals Sequence", Keyword: "TestPointNormalsSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00240059, Name: "Global Deviation Probability Normals Flag", Keyword: "GlobalDeviationProbabilityNormalsFlag", VR: "CS", VM: "1"},
+	{Tag: 0x00240060, Name: "False Positives Quantity", Keyword: "FalsePositivesQuantity", VR: "US", VM: "1"},
+	{Tag: 0x00240061, Name: "Excessive False Positives Data Flag", Keyword: "ExcessiveFalsePositivesDataFlag", VR: "CS", VM: "1"},
+	{Tag: 0x00240062, Name: "Excessive False Positives", Keyword: "ExcessiveFalsePositives", VR: "CS", VM: "1"},
+	{Tag: 0x00240063, Name: "Visual Field Test Normals Flag", Keyword: "VisualFieldTestNormalsFlag", VR: "CS", VM: "1"},
+	{Tag: 0x00240064, Name: "Results Normals Sequence", Keyword: "ResultsNormalsSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00240065, Name: "Age Corrected Sensitivity Deviation Algorithm Sequence", Keyword: "AgeCorrectedSensitivityDeviationAlgorithmSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00240066, Name: "Global Deviation From Normal", Keyword: "GlobalDeviationFromNormal", VR: "FL", VM: "1"},
+	{Tag: 0x00240067, Name: "Generalized Defect Sensitivity Deviation Algorithm Sequence", Keyword: "GeneralizedDefectSensitivityDeviationAlgorithmSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00240068, Name: "Localized Deviation From Normal", Keyword: "LocalizedDeviationFromNormal", VR: "FL", VM: "1"},
+	{Tag: 0x00240069, Name: "Patient Reliability Indicator", Keyword: "PatientReliabilityIndicator", VR: "LO", VM: "1"},
+	{Tag: 0x00240070, Name: "Visual Field Mean Sensitivity", Keyword: "VisualFieldMeanSensitivity", VR: "FL", VM: "1"},
+	{Tag: 0x00240071, Name: "Global Deviation Probability", Keyword: "GlobalDeviationProbability", VR: "FL", VM: "1"},
+	{Tag: 0x00240072, Name: "Local Deviation Probability Normals Flag", Keyword: "LocalDeviationProbabilityNormalsFlag", VR: "CS", VM: "1"},
+	{Tag: 0x00240073, Name: "Localized Deviation Probability", Keyword: "LocalizedDeviationProbability", VR: "FL", VM: "1"},
+	{Tag: 0x00240074, Name: "Short Term Fluctuation Calculated", Keyword: "ShortTermFluctuationCalculated", VR: "CS", VM: "1"},
+	{Tag: 0x00240075, Name: "Short Term Fluctuation", Keyword: "ShortTermFluctuation", VR: "FL", VM: "1"},
+	{Tag: 0x00240076, Name: "Short Term Fluctuation Probability Calculated", Keyword: "ShortTermFluctuationProbabilityCalculated", VR: "CS", VM: "1"},
+	{Tag: 0x00240077, Name: "Short Term Fluctuation Probability", Keyword: "ShortTermFluctuationProbability", VR: "FL", VM: "1"},
+	{Tag: 0x00240078, Name: "Corrected Localized Deviation From Normal Calculated", Keyword: "CorrectedLocalizedDeviationFromNormalCalculated", VR: "CS", VM: "1"},
+	{Tag: 0x00240079, Name: "Corrected Localized Deviation From Normal", Keyword: "CorrectedLocalizedDeviationFromNormal", VR: "FL", VM: "1"},
+	{Tag: 0x00240080, Name: "Corrected Localized Deviation From Normal Probability Calculated", Keyword: "CorrectedLocalizedDeviationFromNormalProbabilityCalculated", VR: "CS", VM: "1"},
+	{Tag: 0x00240081, Name: "Corrected Localized Deviation From Normal Probability", Keyword: "CorrectedLocalizedDeviationFromNormalProbability", VR: "FL", VM: "1"},
+	{Tag: 0x00240083, Name: "Global Deviation Probability Sequence", Keyword: "GlobalDeviationProbabilitySequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00240085, Name: "Localized Deviation Probability Sequence", Keyword: "LocalizedDeviationProbabilitySequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00240086, Name: "Foveal Sensitivity Measured", Keyword: "FovealSensitivityMeasured", VR: "CS", VM: "1"},
+	{Tag: 0x00240087, Name: "Foveal Sensitivity", Keyword: "FovealSensitivity", VR: "FL", VM: "1"},
+	{Tag: 0x00240088, Name: "Visual Field Test Duration", Keyword: "VisualFieldTestDuration", VR: "FL", VM: "1"},
+	{Tag: 0x00240089, Name: "Visual Field Test Point Sequence", Keyword: "VisualFieldTestPointSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00240090, Name: "Visual Field Test Point X-Coordinate", Keyword: "VisualFieldTestPointXCoordinate", VR: "FL", VM: "1"},
+	{Tag: 0x00240091, Name: "Visual Field Test Point Y-Coordinate", Keyword: "VisualFieldTestPointYCoordinate", VR: "FL", VM: "1"},
+	{Tag: 0x00240092, Name: "Age Corrected Sensitivity Deviation Value", Keyword: "AgeCorrectedSensitivityDeviationValue", VR: "FL", VM: "1"},
+	{Tag: 0x00240093, Name: "Stimulus Results", Keyword: "StimulusResults", VR: "CS", VM: "1"},
+	{Tag: 0x00240094, Name: "Sensitivity Value", Keyword: "SensitivityValue", VR: "FL", VM: "1"},
+	{Tag: 0x00240095, Name: "Retest Stimulus Seen", Keyword: "RetestStimulusSeen", VR: "CS", VM: "1"},
+	{Tag: 0x00240096, Name: "Retest Sensitivity Value", Keyword: "RetestSensitivityValue", VR: "FL", VM: "1"},
+	{Tag: 0x00240097, Name: "Visual Field Test Point Normals Sequence", Keyword: "VisualFieldTestPointNormalsSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00240098, Name: "Quantified Defect", Keyword: "QuantifiedDefect", VR: "FL", VM: "1"},
+	{Tag: 0x00240100, Name: "Age Corrected Sensitivity Deviation Probability Value", Keyword: "AgeCorrectedSensitivityDeviationProbabilityValue", VR: "FL", VM: "1"},
+	{Tag: 0x00240102, Name: "Generalized Defect Corrected Sensitivity Deviation Flag", Keyword: "GeneralizedDefectCorrectedSensitivityDeviationFlag", VR: "CS", VM: "1"},
+	{Tag: 0x00240103, Name: "Generalized Defect Corrected Sensitivity Deviation Value", Keyword: "GeneralizedDefectCorrectedSensitivityDeviationValue", VR: "FL", VM: "1"},
+	{Tag: 0x00240104, Name: "Generalized Defect Corrected Sensitivity Deviation Probability Value", Keyword: "GeneralizedDefectCorrectedSensitivityDeviationProbabilityValue", VR: "FL", VM: "1"},
+	{Tag: 0x00240105, Name: "Minimum Sensitivity Value", Keyword: "MinimumSensitivityValue", VR: "FL", VM: "1"},
+	{Tag: 0x00240106, Name: "Blind Spot Localized", Keyword: "BlindSpotLocalized", VR: "CS", VM: "1"},
+	{Tag: 0x00240107, Name: "Blind Spot X-Coordinate", Keyword: "BlindSpotXCoordinate", VR: "FL", VM: "1"},
+	{Tag: 0x00240108, Name: "Blind Spot Y-Coordinate", Keyword: "BlindSpotYCoordinate", VR: "FL", VM: "1"},
+	{Tag: 0x00240110, Name: "Visual Acuity Measurement Sequence", Keyword: "VisualAcuityMeasurementSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00240112, Name: "Refractive Parameters Used on Patient Sequence", Keyword: "RefractiveParametersUsedOnPatientSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00240113, Name: "Measurement Laterality", Keyword: "MeasurementLaterality", VR: "CS", VM: "1"},
+	{Tag: 0x00240114, Name: "Ophthalmic Patient Clinical Information Left Eye Sequence", Keyword: "OphthalmicPatientClinicalInformationLeftEyeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00240115, Name: "Ophthalmic Patient Clinical Information Right Eye Sequence", Keyword: "OphthalmicPatientClinicalInformationRightEyeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00240117, Name: "Foveal Point Normative Data Flag", Keyword: "FovealPointNormativeDataFlag", VR: "CS", VM: "1"},
+	{Tag: 0x00240118, Name: "Foveal Point Probability Value", Keyword: "FovealPointProbabilityValue", VR: "FL", VM: "1"},
+	{Tag: 0x00240120, Name: "Screening Baseline Measured", Keyword: "ScreeningBaselineMeasured", VR: "CS", VM: "1"},
+	{Tag: 0x00240122, Name: "Screening Baseline Measured Sequence", Keyword: "ScreeningBaselineMeasuredSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00240124, Name: "Screening Baseline Type", Keyword: "ScreeningBaselineType", VR: "CS", VM: "1"},
+	{Tag: 0x00240126, Name: "Screening Baseline Value", Keyword: "ScreeningBaselineValue", VR: "FL", VM: "1"},
+	{Tag: 0x00240202, Name: "Algorithm Source", Keyword: "AlgorithmSource", VR: "LO", VM: "1"},
+	{Tag: 0x00240306, Name: "Data Set Name", Keyword: "DataSetName", VR: "LO", VM: "1"},
+	{Tag: 0x00240307, Name: "Data Set Version", Keyword: "DataSetVersion", VR: "LO", VM: "1"},
+	{Tag: 0x00240308, Name: "Data Set Source", Keyword: "DataSetSource", VR: "LO", VM: "1"},
+	{Tag: 0x00240309, Name: "Data Set Description", Keyword: "DataSetDescription", VR: "LO", VM: "1"},
+	{Tag: 0x00240317, Name: "Visual Field Test Reliability Global Index Sequence", Keyword: "VisualFieldTestReliabilityGlobalIndexSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00240320, Name: "Visual Field Global Results Index Sequence", Keyword: "VisualFieldGlobalResultsIndexSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00240325, Name: "Data Observation Sequence", Keyword: "DataObservationSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00240338, Name: "Index Normals Flag", Keyword: "IndexNormalsFlag", VR: "CS", VM: "1"},
+	{Tag: 0x00240341, Name: "Index Probability", Keyword: "IndexProbability", VR: "FL", VM: "1"},
+	{Tag: 0x00240344, Name: "Index Probability Sequence", Keyword: "IndexProbabilitySequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00280002, Name: "Samples per Pixel", Keyword: "SamplesPerPixel", VR: "US", VM: "1"},
+	{Tag: 0x00280003, Name: "Samples per Pixel Used", Keyword: "SamplesPerPixelUsed", VR: "US", VM: "1"},
+	{Tag: 0x00280004, Name: "Photometric Interpretation", Keyword: "PhotometricInterpretation", VR: "CS", VM: "1"},
+	{Tag: 0x00280005, Name: "Image Dimensions", Keyword: "ImageDimensions", VR: "US", VM: "1", Retired: true},
+	{Tag: 0x00280006, Name: "Planar Configuration", Keyword: "PlanarConfiguration", VR: "US", VM: "1"},
+	{Tag: 0x00280008, Name: "Number of Frames", Keyword: "NumberOfFrames", VR: "IS", VM: "1"},
+	{Tag: 0x00280009, Name: "Frame Increment Pointer", Keyword: "FrameIncrementPointer", VR: "AT", VM: "1-n"},
+	{Tag: 0x0028000A, Name: "Frame Dimension Pointer", Keyword: "FrameDimensionPointer", VR: "AT", VM: "1-n"},
+	{Tag: 0x00280010, Name: "Rows", Keyword: "Rows", VR: "US", VM: "1"},
+	{Tag: 0x00280011, Name: "Columns", Keyword: "Columns", VR: "US", VM: "1"},
+	{Tag: 0x00280012, Name: "Planes", Keyword: "Planes", VR: "US", VM: "1", Retired: true},
+	{Tag: 0x00280014, Name: "Ultrasound Color Data Present", Keyword: "UltrasoundColorDataPresent", VR: "US", VM: "1"},
+	{Tag: 0x00280030, Name: "Pixel Spacing", Keyword: "PixelSpacing", VR: "DS", VM: "2"},
+	{Tag: 0x00280031, Name: "Zoom Factor", Keyword: "ZoomFactor", VR: "DS", VM: "2"},
+	{Tag: 0x00280032, Name: "Zoom Center", Keyword: "ZoomCenter", VR: "DS", VM: "2"},
+	{Tag: 0x00280034, Name: "Pixel Aspect Ratio", Keyword: "PixelAspectRatio", VR: "IS", VM: "2"},
+	{Tag: 0x00280040, Name: "Image Format", Keyword: "ImageFormat", VR: "CS", VM: "1", Retired: true},
+	{Tag: 0x00280050, Name: "Manipulated Image", Keyword: "ManipulatedImage", VR: "LO", VM: "1-n", Retired: true},
+	{Tag: 0x00280051, Name: "Corrected Image", Keyword: "CorrectedImage", VR: "CS", VM: "1-n"},
+	{Tag: 0x0028005F, Name: "Compression Recognition Code", Keyword: "CompressionRecognitionCode", VR: "LO", VM: "1", Retired: true},
+	{Tag: 0x00280060, Name: "Compression Code", Keyword: "CompressionCode", VR: "CS", VM: "1", Retired: true},
+	{Tag: 0x00280061, Name: "Compression Originator", Keyword: "CompressionOriginator", VR: "SH", VM: "1", Retired: true},
+	{Tag: 0x00280062, Name: "Compression Label", Keyword: "CompressionLabel", VR: "LO", VM: "1", Retired: true},
+	{Tag: 0x00280063, Name: "Compression Description", Keyword: "CompressionDescription", VR: "SH", VM: "1", Retired: true},
+	{Tag: 0x00280065, Name: "Compression Sequence", Keyword: "CompressionSequence", VR: "CS", VM: "1-n", Retired: true},
+	{Tag: 0x00280066, Name: "Compression Step Pointers", Keyword: "CompressionStepPointers", VR: "AT", VM: "1-n", Retired: true},
+	{Tag: 0x00280068, Name: "Repeat Interval", Keyword: "RepeatInterval", VR: "US", VM: "1", Retired: true},
+	{Tag: 0x00280069, Name: "Bits Grouped", Keyword: "BitsGrouped", VR: "US", VM: "1", Retired: true},
+	{Tag: 0x00280070, Name: "Perimeter Table", Keyword: "PerimeterTable", VR: "US", VM: "1-n", Retired: true},
+	{Tag: 0x00280071, Name: "Perimeter Value", Keyword: "PerimeterValue", VR: "US or SS", VM: "1", Retired: true},
+	{Tag: 0x00280080, Name: "Predictor Rows", Keyword: "PredictorRows", VR: "US", VM: "1", Retired: true},
+	{Tag: 0x00280081, Name: "Predictor Columns", Keyword: "PredictorColumns", VR: "US", VM: "1", Retired: true},
+	{Tag: 0x00280082, Name: "Predictor Constants", Keyword: "PredictorConstants", VR: "US", VM: "1-n", Retired: true},
+	{Tag: 0x00280090, Name: "Blocked Pixels", Keyword: "BlockedPixels", VR: "CS", VM: "1", Retired: true},
+	{Tag: 0x00280091, Name: "Block Rows", Keyword: "BlockRows", VR: "US", VM: "1", Retired: true},
+	{Tag: 0x00280092, Name: "Block Columns", Keyword: "BlockColumns", VR: "US", VM: "1", Retired: true},
+	{Tag: 0x00280093, Name: "Row Overlap", Keyword: "RowOverlap", VR: "US", VM: "1", Retired: true},
+	{Tag: 0x00280094, Name: "Column Overlap", Keyword: "ColumnOverlap", VR: "US", VM: "1", Retired: true},
+	{Tag: 0x00280100, Name: "Bits Allocated", Keyword: "BitsAllocated", VR: "US", VM: "1"},
+	{Tag: 0x00280101, Name: "Bits Stored", Keyword: "BitsStored", VR: "US", VM: "1"},
+	{Tag: 0x00280102, Name: "High Bit", Keyword: "HighBit", VR: "US", VM: "1"},
+	{Tag: 0x00280103, Name: "Pixel Representation", Keyword: "PixelRepresentation", VR: "US", VM: "1"},
+	{Tag: 0x00280104, Name: "Smallest Valid Pixel Value", Keyword: "SmallestValidPixelValue", VR: "US or SS", VM: "1", Retired: true},
+	{Tag: 0x00280105, Name: "Largest Valid Pixel Value", Keyword: "LargestValidPixelValue", VR: "US or SS", VM: "1", Retired: true},
+	{Tag: 0x00280106, Name: "Smallest Image Pixel Value", Keyword: "SmallestImagePixelValue", VR: "US or SS", VM: "1"},
+	{Tag: 0x00280107, Name: "Largest Image Pixel Value", Keyword: "LargestImagePixelValue", VR: "US or SS", VM: "1"},
+	{Tag: 0x00280108, Name: "Smallest Pixel Value in Series", Keyword: "SmallestPixelValueInSeries", VR: "US or SS", VM: "1"},
+	{Tag: 0x00280109, Name: "Largest Pixel Value in Series", Keyword: "LargestPixelValueInSeries", VR: "US or SS", VM: "1"},
+	{Tag: 0x00280110, Name: "Smallest Image Pixel Value in Plane", Keyword: "SmallestImagePixelValueInPlane", VR: "US or SS", VM: "1", Retired: true},
+	{Tag: 0x00280111, Name: "Largest Image Pixel Value in Plane", Keyword: "LargestImagePixelValueInPlane", VR: "US or SS", VM: "1", Retired: true},
+	{Tag: 0x00280120, Name: "Pixel Padding Value", Keyword: "PixelPaddingValue", VR: "US or SS", VM: "1"},
+	{Tag: 0x00280121, Name: "Pixel Padding Range Limit", Keyword: "PixelPaddingRangeLimit", VR: "US or SS", VM: "1"},
+	{Tag: 0x00280122, Name: "Float Pixel Padding Value", Keyword: "FloatPixelPaddingValue", VR: "FL", VM: "1"},
+	{Tag: 0x00280123, Name: "Double Float Pixel Padding Value", Keyword: "DoubleFloatPixelPaddingValue", VR: "FD", VM: "1"},
+	{Tag: 0x00280124, Name: "Float Pixel Padding Range Limit", Keyword: "FloatPixelPaddingRangeLimit", VR: "FL", VM: "1"},
+	{Tag: 0x00280125, Name: "Double Float Pixel Padding Range Limit", Keyword: "DoubleFloatPixelPaddingRangeLimit", VR: "FD", VM: "1"},
+	{Tag: 0x00280200, Name: "Image Location", Keyword: "ImageLocation", VR: "US", VM: "1", Retired: true},
+	{Tag: 0x00280300, Name: "Quality Control Image", Keyword: "QualityControlImage", VR: "CS", VM: "1"},
+	{Tag: 0x00280301, Name: "Burned In Annotation", Keyword: "BurnedInAnnotation", VR: "CS", VM: "1"},
+	{Tag: 0x00280302, Name: "Recognizable Visual Features", Keyword: "RecognizableVisualFeatures", VR: "CS", VM: "1"},
+	{Tag: 0x00280303, Name: "Longitudinal Temporal Information Modified", Keyword: "LongitudinalTemporalInformationModified", VR: "CS", VM: "1"},
+	{Tag: 0x00280304, Name: "Referenced Color Palette Instance UID", Keyword: "ReferencedColorPaletteInstanceUID", VR: "UI", VM: "1"},
+	{Tag: 0x00280400, Name: "Transform Label", Keyword: "TransformLabel", VR: "LO", VM: "1", Retired: true},
+	{Tag: 0x00280401, Name: "Transform Version Number", Keyword: "TransformVersionNumber", VR: "LO", VM: "1", Retired: true},
+	{Tag: 0x00280402, Name: "Number of Transform Steps", Keyword: "NumberOfTransformSteps", VR: "US", VM: "1", Retired: true},
+	{Tag: 0x00280403, Name: "Sequence of Compressed Data", Keyword: "SequenceOfCompressedData", VR: "LO", VM: "1-n", Retired: true},
+	{Tag: 0x00280404, Name: "Details of Coefficients", Keyword: "DetailsOfCoefficients", VR: "AT", VM: "1-n", Retired: true},
+	{Tag: 0x00280700, Name: "DCT Label", Keyword: "DCTLabel", VR: "LO", VM: "1", Retired: true},
+	{Tag: 0x00280701, Name: "Data Block Description", Keyword: "DataBlockDescription", VR: "CS", VM: "1-n", Retired: true},
+	{Tag: 0x00280702, Name: "Data Block", Keyword: "DataBlock", VR: "AT", VM: "1-n", Retired: true},
+	{Tag: 0x00280710, Name: "Normalization Factor Format", Keyword: "NormalizationFactorFormat", VR: "US", VM: "1", Retired: true},
+	{Tag: 0x00280720, Name: "Zonal Map Number Format", Keyword: "ZonalMapNumberFormat", VR: "US", VM: "1", Retired: true},
+	{Tag: 0x00280721, Name: "Zonal Map Location", Keyword: "ZonalMapLocation", VR: "AT", VM: "1-n", Retired: true},
+	{Tag: 0x00280722, Name: "Zonal Map Format", Keyword: "ZonalMapFormat", VR: "US", VM: "1", Retired: true},
+	{Tag: 0x00280730, Name: "Adaptive Map Format", Keyword: "AdaptiveMapFormat", VR: "US", VM: "1", Retired: true},
+	{Tag: 0x00280740, Name: "Code Number Format", Keyword: "CodeNumberFormat", VR: "US", VM: "1", Retired: true},
+	{Tag: 0x00280A02, Name: "Pixel Spacing Calibration Type", Keyword: "PixelSpacingCalibrationType", VR: "CS", VM: "1"},
+	{Tag: 0x00280A04, Name: "Pixel Spacing Calibration Description", Keyword: "PixelSpacingCalibrationDescription", VR: "LO", VM: "1"},
+	{Tag: 0x00281040, Name: "Pixel Intensity Relationship", Keyword: "PixelIntensityRelationship", VR: "CS", VM: "1"},
+	{Tag: 0x00281041, Name: "Pixel Intensity Relationship Sign", Keyword: "PixelIntensityRelationshipSign", VR: "SS", VM: "1"},
+	{Tag: 0x00281050, Name: "Window Center", Keyword: "WindowCenter", VR: "DS", VM: "1-n"},
+	{Tag: 0x00281051, Name: "Window Width", Keyword: "WindowWidth", VR: "DS", VM: "1-n"},
+	{Tag: 0x00281052, Name: "Rescale Intercept", Keyword: "RescaleIntercept", VR: "DS", VM: "1"},
+	{Tag: 0x00281053, Name: "Rescale Slope", Keyword: "RescaleSlope", VR: "DS", VM: "1"},
+	{Tag: 0x00281054, Name: "Rescale Type", Keyword: "RescaleType", VR: "LO", VM: "1"},
+	{Tag: 0x00281055, Name: "Window Center & Width Explanation", Keyword: "WindowCenterWidthExplanation", VR: "LO", VM: "1-n"},
+	{Tag: 0x00281056, Name: "VOI LUT Function", Keyword: "VOILUTFunction", VR: "CS", VM: "1"},
+	{Tag: 0x00281080, Name: "Gray Scale", Keyword: "GrayScale", VR: "CS", VM: "1", Retired: true},
+	{Tag: 0x00281090, Name: "Recommended Viewing Mode", Keyword: "RecommendedViewingMode", VR: "CS", VM: "1"},
+	{Tag: 0x00281100, Name: "Gray Lookup Table Descriptor", Keyword: "GrayLookupTableDescriptor", VR: "US or SS", VM: "3", Retired: true},
+	{Tag: 0x00281101, Name: "Red Palette Color Lookup Table Descriptor", Keyword: "RedPaletteColorLookupTableDescriptor", VR: "US or SS", VM: "3"},
+	{Tag: 0x00281102, Name: "Green Palette Color Lookup Table Descriptor", Keyword: "GreenPaletteColorLookupTableDescriptor", VR: "US or SS", VM: "3"},
+	{Tag: 0x00281103, Name: "Blue Palette Color Lookup Table Descriptor", Keyword: "BluePaletteColorLookupTableDescriptor", VR: "US or SS", VM: "3"},
+	{Tag: 0x00281104, Name: "Alpha Palette Color Lookup Table Descriptor", Keyword: "AlphaPaletteColorLookupTableDescriptor", VR: "US", VM: "3"},
+	{Tag: 0x00281111, Name: "Large Red Palette Color Lookup Table Descriptor", Keyword: "LargeRedPaletteColorLookupTableDescriptor", VR: "US or SS", VM: "4", Retired: true},
+	{Tag: 0x00281112, Name: "Large Green Palette Color Lookup Table Descriptor", Keyword: "LargeGreenPaletteColorLookupTableDescriptor", VR: "US or SS", VM: "4", Retired: true},
+	{Tag: 0x00281113, Name: "Large Blue Palette Color Lookup Table Descriptor", Keyword: "LargeBluePaletteColorLookupTableDescriptor", VR: "US or SS", VM: "4", Retired: true},
+	{Tag: 0x00281199, Name: "Palette Color Lookup Table UID", Keyword: "PaletteColorLookupTableUID", VR: "UI", VM: "1"},
+	{Tag: 0x00281200, Name: "Gray Lookup Table Data", Keyword: "GrayLookupTableData", VR: "US or SS or OW", VM: "1-n or 1", Retired: true},
+	{Tag: 0x00281201, Name: "Red Palette Color Lookup Table Data", Keyword: "RedPaletteColorLookupTableData", VR: "OW", VM: "1"},
+	{Tag: 0x00281202, Name: "Green Palette Color Lookup Table Data", Keyword: "GreenPaletteColorLookupTableData", VR: "OW", VM: "1"},
+	{Tag: 0x00281203, Name: "Blue Palette Color Lookup Table Data", Keyword: "BluePaletteColorLookupTableData", VR: "OW", VM: "1"},
+	{Tag: 0x00281204, Name: "Alpha Palette Color Lookup Table Data", Keyword: "AlphaPaletteColorLookupTableData", VR: "OW", VM: "1"},
+	{Tag: 0x00281211, Name: "Large Red Palette Color Lookup Table Data", Keyword: "LargeRedPaletteColorLookupTableData", VR: "OW", VM: "1", Retired: true},
+	{Tag: 0x00281212, Name: "Large Green Palette Color Lookup Table Data", Keyword: "LargeGreenPaletteColorLookupTableData", VR: "OW", VM: "1", Retired: true},
+	{Tag: 0x00281213, Name: "Large Blue Palette Color Lookup Table Data", Keyword: "LargeBluePaletteColorLookupTableData", VR: "OW", VM: "1", Retired: true},
+	{Tag: 0x00281214, Name: "Large Palette Color Lookup Table UID", Keyword: "LargePaletteColorLookupTableUID", VR: "UI", VM: "1", Retired: true},
+	{Tag: 0x00281221, Name: "Segmented Red Palette Color Lookup Table Data", Keyword: "SegmentedRedPaletteColorLookupTableData", VR: "OW", VM: "1"},
+	{Tag: 0x00281222, Name: "Segmented Green Palette Color Lookup Table Data", Keyword: "SegmentedGreenPaletteColorLookupTableData", VR: "OW", VM: "1"},
+	{Tag: 0x00281223, Name: "Segmented Blue Palette Color Lookup Table Data", Keyword: "SegmentedBluePaletteColorLookupTableData", VR: "OW", VM: "1"},
+	{Tag: 0x00281224, Name: "Segmented Alpha Palette Color Lookup Table Data", Keyword: "SegmentedAlphaPaletteColorLookupTableData", VR: "OW", VM: "1"},
+	{Tag: 0x00281230, Name: "Stored Value Color Range Sequence", Keyword: "StoredValueColorRangeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00281231, Name: "Minimum Stored Value Mapped", Keyword: "MinimumStoredValueMapped", VR: "FD", VM: "1"},
+	{Tag: 0x00281232, Name: "Maximum Stored Value Mapped", Keyword: "MaximumStoredValueMapped", VR: "FD", VM: "1"},
+	{Tag: 0x00281300, Name: "Breast Implant Present", Keyword: "BreastImplantPresent", VR: "CS", VM: "1"},
+	{Tag: 0x00281350, Name: "Partial View", Keyword: "PartialView", VR: "CS", VM: "1"},
+	{Tag: 0x00281351, Name: "Partial View Description", Keyword: "PartialViewDescription", VR: "ST", VM: "1"},
+	{Tag: 0x00281352, Name: "Partial View Code Sequence", Keyword: "PartialViewCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x0028135A, Name: "Spatial Locations Preserved", Keyword: "SpatialLocationsPreserved", VR: "CS", VM: "1"},
+	{Tag: 0x00281401, Name: "Data Frame Assignment Sequence", Keyword: "DataFrameAssignmentSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00281402, Name: "Data Path Assignment", Keyword: "DataPathAssignment", VR: "CS", VM: "1"},
+	{Tag: 0x00281403, Name: "Bits Mapped to Color Lookup Table", Keyword: "BitsMappedToColorLookupTable", VR: "US", VM: "1"},
+	{Tag: 0x00281404, Name: "Blending LUT 1 Sequence", Keyword: "BlendingLUT1Sequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00281405, Name: "Blending LUT 1 Transfer Function", Keyword: "BlendingLUT1TransferFunction", VR: "CS", VM: "1"},
+	{Tag: 0x00281406, Name: "Blending Weight Constant", Keyword: "BlendingWeightConstant", VR: "FD", VM: "1"},
+	{Tag: 0x00281407, Name: "Blending Lookup Table Descriptor", Keyword: "BlendingLookupTableDescriptor", VR: "US", VM: "3"},
+	{Tag: 0x00281408, Name: "Blending Lookup Table Data", Keyword: "BlendingLookupTableData", VR: "OW", VM: "1"},
+	{Tag: 0x0028140B, Name: "Enhanced Palette Color Lookup Table Sequence", Keyword: "EnhancedPaletteColorLookupTableSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x0028140C, Name: "Blending LUT 2 Sequence", Keyword: "BlendingLUT2Sequence", VR: "SQ", VM: "1"},
+	{Tag: 0x0028140D, Name: "Blending LUT 2 Transfer Function", Keyword: "BlendingLUT2TransferFunction", VR: "CS", VM: "1"},
+	{Tag: 0x0028140E, Name: "Data Path ID", Keyword: "DataPathID", VR: "CS", VM: "1"},
+	{Tag: 0x0028140F, Name: "RGB LUT Transfer Function", Keyword: "RGBLUTTransferFunction", VR: "CS", VM: "1"},
+	{Tag: 0x00281410, Name: "Alpha LUT Transfer Function", Keyword: "AlphaLUTTransferFunction", VR: "CS", VM: "1"},
+	{Tag: 0x00282000, Name: "ICC Profile", Keyword: "ICCProfile", VR: "OB", VM: "1"},
+	{Tag: 0x00282002, Name: "Color Space", Keyword: "ColorSpace", VR: "CS", VM: "1"},
+	{Tag: 0x00282110, Name: "Lossy Image Compression", Keyword: "LossyImageCompression", VR: "CS", VM: "1"},
+	{Tag: 0x00282112, Name: "Lossy Image Compression Ratio", Keyword: "LossyImageCompressionRatio", VR: "DS", VM: "1-n"},
+	{Tag: 0x00282114, Name: "Lossy Image Compression Method", Keyword: "LossyImageCompressionMethod", VR: "CS", VM: "1-n"},
+	{Tag: 0x00283000, Name: "Modality LUT Sequence", Keyword: "ModalityLUTSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00283001, Name: "Variable Modality LUT Sequence", Keyword: "VariableModalityLUTSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00283002, Name: "LUT Descriptor", Keyword: "LUTDescriptor", VR: "US or SS", VM: "3"},
+	{Tag: 0x00283003, Name: "LUT Explanation", Keyword: "LUTExplanation", VR: "LO", VM: "1"},
+	{Tag: 0x00283004, Name: "Modality LUT Type", Keyword: "ModalityLUTType", VR: "LO", VM: "1"},
+	{Tag: 0x00283006, Name: "LUT Data", Keyword: "LUTData", VR: "US or OW", VM: "1-n or 1"},
+	{Tag: 0x00283010, Name: "VOI LUT Sequence", Keyword: "VOILUTSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00283110, Name: "Softcopy VOI LUT Sequence", Keyword: "SoftcopyVOILUTSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00284000, Name: "Image Presentation Comments", Keyword: "ImagePresentationComments", VR: "LT", VM: "1", Retired: true},
+	{Tag: 0x00285000, Name: "Bi-Plane Acquisition Sequence", Keyword: "BiPlaneAcquisitionSequence", VR: "SQ", VM: "1", Retired: true},
+	{Tag: 0x00286010, Name: "Representative Frame Number", Keyword: "RepresentativeFrameNumber", VR: "US", VM: "1"},
+	{Tag: 0x00286020, Name: "Frame Numbers of Interest (FOI)", Keyword: "FrameNumbersOfInterest", VR: "US", VM: "1-n"},
+	{Tag: 0x00286022, Name: "Frame of Interest Description", Keyword: "FrameOfInterestDescription", VR: "LO", VM: "1-n"},
+	{Tag: 0x00286023, Name: "Frame of Interest Type", Keyword: "FrameOfInterestType", VR: "CS", VM: "1-n"},
+	{Tag: 0x00286030, Name: "Mask Pointer(s)", Keyword: "MaskPointers", VR: "US", VM: "1-n", Retired: true},
+	{Tag: 0x00286040, Name: "R Wave Pointer", Keyword: "RWavePointer", VR: "US", VM: "1-n"},
+	{Tag: 0x00286100, Name: "Mask Subtraction Sequence", Keyword: "MaskSubtractionSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00286101, Name: "Mask Operation", Keyword: "MaskOperation", VR: "CS", VM: "1"},
+	{Tag: 0x00286102, Name: "Applicable Frame Range", Keyword: "ApplicableFrameRange", VR: "US", VM: "2-2n"},
+	{Tag: 0x00286110, Name: "Mask Frame Numbers", Keyword: "MaskFrameNumbers", VR: "US", VM: "1-n"},
+	{Tag: 0x00286112, Name: "Contrast Frame Averaging", Keyword: "ContrastFrameAveraging", VR: "US", VM: "1"},
+	{Tag: 0x00286114, Name: "Mask Sub-pixel Shift", Keyword: "MaskSubPixelShift", VR: "FL", VM: "2"},
+	{Tag: 0x00286120, Name: "TID Offset", Keyword: "TIDOffset", VR: "SS", VM: "1"},
+	{Tag: 0x00286190, Name: "Mask Operation Explanation", Keyword: "MaskOperationExplanation", VR: "ST", VM: "1"},
+	{Tag: 0x00287000, Name: "Equipment Administrator Sequence", Keyword: "EquipmentAdministratorSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00287001, Name: "Number of Display Subsystems", Keyword: "NumberOfDisplaySubsystems", VR: "US", VM: "1"},
+	{Tag: 0x00287002, Name: "Current Configuration ID", Keyword: "CurrentConfigurationID", VR: "US", VM: "1"},
+	{Tag: 0x00287003, Name: "Display Subsystem ID", Keyword: "DisplaySubsystemID", VR: "US", VM: "1"},
+	{Tag: 0x00287004, Name: "Display Subsystem Name", Keyword: "DisplaySubsystemName", VR: "SH", VM: "1"},
+	{Tag: 0x00287005, Name: "Display Subsystem Description", Keyword: "DisplaySubsystemDescription", VR: "LO", VM: "1"},
+	{Tag: 0x00287006, Name: "System Status", Keyword: "SystemStatus", VR: "CS", VM: "1"},
+	{Tag: 0x00287007, Name: "System Status Comment", Keyword: "SystemStatusComment", VR: "LO", VM: "1"},
+	{Tag: 0x00287008, Name: "Target Luminance Characteristics Sequence", Keyword: "TargetLuminanceCharacteristicsSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00287009, Name: "Luminance Characteristics ID", Keyword: "LuminanceCharacteristicsID", VR: "US", VM: "1"},
+	{Tag: 0x0028700A, Name: "Display Subsystem Configuration Sequence", Keyword: "DisplaySubsystemConfigurationSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x0028700B, Name: "Configuration ID", Keyword: "ConfigurationID", VR: "US", VM: "1"},
+	{Tag: 0x0028700C, Name: "Configuration Name", Keyword: "ConfigurationName", VR: "SH", VM: "1"},
+	{Tag: 0x0028700D, Name: "Configuration Description", Keyword: "ConfigurationDescription", VR: "LO", VM: "1"},
+	{Tag: 0x0028700E, Name: "Referenced Target Luminance Characteristics ID", Keyword: "ReferencedTargetLuminanceCharacteristicsID", VR: "US", VM: "1"},
+	{Tag: 0x0028700F, Name: "QA Results Sequence", Keyword: "QAResultsSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00287010, Name: "Display Subsystem QA Results Sequence", Keyword: "DisplaySubsystemQAResultsSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00287011, Name: "Configuration QA Results Sequence", Keyword: "ConfigurationQAResultsSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00287012, Name: "Measurement Equipment Sequence", Keyword: "MeasurementEquipmentSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00287013, Name: "Measurement Functions", Keyword: "MeasurementFunctions", VR: "CS", VM: "1-n"},
+	{Tag: 0x00287014, Name: "Measurement Equipment Type", Keyword: "MeasurementEquipmentType", VR: "CS", VM: "1"},
+	{Tag: 0x00287015, Name: "Visual Evaluation Result Sequence", Keyword: "VisualEvaluationResultSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00287016, Name: "Display Calibration Result Sequence", Keyword: "DisplayCalibrationResultSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00287017, Name: "DDL Value", Keyword: "DDLValue", VR: "US", VM: "1"},
+	{Tag: 0x00287018, Name: "CIExy White Point", Keyword: "CIExyWhitePoint", VR: "FL", VM: "2"},
+	{Tag: 0x00287019, Name: "Display Function Type", Keyword: "DisplayFunctionType", VR: "CS", VM: "1"},
+	{Tag: 0x0028701A, Name: "Gamma Value", Keyword: "GammaValue", VR: "FL", VM: "1"},
+	{Tag: 0x0028701B, Name: "Number of Luminance Points", Keyword: "NumberOfLuminancePoints", VR: "US", VM: "1"},
+	{Tag: 0x0028701C, Name: "Luminance Response Sequence", Keyword: "LuminanceResponseSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x0028701D, Name: "Target Minimum Luminance", Keyword: "TargetMinimumLuminance", VR: "FL", VM: "1"},
+	{Tag: 0x0028701E, Name: "Target Maximum Luminance", Keyword: "TargetMaximumLuminance", VR: "FL", VM: "1"},
+	{Tag: 0x0028701F, Name: "Luminance Value", Keyword: "LuminanceValue", VR: "FL", VM: "1"},
+	{Tag: 0x00287020, Name: "Luminance Response Description", Keyword: "LuminanceResponseDescription", VR: "LO", VM: "1"},
+	{Tag: 0x00287021, Name: "White Point Flag", Keyword: "WhitePointFlag", VR: "CS", VM: "1"},
+	{Tag: 0x00287022, Name: "Display Device Type Code Sequence", Keyword: "DisplayDeviceTypeCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00287023, Name: "Display Subsystem Sequence", Keyword: "DisplaySubsystemSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00287024, Name: "Luminance Result Sequence", Keyword: "LuminanceResultSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00287025, Name: "Ambient Light Value Source", Keyword: "AmbientLightValueSource", VR: "CS", VM: "1"},
+	{Tag: 0x00287026, Name: "Measured Characteristics", Keyword: "MeasuredCharacteristics", VR: "CS", VM: "1-n"},
+	{Tag: 0x00287027, Name: "Luminance Uniformity Result Sequence", Keyword: "LuminanceUniformityResultSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00287028, Name: "Visual Evaluation Test Sequence", Keyword: "VisualEvaluationTestSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00287029, Name: "Test Result", Keyword: "TestResult", VR: "CS", VM: "1"},
+	{Tag: 0x0028702A, Name: "Test Result Comment", Keyword: "TestResultComment", VR: "LO", VM: "1"},
+	{Tag: 0x0028702B, Name: "Test Image Validation", Keyword: "TestImageValidation", VR: "CS", VM: "1"},
+	{Tag: 0x0028702C, Name: "Test Pattern Code Sequence", Keyword: "TestPatternCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x0028702D, Name: "Measurement Pattern Code Sequence", Keyword: "MeasurementPatternCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x0028702E, Name: "Visual Evaluation Method Code Sequence", Keyword: "VisualEvaluationMethodCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00287FE0, Name: "Pixel Data Provider URL", Keyword: "PixelDataProviderURL", VR: "UR", VM: "1"},
+	{Tag: 0x00289001, Name: "Data Point Rows", Keyword: "DataPointRows", VR: "UL", VM: "1"},
+	{Tag: 0x00289002, Name: "Data Point Columns", Keyword: "DataPointColumns", VR: "UL", VM: "1"},
+	{Tag: 0x00289003, Name: "Signal Domain Columns", Keyword: "SignalDomainColumns", VR: "CS", VM: "1"},
+	{Tag: 0x00289099, Name: "Largest Monochrome Pixel Value", Keyword: "LargestMonochromePixelValue", VR: "US", VM: "1", Retired: true},
+	{Tag: 0x00289108, Name: "Data Representation", Keyword: "DataRepresentation", VR: "CS", VM: "1"},
+	{Tag: 0x00289110, Name: "Pixel Measures Sequence", Keyword: "PixelMeasuresSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00289132, Name: "Frame VOI LUT Sequence", Keyword: "FrameVOILUTSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00289145, Name: "Pixel Value Transformation Sequence", Keyword: "PixelValueTransformationSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00289235, Name: "Signal Domain Rows", Keyword: "SignalDomainRows", VR: "CS", VM: "1"},
+	{Tag: 0x00289411, Name: "Display Filter Percentage", Keyword: "DisplayFilterPercentage", VR: "FL", VM: "1"},
+	{Tag: 0x00289415, Name: "Frame Pixel Shift Sequence", Keyword: "FramePixelShiftSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00289416, Name: "Subtraction Item ID", Keyword: "SubtractionItemID", VR: "US", VM: "1"},
+	{Tag: 0x00289422, Name: "Pixel Intensity Relationship LUT Sequence", Keyword: "PixelIntensityRelationshipLUTSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00289443, Name: "Frame Pixel Data Properties Sequence", Keyword: "FramePixelDataPropertiesSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00289444, Name: "Geometrical Properties", Keyword: "GeometricalProperties", VR: "CS", VM: "1"},
+	{Tag: 0x00289445, Name: "Geometric Maximum Distortion", Keyword: "GeometricMaximumDistortion", VR: "FL", VM: "1"},
+	{Tag: 0x00289446, Name: "Image Processing Applied", Keyword: "ImageProcessingApplied", VR: "CS", VM: "1-n"},
+	{Tag: 0x00289454, Name: "Mask Selection Mode", Keyword: "MaskSelectionMode", VR: "CS", VM: "1"},
+	{Tag: 0x00289474, Name: "LUT Function", Keyword: "LUTFunction", VR: "CS", VM: "1"},
+	{Tag: 0x00289478, Name: "Mask Visibility Percentage", Keyword: "MaskVisibilityPercentage", VR: "FL", VM: "1"},
+	{Tag: 0x00289501, Name: "Pixel Shift Sequence", Keyword: "PixelShiftSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00289502, Name: "Region Pixel Shift Sequence", Keyword: "RegionPixelShiftSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00289503, Name: "Vertices of the Region", Keyword: "VerticesOfTheRegion", VR: "SS", VM: "2-2n"},
+	{Tag: 0x00289505, Name: "Multi-frame Presentation Sequence", Keyword: "MultiFramePresentationSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00289506, Name: "Pixel Shift Frame Range", Keyword: "PixelShiftFrameRange", VR: "US", VM: "2-2n"},
+	{Tag: 0x00289507, Name: "LUT Frame Range", Keyword: "LUTFrameRange", VR: "US", VM: "2-2n"},
+	{Tag: 0x00289520, Name: "Image to Equipment Mapping Matrix", Keyword: "ImageToEquipmentMappingMatrix", VR: "DS", VM: "16"},
+	{Tag: 0x00289537, Name: "Equipment Coordinate System Identification", Keyword: "EquipmentCoordinateSystemIdentification", VR: "CS", VM: "1"},
+	{Tag: 0x0032000A, Name: "Study Status ID", Keyword: "StudyStatusID", VR: "CS", VM: "1", Retired: true},
+	{Tag: 0x0032000C, Name: "Study Priority ID", Keyword: "StudyPriorityID", VR: "CS", VM: "1", Retired: true},
+	{Tag: 0x00320012, Name: "Study ID Issuer", Keyword: "StudyIDIssuer", VR: "LO", VM: "1", Retired: true},
+	{Tag: 0x00320032, Name: "Study Verified Date", Keyword: "StudyVerifiedDate", VR: "DA", VM: "1", Retired: true},
+	{Tag: 0x00320033, Name: "Study Verified Time", Keyword: "StudyVerifiedTime", VR: "TM", VM: "1", Retired: true},
+	{Tag: 0x00320034, Name: "Study Read Date", Keyword: "StudyReadDate", VR: "DA", VM: "1", Retired: true},
+	{Tag: 0x00320035, Name: "Study Read Time", Keyword: "StudyReadTime", VR: "TM", VM: "1", Retired: true},
+	{Tag: 0x00321000, Name: "Scheduled Study Start Date", Keyword: "ScheduledStudyStartDate", VR: "DA", VM: "1", Retired: true},
+	{Tag: 0x00321001, Name: "Scheduled Study Start Time", Keyword: "ScheduledStudyStartTime", VR: "TM", VM: "1", Retired: true},
+	{Tag: 0x00321010, Name: "Scheduled Study Stop Date", Keyword: "ScheduledStudyStopDate", VR: "DA", VM: "1", Retired: true},
+	{Tag: 0x00321011, Name: "Scheduled Study Stop Time", Keyword: "ScheduledStudyStopTime", VR: "TM", VM: "1", Retired: true},
+	{Tag: 0x00321020, Name: "Scheduled Study Location", Keyword: "ScheduledStudyLocation", VR: "LO", VM: "1", Retired: true},
+	{Tag: 0x00321021, Name: "Scheduled Study Location AE Title", Keyword: "ScheduledStudyLocationAETitle", VR: "AE", VM: "1-n", Retired: true},
+	{Tag: 0x00321030, Name: "Reason for Study", Keyword: "ReasonForStudy", VR: "LO", VM: "1", Retired: true},
+	{Tag: 0x00321031, Name: "Requesting Physician Identification Sequence", Keyword: "RequestingPhysicianIdentificationSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00321032, Name: "Requesting Physician", Keyword: "RequestingPhysician", VR: "PN", VM: "1"},
+	{Tag: 0x00321033, Name: "Requesting Service", Keyword: "RequestingService", VR: "LO", VM: "1"},
+	{Tag: 0x00321034, Name: "Requesting Service Code Sequence", Keyword: "RequestingServiceCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00321040, Name: "Study Arrival Date", Keyword: "StudyArrivalDate", VR: "DA", VM: "1", Retired: true},
+	{Tag: 0x00321041, Name: "Study Arrival Time", Keyword: "StudyArrivalTime", VR: "TM", VM: "1", Retired: true},
+	{Tag: 0x00321050, Name: "Study Completion Date", Keyword: "StudyCompletionDate", VR: "DA", VM: "1", Retired: true},
+	{Tag: 0x00321051, Name: "Study Completion Time", Keyword: "StudyCompletionTime", VR: "TM", VM: "1", Retired: true},
+	{Tag: 0x00321055, Name: "Study Component Status ID", Keyword: "StudyComponentStatusID", VR: "CS", VM: "1", Retired: true},
+	{Tag: 0x00321060, Name: "Requested Procedure Description", Keyword: "RequestedProcedureDescription", VR: "LO", VM: "1"},
+	{Tag: 0x00321064, Name: "Requested Procedure Code Sequence", Keyword: "RequestedProcedureCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00321065, Name: "Requested Laterality Code Sequence", Keyword: "RequestedLateralityCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00321066, Name: "Reason for Visit", Keyword: "ReasonForVisit", VR: "UT", VM: "1"},
+	{Tag: 0x00321067, Name: "Reason for Visit Code Sequence", Keyword: "ReasonForVisitCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00321070, Name: "Requested Contrast Agent", Keyword: "RequestedContrastAgent", VR: "LO", VM: "1"},
+	{Tag: 0x00324000, Name: "Study Comments", Keyword: "StudyComments", VR: "LT", VM: "1", Retired: true},
+	{Tag: 0x00340001, Name: "Flow Identifier Sequence", Keyword: "FlowIdentifierSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00340002, Name: "Flow Identifier", Keyword: "FlowIdentifier", VR: "OB", VM: "1"},
+	{Tag: 0x00340003, Name: "Flow Transfer Syntax UID", Keyword: "FlowTransferSyntaxUID", VR: "UI", VM: "1"},
+	{Tag: 0x00340004, Name: "Flow RTP Sampling Rate", Keyword: "FlowRTPSamplingRate", VR: "UL", VM: "1"},
+	{Tag: 0x00340005, Name: "Source Identifier", Keyword: "SourceIdentifier", VR: "OB", VM: "1"},
+	{Tag: 0x00340007, Name: "Frame Origin Timestamp", Keyword: "FrameOriginTimestamp", VR: "OB", VM: "1"},
+	{Tag: 0x00340008, Name: "Includes Imaging Subject", Keyword: "IncludesImagingSubject", VR: "CS", VM: "1"},
+	{Tag: 0x00340009, Name: "Frame Usefulness Group Sequence", Keyword: "FrameUsefulnessGroupSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x0034000A, Name: "Real-Time Bulk Data Flow Sequence", Keyword: "RealTimeBulkDataFlowSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x0034000B, Name: "Camera Position Group Sequence", Keyword: "CameraPositionGroupSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x0034000C, Name: "Includes Information", Keyword: "IncludesInformation", VR: "CS", VM: "1"},
+	{Tag: 0x0034000D, Name: "Time of Frame Group Sequence", Keyword: "TimeOfFrameGroupSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00380004, Name: "Referenced Patient Alias Sequence", Keyword: "ReferencedPatientAliasSequence", VR: "SQ", VM: "1", Retired: true},
+	{Tag: 0x00380008, Name: "Visit Status ID", Keyword: "VisitStatusID", VR: "CS", VM: "1"},
+	{Tag: 0x00380010, Name: "Admission ID", Keyword: "AdmissionID", VR: "LO", VM: "1"},
+	{Tag: 0x00380011, Name: "Issuer of Admission ID", Keyword: "IssuerOfAdmissionID", VR: "LO", VM: "1", Retired: true},
+	{Tag: 0x00380014, Name: "Issuer of Admission ID Sequence", Keyword: "IssuerOfAdmissionIDSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00380016, Name: "Route of Admissions", Keyword: "RouteOfAdmissions", VR: "LO", VM: "1"},
+	{Tag: 0x0038001A, Name: "Scheduled Admission Date", Keyword: "ScheduledAdmissionDate", VR: "DA", VM: "1", Retired: true},
+	{Tag: 0x0038001B, Name: "Scheduled Admission Time", Keyword: "ScheduledAdmissionTime", VR: "TM", VM: "1", Retired: true},
+	{Tag: 0x0038001C, Name: "Scheduled Discharge Date", Keyword: "ScheduledDischargeDate", VR: "DA", VM: "1", Retired: true},
+	{Tag: 0x0038001D, Name: "Scheduled Discharge Time", Keyword: "ScheduledDischargeTime", VR: "TM", VM: "1", Retired: true},
+	{Tag: 0x0038001E, Name: "Scheduled Patient Institution Residence", Keyword: "ScheduledPatientInstitutionResidence", VR: "LO", VM: "1", Retired: true},
+	{Tag: 0x00380020, Name: "Admitting Date", Keyword: "AdmittingDate", VR: "DA", VM: "1"},
+	{Tag: 0x00380021, Name: "Admitting Time", Keyword: "AdmittingTime", VR: "TM", VM: "1"},
+	{Tag: 0x00380030, Name: "Discharge Date", Keyword: "DischargeDate", VR: "DA", VM: "1", Retired: true},
+	{Tag: 0x00380032, Name: "Discharge Time", Keyword: "DischargeTime", VR: "TM", VM: "1", Retired: true},
+	{Tag: 0x00380040, Name: "Discharge Diagnosis Description", Keyword: "DischargeDiagnosisDescription", VR: "LO", VM: "1", Retired: true},
+	{Tag: 0x00380044, Name: "Discharge Diagnosis Code Sequence", Keyword: "DischargeDiagnosisCodeSequence", VR: "SQ", VM: "1", Retired: true},
+	{Tag: 0x00380050, Name: "Special Needs", Keyword: "SpecialNeeds", VR: "LO", VM: "1"},
+	{Tag: 0x00380060, Name: "Service Episode ID", Keyword: "ServiceEpisodeID", VR: "LO", VM: "1"},
+	{Tag: 0x00380061, Name: "Issuer of Service Episode ID", Keyword: "IssuerOfServiceEpisodeID", VR: "LO", VM: "1", Retired: true},
+	{Tag: 0x00380062, Name: "Service Episode Description", Keyword: "ServiceEpisodeDescription", VR: "LO", VM: "1"},
+	{Tag: 0x00380064, Name: "Issuer of Service Episode ID Sequence", Keyword: "IssuerOfServiceEpisodeIDSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00380100, Name: "Pertinent Documents Sequence", Keyword: "PertinentDocumentsSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00380101, Name: "Pertinent Resources Sequence", Keyword: "PertinentResourcesSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00380102, Name: "Resource Description", Keyword: "ResourceDescription", VR: "LO", VM: "1"},
+	{Tag: 0x00380300, Name: "Current Patient Location", Keyword: "CurrentPatientLocation", VR: "LO", VM: "1"},
+	{Tag: 0x00380400, Name: "Patient's Institution Residence", Keyword: "PatientInstitutionResidence", VR: "LO", VM: "1"},
+	{Tag: 0x00380500, Name: "Patient State", Keyword: "PatientState", VR: "LO", VM: "1"},
+	{Tag: 0x00380502, Name: "Patient Clinical Trial Participation Sequence", Keyword: "PatientClinicalTrialParticipationSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00384000, Name: "Visit Comments", Keyword: "VisitComments", VR: "LT", VM: "1"},
+	{Tag: 0x003A0004, Name: "Waveform Originality", Keyword: "WaveformOriginality", VR: "CS", VM: "1"},
+	{Tag: 0x003A0005, Name: "Number of Waveform Channels", Keyword: "NumberOfWaveformChannels", VR: "US", VM: "1"},
+	{Tag: 0x003A0010, Name: "Number of Waveform Samples", Keyword: "NumberOfWaveformSamples", VR: "UL", VM: "1"},
+	{Tag: 0x003A001A, Name: "Sampling Frequency", Keyword: "SamplingFrequency", VR: "DS", VM: "1"},
+	{Tag: 0x003A0020, Name: "Multiplex Group Label", Keyword: "MultiplexGroupLabel", VR: "SH", VM: "1"},
+	{Tag: 0x003A0200, Name: "Channel Definition Sequence", Keyword: "ChannelDefinitionSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x003A0202, Name: "Waveform Channel Number", Keyword: "WaveformChannelNumber", VR: "IS", VM: "1"},
+	{Tag: 0x003A0203, Name: "Channel Label", Keyword: "ChannelLabel", VR: "SH", VM: "1"},
+	{Tag: 0x003A0205, Name: "Channel Status", Keyword: "ChannelStatus", VR: "CS", VM: "1-n"},
+	{Tag: 0x003A0208, Name: "Channel Source Sequence", Keyword: "ChannelSourceSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x003A0209, Name: "Channel Source Modifiers Sequence", Keyword: "ChannelSourceModifiersSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x003A020A, Name: "Source Waveform Sequence", Keyword: "SourceWaveformSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x003A020C, Name: "Channel Derivation Description", Keyword: "ChannelDerivationDescription", VR: "LO", VM: "1"},
+	{Tag: 0x003A0210, Name: "Channel Sensitivity", Keyword: "ChannelSensitivity", VR: "DS", VM: "1"},
+	{Tag: 0x003A0211, Name: "Channel Sensitivity Units Sequence", Keyword: "ChannelSensitivityUnitsSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x003A0212, Name: "Channel Sensitivity Correction Factor", Keyword: "ChannelSensitivityCorrectionFactor", VR: "DS", VM: "1"},
+	{Tag: 0x003A0213, Name: "Channel Baseline", Keyword: "ChannelBaseline", VR: "DS", VM: "1"},
+	{Tag: 0x003A0214, Name: "Channel Time Skew", Keyword: "ChannelTimeSkew", VR: "DS", VM: "1"},
+	{Tag: 0x003A0215, Name: "Channel Sample Skew", Keyword: "ChannelSampleSkew", VR: "DS", VM: "1"},
+	{Tag: 0x003A0218, Name: "Channel Offset", Keyword: "ChannelOffset", VR: "DS", VM: "1"},
+	{Tag: 0x003A021A, Name: "Waveform Bits Stored", Keyword: "WaveformBitsStored", VR: "US", VM: "1"},
+	{Tag: 0x003A0220, Name: "Filter Low Frequency", Keyword: "FilterLowFrequency", VR: "DS", VM: "1"},
+	{Tag: 0x003A0221, Name: "Filter High Frequency", Keyword: "FilterHighFrequency", VR: "DS", VM: "1"},
+	{Tag: 0x003A0222, Name: "Notch Filter Frequency", Keyword: "NotchFilterFrequency", VR: "DS", VM: "1"},
+	{Tag: 0x003A0223, Name: "Notch Filter Bandwidth", Keyword: "NotchFilterBandwidth", VR: "DS", VM: "1"},
+	{Tag: 0x003A0230, Name: "Waveform Data Display Scale", Keyword: "WaveformDataDisplayScale", VR: "FL", VM: "1"},
+	{Tag: 0x003A0231, Name: "Waveform Display Background CIELab Value", Keyword: "WaveformDisplayBackgroundCIELabValue", VR: "US", VM: "3"},
+	{Tag: 0x003A0240, Name: "Waveform Presentation Group Sequence", Keyword: "WaveformPresentationGroupSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x003A0241, Name: "Presentation Group Number", Keyword: "PresentationGroupNumber", VR: "US", VM: "1"},
+	{Tag: 0x003A0242, Name: "Channel Display Sequence", Keyword: "ChannelDisplaySequence", VR: "SQ", VM: "1"},
+	{Tag: 0x003A0244, Name: "Channel Recommended Display CIELab Value", Keyword: "ChannelRecommendedDisplayCIELabValue", VR: "US", VM: "3"},
+	{Tag: 0x003A0245, Name: "Channel Position", Keyword: "ChannelPosition", VR: "FL", VM: "1"},
+	{Tag: 0x003A0246, Name: "Display Shading Flag", Keyword: "DisplayShadingFlag", VR: "CS", VM: "1"},
+	{Tag: 0x003A0247, Name: "Fractional Channel Display Scale", Keyword: "FractionalChannelDisplayScale", VR: "FL", VM: "1"},
+	{Tag: 0x003A0248, Name: "Absolute Channel Display Scale", Keyword: "AbsoluteChannelDisplayScale", VR: "FL", VM: "1"},
+	{Tag: 0x003A0300, Name: "Multiplexed Audio Channels Description Code Sequence", Keyword: "MultiplexedAudioChannelsDescriptionCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x003A0301, Name: "Channel Identification Code", Keyword: "ChannelIdentificationCode", VR: "IS", VM: "1"},
+	{Tag: 0x003A0302, Name: "Channel Mode", Keyword: "ChannelMode", VR: "CS", VM: "1"},
+	{Tag: 0x003A0310, Name: "Multiplex Group UID", Keyword: "MultiplexGroupUID", VR: "UI", VM: "1"},
+	{Tag: 0x003A0311, Name: "Powerline Frequency", Keyword: "PowerlineFrequency", VR: "DS", VM: "1"},
+	{Tag: 0x003A0312, Name: "Channel Impedance Sequence", Keyword: "ChannelImpedanceSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x003A0313, Name: "Impedance Value", Keyword: "ImpedanceValue", VR: "DS", VM: "1"},
+	{Tag: 0x003A0314, Name: "Impedance Measurement DateTime", Keyword: "ImpedanceMeasurementDateTime", VR: "DT", VM: "1"},
+	{Tag: 0x003A0315, Name: "Impedance Measurement Frequency", Keyword: "ImpedanceMeasurementFrequency", VR: "DS", VM: "1"},
+	{Tag: 0x003A0316, Name: "Impedance Measurement Current Type", Keyword: "ImpedanceMeasurementCurrentType", VR: "CS", VM: "1"},
+	{Tag: 0x003A0317, Name: "Waveform Amplifier Type", Keyword: "WaveformAmplifierType", VR: "CS", VM: "1"},
+	{Tag: 0x003A0318, Name: "Filter Low Frequency Characteristics Sequence", Keyword: "FilterLowFrequencyCharacteristicsSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x003A0319, Name: "Filter High Frequency Characteristics Sequence", Keyword: "FilterHighFrequencyCharacteristicsSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x003A0320, Name: "Summarized Filter Lookup Table Sequence", Keyword: "SummarizedFilterLookupTable", VR: "SQ", VM: "1"},
+	{Tag: 0x003A0321, Name: "Notch Filter Characteristics Sequence", Keyword: "NotchFilterCharacteristicsSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x003A0322, Name: "Waveform Filter Type", Keyword: "WaveformFilterType", VR: "CS", VM: "1"},
+	{Tag: 0x003A0323, Name: "Analog Filter Characteristics Sequence", Keyword: "AnalogFilterCharacteristicsSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x003A0324, Name: "Analog Filter Roll Off", Keyword: "AnalogFilterRollOff", VR: "DS", VM: "1"},
+	{Tag: 0x003A0325, Name: "Analog Filter Type Code Sequence", Keyword: "AnalogFilterType", VR: "SQ", VM: "1"},
+	{Tag: 0x003A0326, Name: "Digital Filter Characteristics Sequence", Keyword: "DigitalFilterCharacteristicsSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x003A0327, Name: "Digital Filter Order", Keyword: "DigitalFilterOrder", VR: "IS", VM: "1"},
+	{Tag: 0x003A0328, Name: "Digital Filter Type Code Sequence", Keyword: "DigitalFilterTypeCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x003A0329, Name: "Waveform Filter Description", Keyword: "WaveformFilterDescription", VR: "ST", VM: "1"},
+	{Tag: 0x003A032A, Name: "Filter Lookup Table Sequence", Keyword: "FilterLookupTableSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x003A032B, Name: "Filter Lookup Table Description", Keyword: "FilterLookupTableDescription", VR: "ST", VM: "1"},
+	{Tag: 0x003A032C, Name: "Frequency Encoding Code Sequence", Keyword: "FrequencyEncodingCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x003A032D, Name: "Magnitude Encoding Code Sequence", Keyword: "MagnitudeEncodingCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x003A032E, Name: "Filter Lookup Table Data", Keyword: "FilterLookupTableData", VR: "OD", VM: "1"},
+	{Tag: 0x00400001, Name: "Scheduled Station AE Title", Keyword: "ScheduledStationAETitle", VR: "AE", VM: "1-n"},
+	{Tag: 0x00400002, Name: "Scheduled Procedure Step Start Date", Keyword: "ScheduledProcedureStepStartDate", VR: "DA", VM: "1"},
+	{Tag: 0x00400003, Name: "Scheduled Procedure Step Start Time", Keyword: "ScheduledProcedureStepStartTime", VR: "TM", VM: "1"},
+	{Tag: 0x00400004, Name: "Scheduled Procedure Step End Date", Keyword: "ScheduledProcedureStepEndDate", VR: "DA", VM: "1"},
+	{Tag: 0x00400005, Name: "Scheduled Procedure Step End Time", Keyword: "ScheduledProcedureStepEndTime", VR: "TM", VM: "1"},
+	{Tag: 0x00400006, Name: "Scheduled Performing Physician's Name", Keyword: "ScheduledPerformingPhysicianName", VR: "PN", VM: "1"},
+	{Tag: 0x00400007, Name: "Scheduled Procedure Step Description", Keyword: "ScheduledProcedureStepDescription", VR: "LO", VM: "1"},
+	{Tag: 0x00400008, Name: "Scheduled Protocol Code Sequence", Keyword: "ScheduledProtocolCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00400009, Name: "Scheduled Procedure Step ID", Keyword: "ScheduledProcedureStepID", VR: "SH", VM: "1"},
+	{Tag: 0x0040000A, Name: "Stage Code Sequence", Keyword: "StageCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x0040000B, Name: "Scheduled Performing Physician Identification Sequence", Keyword: "ScheduledPerformingPhysicianIdentificationSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00400010, Name: "Scheduled Station Name", Keyword: "ScheduledStationName", VR: "SH", VM: "1-n"},
+	{Tag: 0x00400011, Name: "Scheduled Procedure Step Location", Keyword: "ScheduledProcedureStepLocation", VR: "SH", VM: "1"},
+	{Tag: 0x00400012, Name: "Pre-Medication", Keyword: "PreMedication", VR: "LO", VM: "1"},
+	{Tag: 0x00400020, Name: "Scheduled Procedure Step Status", Keyword: "ScheduledProcedureStepStatus", VR: "CS", VM: "1"},
+	{Tag: 0x00400026, Name: "Order Placer Identifier Sequence", Keyword: "OrderPlacerIdentifierSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00400027, Name: "Order Filler Identifier Sequence", Keyword: "OrderFillerIdentifierSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00400031, Name: "Local Namespace Entity ID", Keyword: "LocalNamespaceEntityID", VR: "UT", VM: "1"},
+	{Tag: 0x00400032, Name: "Universal Entity ID", Keyword: "UniversalEntityID", VR: "UT", VM: "1"},
+	{Tag: 0x00400033, Name: "Universal Entity ID Type", Keyword: "UniversalEntityIDType", VR: "CS", VM: "1"},
+	{Tag: 0x00400035, Name: "Identifier Type Code", Keyword: "IdentifierTypeCode", VR: "CS", VM: "1"},
+	{Tag: 0x00400036, Name: "Assigning Facility Sequence", Keyword: "AssigningFacilitySequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00400039, Name: "Assigning Jurisdiction Code Sequence", Keyword: "AssigningJurisdictionCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x0040003A, Name: "Assigning Agency or Department Code Sequence", Keyword: "AssigningAgencyOrDepartmentCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00400100, Name: "Scheduled Procedure Step Sequence", Keyword: "ScheduledProcedureStepSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00400220, Name: "Referenced Non-Image Composite SOP Instance Sequence", Keyword: "ReferencedNonImageCompositeSOPInstanceSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00400241, Name: "Performed Station AE Title", Keyword: "PerformedStationAETitle", VR: "AE", VM: "1"},
+	{Tag: 0x00400242, Name: "Performed Station Name", Keyword: "PerformedStationName", VR: "SH", VM: "1"},
+	{Tag: 0x00400243, Name: "Performed Location", Keyword: "PerformedLocation", VR: "SH", VM: "1"},
+	{Tag: 0x00400244, Name: "Performed Procedure Step Start Date", Keyword: "PerformedProcedureStepStartDate", VR: "DA", VM: "1"},
+	{Tag: 0x00400245, Name: "Performed Procedure Step Start Time", Keyword: "PerformedProcedureStepStartTime", VR: "TM", VM: "1"},
+	{Tag: 0x00400250, Name: "Performed Procedure Step End Date", Keyword: "PerformedProcedureStepEndDate", VR: "DA", VM: "1"},
+	{Tag: 0x00400251, Name: "Performed Procedure Step End Time", Keyword: "PerformedProcedureStepEndTime", VR: "TM", VM: "1"},
+	{Tag: 0x00400252, Name: "Performed Procedure Step Status", Keyword: "PerformedProcedureStepStatus", VR: "CS", VM: "1"},
+	{Tag: 0x00400253, Name: "Performed Procedure Step ID", Keyword: "PerformedProcedureStepID", VR: "SH", VM: "1"},
+	{Tag: 0x00400254, Name: "Performed Procedure Step Description", Keyword: "PerformedProcedureStepDescription", VR: "LO", VM: "1"},
+	{Tag: 0x00400255, Name: "Performed Procedure Type Description", Keyword: "PerformedProcedureTypeDescription", VR: "LO", VM: "1"},
+	{Tag: 0x00400260, Name: "Performed Protocol Code Sequence", Keyword: "PerformedProtocolCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00400261, Name: "Performed Protocol Type", Keyword: "PerformedProtocolType", VR: "CS", VM: "1"},
+	{Tag: 0x00400270, Name: "Scheduled Step Attributes Sequence", Keyword: "ScheduledStepAttributesSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00400275, Name: "Request Attributes Sequence", Keyword: "RequestAttributesSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00400280, Name: "Comments on the Performed Procedure Step", Keyword: "CommentsOnThePerformedProcedureStep", VR: "ST", VM: "1"},
+	{Tag: 0x00400281, Name: "Performed Procedure Step Discontinuation Reason Code Sequence", Keyword: "PerformedProcedureStepDiscontinuationReasonCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00400293, Name: "Quantity Sequence", Keyword: "QuantitySequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00400294, Name: "Quantity", Keyword: "Quantity", VR: "DS", VM: "1"},
+	{Tag: 0x00400295, Name: "Measuring Units Sequence", Keyword: "MeasuringUnitsSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00400296, Name: "Billing Item Sequence", Keyword: "BillingItemSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00400300, Name: "Total Time of Fluoroscopy", Keyword: "TotalTimeOfFluoroscopy", VR: "US", VM: "1", Retired: true},
+	{Tag: 0x00400301, Name: "Total Number of Exposures", Keyword: "TotalNumberOfExposures", VR: "US", VM: "1", Retired: true},
+	{Tag: 0x00400302, Name: "Entrance Dose", Keyword: "EntranceDose", VR: "US", VM: "1"},
+	{Tag: 0x00400303, Name: "Exposed Area", Keyword: "ExposedArea", VR: "US", VM: "1-2"},
+	{Tag: 0x00400306, Name: "Distance Source to Entrance", Keyword: "DistanceSourceToEntrance", VR: "DS", VM: "1"},
+	{Tag: 0x00400307, Name: "Distance Source to Support", Keyword: "DistanceSourceToSupport", VR: "DS", VM: "1", Retired: true},
+	{Tag: 0x0040030E, Name: "Exposure Dose Sequence", Keyword: "ExposureDoseSequence", VR: "SQ", VM: "1", Retired: true},
+	{Tag: 0x00400310, Name: "Comments on Radiation Dose", Keyword: "CommentsOnRadiationDose", VR: "ST", VM: "1"},
+	{Tag: 0x00400312, Name: "X-Ray Output", Keyword: "XRayOutput", VR: "DS", VM: "1"},
+	{Tag: 0x00400314, Name: "Half Value Layer", Keyword: "HalfValueLayer", VR: "DS", VM: "1"},
+	{Tag: 0x00400316, Name: "Organ Dose", Keyword: "OrganDose", VR: "DS", VM: "1"},
+	{Tag: 0x00400318, Name: "Organ Exposed", Keyword: "OrganExposed", VR: "CS", VM: "1"},
+	{Tag: 0x00400320, Name: "Billing Procedure Step Sequence", Keyword: "BillingProcedureStepSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00400321, Name: "Film Consumption Sequence", Keyword: "FilmConsumptionSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00400324, Name: "Billing Supplies and Devices Sequence", Keyword: "BillingSuppliesAndDevicesSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00400330, Name: "Referenced Procedure Step Sequence", Keyword: "ReferencedProcedureStepSequence", VR: "SQ", VM: "1", Retired: true},
+	{Tag: 0x00400340, Name: "Performed Series Sequence", Keyword: "PerformedSeriesSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00400400, Name: "Comments on the Scheduled Procedure Step", Keyword: "CommentsOnTheScheduledProcedureStep", VR: "LT", VM: "1"},
+	{Tag: 0x00400440, Name: "Protocol Context Sequence", Keyword: "ProtocolContextSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00400441, Name: "Content Item Modifier Sequence", Keyword: "ContentItemModifierSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00400500, Name: "Scheduled Specimen Sequence", Keyword: "ScheduledSpecimenSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x0040050A, Name: "Specimen Accession Number", Keyword: "SpecimenAccessionNumber", VR: "LO", VM: "1", Retired: true},
+	{Tag: 0x00400512, Name: "Container Identifier", Keyword: "ContainerIdentifier", VR: "LO", VM: "1"},
+	{Tag: 0x00400513, Name: "Issuer of the Container Identifier Sequence", Keyword: "IssuerOfTheContainerIdentifierSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00400515, Name: "Alternate Container Identifier Sequence", Keyword: "AlternateContainerIdentifierSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00400518, Name: "Container Type Code Sequence", Keyword: "ContainerTypeCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x0040051A, Name: "Container Description", Keyword: "ContainerDescription", VR: "LO", VM: "1"},
+	{Tag: 0x00400520, Name: "Container Component Sequence", Keyword: "ContainerComponentSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00400550, Name: "Specimen Sequence", Keyword: "SpecimenSequence", VR: "SQ", VM: "1", Retired: true},
+	{Tag: 0x00400551, Name: "Specimen Identifier", Keyword: "SpecimenIdentifier", VR: "LO", VM: "1"},
+	{Tag: 0x00400552, Name: "Specimen Description Sequence (Trial)", Keyword: "SpecimenDescriptionSequenceTrial", VR: "SQ", VM: "1", Retired: true},
+	{Tag: 0x00400553, Name: "Specimen Description (Trial)", Keyword: "SpecimenDescriptionTrial", VR: "ST", VM: "1", Retired: true},
+	{Tag: 0x00400554, Name: "Specimen UID", Keyword: "SpecimenUID", VR: "UI", VM: "1"},
+	{Tag: 0x00400555, Name: "Acquisition Context Sequence", Keyword: "AcquisitionContextSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00400556, Name: "Acquisition Context Description", Keyword: "AcquisitionContextDescription", VR: "ST", VM: "1"},
+	{Tag: 0x00400560, Name: "Specimen Description Sequence", Keyword: "SpecimenDescriptionSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00400562, Name: "Issuer of the Specimen Identifier Sequence", Keyword: "IssuerOfTheSpecimenIdentifierSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x0040059A, Name: "Specimen Type Code Sequence", Keyword: "SpecimenTypeCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00400600, Name: "Specimen Short Description", Keyword: "SpecimenShortDescription", VR: "LO", VM: "1"},
+	{Tag: 0x00400602, Name: "Specimen Detailed Description", Keyword: "SpecimenDetailedDescription", VR: "UT", VM: "1"},
+	{Tag: 0x00400610, Name: "Specimen Preparation Sequence", Keyword: "SpecimenPreparationSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00400612, Name: "Specimen Preparation Step Content Item Sequence", Keyword: "SpecimenPreparationStepContentItemSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00400620, Name: "Specimen Localization Content Item Sequence", Keyword: "SpecimenLocalizationContentItemSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x004006FA, Name: "Slide Identifier", Keyword: "SlideIdentifier", VR: "LO", VM: "1", Retired: true},
+	{Tag: 0x00400710, Name: "Whole Slide Microscopy Image Frame Type Sequence", Keyword: "WholeSlideMicroscopyImageFrameTypeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x0040071A, Name: "Image Center Point Coordinates Sequence", Keyword: "ImageCenterPointCoordinatesSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x0040072A, Name: "X Offset in Slide Coordinate System", Keyword: "XOffsetInSlideCoordinateSystem", VR: "DS", VM: "1"},
+	{Tag: 0x0040073A, Name: "Y Offset in Slide Coordinate System", Keyword: "YOffsetInSlideCoordinateSystem", VR: "DS", VM: "1"},
+	{Tag: 0x0040074A, Name: "Z Offset in Slide Coordinate System", Keyword: "ZOffsetInSlideCoordinateSystem", VR: "DS", VM: "1"},
+	{Tag: 0x004008D8, Name: "Pixel Spacing Sequence", Keyword: "PixelSpacingSequence", VR: "SQ", VM: "1", Retired: true},
+	{Tag: 0x004008DA, Name: "Coordinate System Axis Code Sequence", Keyword: "CoordinateSystemAxisCodeSequence", VR: "SQ", VM: "1", Retired: true},
+	{Tag: 0x004008EA, Name: "Measurement Units Code Sequence", Keyword: "MeasurementUnitsCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x004009F8, Name: "Vital Stain Code Sequence (Trial)", Keyword: "VitalStainCodeSequenceTrial", VR: "SQ", VM: "1", Retired: true},
+	{Tag: 0x00401001, Name: "Requested Procedure ID", Keyword: "RequestedProcedureID", VR: "SH", VM: "1"},
+	{Tag: 0x00401002, Name: "Reason for the Requested Procedure", Keyword: "ReasonForTheRequestedProcedure", VR: "LO", VM: "1"},
+	{Tag: 0x00401003, Name: "Requested Procedure Priority", Keyword: "RequestedProcedurePriority", VR: "SH", VM: "1"},
+	{Tag: 0x00401004, Name: "Patient Transport Arrangements", Keyword: "PatientTransportArrangements", VR: "LO", VM: "1"},
+	{Tag: 0x00401005, Name: "Requested Procedure Location", Keyword: "RequestedProcedureLocation", VR: "LO", VM: "1"},
+	{Tag: 0x00401006, Name: "Placer Order Number / Procedure", Keyword: "PlacerOrderNumberProcedure", VR: "SH", VM: "1", Retired: true},
+	{Tag: 0x00401007, Name: "Filler Order Number / Procedure", Keyword: "FillerOrderNumberProcedure", VR: "SH", VM: "1", Retired: true},
+	{Tag: 0x00401008, Name: "Confidentiality Code", Keyword: "ConfidentialityCode", VR: "LO", VM: "1"},
+	{Tag: 0x00401009, Name: "Reporting Priority", Keyword: "ReportingPriority", VR: "SH", VM: "1"},
+	{Tag: 0x0040100A, Name: "Reason for Requested Procedure Code Sequence", Keyword: "ReasonForRequestedProcedureCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00401010, Name: "Names of Intended Recipients of Results", Keyword: "NamesOfIntendedRecipientsOfResults", VR: "PN", VM: "1-n"},
+	{Tag: 0x00401011, Name: "Intended Recipients of Results Identification Sequence", Keyword: "IntendedRecipientsOfResultsIdentificationSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00401012, Name: "Reason For Performed Procedure Code Sequence", Keyword: "ReasonForPerformedProcedureCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00401060, Name: "Requested Procedure Description (Trial)", Keyword: "RequestedProcedureDescriptionTrial", VR: "LO", VM: "1", Retired: true},
+	{Tag: 0x00401101, Name: "Person Identification Code Sequence", Keyword: "PersonIdentificationCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00401102, Name: "Person's Address", Keyword: "PersonAddress", VR: "ST", VM: "1"},
+	{Tag: 0x00401103, Name: "Person's Telephone Numbers", Keyword: "PersonTelephoneNumbers", VR: "LO", VM: "1-n"},
+	{Tag: 0x00401104, Name: "Person's Telecom Information", Keyword: "PersonTelecomInformation", VR: "LT", VM: "1"},
+	{Tag: 0x00401400, Name: "Requested Procedure Comments", Keyword: "RequestedProcedureComments", VR: "LT", VM: "1"},
+	{Tag: 0x00402001, Name: "Reason for the Imaging Service Request", Keyword: "ReasonForTheImagingServiceRequest", VR: "LO", VM: "1", Retired: true},
+	{Tag: 0x00402004, Name: "Issue Date of Imaging Service Request", Keyword: "IssueDateOfImagingServiceRequest", VR: "DA", VM: "1"},
+	{Tag: 0x00402005, Name: "Issue Time of Imaging Service Request", Keyword: "IssueTimeOfImagingServiceRequest", VR: "TM", VM: "1"},
+	{Tag: 0x00402006, Name: "Placer Order Number / Imaging Service Request (Retired)", Keyword: "PlacerOrderNumberImagingServiceRequestRetired", VR: "SH", VM: "1", Retired: true},
+	{Tag: 0x00402007, Name: "Filler Order Number / Imaging Service Request (Retired)", Keyword: "FillerOrderNumberImagingServiceRequestRetired", VR: "SH", VM: "1", Retired: true},
+	{Tag: 0x00402008, Name: "Order Entered By", Keyword: "OrderEnteredBy", VR: "PN", VM: "1"},
+	{Tag: 0x00402009, Name: "Order Enterer's Location", Keyword: "OrderEntererLocation", VR: "SH", VM: "1"},
+	{Tag: 0x00402010, Name: "Order Callback Phone Number", Keyword: "OrderCallbackPhoneNumber", VR: "SH", VM: "1"},
+	{Tag: 0x00402011, Name: "Order Callback Telecom Information", Keyword: "OrderCallbackTelecomInformation", VR: "LT", VM: "1"},
+	{Tag: 0x00402016, Name: "Placer Order Number / Imaging Service Request", Keyword: "PlacerOrderNumberImagingServiceRequest", VR: "LO", VM: "1"},
+	{Tag: 0x00402017, Name: "Filler Order Number / Imaging Service Request", Keyword: "FillerOrderNumberImagingServiceRequest", VR: "LO", VM: "1"},
+	{Tag: 0x00402400, Name: "Imaging Service Request Comments", Keyword: "ImagingServiceRequestComments", VR: "LT", VM: "1"},
+	{Tag: 0x00403001, Name: "Confidentiality Constraint on Patient Data Description", Keyword: "ConfidentialityConstraintOnPatientDataDescription", VR: "LO", VM: "1"},
+	{Tag: 0x00404001, Name: "General Purpose Scheduled Procedure Step Status", Keyword: "GeneralPurposeScheduledProcedureStepStatus", VR: "CS", VM: "1", Retired: true},
+	{Tag: 0x00404002, Name: "General Purpose Performed Procedure Step Status", Keyword: "GeneralPurposePerformedProcedureStepStatus", VR: "CS", VM: "1", Retired: true},
+	{Tag: 0x00404003, Name: "General Purpose Scheduled Procedure Step Priority", Keyword: "GeneralPurposeScheduledProcedureStepPriority", VR: "CS", VM: "1", Retired: true},
+	{Tag: 0x00404004, Name: "Scheduled Processing Applications Code Sequence", Keyword: "ScheduledProcessingApplicationsCodeSequence", VR: "SQ", VM: "1", Retired: true},
+	{Tag: 0x00404005, Name: "Scheduled Procedure Step Start DateTime", Keyword: "ScheduledProcedureStepStartDateTime", VR: "DT", VM: "1"},
+	{Tag: 0x00404006, Name: "Multiple Copies Flag", Keyword: "MultipleCopiesFlag", VR: "CS", VM: "1", Retired: true},
+	{Tag: 0x00404007, Name: "Performed Processing Applications Code Sequence", Keyword: "PerformedProcessingApplicationsCodeSequence", VR: "SQ", VM: "1", Retired: true},
+	{Tag: 0x00404008, Name: "Scheduled Procedure Step Expiration DateTime", Keyword: "ScheduledProcedureStepExpirationDateTime", VR: "DT", VM: "1"},
+	{Tag: 0x00404009, Name: "Human Performer Code Sequence", Keyword: "HumanPerformerCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00404010, Name: "Scheduled Procedure Step Modification DateTime", Keyword: "ScheduledProcedureStepModificationDateTime", VR: "DT", VM: "1"},
+	{Tag: 0x00404011, Name: "Expected Completion DateTime", Keyword: "ExpectedCompletionDateTime", VR: "DT", VM: "1"},
+	{Tag: 0x00404015, Name: "Resulting General Purpose Performed Procedure Steps Sequence", Keyword: "ResultingGeneralPurposePerformedProcedureStepsSequence", VR: "SQ", VM: "1", Retired: true},
+	{Tag: 0x00404016, Name: "Referenced General Purpose Scheduled Procedure Step Sequence", Keyword: "ReferencedGeneralPurposeScheduledProcedureStepSequence", VR: "SQ", VM: "1", Retired: true},
+	{Tag: 0x00404018, Name: "Scheduled Workitem Code Sequence", Keyword: "ScheduledWorkitemCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00404019, Name: "Performed Workitem Code Sequence", Keyword: "PerformedWorkitemCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00404020, Name: "Input Availability Flag", Keyword: "InputAvailabilityFlag", VR: "CS", VM: "1", Retired: true},
+	{Tag: 0x00404021, Name: "Input Information Sequence", Keyword: "InputInformationSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00404022, Name: "Relevant Information Sequence", Keyword: "RelevantInformationSequence", VR: "SQ", VM: "1", Retired: true},
+	{Tag: 0x00404023, Name: "Referenced General Purpose Scheduled Procedure Step Transaction UID", Keyword: "ReferencedGeneralPurposeScheduledProcedureStepTransactionUID", VR: "UI", VM: "1", Retired: true},
+	{Tag: 0x00404025, Name: "Scheduled Station Name Code Sequence", Keyword: "ScheduledStationNameCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00404026, Name: "Scheduled Station Class Code Sequence", Keyword: "ScheduledStationClassCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00404027, Name: "Scheduled Station Geographic Location Code Sequence", Keyword: "ScheduledStationGeographicLocationCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00404028, Name: "Performed Station Name Code Sequence", Keyword: "PerformedStationNameCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00404029, Name: "Performed Station Class Code Sequence", Keyword: "PerformedStationClassCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00404030, Name: "Performed Station Geographic Location Code Sequence", Keyword: "PerformedStationGeographicLocationCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00404031, Name: "Requested Subsequent Workitem Code Sequence", Keyword: "RequestedSubsequentWorkitemCodeSequence", VR: "SQ", VM: "1", Retired: true},
+	{Tag: 0x00404032, Name: "Non-DICOM Output Code Sequence", Keyword: "NonDICOMOutputCodeSequence", VR: "SQ", VM: "1", Retired: true},
+	{Tag: 0x00404033, Name: "Output Information Sequence", Keyword: "OutputInformationSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00404034, Name: "Scheduled Human Performers Sequence", Keyword: "ScheduledHumanPerformersSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00404035, Name: "Actual Human Performers Sequence", Keyword: "ActualHumanPerformersSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00404036, Name: "Human Performer's Organization", Keyword: "HumanPerformerOrganization", VR: "LO", VM: "1"},
+	{Tag: 0x00404037, Name: "Human Performer's Name", Keyword: "HumanPerformerName", VR: "PN", VM: "1"},
+	{Tag: 0x00404040, Name: "Raw Data Handling", Keyword: "RawDataHandling", VR: "CS", VM: "1"},
+	{Tag: 0x00404041, Name: "Input Readiness State", Keyword: "InputReadinessState", VR: "CS", VM: "1"},
+	{Tag: 0x00404050, Name: "Performed Procedure Step Start DateTime", Keyword: "PerformedProcedureStepStartDateTime", VR: "DT", VM: "1"},
+	{Tag: 0x00404051, Name: "Performed Procedure Step End DateTime", Keyword: "PerformedProcedureStepEndDateTime", VR: "DT", VM: "1"},
+	{Tag: 0x00404052, Name: "Procedure Step Cancellation DateTime", Keyword: "ProcedureStepCancellationDateTime", VR: "DT", VM: "1"},
+	{Tag: 0x00404070, Name: "Output Destination Sequence", Keyword: "OutputDestinationSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00404071, Name: "DICOM Storage Sequence", Keyword: "DICOMStorageSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00404072, Name: "STOW-RS Storage Sequence", Keyword: "STOWRSStorageSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00404073, Name: "Storage URL", Keyword: "StorageURL", VR: "UR", VM: "1"},
+	{Tag: 0x00404074, Name: "XDS Storage Sequence", Keyword: "XDSStorageSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00408302, Name: "Entrance Dose in mGy", Keyword: "EntranceDoseInmGy", VR: "DS", VM: "1"},
+	{Tag: 0x00408303, Name: "Entrance Dose Derivation", Keyword: "EntranceDoseDerivation", VR: "CS", VM: "1"},
+	{Tag: 0x00409092, Name: "Parametric Map Frame Type Sequence", Keyword: "ParametricMapFrameTypeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00409094, Name: "Referenced Image Real World Value Mapping Sequence", Keyword: "ReferencedImageRealWorldValueMappingSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00409096, Name: "Real World Value Mapping Sequence", Keyword: "RealWorldValueMappingSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00409098, Name: "Pixel Value Mapping Code Sequence", Keyword: "PixelValueMappingCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00409210, Name: "LUT Label", Keyword: "LUTLabel", VR: "SH", VM: "1"},
+	{Tag: 0x00409211, Name: "Real World Value Last Value Mapped", Keyword: "RealWorldValueLastValueMapped", VR: "US or SS", VM: "1"},
+	{Tag: 0x00409212, Name: "Real World Value LUT Data", Keyword: "RealWorldValueLUTData", VR: "FD", VM: "1-n"},
+	{Tag: 0x00409213, Name: "Double Float Real World Value Last Value Mapped", Keyword: "DoubleFloatRealWorldValueLastValueMapped", VR: "FD", VM: "1"},
+	{Tag: 0x00409214, Name: "Double Float Real World Value First Value Mapped", Keyword: "DoubleFloatRealWorldValueFirstValueMapped", VR: "FD", VM: "1"},
+	{Tag: 0x00409216, Name: "Real World Value First Value Mapped", Keyword: "RealWorldValueFirstValueMapped", VR: "US or SS", VM: "1"},
+	{Tag: 0x00409220, Name: "Quantity Definition Sequence", Keyword: "QuantityDefinitionSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00409224, Name: "Real World Value Intercept", Keyword: "RealWorldValueIntercept", VR: "FD", VM: "1"},
+	{Tag: 0x00409225, Name: "Real World Value Slope", Keyword: "RealWorldValueSlope", VR: "FD", VM: "1"},
+	{Tag: 0x0040A007, Name: "Findings Flag (Trial)", Keyword: "FindingsFlagTrial", VR: "CS", VM: "1", Retired: true},
+	{Tag: 0x0040A010, Name: "Relationship Type", Keyword: "RelationshipType", VR: "CS", VM: "1"},
+	{Tag: 0x0040A020, Name: "Findings Sequence (Trial)", Keyword: "FindingsSequenceTrial", VR: "SQ", VM: "1", Retired: true},
+	{Tag: 0x0040A021, Name: "Findings Group UID (Trial)", Keyword: "FindingsGroupUIDTrial", VR: "UI", VM: "1", Retired: true},
+	{Tag: 0x0040A022, Name: "Referenced Findings Group UID (Trial)", Keyword: "ReferencedFindingsGroupUIDTrial", VR: "UI", VM: "1", Retired: true},
+	{Tag: 0x0040A023, Name: "Findings Group Recording Date (Trial)", Keyword: "FindingsGroupRecordingDateTrial", VR: "DA", VM: "1", Retired: true},
+	{Tag: 0x0040A024, Name: "Findings Group Recording Time (Trial)", Keyword: "FindingsGroupRecordingTimeTrial", VR: "TM", VM: "1", Retired: true},
+	{Tag: 0x0040A026, Name: "Findings Source Category Code Sequence (Trial)", Keyword: "FindingsSourceCategoryCodeSequenceTrial", VR: "SQ", VM: "1", Retired: true},
+	{Tag: 0x0040A027, Name: "Verifying Organization", Keyword: "VerifyingOrganization", VR: "LO", VM: "1"},
+	{Tag: 0x0040A028, Name: "Documenting Organization Identifier Code Sequence (Trial)", Keyword: "DocumentingOrganizationIdentifierCodeSequenceTrial", VR: "SQ", VM: "1", Retired: true},
+	{Tag: 0x0040A030, Name: "Verification DateTime", Keyword: "VerificationDateTime", VR: "DT", VM: "1"},
+	{Tag: 0x0040A032, Name: "Observation DateTime", Keyword: "ObservationDateTime", VR: "DT", VM: "1"},
+	{Tag: 0x0040A033, Name: "Observation Start DateTime", Keyword: "ObservationStartDateTime", VR: "DT", VM: "1"},
+	{Tag: 0x0040A040, Name: "Value Type", Keyword: "ValueType", VR: "CS", VM: "1"},
+	{Tag: 0x0040A043, Name: "Concept Name Code Sequence", Keyword: "ConceptNameCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x0040A047, Name: "Measurement Precision Description (Trial)", Keyword: "MeasurementPrecisionDescriptionTrial", VR: "LO", VM: "1", Retired: true},
+	{Tag: 0x0040A050, Name: "Continuity Of Content", Keyword: "ContinuityOfContent", VR: "CS", VM: "1"},
+	{Tag: 0x0040A057, Name: "Urgency or Priority Alerts (Trial)", Keyword: "UrgencyOrPriorityAlertsTrial", VR: "CS", VM: "1-n", Retired: true},
+	{Tag: 0x0040A060, Name: "Sequencing Indicator (Trial)", Keyword: "SequencingIndicatorTrial", VR: "LO", VM: "1", Retired: true},
+	{Tag: 0x0040A066, Name: "Document Identifier Code Sequence (Trial)", Keyword: "DocumentIdentifierCodeSequenceTrial", VR: "SQ", VM: "1", Retired: true},
+	{Tag: 0x0040A067, Name: "Document Author (Trial)", Keyword: "DocumentAuthorTrial", VR: "PN", VM: "1", Retired: true},
+	{Tag: 0x0040A068, Name: "Document Author Identifier Code Sequence (Trial)", Keyword: "DocumentAuthorIdentifierCodeSequenceTrial", VR: "SQ", VM: "1", Retired: true},
+	{Tag: 0x0040A070, Name: "Identifier Code Sequence (Trial)", Keyword: "IdentifierCodeSequenceTrial", VR: "SQ", VM: "1", Retired: true},
+	{Tag: 0x0040A073, Name: "Verifying Observer Sequence", Keyword: "VerifyingObserverSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x0040A074, Name: "Object Binary Identifier (Trial)", Keyword: "ObjectBinaryIdentifierTrial", VR: "OB", VM: "1", Retired: true},
+	{Tag: 0x0040A075, Name: "Verifying Observer Name", Keyword: "VerifyingObserverName", VR: "PN", VM: "1"},
+	{Tag: 0x0040A076, Name: "Documenting Observer Identifier Code Sequence (Trial)", Keyword: "DocumentingObserverIdentifierCodeSequenceTrial", VR: "SQ", VM: "1", Retired: true},
+	{Tag: 0x0040A078, Name: "Author Observer Sequence", Keyword: "AuthorObserverSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x0040A07A, Name: "Participant Sequence", Keyword: "ParticipantSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x0040A07C, Name: "Custodial Organization Sequence", Keyword: "CustodialOrganizationSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x0040A080, Name: "Participation Type", Keyword: "ParticipationType", VR: "CS", VM: "1"},
+	{Tag: 0x0040A082, Name: "Participation DateTime", Keyword: "ParticipationDateTime", VR: "DT", VM: "1"},
+	{Tag: 0x0040A084, Name: "Observer Type", Keyword: "ObserverType", VR: "CS", VM: "1"},
+	{Tag: 0x0040A085, Name: "Procedure Identifier Code Sequence (Trial)", Keyword: "ProcedureIdentifierCodeSequenceTrial", VR: "SQ", VM: "1", Retired: true},
+	{Tag: 0x0040A088, Name: "Verifying Observer Identification Code Sequence", Keyword: "VerifyingObserverIdentificationCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x0040A089, Name: "Object Directory Binary Identifier (Trial)", Keyword: "ObjectDirectoryBinaryIdentifierTrial", VR: "OB", VM: "1", Retired: true},
+	{Tag: 0x0040A090, Name: "Equivalent CDA Document Sequence", Keyword: "EquivalentCDADocumentSequence", VR: "SQ", VM: "1", Retired: true},
+	{Tag: 0x0040A0B0, Name: "Referenced Waveform Channels", Keyword: "ReferencedWaveformChannels", VR: "US", VM: "2-2n"},
+	{Tag: 0x0040A110, Name: "Date of Document or Verbal Transaction (Trial)", Keyword: "DateOfDocumentOrVerbalTransactionTrial", VR: "DA", VM: "1", Retired: true},
+	{Tag: 0x0040A112, Name: "Time of Document Creation or Verbal Transaction (Trial)", Keyword: "TimeOfDocumentCreationOrVerbalTransactionTrial", VR: "TM", VM: "1", Retired: true},
+	{Tag: 0x0040A120, Name: "DateTime", Keyword: "DateTime", VR: "DT", VM: "1"},
+	{Tag: 0x0040A121, Name: "Date", Keyword: "Date", VR: "DA", VM: "1"},
+	{Tag: 0x0040A122, Name: "Time", Keyword: "Time", VR: "TM", VM: "1"},
+	{Tag: 0x0040A123, Name: "Person Name", Keyword: "PersonName", VR: "PN", VM: "1"},
+	{Tag: 0x0040A124, Name: "UID", Keyword: "UID", VR: "UI", VM: "1"},
+	{Tag: 0x0040A125, Name: "Report Status ID (Trial)", Keyword: "ReportStatusIDTrial", VR: "CS", VM: "2", Retired: true},
+	{Tag: 0x0040A130, Name: "Temporal Range Type", Keyword: "TemporalRangeType", VR: "CS", VM: "1"},
+	{Tag: 0x0040A132, Name: "Referenced Sample Positions", Keyword: "ReferencedSamplePositions", VR: "UL", VM: "1-n"},
+	{Tag: 0x0040A136, Name: "Referenced Frame Numbers", Keyword: "ReferencedFrameNumbers", VR: "US", VM: "1-n", Retired: true},
+	{Tag: 0x0040A138, Name: "Referenced Time Offsets", Keyword: "ReferencedTimeOffsets", VR: "DS", VM: "1-n"},
+	{Tag: 0x0040A13A, Name: "Referenced DateTime", Keyword: "ReferencedDateTime", VR: "DT", VM: "1-n"},
+	{Tag: 0x0040A160, Name: "Text Value", Keyword: "TextValue", VR: "UT", VM: "1"},
+	{Tag: 0x0040A161, Name: "Floating Point Value", Keyword: "FloatingPointValue", VR: "FD", VM: "1-n"},
+	{Tag: 0x0040A162, Name: "Rational Numerator Value", Keyword: "RationalNumeratorValue", VR: "SL", VM: "1-n"},
+	{Tag: 0x0040A163, Name: "Rational Denominator Value", Keyword: "RationalDenominatorValue", VR: "UL", VM: "1-n"},
+	{Tag: 0x0040A167, Name: "Observation Category Code Sequence (Trial)", Keyword: "ObservationCategoryCodeSequenceTrial", VR: "SQ", VM: "1", Retired: true},
+	{Tag: 0x0040A168, Name: "Concept Code Sequence", Keyword: "ConceptCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x0040A16A, Name: "Bibliographic Citation (Trial)", Keyword: "BibliographicCitationTrial", VR: "ST", VM: "1", Retired: true},
+	{Tag: 0x0040A170, Name: "Purpose of Reference Code Sequence", Keyword: "PurposeOfReferenceCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x0040A171, Name: "Observation UID", Keyword: "ObservationUID", VR: "UI", VM: "1"},
+	{Tag: 0x0040A172, Name: "Referenced Observation UID (Trial)", Keyword: "ReferencedObservationUIDTrial", VR: "UI", VM: "1", Retired: true},
+	{Tag: 0x0040A173, Name: "Referenced Observation Class (Trial)", Keyword: "ReferencedObservationClassTrial", VR: "CS", VM: "1", Retired: true},
+	{Tag: 0x0040A174, Name: "Referenced Object Observation Class (Trial)", Keyword: "ReferencedObjectObservationClassTrial", VR: "CS", VM: "1", Retired: true},
+	{Tag: 0x0040A180, Name: "Annotation Group Number", Keyword: "AnnotationGroupNumber", VR: "US", VM: "1"},
+	{Tag: 0x0040A192, Name: "Observation Date (Trial)", Keyword: "ObservationDateTrial", VR: "DA", VM: "1", Retired: true},
+	{Tag: 0x0040A193, Name: "Observation Time (Trial)", Keyword: "ObservationTimeTrial", VR: "TM", VM: "1", Retired: true},
+	{Tag: 0x0040A194, Name: "Measurement Automation (Trial)", Keyword: "MeasurementAutomationTrial", VR: "CS", VM: "1", Retired: true},
+	{Tag: 0x0040A195, Name: "Modifier Code Sequence", Keyword: "ModifierCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x0040A224, Name: "Identification Description (Trial)", Keyword: "IdentificationDescriptionTrial", VR: "ST", VM: "1", Retired: true},
+	{Tag: 0x0040A290, Name: "Coordinates Set Geometric Type (Trial)", Keyword: "CoordinatesSetGeometricTypeTrial", VR: "CS", VM: "1", Retired: true},
+	{Tag: 0x0040A296, Name: "Algorithm Code Sequence (Trial)", Keyword: "AlgorithmCodeSequenceTrial", VR: "SQ", VM: "1", Retired: true},
+	{Tag: 0x0040A297, Name: "Algorithm Description (Trial)", Keyword: "AlgorithmDescriptionTrial", VR: "ST", VM: "1", Retired: true},
+	{Tag: 0x0040A29A, Name: "Pixel Coordinates Set (Trial)", Keyword: "PixelCoordinatesSetTrial", VR: "SL", VM: "2-2n", Retired: true},
+	{Tag: 0x0040A300, Name: "Measured Value Sequence", Keyword: "MeasuredValueSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x0040A301, Name: "Numeric Value Qualifier Code Sequence", Keyword: "NumericValueQualifierCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x0040A307, Name: "Current Observer (Trial)", Keyword: "CurrentObserverTrial", VR: "PN", VM: "1", Retired: true},
+	{Tag: 0x0040A30A, Name: "Numeric Value", Keyword: "NumericValue", VR: "DS", VM: "1-n"},
+	{Tag: 0x0040A313, Name: "Referenced Accession Sequence (Trial)", Keyword: "ReferencedAccessionSequenceTrial", VR: "SQ", VM: "1", Retired: true},
+	{Tag: 0x0040A33A, Name: "Report Status Comment (Trial)", Keyword: "ReportStatusCommentTrial", VR: "ST", VM: "1", Retired: true},
+	{Tag: 0x0040A340, Name: "Procedure Context Sequence (Trial)", Keyword: "ProcedureContextSequenceTrial", VR: "SQ", VM: "1", Retired: true},
+	{Tag: 0x0040A352, Name: "Verbal Source (Trial)", Keyword: "VerbalSourceTrial", VR: "PN", VM: "1", Retired: true},
+	{Tag: 0x0040A353, Name: "Address (Trial)", Keyword: "AddressTrial", VR: "ST", VM: "1", Retired: true},
+	{Tag: 0x0040A354, Name: "Telephone Number (Trial)", Keyword: "TelephoneNumberTrial", VR: "LO", VM: "1", Retired: true},
+	{Tag: 0x0040A358, Name: "Verbal Source Identifier Code Sequence (Trial)", Keyword: "VerbalSourceIdentifierCodeSequenceTrial", VR: "SQ", VM: "1", Retired: true},
+	{Tag: 0x0040A360, Name: "Predecessor Documents Sequence", Keyword: "PredecessorDocumentsSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x0040A370, Name: "Referenced Request Sequence", Keyword: "ReferencedRequestSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x0040A372, Name: "Performed Procedure Code Sequence", Keyword: "PerformedProcedureCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x0040A375, Name: "Current Requested Procedure Evidence Sequence", Keyword: "CurrentRequestedProcedureEvidenceSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x0040A380, Name: "Report Detail Sequence (Trial)", Keyword: "ReportDetailSequenceTrial", VR: "SQ", VM: "1", Retired: true},
+	{Tag: 0x0040A385, Name: "Pertinent Other Evidence Sequence", Keyword: "PertinentOtherEvidenceSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x0040A390, Name: "HL7 Structured Document Reference Sequence", Keyword: "HL7StructuredDocumentReferenceSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x0040A402, Name: "Observation Subject UID (Trial)", Keyword: "ObservationSubjectUIDTrial", VR: "UI", VM: "1", Retired: true},
+	{Tag: 0x0040A403, Name: "Observation Subject Class (Trial)", Keyword: "ObservationSubjectClassTrial", VR: "CS", VM: "1", Retired: true},
+	{Tag: 0x0040A404, Name: "Observation Subject Type Code Sequence (Trial)", Keyword: "ObservationSubjectTypeCodeSequenceTrial", VR: "SQ", VM: "1", Retired: true},
+	{Tag: 0x0040A491, Name: "Completion Flag", Keyword: "CompletionFlag", VR: "CS", VM: "1"},
+	{Tag: 0x0040A492, Name: "Completion Flag Description", Keyword: "CompletionFlagDescription", VR: "LO", VM: "1"},
+	{Tag: 0x0040A493, Name: "Verification Flag", Keyword: "VerificationFlag", VR: "CS", VM: "1"},
+	{Tag: 0x0040A494, Name: "Archive Requested", Keyword: "ArchiveRequested", VR: "CS", VM: "1"},
+	{Tag: 0x0040A496, Name: "Preliminary Flag", Keyword: "PreliminaryFlag", VR: "CS", VM: "1"},
+	{Tag: 0x0040A504, Name: "Content Template Sequence", Keyword: "ContentTemplateSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x0040A525, Name: "Identical Documents Sequence", Keyword: "IdenticalDocumentsSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x0040A600, Name: "Observation Subject Context Flag (Trial)", Keyword: "ObservationSubjectContextFlagTrial", VR: "CS", VM: "1", Retired: true},
+	{Tag: 0x0040A601, Name: "Observer Context Flag (Trial)", Keyword: "ObserverContextFlagTrial", VR: "CS", VM: "1", Retired: true},
+	{Tag: 0x0040A603, Name: "Procedure Context Flag (Trial)", Keyword: "ProcedureContextFlagTrial", VR: "CS", VM: "1", Retired: true},
+	{Tag: 0x0040A730, Name: "Content Sequence", Keyword: "ContentSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x0040A731, Name: "Relationship Sequence (Trial)", Keyword: "RelationshipSequenceTrial", VR: "SQ", VM: "1", Retired: true},
+	{Tag: 0x0040A732, Name: "Relationship Type Code Sequence (Trial)", Keyword: "RelationshipTypeCodeSequenceTrial", VR: "SQ", VM: "1", Retired: true},
+	{Tag: 0x0040A744, Name: "Language Code Sequence (Trial)", Keyword: "LanguageCodeSequenceTrial", VR: "SQ", VM: "1", Retired: true},
+	{Tag: 0x0040A801, Name: "Tabulated Values Sequence", Keyword: "TabulatedValuesSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x0040A802, Name: "Number of Table Rows", Keyword: "NumberOfTableRows", VR: "UL", VM: "1"},
+	{Tag: 0x0040A803, Name: "Number of Table Columns", Keyword: "NumberOfTableColumns", VR: "UL", VM: "1"},
+	{Tag: 0x0040A804, Name: "Table Row Number", Keyword: "TableRowNumber", VR: "UL", VM: "1"},
+	{Tag: 0x0040A805, Name: "Table Column Number", Keyword: "TableColumnNumber", VR: "UL", VM: "1"},
+	{Tag: 0x0040A806, Name: "Table Row Definition Sequence", Keyword: "TableRowDefinitionSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x0040A807, Name: "Table Column Definition Sequence", Keyword: "TableColumnDefinitionSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x0040A808, Name: "Cell Values Sequence", Keyword: "CellValuesSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x0040A992, Name: "Uniform Resource Locator (Trial)", Keyword: "UniformResourceLocatorTrial", VR: "ST", VM: "1", Retired: true},
+	{Tag: 0x0040B020, Name: "Waveform Annotation Sequence", Keyword: "WaveformAnnotationSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x0040DB00, Name: "Template Identifier", Keyword: "TemplateIdentifier", VR: "CS", VM: "1"},
+	{Tag: 0x0040DB06, Name: "Template Version", Keyword: "TemplateVersion", VR: "DT", VM: "1", Retired: true},
+	{Tag: 0x0040DB07, Name: "Template Local Version", Keyword: "TemplateLocalVersion", VR: "DT", VM: "1", Retired: true},
+	{Tag: 0x0040DB0B, Name: "Template Extension Flag", Keyword: "TemplateExtensionFlag", VR: "CS", VM: "1", Retired: true},
+	{Tag: 0x0040DB0C, Name: "Template Extension Organization UID", Keyword: "TemplateExtensionOrganizationUID", VR: "UI", VM: "1", Retired: true},
+	{Tag: 0x0040DB0D, Name: "Template Extension Creator UID", Keyword: "TemplateExtensionCreatorUID", VR: "UI", VM: "1", Retired: true},
+	{Tag: 0x0040DB73, Name: "Referenced Content Item Identifier", Keyword: "ReferencedContentItemIdentifier", VR: "UL", VM: "1-n"},
+	{Tag: 0x0040E001, Name: "HL7 Instance Identifier", Keyword: "HL7InstanceIdentifier", VR: "ST", VM: "1"},
+	{Tag: 0x0040E004, Name: "HL7 Document Effective Time", Keyword: "HL7DocumentEffectiveTime", VR: "DT", VM: "1"},
+	{Tag: 0x0040E006, Name: "HL7 Document Type Code Sequence", Keyword: "HL7DocumentTypeCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x0040E008, Name: "Document Class Code Sequence", Keyword: "DocumentClassCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x0040E010, Name: "Retrieve URI", Keyword: "RetrieveURI", VR: "UR", VM: "1"},
+	{Tag: 0x0040E011, Name: "Retrieve Location UID", Keyword: "RetrieveLocationUID", VR: "UI", VM: "1"},
+	{Tag: 0x0040E020, Name: "Type of Instances", Keyword: "TypeOfInstances", VR: "CS", VM: "1"},
+	{Tag: 0x0040E021, Name: "DICOM Retrieval Sequence", Keyword: "DICOMRetrievalSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x0040E022, Name: "DICOM Media Retrieval Sequence", Keyword: "DICOMMediaRetrievalSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x0040E023, Name: "WADO Retrieval Sequence", Keyword: "WADORetrievalSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x0040E024, Name: "XDS Retrieval Sequence", Keyword: "XDSRetrievalSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x0040E025, Name: "WADO-RS Retrieval Sequence", Keyword: "WADORSRetrievalSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x0040E030, Name: "Repository Unique ID", Keyword: "RepositoryUniqueID", VR: "UI", VM: "1"},
+	{Tag: 0x0040E031, Name: "Home Community ID", Keyword: "HomeCommunityID", VR: "UI", VM: "1"},
+	{Tag: 0x00420010, Name: "Document Title", Keyword: "DocumentTitle", VR: "ST", VM: "1"},
+	{Tag: 0x00420011, Name: "Encapsulated Document", Keyword: "EncapsulatedDocument", VR: "OB", VM: "1"},
+	{Tag: 0x00420012, Name: "MIME Type of Encapsulated Document", Keyword: "MIMETypeOfEncapsulatedDocument", VR: "LO", VM: "1"},
+	{Tag: 0x00420013, Name: "Source Instance Sequence", Keyword: "SourceInstanceSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00420014, Name: "List of MIME Types", Keyword: "ListOfMIMETypes", VR: "LO", VM: "1-n"},
+	{Tag: 0x00420015, Name: "Encapsulated Document Length", Keyword: "EncapsulatedDocumentLength", VR: "UL", VM: "1"},
+	{Tag: 0x00440001, Name: "Product Package Identifier", Keyword: "ProductPackageIdentifier", VR: "ST", VM: "1"},
+	{Tag: 0x00440002, Name: "Substance Administration Approval", Keyword: "SubstanceAdministrationApproval", VR: "CS", VM: "1"},
+	{Tag: 0x00440003, Name: "Approval Status Further Description", Keyword: "ApprovalStatusFurtherDescription", VR: "LT", VM: "1"},
+	{Tag: 0x00440004, Name: "Approval Status DateTime", Keyword: "ApprovalStatusDateTime", VR: "DT", VM: "1"},
+	{Tag: 0x00440007, Name: "Product Type Code Sequence", Keyword: "ProductTypeCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00440008, Name: "Product Name", Keyword: "ProductName", VR: "LO", VM: "1-n"},
+	{Tag: 0x00440009, Name: "Product Description", Keyword: "ProductDescription", VR: "LT", VM: "1"},
+	{Tag: 0x0044000A, Name: "Product Lot Identifier", Keyword: "ProductLotIdentifier", VR: "LO", VM: "1"},
+	{Tag: 0x0044000B, Name: "Product Expiration DateTime", Keyword: "ProductExpirationDateTime", VR: "DT", VM: "1"},
+	{Tag: 0x00440010, Name: "Substance Administration DateTime", Keyword: "SubstanceAdministrationDateTime", VR: "DT", VM: "1"},
+	{Tag: 0x00440011, Name: "Substance Administration Notes", Keyword: "SubstanceAdministrationNotes", VR: "LO", VM: "1"},
+	{Tag: 0x00440012, Name: "Substance Administration Device ID", Keyword: "SubstanceAdministrationDeviceID", VR: "LO", VM: "1"},
+	{Tag: 0x00440013, Name: "Product Parameter Sequence", Keyword: "ProductParameterSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00440019, Name: "Substance Administration Parameter Sequence", Keyword: "SubstanceAdministrationParameterSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00440100, Name: "Approval Sequence", Keyword: "ApprovalSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00440101, Name: "Assertion Code Sequence", Keyword: "AssertionCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00440102, Name: "Assertion UID", Keyword: "AssertionUID", VR: "UI", VM: "1"},
+	{Tag: 0x00440103, Name: "Asserter Identification Sequence", Keyword: "AsserterIdentificationSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00440104, Name: "Assertion DateTime", Keyword: "AssertionDateTime", VR: "DT", VM: "1"},
+	{Tag: 0x00440105, Name: "Assertion Expiration DateTime", Keyword: "AssertionExpirationDateTime", VR: "DT", VM: "1"},
+	{Tag: 0x00440106, Name: "Assertion Comments", Keyword: "AssertionComments", VR: "UT", VM: "1"},
+	{Tag: 0x00440107, Name: "Related Assertion Sequence", Keyword: "RelatedAssertionSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00440108, Name: "Referenced Assertion UID", Keyword: "ReferencedAssertionUID", VR: "UI", VM: "1"},
+	{Tag: 0x00440109, Name: "Approval Subject Sequence", Keyword: "ApprovalSubjectSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x0044010A, Name: "Organizational Role Code Sequence", Keyword: "OrganizationalRoleCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00460012, Name: "Lens Description", Keyword: "LensDescription", VR: "LO", VM: "1"},
+	{Tag: 0x00460014, Name: "Right Lens Sequence", Keyword: "RightLensSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00460015, Name: "Left Lens Sequence", Keyword: "LeftLensSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00460016, Name: "Unspecified Laterality Lens Sequence", Keyword: "UnspecifiedLateralityLensSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00460018, Name: "Cylinder Sequence", Keyword: "CylinderSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00460028, Name: "Prism Sequence", Keyword: "PrismSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00460030, Name: "Horizontal Prism Power", Keyword: "HorizontalPrismPower", VR: "FD", VM: "1"},
+	{Tag: 0x00460032, Name: "Horizontal Prism Base", Keyword: "HorizontalPrismBase", VR: "CS", VM: "1"},
+	{Tag: 0x00460034, Name: "Vertical Prism Power", Keyword: "VerticalPrismPower", VR: "FD", VM: "1"},
+	{Tag: 0x00460036, Name: "Vertical Prism Base", Keyword: "VerticalPrismBase", VR: "CS", VM: "1"},
+	{Tag: 0x00460038, Name: "Lens Segment Type", Keyword: "LensSegmentType", VR: "CS", VM: "1"},
+	{Tag: 0x00460040, Name: "Optical Transmittance", Keyword: "OpticalTransmittance", VR: "FD", VM: "1"},
+	{Tag: 0x00460042, Name: "Channel Width", Keyword: "ChannelWidth", VR: "FD", VM: "1"},
+	{Tag: 0x00460044, Name: "Pupil Size", Keyword: "PupilSize", VR: "FD", VM: "1"},
+	{Tag: 0x00460046, Name: "Corneal Size", Keyword: "CornealSize", VR: "FD", VM: "1"},
+	{Tag: 0x00460047, Name: "Corneal Size Sequence", Keyword: "CornealSizeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00460050, Name: "Autorefraction Right Eye Sequence", Keyword: "AutorefractionRightEyeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00460052, Name: "Autorefraction Left Eye Sequence", Keyword: "AutorefractionLeftEyeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00460060, Name: "Distance Pupillary Distance", Keyword: "DistancePupillaryDistance", VR: "FD", VM: "1"},
+	{Tag: 0x00460062, Name: "Near Pupillary Distance", Keyword: "NearPupillaryDistance", VR: "FD", VM: "1"},
+	{Tag: 0x00460063, Name: "Intermediate Pupillary Distance", Keyword: "IntermediatePupillaryDistance", VR: "FD", VM: "1"},
+	{Tag: 0x00460064, Name: "Other Pupillary Distance", Keyword: "OtherPupillaryDistance", VR: "FD", VM: "1"},
+	{Tag: 0x00460070, Name: "Keratometry Right Eye Sequence", Keyword: "KeratometryRightEyeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00460071, Name: "Keratometry Left Eye Sequence", Keyword: "KeratometryLeftEyeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00460074, Name: "Steep Keratometric Axis Sequence", Keyword: "SteepKeratometricAxisSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00460075, Name: "Radius of Curvature", Keyword: "RadiusOfCurvature", VR: "FD", VM: "1"},
+	{Tag: 0x00460076, Name: "Keratometric Power", Keyword: "KeratometricPower", VR: "FD", VM: "1"},
+	{Tag: 0x00460077, Name: "Keratometric Axis", Keyword: "KeratometricAxis", VR: "FD", VM: "1"},
+	{Tag: 0x00460080, Name: "Flat Keratometric Axis Sequence", Keyword: "FlatKeratometricAxisSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00460092, Name: "Background Color", Keyword: "BackgroundColor", VR: "CS", VM: "1"},
+	{Tag: 0x00460094, Name: "Optotype", Keyword: "Optotype", VR: "CS", VM: "1"},
+	{Tag: 0x00460095, Name: "Optotype Presentation", Keyword: "OptotypePresentation", VR: "CS", VM: "1"},
+	{Tag: 0x00460097, Name: "Subjective Refraction Right Eye Sequence", Keyword: "SubjectiveRefractionRightEyeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00460098, Name: "Subjective Refraction Left Eye Sequence", Keyword: "SubjectiveRefractionLeftEyeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00460100, Name: "Add Near Sequence", Keyword: "AddNearSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00460101, Name: "Add Intermediate Sequence", Keyword: "AddIntermediateSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00460102, Name: "Add Other Sequence", Keyword: "AddOtherSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00460104, Name: "Add Power", Keyword: "AddPower", VR: "FD", VM: "1"},
+	{Tag: 0x00460106, Name: "Viewing Distance", Keyword: "ViewingDistance", VR: "FD", VM: "1"},
+	{Tag: 0x00460110, Name: "Cornea Measurements Sequence", Keyword: "CorneaMeasurementsSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00460111, Name: "Source of Cornea Measurement Data Code Sequence", Keyword: "SourceOfCorneaMeasurementDataCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00460112, Name: "Steep Corneal Axis Sequence", Keyword: "SteepCornealAxisSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00460113, Name: "Flat Corneal Axis Sequence", Keyword: "FlatCornealAxisSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00460114, Name: "Corneal Power", Keyword: "CornealPower", VR: "FD", VM: "1"},
+	{Tag: 0x00460115, Name: "Corneal Axis", Keyword: "CornealAxis", VR: "FD", VM: "1"},
+	{Tag: 0x00460116, Name: "Cornea Measurement Method Code Sequence", Keyword: "CorneaMeasurementMethodCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00460117, Name: "Refractive Index of Cornea", Keyword: "RefractiveIndexOfCornea", VR: "FL", VM: "1"},
+	{Tag: 0x00460118, Name: "Refractive Index of Aqueous Humor", Keyword: "RefractiveIndexOfAqueousHumor", VR: "FL", VM: "1"},
+	{Tag: 0x00460121, Name: "Visual Acuity Type Code Sequence", Keyword: "VisualAcuityTypeCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00460122, Name: "Visual Acuity Right Eye Sequence", Keyword: "VisualAcuityRightEyeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00460123, Name: "Visual Acuity Left Eye Sequence", Keyword: "VisualAcuityLeftEyeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00460124, Name: "Visual Acuity Both Eyes Open Sequence", Keyword: "VisualAcuityBothEyesOpenSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00460125, Name: "Viewing Distance Type", Keyword: "ViewingDistanceType", VR: "CS", VM: "1"},
+	{Tag: 0x00460135, Name: "Visual Acuity Modifiers", Keyword: "VisualAcuityModifiers", VR: "SS", VM: "2"},
+	{Tag: 0x00460137, Name: "Decimal Visual Acuity", Keyword: "DecimalVisualAcuity", VR: "FD", VM: "1"},
+	{Tag: 0x00460139, Name: "Optotype Detailed Definition", Keyword: "OptotypeDetailedDefinition", VR: "LO", VM: "1"},
+	{Tag: 0x00460145, Name: "Referenced Refractive Measurements Sequence", Keyword: "ReferencedRefractiveMeasurementsSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00460146, Name: "Sphere Power", Keyword: "SpherePower", VR: "FD", VM: "1"},
+	{Tag: 0x00460147, Name: "Cylinder Power", Keyword: "CylinderPower", VR: "FD", VM: "1"},
+	{Tag: 0x00460201, Name: "Corneal Topography Surface", Keyword: "CornealTopographySurface", VR: "CS", VM: "1"},
+	{Tag: 0x00460202, Name: "Corneal Vertex Location", Keyword: "CornealVertexLocation", VR: "FL", VM: "2"},
+	{Tag: 0x00460203, Name: "Pupil Centroid X-Coordinate", Keyword: "PupilCentroidXCoordinate", VR: "FL", VM: "1"},
+	{Tag: 0x00460204, Name: "Pupil Centroid Y-Coordinate", Keyword: "PupilCentroidYCoordinate", VR: "FL", VM: "1"},
+	{Tag: 0x00460205, Name: "Equivalent Pupil Radius", Keyword: "EquivalentPupilRadius", VR: "FL", VM: "1"},
+	{Tag: 0x00460207, Name: "Corneal Topography Map Type Code Sequence", Keyword: "CornealTopographyMapTypeCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00460208, Name: "Vertices of the Outline of Pupil", Keyword: "VerticesOfTheOutlineOfPupil", VR: "IS", VM: "2-2n"},
+	{Tag: 0x00460210, Name: "Corneal Topography Mapping Normals Sequence", Keyword: "CornealTopographyMappingNormalsSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00460211, Name: "Maximum Corneal Curvature Sequence", Keyword: "MaximumCornealCurvatureSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00460212, Name: "Maximum Corneal Curvature", Keyword: "MaximumCornealCurvature", VR: "FL", VM: "1"},
+	{Tag: 0x00460213, Name: "Maximum Corneal Curvature Location", Keyword: "MaximumCornealCurvatureLocation", VR: "FL", VM: "2"},
+	{Tag: 0x00460215, Name: "Minimum Keratometric Sequence", Keyword: "MinimumKeratometricSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00460218, Name: "Simulated Keratometric Cylinder Sequence", Keyword: "SimulatedKeratometricCylinderSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00460220, Name: "Average Corneal Power", Keyword: "AverageCornealPower", VR: "FL", VM: "1"},
+	{Tag: 0x00460224, Name: "Corneal I-S Value", Keyword: "CornealISValue", VR: "FL", VM: "1"},
+	{Tag: 0x00460227, Name: "Analyzed Area", Keyword: "AnalyzedArea", VR: "FL", VM: "1"},
+	{Tag: 0x00460230, Name: "Surface Regularity Index", Keyword: "SurfaceRegularityIndex", VR: "FL", VM: "1"},
+	{Tag: 0x00460232, Name: "Surface Asymmetry Index", Keyword: "SurfaceAsymmetryIndex", VR: "FL", VM: "1"},
+	{Tag: 0x00460234, Name: "Corneal Eccentricity Index", Keyword: "CornealEccentricityIndex", VR: "FL", VM: "1"},
+	{Tag: 0x00460236, Name: "Keratoconus Prediction Index", Keyword: "KeratoconusPredictionIndex", VR: "FL", VM: "1"},
+	{Tag: 0x00460238, Name: "Decimal Potential Visual Acuity", Keyword: "DecimalPotentialVisualAcuity", VR: "FL", VM: "1"},
+	{Tag: 0x00460242, Name: "Corneal Topography Map Quality Evaluation", Keyword: "CornealTopographyMapQualityEvaluation", VR: "CS", VM: "1"},
+	{Tag: 0x00460244, Name: "Source Image Corneal Processed Data Sequence", Keyword: "SourceImageCornealProcessedDataSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00460247, Name: "Corneal Point Location", Keyword: "CornealPointLocation", VR: "FL", VM: "3"},
+	{Tag: 0x00460248, Name: "Corneal Point Estimated", Keyword: "CornealPointEstimated", VR: "CS", VM: "1"},
+	{Tag: 0x00460249, Name: "Axial Power", Keyword: "AxialPower", VR: "FL", VM: "1"},
+	{Tag: 0x00460250, Name: "Tangential Power", Keyword: "TangentialPower", VR: "FL", VM: "1"},
+	{Tag: 0x00460251, Name: "Refractive Power", Keyword: "RefractivePower", VR: "FL", VM: "1"},
+	{Tag: 0x00460252, Name: "Relative Elevation", Keyword: "RelativeElevation", VR: "FL", VM: "1"},
+	{Tag: 0x00460253, Name: "Corneal Wavefront", Keyword: "CornealWavefront", VR: "FL", VM: "1"},
+	{Tag: 0x00480001, Name: "Imaged Volume Width", Keyword: "ImagedVolumeWidth", VR: "FL", VM: "1"},
+	{Tag: 0x00480002, Name: "Imaged Volume Height", Keyword: "ImagedVolumeHeight", VR: "FL", VM: "1"},
+	{Tag: 0x00480003, Name: "Imaged Volume Depth", Keyword: "ImagedVolumeDepth", VR: "FL", VM: "1"},
+	{Tag: 0x00480006, Name: "Total Pixel Matrix Columns", Keyword: "TotalPixelMatrixColumns", VR: "UL", VM: "1"},
+	{Tag: 0x00480007, Name: "Total Pixel Matrix Rows", Keyword: "TotalPixelMatrixRows", VR: "UL", VM: "1"},
+	{Tag: 0x00480008, Name: "Total Pixel Matrix Origin Sequence", Keyword: "TotalPixelMatrixOriginSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00480010, Name: "Specimen Label in Image", Keyword: "SpecimenLabelInImage", VR: "CS", VM: "1"},
+	{Tag: 0x00480011, Name: "Focus Method", Keyword: "FocusMethod", VR: "CS", VM: "1"},
+	{Tag: 0x00480012, Name: "Extended Depth of Field", Keyword: "ExtendedDepthOfField", VR: "CS", VM: "1"},
+	{Tag: 0x00480013, Name: "Number of Focal Planes", Keyword: "NumberOfFocalPlanes", VR: "US", VM: "1"},
+	{Tag: 0x00480014, Name: "Distance Between Focal Planes", Keyword: "DistanceBetweenFocalPlanes", VR: "FL", VM: "1"},
+	{Tag: 0x00480015, Name: "Recommended Absent Pixel CIELab Value", Keyword: "RecommendedAbsentPixelCIELabValue", VR: "US", VM: "3"},
+	{Tag: 0x00480100, Name: "Illuminator Type Code Sequence", Keyword: "IlluminatorTypeCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00480102, Name: "Image Orientation (Slide)", Keyword: "ImageOrientationSlide", VR: "DS", VM: "6"},
+	{Tag: 0x00480105, Name: "Optical Path Sequence", Keyword: "OpticalPathSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00480106, Name: "Optical Path Identifier", Keyword: "OpticalPathIdentifier", VR: "SH", VM: "1"},
+	{Tag: 0x00480107, Name: "Optical Path Description", Keyword: "OpticalPathDescription", VR: "ST", VM: "1"},
+	{Tag: 0x00480108, Name: "Illumination Color Code Sequence", Keyword: "IlluminationColorCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00480110, Name: "Specimen Reference Sequence", Keyword: "SpecimenReferenceSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00480111, Name: "Condenser Lens Power", Keyword: "CondenserLensPower", VR: "DS", VM: "1"},
+	{Tag: 0x00480112, Name: "Objective Lens Power", Keyword: "ObjectiveLensPower", VR: "DS", VM: "1"},
+	{Tag: 0x00480113, Name: "Objective Lens Numerical Aperture", Keyword: "ObjectiveLensNumericalAperture", VR: "DS", VM: "1"},
+	{Tag: 0x00480114, Name: "Confocal Mode", Keyword: "ConfocalMode", VR: "CS", VM: "1"},
+	{Tag: 0x00480115, Name: "Tissue Location", Keyword: "TissueLocation", VR: "CS", VM: "1"},
+	{Tag: 0x00480116, Name: "Confocal Microscopy Image Frame Type Sequence", Keyword: "ConfocalMicroscopyImageFrameTypeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00480117, Name: "Image Acquisition Depth", Keyword: "ImageAcquisitionDepth", VR: "FD", VM: "1"},
+	{Tag: 0x00480120, Name: "Palette Color Lookup Table Sequence", Keyword: "PaletteColorLookupTableSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00480200, Name: "Referenced Image Navigation Sequence", Keyword: "ReferencedImageNavigationSequence", VR: "SQ", VM: "1", Retired: true},
+	{Tag: 0x00480201, Name: "Top Left Hand Corner of Localizer Area", Keyword: "TopLeftHandCornerOfLocalizerArea", VR: "US", VM: "2", Retired: true},
+	{Tag: 0x00480202, Name: "Bottom Right Hand Corner of Localizer Area", Keyword: "BottomRightHandCornerOfLocalizerArea", VR: "US", VM: "2", Retired: true},
+	{Tag: 0x00480207, Name: "Optical Path Identification Sequence", Keyword: "OpticalPathIdentificationSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x0048021A, Name: "Plane Position (Slide) Sequence", Keyword: "PlanePositionSlideSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x0048021E, Name: "Column Position In Total Image Pixel Matrix", Keyword: "ColumnPositionInTotalImagePixelMatrix", VR: "SL", VM: "1"},
+	{Tag: 0x0048021F, Name: "Row Position In Total Image Pixel Matrix", Keyword: "RowPositionInTotalImagePixelMatrix", VR: "SL", VM: "1"},
+	{Tag: 0x00480301, Name: "Pixel Origin Interpretation", Keyword: "PixelOriginInterpretation", VR: "CS", VM: "1"},
+	{Tag: 0x00480302, Name: "Number of Optical Paths", Keyword: "NumberOfOpticalPaths", VR: "UL", VM: "1"},
+	{Tag: 0x00480303, Name: "Total Pixel Matrix Focal Planes", Keyword: "TotalPixelMatrixFocalPlanes", VR: "UL", VM: "1"},
+	{Tag: 0x00500004, Name: "Calibration Image", Keyword: "CalibrationImage", VR: "CS", VM: "1"},
+	{Tag: 0x00500010, Name: "Device Sequence", Keyword: "DeviceSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00500012, Name: "Container Component Type Code Sequence", Keyword: "ContainerComponentTypeCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00500013, Name: "Container Component Thickness", Keyword: "ContainerComponentThickness", VR: "FD", VM: "1"},
+	{Tag: 0x00500014, Name: "Device Length", Keyword: "DeviceLength", VR: "DS", VM: "1"},
+	{Tag: 0x00500015, Name: "Container Component Width", Keyword: "ContainerComponentWidth", VR: "FD", VM: "1"},
+	{Tag: 0x00500016, Name: "Device Diameter", Keyword: "DeviceDiameter", VR: "DS", VM: "1"},
+	{Tag: 0x00500017, Name: "Device Diameter Units", Keyword: "DeviceDiameterUnits", VR: "CS", VM: "1"},
+	{Tag: 0x00500018, Name: "Device Volume", Keyword: "DeviceVolume", VR: "DS", VM: "1"},
+	{Tag: 0x00500019, Name: "Inter-Marker Distance", Keyword: "InterMarkerDistance", VR: "DS", VM: "1"},
+	{Tag: 0x0050001A, Name: "Container Component Material", Keyword: "ContainerComponentMaterial", VR: "CS", VM: "1"},
+	{Tag: 0x0050001B, Name: "Container Component ID", Keyword: "ContainerComponentID", VR: "LO", VM: "1"},
+	{Tag: 0x0050001C, Name: "Container Component Length", Keyword: "ContainerComponentLength", VR: "FD", VM: "1"},
+	{Tag: 0x0050001D, Name: "Container Component Diameter", Keyword: "ContainerComponentDiameter", VR: "FD", VM: "1"},
+	{Tag: 0x0050001E, Name: "Container Component Description", Keyword: "ContainerComponentDescription", VR: "LO", VM: "1"},
+	{Tag: 0x00500020, Name: "Device Description", Keyword: "DeviceDescription", VR: "LO", VM: "1"},
+	{Tag: 0x00500021, Name: "Long Device Description", Keyword: "LongDeviceDescription", VR: "ST", VM: "1"},
+	{Tag: 0x00520001, Name: "Contrast/Bolus Ingredient Percent by Volume", Keyword: "ContrastBolusIngredientPercentByVolume", VR: "FL", VM: "1"},
+	{Tag: 0x00520002, Name: "OCT Focal Distance", Keyword: "OCTFocalDistance", VR: "FD", VM: "1"},
+	{Tag: 0x00520003, Name: "Beam Spot Size", Keyword: "BeamSpotSize", VR: "FD", VM: "1"},
+	{Tag: 0x00520004, Name: "Effective Refractive Index", Keyword: "EffectiveRefractiveIndex", VR: "FD", VM: "1"},
+	{Tag: 0x00520006, Name: "OCT Acquisition Domain", Keyword: "OCTAcquisitionDomain", VR: "CS", VM: "1"},
+	{Tag: 0x00520007, Name: "OCT Optical Center Wavelength", Keyword: "OCTOpticalCenterWavelength", VR: "FD", VM: "1"},
+	{Tag: 0x00520008, Name: "Axial Resolution", Keyword: "AxialResolution", VR: "FD", VM: "1"},
+	{Tag: 0x00520009, Name: "Ranging Depth", Keyword: "RangingDepth", VR: "FD", VM: "1"},
+	{Tag: 0x00520011, Name: "A-line Rate", Keyword: "ALineRate", VR: "FD", VM: "1"},
+	{Tag: 0x00520012, Name: "A-lines Per Frame", Keyword: "ALinesPerFrame", VR: "US", VM: "1"},
+	{Tag: 0x00520013, Name: "Catheter Rotational Rate", Keyword: "CatheterRotationalRate", VR: "FD", VM: "1"},
+	{Tag: 0x00520014, Name: "A-line Pixel Spacing", Keyword: "ALinePixelSpacing", VR: "FD", VM: "1"},
+	{Tag: 0x00520016, Name: "Mode of Percutaneous Access Sequence", Keyword: "ModeOfPercutaneousAccessSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00520025, Name: "Intravascular OCT Frame Type Sequence", Keyword: "IntravascularOCTFrameTypeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00520026, Name: "OCT Z Offset Applied", Keyword: "OCTZOffsetApplied", VR: "CS", VM: "1"},
+	{Tag: 0x00520027, Name: "Intravascular Frame Content Sequence", Keyword: "IntravascularFrameContentSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00520028, Name: "Intravascular Longitudinal Distance", Keyword: "IntravascularLongitudinalDistance", VR: "FD", VM: "1"},
+	{Tag: 0x00520029, Name: "Intravascular OCT Frame Content Sequence", Keyword: "IntravascularOCTFrameContentSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00520030, Name: "OCT Z Offset Correction", Keyword: "OCTZOffsetCorrection", VR: "SS", VM: "1"},
+	{Tag: 0x00520031, Name: "Catheter Direction of Rotation", Keyword: "CatheterDirectionOfRotation", VR: "CS", VM: "1"},
+	{Tag: 0x00520033, Name: "Seam Line Location", Keyword: "SeamLineLocation", VR: "FD", VM: "1"},
+	{Tag: 0x00520034, Name: "First A-line Location", Keyword: "FirstALineLocation", VR: "FD", VM: "1"},
+	{Tag: 0x00520036, Name: "Seam Line Index", Keyword: "SeamLineIndex", VR: "US", VM: "1"},
+	{Tag: 0x00520038, Name: "Number of Padded A-lines", Keyword: "NumberOfPaddedALines", VR: "US", VM: "1"},
+	{Tag: 0x00520039, Name: "Interpolation Type", Keyword: "InterpolationType", VR: "CS", VM: "1"},
+	{Tag: 0x0052003A, Name: "Refractive Index Applied", Keyword: "RefractiveIndexApplied", VR: "CS", VM: "1"},
+	{Tag: 0x00540010, Name: "Energy Window Vector", Keyword: "EnergyWindowVector", VR: "US", VM: "1-n"},
+	{Tag: 0x00540011, Name: "Number of Energy Windows", Keyword: "NumberOfEnergyWindows", VR: "US", VM: "1"},
+	{Tag: 0x00540012, Name: "Energy Window Information Sequence", Keyword: "EnergyWindowInformationSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00540013, Name: "Energy Window Range Sequence", Keyword: "EnergyWindowRangeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00540014, Name: "Energy Window Lower Limit", Keyword: "EnergyWindowLowerLimit", VR: "DS", VM: "1"},
+	{Tag: 0x00540015, Name: "Energy Window Upper Limit", Keyword: "EnergyWindowUpperLimit", VR: "DS", VM: "1"},
+	{Tag: 0x00540016, Name: "Radiopharmaceutical Information Sequence", Keyword: "RadiopharmaceuticalInformationSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00540017, Name: "Residual Syringe Counts", Keyword: "ResidualSyringeCounts", VR: "IS", VM: "1"},
+	{Tag: 0x00540018, Name: "Energy Window Name", Keyword: "EnergyWindowName", VR: "SH", VM: "1"},
+	{Tag: 0x00540020, Name: "Detector Vector", Keyword: "DetectorVector", VR: "US", VM: "1-n"},
+	{Tag: 0x00540021, Name: "Number of Detectors", Keyword: "NumberOfDetectors", VR: "US", VM: "1"},
+	{Tag: 0x00540022, Name: "Detector Information Sequence", Keyword: "DetectorInformationSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00540030, Name: "Phase Vector", Keyword: "PhaseVector", VR: "US", VM: "1-n"},
+	{Tag: 0x00540031, Name: "Number of Phases", Keyword: "NumberOfPhases", VR: "US", VM: "1"},
+	{Tag: 0x00540032, Name: "Phase Information Sequence", Keyword: "PhaseInformationSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00540033, Name: "Number of Frames in Phase", Keyword: "NumberOfFramesInPhase", VR: "US", VM: "1"},
+	{Tag: 0x00540036, Name: "Phase Delay", Keyword: "PhaseDelay", VR: "IS", VM: "1"},
+	{Tag: 0x00540038, Name: "Pause Between Frames", Keyword: "PauseBetweenFrames", VR: "IS", VM: "1"},
+	{Tag: 0x00540039, Name: "Phase Description", Keyword: "PhaseDescription", VR: "CS", VM: "1"},
+	{Tag: 0x00540050, Name: "Rotation Vector", Keyword: "RotationVector", VR: "US", VM: "1-n"},
+	{Tag: 0x00540051, Name: "Number of Rotations", Keyword: "NumberOfRotations", VR: "US", VM: "1"},
+	{Tag: 0x00540052, Name: "Rotation Information Sequence", Keyword: "RotationInformationSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00540053, Name: "Number of Frames in Rotation", Keyword: "NumberOfFramesInRotation", VR: "US", VM: "1"},
+	{Tag: 0x00540060, Name: "R-R Interval Vector", Keyword: "RRIntervalVector", VR: "US", VM: "1-n"},
+	{Tag: 0x00540061, Name: "Number of R-R Intervals", Keyword: "NumberOfRRIntervals", VR: "US", VM: "1"},
+	{Tag: 0x00540062, Name: "Gated Information Sequence", Keyword: "GatedInformationSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00540063, Name: "Data Information Sequence", Keyword: "DataInformationSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00540070, Name: "Time Slot Vector", Keyword: "TimeSlotVector", VR: "US", VM: "1-n"},
+	{Tag: 0x00540071, Name: "Number of Time Slots", Keyword: "NumberOfTimeSlots", VR: "US", VM: "1"},
+	{Tag: 0x00540072, Name: "Time Slot Information Sequence", Keyword: "TimeSlotInformationSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00540073, Name: "Time Slot Time", Keyword: "TimeSlotTime", VR: "DS", VM: "1"},
+	{Tag: 0x00540080, Name: "Slice Vector", Keyword: "SliceVector", VR: "US", VM: "1-n"},
+	{Tag: 0x00540081, Name: "Number of Slices", Keyword: "NumberOfSlices", VR: "US", VM: "1"},
+	{Tag: 0x00540090, Name: "Angular View Vector", Keyword: "AngularViewVector", VR: "US", VM: "1-n"},
+	{Tag: 0x00540100, Name: "Time Slice Vector", Keyword: "TimeSliceVector", VR: "US", VM: "1-n"},
+	{Tag: 0x00540101, Name: "Number of Time Slices", Keyword: "NumberOfTimeSlices", VR: "US", VM: "1"},
+	{Tag: 0x00540200, Name: "Start Angle", Keyword: "StartAngle", VR: "DS", VM: "1"},
+	{Tag: 0x00540202, Name: "Type of Detector Motion", Keyword: "TypeOfDetectorMotion", VR: "CS", VM: "1"},
+	{Tag: 0x00540210, Name: "Trigger Vector", Keyword: "TriggerVector", VR: "IS", VM: "1-n"},
+	{Tag: 0x00540211, Name: "Number of Triggers in Phase", Keyword: "NumberOfTriggersInPhase", VR: "US", VM: "1"},
+	{Tag: 0x00540220, Name: "View Code Sequence", Keyword: "ViewCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00540222, Name: "View Modifier Code Sequence", Keyword: "ViewModifierCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00540300, Name: "Radionuclide Code Sequence", Keyword: "RadionuclideCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00540302, Name: "Administration Route Code Sequence", Keyword: "AdministrationRouteCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00540304, Name: "Radiopharmaceutical Code Sequence", Keyword: "RadiopharmaceuticalCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00540306, Name: "Calibration Data Sequence", Keyword: "CalibrationDataSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00540308, Name: "Energy Window Number", Keyword: "EnergyWindowNumber", VR: "US", VM: "1"},
+	{Tag: 0x00540400, Name: "Image ID", Keyword: "ImageID", VR: "SH", VM: "1"},
+	{Tag: 0x00540410, Name: "Patient Orientation Code Sequence", Keyword: "PatientOrientationCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00540412, Name: "Patient Orientation Modifier Code Sequence", Keyword: "PatientOrientationModifierCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00540414, Name: "Patient Gantry Relationship Code Sequence", Keyword: "PatientGantryRelationshipCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00540500, Name: "Slice Progression Direction", Keyword: "SliceProgressionDirection", VR: "CS", VM: "1"},
+	{Tag: 0x00540501, Name: "Scan Progression Direction", Keyword: "ScanProgressionDirection", VR: "CS", VM: "1"},
+	{Tag: 0x00541000, Name: "Series Type", Keyword: "SeriesType", VR: "CS", VM: "2"},
+	{Tag: 0x00541001, Name: "Units", Keyword: "Units", VR: "CS", VM: "1"},
+	{Tag: 0x00541002, Name: "Counts Source", Keyword: "CountsSource", VR: "CS", VM: "1"},
+	{Tag: 0x00541004, Name: "Reprojection Method", Keyword: "ReprojectionMethod", VR: "CS", VM: "1"},
+	{Tag: 0x00541006, Name: "SUV Type", Keyword: "SUVType", VR: "CS", VM: "1"},
+	{Tag: 0x00541100, Name: "Randoms Correction Method", Keyword: "RandomsCorrectionMethod", VR: "CS", VM: "1"},
+	{Tag: 0x00541101, Name: "Attenuation Correction Method", Keyword: "AttenuationCorrectionMethod", VR: "LO", VM: "1"},
+	{Tag: 0x00541102, Name: "Decay Correction", Keyword: "DecayCorrection", VR: "CS", VM: "1"},
+	{Tag: 0x00541103, Name: "Reconstruction Method", Keyword: "ReconstructionMethod", VR: "LO", VM: "1"},
+	{Tag: 0x00541104, Name: "Detector Lines of Response Used", Keyword: "DetectorLinesOfResponseUsed", VR: "LO", VM: "1"},
+	{Tag: 0x00541105, Name: "Scatter Correction Method", Keyword: "ScatterCorrectionMethod", VR: "LO", VM: "1"},
+	{Tag: 0x00541200, Name: "Axial Acceptance", Keyword: "AxialAcceptance", VR: "DS", VM: "1"},
+	{Tag: 0x00541201, Name: "Axial Mash", Keyword: "AxialMash", VR: "IS", VM: "2"},
+	{Tag: 0x00541202, Name: "Transverse Mash", Keyword: "TransverseMash", VR: "IS", VM: "1"},
+	{Tag: 0x00541203, Name: "Detector Element Size", Keyword: "DetectorElementSize", VR: "DS", VM: "2"},
+	{Tag: 0x00541210, Name: "Coincidence Window Width", Keyword: "CoincidenceWindowWidth", VR: "DS", VM: "1"},
+	{Tag: 0x00541220, Name: "Secondary Counts Type", Keyword: "SecondaryCountsType", VR: "CS", VM: "1-n"},
+	{Tag: 0x00541300, Name: "Frame Reference Time", Keyword: "FrameReferenceTime", VR: "DS", VM: "1"},
+	{Tag: 0x00541310, Name: "Primary (Prompts) Counts Accumulated", Keyword: "PrimaryPromptsCountsAccumulated", VR: "IS", VM: "1"},
+	{Tag: 0x00541311, Name: "Secondary Counts Accumulated", Keyword: "SecondaryCountsAccumulated", VR: "IS", VM: "1-n"},
+	{Tag: 0x00541320, Name: "Slice Sensitivity Factor", Keyword: "SliceSensitivityFactor", VR: "DS", VM: "1"},
+	{Tag: 0x00541321, Name: "Decay Factor", Keyword: "DecayFactor", VR: "DS", VM: "1"},
+	{Tag: 0x00541322, Name: "Dose Calibration Factor", Keyword: "DoseCalibrationFactor", VR: "DS", VM: "1"},
+	{Tag: 0x00541323, Name: "Scatter Fraction Factor", Keyword: "ScatterFractionFactor", VR: "DS", VM: "1"},
+	{Tag: 0x00541324, Name: "Dead Time Factor", Keyword: "DeadTimeFactor", VR: "DS", VM: "1"},
+	{Tag: 0x00541330, Name: "Image Index", Keyword: "ImageIndex", VR: "US", VM: "1"},
+	{Tag: 0x00541400, Name: "Counts Included", Keyword: "CountsIncluded", VR: "CS", VM: "1-n", Retired: true},
+	{Tag: 0x00541401, Name: "Dead Time Correction Flag", Keyword: "DeadTimeCorrectionFlag", VR: "CS", VM: "1", Retired: true},
+	{Tag: 0x00603000, Name: "Histogram Sequence", Keyword: "HistogramSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00603002, Name: "Histogram Number of Bins", Keyword: "HistogramNumberOfBins", VR: "US", VM: "1"},
+	{Tag: 0x00603004, Name: "Histogram First Bin Value", Keyword: "HistogramFirstBinValue", VR: "US or SS", VM: "1"},
+	{Tag: 0x00603006, Name: "Histogram Last Bin Value", Keyword: "HistogramLastBinValue", VR: "US or SS", VM: "1"},
+	{Tag: 0x00603008, Name: "Histogram Bin Width", Keyword: "HistogramBinWidth", VR: "US", VM: "1"},
+	{Tag: 0x00603010, Name: "Histogram Explanation", Keyword: "HistogramExplanation", VR: "LO", VM: "1"},
+	{Tag: 0x00603020, Name: "Histogram Data", Keyword: "HistogramData", VR: "UL", VM: "1-n"},
+	{Tag: 0x00620001, Name: "Segmentation Type", Keyword: "SegmentationType", VR: "CS", VM: "1"},
+	{Tag: 0x00620002, Name: "Segment Sequence", Keyword: "SegmentSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00620003, Name: "Segmented Property Category Code Sequence", Keyword: "SegmentedPropertyCategoryCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00620004, Name: "Segment Number", Keyword: "SegmentNumber", VR: "US", VM: "1"},
+	{Tag: 0x00620005, Name: "Segment Label", Keyword: "SegmentLabel", VR: "LO", VM: "1"},
+	{Tag: 0x00620006, Name: "Segment Description", Keyword: "SegmentDescription", VR: "ST", VM: "1"},
+	{Tag: 0x00620007, Name: "Segmentation Algorithm Identification Sequence", Keyword: "SegmentationAlgorithmIdentificationSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00620008, Name: "Segment Algorithm Type", Keyword: "SegmentAlgorithmType", VR: "CS", VM: "1"},
+	{Tag: 0x00620009, Name: "Segment Algorithm Name", Keyword: "SegmentAlgorithmName", VR: "LO", VM: "1-n"},
+	{Tag: 0x0062000A, Name: "Segment Identification Sequence", Keyword: "SegmentIdentificationSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x0062000B, Name: "Referenced Segment Number", Keyword: "ReferencedSegmentNumber", VR: "US", VM: "1-n"},
+	{Tag: 0x0062000C, Name: "Recommended Display Grayscale Value", Keyword: "RecommendedDisplayGrayscaleValue", VR: "US", VM: "1"},
+	{Tag: 0x0062000D, Name: "Recommended Display CIELab Value", Keyword: "RecommendedDisplayCIELabValue", VR: "US", VM: "3"},
+	{Tag: 0x0062000E, Name: "Maximum Fractional Value", Keyword: "MaximumFractionalValue", VR: "US", VM: "1"},
+	{Tag: 0x0062000F, Name: "Segmented Property Type Code Sequence", Keyword: "SegmentedPropertyTypeCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00620010, Name: "Segmentation Fractional Type", Keyword: "SegmentationFractionalType", VR: "CS", VM: "1"},
+	{Tag: 0x00620011, Name: "Segmented Property Type Modifier Code Sequence", Keyword: "SegmentedPropertyTypeModifierCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00620012, Name: "Used Segments Sequence", Keyword: "UsedSegmentsSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00620013, Name: "Segments Overlap", Keyword: "SegmentsOverlap", VR: "CS", VM: "1"},
+	{Tag: 0x00620020, Name: "Tracking ID", Keyword: "TrackingID", VR: "UT", VM: "1"},
+	{Tag: 0x00620021, Name: "Tracking UID", Keyword: "TrackingUID", VR: "UI", VM: "1"},
+	{Tag: 0x00640002, Name: "Deformable Registration Sequence", Keyword: "DeformableRegistrationSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00640003, Name: "Source Frame of Reference UID", Keyword: "SourceFrameOfReferenceUID", VR: "UI", VM: "1"},
+	{Tag: 0x00640005, Name: "Deformable Registration Grid Sequence", Keyword: "DeformableRegistrationGridSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00640007, Name: "Grid Dimensions", Keyword: "GridDimensions", VR: "UL", VM: "3"},
+	{Tag: 0x00640008, Name: "Grid Resolution", Keyword: "GridResolution", VR: "FD", VM: "3"},
+	{Tag: 0x00640009, Name: "Vector Grid Data", Keyword: "VectorGridData", VR: "OF", VM: "1"},
+	{Tag: 0x0064000F, Name: "Pre Deformation Matrix Registration Sequence", Keyword: "PreDeformationMatrixRegistrationSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00640010, Name: "Post Deformation Matrix Registration Sequence", Keyword: "PostDeformationMatrixRegistrationSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00660001, Name: "Number of Surfaces", Keyword: "NumberOfSurfaces", VR: "UL", VM: "1"},
+	{Tag: 0x00660002, Name: "Surface Sequence", Keyword: "SurfaceSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00660003, Name: "Surface Number", Keyword: "SurfaceNumber", VR: "UL", VM: "1"},
+	{Tag: 0x00660004, Name: "Surface Comments", Keyword: "SurfaceComments", VR: "LT", VM: "1"},
+	{Tag: 0x00660009, Name: "Surface Processing", Keyword: "SurfaceProcessing", VR: "CS", VM: "1"},
+	{Tag: 0x0066000A, Name: "Surface Processing Ratio", Keyword: "SurfaceProcessingRatio", VR: "FL", VM: "1"},
+	{Tag: 0x0066000B, Name: "Surface Processing Description", Keyword: "SurfaceProcessingDescription", VR: "LO", VM: "1"},
+	{Tag: 0x0066000C, Name: "Recommended Presentation Opacity", Keyword: "RecommendedPresentationOpacity", VR: "FL", VM: "1"},
+	{Tag: 0x0066000D, Name: "Recommended Presentation Type", Keyword: "RecommendedPresentationType", VR: "CS", VM: "1"},
+	{Tag: 0x0066000E, Name: "Finite Volume", Keyword: "FiniteVolume", VR: "CS", VM: "1"},
+	{Tag: 0x00660010, Name: "Manifold", Keyword: "Manifold", VR: "CS", VM: "1"},
+	{Tag: 0x00660011, Name: "Surface Points Sequence", Keyword: "SurfacePointsSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00660012, Name: "Surface Points Normals Sequence", Keyword: "SurfacePointsNormalsSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00660013, Name: "Surface Mesh Primitives Sequence", Keyword: "SurfaceMeshPrimitivesSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00660015, Name: "Number of Surface Points", Keyword: "NumberOfSurfacePoints", VR: "UL", VM: "1"},
+	{Tag: 0x00660016, Name: "Point Coordinates Data", Keyword: "PointCoordinatesData", VR: "OF", VM: "1"},
+	{Tag: 0x00660017, Name: "Point Position Accuracy", Keyword: "PointPositionAccuracy", VR: "FL", VM: "3"},
+	{Tag: 0x00660018, Name: "Mean Point Distance", Keyword: "MeanPointDistance", VR: "FL", VM: "1"},
+	{Tag: 0x00660019, Name: "Maximum Point Distance", Keyword: "MaximumPointDistance", VR: "FL", VM: "1"},
+	{Tag: 0x0066001A, Name: "Points Bounding Box Coordinates", Keyword: "PointsBoundingBoxCoordinates", VR: "FL", VM: "6"},
+	{Tag: 0x0066001B, Name: "Axis of Rotation", Keyword: "AxisOfRotation", VR: "FL", VM: "3"},
+	{Tag: 0x0066001C, Name: "Center of Rotation", Keyword: "CenterOfRotation", VR: "FL", VM: "3"},
+	{Tag: 0x0066001E, Name: "Number of Vectors", Keyword: "NumberOfVectors", VR: "UL", VM: "1"},
+	{Tag: 0x0066001F, Name: "Vector Dimensionality", Keyword: "VectorDimensionality", VR: "US", VM: "1"},
+	{Tag: 0x00660020, Name: "Vector Accuracy", Keyword: "VectorAccuracy", VR: "FL", VM: "1-n"},
+	{Tag: 0x00660021, Name: "Vector Coordinate Data", Keyword: "VectorCoordinateData", VR: "OF", VM: "1"},
+	{Tag: 0x00660022, Name: "Double Point Coordinates Data", Keyword: "DoublePointCoordinatesData", VR: "OD", VM: "1"},
+	{Tag: 0x00660023, Name: "Triangle Point Index List", Keyword: "TrianglePointIndexList", VR: "OW", VM: "1", Retired: true},
+	{Tag: 0x00660024, Name: "Edge Point Index List", Keyword: "EdgePointIndexList", VR: "OW", VM: "1", Retired: true},
+	{Tag: 0x00660025, Name: "Vertex Point Index List", Keyword: "VertexPointIndexList", VR: "OW", VM: "1", Retired: true},
+	{Tag: 0x00660026, Name: "Triangle Strip Sequence", Keyword: "TriangleStripSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00660027, Name: "Triangle Fan Sequence", Keyword: "TriangleFanSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00660028, Name: "Line Sequence", Keyword: "LineSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00660029, Name: "Primitive Point Index List", Keyword: "PrimitivePointIndexList", VR: "OW", VM: "1", Retired: true},
+	{Tag: 0x0066002A, Name: "Surface Count", Keyword: "SurfaceCount", VR: "UL", VM: "1"},
+	{Tag: 0x0066002B, Name: "Referenced Surface Sequence", Keyword: "ReferencedSurfaceSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x0066002C, Name: "Referenced Surface Number", Keyword: "ReferencedSurfaceNumber", VR: "UL", VM: "1"},
+	{Tag: 0x0066002D, Name: "Segment Surface Generation Algorithm Identification Sequence", Keyword: "SegmentSurfaceGenerationAlgorithmIdentificationSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x0066002E, Name: "Segment Surface Source Instance Sequence", Keyword: "SegmentSurfaceSourceInstanceSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x0066002F, Name: "Algorithm Family Code Sequence", Keyword: "AlgorithmFamilyCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00660030, Name: "Algorithm Name Code Sequence", Keyword: "AlgorithmNameCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00660031, Name: "Algorithm Version", Keyword: "AlgorithmVersion", VR: "LO", VM: "1"},
+	{Tag: 0x00660032, Name: "Algorithm Parameters", Keyword: "AlgorithmParameters", VR: "LT", VM: "1"},
+	{Tag: 0x00660034, Name: "Facet Sequence", Keyword: "FacetSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00660035, Name: "Surface Processing Algorithm Identification Sequence", Keyword: "SurfaceProcessingAlgorithmIdentificationSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00660036, Name: "Algorithm Name", Keyword: "AlgorithmName", VR: "LO", VM: "1"},
+	{Tag: 0x00660037, Name: "Recommended Point Radius", Keyword: "RecommendedPointRadius", VR: "FL", VM: "1"},
+	{Tag: 0x00660038, Name: "Recommended Line Thickness", Keyword: "RecommendedLineThickness", VR: "FL", VM: "1"},
+	{Tag: 0x00660040, Name: "Long Primitive Point Index List", Keyword: "LongPrimitivePointIndexList", VR: "OL", VM: "1"},
+	{Tag: 0x00660041, Name: "Long Triangle Point Index List", Keyword: "LongTrianglePointIndexList", VR: "OL", VM: "1"},
+	{Tag: 0x00660042, Name: "Long Edge Point Index List", Keyword: "LongEdgePointIndexList", VR: "OL", VM: "1"},
+	{Tag: 0x00660043, Name: "Long Vertex Point Index List", Keyword: "LongVertexPointIndexList", VR: "OL", VM: "1"},
+	{Tag: 0x00660101, Name: "Track Set Sequence", Keyword: "TrackSetSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00660102, Name: "Track Sequence", Keyword: "TrackSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00660103, Name: "Recommended Display CIELab Value List", Keyword: "RecommendedDisplayCIELabValueList", VR: "OW", VM: "1"},
+	{Tag: 0x00660104, Name: "Tracking Algorithm Identification Sequence", Keyword: "TrackingAlgorithmIdentificationSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00660105, Name: "Track Set Number", Keyword: "TrackSetNumber", VR: "UL", VM: "1"},
+	{Tag: 0x00660106, Name: "Track Set Label", Keyword: "TrackSetLabel", VR: "LO", VM: "1"},
+	{Tag: 0x00660107, Name: "Track Set Description", Keyword: "TrackSetDescription", VR: "UT", VM: "1"},
+	{Tag: 0x00660108, Name: "Track Set Anatomical Type Code Sequence", Keyword: "TrackSetAnatomicalTypeCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00660121, Name: "Measurements Sequence", Keyword: "MeasurementsSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00660124, Name: "Track Set Statistics Sequence", Keyword: "TrackSetStatisticsSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00660125, Name: "Floating Point Values", Keyword: "FloatingPointValues", VR: "OF", VM: "1"},
+	{Tag: 0x00660129, Name: "Track Point Index List", Keyword: "TrackPointIndexList", VR: "OL", VM: "1"},
+	{Tag: 0x00660130, Name: "Track Statistics Sequence", Keyword: "TrackStatisticsSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00660132, Name: "Measurement Values Sequence", Keyword: "MeasurementValuesSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00660133, Name: "Diffusion Acquisition Code Sequence", Keyword: "DiffusionAcquisitionCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00660134, Name: "Diffusion Model Code Sequence", Keyword: "DiffusionModelCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00686210, Name: "Implant Size", Keyword: "ImplantSize", VR: "LO", VM: "1"},
+	{Tag: 0x00686221, Name: "Implant Template Version", Keyword: "ImplantTemplateVersion", VR: "LO", VM: "1"},
+	{Tag: 0x00686222, Name: "Replaced Implant Template Sequence", Keyword: "ReplacedImplantTemplateSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00686223, Name: "Implant Type", Keyword: "ImplantType", VR: "CS", VM: "1"},
+	{Tag: 0x00686224, Name: "Derivation Implant Template Sequence", Keyword: "DerivationImplantTemplateSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00686225, Name: "Original Implant Template Sequence", Keyword: "OriginalImplantTemplateSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00686226, Name: "Effective DateTime", Keyword: "EffectiveDateTime", VR: "DT", VM: "1"},
+	{Tag: 0x00686230, Name: "Implant Target Anatomy Sequence", Keyword: "ImplantTargetAnatomySequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00686260, Name: "Information From Manufacturer Sequence", Keyword: "InformationFromManufacturerSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00686265, Name: "Notification From Manufacturer Sequence", Keyword: "NotificationFromManufacturerSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00686270, Name: "Information Issue DateTime", Keyword: "InformationIssueDateTime", VR: "DT", VM: "1"},
+	{Tag: 0x00686280, Name: "Information Summary", Keyword: "InformationSummary", VR: "ST", VM: "1"},
+	{Tag: 0x006862A0, Name: "Implant Regulatory Disapproval Code Sequence", Keyword: "ImplantRegulatoryDisapprovalCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x006862A5, Name: "Overall Template Spatial Tolerance", Keyword: "OverallTemplateSpatialTolerance", VR: "FD", VM: "1"},
+	{Tag: 0x006862C0, Name: "HPGL Document Sequence", Keyword: "HPGLDocumentSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x006862D0, Name: "HPGL Document ID", Keyword: "HPGLDocumentID", VR: "US", VM: "1"},
+	{Tag: 0x006862D5, Name: "HPGL Document Label", Keyword: "HPGLDocumentLabel", VR: "LO", VM: "1"},
+	{Tag: 0x006862E0, Name: "View Orientation Code Sequence", Keyword: "ViewOrientationCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x006862F0, Name: "View Orientation Modifier Code Sequence", Keyword: "ViewOrientationModifierCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x006862F2, Name: "HPGL Document Scaling", Keyword: "HPGLDocumentScaling", VR: "FD", VM: "1"},
+	{Tag: 0x00686300, Name: "HPGL Document", Keyword: "HPGLDocument", VR: "OB", VM: "1"},
+	{Tag: 0x00686310, Name: "HPGL Contour Pen Number", Keyword: "HPGLContourPenNumber", VR: "US", VM: "1"},
+	{Tag: 0x00686320, Name: "HPGL Pen Sequence", Keyword: "HPGLPenSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00686330, Name: "HPGL Pen Number", Keyword: "HPGLPenNumber", VR: "US", VM: "1"},
+	{Tag: 0x00686340, Name: "HPGL Pen Label", Keyword: "HPGLPenLabel", VR: "LO", VM: "1"},
+	{Tag: 0x00686345, Name: "HPGL Pen Description", Keyword: "HPGLPenDescription", VR: "ST", VM: "1"},
+	{Tag: 0x00686346, Name: "Recommended Rotation Point", Keyword: "RecommendedRotationPoint", VR: "FD", VM: "2"},
+	{Tag: 0x00686347, Name: "Bounding Rectangle", Keyword: "BoundingRectangle", VR: "FD", VM: "4"},
+	{Tag: 0x00686350, Name: "Implant Template 3D Model Surface Number", Keyword: "ImplantTemplate3DModelSurfaceNumber", VR: "US", VM: "1-n"},
+	{Tag: 0x00686360, Name: "Surface Model Description Sequence", Keyword: "SurfaceModelDescriptionSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00686380, Name: "Surface Model Label", Keyword: "SurfaceModelLabel", VR: "LO", VM: "1"},
+	{Tag: 0x00686390, Name: "Surface Model Scaling Factor", Keyword: "SurfaceModelScalingFactor", VR: "FD", VM: "1"},
+	{Tag: 0x006863A0, Name: "Materials Code Sequence", Keyword: "MaterialsCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x006863A4, Name: "Coating Materials Code Sequence", Keyword: "CoatingMaterialsCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x006863A8, Name: "Implant Type Code Sequence", Keyword: "ImplantTypeCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x006863AC, Name: "Fixation Method Code Sequence", Keyword: "FixationMethodCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x006863B0, Name: "Mating Feature Sets Sequence", Keyword: "MatingFeatureSetsSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x006863C0, Name: "Mating Feature Set ID", Keyword: "MatingFeatureSetID", VR: "US", VM: "1"},
+	{Tag: 0x006863D0, Name: "Mating Feature Set Label", Keyword: "MatingFeatureSetLabel", VR: "LO", VM: "1"},
+	{Tag: 0x006863E0, Name: "Mating Feature Sequence", Keyword: "MatingFeatureSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x006863F0, Name: "Mating Feature ID", Keyword: "MatingFeatureID", VR: "US", VM: "1"},
+	{Tag: 0x00686400, Name: "Mating Feature Degree of Freedom Sequence", Keyword: "MatingFeatureDegreeOfFreedomSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00686410, Name: "Degree of Freedom ID", Keyword: "DegreeOfFreedomID", VR: "US", VM: "1"},
+	{Tag: 0x00686420, Name: "Degree of Freedom Type", Keyword: "DegreeOfFreedomType", VR: "CS", VM: "1"},
+	{Tag: 0x00686430, Name: "2D Mating Feature Coordinates Sequence", Keyword: "TwoDMatingFeatureCoordinatesSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00686440, Name: "Referenced HPGL Document ID", Keyword: "ReferencedHPGLDocumentID", VR: "US", VM: "1"},
+	{Tag: 0x00686450, Name: "2D Mating Point", Keyword: "TwoDMatingPoint", VR: "FD", VM: "2"},
+	{Tag: 0x00686460, Name: "2D Mating Axes", Keyword: "TwoDMatingAxes", VR: "FD", VM: "4"},
+	{Tag: 0x00686470, Name: "2D Degree of Freedom Sequence", Keyword: "TwoDDegreeOfFreedomSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00686490, Name: "3D Degree of Freedom Axis", Keyword: "ThreeDDegreeOfFreedomAxis", VR: "FD", VM: "3"},
+	{Tag: 0x006864A0, Name: "Range of Freedom", Keyword: "RangeOfFreedom", VR: "FD", VM: "2"},
+	{Tag: 0x006864C0, Name: "3D Mating Point", Keyword: "ThreeDMatingPoint", VR: "FD", VM: "3"},
+	{Tag: 0x006864D0, Name: "3D Mating Axes", Keyword: "ThreeDMatingAxes", VR: "FD", VM: "9"},
+	{Tag: 0x006864F0, Name: "2D Degree of Freedom Axis", Keyword: "TwoDDegreeOfFreedomAxis", VR: "FD", VM: "3"},
+	{Tag: 0x00686500, Name: "Planning Landmark Point Sequence", Keyword: "PlanningLandmarkPointSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00686510, Name: "Planning Landmark Line Sequence", Keyword: "PlanningLandmarkLineSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00686520, Name: "Planning Landmark Plane Sequence", Keyword: "PlanningLandmarkPlaneSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00686530, Name: "Planning Landmark ID", Keyword: "PlanningLandmarkID", VR: "US", VM: "1"},
+	{Tag: 0x00686540, Name: "Planning Landmark Description", Keyword: "PlanningLandmarkDescription", VR: "LO", VM: "1"},
+	{Tag: 0x00686545, Name: "Planning Landmark Identification Code Sequence", Keyword: "PlanningLandmarkIdentificationCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00686550, Name: "2D Point Coordinates Sequence", Keyword: "TwoDPointCoordinatesSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00686560, Name: "2D Point Coordinates", Keyword: "TwoDPointCoordinates", VR: "FD", VM: "2"},
+	{Tag: 0x00686590, Name: "3D Point Coordinates", Keyword: "ThreeDPointCoordinates", VR: "FD", VM: "3"},
+	{Tag: 0x006865A0, Name: "2D Line Coordinates Sequence", Keyword: "TwoDLineCoordinatesSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x006865B0, Name: "2D Line Coordinates", Keyword: "TwoDLineCoordinates", VR: "FD", VM: "4"},
+	{Tag: 0x006865D0, Name: "3D Line Coordinates", Keyword: "ThreeDLineCoordinates", VR: "FD", VM: "6"},
+	{Tag: 0x006865E0, Name: "2D Plane Coordinates Sequence", Keyword: "TwoDPlaneCoordinatesSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x006865F0, Name: "2D Plane Intersection", Keyword: "TwoDPlaneIntersection", VR: "FD", VM: "4"},
+	{Tag: 0x00686610, Name: "3D Plane Origin", Keyword: "ThreeDPlaneOrigin", VR: "FD", VM: "3"},
+	{Tag: 0x00686620, Name: "3D Plane Normal", Keyword: "ThreeDPlaneNormal", VR: "FD", VM: "3"},
+	{Tag: 0x00687001, Name: "Model Modification", Keyword: "ModelModification", VR: "CS", VM: "1"},
+	{Tag: 0x00687002, Name: "Model Mirroring", Keyword: "ModelMirroring", VR: "CS", VM: "1"},
+	{Tag: 0x00687003, Name: "Model Usage Code Sequence", Keyword: "ModelUsageCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00687004, Name: "Model Group UID", Keyword: "ModelGroupUID", VR: "UI", VM: "1"},
+	{Tag: 0x00687005, Name: "Relative URI Reference Within Encapsulated Document", Keyword: "RelativeURIReferenceWithinEncapsulatedDocument", VR: "UR", VM: "1"},
+	{Tag: 0x006A0001, Name: "Annotation Coordinate Type", Keyword: "AnnotationCoordinateType", VR: "CS", VM: "1"},
+	{Tag: 0x006A0002, Name: "Annotation Group Sequence", Keyword: "AnnotationGroupSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x006A0003, Name: "Annotation Group UID", Keyword: "AnnotationGroupUID", VR: "UI", VM: "1"},
+	{Tag: 0x006A0005, Name: "Annotation Group Label", Keyword: "AnnotationGroupLabel", VR: "LO", VM: "1"},
+	{Tag: 0x006A0006, Name: "Annotation Group Description", Keyword: "AnnotationGroupDescription", VR: "UT", VM: "1"},
+	{Tag: 0x006A0007, Name: "Annotation Group Generation Type", Keyword: "AnnotationGroupGenerationType", VR: "CS", VM: "1"},
+	{Tag: 0x006A0008, Name: "Annotation Group Algorithm Identification Sequence", Keyword: "AnnotationGroupAlgorithmIdentificationSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x006A0009, Name: "Annotation Property Category Code Sequence", Keyword: "AnnotationPropertyCategoryCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x006A000A, Name: "Annotation Property Type Code Sequence", Keyword: "AnnotationPropertyTypeCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x006A000B, Name: "Annotation Property Type Modifier Code Sequence", Keyword: "AnnotationPropertyTypeModifierCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x006A000C, Name: "Number of Annotations", Keyword: "NumberOfAnnotations", VR: "UL", VM: "1"},
+	{Tag: 0x006A000D, Name: "Annotation Applies to All Optical Paths", Keyword: "AnnotationAppliesToAllOpticalPaths", VR: "CS", VM: "1"},
+	{Tag: 0x006A000E, Name: "Referenced Optical Path Identifier", Keyword: "ReferencedOpticalPathIdentifier", VR: "SH", VM: "1-n"},
+	{Tag: 0x006A000F, Name: "Annotation Applies to All Z Planes", Keyword: "AnnotationAppliesToAllZPlanes", VR: "CS", VM: "1"},
+	{Tag: 0x006A0010, Name: "Common Z Coordinate Value", Keyword: "CommonZCoordinateValue", VR: "FD", VM: "1-n"},
+	{Tag: 0x006A0011, Name: "Annotation Index List", Keyword: "AnnotationIndexList", VR: "OL", VM: "1"},
+	{Tag: 0x00700001, Name: "Graphic Annotation Sequence", Keyword: "GraphicAnnotationSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00700002, Name: "Graphic Layer", Keyword: "GraphicLayer", VR: "CS", VM: "1"},
+	{Tag: 0x00700003, Name: "Bounding Box Annotation Units", Keyword: "BoundingBoxAnnotationUnits", VR: "CS", VM: "1"},
+	{Tag: 0x00700004, Name: "Anchor Point Annotation Units", Keyword: "AnchorPointAnnotationUnits", VR: "CS", VM: "1"},
+	{Tag: 0x00700005, Name: "Graphic Annotation Units", Keyword: "GraphicAnnotationUnits", VR: "CS", VM: "1"},
+	{Tag: 0x00700006, Name: "Unformatted Text Value", Keyword: "UnformattedTextValue", VR: "ST", VM: "1"},
+	{Tag: 0x00700008, Name: "Text Object Sequence", Keyword: "TextObjectSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00700009, Name: "Graphic Object Sequence", Keyword: "GraphicObjectSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00700010, Name: "Bounding Box Top Left Hand Corner", Keyword: "BoundingBoxTopLeftHandCorner", VR: "FL", VM: "2"},
+	{Tag: 0x00700011, Name: "Bounding Box Bottom Right Hand Corner", Keyword: "BoundingBoxBottomRightHandCorner", VR: "FL", VM: "2"},
+	{Tag: 0x00700012, Name: "Bounding Box Text Horizontal Justification", Keyword: "BoundingBoxTextHorizontalJustification", VR: "CS", VM: "1"},
+	{Tag: 0x00700014, Name: "Anchor Point", Keyword: "AnchorPoint", VR: "FL", VM: "2"},
+	{Tag: 0x00700015, Name: "Anchor Point Visibility", Keyword: "AnchorPointVisibility", VR: "CS", VM: "1"},
+	{Tag: 0x00700020, Name: "Graphic Dimensions", Keyword: "GraphicDimensions", VR: "US", VM: "1"},
+	{Tag: 0x00700021, Name: "Number of Graphic Points", Keyword: "NumberOfGraphicPoints", VR: "US", VM: "1"},
+	{Tag: 0x00700022, Name: "Graphic Data", Keyword: "GraphicData", VR: "FL", VM: "2-n"},
+	{Tag: 0x00700023, Name: "Graphic Type", Keyword: "GraphicType", VR: "CS", VM: "1"},
+	{Tag: 0x00700024, Name: "Graphic Filled", Keyword: "GraphicFilled", VR: "CS", VM: "1"},
+	{Tag: 0x00700040, Name: "Image Rotation (Retired)", Keyword: "ImageRotationRetired", VR: "IS", VM: "1", Retired: true},
+	{Tag: 0x00700041, Name: "Image Horizontal Flip", Keyword: "ImageHorizontalFlip", VR: "CS", VM: "1"},
+	{Tag: 0x00700042, Name: "Image Rotation", Keyword: "ImageRotation", VR: "US", VM: "1"},
+	{Tag: 0x00700050, Name: "Displayed Area Top Left Hand Corner (Trial)", Keyword: "DisplayedAreaTopLeftHandCornerTrial", VR: "US", VM: "2", Retired: true},
+	{Tag: 0x00700051, Name: "Displayed Area Bottom Right Hand Corner (Trial)", Keyword: "DisplayedAreaBottomRightHandCornerTrial", VR: "US", VM: "2", Retired: true},
+	{Tag: 0x00700052, Name: "Displayed Area Top Left Hand Corner", Keyword: "DisplayedAreaTopLeftHandCorner", VR: "SL", VM: "2"},
+	{Tag: 0x00700053, Name: "Displayed Area Bottom Right Hand Corner", Keyword: "DisplayedAreaBottomRightHandCorner", VR: "SL", VM: "2"},
+	{Tag: 0x0070005A, Name: "Displayed Area Selection Sequence", Keyword: "DisplayedAreaSelectionSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00700060, Name: "Graphic Layer Sequence", Keyword: "GraphicLayerSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00700062, Name: "Graphic Layer Order", Keyword: "GraphicLayerOrder", VR: "IS", VM: "1"},
+	{Tag: 0x00700066, Name: "Graphic Layer Recommended Display Grayscale Value", Keyword: "GraphicLayerRecommendedDisplayGrayscaleValue", VR: "US", VM: "1"},
+	{Tag: 0x00700067, Name: "Graphic Layer Recommended Display RGB Value", Keyword: "GraphicLayerRecommendedDisplayRGBValue", VR: "US", VM: "3", Retired: true},
+	{Tag: 0x00700068, Name: "Graphic Layer Description", Keyword: "GraphicLayerDescription", VR: "LO", VM: "1"},
+	{Tag: 0x00700080, Name: "Content Label", Keyword: "ContentLabel", VR: "CS", VM: "1"},
+	{Tag: 0x00700081, Name: "Content Description", Keyword: "ContentDescription", VR: "LO", VM: "1"},
+	{Tag: 0x00700082, Name: "Presentation Creation Date", Keyword: "PresentationCreationDate", VR: "DA", VM: "1"},
+	{Tag: 0x00700083, Name: "Presentation Creation Time", Keyword: "PresentationCreationTime", VR: "TM", VM: "1"},
+	{Tag: 0x00700084, Name: "Content Creator's Name", Keyword: "ContentCreatorName", VR: "PN", VM: "1"},
+	{Tag: 0x00700086, Name: "Content Creator's Identification Code Sequence", Keyword: "ContentCreatorIdentificationCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00700087, Name: "Alternate Content Description Sequence", Keyword: "AlternateContentDescriptionSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00700100, Name: "Presentation Size Mode", Keyword: "PresentationSizeMode", VR: "CS", VM: "1"},
+	{Tag: 0x00700101, Name: "Presentation Pixel Spacing", Keyword: "PresentationPixelSpacing", VR: "DS", VM: "2"},
+	{Tag: 0x00700102, Name: "Presentation Pixel Aspect Ratio", Keyword: "PresentationPixelAspectRatio", VR: "IS", VM: "2"},
+	{Tag: 0x00700103, Name: "Presentation Pixel Magnification Ratio", Keyword: "PresentationPixelMagnificationRatio", VR: "FL", VM: "1"},
+	{Tag: 0x00700207, Name: "Graphic Group Label", Keyword: "GraphicGroupLabel", VR: "LO", VM: "1"},
+	{Tag: 0x00700208, Name: "Graphic Group Description", Keyword: "GraphicGroupDescription", VR: "ST", VM: "1"},
+	{Tag: 0x00700209, Name: "Compound Graphic Sequence", Keyword: "CompoundGraphicSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00700226, Name: "Compound Graphic Instance ID", Keyword: "CompoundGraphicInstanceID", VR: "UL", VM: "1"},
+	{Tag: 0x00700227, Name: "Font Name", Keyword: "FontName", VR: "LO", VM: "1"},
+	{Tag: 0x00700228, Name: "Font Name Type", Keyword: "FontNameType", VR: "CS", VM: "1"},
+	{Tag: 0x00700229, Name: "CSS Font Name", Keyword: "CSSFontName", VR: "LO", VM: "1"},
+	{Tag: 0x00700230, Name: "Rotation Angle", Keyword: "RotationAngle", VR: "FD", VM: "1"},
+	{Tag: 0x00700231, Name: "Text Style Sequence", Keyword: "TextStyleSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00700232, Name: "Line Style Sequence", Keyword: "LineStyleSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00700233, Name: "Fill Style Sequence", Keyword: "FillStyleSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00700234, Name: "Graphic Group Sequence", Keyword: "GraphicGroupSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00700241, Name: "Text Color CIELab Value", Keyword: "TextColorCIELabValue", VR: "US", VM: "3"},
+	{Tag: 0x00700242, Name: "Horizontal Alignment", Keyword: "HorizontalAlignment", VR: "CS", VM: "1"},
+	{Tag: 0x00700243, Name: "Vertical Alignment", Keyword: "VerticalAlignment", VR: "CS", VM: "1"},
+	{Tag: 0x00700244, Name: "Shadow Style", Keyword: "ShadowStyle", VR: "CS", VM: "1"},
+	{Tag: 0x00700245, Name: "Shadow Offset X", Keyword: "ShadowOffsetX", VR: "FL", VM: "1"},
+	{Tag: 0x00700246, Name: "Shadow Offset Y", Keyword: "ShadowOffsetY", VR: "FL", VM: "1"},
+	{Tag: 0x00700247, Name: "Shadow Color CIELab Value", Keyword: "ShadowColorCIELabValue", VR: "US", VM: "3"},
+	{Tag: 0x00700248, Name: "Underlined", Keyword: "Underlined", VR: "CS", VM: "1"},
+	{Tag: 0x00700249, Name: "Bold", Keyword: "Bold", VR: "CS", VM: "1"},
+	{Tag: 0x00700250, Name: "Italic", Keyword: "Italic", VR: "CS", VM: "1"},
+	{Tag: 0x00700251, Name: "Pattern On Color CIELab Value", Keyword: "PatternOnColorCIELabValue", VR: "US", VM: "3"},
+	{Tag: 0x00700252, Name: "Pattern Off Color CIELab Value", Keyword: "PatternOffColorCIELabValue", VR: "US", VM: "3"},
+	{Tag: 0x00700253, Name: "Line Thickness", Keyword: "LineThickness", VR: "FL", VM: "1"},
+	{Tag: 0x00700254, Name: "Line Dashing Style", Keyword: "LineDashingStyle", VR: "CS", VM: "1"},
+	{Tag: 0x00700255, Name: "Line Pattern", Keyword: "LinePattern", VR: "UL", VM: "1"},
+	{Tag: 0x00700256, Name: "Fill Pattern", Keyword: "FillPattern", VR: "OB", VM: "1"},
+	{Tag: 0x00700257, Name: "Fill Mode", Keyword: "FillMode", VR: "CS", VM: "1"},
+	{Tag: 0x00700258, Name: "Shadow Opacity", Keyword: "ShadowOpacity", VR: "FL", VM: "1"},
+	{Tag: 0x00700261, Name: "Gap Length", Keyword: "GapLength", VR: "FL", VM: "1"},
+	{Tag: 0x00700262, Name: "Diameter of Visibility", Keyword: "DiameterOfVisibility", VR: "FL", VM: "1"},
+	{Tag: 0x00700273, Name: "Rotation Point", Keyword: "RotationPoint", VR: "FL", VM: "2"},
+	{Tag: 0x00700274, Name: "Tick Alignment", Keyword: "TickAlignment", VR: "CS", VM: "1"},
+	{Tag: 0x00700278, Name: "Show Tick Label", Keyword: "ShowTickLabel", VR: "CS", VM: "1"},
+	{Tag: 0x00700279, Name: "Tick Label Alignment", Keyword: "TickLabelAlignment", VR: "CS", VM: "1"},
+	{Tag: 0x00700282, Name: "Compound Graphic Units", Keyword: "CompoundGraphicUnits", VR: "CS", VM: "1"},
+	{Tag: 0x00700284, Name: "Pattern On Opacity", Keyword: "PatternOnOpacity", VR: "FL", VM: "1"},
+	{Tag: 0x00700285, Name: "Pattern Off Opacity", Keyword: "PatternOffOpacity", VR: "FL", VM: "1"},
+	{Tag: 0x00700287, Name: "Major Ticks Sequence", Keyword: "MajorTicksSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00700288, Name: "Tick Position", Keyword: "TickPosition", VR: "FL", VM: "1"},
+	{Tag: 0x00700289, Name: "Tick Label", Keyword: "TickLabel", VR: "SH", VM: "1"},
+	{Tag: 0x00700294, Name: "Compound Graphic Type", Keyword: "CompoundGraphicType", VR: "CS", VM: "1"},
+	{Tag: 0x00700295, Name: "Graphic Group ID", Keyword: "GraphicGroupID", VR: "UL", VM: "1"},
+	{Tag: 0x00700306, Name: "Shape Type", Keyword: "ShapeType", VR: "CS", VM: "1"},
+	{Tag: 0x00700308, Name: "Registration Sequence", Keyword: "RegistrationSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00700309, Name: "Matrix Registration Sequence", Keyword: "MatrixRegistrationSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x0070030A, Name: "Matrix Sequence", Keyword: "MatrixSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x0070030B, Name: "Frame of Reference to Displayed Coordinate System Transformation Matrix", Keyword: "FrameOfReferenceToDisplayedCoordinateSystemTransformationMatrix", VR: "FD", VM: "16"},
+	{Tag: 0x0070030C, Name: "Frame of Reference Transformation Matrix Type", Keyword: "FrameOfReferenceTransformationMatrixType", VR: "CS", VM: "1"},
+	{Tag: 0x0070030D, Name: "Registration Type Code Sequence", Keyword: "RegistrationTypeCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x0070030F, Name: "Fiducial Description", Keyword: "FiducialDescription", VR: "ST", VM: "1"},
+	{Tag: 0x00700310, Name: "Fiducial Identifier", Keyword: "FiducialIdentifier", VR: "SH", VM: "1"},
+	{Tag: 0x00700311, Name: "Fiducial Identifier Code Sequence", Keyword: "FiducialIdentifierCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00700312, Name: "Contour Uncertainty Radius", Keyword: "ContourUncertaintyRadius", VR: "FD", VM: "1"},
+	{Tag: 0x00700314, Name: "Used Fiducials Sequence", Keyword: "UsedFiducialsSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00700315, Name: "Used RT Structure Set ROI Sequence", Keyword: "UsedRTStructureSetROISequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00700318, Name: "Graphic Coordinates Data Sequence", Keyword: "GraphicCoordinatesDataSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x0070031A, Name: "Fiducial UID", Keyword: "FiducialUID", VR: "UI", VM: "1"},
+	{Tag: 0x0070031B, Name: "Referenced Fiducial UID", Keyword: "ReferencedFiducialUID", VR: "UI", VM: "1"},
+	{Tag: 0x0070031C, Name: "Fiducial Set Sequence", Keyword: "FiducialSetSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x0070031E, Name: "Fiducial Sequence", Keyword: "FiducialSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x0070031F, Name: "Fiducials Property Category Code Sequence", Keyword: "FiducialsPropertyCategoryCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00700401, Name: "Graphic Layer Recommended Display CIELab Value", Keyword: "GraphicLayerRecommendedDisplayCIELabValue", VR: "US", VM: "3"},
+	{Tag: 0x00700402, Name: "Blending Sequence", Keyword: "BlendingSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00700403, Name: "Relative Opacity", Keyword: "RelativeOpacity", VR: "FL", VM: "1"},
+	{Tag: 0x00700404, Name: "Referenced Spatial Registration Sequence", Keyword: "ReferencedSpatialRegistrationSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00700405, Name: "Blending Position", Keyword: "BlendingPosition", VR: "CS", VM: "1"},
+	{Tag: 0x00701101, Name: "Presentation Display Collection UID", Keyword: "PresentationDisplayCollectionUID", VR: "UI", VM: "1"},
+	{Tag: 0x00701102, Name: "Presentation Sequence Collection UID", Keyword: "PresentationSequenceCollectionUID", VR: "UI", VM: "1"},
+	{Tag: 0x00701103, Name: "Presentation Sequence Position Index", Keyword: "PresentationSequencePositionIndex", VR: "US", VM: "1"},
+	{Tag: 0x00701104, Name: "Rendered Image Reference Sequence", Keyword: "RenderedImageReferenceSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00701201, Name: "Volumetric Presentation State Input Sequence", Keyword: "VolumetricPresentationStateInputSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00701202, Name: "Presentation Input Type", Keyword: "PresentationInputType", VR: "CS", VM: "1"},
+	{Tag: 0x00701203, Name: "Input Sequence Position Index", Keyword: "InputSequencePositionIndex", VR: "US", VM: "1"},
+	{Tag: 0x00701204, Name: "Crop", Keyword: "Crop", VR: "CS", VM: "1"},
+	{Tag: 0x00701205, Name: "Cropping Specification Index", Keyword: "CroppingSpecificationIndex", VR: "US", VM: "1-n"},
+	{Tag: 0x00701206, Name: "Compositing Method", Keyword: "CompositingMethod", VR: "CS", VM: "1", Retired: true},
+	{Tag: 0x00701207, Name: "Volumetric Presentation Input Number", Keyword: "VolumetricPresentationInputNumber", VR: "US", VM: "1"},
+	{Tag: 0x00701208, Name: "Image Volume Geometry", Keyword: "ImageVolumeGeometry", VR: "CS", VM: "1"},
+	{Tag: 0x00701209, Name: "Volumetric Presentation Input Set UID", Keyword: "VolumetricPresentationInputSetUID", VR: "UI", VM: "1"},
+	{Tag: 0x0070120A, Name: "Volumetric Presentation Input Set Sequence", Keyword: "VolumetricPresentationInputSetSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x0070120B, Name: "Global Crop", Keyword: "GlobalCrop", VR: "CS", VM: "1"},
+	{Tag: 0x0070120C, Name: "Global Cropping Specification Index", Keyword: "GlobalCroppingSpecificationIndex", VR: "US", VM: "1-n"},
+	{Tag: 0x0070120D, Name: "Rendering Method", Keyword: "RenderingMethod", VR: "CS", VM: "1"},
+	{Tag: 0x00701301, Name: "Volume Cropping Sequence", Keyword: "VolumeCroppingSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00701302, Name: "Volume Cropping Method", Keyword: "VolumeCroppingMethod", VR: "CS", VM: "1"},
+	{Tag: 0x00701303, Name: "Bounding Box Crop", Keyword: "BoundingBoxCrop", VR: "FD", VM: "6"},
+	{Tag: 0x00701304, Name: "Oblique Cropping Plane Sequence", Keyword: "ObliqueCroppingPlaneSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00701305, Name: "Plane", Keyword: "Plane", VR: "FD", VM: "4"},
+	{Tag: 0x00701306, Name: "Plane Normal", Keyword: "PlaneNormal", VR: "FD", VM: "3"},
+	{Tag: 0x00701309, Name: "Cropping Specification Number", Keyword: "CroppingSpecificationNumber", VR: "US", VM: "1"},
+	{Tag: 0x00701501, Name: "Multi-Planar Reconstruction Style", Keyword: "MultiPlanarReconstructionStyle", VR: "CS", VM: "1"},
+	{Tag: 0x00701502, Name: "MPR Thickness Type", Keyword: "MPRThicknessType", VR: "CS", VM: "1"},
+	{Tag: 0x00701503, Name: "MPR Slab Thickness", Keyword: "MPRSlabThickness", VR: "FD", VM: "1"},
+	{Tag: 0x00701505, Name: "MPR Top Left Hand Corner", Keyword: "MPRTopLeftHandCorner", VR: "FD", VM: "3"},
+	{Tag: 0x00701507, Name: "MPR View Width Direction", Keyword: "MPRViewWidthDirection", VR: "FD", VM: "3"},
+	{Tag: 0x00701508, Name: "MPR View Width", Keyword: "MPRViewWidth", VR: "FD", VM: "1"},
+	{Tag: 0x0070150C, Name: "Number of Volumetric Curve Points", Keyword: "NumberOfVolumetricCurvePoints", VR: "UL", VM: "1"},
+	{Tag: 0x0070150D, Name: "Volumetric Curve Points", Keyword: "VolumetricCurvePoints", VR: "OD", VM: "1"},
+	{Tag: 0x00701511, Name: "MPR View Height Direction", Keyword: "MPRViewHeightDirection", VR: "FD", VM: "3"},
+	{Tag: 0x00701512, Name: "MPR View Height", Keyword: "MPRViewHeight", VR: "FD", VM: "1"},
+	{Tag: 0x00701602, Name: "Render Projection", Keyword: "RenderProjection", VR: "CS", VM: "1"},
+	{Tag: 0x00701603, Name: "Viewpoint Position", Keyword: "ViewpointPosition", VR: "FD", VM: "3"},
+	{Tag: 0x00701604, Name: "Viewpoint LookAt Point", Keyword: "ViewpointLookAtPoint", VR: "FD", VM: "3"},
+	{Tag: 0x00701605, Name: "Viewpoint Up Direction", Keyword: "ViewpointUpDirection", VR: "FD", VM: "3"},
+	{Tag: 0x00701606, Name: "Render Field of View", Keyword: "RenderFieldOfView", VR: "FD", VM: "6"},
+	{Tag: 0x00701607, Name: "Sampling Step Size", Keyword: "SamplingStepSize", VR: "FD", VM: "1"},
+	{Tag: 0x00701701, Name: "Shading Style", Keyword: "ShadingStyle", VR: "CS", VM: "1"},
+	{Tag: 0x00701702, Name: "Ambient Reflection Intensity", Keyword: "AmbientReflectionIntensity", VR: "FD", VM: "1"},
+	{Tag: 0x00701703, Name: "Light Direction", Keyword: "LightDirection", VR: "FD", VM: "3"},
+	{Tag: 0x00701704, Name: "Diffuse Reflection Intensity", Keyword: "DiffuseReflectionIntensity", VR: "FD", VM: "1"},
+	{Tag: 0x00701705, Name: "Specular Reflection Intensity", Keyword: "SpecularReflectionIntensity", VR: "FD", VM: "1"},
+	{Tag: 0x00701706, Name: "Shininess", Keyword: "Shininess", VR: "FD", VM: "1"},
+	{Tag: 0x00701801, Name: "Presentation State Classification Component Sequence", Keyword: "PresentationStateClassificationComponentSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00701802, Name: "Component Type", Keyword: "ComponentType", VR: "CS", VM: "1"},
+	{Tag: 0x00701803, Name: "Component Input Sequence", Keyword: "ComponentInputSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00701804, Name: "Volumetric Presentation Input Index", Keyword: "VolumetricPresentationInputIndex", VR: "US", VM: "1"},
+	{Tag: 0x00701805, Name: "Presentation State Compositor Component Sequence", Keyword: "PresentationStateCompositorComponentSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00701806, Name: "Weighting Transfer Function Sequence", Keyword: "WeightingTransferFunctionSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00701807, Name: "Weighting Lookup Table Descriptor", Keyword: "WeightingLookupTableDescriptor", VR: "US", VM: "3", Retired: true},
+	{Tag: 0x00701808, Name: "Weighting Lookup Table Data", Keyword: "WeightingLookupTableData", VR: "OB", VM: "1", Retired: true},
+	{Tag: 0x00701901, Name: "Volumetric Annotation Sequence", Keyword: "VolumetricAnnotationSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00701903, Name: "Referenced Structured Context Sequence", Keyword: "ReferencedStructuredContextSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00701904, Name: "Referenced Content Item", Keyword: "ReferencedContentItem", VR: "UI", VM: "1"},
+	{Tag: 0x00701905, Name: "Volumetric Presentation Input Annotation Sequence", Keyword: "VolumetricPresentationInputAnnotationSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00701907, Name: "Annotation Clipping", Keyword: "AnnotationClipping", VR: "CS", VM: "1"},
+	{Tag: 0x00701A01, Name: "Presentation Animation Style", Keyword: "PresentationAnimationStyle", VR: "CS", VM: "1"},
+	{Tag: 0x00701A03, Name: "Recommended Animation Rate", Keyword: "RecommendedAnimationRate", VR: "FD", VM: "1"},
+	{Tag: 0x00701A04, Name: "Animation Curve Sequence", Keyword: "AnimationCurveSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00701A05, Name: "Animation Step Size", Keyword: "AnimationStepSize", VR: "FD", VM: "1"},
+	{Tag: 0x00701A06, Name: "Swivel Range", Keyword: "SwivelRange", VR: "FD", VM: "1"},
+	{Tag: 0x00701A07, Name: "Volumetric Curve Up Directions", Keyword: "VolumetricCurveUpDirections", VR: "OD", VM: "1"},
+	{Tag: 0x00701A08, Name: "Volume Stream Sequence", Keyword: "VolumeStreamSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00701A09, Name: "RGBA Transfer Function Description", Keyword: "RGBATransferFunctionDescription", VR: "LO", VM: "1"},
+	{Tag: 0x00701B01, Name: "Advanced Blending Sequence", Keyword: "AdvancedBlendingSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00701B02, Name: "Blending Input Number", Keyword: "BlendingInputNumber", VR: "US", VM: "1"},
+	{Tag: 0x00701B03, Name: "Blending Display Input Sequence", Keyword: "BlendingDisplayInputSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00701B04, Name: "Blending Display Sequence", Keyword: "BlendingDisplaySequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00701B06, Name: "Blending Mode", Keyword: "BlendingMode", VR: "CS", VM: "1"},
+	{Tag: 0x00701B07, Name: "Time Series Blending", Keyword: "TimeSeriesBlending", VR: "CS", VM: "1"},
+	{Tag: 0x00701B08, Name: "Geometry for Display", Keyword: "GeometryForDisplay", VR: "CS", VM: "1"},
+	{Tag: 0x00701B11, Name: "Threshold Sequence", Keyword: "ThresholdSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00701B12, Name: "Threshold Value Sequence", Keyword: "ThresholdValueSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00701B13, Name: "Threshold Type", Keyword: "ThresholdType", VR: "CS", VM: "1"},
+	{Tag: 0x00701B14, Name: "Threshold Value", Keyword: "ThresholdValue", VR: "FD", VM: "1"},
+	{Tag: 0x00720002, Name: "Hanging Protocol Name", Keyword: "HangingProtocolName", VR: "SH", VM: "1"},
+	{Tag: 0x00720004, Name: "Hanging Protocol Description", Keyword: "HangingProtocolDescription", VR: "LO", VM: "1"},
+	{Tag: 0x00720006, Name: "Hanging Protocol Level", Keyword: "HangingProtocolLevel", VR: "CS", VM: "1"},
+	{Tag: 0x00720008, Name: "Hanging Protocol Creator", Keyword: "HangingProtocolCreator", VR: "LO", VM: "1"},
+	{Tag: 0x0072000A, Name: "Hanging Protocol Creation DateTime", Keyword: "HangingProtocolCreationDateTime", VR: "DT", VM: "1"},
+	{Tag: 0x0072000C, Name: "Hanging Protocol Definition Sequence", Keyword: "HangingProtocolDefinitionSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x0072000E, Name: "Hanging Protocol User Identification Code Sequence", Keyword: "HangingProtocolUserIdentificationCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00720010, Name: "Hanging Protocol User Group Name", Keyword: "HangingProtocolUserGroupName", VR: "LO", VM: "1"},
+	{Tag: 0x00720012, Name: "Source Hanging Protocol Sequence", Keyword: "SourceHangingProtocolSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00720014, Name: "Number of Priors Referenced", Keyword: "NumberOfPriorsReferenced", VR: "US", VM: "1"},
+	{Tag: 0x00720020, Name: "Image Sets Sequence", Keyword: "ImageSetsSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00720022, Name: "Image Set Selector Sequence", Keyword: "ImageSetSelectorSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00720024, Name: "Image Set Selector Usage Flag", Keyword: "ImageSetSelectorUsageFlag", VR: "CS", VM: "1"},
+	{Tag: 0x00720026, Name: "Selector Attribute", Keyword: "SelectorAttribute", VR: "AT", VM: "1"},
+	{Tag: 0x00720028, Name: "Selector Value Number", Keyword: "SelectorValueNumber", VR: "US", VM: "1"},
+	{Tag: 0x00720030, Name: "Time Based Image Sets Sequence", Keyword: "TimeBasedImageSetsSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00720032, Name: "Image Set Number", Keyword: "ImageSetNumber", VR: "US", VM: "1"},
+	{Tag: 0x00720034, Name: "Image Set Selector Category", Keyword: "ImageSetSelectorCategory", VR: "CS", VM: "1"},
+	{Tag: 0x00720038, Name: "Relative Time", Keyword: "RelativeTime", VR: "US", VM: "2"},
+	{Tag: 0x0072003A, Name: "Relative Time Units", Keyword: "RelativeTimeUnits", VR: "CS", VM: "1"},
+	{Tag: 0x0072003C, Name: "Abstract Prior Value", Keyword: "AbstractPriorValue", VR: "SS", VM: "2"},
+	{Tag: 0x0072003E, Name: "Abstract Prior Code Sequence", Keyword: "AbstractPriorCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00720040, Name: "Image Set Label", Keyword: "ImageSetLabel", VR: "LO", VM: "1"},
+	{Tag: 0x00720050, Name: "Selector Attribute VR", Keyword: "SelectorAttributeVR", VR: "CS", VM: "1"},
+	{Tag: 0x00720052, Name: "Selector Sequence Pointer", Keyword: "SelectorSequencePointer", VR: "AT", VM: "1-n"},
+	{Tag: 0x00720054, Name: "Selector Sequence Pointer Private Creator", Keyword: "SelectorSequencePointerPrivateCreator", VR: "LO", VM: "1-n"},
+	{Tag: 0x00720056, Name: "Selector Attribute Private Creator", Keyword: "SelectorAttributePrivateCreator", VR: "LO", VM: "1"},
+	{Tag: 0x0072005E, Name: "Selector AE Value", Keyword: "SelectorAEValue", VR: "AE", VM: "1-n"},
+	{Tag: 0x0072005F, Name: "Selector AS Value", Keyword: "SelectorASValue", VR: "AS", VM: "1-n"},
+	{Tag: 0x00720060, Name: "Selector AT Value", Keyword: "SelectorATValue", VR: "AT", VM: "1-n"},
+	{Tag: 0x00720061, Name: "Selector DA Value", Keyword: "SelectorDAValue", VR: "DA", VM: "1-n"},
+	{Tag: 0x00720062, Name: "Selector CS Value", Keyword: "SelectorCSValue", VR: "CS", VM: "1-n"},
+	{Tag: 0x00720063, Name: "Selector DT Value", Keyword: "SelectorDTValue", VR: "DT", VM: "1-n"},
+	{Tag: 0x00720064, Name: "Selector IS Value", Keyword: "SelectorISValue", VR: "IS", VM: "1-n"},
+	{Tag: 0x00720065, Name: "Selector OB Value", Keyword: "SelectorOBValue", VR: "OB", VM: "1"},
+	{Tag: 0x00720066, Name: "Selector LO Value", Keyword: "SelectorLOValue", VR: "LO", VM: "1-n"},
+	{Tag: 0x00720067, Name: "Selector OF Value", Keyword: "SelectorOFValue", VR: "OF", VM: "1"},
+	{Tag: 0x00720068, Name: "Selector LT Value", Keyword: "SelectorLTValue", VR: "LT", VM: "1"},
+	{Tag: 0x00720069, Name: "Selector OW Value", Keyword: "SelectorOWValue", VR: "OW", VM: "1"},
+	{Tag: 0x0072006A, Name: "Selector PN Value", Keyword: "SelectorPNValue", VR: "PN", VM: "1-n"},
+	{Tag: 0x0072006B, Name: "Selector TM Value", Keyword: "SelectorTMValue", VR: "TM", VM: "1-n"},
+	{Tag: 0x0072006C, Name: "Selector SH Value", Keyword: "SelectorSHValue", VR: "SH", VM: "1-n"},
+	{Tag: 0x0072006D, Name: "Selector UN Value", Keyword: "SelectorUNValue", VR: "UN", VM: "1"},
+	{Tag: 0x0072006E, Name: "Selector ST Value", Keyword: "SelectorSTValue", VR: "ST", VM: "1"},
+	{Tag: 0x0072006F, Name: "Selector UC Value", Keyword: "SelectorUCValue", VR: "UC", VM: "1-n"},
+	{Tag: 0x00720070, Name: "Selector UT Value", Keyword: "SelectorUTValue", VR: "UT", VM: "1"},
+	{Tag: 0x00720071, Name: "Selector UR Value", Keyword: "SelectorURValue", VR: "UR", VM: "1"},
+	{Tag: 0x00720072, Name: "Selector DS Value", Keyword: "SelectorDSValue", VR: "DS", VM: "1-n"},
+	{Tag: 0x00720073, Name: "Selector OD Value", Keyword: "SelectorODValue", VR: "OD", VM: "1"},
+	{Tag: 0x00720074, Name: "Selector FD Value", Keyword: "SelectorFDValue", VR: "FD", VM: "1-n"},
+	{Tag: 0x00720075, Name: "Selector OL Value", Keyword: "SelectorOLValue", VR: "OL", VM: "1"},
+	{Tag: 0x00720076, Name: "Selector FL Value", Keyword: "SelectorFLValue", VR: "FL", VM: "1-n"},
+	{Tag: 0x00720078, Name: "Selector UL Value", Keyword: "SelectorULValue", VR: "UL", VM: "1-n"},
+	{Tag: 0x0072007A, Name: "Selector US Value", Keyword: "SelectorUSValue", VR: "US", VM: "1-n"},
+	{Tag: 0x0072007C, Name: "Selector SL Value", Keyword: "SelectorSLValue", VR: "SL", VM: "1-n"},
+	{Tag: 0x0072007E, Name: "Selector SS Value", Keyword: "SelectorSSValue", VR: "SS", VM: "1-n"},
+	{Tag: 0x0072007F, Name: "Selector UI Value", Keyword: "SelectorUIValue", VR: "UI", VM: "1-n"},
+	{Tag: 0x00720080, Name: "Selector Code Sequence Value", Keyword: "SelectorCodeSequenceValue", VR: "SQ", VM: "1"},
+	{Tag: 0x00720081, Name: "Selector OV Value", Keyword: "SelectorOVValue", VR: "OV", VM: "1"},
+	{Tag: 0x00720082, Name: "Selector SV Value", Keyword: "SelectorSVValue", VR: "SV", VM: "1-n"},
+	{Tag: 0x00720083, Name: "Selector UV Value", Keyword: "SelectorUVValue", VR: "UV", VM: "1-n"},
+	{Tag: 0x00720100, Name: "Number of Screens", Keyword: "NumberOfScreens", VR: "US", VM: "1"},
+	{Tag: 0x00720102, Name: "Nominal Screen Definition Sequence", Keyword: "NominalScreenDefinitionSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00720104, Name: "Number of Vertical Pixels", Keyword: "NumberOfVerticalPixels", VR: "US", VM: "1"},
+	{Tag: 0x00720106, Name: "Number of Horizontal Pixels", Keyword: "NumberOfHorizontalPixels", VR: "US", VM: "1"},
+	{Tag: 0x00720108, Name: "Display Environment Spatial Position", Keyword: "DisplayEnvironmentSpatialPosition", VR: "FD", VM: "4"},
+	{Tag: 0x0072010A, Name: "Screen Minimum Grayscale Bit Depth", Keyword: "ScreenMinimumGrayscaleBitDepth", VR: "US", VM: "1"},
+	{Tag: 0x0072010C, Name: "Screen Minimum Color Bit Depth", Keyword: "ScreenMinimumColorBitDepth", VR: "US", VM: "1"},
+	{Tag: 0x0072010E, Name: "Application Maximum Repaint Time", Keyword: "ApplicationMaximumRepaintTime", VR: "US", VM: "1"},
+	{Tag: 0x00720200, Name: "Display Sets Sequence", Keyword: "DisplaySetsSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00720202, Name: "Display Set Number", Keyword: "DisplaySetNumber", VR: "US", VM: "1"},
+	{Tag: 0x00720203, Name: "Display Set Label", Keyword: "DisplaySetLabel", VR: "LO", VM: "1"},
+	{Tag: 0x00720204, Name: "Display Set Presentation Group", Keyword: "DisplaySetPresentationGroup", VR: "US", VM: "1"},
+	{Tag: 0x00720206, Name: "Display Set Presentation Group Description", Keyword: "DisplaySetPresentationGroupDescription", VR: "LO", VM: "1"},
+	{Tag: 0x00720208, Name: "Partial Data Display Handling", Keyword: "PartialDataDisplayHandling", VR: "CS", VM: "1"},
+	{Tag: 0x00720210, Name: "Synchronized Scrolling Sequence", Keyword: "SynchronizedScrollingSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00720212, Name: "Display Set Scrolling Group", Keyword: "DisplaySetScrollingGroup", VR: "US", VM: "2-n"},
+	{Tag: 0x00720214, Name: "Navigation Indicator Sequence", Keyword: "NavigationIndicatorSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00720216, Name: "Navigation Display Set", Keyword: "NavigationDisplaySet", VR: "US", VM: "1"},
+	{Tag: 0x00720218, Name: "Reference Display Sets", Keyword: "ReferenceDisplaySets", VR: "US", VM: "1-n"},
+	{Tag: 0x00720300, Name: "Image Boxes Sequence", Keyword: "ImageBoxesSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00720302, Name: "Image Box Number", Keyword: "ImageBoxNumber", VR: "US", VM: "1"},
+	{Tag: 0x00720304, Name: "Image Box Layout Type", Keyword: "ImageBoxLayoutType", VR: "CS", VM: "1"},
+	{Tag: 0x00720306, Name: "Image Box Tile Horizontal Dimension", Keyword: "ImageBoxTileHorizontalDimension", VR: "US", VM: "1"},
+	{Tag: 0x00720308, Name: "Image Box Tile Vertical Dimension", Keyword: "ImageBoxTileVerticalDimension", VR: "US", VM: "1"},
+	{Tag: 0x00720310, Name: "Image Box Scroll Direction", Keyword: "ImageBoxScrollDirection", VR: "CS", VM: "1"},
+	{Tag: 0x00720312, Name: "Image Box Small Scroll Type", Keyword: "ImageBoxSmallScrollType", VR: "CS", VM: "1"},
+	{Tag: 0x00720314, Name: "Image Box Small Scroll Amount", Keyword: "ImageBoxSmallScrollAmount", VR: "US", VM: "1"},
+	{Tag: 0x00720316, Name: "Image Box Large Scroll Type", Keyword: "ImageBoxLargeScrollType", VR: "CS", VM: "1"},
+	{Tag: 0x00720318, Name: "Image Box Large Scroll Amount", Keyword: "ImageBoxLargeScrollAmount", VR: "US", VM: "1"},
+	{Tag: 0x00720320, Name: "Image Box Overlap Priority", Keyword: "ImageBoxOverlapPriority", VR: "US", VM: "1"},
+	{Tag: 0x00720330, Name: "Cine Relative to Real-Time", Keyword: "CineRelativeToRealTime", VR: "FD", VM: "1"},
+	{Tag: 0x00720400, Name: "Filter Operations Sequence", Keyword: "FilterOperationsSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00720402, Name: "Filter-by Category", Keyword: "FilterByCategory", VR: "CS", VM: "1"},
+	{Tag: 0x00720404, Name: "Filter-by Attribute Presence", Keyword: "FilterByAttributePresence", VR: "CS", VM: "1"},
+	{Tag: 0x00720406, Name: "Filter-by Operator", Keyword: "FilterByOperator", VR: "CS", VM: "1"},
+	{Tag: 0x00720420, Name: "Structured Display Background CIELab Value", Keyword: "StructuredDisplayBackgroundCIELabValue", VR: "US", VM: "3"},
+	{Tag: 0x00720421, Name: "Empty Image Box CIELab Value", Keyword: "EmptyImageBoxCIELabValue", VR: "US", VM: "3"},
+	{Tag: 0x00720422, Name: "Structured Display Image Box Sequence", Keyword: "StructuredDisplayImageBoxSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00720424, Name: "Structured Display Text Box Sequence", Keyword: "StructuredDisplayTextBoxSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00720427, Name: "Referenced First Frame Sequence", Keyword: "ReferencedFirstFrameSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00720430, Name: "Image Box Synchronization Sequence", Keyword: "ImageBoxSynchronizationSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00720432, Name: "Synchronized Image Box List", Keyword: "SynchronizedImageBoxList", VR: "US", VM: "2-n"},
+	{Tag: 0x00720434, Name: "Type of Synchronization", Keyword: "TypeOfSynchronization", VR: "CS", VM: "1"},
+	{Tag: 0x00720500, Name: "Blending Operation Type", Keyword: "BlendingOperationType", VR: "CS", VM: "1"},
+	{Tag: 0x00720510, Name: "Reformatting Operation Type", Keyword: "ReformattingOperationType", VR: "CS", VM: "1"},
+	{Tag: 0x00720512, Name: "Reformatting Thickness", Keyword: "ReformattingThickness", VR: "FD", VM: "1"},
+	{Tag: 0x00720514, Name: "Reformatting Interval", Keyword: "ReformattingInterval", VR: "FD", VM: "1"},
+	{Tag: 0x00720516, Name: "Reformatting Operation Initial View Direction", Keyword: "ReformattingOperationInitialViewDirection", VR: "CS", VM: "1"},
+	{Tag: 0x00720520, Name: "3D Rendering Type", Keyword: "ThreeDRenderingType", VR: "CS", VM: "1-n"},
+	{Tag: 0x00720600, Name: "Sorting Operations Sequence", Keyword: "SortingOperationsSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00720602, Name: "Sort-by Category", Keyword: "SortByCategory", VR: "CS", VM: "1"},
+	{Tag: 0x00720604, Name: "Sorting Direction", Keyword: "SortingDirection", VR: "CS", VM: "1"},
+	{Tag: 0x00720700, Name: "Display Set Patient Orientation", Keyword: "DisplaySetPatientOrientation", VR: "CS", VM: "2"},
+	{Tag: 0x00720702, Name: "VOI Type", Keyword: "VOIType", VR: "CS", VM: "1"},
+	{Tag: 0x00720704, Name: "Pseudo-Color Type", Keyword: "PseudoColorType", VR: "CS", VM: "1"},
+	{Tag: 0x00720705, Name: "Pseudo-Color Palette Instance Reference Sequence", Keyword: "PseudoColorPaletteInstanceReferenceSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00720706, Name: "Show Grayscale Inverted", Keyword: "ShowGrayscaleInverted", VR: "CS", VM: "1"},
+	{Tag: 0x00720710, Name: "Show Image True Size Flag", Keyword: "ShowImageTrueSizeFlag", VR: "CS", VM: "1"},
+	{Tag: 0x00720712, Name: "Show Graphic Annotation Flag", Keyword: "ShowGraphicAnnotationFlag", VR: "CS", VM: "1"},
+	{Tag: 0x00720714, Name: "Show Patient Demographics Flag", Keyword: "ShowPatientDemographicsFlag", VR: "CS", VM: "1"},
+	{Tag: 0x00720716, Name: "Show Acquisition Techniques Flag", Keyword: "ShowAcquisitionTechniquesFlag", VR: "CS", VM: "1"},
+	{Tag: 0x00720717, Name: "Display Set Horizontal Justification", Keyword: "DisplaySetHorizontalJustification", VR: "CS", VM: "1"},
+	{Tag: 0x00720718, Name: "Display Set Vertical Justification", Keyword: "DisplaySetVerticalJustification", VR: "CS", VM: "1"},
+	{Tag: 0x00740120, Name: "Continuation Start Meterset", Keyword: "ContinuationStartMeterset", VR: "FD", VM: "1"},
+	{Tag: 0x00740121, Name: "Continuation End Meterset", Keyword: "ContinuationEndMeterset", VR: "FD", VM: "1"},
+	{Tag: 0x00741000, Name: "Procedure Step State", Keyword: "ProcedureStepState", VR: "CS", VM: "1"},
+	{Tag: 0x00741002, Name: "Procedure Step Progress Information Sequence", Keyword: "ProcedureStepProgressInformationSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00741004, Name: "Procedure Step Progress", Keyword: "ProcedureStepProgress", VR: "DS", VM: "1"},
+	{Tag: 0x00741006, Name: "Procedure Step Progress Description", Keyword: "ProcedureStepProgressDescription", VR: "ST", VM: "1"},
+	{Tag: 0x00741007, Name: "Procedure Step Progress Parameters Sequence", Keyword: "ProcedureStepProgressParametersSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00741008, Name: "Procedure Step Communications URI Sequence", Keyword: "ProcedureStepCommunicationsURISequence", VR: "SQ", VM: "1"},
+	{Tag: 0x0074100A, Name: "Contact URI", Keyword: "ContactURI", VR: "UR", VM: "1"},
+	{Tag: 0x0074100C, Name: "Contact Display Name", Keyword: "ContactDisplayName", VR: "LO", VM: "1"},
+	{Tag: 0x0074100E, Name: "Procedure Step Discontinuation Reason Code Sequence", Keyword: "ProcedureStepDiscontinuationReasonCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00741020, Name: "Beam Task Sequence", Keyword: "BeamTaskSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00741022, Name: "Beam Task Type", Keyword: "BeamTaskType", VR: "CS", VM: "1"},
+	{Tag: 0x00741024, Name: "Beam Order Index (Trial)", Keyword: "BeamOrderIndexTrial", VR: "IS", VM: "1", Retired: true},
+	{Tag: 0x00741025, Name: "Autosequence Flag", Keyword: "AutosequenceFlag", VR: "CS", VM: "1"},
+	{Tag: 0x00741026, Name: "Table Top Vertical Adjusted Position", Keyword: "TableTopVerticalAdjustedPosition", VR: "FD", VM: "1"},
+	{Tag: 0x00741027, Name: "Table Top Longitudinal Adjusted Position", Keyword: "TableTopLongitudinalAdjustedPosition", VR: "FD", VM: "1"},
+	{Tag: 0x00741028, Name: "Table Top Lateral Adjusted Position", Keyword: "TableTopLateralAdjustedPosition", VR: "FD", VM: "1"},
+	{Tag: 0x0074102A, Name: "Patient Support Adjusted Angle", Keyword: "PatientSupportAdjustedAngle", VR: "FD", VM: "1"},
+	{Tag: 0x0074102B, Name: "Table Top Eccentric Adjusted Angle", Keyword: "TableTopEccentricAdjustedAngle", VR: "FD", VM: "1"},
+	{Tag: 0x0074102C, Name: "Table Top Pitch Adjusted Angle", Keyword: "TableTopPitchAdjustedAngle", VR: "FD", VM: "1"},
+	{Tag: 0x0074102D, Name: "Table Top Roll Adjusted Angle", Keyword: "TableTopRollAdjustedAngle", VR: "FD", VM: "1"},
+	{Tag: 0x00741030, Name: "Delivery Verification Image Sequence", Keyword: "DeliveryVerificationImageSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00741032, Name: "Verification Image Timing", Keyword: "VerificationImageTiming", VR: "CS", VM: "1"},
+	{Tag: 0x00741034, Name: "Double Exposure Flag", Keyword: "DoubleExposureFlag", VR: "CS", VM: "1"},
+	{Tag: 0x00741036, Name: "Double Exposure Ordering", Keyword: "DoubleExposureOrdering", VR: "CS", VM: "1"},
+	{Tag: 0x00741038, Name: "Double Exposure Meterset (Trial)", Keyword: "DoubleExposureMetersetTrial", VR: "DS", VM: "1", Retired: true},
+	{Tag: 0x0074103A, Name: "Double Exposure Field Delta (Trial)", Keyword: "DoubleExposureFieldDeltaTrial", VR: "DS", VM: "4", Retired: true},
+	{Tag: 0x00741040, Name: "Related Reference RT Image Sequence", Keyword: "RelatedReferenceRTImageSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00741042, Name: "General Machine Verification Sequence", Keyword: "GeneralMachineVerificationSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00741044, Name: "Conventional Machine Verification Sequence", Keyword: "ConventionalMachineVerificationSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00741046, Name: "Ion Machine Verification Sequence", Keyword: "IonMachineVerificationSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00741048, Name: "Failed Attributes Sequence", Keyword: "FailedAttributesSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x0074104A, Name: "Overridden Attributes Sequence", Keyword: "OverriddenAttributesSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x0074104C, Name: "Conventional Control Point Verification Sequence", Keyword: "ConventionalControlPointVerificationSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x0074104E, Name: "Ion Control Point Verification Sequence", Keyword: "IonControlPointVerificationSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00741050, Name: "Attribute Occurrence Sequence", Keyword: "AttributeOccurrenceSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00741052, Name: "Attribute Occurrence Pointer", Keyword: "AttributeOccurrencePointer", VR: "AT", VM: "1"},
+	{Tag: 0x00741054, Name: "Attribute Item Selector", Keyword: "AttributeItemSelector", VR: "UL", VM: "1"},
+	{Tag: 0x00741056, Name: "Attribute Occurrence Private Creator", Keyword: "AttributeOccurrencePrivateCreator", VR: "LO", VM: "1"},
+	{Tag: 0x00741057, Name: "Selector Sequence Pointer Items", Keyword: "SelectorSequencePointerItems", VR: "IS", VM: "1-n"},
+	{Tag: 0x00741200, Name: "Scheduled Procedure Step Priority", Keyword: "ScheduledProcedureStepPriority", VR: "CS", VM: "1"},
+	{Tag: 0x00741202, Name: "Worklist Label", Keyword: "WorklistLabel", VR: "LO", VM: "1"},
+	{Tag: 0x00741204, Name: "Procedure Step Label", Keyword: "ProcedureStepLabel", VR: "LO", VM: "1"},
+	{Tag: 0x00741210, Name: "Scheduled Processing Parameters Sequence", Keyword: "ScheduledProcessingParametersSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00741212, Name: "Performed Processing Parameters Sequence", Keyword: "PerformedProcessingParametersSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00741216, Name: "Unified Procedure Step Performed Procedure Sequence", Keyword: "UnifiedProcedureStepPerformedProcedureSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00741220, Name: "Related Procedure Step Sequence", Keyword: "RelatedProcedureStepSequence", VR: "SQ", VM: "1", Retired: true},
+	{Tag: 0x00741222, Name: "Procedure Step Relationship Type", Keyword: "ProcedureStepRelationshipType", VR: "LO", VM: "1", Retired: true},
+	{Tag: 0x00741224, Name: "Replaced Procedure Step Sequence", Keyword: "ReplacedProcedureStepSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00741230, Name: "Deletion Lock", Keyword: "DeletionLock", VR: "LO", VM: "1"},
+	{Tag: 0x00741234, Name: "Receiving AE", Keyword: "ReceivingAE", VR: "AE", VM: "1"},
+	{Tag: 0x00741236, Name: "Requesting AE", Keyword: "RequestingAE", VR: "AE", VM: "1"},
+	{Tag: 0x00741238, Name: "Reason for Cancellation", Keyword: "ReasonForCancellation", VR: "LT", VM: "1"},
+	{Tag: 0x00741242, Name: "SCP Status", Keyword: "SCPStatus", VR: "CS", VM: "1"},
+	{Tag: 0x00741244, Name: "Subscription List Status", Keyword: "SubscriptionListStatus", VR: "CS", VM: "1"},
+	{Tag: 0x00741246, Name: "Unified Procedure Step List Status", Keyword: "UnifiedProcedureStepListStatus", VR: "CS", VM: "1"},
+	{Tag: 0x00741324, Name: "Beam Order Index", Keyword: "BeamOrderIndex", VR: "UL", VM: "1"},
+	{Tag: 0x00741338, Name: "Double Exposure Meterset", Keyword: "DoubleExposureMeterset", VR: "FD", VM: "1"},
+	{Tag: 0x0074133A, Name: "Double Exposure Field Delta", Keyword: "DoubleExposureFieldDelta", VR: "FD", VM: "4"},
+	{Tag: 0x00741401, Name: "Brachy Task Sequence", Keyword: "BrachyTaskSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00741402, Name: "Continuation Start Total Reference Air Kerma", Keyword: "ContinuationStartTotalReferenceAirKerma", VR: "DS", VM: "1"},
+	{Tag: 0x00741403, Name: "Continuation End Total Reference Air Kerma", Keyword: "ContinuationEndTotalReferenceAirKerma", VR: "DS", VM: "1"},
+	{Tag: 0x00741404, Name: "Continuation Pulse Number", Keyword: "ContinuationPulseNumber", VR: "IS", VM: "1"},
+	{Tag: 0x00741405, Name: "Channel Delivery Order Sequence", Keyword: "ChannelDeliveryOrderSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00741406, Name: "Referenced Channel Number", Keyword: "ReferencedChannelNumber", VR: "IS", VM: "1"},
+	{Tag: 0x00741407, Name: "Start Cumulative Time Weight", Keyword: "StartCumulativeTimeWeight", VR: "DS", VM: "1"},
+	{Tag: 0x00741408, Name: "End Cumulative Time Weight", Keyword: "EndCumulativeTimeWeight", VR: "DS", VM: "1"},
+	{Tag: 0x00741409, Name: "Omitted Channel Sequence", Keyword: "OmittedChannelSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x0074140A, Name: "Reason for Channel Omission", Keyword: "ReasonForChannelOmission", VR: "CS", VM: "1"},
+	{Tag: 0x0074140B, Name: "Reason for Channel Omission Description", Keyword: "ReasonForChannelOmissionDescription", VR: "LO", VM: "1"},
+	{Tag: 0x0074140C, Name: "Channel Delivery Order Index", Keyword: "ChannelDeliveryOrderIndex", VR: "IS", VM: "1"},
+	{Tag: 0x0074140D, Name: "Channel Delivery Continuation Sequence", Keyword: "ChannelDeliveryContinuationSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x0074140E, Name: "Omitted Application Setup Sequence", Keyword: "OmittedApplicationSetupSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00760001, Name: "Implant Assembly Template Name", Keyword: "ImplantAssemblyTemplateName", VR: "LO", VM: "1"},
+	{Tag: 0x00760003, Name: "Implant Assembly Template Issuer", Keyword: "ImplantAssemblyTemplateIssuer", VR: "LO", VM: "1"},
+	{Tag: 0x00760006, Name: "Implant Assembly Template Version", Keyword: "ImplantAssemblyTemplateVersion", VR: "LO", VM: "1"},
+	{Tag: 0x00760008, Name: "Replaced Implant Assembly Template Sequence", Keyword: "ReplacedImplantAssemblyTemplateSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x0076000A, Name: "Implant Assembly Template Type", Keyword: "ImplantAssemblyTemplateType", VR: "CS", VM: "1"},
+	{Tag: 0x0076000C, Name: "Original Implant Assembly Template Sequence", Keyword: "OriginalImplantAssemblyTemplateSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x0076000E, Name: "Derivation Implant Assembly Template Sequence", Keyword: "DerivationImplantAssemblyTemplateSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00760010, Name: "Implant Assembly Template Target Anatomy Sequence", Keyword: "ImplantAssemblyTemplateTargetAnatomySequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00760020, Name: "Procedure Type Code Sequence", Keyword: "ProcedureTypeCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00760030, Name: "Surgical Technique", Keyword: "SurgicalTechnique", VR: "LO", VM: "1"},
+	{Tag: 0x00760032, Name: "Component Types Sequence", Keyword: "ComponentTypesSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00760034, Name: "Component Type Code Sequence", Keyword: "ComponentTypeCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00760036, Name: "Exclusive Component Type", Keyword: "ExclusiveComponentType", VR: "CS", VM: "1"},
+	{Tag: 0x00760038, Name: "Mandatory Component Type", Keyword: "MandatoryComponentType", VR: "CS", VM: "1"},
+	{Tag: 0x00760040, Name: "Component Sequence", Keyword: "ComponentSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00760055, Name: "Component ID", Keyword: "ComponentID", VR: "US", VM: "1"},
+	{Tag: 0x00760060, Name: "Component Assembly Sequence", Keyword: "ComponentAssemblySequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00760070, Name: "Component 1 Referenced ID", Keyword: "Component1ReferencedID", VR: "US", VM: "1"},
+	{Tag: 0x00760080, Name: "Component 1 Referenced Mating Feature Set ID", Keyword: "Component1ReferencedMatingFeatureSetID", VR: "US", VM: "1"},
+	{Tag: 0x00760090, Name: "Component 1 Referenced Mating Feature ID", Keyword: "Component1ReferencedMatingFeatureID", VR: "US", VM: "1"},
+	{Tag: 0x007600A0, Name: "Component 2 Referenced ID", Keyword: "Component2ReferencedID", VR: "US", VM: "1"},
+	{Tag: 0x007600B0, Name: "Component 2 Referenced Mating Feature Set ID", Keyword: "Component2ReferencedMatingFeatureSetID", VR: "US", VM: "1"},
+	{Tag: 0x007600C0, Name: "Component 2 Referenced Mating Feature ID", Keyword: "Component2ReferencedMatingFeatureID", VR: "US", VM: "1"},
+	{Tag: 0x00780001, Name: "Implant Template Group Name", Keyword: "ImplantTemplateGroupName", VR: "LO", VM: "1"},
+	{Tag: 0x00780010, Name: "Implant Template Group Description", Keyword: "ImplantTemplateGroupDescription", VR: "ST", VM: "1"},
+	{Tag: 0x00780020, Name: "Implant Template Group Issuer", Keyword: "ImplantTemplateGroupIssuer", VR: "LO", VM: "1"},
+	{Tag: 0x00780024, Name: "Implant Template Group Version", Keyword: "ImplantTemplateGroupVersion", VR: "LO", VM: "1"},
+	{Tag: 0x00780026, Name: "Replaced Implant Template Group Sequence", Keyword: "ReplacedImplantTemplateGroupSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00780028, Name: "Implant Template Group Target Anatomy Sequence", Keyword: "ImplantTemplateGroupTargetAnatomySequence", VR: "SQ", VM: "1"},
+	{Tag: 0x0078002A, Name: "Implant Template Group Members Sequence", Keyword: "ImplantTemplateGroupMembersSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x0078002E, Name: "Implant Template Group Member ID", Keyword: "ImplantTemplateGroupMemberID", VR: "US", VM: "1"},
+	{Tag: 0x00780050, Name: "3D Implant Template Group Member Matching Point", Keyword: "ThreeDImplantTemplateGroupMemberMatchingPoint", VR: "FD", VM: "3"},
+	{Tag: 0x00780060, Name: "3D Implant Template Group Member Matching Axes", Keyword: "ThreeDImplantTemplateGroupMemberMatchingAxes", VR: "FD", VM: "9"},
+	{Tag: 0x00780070, Name: "Implant Template Group Member Matching 2D Coordinates Sequence", Keyword: "ImplantTemplateGroupMemberMatching2DCoordinatesSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00780090, Name: "2D Implant Template Group Member Matching Point", Keyword: "TwoDImplantTemplateGroupMemberMatchingPoint", VR: "FD", VM: "2"},
+	{Tag: 0x007800A0, Name: "2D Implant Template Group Member Matching Axes", Keyword: "TwoDImplantTemplateGroupMemberMatchingAxes", VR: "FD", VM: "4"},
+	{Tag: 0x007800B0, Name: "Implant Template Group Variation Dimension Sequence", Keyword: "ImplantTemplateGroupVariationDimensionSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x007800B2, Name: "Implant Template Group Variation Dimension Name", Keyword: "ImplantTemplateGroupVariationDimensionName", VR: "LO", VM: "1"},
+	{Tag: 0x007800B4, Name: "Implant Template Group Variation Dimension Rank Sequence", Keyword: "ImplantTemplateGroupVariationDimensionRankSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x007800B6, Name: "Referenced Implant Template Group Member ID", Keyword: "ReferencedImplantTemplateGroupMemberID", VR: "US", VM: "1"},
+	{Tag: 0x007800B8, Name: "Implant Template Group Variation Dimension Rank", Keyword: "ImplantTemplateGroupVariationDimensionRank", VR: "US", VM: "1"},
+	{Tag: 0x00800001, Name: "Surface Scan Acquisition Type Code Sequence", Keyword: "SurfaceScanAcquisitionTypeCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00800002, Name: "Surface Scan Mode Code Sequence", Keyword: "SurfaceScanModeCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00800003, Name: "Registration Method Code Sequence", Keyword: "RegistrationMethodCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00800004, Name: "Shot Duration Time", Keyword: "ShotDurationTime", VR: "FD", VM: "1"},
+	{Tag: 0x00800005, Name: "Shot Offset Time", Keyword: "ShotOffsetTime", VR: "FD", VM: "1"},
+	{Tag: 0x00800006, Name: "Surface Point Presentation Value Data", Keyword: "SurfacePointPresentationValueData", VR: "US", VM: "1-n"},
+	{Tag: 0x00800007, Name: "Surface Point Color CIELab Value Data", Keyword: "SurfacePointColorCIELabValueData", VR: "US", VM: "3-3n"},
+	{Tag: 0x00800008, Name: "UV Mapping Sequence", Keyword: "UVMappingSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00800009, Name: "Texture Label", Keyword: "TextureLabel", VR: "SH", VM: "1"},
+	{Tag: 0x00800010, Name: "U Value Data", Keyword: "UValueData", VR: "OF", VM: "1"},
+	{Tag: 0x00800011, Name: "V Value Data", Keyword: "VValueData", VR: "OF", VM: "1"},
+	{Tag: 0x00800012, Name: "Referenced Texture Sequence", Keyword: "ReferencedTextureSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00800013, Name: "Referenced Surface Data Sequence", Keyword: "ReferencedSurfaceDataSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00820001, Name: "Assessment Summary", Keyword: "AssessmentSummary", VR: "CS", VM: "1"},
+	{Tag: 0x00820003, Name: "Assessment Summary Description", Keyword: "AssessmentSummaryDescription", VR: "UT", VM: "1"},
+	{Tag: 0x00820004, Name: "Assessed SOP Instance Sequence", Keyword: "AssessedSOPInstanceSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00820005, Name: "Referenced Comparison SOP Instance Sequence", Keyword: "ReferencedComparisonSOPInstanceSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00820006, Name: "Number of Assessment Observations", Keyword: "NumberOfAssessmentObservations", VR: "UL", VM: "1"},
+	{Tag: 0x00820007, Name: "Assessment Observations Sequence", Keyword: "AssessmentObservationsSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00820008, Name: "Observation Significance", Keyword: "ObservationSignificance", VR: "CS", VM: "1"},
+	{Tag: 0x0082000A, Name: "Observation Description", Keyword: "ObservationDescription", VR: "UT", VM: "1"},
+	{Tag: 0x0082000C, Name: "Structured Constraint Observation Sequence", Keyword: "StructuredConstraintObservationSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00820010, Name: "Assessed Attribute Value Sequence", Keyword: "AssessedAttributeValueSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00820016, Name: "Assessment Set ID", Keyword: "AssessmentSetID", VR: "LO", VM: "1"},
+	{Tag: 0x00820017, Name: "Assessment Requester Sequence", Keyword: "AssessmentRequesterSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00820018, Name: "Selector Attribute Name", Keyword: "SelectorAttributeName", VR: "LO", VM: "1"},
+	{Tag: 0x00820019, Name: "Selector Attribute Keyword", Keyword: "SelectorAttributeKeyword", VR: "LO", VM: "1"},
+	{Tag: 0x00820021, Name: "Assessment Type Code Sequence", Keyword: "AssessmentTypeCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00820022, Name: "Observation Basis Code Sequence", Keyword: "ObservationBasisCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00820023, Name: "Assessment Label", Keyword: "AssessmentLabel", VR: "LO", VM: "1"},
+	{Tag: 0x00820032, Name: "Constraint Type", Keyword: "ConstraintType", VR: "CS", VM: "1"},
+	{Tag: 0x00820033, Name: "Specification Selection Guidance", Keyword: "SpecificationSelectionGuidance", VR: "UT", VM: "1"},
+	{Tag: 0x00820034, Name: "Constraint Value Sequence", Keyword: "ConstraintValueSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00820035, Name: "Recommended Default Value Sequence", Keyword: "RecommendedDefaultValueSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00820036, Name: "Constraint Violation Significance", Keyword: "ConstraintViolationSignificance", VR: "CS", VM: "1"},
+	{Tag: 0x00820037, Name: "Constraint Violation Condition", Keyword: "ConstraintViolationCondition", VR: "UT", VM: "1"},
+	{Tag: 0x00820038, Name: "Modifiable Constraint Flag", Keyword: "ModifiableConstraintFlag", VR: "CS", VM: "1"},
+	{Tag: 0x00880130, Name: "Storage Media File-set ID", Keyword: "StorageMediaFileSetID", VR: "SH", VM: "1"},
+	{Tag: 0x00880140, Name: "Storage Media File-set UID", Keyword: "StorageMediaFileSetUID", VR: "UI", VM: "1"},
+	{Tag: 0x00880200, Name: "Icon Image Sequence", Keyword: "IconImageSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x00880904, Name: "Topic Title", Keyword: "TopicTitle", VR: "LO", VM: "1", Retired: true},
+	{Tag: 0x00880906, Name: "Topic Subject", Keyword: "TopicSubject", VR: "ST", VM: "1", Retired: true},
+	{Tag: 0x00880910, Name: "Topic Author", Keyword: "TopicAuthor", VR: "LO", VM: "1", Retired: true},
+	{Tag: 0x00880912, Name: "Topic Keywords", Keyword: "TopicKeywords", VR: "LO", VM: "1-32", Retired: true},
+	{Tag: 0x01000410, Name: "SOP Instance Status", Keyword: "SOPInstanceStatus", VR: "CS", VM: "1"},
+	{Tag: 0x01000420, Name: "SOP Authorization DateTime", Keyword: "SOPAuthorizationDateTime", VR: "DT", VM: "1"},
+	{Tag: 0x01000424, Name: "SOP Authorization Comment", Keyword: "SOPAuthorizationComment", VR: "LT", VM: "1"},
+	{Tag: 0x01000426, Name: "Authorization Equipment Certification Number", Keyword: "AuthorizationEquipmentCertificationNumber", VR: "LO", VM: "1"},
+	{Tag: 0x04000005, Name: "MAC ID Number", Keyword: "MACIDNumber", VR: "US", VM: "1"},
+	{Tag: 0x04000010, Name: "MAC Calculation Transfer Syntax UID", Keyword: "MACCalculationTransferSyntaxUID", VR: "UI", VM: "1"},
+	{Tag: 0x04000015, Name: "MAC Algorithm", Keyword: "MACAlgorithm", VR: "CS", VM: "1"},
+	{Tag: 0x04000020, Name: "Data Elements Signed", Keyword: "DataElementsSigned", VR: "AT", VM: "1-n"},
+	{Tag: 0x04000100, Name: "Digital Signature UID", Keyword: "DigitalSignatureUID", VR: "UI", VM: "1"},
+	{Tag: 0x04000105, Name: "Digital Signature DateTime", Keyword: "DigitalSignatureDateTime", VR: "DT", VM: "1"},
+	{Tag: 0x04000110, Name: "Certificate Type", Keyword: "CertificateType", VR: "CS", VM: "1"},
+	{Tag: 0x04000115, Name: "Certificate of Signer", Keyword: "CertificateOfSigner", VR: "OB", VM: "1"},
+	{Tag: 0x04000120, Name: "Signature", Keyword: "Signature", VR: "OB", VM: "1"},
+	{Tag: 0x04000305, Name: "Certified Timestamp Type", Keyword: "CertifiedTimestampType", VR: "CS", VM: "1"},
+	{Tag: 0x04000310, Name: "Certified Timestamp", Keyword: "CertifiedTimestamp", VR: "OB", VM: "1"},
+	{Tag: 0x04000401, Name: "Digital Signature Purpose Code Sequence", Keyword: "DigitalSignaturePurposeCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x04000402, Name: "Referenced Digital Signature Sequence", Keyword: "ReferencedDigitalSignatureSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x04000403, Name: "Referenced SOP Instance MAC Sequence", Keyword: "ReferencedSOPInstanceMACSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x04000404, Name: "MAC", Keyword: "MAC", VR: "OB", VM: "1"},
+	{Tag: 0x04000500, Name: "Encrypted Attributes Sequence", Keyword: "EncryptedAttributesSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x04000510, Name: "Encrypted Content Transfer Syntax UID", Keyword: "EncryptedContentTransferSyntaxUID", VR: "UI", VM: "1"},
+	{Tag: 0x04000520, Name: "Encrypted Content", Keyword: "EncryptedContent", VR: "OB", VM: "1"},
+	{Tag: 0x04000550, Name: "Modified Attributes Sequence", Keyword: "ModifiedAttributesSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x04000551, Name: "Nonconforming Modified Attributes Sequence", Keyword: "NonconformingModifiedAttributesSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x04000552, Name: "Nonconforming Data Element Value", Keyword: "NonconformingDataElementValue", VR: "OB", VM: "1"},
+	{Tag: 0x04000561, Name: "Original Attributes Sequence", Keyword: "OriginalAttributesSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x04000562, Name: "Attribute Modification DateTime", Keyword: "AttributeModificationDateTime", VR: "DT", VM: "1"},
+	{Tag: 0x04000563, Name: "Modifying System", Keyword: "ModifyingSystem", VR: "LO", VM: "1"},
+	{Tag: 0x04000564, Name: "Source of Previous Values", Keyword: "SourceOfPreviousValues", VR: "LO", VM: "1"},
+	{Tag: 0x04000565, Name: "Reason for the Attribute Modification", Keyword: "ReasonForTheAttributeModification", VR: "CS", VM: "1"},
+	{Tag: 0x04000600, Name: "Instance Origin Status", Keyword: "InstanceOriginStatus", VR: "CS", VM: "1"},
+	{Tag: 0x20000010, Name: "Number of Copies", Keyword: "NumberOfCopies", VR: "IS", VM: "1"},
+	{Tag: 0x2000001E, Name: "Printer Configuration Sequence", Keyword: "PrinterConfigurationSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x20000020, Name: "Print Priority", Keyword: "PrintPriority", VR: "CS", VM: "1"},
+	{Tag: 0x20000030, Name: "Medium Type", Keyword: "MediumType", VR: "CS", VM: "1"},
+	{Tag: 0x20000040, Name: "Film Destination", Keyword: "FilmDestination", VR: "CS", VM: "1"},
+	{Tag: 0x20000050, Name: "Film Session Label", Keyword: "FilmSessionLabel", VR: "LO", VM: "1"},
+	{Tag: 0x20000060, Name: "Memory Allocation", Keyword: "MemoryAllocation", VR: "IS", VM: "1"},
+	{Tag: 0x20000061, Name: "Maximum Memory Allocation", Keyword: "MaximumMemoryAllocation", VR: "IS", VM: "1"},
+	{Tag: 0x20000062, Name: "Color Image Printing Flag", Keyword: "ColorImagePrintingFlag", VR: "CS", VM: "1", Retired: true},
+	{Tag: 0x20000063, Name: "Collation Flag", Keyword: "CollationFlag", VR: "CS", VM: "1", Retired: true},
+	{Tag: 0x20000065, Name: "Annotation Flag", Keyword: "AnnotationFlag", VR: "CS", VM: "1", Retired: true},
+	{Tag: 0x20000067, Name: "Image Overlay Flag", Keyword: "ImageOverlayFlag", VR: "CS", VM: "1", Retired: true},
+	{Tag: 0x20000069, Name: "Presentation LUT Flag", Keyword: "PresentationLUTFlag", VR: "CS", VM: "1", Retired: true},
+	{Tag: 0x2000006A, Name: "Image Box Presentation LUT Flag", Keyword: "ImageBoxPresentationLUTFlag", VR: "CS", VM: "1", Retired: true},
+	{Tag: 0x200000A0, Name: "Memory Bit Depth", Keyword: "MemoryBitDepth", VR: "US", VM: "1"},
+	{Tag: 0x200000A1, Name: "Printing Bit Depth", Keyword: "PrintingBitDepth", VR: "US", VM: "1"},
+	{Tag: 0x200000A2, Name: "Media Installed Sequence", Keyword: "MediaInstalledSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x200000A4, Name: "Other Media Available Sequence", Keyword: "OtherMediaAvailableSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x200000A8, Name: "Supported Image Display Formats Sequence", Keyword: "SupportedImageDisplayFormatsSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x20000500, Name: "Referenced Film Box Sequence", Keyword: "ReferencedFilmBoxSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x20000510, Name: "Referenced Stored Print Sequence", Keyword: "ReferencedStoredPrintSequence", VR: "SQ", VM: "1", Retired: true},
+	{Tag: 0x20100010, Name: "Image Display Format", Keyword: "ImageDisplayFormat", VR: "ST", VM: "1"},
+	{Tag: 0x20100030, Name: "Annotation Display Format ID", Keyword: "AnnotationDisplayFormatID", VR: "CS", VM: "1"},
+	{Tag: 0x20100040, Name: "Film Orientation", Keyword: "FilmOrientation", VR: "CS", VM: "1"},
+	{Tag: 0x20100050, Name: "Film Size ID", Keyword: "FilmSizeID", VR: "CS", VM: "1"},
+	{Tag: 0x20100052, Name: "Printer Resolution ID", Keyword: "PrinterResolutionID", VR: "CS", VM: "1"},
+	{Tag: 0x20100054, Name: "Default Printer Resolution ID", Keyword: "DefaultPrinterResolutionID", VR: "CS", VM: "1"},
+	{Tag: 0x20100060, Name: "Magnification Type", Keyword: "MagnificationType", VR: "CS", VM: "1"},
+	{Tag: 0x20100080, Name: "Smoothing Type", Keyword: "SmoothingType", VR: "CS", VM: "1"},
+	{Tag: 0x201000A6, Name: "Default Magnification Type", Keyword: "DefaultMagnificationType", VR: "CS", VM: "1"},
+	{Tag: 0x201000A7, Name: "Other Magnification Types Available", Keyword: "OtherMagnificationTypesAvailable", VR: "CS", VM: "1-n"},
+	{Tag: 0x201000A8, Name: "Default Smoothing Type", Keyword: "DefaultSmoothingType", VR: "CS", VM: "1"},
+	{Tag: 0x201000A9, Name: "Other Smoothing Types Available", Keyword: "OtherSmoothingTypesAvailable", VR: "CS", VM: "1-n"},
+	{Tag: 0x20100100, Name: "Border Density", Keyword: "BorderDensity", VR: "CS", VM: "1"},
+	{Tag: 0x20100110, Name: "Empty Image Density", Keyword: "EmptyImageDensity", VR: "CS", VM: "1"},
+	{Tag: 0x20100120, Name: "Min Density", Keyword: "MinDensity", VR: "US", VM: "1"},
+	{Tag: 0x20100130, Name: "Max Density", Keyword: "MaxDensity", VR: "US", VM: "1"},
+	{Tag: 0x20100140, Name: "Trim", Keyword: "Trim", VR: "CS", VM: "1"},
+	{Tag: 0x20100150, Name: "Configuration Information", Keyword: "ConfigurationInformation", VR: "ST", VM: "1"},
+	{Tag: 0x20100152, Name: "Configuration Information Description", Keyword: "ConfigurationInformationDescription", VR: "LT", VM: "1"},
+	{Tag: 0x20100154, Name: "Maximum Collated Films", Keyword: "MaximumCollatedFilms", VR: "IS", VM: "1"},
+	{Tag: 0x2010015E, Name: "Illumination", Keyword: "Illumination", VR: "US", VM: "1"},
+	{Tag: 0x20100160, Name: "Reflected Ambient Light", Keyword: "ReflectedAmbientLight", VR: "US", VM: "1"},
+	{Tag: 0x20100376, Name: "Printer Pixel Spacing", Keyword: "PrinterPixelSpacing", VR: "DS", VM: "2"},
+	{Tag: 0x20100500, Name: "Referenced Film Session Sequence", Keyword: "ReferencedFilmSessionSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x20100510, Name: "Referenced Image Box Sequence", Keyword: "ReferencedImageBoxSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x20100520, Name: "Referenced Basic Annotation Box Sequence", Keyword: "ReferencedBasicAnnotationBoxSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x20200010, Name: "Image Box Position", Keyword: "ImageBoxPosition", VR: "US", VM: "1"},
+	{Tag: 0x20200020, Name: "Polarity", Keyword: "Polarity", VR: "CS", VM: "1"},
+	{Tag: 0x20200030, Name: "Requested Image Size", Keyword: "RequestedImageSize", VR: "DS", VM: "1"},
+	{Tag: 0x20200040, Name: "Requested Decimate/Crop Behavior", Keyword: "RequestedDecimateCropBehavior", VR: "CS", VM: "1"},
+	{Tag: 0x20200050, Name: "Requested Resolution ID", Keyword: "RequestedResolutionID", VR: "CS", VM: "1"},
+	{Tag: 0x202000A0, Name: "Requested Image Size Flag", Keyword: "RequestedImageSizeFlag", VR: "CS", VM: "1"},
+	{Tag: 0x202000A2, Name: "Decimate/Crop Result", Keyword: "DecimateCropResult", VR: "CS", VM: "1"},
+	{Tag: 0x20200110, Name: "Basic Grayscale Image Sequence", Keyword: "BasicGrayscaleImageSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x20200111, Name: "Basic Color Image Sequence", Keyword: "BasicColorImageSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x20200130, Name: "Referenced Image Overlay Box Sequence", Keyword: "ReferencedImageOverlayBoxSequence", VR: "SQ", VM: "1", Retired: true},
+	{Tag: 0x20200140, Name: "Referenced VOI LUT Box Sequence", Keyword: "ReferencedVOILUTBoxSequence", VR: "SQ", VM: "1", Retired: true},
+	{Tag: 0x20300010, Name: "Annotation Position", Keyword: "AnnotationPosition", VR: "US", VM: "1"},
+	{Tag: 0x20300020, Name: "Text String", Keyword: "TextString", VR: "LO", VM: "1"},
+	{Tag: 0x20400010, Name: "Referenced Overlay Plane Sequence", Keyword: "ReferencedOverlayPlaneSequence", VR: "SQ", VM: "1", Retired: true},
+	{Tag: 0x20400011, Name: "Referenced Overlay Plane Groups", Keyword: "ReferencedOverlayPlaneGroups", VR: "US", VM: "1-99", Retired: true},
+	{Tag: 0x20400020, Name: "Overlay Pixel Data Sequence", Keyword: "OverlayPixelDataSequence", VR: "SQ", VM: "1", Retired: true},
+	{Tag: 0x20400060, Name: "Overlay Magnification Type", Keyword: "OverlayMagnificationType", VR: "CS", VM: "1", Retired: true},
+	{Tag: 0x20400070, Name: "Overlay Smoothing Type", Keyword: "OverlaySmoothingType", VR: "CS", VM: "1", Retired: true},
+	{Tag: 0x20400072, Name: "Overlay or Image Magnification", Keyword: "OverlayOrImageMagnification", VR: "CS", VM: "1", Retired: true},
+	{Tag: 0x20400074, Name: "Magnify to Number of Columns", Keyword: "MagnifyToNumberOfColumns", VR: "US", VM: "1", Retired: true},
+	{Tag: 0x20400080, Name: "Overlay Foreground Density", Keyword: "OverlayForegroundDensity", VR: "CS", VM: "1", Retired: true},
+	{Tag: 0x20400082, Name: "Overlay Background Density", Keyword: "OverlayBackgroundDensity", VR: "CS", VM: "1", Retired: true},
+	{Tag: 0x20400090, Name: "Overlay Mode", Keyword: "OverlayMode", VR: "CS", VM: "1", Retired: true},
+	{Tag: 0x20400100, Name: "Threshold Density", Keyword: "ThresholdDensity", VR: "CS", VM: "1", Retired: true},
+	{Tag: 0x20400500, Name: "Referenced Image Box Sequence (Retired)", Keyword: "ReferencedImageBoxSequenceRetired", VR: "SQ", VM: "1", Retired: true},
+	{Tag: 0x20500010, Name: "Presentation LUT Sequence", Keyword: "PresentationLUTSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x20500020, Name: "Presentation LUT Shape", Keyword: "PresentationLUTShape", VR: "CS", VM: "1"},
+	{Tag: 0x20500500, Name: "Referenced Presentation LUT Sequence", Keyword: "ReferencedPresentationLUTSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x21000010, Name: "Print Job ID", Keyword: "PrintJobID", VR: "SH", VM: "1", Retired: true},
+	{Tag: 0x21000020, Name: "Execution Status", Keyword: "ExecutionStatus", VR: "CS", VM: "1"},
+	{Tag: 0x21000030, Name: "Execution Status Info", Keyword: "ExecutionStatusInfo", VR: "CS", VM: "1"},
+	{Tag: 0x21000040, Name: "Creation Date", Keyword: "CreationDate", VR: "DA", VM: "1"},
+	{Tag: 0x21000050, Name: "Creation Time", Keyword: "CreationTime", VR: "TM", VM: "1"},
+	{Tag: 0x21000070, Name: "Originator", Keyword: "Originator", VR: "AE", VM: "1"},
+	{Tag: 0x21000140, Name: "Destination AE", Keyword: "DestinationAE", VR: "AE", VM: "1"},
+	{Tag: 0x21000160, Name: "Owner ID", Keyword: "OwnerID", VR: "SH", VM: "1"},
+	{Tag: 0x21000170, Name: "Number of Films", Keyword: "NumberOfFilms", VR: "IS", VM: "1"},
+	{Tag: 0x21000500, Name: "Referenced Print Job Sequence (Pull Stored Print)", Keyword: "ReferencedPrintJobSequencePullStoredPrint", VR: "SQ", VM: "1", Retired: true},
+	{Tag: 0x21100010, Name: "Printer Status", Keyword: "PrinterStatus", VR: "CS", VM: "1"},
+	{Tag: 0x21100020, Name: "Printer Status Info", Keyword: "PrinterStatusInfo", VR: "CS", VM: "1"},
+	{Tag: 0x21100030, Name: "Printer Name", Keyword: "PrinterName", VR: "LO", VM: "1"},
+	{Tag: 0x21100099, Name: "Print Queue ID", Keyword: "PrintQueueID", VR: "SH", VM: "1", Retired: true},
+	{Tag: 0x21200010, Name: "Queue Status", Keyword: "QueueStatus", VR: "CS", VM: "1", Retired: true},
+	{Tag: 0x21200050, Name: "Print Job Description Sequence", Keyword: "PrintJobDescriptionSequence", VR: "SQ", VM: "1", Retired: true},
+	{Tag: 0x21200070, Name: "Referenced Print Job Sequence", Keyword: "ReferencedPrintJobSequence", VR: "SQ", VM: "1", Retired: true},
+	{Tag: 0x21300010, Name: "Print Management Capabilities Sequence", Keyword: "PrintManagementCapabilitiesSequence", VR: "SQ", VM: "1", Retired: true},
+	{Tag: 0x21300015, Name: "Printer Characteristics Sequence", Keyword: "PrinterCharacteristicsSequence", VR: "SQ", VM: "1", Retired: true},
+	{Tag: 0x21300030, Name: "Film Box Content Sequence", Keyword: "FilmBoxContentSequence", VR: "SQ", VM: "1", Retired: true},
+	{Tag: 0x21300040, Name: "Image Box Content Sequence", Keyword: "ImageBoxContentSequence", VR: "SQ", VM: "1", Retired: true},
+	{Tag: 0x21300050, Name: "Annotation Content Sequence", Keyword: "AnnotationContentSequence", VR: "SQ", VM: "1", Retired: true},
+	{Tag: 0x21300060, Name: "Image Overlay Box Content Sequence", Keyword: "ImageOverlayBoxContentSequence", VR: "SQ", VM: "1", Retired: true},
+	{Tag: 0x21300080, Name: "Presentation LUT Content Sequence", Keyword: "PresentationLUTContentSequence", VR: "SQ", VM: "1", Retired: true},
+	{Tag: 0x213000A0, Name: "Proposed Study Sequence", Keyword: "ProposedStudySequence", VR: "SQ", VM: "1"},
+	{Tag: 0x213000C0, Name: "Original Image Sequence", Keyword: "OriginalImageSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x22000001, Name: "Label Using Information Extracted From Instances", Keyword: "LabelUsingInformationExtractedFromInstances", VR: "CS", VM: "1"},
+	{Tag: 0x22000002, Name: "Label Text", Keyword: "LabelText", VR: "UT", VM: "1"},
+	{Tag: 0x22000003, Name: "Label Style Selection", Keyword: "LabelStyleSelection", VR: "CS", VM: "1"},
+	{Tag: 0x22000004, Name: "Media Disposition", Keyword: "MediaDisposition", VR: "LT", VM: "1"},
+	{Tag: 0x22000005, Name: "Barcode Value", Keyword: "BarcodeValue", VR: "LT", VM: "1"},
+	{Tag: 0x22000006, Name: "Barcode Symbology", Keyword: "BarcodeSymbology", VR: "CS", VM: "1"},
+	{Tag: 0x22000007, Name: "Allow Media Splitting", Keyword: "AllowMediaSplitting", VR: "CS", VM: "1"},
+	{Tag: 0x22000008, Name: "Include Non-DICOM Objects", Keyword: "IncludeNonDICOMObjects", VR: "CS", VM: "1"},
+	{Tag: 0x22000009, Name: "Include Display Application", Keyword: "IncludeDisplayApplication", VR: "CS", VM: "1"},
+	{Tag: 0x2200000A, Name: "Preserve Composite Instances After Media Creation", Keyword: "PreserveCompositeInstancesAfterMediaCreation", VR: "CS", VM: "1"},
+	{Tag: 0x2200000B, Name: "Total Number of Pieces of Media Created", Keyword: "TotalNumberOfPiecesOfMediaCreated", VR: "US", VM: "1"},
+	{Tag: 0x2200000C, Name: "Requested Media Application Profile", Keyword: "RequestedMediaApplicationProfile", VR: "LO", VM: "1"},
+	{Tag: 0x2200000D, Name: "Referenced Storage Media Sequence", Keyword: "ReferencedStorageMediaSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x2200000E, Name: "Failure Attributes", Keyword: "FailureAttributes", VR: "AT", VM: "1-n"},
+	{Tag: 0x2200000F, Name: "Allow Lossy Compression", Keyword: "AllowLossyCompression", VR: "CS", VM: "1"},
+	{Tag: 0x22000020, Name: "Request Priority", Keyword: "RequestPriority", VR: "CS", VM: "1"},
+	{Tag: 0x30020002, Name: "RT Image Label", Keyword: "RTImageLabel", VR: "SH", VM: "1"},
+	{Tag: 0x30020003, Name: "RT Image Name", Keyword: "RTImageName", VR: "LO", VM: "1"},
+	{Tag: 0x30020004, Name: "RT Image Description", Keyword: "RTImageDescription", VR: "ST", VM: "1"},
+	{Tag: 0x3002000A, Name: "Reported Values Origin", Keyword: "ReportedValuesOrigin", VR: "CS", VM: "1"},
+	{Tag: 0x3002000C, Name: "RT Image Plane", Keyword: "RTImagePlane", VR: "CS", VM: "1"},
+	{Tag: 0x3002000D, Name: "X-Ray Image Receptor Translation", Keyword: "XRayImageReceptorTranslation", VR: "DS", VM: "3"},
+	{Tag: 0x3002000E, Name: "X-Ray Image Receptor Angle", Keyword: "XRayImageReceptorAngle", VR: "DS", VM: "1"},
+	{Tag: 0x30020010, Name: "RT Image Orientation", Keyword: "RTImageOrientation", VR: "DS", VM: "6"},
+	{Tag: 0x30020011, Name: "Image Plane Pixel Spacing", Keyword: "ImagePlanePixelSpacing", VR: "DS", VM: "2"},
+	{Tag: 0x30020012, Name: "RT Image Position", Keyword: "RTImagePosition", VR: "DS", VM: "2"},
+	{Tag: 0x30020020, Name: "Radiation Machine Name", Keyword: "RadiationMachineName", VR: "SH", VM: "1"},
+	{Tag: 0x30020022, Name: "Radiation Machine SAD", Keyword: "RadiationMachineSAD", VR: "DS", VM: "1"},
+	{Tag: 0x30020024, Name: "Radiation Machine SSD", Keyword: "RadiationMachineSSD", VR: "DS", VM: "1"},
+	{Tag: 0x30020026, Name: "RT Image SID", Keyword: "RTImageSID", VR: "DS", VM: "1"},
+	{Tag: 0x30020028, Name: "Source to Reference Object Distance", Keyword: "SourceToReferenceObjectDistance", VR: "DS", VM: "1"},
+	{Tag: 0x30020029, Name: "Fraction Number", Keyword: "FractionNumber", VR: "IS", VM: "1"},
+	{Tag: 0x30020030, Name: "Exposure Sequence", Keyword: "ExposureSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x30020032, Name: "Meterset Exposure", Keyword: "MetersetExposure", VR: "DS", VM: "1"},
+	{Tag: 0x30020034, Name: "Diaphragm Position", Keyword: "DiaphragmPosition", VR: "DS", VM: "4"},
+	{Tag: 0x30020040, Name: "Fluence Map Sequence", Keyword: "FluenceMapSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x30020041, Name: "Fluence Data Source", Keyword: "FluenceDataSource", VR: "CS", VM: "1"},
+	{Tag: 0x30020042, Name: "Fluence Data Scale", Keyword: "FluenceDataScale", VR: "DS", VM: "1"},
+	{Tag: 0x30020050, Name: "Primary Fluence Mode Sequence", Keyword: "PrimaryFluenceModeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x30020051, Name: "Fluence Mode", Keyword: "FluenceMode", VR: "CS", VM: "1"},
+	{Tag: 0x30020052, Name: "Fluence Mode ID", Keyword: "FluenceModeID", VR: "SH", VM: "1"},
+	{Tag: 0x30020100, Name: "Selected Frame Number", Keyword: "SelectedFrameNumber", VR: "IS", VM: "1"},
+	{Tag: 0x30020101, Name: "Selected Frame Functional Groups Sequence", Keyword: "SelectedFrameFunctionalGroupsSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x30020102, Name: "RT Image Frame General Content Sequence", Keyword: "RTImageFrameGeneralContentSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x30020103, Name: "RT Image Frame Context Sequence", Keyword: "RTImageFrameContextSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x30020104, Name: "RT Image Scope Sequence", Keyword: "RTImageScopeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x30020105, Name: "Beam Modifier Coordinates Presence Flag", Keyword: "BeamModifierCoordinatesPresenceFlag", VR: "CS", VM: "1"},
+	{Tag: 0x30020106, Name: "Start Cumulative Meterset", Keyword: "StartCumulativeMeterset", VR: "FD", VM: "1"},
+	{Tag: 0x30020107, Name: "Stop Cumulative Meterset", Keyword: "StopCumulativeMeterset", VR: "FD", VM: "1"},
+	{Tag: 0x30020108, Name: "RT Acquisition Patient Position Sequence", Keyword: "RTAcquisitionPatientPositionSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x30020109, Name: "RT Image Frame Imaging Device Position Sequence", Keyword: "RTImageFrameImagingDevicePositionSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x3002010A, Name: "RT Image Frame kV Radiation Acquisition Sequence", Keyword: "RTImageFramekVRadiationAcquisitionSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x3002010B, Name: "RT Image Frame MV Radiation Acquisition Sequence", Keyword: "RTImageFrameMVRadiationAcquisitionSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x3002010C, Name: "RT Image Frame Radiation Acquisition Sequence", Keyword: "RTImageFrameRadiationAcquisitionSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x3002010D, Name: "Imaging Source Position Sequence", Keyword: "ImagingSourcePositionSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x3002010E, Name: "Image Receptor Position Sequence", Keyword: "ImageReceptorPositionSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x3002010F, Name: "Device Position to Equipment Mapping Matrix", Keyword: "DevicePositionToEquipmentMappingMatrix", VR: "FD", VM: "16"},
+	{Tag: 0x30020110, Name: "Device Position Parameter Sequence", Keyword: "DevicePositionParameterSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x30020111, Name: "Imaging Source Location Specification Type", Keyword: "ImagingSourceLocationSpecificationType", VR: "CS", VM: "1"},
+	{Tag: 0x30020112, Name: "Imaging Device Location Matrix Sequence", Keyword: "ImagingDeviceLocationMatrixSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x30020113, Name: "Imaging Device Location Parameter Sequence", Keyword: "ImagingDeviceLocationParameterSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x30020114, Name: "Imaging Aperture Sequence", Keyword: "ImagingApertureSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x30020115, Name: "Imaging Aperture Specification Type", Keyword: "ImagingApertureSpecificationType", VR: "CS", VM: "1"},
+	{Tag: 0x30020116, Name: "Number of Acquisition Devices", Keyword: "NumberOfAcquisitionDevices", VR: "US", VM: "1"},
+	{Tag: 0x30020117, Name: "Acquisition Device Sequence", Keyword: "AcquisitionDeviceSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x30020118, Name: "Acquisition Task Sequence", Keyword: "AcquisitionTaskSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x30020119, Name: "Acquisition Task Workitem Code Sequence", Keyword: "AcquisitionTaskWorkitemCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x3002011A, Name: "Acquisition Subtask Sequence", Keyword: "AcquisitionSubtaskSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x3002011B, Name: "Subtask Workitem Code Sequence", Keyword: "SubtaskWorkitemCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x3002011C, Name: "Acquisition Task Index", Keyword: "AcquisitionTaskIndex", VR: "US", VM: "1"},
+	{Tag: 0x3002011D, Name: "Acquisition Subtask Index", Keyword: "AcquisitionSubtaskIndex", VR: "US", VM: "1"},
+	{Tag: 0x3002011E, Name: "Referenced Baseline Parameters RT Radiation Instance Sequence", Keyword: "ReferencedBaselineParametersRTRadiationInstanceSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x3002011F, Name: "Position Acquisition Template Identification Sequence", Keyword: "PositionAcquisitionTemplateIdentificationSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x30020120, Name: "Position Acquisition Template ID", Keyword: "PositionAcquisitionTemplateID", VR: "ST", VM: "1"},
+	{Tag: 0x30020121, Name: "Position Acquisition Template Name", Keyword: "PositionAcquisitionTemplateName", VR: "LO", VM: "1"},
+	{Tag: 0x30020122, Name: "Position Acquisition Template Code Sequence", Keyword: "PositionAcquisitionTemplateCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x30020123, Name: "Position Acquisition Template Description", Keyword: "PositionAcquisitionTemplateDescription", VR: "LT", VM: "1"},
+	{Tag: 0x30020124, Name: "Acquisition Task Applicability Sequence", Keyword: "AcquisitionTaskApplicabilitySequence", VR: "SQ", VM: "1"},
+	{Tag: 0x30020125, Name: "Projection Imaging Acquisition Parameter Sequence", Keyword: "ProjectionImagingAcquisitionParameterSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x30020126, Name: "CT Imaging Acquisition Parameter Sequence", Keyword: "CTImagingAcquisitionParameterSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x30020127, Name: "KV Imaging Generation Parameters Sequence", Keyword: "KVImagingGenerationParametersSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x30020128, Name: "MV Imaging Generation Parameters Sequence", Keyword: "MVImagingGenerationParametersSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x30020129, Name: "Acquisition Signal Type", Keyword: "AcquisitionSignalType", VR: "CS", VM: "1"},
+	{Tag: 0x3002012A, Name: "Acquisition Method", Keyword: "AcquisitionMethod", VR: "CS", VM: "1"},
+	{Tag: 0x3002012B, Name: "Scan Start Position Sequence", Keyword: "ScanStartPositionSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x3002012C, Name: "Scan Stop Position Sequence", Keyword: "ScanStopPositionSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x3002012D, Name: "Imaging Source to Beam Modifier Definition Plane Distance", Keyword: "ImagingSourceToBeamModifierDefinitionPlaneDistance", VR: "FD", VM: "1"},
+	{Tag: 0x3002012E, Name: "Scan Arc Type", Keyword: "ScanArcType", VR: "CS", VM: "1"},
+	{Tag: 0x3002012F, Name: "Detector Positioning Type", Keyword: "DetectorPositioningType", VR: "CS", VM: "1"},
+	{Tag: 0x30020130, Name: "Additional RT Accessory Device Sequence", Keyword: "AdditionalRTAccessoryDeviceSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x30020131, Name: "Device-Specific Acquisition Parameter Sequence", Keyword: "DeviceSpecificAcquisitionParameterSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x30020132, Name: "Referenced Position Reference Instance Sequence", Keyword: "ReferencedPositionReferenceInstanceSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x30020133, Name: "Energy Derivation Code Sequence", Keyword: "EnergyDerivationCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x30020134, Name: "Maximum Cumulative Meterset Exposure", Keyword: "MaximumCumulativeMetersetExposure", VR: "FD", VM: "1"},
+	{Tag: 0x30020135, Name: "Acquisition Initiation Sequence", Keyword: "AcquisitionInitiationSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x30040001, Name: "DVH Type", Keyword: "DVHType", VR: "CS", VM: "1"},
+	{Tag: 0x30040002, Name: "Dose Units", Keyword: "DoseUnits", VR: "CS", VM: "1"},
+	{Tag: 0x30040004, Name: "Dose Type", Keyword: "DoseType", VR: "CS", VM: "1"},
+	{Tag: 0x30040005, Name: "Spatial Transform of Dose", Keyword: "SpatialTransformOfDose", VR: "CS", VM: "1"},
+	{Tag: 0x30040006, Name: "Dose Comment", Keyword: "DoseComment", VR: "LO", VM: "1"},
+	{Tag: 0x30040008, Name: "Normalization Point", Keyword: "NormalizationPoint", VR: "DS", VM: "3"},
+	{Tag: 0x3004000A, Name: "Dose Summation Type", Keyword: "DoseSummationType", VR: "CS", VM: "1"},
+	{Tag: 0x3004000C, Name: "Grid Frame Offset Vector", Keyword: "GridFrameOffsetVector", VR: "DS", VM: "2-n"},
+	{Tag: 0x3004000E, Name: "Dose Grid Scaling", Keyword: "DoseGridScaling", VR: "DS", VM: "1"},
+	{Tag: 0x30040010, Name: "RT Dose ROI Sequence", Keyword: "RTDoseROISequence", VR: "SQ", VM: "1", Retired: true},
+	{Tag: 0x30040012, Name: "Dose Value", Keyword: "DoseValue", VR: "DS", VM: "1", Retired: true},
+	{Tag: 0x30040014, Name: "Tissue Heterogeneity Correction", Keyword: "TissueHeterogeneityCorrection", VR: "CS", VM: "1-3"},
+	{Tag: 0x30040040, Name: "DVH Normalization Point", Keyword: "DVHNormalizationPoint", VR: "DS", VM: "3"},
+	{Tag: 0x30040042, Name: "DVH Normalization Dose Value", Keyword: "DVHNormalizationDoseValue", VR: "DS", VM: "1"},
+	{Tag: 0x30040050, Name: "DVH Sequence", Keyword: "DVHSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x30040052, Name: "DVH Dose Scaling", Keyword: "DVHDoseScaling", VR: "DS", VM: "1"},
+	{Tag: 0x30040054, Name: "DVH Volume Units", Keyword: "DVHVolumeUnits", VR: "CS", VM: "1"},
+	{Tag: 0x30040056, Name: "DVH Number of Bins", Keyword: "DVHNumberOfBins", VR: "IS", VM: "1"},
+	{Tag: 0x30040058, Name: "DVH Data", Keyword: "DVHData", VR: "DS", VM: "2-2n"},
+	{Tag: 0x30040060, Name: "DVH Referenced ROI Sequence", Keyword: "DVHReferencedROISequence", VR: "SQ", VM: "1"},
+	{Tag: 0x30040062, Name: "DVH ROI Contribution Type", Keyword: "DVHROIContributionType", VR: "CS", VM: "1"},
+	{Tag: 0x30040070, Name: "DVH Minimum Dose", Keyword: "DVHMinimumDose", VR: "DS", VM: "1"},
+	{Tag: 0x30040072, Name: "DVH Maximum Dose", Keyword: "DVHMaximumDose", VR: "DS", VM: "1"},
+	{Tag: 0x30040074, Name: "DVH Mean Dose", Keyword: "DVHMeanDose", VR: "DS", VM: "1"},
+	{Tag: 0x30060002, Name: "Structure Set Label", Keyword: "StructureSetLabel", VR: "SH", VM: "1"},
+	{Tag: 0x30060004, Name: "Structure Set Name", Keyword: "StructureSetName", VR: "LO", VM: "1"},
+	{Tag: 0x30060006, Name: "Structure Set Description", Keyword: "StructureSetDescription", VR: "ST", VM: "1"},
+	{Tag: 0x30060008, Name: "Structure Set Date", Keyword: "StructureSetDate", VR: "DA", VM: "1"},
+	{Tag: 0x30060009, Name: "Structure Set Time", Keyword: "StructureSetTime", VR: "TM", VM: "1"},
+	{Tag: 0x30060010, Name: "Referenced Frame of Reference Sequence", Keyword: "ReferencedFrameOfReferenceSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x30060012, Name: "RT Referenced Study Sequence", Keyword: "RTReferencedStudySequence", VR: "SQ", VM: "1"},
+	{Tag: 0x30060014, Name: "RT Referenced Series Sequence", Keyword: "RTReferencedSeriesSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x30060016, Name: "Contour Image Sequence", Keyword: "ContourImageSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x30060018, Name: "Predecessor Structure Set Sequence", Keyword: "PredecessorStructureSetSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x30060020, Name: "Structure Set ROI Sequence", Keyword: "StructureSetROISequence", VR: "SQ", VM: "1"},
+	{Tag: 0x30060022, Name: "ROI Number", Keyword: "ROINumber", VR: "IS", VM: "1"},
+	{Tag: 0x30060024, Name: "Referenced Frame of Reference UID", Keyword: "ReferencedFrameOfReferenceUID", VR: "UI", VM: "1"},
+	{Tag: 0x30060026, Name: "ROI Name", Keyword: "ROIName", VR: "LO", VM: "1"},
+	{Tag: 0x30060028, Name: "ROI Description", Keyword: "ROIDescription", VR: "ST", VM: "1"},
+	{Tag: 0x3006002A, Name: "ROI Display Color", Keyword: "ROIDisplayColor", VR: "IS", VM: "3"},
+	{Tag: 0x3006002C, Name: "ROI Volume", Keyword: "ROIVolume", VR: "DS", VM: "1"},
+	{Tag: 0x3006002D, Name: "ROI DateTime", Keyword: "ROIDateTime", VR: "DT", VM: "1"},
+	{Tag: 0x3006002E, Name: "ROI Observation DateTime", Keyword: "ROIObservationDateTime", VR: "DT", VM: "1"},
+	{Tag: 0x30060030, Name: "RT Related ROI Sequence", Keyword: "RTRelatedROISequence", VR: "SQ", VM: "1"},
+	{Tag: 0x30060033, Name: "RT ROI Relationship", Keyword: "RTROIRelationship", VR: "CS", VM: "1"},
+	{Tag: 0x30060036, Name: "ROI Generation Algorithm", Keyword: "ROIGenerationAlgorithm", VR: "CS", VM: "1"},
+	{Tag: 0x30060037, Name: "ROI Derivation Algorithm Identification Sequence", Keyword: "ROIDerivationAlgorithmIdentificationSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x30060038, Name: "ROI Generation Description", Keyword: "ROIGenerationDescription", VR: "LO", VM: "1"},
+	{Tag: 0x30060039, Name: "ROI Contour Sequence", Keyword: "ROIContourSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x30060040, Name: "Contour Sequence", Keyword: "ContourSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x30060042, Name: "Contour Geometric Type", Keyword: "ContourGeometricType", VR: "CS", VM: "1"},
+	{Tag: 0x30060044, Name: "Contour Slab Thickness", Keyword: "ContourSlabThickness", VR: "DS", VM: "1", Retired: true},
+	{Tag: 0x30060045, Name: "Contour Offset Vector", Keyword: "ContourOffsetVector", VR: "DS", VM: "3", Retired: true},
+	{Tag: 0x30060046, Name: "Number of Contour Points", Keyword: "NumberOfContourPoints", VR: "IS", VM: "1"},
+	{Tag: 0x30060048, Name: "Contour Number", Keyword: "ContourNumber", VR: "IS", VM: "1"},
+	{Tag: 0x30060049, Name: "Attached Contours", Keyword: "AttachedContours", VR: "IS", VM: "1-n", Retired: true},
+	{Tag: 0x3006004A, Name: "Source Pixel Planes Characteristics Sequence", Keyword: "SourcePixelPlanesCharacteristicsSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x3006004B, Name: "Source Series Sequence", Keyword: "SourceSeriesSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x3006004C, Name: "Source Series Information Sequence", Keyword: "SourceSeriesInformationSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x3006004D, Name: "ROI Creator Sequence", Keyword: "ROICreatorSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x3006004E, Name: "ROI Interpreter Sequence", Keyword: "ROIInterpreterSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x3006004F, Name: "ROI Observation Context Code Sequence", Keyword: "ROIObservationContextCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x30060050, Name: "Contour Data", Keyword: "ContourData", VR: "DS", VM: "3-3n"},
+	{Tag: 0x30060080, Name: "RT ROI Observations Sequence", Keyword: "RTROIObservationsSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x30060082, Name: "Observation Number", Keyword: "ObservationNumber", VR: "IS", VM: "1"},
+	{Tag: 0x30060084, Name: "Referenced ROI Number", Keyword: "ReferencedROINumber", VR: "IS", VM: "1"},
+	{Tag: 0x30060085, Name: "ROI Observation Label", Keyword: "ROIObservationLabel", VR: "SH", VM: "1", Retired: true},
+	{Tag: 0x30060086, Name: "RT ROI Identification Code Sequence", Keyword: "RTROIIdentificationCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x30060088, Name: "ROI Observation Description", Keyword: "ROIObservationDescription", VR: "ST", VM: "1", Retired: true},
+	{Tag: 0x300600A0, Name: "Related RT ROI Observations Sequence", Keyword: "RelatedRTROIObservationsSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300600A4, Name: "RT ROI Interpreted Type", Keyword: "RTROIInterpretedType", VR: "CS", VM: "1"},
+	{Tag: 0x300600A6, Name: "ROI Interpreter", Keyword: "ROIInterpreter", VR: "PN", VM: "1"},
+	{Tag: 0x300600B0, Name: "ROI Physical Properties Sequence", Keyword: "ROIPhysicalPropertiesSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300600B2, Name: "ROI Physical Property", Keyword: "ROIPhysicalProperty", VR: "CS", VM: "1"},
+	{Tag: 0x300600B4, Name: "ROI Physical Property Value", Keyword: "ROIPhysicalPropertyValue", VR: "DS", VM: "1"},
+	{Tag: 0x300600B6, Name: "ROI Elemental Composition Sequence", Keyword: "ROIElementalCompositionSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300600B7, Name: "ROI Elemental Composition Atomic Number", Keyword: "ROIElementalCompositionAtomicNumber", VR: "US", VM: "1"},
+	{Tag: 0x300600B8, Name: "ROI Elemental Composition Atomic Mass Fraction", Keyword: "ROIElementalCompositionAtomicMassFraction", VR: "FL", VM: "1"},
+	{Tag: 0x300600B9, Name: "Additional RT ROI Identification Code Sequence", Keyword: "AdditionalRTROIIdentificationCodeSequence", VR: "SQ", VM: "1", Retired: true},
+	{Tag: 0x300600C0, Name: "Frame of Reference Relationship Sequence", Keyword: "FrameOfReferenceRelationshipSequence", VR: "SQ", VM: "1", Retired: true},
+	{Tag: 0x300600C2, Name: "Related Frame of Reference UID", Keyword: "RelatedFrameOfReferenceUID", VR: "UI", VM: "1", Retired: true},
+	{Tag: 0x300600C4, Name: "Frame of Reference Transformation Type", Keyword: "FrameOfReferenceTransformationType", VR: "CS", VM: "1", Retired: true},
+	{Tag: 0x300600C6, Name: "Frame of Reference Transformation Matrix", Keyword: "FrameOfReferenceTransformationMatrix", VR: "DS", VM: "16"},
+	{Tag: 0x300600C8, Name: "Frame of Reference Transformation Comment", Keyword: "FrameOfReferenceTransformationComment", VR: "LO", VM: "1"},
+	{Tag: 0x300600C9, Name: "Patient Location Coordinates Sequence", Keyword: "PatientLocationCoordinatesSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300600CA, Name: "Patient Location Coordinates Code Sequence", Keyword: "PatientLocationCoordinatesCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300600CB, Name: "Patient Support Position Sequence", Keyword: "PatientSupportPositionSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x30080010, Name: "Measured Dose Reference Sequence", Keyword: "MeasuredDoseReferenceSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x30080012, Name: "Measured Dose Description", Keyword: "MeasuredDoseDescription", VR: "ST", VM: "1"},
+	{Tag: 0x30080014, Name: "Measured Dose Type", Keyword: "MeasuredDoseType", VR: "CS", VM: "1"},
+	{Tag: 0x30080016, Name: "Measured Dose Value", Keyword: "MeasuredDoseValue", VR: "DS", VM: "1"},
+	{Tag: 0x30080020, Name: "Treatment Session Beam Sequence", Keyword: "TreatmentSessionBeamSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x30080021, Name: "Treatment Session Ion Beam Sequence", Keyword: "TreatmentSessionIonBeamSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x30080022, Name: "Current Fraction Number", Keyword: "CurrentFractionNumber", VR: "IS", VM: "1"},
+	{Tag: 0x30080024, Name: "Treatment Control Point Date", Keyword: "TreatmentControlPointDate", VR: "DA", VM: "1"},
+	{Tag: 0x30080025, Name: "Treatment Control Point Time", Keyword: "TreatmentControlPointTime", VR: "TM", VM: "1"},
+	{Tag: 0x3008002A, Name: "Treatment Termination Status", Keyword: "TreatmentTerminationStatus", VR: "CS", VM: "1"},
+	{Tag: 0x3008002B, Name: "Treatment Termination Code", Keyword: "TreatmentTerminationCode", VR: "SH", VM: "1", Retired: true},
+	{Tag: 0x3008002C, Name: "Treatment Verification Status", Keyword: "TreatmentVerificationStatus", VR: "CS", VM: "1"},
+	{Tag: 0x30080030, Name: "Referenced Treatment Record Sequence", Keyword: "ReferencedTreatmentRecordSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x30080032, Name: "Specified Primary Meterset", Keyword: "SpecifiedPrimaryMeterset", VR: "DS", VM: "1"},
+	{Tag: 0x30080033, Name: "Specified Secondary Meterset", Keyword: "SpecifiedSecondaryMeterset", VR: "DS", VM: "1"},
+	{Tag: 0x30080036, Name: "Delivered Primary Meterset", Keyword: "DeliveredPrimaryMeterset", VR: "DS", VM: "1"},
+	{Tag: 0x30080037, Name: "Delivered Secondary Meterset", Keyword: "DeliveredSecondaryMeterset", VR: "DS", VM: "1"},
+	{Tag: 0x3008003A, Name: "Specified Treatment Time", Keyword: "SpecifiedTreatmentTime", VR: "DS", VM: "1"},
+	{Tag: 0x3008003B, Name: "Delivered Treatment Time", Keyword: "DeliveredTreatmentTime", VR: "DS", VM: "1"},
+	{Tag: 0x30080040, Name: "Control Point Delivery Sequence", Keyword: "ControlPointDeliverySequence", VR: "SQ", VM: "1"},
+	{Tag: 0x30080041, Name: "Ion Control Point Delivery Sequence", Keyword: "IonControlPointDeliverySequence", VR: "SQ", VM: "1"},
+	{Tag: 0x30080042, Name: "Specified Meterset", Keyword: "SpecifiedMeterset", VR: "DS", VM: "1"},
+	{Tag: 0x30080044, Name: "Delivered Meterset", Keyword: "DeliveredMeterset", VR: "DS", VM: "1"},
+	{Tag: 0x30080045, Name: "Meterset Rate Set", Keyword: "MetersetRateSet", VR: "FL", VM: "1"},
+	{Tag: 0x30080046, Name: "Meterset Rate Delivered", Keyword: "MetersetRateDelivered", VR: "FL", VM: "1"},
+	{Tag: 0x30080047, Name: "Scan Spot Metersets Delivered", Keyword: "ScanSpotMetersetsDelivered", VR: "FL", VM: "1-n"},
+	{Tag: 0x30080048, Name: "Dose Rate Delivered", Keyword: "DoseRateDelivered", VR: "DS", VM: "1"},
+	{Tag: 0x30080050, Name: "Treatment Summary Calculated Dose Reference Sequence", Keyword: "TreatmentSummaryCalculatedDoseReferenceSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x30080052, Name: "Cumulative Dose to Dose Reference", Keyword: "CumulativeDoseToDoseReference", VR: "DS", VM: "1"},
+	{Tag: 0x30080054, Name: "First Treatment Date", Keyword: "FirstTreatmentDate", VR: "DA", VM: "1"},
+	{Tag: 0x30080056, Name: "Most Recent Treatment Date", Keyword: "MostRecentTreatmentDate", VR: "DA", VM: "1"},
+	{Tag: 0x3008005A, Name: "Number of Fractions Delivered", Keyword: "NumberOfFractionsDelivered", VR: "IS", VM: "1"},
+	{Tag: 0x30080060, Name: "Override Sequence", Keyword: "OverrideSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x30080061, Name: "Parameter Sequence Pointer", Keyword: "ParameterSequencePointer", VR: "AT", VM: "1"},
+	{Tag: 0x30080062, Name: "Override Parameter Pointer", Keyword: "OverrideParameterPointer", VR: "AT", VM: "1"},
+	{Tag: 0x30080063, Name: "Parameter Item Index", Keyword: "ParameterItemIndex", VR: "IS", VM: "1"},
+	{Tag: 0x30080064, Name: "Measured Dose Reference Number", Keyword: "MeasuredDoseReferenceNumber", VR: "IS", VM: "1"},
+	{Tag: 0x30080065, Name: "Parameter Pointer", Keyword: "ParameterPointer", VR: "AT", VM: "1"},
+	{Tag: 0x30080066, Name: "Override Reason", Keyword: "OverrideReason", VR: "ST", VM: "1"},
+	{Tag: 0x30080067, Name: "Parameter Value Number", Keyword: "ParameterValueNumber", VR: "US", VM: "1"},
+	{Tag: 0x30080068, Name: "Corrected Parameter Sequence", Keyword: "CorrectedParameterSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x3008006A, Name: "Correction Value", Keyword: "CorrectionValue", VR: "FL", VM: "1"},
+	{Tag: 0x30080070, Name: "Calculated Dose Reference Sequence", Keyword: "CalculatedDoseReferenceSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x30080072, Name: "Calculated Dose Reference Number", Keyword: "CalculatedDoseReferenceNumber", VR: "IS", VM: "1"},
+	{Tag: 0x30080074, Name: "Calculated Dose Reference Description", Keyword: "CalculatedDoseReferenceDescription", VR: "ST", VM: "1"},
+	{Tag: 0x30080076, Name: "Calculated Dose Reference Dose Value", Keyword: "CalculatedDoseReferenceDoseValue", VR: "DS", VM: "1"},
+	{Tag: 0x30080078, Name: "Start Meterset", Keyword: "StartMeterset", VR: "DS", VM: "1"},
+	{Tag: 0x3008007A, Name: "End Meterset", Keyword: "EndMeterset", VR: "DS", VM: "1"},
+	{Tag: 0x30080080, Name: "Referenced Measured Dose Reference Sequence", Keyword: "ReferencedMeasuredDoseReferenceSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x30080082, Name: "Referenced Measured Dose Reference Number", Keyword: "ReferencedMeasuredDoseReferenceNumber", VR: "IS", VM: "1"},
+	{Tag: 0x30080090, Name: "Referenced Calculated Dose Reference Sequence", Keyword: "ReferencedCalculatedDoseReferenceSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x30080092, Name: "Referenced Calculated Dose Reference Number", Keyword: "ReferencedCalculatedDoseReferenceNumber", VR: "IS", VM: "1"},
+	{Tag: 0x300800A0, Name: "Beam Limiting Device Leaf Pairs Sequence", Keyword: "BeamLimitingDeviceLeafPairsSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300800A1, Name: "Enhanced RT Beam Limiting Device Sequence", Keyword: "EnhancedRTBeamLimitingDeviceSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300800A2, Name: "Enhanced RT Beam Limiting Opening Sequence", Keyword: "EnhancedRTBeamLimitingOpeningSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300800A3, Name: "Enhanced RT Beam Limiting Device Definition Flag", Keyword: "EnhancedRTBeamLimitingDeviceDefinitionFlag", VR: "CS", VM: "1"},
+	{Tag: 0x300800A4, Name: "Parallel RT Beam Delimiter Opening Extents", Keyword: "ParallelRTBeamDelimiterOpeningExtents", VR: "FD", VM: "2-2n"},
+	{Tag: 0x300800B0, Name: "Recorded Wedge Sequence", Keyword: "RecordedWedgeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300800C0, Name: "Recorded Compensator Sequence", Keyword: "RecordedCompensatorSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300800D0, Name: "Recorded Block Sequence", Keyword: "RecordedBlockSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300800D1, Name: "Recorded Block Slab Sequence", Keyword: "RecordedBlockSlabSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300800E0, Name: "Treatment Summary Measured Dose Reference Sequence", Keyword: "TreatmentSummaryMeasuredDoseReferenceSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300800F0, Name: "Recorded Snout Sequence", Keyword: "RecordedSnoutSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300800F2, Name: "Recorded Range Shifter Sequence", Keyword: "RecordedRangeShifterSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300800F4, Name: "Recorded Lateral Spreading Device Sequence", Keyword: "RecordedLateralSpreadingDeviceSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300800F6, Name: "Recorded Range Modulator Sequence", Keyword: "RecordedRangeModulatorSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x30080100, Name: "Recorded Source Sequence", Keyword: "RecordedSourceSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x30080105, Name: "Source Serial Number", Keyword: "SourceSerialNumber", VR: "LO", VM: "1"},
+	{Tag: 0x30080110, Name: "Treatment Session Application Setup Sequence", Keyword: "TreatmentSessionApplicationSetupSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x30080116, Name: "Application Setup Check", Keyword: "ApplicationSetupCheck", VR: "CS", VM: "1"},
+	{Tag: 0x30080120, Name: "Recorded Brachy Accessory Device Sequence", Keyword: "RecordedBrachyAccessoryDeviceSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x30080122, Name: "Referenced Brachy Accessory Device Number", Keyword: "ReferencedBrachyAccessoryDeviceNumber", VR: "IS", VM: "1"},
+	{Tag: 0x30080130, Name: "Recorded Channel Sequence", Keyword: "RecordedChannelSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x30080132, Name: "Specified Channel Total Time", Keyword: "SpecifiedChannelTotalTime", VR: "DS", VM: "1"},
+	{Tag: 0x30080134, Name: "Delivered Channel Total Time", Keyword: "DeliveredChannelTotalTime", VR: "DS", VM: "1"},
+	{Tag: 0x30080136, Name: "Specified Number of Pulses", Keyword: "SpecifiedNumberOfPulses", VR: "IS", VM: "1"},
+	{Tag: 0x30080138, Name: "Delivered Number of Pulses", Keyword: "DeliveredNumberOfPulses", VR: "IS", VM: "1"},
+	{Tag: 0x3008013A, Name: "Specified Pulse Repetition Interval", Keyword: "SpecifiedPulseRepetitionInterval", VR: "DS", VM: "1"},
+	{Tag: 0x3008013C, Name: "Delivered Pulse Repetition Interval", Keyword: "DeliveredPulseRepetitionInterval", VR: "DS", VM: "1"},
+	{Tag: 0x30080140, Name: "Recorded Source Applicator Sequence", Keyword: "RecordedSourceApplicatorSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x30080142, Name: "Referenced Source Applicator Number", Keyword: "ReferencedSourceApplicatorNumber", VR: "IS", VM: "1"},
+	{Tag: 0x30080150, Name: "Recorded Channel Shield Sequence", Keyword: "RecordedChannelShieldSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x30080152, Name: "Referenced Channel Shield Number", Keyword: "ReferencedChannelShieldNumber", VR: "IS", VM: "1"},
+	{Tag: 0x30080160, Name: "Brachy Control Point Delivered Sequence", Keyword: "BrachyControlPointDeliveredSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x30080162, Name: "Safe Position Exit Date", Keyword: "SafePositionExitDate", VR: "DA", VM: "1"},
+	{Tag: 0x30080164, Name: "Safe Position Exit Time", Keyword: "SafePositionExitTime", VR: "TM", VM: "1"},
+	{Tag: 0x30080166, Name: "Safe Position Return Date", Keyword: "SafePositionReturnDate", VR: "DA", VM: "1"},
+	{Tag: 0x30080168, Name: "Safe Position Return Time", Keyword: "SafePositionReturnTime", VR: "TM", VM: "1"},
+	{Tag: 0x30080171, Name: "Pulse Specific Brachy Control Point Delivered Sequence", Keyword: "PulseSpecificBrachyControlPointDeliveredSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x30080172, Name: "Pulse Number", Keyword: "PulseNumber", VR: "US", VM: "1"},
+	{Tag: 0x30080173, Name: "Brachy Pulse Control Point Delivered Sequence", Keyword: "BrachyPulseControlPointDeliveredSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x30080200, Name: "Current Treatment Status", Keyword: "CurrentTreatmentStatus", VR: "CS", VM: "1"},
+	{Tag: 0x30080202, Name: "Treatment Status Comment", Keyword: "TreatmentStatusComment", VR: "ST", VM: "1"},
+	{Tag: 0x30080220, Name: "Fraction Group Summary Sequence", Keyword: "FractionGroupSummarySequence", VR: "SQ", VM: "1"},
+	{Tag: 0x30080223, Name: "Referenced Fraction Number", Keyword: "ReferencedFractionNumber", VR: "IS", VM: "1"},
+	{Tag: 0x30080224, Name: "Fraction Group Type", Keyword: "FractionGroupType", VR: "CS", VM: "1"},
+	{Tag: 0x30080230, Name: "Beam Stopper Position", Keyword: "BeamStopperPosition", VR: "CS", VM: "1"},
+	{Tag: 0x30080240, Name: "Fraction Status Summary Sequence", Keyword: "FractionStatusSummarySequence", VR: "SQ", VM: "1"},
+	{Tag: 0x30080250, Name: "Treatment Date", Keyword: "TreatmentDate", VR: "DA", VM: "1"},
+	{Tag: 0x30080251, Name: "Treatment Time", Keyword: "TreatmentTime", VR: "TM", VM: "1"},
+	{Tag: 0x300A0002, Name: "RT Plan Label", Keyword: "RTPlanLabel", VR: "SH", VM: "1"},
+	{Tag: 0x300A0003, Name: "RT Plan Name", Keyword: "RTPlanName", VR: "LO", VM: "1"},
+	{Tag: 0x300A0004, Name: "RT Plan Description", Keyword: "RTPlanDescription", VR: "ST", VM: "1"},
+	{Tag: 0x300A0006, Name: "RT Plan Date", Keyword: "RTPlanDate", VR: "DA", VM: "1"},
+	{Tag: 0x300A0007, Name: "RT Plan Time", Keyword: "RTPlanTime", VR: "TM", VM: "1"},
+	{Tag: 0x300A0009, Name: "Treatment Protocols", Keyword: "TreatmentProtocols", VR: "LO", VM: "1-n"},
+	{Tag: 0x300A000A, Name: "Plan Intent", Keyword: "PlanIntent", VR: "CS", VM: "1"},
+	{Tag: 0x300A000B, Name: "Treatment Sites", Keyword: "TreatmentSites", VR: "LO", VM: "1-n", Retired: true},
+	{Tag: 0x300A000C, Name: "RT Plan Geometry", Keyword: "RTPlanGeometry", VR: "CS", VM: "1"},
+	{Tag: 0x300A000E, Name: "Prescription Description", Keyword: "PrescriptionDescription", VR: "ST", VM: "1"},
+	{Tag: 0x300A0010, Name: "Dose Reference Sequence", Keyword: "DoseReferenceSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300A0012, Name: "Dose Reference Number", Keyword: "DoseReferenceNumber", VR: "IS", VM: "1"},
+	{Tag: 0x300A0013, Name: "Dose Reference UID", Keyword: "DoseReferenceUID", VR: "UI", VM: "1"},
+	{Tag: 0x300A0014, Name: "Dose Reference Structure Type", Keyword: "DoseReferenceStructureType", VR: "CS", VM: "1"},
+	{Tag: 0x300A0015, Name: "Nominal Beam Energy Unit", Keyword: "NominalBeamEnergyUnit", VR: "CS", VM: "1"},
+	{Tag: 0x300A0016, Name: "Dose Reference Description", Keyword: "DoseReferenceDescription", VR: "LO", VM: "1"},
+	{Tag: 0x300A0018, Name: "Dose Reference Point Coordinates", Keyword: "DoseReferencePointCoordinates", VR: "DS", VM: "3"},
+	{Tag: 0x300A001A, Name: "Nominal Prior Dose", Keyword: "NominalPriorDose", VR: "DS", VM: "1"},
+	{Tag: 0x300A0020, Name: "Dose Reference Type", Keyword: "DoseReferenceType", VR: "CS", VM: "1"},
+	{Tag: 0x300A0021, Name: "Constraint Weight", Keyword: "ConstraintWeight", VR: "DS", VM: "1"},
+	{Tag: 0x300A0022, Name: "Delivery Warning Dose", Keyword: "DeliveryWarningDose", VR: "DS", VM: "1"},
+	{Tag: 0x300A0023, Name: "Delivery Maximum Dose", Keyword: "DeliveryMaximumDose", VR: "DS", VM: "1"},
+	{Tag: 0x300A0025, Name: "Target Minimum Dose", Keyword: "TargetMinimumDose", VR: "DS", VM: "1"},
+	{Tag: 0x300A0026, Name: "Target Prescription Dose", Keyword: "TargetPrescriptionDose", VR: "DS", VM: "1"},
+	{Tag: 0x300A0027, Name: "Target Maximum Dose", Keyword: "TargetMaximumDose", VR: "DS", VM: "1"},
+	{Tag: 0x300A0028, Name: "Target Underdose Volume Fraction", Keyword: "TargetUnderdoseVolumeFraction", VR: "DS", VM: "1"},
+	{Tag: 0x300A002A, Name: "Organ at Risk Full-volume Dose", Keyword: "OrganAtRiskFullVolumeDose", VR: "DS", VM: "1"},
+	{Tag: 0x300A002B, Name: "Organ at Risk Limit Dose", Keyword: "OrganAtRiskLimitDose", VR: "DS", VM: "1"},
+	{Tag: 0x300A002C, Name: "Organ at Risk Maximum Dose", Keyword: "OrganAtRiskMaximumDose", VR: "DS", VM: "1"},
+	{Tag: 0x300A002D, Name: "Organ at Risk Overdose Volume Fraction", Keyword: "OrganAtRiskOverdoseVolumeFraction", VR: "DS", VM: "1"},
+	{Tag: 0x300A0040, Name: "Tolerance Table Sequence", Keyword: "ToleranceTableSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300A0042, Name: "Tolerance Table Number", Keyword: "ToleranceTableNumber", VR: "IS", VM: "1"},
+	{Tag: 0x300A0043, Name: "Tolerance Table Label", Keyword: "ToleranceTableLabel", VR: "SH", VM: "1"},
+	{Tag: 0x300A0044, Name: "Gantry Angle Tolerance", Keyword: "GantryAngleTolerance", VR: "DS", VM: "1"},
+	{Tag: 0x300A0046, Name: "Beam Limiting Device Angle Tolerance", Keyword: "BeamLimitingDeviceAngleTolerance", VR: "DS", VM: "1"},
+	{Tag: 0x300A0048, Name: "Beam Limiting Device Tolerance Sequence", Keyword: "BeamLimitingDeviceToleranceSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300A004A, Name: "Beam Limiting Device Position Tolerance", Keyword: "BeamLimitingDevicePositionTolerance", VR: "DS", VM: "1"},
+	{Tag: 0x300A004B, Name: "Snout Position Tolerance", Keyword: "SnoutPositionTolerance", VR: "FL", VM: "1"},
+	{Tag: 0x300A004C, Name: "Patient Support Angle Tolerance", Keyword: "PatientSupportAngleTolerance", VR: "DS", VM: "1"},
+	{Tag: 0x300A004E, Name: "Table Top Eccentric Angle Tolerance", Keyword: "TableTopEccentricAngleTolerance", VR: "DS", VM: "1"},
+	{Tag: 0x300A004F, Name: "Table Top Pitch Angle Tolerance", Keyword: "TableTopPitchAngleTolerance", VR: "FL", VM: "1"},
+	{Tag: 0x300A0050, Name: "Table Top Roll Angle Tolerance", Keyword: "TableTopRollAngleTolerance", VR: "FL", VM: "1"},
+	{Tag: 0x300A0051, Name: "Table Top Vertical Position Tolerance", Keyword: "TableTopVerticalPositionTolerance", VR: "DS", VM: "1"},
+	{Tag: 0x300A0052, Name: "Table Top Longitudinal Position Tolerance", Keyword: "TableTopLongitudinalPositionTolerance", VR: "DS", VM: "1"},
+	{Tag: 0x300A0053, Name: "Table Top Lateral Position Tolerance", Keyword: "TableTopLateralPositionTolerance", VR: "DS", VM: "1"},
+	{Tag: 0x300A0055, Name: "RT Plan Relationship", Keyword: "RTPlanRelationship", VR: "CS", VM: "1"},
+	{Tag: 0x300A0070, Name: "Fraction Group Sequence", Keyword: "FractionGroupSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300A0071, Name: "Fraction Group Number", Keyword: "FractionGroupNumber", VR: "IS", VM: "1"},
+	{Tag: 0x300A0072, Name: "Fraction Group Description", Keyword: "FractionGroupDescription", VR: "LO", VM: "1"},
+	{Tag: 0x300A0078, Name: "Number of Fractions Planned", Keyword: "NumberOfFractionsPlanned", VR: "IS", VM: "1"},
+	{Tag: 0x300A0079, Name: "Number of Fraction Pattern Digits Per Day", Keyword: "NumberOfFractionPatternDigitsPerDay", VR: "IS", VM: "1"},
+	{Tag: 0x300A007A, Name: "Repeat Fraction Cycle Length", Keyword: "RepeatFractionCycleLength", VR: "IS", VM: "1"},
+	{Tag: 0x300A007B, Name: "Fraction Pattern", Keyword: "FractionPattern", VR: "LT", VM: "1"},
+	{Tag: 0x300A0080, Name: "Number of Beams", Keyword: "NumberOfBeams", VR: "IS", VM: "1"},
+	{Tag: 0x300A0082, Name: "Beam Dose Specification Point", Keyword: "BeamDoseSpecificationPoint", VR: "DS", VM: "3", Retired: true},
+	{Tag: 0x300A0083, Name: "Referenced Dose Reference UID", Keyword: "ReferencedDoseReferenceUID", VR: "UI", VM: "1"},
+	{Tag: 0x300A0084, Name: "Beam Dose", Keyword: "BeamDose", VR: "DS", VM: "1"},
+	{Tag: 0x300A0086, Name: "Beam Meterset", Keyword: "BeamMeterset", VR: "DS", VM: "1"},
+	{Tag: 0x300A0088, Name: "Beam Dose Point Depth", Keyword: "BeamDosePointDepth", VR: "FL", VM: "1"},
+	{Tag: 0x300A0089, Name: "Beam Dose Point Equivalent Depth", Keyword: "BeamDosePointEquivalentDepth", VR: "FL", VM: "1"},
+	{Tag: 0x300A008A, Name: "Beam Dose Point SSD", Keyword: "BeamDosePointSSD", VR: "FL", VM: "1"},
+	{Tag: 0x300A008B, Name: "Beam Dose Meaning", Keyword: "BeamDoseMeaning", VR: "CS", VM: "1"},
+	{Tag: 0x300A008C, Name: "Beam Dose Verification Control Point Sequence", Keyword: "BeamDoseVerificationControlPointSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300A008D, Name: "Average Beam Dose Point Depth", Keyword: "AverageBeamDosePointDepth", VR: "FL", VM: "1", Retired: true},
+	{Tag: 0x300A008E, Name: "Average Beam Dose Point Equivalent Depth", Keyword: "AverageBeamDosePointEquivalentDepth", VR: "FL", VM: "1", Retired: true},
+	{Tag: 0x300A008F, Name: "Average Beam Dose Point SSD", Keyword: "AverageBeamDosePointSSD", VR: "FL", VM: "1", Retired: true},
+	{Tag: 0x300A0090, Name: "Beam Dose Type", Keyword: "BeamDoseType", VR: "CS", VM: "1"},
+	{Tag: 0x300A0091, Name: "Alternate Beam Dose", Keyword: "AlternateBeamDose", VR: "DS", VM: "1"},
+	{Tag: 0x300A0092, Name: "Alternate Beam Dose Type", Keyword: "AlternateBeamDoseType", VR: "CS", VM: "1"},
+	{Tag: 0x300A0093, Name: "Depth Value Averaging Flag", Keyword: "DepthValueAveragingFlag", VR: "CS", VM: "1"},
+	{Tag: 0x300A0094, Name: "Beam Dose Point Source to External Contour Distance", Keyword: "BeamDosePointSourceToExternalContourDistance", VR: "DS", VM: "1"},
+	{Tag: 0x300A00A0, Name: "Number of Brachy Application Setups", Keyword: "NumberOfBrachyApplicationSetups", VR: "IS", VM: "1"},
+	{Tag: 0x300A00A2, Name: "Brachy Application Setup Dose Specification Point", Keyword: "BrachyApplicationSetupDoseSpecificationPoint", VR: "DS", VM: "3"},
+	{Tag: 0x300A00A4, Name: "Brachy Application Setup Dose", Keyword: "BrachyApplicationSetupDose", VR: "DS", VM: "1"},
+	{Tag: 0x300A00B0, Name: "Beam Sequence", Keyword: "BeamSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300A00B2, Name: "Treatment Machine Name", Keyword: "TreatmentMachineName", VR: "SH", VM: "1"},
+	{Tag: 0x300A00B3, Name: "Primary Dosimeter Unit", Keyword: "PrimaryDosimeterUnit", VR: "CS", VM: "1"},
+	{Tag: 0x300A00B4, Name: "Source-Axis Distance", Keyword: "SourceAxisDistance", VR: "DS", VM: "1"},
+	{Tag: 0x300A00B6, Name: "Beam Limiting Device Sequence", Keyword: "BeamLimitingDeviceSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300A00B8, Name: "RT Beam Limiting Device Type", Keyword: "RTBeamLimitingDeviceType", VR: "CS", VM: "1"},
+	{Tag: 0x300A00BA, Name: "Source to Beam Limiting Device Distance", Keyword: "SourceToBeamLimitingDeviceDistance", VR: "DS", VM: "1"},
+	{Tag: 0x300A00BB, Name: "Isocenter to Beam Limiting Device Distance", Keyword: "IsocenterToBeamLimitingDeviceDistance", VR: "FL", VM: "1"},
+	{Tag: 0x300A00BC, Name: "Number of Leaf/Jaw Pairs", Keyword: "NumberOfLeafJawPairs", VR: "IS", VM: "1"},
+	{Tag: 0x300A00BE, Name: "Leaf Position Boundaries", Keyword: "LeafPositionBoundaries", VR: "DS", VM: "3-n"},
+	{Tag: 0x300A00C0, Name: "Beam Number", Keyword: "BeamNumber", VR: "IS", VM: "1"},
+	{Tag: 0x300A00C2, Name: "Beam Name", Keyword: "BeamName", VR: "LO", VM: "1"},
+	{Tag: 0x300A00C3, Name: "Beam Description", Keyword: "BeamDescription", VR: "ST", VM: "1"},
+	{Tag: 0x300A00C4, Name: "Beam Type", Keyword: "BeamType", VR: "CS", VM: "1"},
+	{Tag: 0x300A00C5, Name: "Beam Delivery Duration Limit", Keyword: "BeamDeliveryDurationLimit", VR: "FD", VM: "1"},
+	{Tag: 0x300A00C6, Name: "Radiation Type", Keyword: "RadiationType", VR: "CS", VM: "1"},
+	{Tag: 0x300A00C7, Name: "High-Dose Technique Type", Keyword: "HighDoseTechniqueType", VR: "CS", VM: "1"},
+	{Tag: 0x300A00C8, Name: "Reference Image Number", Keyword: "ReferenceImageNumber", VR: "IS", VM: "1"},
+	{Tag: 0x300A00CA, Name: "Planned Verification Image Sequence", Keyword: "PlannedVerificationImageSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300A00CC, Name: "Imaging Device-Specific Acquisition Parameters", Keyword: "ImagingDeviceSpecificAcquisitionParameters", VR: "LO", VM: "1-n"},
+	{Tag: 0x300A00CE, Name: "Treatment Delivery Type", Keyword: "TreatmentDeliveryType", VR: "CS", VM: "1"},
+	{Tag: 0x300A00D0, Name: "Number of Wedges", Keyword: "NumberOfWedges", VR: "IS", VM: "1"},
+	{Tag: 0x300A00D1, Name: "Wedge Sequence", Keyword: "WedgeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300A00D2, Name: "Wedge Number", Keyword: "WedgeNumber", VR: "IS", VM: "1"},
+	{Tag: 0x300A00D3, Name: "Wedge Type", Keyword: "WedgeType", VR: "CS", VM: "1"},
+	{Tag: 0x300A00D4, Name: "Wedge ID", Keyword: "WedgeID", VR: "SH", VM: "1"},
+	{Tag: 0x300A00D5, Name: "Wedge Angle", Keyword: "WedgeAngle", VR: "IS", VM: "1"},
+	{Tag: 0x300A00D6, Name: "Wedge Factor", Keyword: "WedgeFactor", VR: "DS", VM: "1"},
+	{Tag: 0x300A00D7, Name: "Total Wedge Tray Water-Equivalent Thickness", Keyword: "TotalWedgeTrayWaterEquivalentThickness", VR: "FL", VM: "1"},
+	{Tag: 0x300A00D8, Name: "Wedge Orientation", Keyword: "WedgeOrientation", VR: "DS", VM: "1"},
+	{Tag: 0x300A00D9, Name: "Isocenter to Wedge Tray Distance", Keyword: "IsocenterToWedgeTrayDistance", VR: "FL", VM: "1"},
+	{Tag: 0x300A00DA, Name: "Source to Wedge Tray Distance", Keyword: "SourceToWedgeTrayDistance", VR: "DS", VM: "1"},
+	{Tag: 0x300A00DB, Name: "Wedge Thin Edge Position", Keyword: "WedgeThinEdgePosition", VR: "FL", VM: "1"},
+	{Tag: 0x300A00DC, Name: "Bolus ID", Keyword: "BolusID", VR: "SH", VM: "1"},
+	{Tag: 0x300A00DD, Name: "Bolus Description", Keyword: "BolusDescription", VR: "ST", VM: "1"},
+	{Tag: 0x300A00DE, Name: "Effective Wedge Angle", Keyword: "EffectiveWedgeAngle", VR: "DS", VM: "1"},
+	{Tag: 0x300A00E0, Name: "Number of Compensators", Keyword: "NumberOfCompensators", VR: "IS", VM: "1"},
+	{Tag: 0x300A00E1, Name: "Material ID", Keyword: "MaterialID", VR: "SH", VM: "1"},
+	{Tag: 0x300A00E2, Name: "Total Compensator Tray Factor", Keyword: "TotalCompensatorTrayFactor", VR: "DS", VM: "1"},
+	{Tag: 0x300A00E3, Name: "Compensator Sequence", Keyword: "CompensatorSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300A00E4, Name: "Compensator Number", Keyword: "CompensatorNumber", VR: "IS", VM: "1"},
+	{Tag: 0x300A00E5, Name: "Compensator ID", Keyword: "CompensatorID", VR: "SH", VM: "1"},
+	{Tag: 0x300A00E6, Name: "Source to Compensator Tray Distance", Keyword: "SourceToCompensatorTrayDistance", VR: "DS", VM: "1"},
+	{Tag: 0x300A00E7, Name: "Compensator Rows", Keyword: "CompensatorRows", VR: "IS", VM: "1"},
+	{Tag: 0x300A00E8, Name: "Compensator Columns", Keyword: "CompensatorColumns", VR: "IS", VM: "1"},
+	{Tag: 0x300A00E9, Name: "Compensator Pixel Spacing", Keyword: "CompensatorPixelSpacing", VR: "DS", VM: "2"},
+	{Tag: 0x300A00EA, Name: "Compensator Position", Keyword: "CompensatorPosition", VR: "DS", VM: "2"},
+	{Tag: 0x300A00EB, Name: "Compensator Transmission Data", Keyword: "CompensatorTransmissionData", VR: "DS", VM: "1-n"},
+	{Tag: 0x300A00EC, Name: "Compensator Thickness Data", Keyword: "CompensatorThicknessData", VR: "DS", VM: "1-n"},
+	{Tag: 0x300A00ED, Name: "Number of Boli", Keyword: "NumberOfBoli", VR: "IS", VM: "1"},
+	{Tag: 0x300A00EE, Name: "Compensator Type", Keyword: "CompensatorType", VR: "CS", VM: "1"},
+	{Tag: 0x300A00EF, Name: "Compensator Tray ID", Keyword: "CompensatorTrayID", VR: "SH", VM: "1"},
+	{Tag: 0x300A00F0, Name: "Number of Blocks", Keyword: "NumberOfBlocks", VR: "IS", VM: "1"},
+	{Tag: 0x300A00F2, Name: "Total Block Tray Factor", Keyword: "TotalBlockTrayFactor", VR: "DS", VM: "1"},
+	{Tag: 0x300A00F3, Name: "Total Block Tray Water-Equivalent Thickness", Keyword: "TotalBlockTrayWaterEquivalentThickness", VR: "FL", VM: "1"},
+	{Tag: 0x300A00F4, Name: "Block Sequence", Keyword: "BlockSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300A00F5, Name: "Block Tray ID", Keyword: "BlockTrayID", VR: "SH", VM: "1"},
+	{Tag: 0x300A00F6, Name: "Source to Block Tray Distance", Keyword: "SourceToBlockTrayDistance", VR: "DS", VM: "1"},
+	{Tag: 0x300A00F7, Name: "Isocenter to Block Tray Distance", Keyword: "IsocenterToBlockTrayDistance", VR: "FL", VM: "1"},
+	{Tag: 0x300A00F8, Name: "Block Type", Keyword: "BlockType", VR: "CS", VM: "1"},
+	{Tag: 0x300A00F9, Name: "Accessory Code", Keyword: "AccessoryCode", VR: "LO", VM: "1"},
+	{Tag: 0x300A00FA, Name: "Block Divergence", Keyword: "BlockDivergence", VR: "CS", VM: "1"},
+	{Tag: 0x300A00FB, Name: "Block Mounting Position", Keyword: "BlockMountingPosition", VR: "CS", VM: "1"},
+	{Tag: 0x300A00FC, Name: "Block Number", Keyword: "BlockNumber", VR: "IS", VM: "1"},
+	{Tag: 0x300A00FE, Name: "Block Name", Keyword: "BlockName", VR: "LO", VM: "1"},
+	{Tag: 0x300A0100, Name: "Block Thickness", Keyword: "BlockThickness", VR: "DS", VM: "1"},
+	{Tag: 0x300A0102, Name: "Block Transmission", Keyword: "BlockTransmission", VR: "DS", VM: "1"},
+	{Tag: 0x300A0104, Name: "Block Number of Points", Keyword: "BlockNumberOfPoints", VR: "IS", VM: "1"},
+	{Tag: 0x300A0106, Name: "Block Data", Keyword: "BlockData", VR: "DS", VM: "2-2n"},
+	{Tag: 0x300A0107, Name: "Applicator Sequence", Keyword: "ApplicatorSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300A0108, Name: "Applicator ID", Keyword: "ApplicatorID", VR: "SH", VM: "1"},
+	{Tag: 0x300A0109, Name: "Applicator Type", Keyword: "ApplicatorType", VR: "CS", VM: "1"},
+	{Tag: 0x300A010A, Name: "Applicator Description", Keyword: "ApplicatorDescription", VR: "LO", VM: "1"},
+	{Tag: 0x300A010C, Name: "Cumulative Dose Reference Coefficient", Keyword: "CumulativeDoseReferenceCoefficient", VR: "DS", VM: "1"},
+	{Tag: 0x300A010E, Name: "Final Cumulative Meterset Weight", Keyword: "FinalCumulativeMetersetWeight", VR: "DS", VM: "1"},
+	{Tag: 0x300A0110, Name: "Number of Control Points", Keyword: "NumberOfControlPoints", VR: "IS", VM: "1"},
+	{Tag: 0x300A0111, Name: "Control Point Sequence", Keyword: "ControlPointSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300A0112, Name: "Control Point Index", Keyword: "ControlPointIndex", VR: "IS", VM: "1"},
+	{Tag: 0x300A0114, Name: "Nominal Beam Energy", Keyword: "NominalBeamEnergy", VR: "DS", VM: "1"},
+	{Tag: 0x300A0115, Name: "Dose Rate Set", Keyword: "DoseRateSet", VR: "DS", VM: "1"},
+	{Tag: 0x300A0116, Name: "Wedge Position Sequence", Keyword: "WedgePositionSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300A0118, Name: "Wedge Position", Keyword: "WedgePosition", VR: "CS", VM: "1"},
+	{Tag: 0x300A011A, Name: "Beam Limiting Device Position Sequence", Keyword: "BeamLimitingDevicePositionSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300A011C, Name: "Leaf/Jaw Positions", Keyword: "LeafJawPositions", VR: "DS", VM: "2-2n"},
+	{Tag: 0x300A011E, Name: "Gantry Angle", Keyword: "GantryAngle", VR: "DS", VM: "1"},
+	{Tag: 0x300A011F, Name: "Gantry Rotation Direction", Keyword: "GantryRotationDirection", VR: "CS", VM: "1"},
+	{Tag: 0x300A0120, Name: "Beam Limiting Device Angle", Keyword: "BeamLimitingDeviceAngle", VR: "DS", VM: "1"},
+	{Tag: 0x300A0121, Name: "Beam Limiting Device Rotation Direction", Keyword: "BeamLimitingDeviceRotationDirection", VR: "CS", VM: "1"},
+	{Tag: 0x300A0122, Name: "Patient Support Angle", Keyword: "PatientSupportAngle", VR: "DS", VM: "1"},
+	{Tag: 0x300A0123, Name: "Patient Support Rotation Direction", Keyword: "PatientSupportRotationDirection", VR: "CS", VM: "1"},
+	{Tag: 0x300A0124, Name: "Table Top Eccentric Axis Distance", Keyword: "TableTopEccentricAxisDistance", VR: "DS", VM: "1"},
+	{Tag: 0x300A0125, Name: "Table Top Eccentric Angle", Keyword: "TableTopEccentricAngle", VR: "DS", VM: "1"},
+	{Tag: 0x300A0126, Name: "Table Top Eccentric Rotation Direction", Keyword: "TableTopEccentricRotationDirection", VR: "CS", VM: "1"},
+	{Tag: 0x300A0128, Name: "Table Top Vertical Position", Keyword: "TableTopVerticalPosition", VR: "DS", VM: "1"},
+	{Tag: 0x300A0129, Name: "Table Top Longitudinal Position", Keyword: "TableTopLongitudinalPosition", VR: "DS", VM: "1"},
+	{Tag: 0x300A012A, Name: "Table Top Lateral Position", Keyword: "TableTopLateralPosition", VR: "DS", VM: "1"},
+	{Tag: 0x300A012C, Name: "Isocenter Position", Keyword: "IsocenterPosition", VR: "DS", VM: "3"},
+	{Tag: 0x300A012E, Name: "Surface Entry Point", Keyword: "SurfaceEntryPoint", VR: "DS", VM: "3"},
+	{Tag: 0x300A0130, Name: "Source to Surface Distance", Keyword: "SourceToSurfaceDistance", VR: "DS", VM: "1"},
+	{Tag: 0x300A0131, Name: "Average Beam Dose Point Source to External Contour Distance", Keyword: "AverageBeamDosePointSourceToExternalContourDistance", VR: "FL", VM: "1"},
+	{Tag: 0x300A0132, Name: "Source to External Contour Distance", Keyword: "SourceToExternalContourDistance", VR: "FL", VM: "1"},
+	{Tag: 0x300A0133, Name: "External Contour Entry Point", Keyword: "ExternalContourEntryPoint", VR: "FL", VM: "3"},
+	{Tag: 0x300A0134, Name: "Cumulative Meterset Weight", Keyword: "CumulativeMetersetWeight", VR: "DS", VM: "1"},
+	{Tag: 0x300A0140, Name: "Table Top Pitch Angle", Keyword: "TableTopPitchAngle", VR: "FL", VM: "1"},
+	{Tag: 0x300A0142, Name: "Table Top Pitch Rotation Direction", Keyword: "TableTopPitchRotationDirection", VR: "CS", VM: "1"},
+	{Tag: 0x300A0144, Name: "Table Top Roll Angle", Keyword: "TableTopRollAngle", VR: "FL", VM: "1"},
+	{Tag: 0x300A0146, Name: "Table Top Roll Rotation Direction", Keyword: "TableTopRollRotationDirection", VR: "CS", VM: "1"},
+	{Tag: 0x300A0148, Name: "Head Fixation Angle", Keyword: "HeadFixationAngle", VR: "FL", VM: "1"},
+	{Tag: 0x300A014A, Name: "Gantry Pitch Angle", Keyword: "GantryPitchAngle", VR: "FL", VM: "1"},
+	{Tag: 0x300A014C, Name: "Gantry Pitch Rotation Direction", Keyword: "GantryPitchRotationDirection", VR: "CS", VM: "1"},
+	{Tag: 0x300A014E, Name: "Gantry Pitch Angle Tolerance", Keyword: "GantryPitchAngleTolerance", VR: "FL", VM: "1"},
+	{Tag: 0x300A0150, Name: "Fixation Eye", Keyword: "FixationEye", VR: "CS", VM: "1"},
+	{Tag: 0x300A0151, Name: "Chair Head Frame Position", Keyword: "ChairHeadFramePosition", VR: "DS", VM: "1"},
+	{Tag: 0x300A0152, Name: "Head Fixation Angle Tolerance", Keyword: "HeadFixationAngleTolerance", VR: "DS", VM: "1"},
+	{Tag: 0x300A0153, Name: "Chair Head Frame Position Tolerance", Keyword: "ChairHeadFramePositionTolerance", VR: "DS", VM: "1"},
+	{Tag: 0x300A0154, Name: "Fixation Light Azimuthal Angle Tolerance", Keyword: "FixationLightAzimuthalAngleTolerance", VR: "DS", VM: "1"},
+	{Tag: 0x300A0155, Name: "Fixation Light Polar Angle Tolerance", Keyword: "FixationLightPolarAngleTolerance", VR: "DS", VM: "1"},
+	{Tag: 0x300A0180, Name: "Patient Setup Sequence", Keyword: "PatientSetupSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300A0182, Name: "Patient Setup Number", Keyword: "PatientSetupNumber", VR: "IS", VM: "1"},
+	{Tag: 0x300A0183, Name: "Patient Setup Label", Keyword: "PatientSetupLabel", VR: "LO", VM: "1"},
+	{Tag: 0x300A0184, Name: "Patient Additional Position", Keyword: "PatientAdditionalPosition", VR: "LO", VM: "1"},
+	{Tag: 0x300A0190, Name: "Fixation Device Sequence", Keyword: "FixationDeviceSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300A0192, Name: "Fixation Device Type", Keyword: "FixationDeviceType", VR: "CS", VM: "1"},
+	{Tag: 0x300A0194, Name: "Fixation Device Label", Keyword: "FixationDeviceLabel", VR: "SH", VM: "1"},
+	{Tag: 0x300A0196, Name: "Fixation Device Description", Keyword: "FixationDeviceDescription", VR: "ST", VM: "1"},
+	{Tag: 0x300A0198, Name: "Fixation Device Position", Keyword: "FixationDevicePosition", VR: "SH", VM: "1"},
+	{Tag: 0x300A0199, Name: "Fixation Device Pitch Angle", Keyword: "FixationDevicePitchAngle", VR: "FL", VM: "1"},
+	{Tag: 0x300A019A, Name: "Fixation Device Roll Angle", Keyword: "FixationDeviceRollAngle", VR: "FL", VM: "1"},
+	{Tag: 0x300A01A0, Name: "Shielding Device Sequence", Keyword: "ShieldingDeviceSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300A01A2, Name: "Shielding Device Type", Keyword: "ShieldingDeviceType", VR: "CS", VM: "1"},
+	{Tag: 0x300A01A4, Name: "Shielding Device Label", Keyword: "ShieldingDeviceLabel", VR: "SH", VM: "1"},
+	{Tag: 0x300A01A6, Name: "Shielding Device Description", Keyword: "ShieldingDeviceDescription", VR: "ST", VM: "1"},
+	{Tag: 0x300A01A8, Name: "Shielding Device Position", Keyword: "ShieldingDevicePosition", VR: "SH", VM: "1"},
+	{Tag: 0x300A01B0, Name: "Setup Technique", Keyword: "SetupTechnique", VR: "CS", VM: "1"},
+	{Tag: 0x300A01B2, Name: "Setup Technique Description", Keyword: "SetupTechniqueDescription", VR: "ST", VM: "1"},
+	{Tag: 0x300A01B4, Name: "Setup Device Sequence", Keyword: "SetupDeviceSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300A01B6, Name: "Setup Device Type", Keyword: "SetupDeviceType", VR: "CS", VM: "1"},
+	{Tag: 0x300A01B8, Name: "Setup Device Label", Keyword: "SetupDeviceLabel", VR: "SH", VM: "1"},
+	{Tag: 0x300A01BA, Name: "Setup Device Description", Keyword: "SetupDeviceDescription", VR: "ST", VM: "1"},
+	{Tag: 0x300A01BC, Name: "Setup Device Parameter", Keyword: "SetupDeviceParameter", VR: "DS", VM: "1"},
+	{Tag: 0x300A01D0, Name: "Setup Reference Description", Keyword: "SetupReferenceDescription", VR: "ST", VM: "1"},
+	{Tag: 0x300A01D2, Name: "Table Top Vertical Setup Displacement", Keyword: "TableTopVerticalSetupDisplacement", VR: "DS", VM: "1"},
+	{Tag: 0x300A01D4, Name: "Table Top Longitudinal Setup Displacement", Keyword: "TableTopLongitudinalSetupDisplacement", VR: "DS", VM: "1"},
+	{Tag: 0x300A01D6, Name: "Table Top Lateral Setup Displacement", Keyword: "TableTopLateralSetupDisplacement", VR: "DS", VM: "1"},
+	{Tag: 0x300A0200, Name: "Brachy Treatment Technique", Keyword: "BrachyTreatmentTechnique", VR: "CS", VM: "1"},
+	{Tag: 0x300A0202, Name: "Brachy Treatment Type", Keyword: "BrachyTreatmentType", VR: "CS", VM: "1"},
+	{Tag: 0x300A0206, Name: "Treatment Machine Sequence", Keyword: "TreatmentMachineSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300A0210, Name: "Source Sequence", Keyword: "SourceSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300A0212, Name: "Source Number", Keyword: "SourceNumber", VR: "IS", VM: "1"},
+	{Tag: 0x300A0214, Name: "Source Type", Keyword: "SourceType", VR: "CS", VM: "1"},
+	{Tag: 0x300A0216, Name: "Source Manufacturer", Keyword: "SourceManufacturer", VR: "LO", VM: "1"},
+	{Tag: 0x300A0218, Name: "Active Source Diameter", Keyword: "ActiveSourceDiameter", VR: "DS", VM: "1"},
+	{Tag: 0x300A021A, Name: "Active Source Length", Keyword: "ActiveSourceLength", VR: "DS", VM: "1"},
+	{Tag: 0x300A021B, Name: "Source Model ID", Keyword: "SourceModelID", VR: "SH", VM: "1"},
+	{Tag: 0x300A021C, Name: "Source Description", Keyword: "SourceDescription", VR: "LO", VM: "1"},
+	{Tag: 0x300A0222, Name: "Source Encapsulation Nominal Thickness", Keyword: "SourceEncapsulationNominalThickness", VR: "DS", VM: "1"},
+	{Tag: 0x300A0224, Name: "Source Encapsulation Nominal Transmission", Keyword: "SourceEncapsulationNominalTransmission", VR: "DS", VM: "1"},
+	{Tag: 0x300A0226, Name: "Source Isotope Name", Keyword: "SourceIsotopeName", VR: "LO", VM: "1"},
+	{Tag: 0x300A0228, Name: "Source Isotope Half Life", Keyword: "SourceIsotopeHalfLife", VR: "DS", VM: "1"},
+	{Tag: 0x300A0229, Name: "Source Strength Units", Keyword: "SourceStrengthUnits", VR: "CS", VM: "1"},
+	{Tag: 0x300A022A, Name: "Reference Air Kerma Rate", Keyword: "ReferenceAirKermaRate", VR: "DS", VM: "1"},
+	{Tag: 0x300A022B, Name: "Source Strength", Keyword: "SourceStrength", VR: "DS", VM: "1"},
+	{Tag: 0x300A022C, Name: "Source Strength Reference Date", Keyword: "SourceStrengthReferenceDate", VR: "DA", VM: "1"},
+	{Tag: 0x300A022E, Name: "Source Strength Reference Time", Keyword: "SourceStrengthReferenceTime", VR: "TM", VM: "1"},
+	{Tag: 0x300A0230, Name: "Application Setup Sequence", Keyword: "ApplicationSetupSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300A0232, Name: "Application Setup Type", Keyword: "ApplicationSetupType", VR: "CS", VM: "1"},
+	{Tag: 0x300A0234, Name: "Application Setup Number", Keyword: "ApplicationSetupNumber", VR: "IS", VM: "1"},
+	{Tag: 0x300A0236, Name: "Application Setup Name", Keyword: "ApplicationSetupName", VR: "LO", VM: "1"},
+	{Tag: 0x300A0238, Name: "Application Setup Manufacturer", Keyword: "ApplicationSetupManufacturer", VR: "LO", VM: "1"},
+	{Tag: 0x300A0240, Name: "Template Number", Keyword: "TemplateNumber", VR: "IS", VM: "1"},
+	{Tag: 0x300A0242, Name: "Template Type", Keyword: "TemplateType", VR: "SH", VM: "1"},
+	{Tag: 0x300A0244, Name: "Template Name", Keyword: "TemplateName", VR: "LO", VM: "1"},
+	{Tag: 0x300A0250, Name: "Total Reference Air Kerma", Keyword: "TotalReferenceAirKerma", VR: "DS", VM: "1"},
+	{Tag: 0x300A0260, Name: "Brachy Accessory Device Sequence", Keyword: "BrachyAccessoryDeviceSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300A0262, Name: "Brachy Accessory Device Number", Keyword: "BrachyAccessoryDeviceNumber", VR: "IS", VM: "1"},
+	{Tag: 0x300A0263, Name: "Brachy Accessory Device ID", Keyword: "BrachyAccessoryDeviceID", VR: "SH", VM: "1"},
+	{Tag: 0x300A0264, Name: "Brachy Accessory Device Type", Keyword: "BrachyAccessoryDeviceType", VR: "CS", VM: "1"},
+	{Tag: 0x300A0266, Name: "Brachy Accessory Device Name", Keyword: "BrachyAccessoryDeviceName", VR: "LO", VM: "1"},
+	{Tag: 0x300A026A, Name: "Brachy Accessory Device Nominal Thickness", Keyword: "BrachyAccessoryDeviceNominalThickness", VR: "DS", VM: "1"},
+	{Tag: 0x300A026C, Name: "Brachy Accessory Device Nominal Transmission", Keyword: "BrachyAccessoryDeviceNominalTransmission", VR: "DS", VM: "1"},
+	{Tag: 0x300A0271, Name: "Channel Effective Length", Keyword: "ChannelEffectiveLength", VR: "DS", VM: "1"},
+	{Tag: 0x300A0272, Name: "Channel Inner Length", Keyword: "ChannelInnerLength", VR: "DS", VM: "1"},
+	{Tag: 0x300A0273, Name: "Afterloader Channel ID", Keyword: "AfterloaderChannelID", VR: "SH", VM: "1"},
+	{Tag: 0x300A0274, Name: "Source Applicator Tip Length", Keyword: "SourceApplicatorTipLength", VR: "DS", VM: "1"},
+	{Tag: 0x300A0280, Name: "Channel Sequence", Keyword: "ChannelSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300A0282, Name: "Channel Number", Keyword: "ChannelNumber", VR: "IS", VM: "1"},
+	{Tag: 0x300A0284, Name: "Channel Length", Keyword: "ChannelLength", VR: "DS", VM: "1"},
+	{Tag: 0x300A0286, Name: "Channel Total Time", Keyword: "ChannelTotalTime", VR: "DS", VM: "1"},
+	{Tag: 0x300A0288, Name: "Source Movement Type", Keyword: "SourceMovementType", VR: "CS", VM: "1"},
+	{Tag: 0x300A028A, Name: "Number of Pulses", Keyword: "NumberOfPulses", VR: "IS", VM: "1"},
+	{Tag: 0x300A028C, Name: "Pulse Repetition Interval", Keyword: "PulseRepetitionInterval", VR: "DS", VM: "1"},
+	{Tag: 0x300A0290, Name: "Source Applicator Number", Keyword: "SourceApplicatorNumber", VR: "IS", VM: "1"},
+	{Tag: 0x300A0291, Name: "Source Applicator ID", Keyword: "SourceApplicatorID", VR: "SH", VM: "1"},
+	{Tag: 0x300A0292, Name: "Source Applicator Type", Keyword: "SourceApplicatorType", VR: "CS", VM: "1"},
+	{Tag: 0x300A0294, Name: "Source Applicator Name", Keyword: "SourceApplicatorName", VR: "LO", VM: "1"},
+	{Tag: 0x300A0296, Name: "Source Applicator Length", Keyword: "SourceApplicatorLength", VR: "DS", VM: "1"},
+	{Tag: 0x300A0298, Name: "Source Applicator Manufacturer", Keyword: "SourceApplicatorManufacturer", VR: "LO", VM: "1"},
+	{Tag: 0x300A029C, Name: "Source Applicator Wall Nominal Thickness", Keyword: "SourceApplicatorWallNominalThickness", VR: "DS", VM: "1"},
+	{Tag: 0x300A029E, Name: "Source Applicator Wall Nominal Transmission", Keyword: "SourceApplicatorWallNominalTransmission", VR: "DS", VM: "1"},
+	{Tag: 0x300A02A0, Name: "Source Applicator Step Size", Keyword: "SourceApplicatorStepSize", VR: "DS", VM: "1"},
+	{Tag: 0x300A02A1, Name: "Applicator Shape Referenced ROI Number", Keyword: "ApplicatorShapeReferencedROINumber", VR: "IS", VM: "1"},
+	{Tag: 0x300A02A2, Name: "Transfer Tube Number", Keyword: "TransferTubeNumber", VR: "IS", VM: "1"},
+	{Tag: 0x300A02A4, Name: "Transfer Tube Length", Keyword: "TransferTubeLength", VR: "DS", VM: "1"},
+	{Tag: 0x300A02B0, Name: "Channel Shield Sequence", Keyword: "ChannelShieldSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300A02B2, Name: "Channel Shield Number", Keyword: "ChannelShieldNumber", VR: "IS", VM: "1"},
+	{Tag: 0x300A02B3, Name: "Channel Shield ID", Keyword: "ChannelShieldID", VR: "SH", VM: "1"},
+	{Tag: 0x300A02B4, Name: "Channel Shield Name", Keyword: "ChannelShieldName", VR: "LO", VM: "1"},
+	{Tag: 0x300A02B8, Name: "Channel Shield Nominal Thickness", Keyword: "ChannelShieldNominalThickness", VR: "DS", VM: "1"},
+	{Tag: 0x300A02BA, Name: "Channel Shield Nominal Transmission", Keyword: "ChannelShieldNominalTransmission", VR: "DS", VM: "1"},
+	{Tag: 0x300A02C8, Name: "Final Cumulative Time Weight", Keyword: "FinalCumulativeTimeWeight", VR: "DS", VM: "1"},
+	{Tag: 0x300A02D0, Name: "Brachy Control Point Sequence", Keyword: "BrachyControlPointSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300A02D2, Name: "Control Point Relative Position", Keyword: "ControlPointRelativePosition", VR: "DS", VM: "1"},
+	{Tag: 0x300A02D4, Name: "Control Point 3D Position", Keyword: "ControlPoint3DPosition", VR: "DS", VM: "3"},
+	{Tag: 0x300A02D6, Name: "Cumulative Time Weight", Keyword: "CumulativeTimeWeight", VR: "DS", VM: "1"},
+	{Tag: 0x300A02E0, Name: "Compensator Divergence", Keyword: "CompensatorDivergence", VR: "CS", VM: "1"},
+	{Tag: 0x300A02E1, Name: "Compensator Mounting Position", Keyword: "CompensatorMountingPosition", VR: "CS", VM: "1"},
+	{Tag: 0x300A02E2, Name: "Source to Compensator Distance", Keyword: "SourceToCompensatorDistance", VR: "DS", VM: "1-n"},
+	{Tag: 0x300A02E3, Name: "Total Compensator Tray Water-Equivalent Thickness", Keyword: "TotalCompensatorTrayWaterEquivalentThickness", VR: "FL", VM: "1"},
+	{Tag: 0x300A02E4, Name: "Isocenter to Compensator Tray Distance", Keyword: "IsocenterToCompensatorTrayDistance", VR: "FL", VM: "1"},
+	{Tag: 0x300A02E5, Name: "Compensator Column Offset", Keyword: "CompensatorColumnOffset", VR: "FL", VM: "1"},
+	{Tag: 0x300A02E6, Name: "Isocenter to Compensator Distances", Keyword: "IsocenterToCompensatorDistances", VR: "FL", VM: "1-n"},
+	{Tag: 0x300A02E7, Name: "Compensator Relative Stopping Power Ratio", Keyword: "CompensatorRelativeStoppingPowerRatio", VR: "FL", VM: "1"},
+	{Tag: 0x300A02E8, Name: "Compensator Milling Tool Diameter", Keyword: "CompensatorMillingToolDiameter", VR: "FL", VM: "1"},
+	{Tag: 0x300A02EA, Name: "Ion Range Compensator Sequence", Keyword: "IonRangeCompensatorSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300A02EB, Name: "Compensator Description", Keyword: "CompensatorDescription", VR: "LT", VM: "1"},
+	{Tag: 0x300A0302, Name: "Radiation Mass Number", Keyword: "RadiationMassNumber", VR: "IS", VM: "1"},
+	{Tag: 0x300A0304, Name: "Radiation Atomic Number", Keyword: "RadiationAtomicNumber", VR: "IS", VM: "1"},
+	{Tag: 0x300A0306, Name: "Radiation Charge State", Keyword: "RadiationChargeState", VR: "SS", VM: "1"},
+	{Tag: 0x300A0308, Name: "Scan Mode", Keyword: "ScanMode", VR: "CS", VM: "1"},
+	{Tag: 0x300A0309, Name: "Modulated Scan Mode Type", Keyword: "ModulatedScanModeType", VR: "CS", VM: "1"},
+	{Tag: 0x300A030A, Name: "Virtual Source-Axis Distances", Keyword: "VirtualSourceAxisDistances", VR: "FL", VM: "2"},
+	{Tag: 0x300A030C, Name: "Snout Sequence", Keyword: "SnoutSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300A030D, Name: "Snout Position", Keyword: "SnoutPosition", VR: "FL", VM: "1"},
+	{Tag: 0x300A030F, Name: "Snout ID", Keyword: "SnoutID", VR: "SH", VM: "1"},
+	{Tag: 0x300A0312, Name: "Number of Range Shifters", Keyword: "NumberOfRangeShifters", VR: "IS", VM: "1"},
+	{Tag: 0x300A0314, Name: "Range Shifter Sequence", Keyword: "RangeShifterSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300A0316, Name: "Range Shifter Number", Keyword: "RangeShifterNumber", VR: "IS", VM: "1"},
+	{Tag: 0x300A0318, Name: "Range Shifter ID", Keyword: "RangeShifterID", VR: "SH", VM: "1"},
+	{Tag: 0x300A0320, Name: "Range Shifter Type", Keyword: "RangeShifterType", VR: "CS", VM: "1"},
+	{Tag: 0x300A0322, Name: "Range Shifter Description", Keyword: "RangeShifterDescription", VR: "LO", VM: "1"},
+	{Tag: 0x300A0330, Name: "Number of Lateral Spreading Devices", Keyword: "NumberOfLateralSpreadingDevices", VR: "IS", VM: "1"},
+	{Tag: 0x300A0332, Name: "Lateral Spreading Device Sequence", Keyword: "LateralSpreadingDeviceSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300A0334, Name: "Lateral Spreading Device Number", Keyword: "LateralSpreadingDeviceNumber", VR: "IS", VM: "1"},
+	{Tag: 0x300A0336, Name: "Lateral Spreading Device ID", Keyword: "LateralSpreadingDeviceID", VR: "SH", VM: "1"},
+	{Tag: 0x300A0338, Name: "Lateral Spreading Device Type", Keyword: "LateralSpreadingDeviceType", VR: "CS", VM: "1"},
+	{Tag: 0x300A033A, Name: "Lateral Spreading Device Description", Keyword: "LateralSpreadingDeviceDescription", VR: "LO", VM: "1"},
+	{Tag: 0x300A033C, Name: "Lateral Spreading Device Water Equivalent Thickness", Keyword: "LateralSpreadingDeviceWaterEquivalentThickness", VR: "FL", VM: "1"},
+	{Tag: 0x300A0340, Name: "Number of Range Modulators", Keyword: "NumberOfRangeModulators", VR: "IS", VM: "1"},
+	{Tag: 0x300A0342, Name: "Range Modulator Sequence", Keyword: "RangeModulatorSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300A0344, Name: "Range Modulator Number", Keyword: "RangeModulatorNumber", VR: "IS", VM: "1"},
+	{Tag: 0x300A0346, Name: "Range Modulator ID", Keyword: "RangeModulatorID", VR: "SH", VM: "1"},
+	{Tag: 0x300A0348, Name: "Range Modulator Type", Keyword: "RangeModulatorType", VR: "CS", VM: "1"},
+	{Tag: 0x300A034A, Name: "Range Modulator Description", Keyword: "RangeModulatorDescription", VR: "LO", VM: "1"},
+	{Tag: 0x300A034C, Name: "Beam Current Modulation ID", Keyword: "BeamCurrentModulationID", VR: "SH", VM: "1"},
+	{Tag: 0x300A0350, Name: "Patient Support Type", Keyword: "PatientSupportType", VR: "CS", VM: "1"},
+	{Tag: 0x300A0352, Name: "Patient Support ID", Keyword: "PatientSupportID", VR: "SH", VM: "1"},
+	{Tag: 0x300A0354, Name: "Patient Support Accessory Code", Keyword: "PatientSupportAccessoryCode", VR: "LO", VM: "1"},
+	{Tag: 0x300A0355, Name: "Tray Accessory Code", Keyword: "TrayAccessoryCode", VR: "LO", VM: "1"},
+	{Tag: 0x300A0356, Name: "Fixation Light Azimuthal Angle", Keyword: "FixationLightAzimuthalAngle", VR: "FL", VM: "1"},
+	{Tag: 0x300A0358, Name: "Fixation Light Polar Angle", Keyword: "FixationLightPolarAngle", VR: "FL", VM: "1"},
+	{Tag: 0x300A035A, Name: "Meterset Rate", Keyword: "MetersetRate", VR: "FL", VM: "1"},
+	{Tag: 0x300A0360, Name: "Range Shifter Settings Sequence", Keyword: "RangeShifterSettingsSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300A0362, Name: "Range Shifter Setting", Keyword: "RangeShifterSetting", VR: "LO", VM: "1"},
+	{Tag: 0x300A0364, Name: "Isocenter to Range Shifter Distance", Keyword: "IsocenterToRangeShifterDistance", VR: "FL", VM: "1"},
+	{Tag: 0x300A0366, Name: "Range Shifter Water Equivalent Thickness", Keyword: "RangeShifterWaterEquivalentThickness", VR: "FL", VM: "1"},
+	{Tag: 0x300A0370, Name: "Lateral Spreading Device Settings Sequence", Keyword: "LateralSpreadingDeviceSettingsSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300A0372, Name: "Lateral Spreading Device Setting", Keyword: "LateralSpreadingDeviceSetting", VR: "LO", VM: "1"},
+	{Tag: 0x300A0374, Name: "Isocenter to Lateral Spreading Device Distance", Keyword: "IsocenterToLateralSpreadingDeviceDistance", VR: "FL", VM: "1"},
+	{Tag: 0x300A0380, Name: "Range Modulator Settings Sequence", Keyword: "RangeModulatorSettingsSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300A0382, Name: "Range Modulator Gating Start Value", Keyword: "RangeModulatorGatingStartValue", VR: "FL", VM: "1"},
+	{Tag: 0x300A0384, Name: "Range Modulator Gating Stop Value", Keyword: "RangeModulatorGatingStopValue", VR: "FL", VM: "1"},
+	{Tag: 0x300A0386, Name: "Range Modulator Gating Start Water Equivalent Thickness", Keyword: "RangeModulatorGatingStartWaterEquivalentThickness", VR: "FL", VM: "1"},
+	{Tag: 0x300A0388, Name: "Range Modulator Gating Stop Water Equivalent Thickness", Keyword: "RangeModulatorGatingStopWaterEquivalentThickness", VR: "FL", VM: "1"},
+	{Tag: 0x300A038A, Name: "Isocenter to Range Modulator Distance", Keyword: "IsocenterToRangeModulatorDistance", VR: "FL", VM: "1"},
+	{Tag: 0x300A038F, Name: "Scan Spot Time Offset", Keyword: "ScanSpotTimeOffset", VR: "FL", VM: "1-n"},
+	{Tag: 0x300A0390, Name: "Scan Spot Tune ID", Keyword: "ScanSpotTuneID", VR: "SH", VM: "1"},
+	{Tag: 0x300A0391, Name: "Scan Spot Prescribed Indices", Keyword: "ScanSpotPrescribedIndices", VR: "IS", VM: "1-n"},
+	{Tag: 0x300A0392, Name: "Number of Scan Spot Positions", Keyword: "NumberOfScanSpotPositions", VR: "IS", VM: "1"},
+	{Tag: 0x300A0393, Name: "Scan Spot Reordered", Keyword: "ScanSpotReordered", VR: "CS", VM: "1"},
+	{Tag: 0x300A0394, Name: "Scan Spot Position Map", Keyword: "ScanSpotPositionMap", VR: "FL", VM: "1-n"},
+	{Tag: 0x300A0395, Name: "Scan Spot Reordering Allowed", Keyword: "ScanSpotReorderingAllowed", VR: "CS", VM: "1"},
+	{Tag: 0x300A0396, Name: "Scan Spot Meterset Weights", Keyword: "ScanSpotMetersetWeights", VR: "FL", VM: "1-n"},
+	{Tag: 0x300A0398, Name: "Scanning Spot Size", Keyword: "ScanningSpotSize", VR: "FL", VM: "2"},
+	{Tag: 0x300A0399, Name: "Scan Spot Sizes Delivered", Keyword: "ScanSpotSizesDelivered", VR: "FL", VM: "2-2n"},
+	{Tag: 0x300A039A, Name: "Number of Paintings", Keyword: "NumberOfPaintings", VR: "IS", VM: "1"},
+	{Tag: 0x300A03A0, Name: "Ion Tolerance Table Sequence", Keyword: "IonToleranceTableSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300A03A2, Name: "Ion Beam Sequence", Keyword: "IonBeamSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300A03A4, Name: "Ion Beam Limiting Device Sequence", Keyword: "IonBeamLimitingDeviceSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300A03A6, Name: "Ion Block Sequence", Keyword: "IonBlockSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300A03A8, Name: "Ion Control Point Sequence", Keyword: "IonControlPointSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300A03AA, Name: "Ion Wedge Sequence", Keyword: "IonWedgeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300A03AC, Name: "Ion Wedge Position Sequence", Keyword: "IonWedgePositionSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300A0401, Name: "Referenced Setup Image Sequence", Keyword: "ReferencedSetupImageSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300A0402, Name: "Setup Image Comment", Keyword: "SetupImageComment", VR: "ST", VM: "1"},
+	{Tag: 0x300A0410, Name: "Motion Synchronization Sequence", Keyword: "MotionSynchronizationSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300A0412, Name: "Control Point Orientation", Keyword: "ControlPointOrientation", VR: "FL", VM: "3"},
+	{Tag: 0x300A0420, Name: "General Accessory Sequence", Keyword: "GeneralAccessorySequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300A0421, Name: "General Accessory ID", Keyword: "GeneralAccessoryID", VR: "SH", VM: "1"},
+	{Tag: 0x300A0422, Name: "General Accessory Description", Keyword: "GeneralAccessoryDescription", VR: "ST", VM: "1"},
+	{Tag: 0x300A0423, Name: "General Accessory Type", Keyword: "GeneralAccessoryType", VR: "CS", VM: "1"},
+	{Tag: 0x300A0424, Name: "General Accessory Number", Keyword: "GeneralAccessoryNumber", VR: "IS", VM: "1"},
+	{Tag: 0x300A0425, Name: "Source to General Accessory Distance", Keyword: "SourceToGeneralAccessoryDistance", VR: "FL", VM: "1"},
+	{Tag: 0x300A0426, Name: "Isocenter to General Accessory Distance", Keyword: "IsocenterToGeneralAccessoryDistance", VR: "DS", VM: "1"},
+	{Tag: 0x300A0431, Name: "Applicator Geometry Sequence", Keyword: "ApplicatorGeometrySequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300A0432, Name: "Applicator Aperture Shape", Keyword: "ApplicatorApertureShape", VR: "CS", VM: "1"},
+	{Tag: 0x300A0433, Name: "Applicator Opening", Keyword: "ApplicatorOpening", VR: "FL", VM: "1"},
+	{Tag: 0x300A0434, Name: "Applicator Opening X", Keyword: "ApplicatorOpeningX", VR: "FL", VM: "1"},
+	{Tag: 0x300A0435, Name: "Applicator Opening Y", Keyword: "ApplicatorOpeningY", VR: "FL", VM: "1"},
+	{Tag: 0x300A0436, Name: "Source to Applicator Mounting Position Distance", Keyword: "SourceToApplicatorMountingPositionDistance", VR: "FL", VM: "1"},
+	{Tag: 0x300A0440, Name: "Number of Block Slab Items", Keyword: "NumberOfBlockSlabItems", VR: "IS", VM: "1"},
+	{Tag: 0x300A0441, Name: "Block Slab Sequence", Keyword: "BlockSlabSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300A0442, Name: "Block Slab Thickness", Keyword: "BlockSlabThickness", VR: "DS", VM: "1"},
+	{Tag: 0x300A0443, Name: "Block Slab Number", Keyword: "BlockSlabNumber", VR: "US", VM: "1"},
+	{Tag: 0x300A0450, Name: "Device Motion Control Sequence", Keyword: "DeviceMotionControlSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300A0451, Name: "Device Motion Execution Mode", Keyword: "DeviceMotionExecutionMode", VR: "CS", VM: "1"},
+	{Tag: 0x300A0452, Name: "Device Motion Observation Mode", Keyword: "DeviceMotionObservationMode", VR: "CS", VM: "1"},
+	{Tag: 0x300A0453, Name: "Device Motion Parameter Code Sequence", Keyword: "DeviceMotionParameterCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300A0501, Name: "Distal Depth Fraction", Keyword: "DistalDepthFraction", VR: "FL", VM: "1"},
+	{Tag: 0x300A0502, Name: "Distal Depth", Keyword: "DistalDepth", VR: "FL", VM: "1"},
+	{Tag: 0x300A0503, Name: "Nominal Range Modulation Fractions", Keyword: "NominalRangeModulationFractions", VR: "FL", VM: "2"},
+	{Tag: 0x300A0504, Name: "Nominal Range Modulated Region Depths", Keyword: "NominalRangeModulatedRegionDepths", VR: "FL", VM: "2"},
+	{Tag: 0x300A0505, Name: "Depth Dose Parameters Sequence", Keyword: "DepthDoseParametersSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300A0506, Name: "Delivered Depth Dose Parameters Sequence", Keyword: "DeliveredDepthDoseParametersSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300A0507, Name: "Delivered Distal Depth Fraction", Keyword: "DeliveredDistalDepthFraction", VR: "FL", VM: "1"},
+	{Tag: 0x300A0508, Name: "Delivered Distal Depth", Keyword: "DeliveredDistalDepth", VR: "FL", VM: "1"},
+	{Tag: 0x300A0509, Name: "Delivered Nominal Range Modulation Fractions", Keyword: "DeliveredNominalRangeModulationFractions", VR: "FL", VM: "2"},
+	{Tag: 0x300A0510, Name: "Delivered Nominal Range Modulated Region Depths", Keyword: "DeliveredNominalRangeModulatedRegionDepths", VR: "FL", VM: "2"},
+	{Tag: 0x300A0511, Name: "Delivered Reference Dose Definition", Keyword: "DeliveredReferenceDoseDefinition", VR: "CS", VM: "1"},
+	{Tag: 0x300A0512, Name: "Reference Dose Definition", Keyword: "ReferenceDoseDefinition", VR: "CS", VM: "1"},
+	{Tag: 0x300A0600, Name: "RT Control Point Index", Keyword: "RTControlPointIndex", VR: "US", VM: "1"},
+	{Tag: 0x300A0601, Name: "Radiation Generation Mode Index", Keyword: "RadiationGenerationModeIndex", VR: "US", VM: "1"},
+	{Tag: 0x300A0602, Name: "Referenced Defined Device Index", Keyword: "ReferencedDefinedDeviceIndex", VR: "US", VM: "1"},
+	{Tag: 0x300A0603, Name: "Radiation Dose Identification Index", Keyword: "RadiationDoseIdentificationIndex", VR: "US", VM: "1"},
+	{Tag: 0x300A0604, Name: "Number of RT Control Points", Keyword: "NumberOfRTControlPoints", VR: "US", VM: "1"},
+	{Tag: 0x300A0605, Name: "Referenced Radiation Generation Mode Index", Keyword: "ReferencedRadiationGenerationModeIndex", VR: "US", VM: "1"},
+	{Tag: 0x300A0606, Name: "Treatment Position Index", Keyword: "TreatmentPositionIndex", VR: "US", VM: "1"},
+	{Tag: 0x300A0607, Name: "Referenced Device Index", Keyword: "ReferencedDeviceIndex", VR: "US", VM: "1"},
+	{Tag: 0x300A0608, Name: "Treatment Position Group Label", Keyword: "TreatmentPositionGroupLabel", VR: "LO", VM: "1"},
+	{Tag: 0x300A0609, Name: "Treatment Position Group UID", Keyword: "TreatmentPositionGroupUID", VR: "UI", VM: "1"},
+	{Tag: 0x300A060A, Name: "Treatment Position Group Sequence", Keyword: "TreatmentPositionGroupSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300A060B, Name: "Referenced Treatment Position Index", Keyword: "ReferencedTreatmentPositionIndex", VR: "US", VM: "1"},
+	{Tag: 0x300A060C, Name: "Referenced Radiation Dose Identification Index", Keyword: "ReferencedRadiationDoseIdentificationIndex", VR: "US", VM: "1"},
+	{Tag: 0x300A060D, Name: "RT Accessory Holder Water-Equivalent Thickness", Keyword: "RTAccessoryHolderWaterEquivalentThickness", VR: "FD", VM: "1"},
+	{Tag: 0x300A060E, Name: "Referenced RT Accessory Holder Device Index", Keyword: "ReferencedRTAccessoryHolderDeviceIndex", VR: "US", VM: "1"},
+	{Tag: 0x300A060F, Name: "RT Accessory Holder Slot Existence Flag", Keyword: "RTAccessoryHolderSlotExistenceFlag", VR: "CS", VM: "1"},
+	{Tag: 0x300A0610, Name: "RT Accessory Holder Slot Sequence", Keyword: "RTAccessoryHolderSlotSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300A0611, Name: "RT Accessory Holder Slot ID", Keyword: "RTAccessoryHolderSlotID", VR: "LO", VM: "1"},
+	{Tag: 0x300A0612, Name: "RT Accessory Holder Slot Distance", Keyword: "RTAccessoryHolderSlotDistance", VR: "FD", VM: "1"},
+	{Tag: 0x300A0613, Name: "RT Accessory Slot Distance", Keyword: "RTAccessorySlotDistance", VR: "FD", VM: "1"},
+	{Tag: 0x300A0614, Name: "RT Accessory Holder Definition Sequence", Keyword: "RTAccessoryHolderDefinitionSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300A0615, Name: "RT Accessory Device Slot ID", Keyword: "RTAccessoryDeviceSlotID", VR: "LO", VM: "1"},
+	{Tag: 0x300A0616, Name: "RT Radiation Sequence", Keyword: "RTRadiationSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300A0617, Name: "Radiation Dose Sequence", Keyword: "RadiationDoseSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300A0618, Name: "Radiation Dose Identification Sequence", Keyword: "RadiationDoseIdentificationSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300A0619, Name: "Radiation Dose Identification Label", Keyword: "RadiationDoseIdentificationLabel", VR: "LO", VM: "1"},
+	{Tag: 0x300A061A, Name: "Reference Dose Type", Keyword: "ReferenceDoseType", VR: "CS", VM: "1"},
+	{Tag: 0x300A061B, Name: "Primary Dose Value Indicator", Keyword: "PrimaryDoseValueIndicator", VR: "CS", VM: "1"},
+	{Tag: 0x300A061C, Name: "Dose Values Sequence", Keyword: "DoseValuesSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300A061D, Name: "Dose Value Purpose", Keyword: "DoseValuePurpose", VR: "CS", VM: "1-n"},
+	{Tag: 0x300A061E, Name: "Reference Dose Point Coordinates", Keyword: "ReferenceDosePointCoordinates", VR: "FD", VM: "3"},
+	{Tag: 0x300A061F, Name: "Radiation Dose Values Parameters Sequence", Keyword: "RadiationDoseValuesParametersSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300A0620, Name: "Meterset to Dose Mapping Sequence", Keyword: "MetersetToDoseMappingSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300A0621, Name: "Expected In-Vivo Measurement Values Sequence", Keyword: "ExpectedInVivoMeasurementValuesSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300A0622, Name: "Expected In-Vivo Measurement Value Index", Keyword: "ExpectedInVivoMeasurementValueIndex", VR: "US", VM: "1"},
+	{Tag: 0x300A0623, Name: "Radiation Dose In-Vivo Measurement Label", Keyword: "RadiationDoseInVivoMeasurementLabel", VR: "LO", VM: "1"},
+	{Tag: 0x300A0624, Name: "Radiation Dose Central Axis Displacement", Keyword: "RadiationDoseCentralAxisDisplacement", VR: "FD", VM: "2"},
+	{Tag: 0x300A0625, Name: "Radiation Dose Value", Keyword: "RadiationDoseValue", VR: "FD", VM: "1"},
+	{Tag: 0x300A0626, Name: "Radiation Dose Source to Skin Distance", Keyword: "RadiationDoseSourceToSkinDistance", VR: "FD", VM: "1"},
+	{Tag: 0x300A0627, Name: "Radiation Dose Measurement Point Coordinates", Keyword: "RadiationDoseMeasurementPointCoordinates", VR: "FD", VM: "3"},
+	{Tag: 0x300A0628, Name: "Radiation Dose Source to External Contour Distance", Keyword: "RadiationDoseSourceToExternalContourDistance", VR: "FD", VM: "1"},
+	{Tag: 0x300A0629, Name: "RT Tolerance Set Sequence", Keyword: "RTToleranceSetSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300A062A, Name: "RT Tolerance Set Label", Keyword: "RTToleranceSetLabel", VR: "LO", VM: "1"},
+	{Tag: 0x300A062B, Name: "Attribute Tolerance Values Sequence", Keyword: "AttributeToleranceValuesSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300A062C, Name: "Tolerance Value", Keyword: "ToleranceValue", VR: "FD", VM: "1"},
+	{Tag: 0x300A062D, Name: "Patient Support Position Tolerance Sequence", Keyword: "PatientSupportPositionToleranceSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300A062E, Name: "Treatment Time Limit", Keyword: "TreatmentTimeLimit", VR: "FD", VM: "1"},
+	{Tag: 0x300A062F, Name: "C-Arm Photon-Electron Control Point Sequence", Keyword: "CArmPhotonElectronControlPointSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300A0630, Name: "Referenced RT Radiation Sequence", Keyword: "ReferencedRTRadiationSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300A0631, Name: "Referenced RT Instance Sequence", Keyword: "ReferencedRTInstanceSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300A0632, Name: "Referenced RT Patient Setup Sequence", Keyword: "ReferencedRTPatientSetupSequence", VR: "SQ", VM: "1", Retired: true},
+	{Tag: 0x300A0634, Name: "Source to Patient Surface Distance", Keyword: "SourceToPatientSurfaceDistance", VR: "FD", VM: "1"},
+	{Tag: 0x300A0635, Name: "Treatment Machine Special Mode Code Sequence", Keyword: "TreatmentMachineSpecialModeCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300A0636, Name: "Intended Number of Fractions", Keyword: "IntendedNumberOfFractions", VR: "US", VM: "1"},
+	{Tag: 0x300A0637, Name: "RT Radiation Set Intent", Keyword: "RTRadiationSetIntent", VR: "CS", VM: "1"},
+	{Tag: 0x300A0638, Name: "RT Radiation Physical and Geometric Content Detail Flag", Keyword: "RTRadiationPhysicalAndGeometricContentDetailFlag", VR: "CS", VM: "1"},
+	{Tag: 0x300A0639, Name: "RT Record Flag", Keyword: "RTRecordFlag", VR: "CS", VM: "1"},
+	{Tag: 0x300A063A, Name: "Treatment Device Identification Sequence", Keyword: "TreatmentDeviceIdentificationSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300A063B, Name: "Referenced RT Physician Intent Sequence", Keyword: "ReferencedRTPhysicianIntentSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300A063C, Name: "Cumulative Meterset", Keyword: "CumulativeMeterset", VR: "FD", VM: "1"},
+	{Tag: 0x300A063D, Name: "Delivery Rate", Keyword: "DeliveryRate", VR: "FD", VM: "1"},
+	{Tag: 0x300A063E, Name: "Delivery Rate Unit Sequence", Keyword: "DeliveryRateUnitSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300A063F, Name: "Treatment Position Sequence", Keyword: "TreatmentPositionSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300A0640, Name: "Radiation Source-Axis Distance", Keyword: "RadiationSourceAxisDistance", VR: "FD", VM: "1"},
+	{Tag: 0x300A0641, Name: "Number of RT Beam Limiting Devices", Keyword: "NumberOfRTBeamLimitingDevices", VR: "US", VM: "1"},
+	{Tag: 0x300A0642, Name: "RT Beam Limiting Device Proximal Distance", Keyword: "RTBeamLimitingDeviceProximalDistance", VR: "FD", VM: "1"},
+	{Tag: 0x300A0643, Name: "RT Beam Limiting Device Distal Distance", Keyword: "RTBeamLimitingDeviceDistalDistance", VR: "FD", VM: "1"},
+	{Tag: 0x300A0644, Name: "Parallel RT Beam Delimiter Device Orientation Label Code Sequence", Keyword: "ParallelRTBeamDelimiterDeviceOrientationLabelCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300A0645, Name: "Beam Modifier Orientation Angle", Keyword: "BeamModifierOrientationAngle", VR: "FD", VM: "1"},
+	{Tag: 0x300A0646, Name: "Fixed RT Beam Delimiter Device Sequence", Keyword: "FixedRTBeamDelimiterDeviceSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300A0647, Name: "Parallel RT Beam Delimiter Device Sequence", Keyword: "ParallelRTBeamDelimiterDeviceSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300A0648, Name: "Number of Parallel RT Beam Delimiters", Keyword: "NumberOfParallelRTBeamDelimiters", VR: "US", VM: "1"},
+	{Tag: 0x300A0649, Name: "Parallel RT Beam Delimiter Boundaries", Keyword: "ParallelRTBeamDelimiterBoundaries", VR: "FD", VM: "2-n"},
+	{Tag: 0x300A064A, Name: "Parallel RT Beam Delimiter Positions", Keyword: "ParallelRTBeamDelimiterPositions", VR: "FD", VM: "2-n"},
+	{Tag: 0x300A064B, Name: "RT Beam Limiting Device Offset", Keyword: "RTBeamLimitingDeviceOffset", VR: "FD", VM: "2"},
+	{Tag: 0x300A064C, Name: "RT Beam Delimiter Geometry Sequence", Keyword: "RTBeamDelimiterGeometrySequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300A064D, Name: "RT Beam Limiting Device Definition Sequence", Keyword: "RTBeamLimitingDeviceDefinitionSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300A064E, Name: "Parallel RT Beam Delimiter Opening Mode", Keyword: "ParallelRTBeamDelimiterOpeningMode", VR: "CS", VM: "1"},
+	{Tag: 0x300A064F, Name: "Parallel RT Beam Delimiter Leaf Mounting Side", Keyword: "ParallelRTBeamDelimiterLeafMountingSide", VR: "CS", VM: "1-n"},
+	{Tag: 0x300A0650, Name: "Patient Setup UID", Keyword: "PatientSetupUID", VR: "UI", VM: "1", Retired: true},
+	{Tag: 0x300A0651, Name: "Wedge Definition Sequence", Keyword: "WedgeDefinitionSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300A0652, Name: "Radiation Beam Wedge Angle", Keyword: "RadiationBeamWedgeAngle", VR: "FD", VM: "1"},
+	{Tag: 0x300A0653, Name: "Radiation Beam Wedge Thin Edge Distance", Keyword: "RadiationBeamWedgeThinEdgeDistance", VR: "FD", VM: "1"},
+	{Tag: 0x300A0654, Name: "Radiation Beam Effective Wedge Angle", Keyword: "RadiationBeamEffectiveWedgeAngle", VR: "FD", VM: "1"},
+	{Tag: 0x300A0655, Name: "Number of Wedge Positions", Keyword: "NumberOfWedgePositions", VR: "US", VM: "1"},
+	{Tag: 0x300A0656, Name: "RT Beam Limiting Device Opening Sequence", Keyword: "RTBeamLimitingDeviceOpeningSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300A0657, Name: "Number of RT Beam Limiting Device Openings", Keyword: "NumberOfRTBeamLimitingDeviceOpenings", VR: "US", VM: "1"},
+	{Tag: 0x300A0658, Name: "Radiation Dosimeter Unit Sequence", Keyword: "RadiationDosimeterUnitSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300A0659, Name: "RT Device Distance Reference Location Code Sequence", Keyword: "RTDeviceDistanceReferenceLocationCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300A065A, Name: "Radiation Device Configuration and Commissioning Key Sequence", Keyword: "RadiationDeviceConfigurationAndCommissioningKeySequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300A065B, Name: "Patient Support Position Parameter Sequence", Keyword: "PatientSupportPositionParameterSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300A065C, Name: "Patient Support Position Specification Method", Keyword: "PatientSupportPositionSpecificationMethod", VR: "CS", VM: "1"},
+	{Tag: 0x300A065D, Name: "Patient Support Position Device Parameter Sequence", Keyword: "PatientSupportPositionDeviceParameterSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300A065E, Name: "Device Order Index", Keyword: "DeviceOrderIndex", VR: "US", VM: "1"},
+	{Tag: 0x300A065F, Name: "Patient Support Position Parameter Order Index", Keyword: "PatientSupportPositionParameterOrderIndex", VR: "US", VM: "1"},
+	{Tag: 0x300A0660, Name: "Patient Support Position Device Tolerance Sequence", Keyword: "PatientSupportPositionDeviceToleranceSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300A0661, Name: "Patient Support Position Tolerance Order Index", Keyword: "PatientSupportPositionToleranceOrderIndex", VR: "US", VM: "1"},
+	{Tag: 0x300A0662, Name: "Compensator Definition Sequence", Keyword: "CompensatorDefinitionSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300A0663, Name: "Compensator Map Orientation", Keyword: "CompensatorMapOrientation", VR: "CS", VM: "1"},
+	{Tag: 0x300A0664, Name: "Compensator Proximal Thickness Map", Keyword: "CompensatorProximalThicknessMap", VR: "OF", VM: "1"},
+	{Tag: 0x300A0665, Name: "Compensator Distal Thickness Map", Keyword: "CompensatorDistalThicknessMap", VR: "OF", VM: "1"},
+	{Tag: 0x300A0666, Name: "Compensator Base Plane Offset", Keyword: "CompensatorBasePlaneOffset", VR: "FD", VM: "1"},
+	{Tag: 0x300A0667, Name: "Compensator Shape Fabrication Code Sequence", Keyword: "CompensatorShapeFabricationCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300A0668, Name: "Compensator Shape Sequence", Keyword: "CompensatorShapeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300A0669, Name: "Radiation Beam Compensator Milling Tool Diameter", Keyword: "RadiationBeamCompensatorMillingToolDiameter", VR: "FD", VM: "1"},
+	{Tag: 0x300A066A, Name: "Block Definition Sequence", Keyword: "BlockDefinitionSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300A066B, Name: "Block Edge Data", Keyword: "BlockEdgeData", VR: "OF", VM: "1"},
+	{Tag: 0x300A066C, Name: "Block Orientation", Keyword: "BlockOrientation", VR: "CS", VM: "1"},
+	{Tag: 0x300A066D, Name: "Radiation Beam Block Thickness", Keyword: "RadiationBeamBlockThickness", VR: "FD", VM: "1"},
+	{Tag: 0x300A066E, Name: "Radiation Beam Block Slab Thickness", Keyword: "RadiationBeamBlockSlabThickness", VR: "FD", VM: "1"},
+	{Tag: 0x300A066F, Name: "Block Edge Data Sequence", Keyword: "BlockEdgeDataSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300A0670, Name: "Number of RT Accessory Holders", Keyword: "NumberOfRTAccessoryHolders", VR: "US", VM: "1"},
+	{Tag: 0x300A0671, Name: "General Accessory Definition Sequence", Keyword: "GeneralAccessoryDefinitionSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300A0672, Name: "Number of General Accessories", Keyword: "NumberOfGeneralAccessories", VR: "US", VM: "1"},
+	{Tag: 0x300A0673, Name: "Bolus Definition Sequence", Keyword: "BolusDefinitionSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300A0674, Name: "Number of Boluses", Keyword: "NumberOfBoluses", VR: "US", VM: "1"},
+	{Tag: 0x300A0675, Name: "Equipment Frame of Reference UID", Keyword: "EquipmentFrameOfReferenceUID", VR: "UI", VM: "1"},
+	{Tag: 0x300A0676, Name: "Equipment Frame of Reference Description", Keyword: "EquipmentFrameOfReferenceDescription", VR: "ST", VM: "1"},
+	{Tag: 0x300A0677, Name: "Equipment Reference Point Coordinates Sequence", Keyword: "EquipmentReferencePointCoordinatesSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300A0678, Name: "Equipment Reference Point Code Sequence", Keyword: "EquipmentReferencePointCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300A0679, Name: "RT Beam Limiting Device Angle", Keyword: "RTBeamLimitingDeviceAngle", VR: "FD", VM: "1"},
+	{Tag: 0x300A067A, Name: "Source Roll Angle", Keyword: "SourceRollAngle", VR: "FD", VM: "1"},
+	{Tag: 0x300A067B, Name: "Radiation GenerationMode Sequence", Keyword: "RadiationGenerationModeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300A067C, Name: "Radiation GenerationMode Label", Keyword: "RadiationGenerationModeLabel", VR: "SH", VM: "1"},
+	{Tag: 0x300A067D, Name: "Radiation GenerationMode Description", Keyword: "RadiationGenerationModeDescription", VR: "ST", VM: "1"},
+	{Tag: 0x300A067E, Name: "Radiation GenerationMode Machine Code Sequence", Keyword: "RadiationGenerationModeMachineCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300A067F, Name: "Radiation Type Code Sequence", Keyword: "RadiationTypeCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300A0680, Name: "Nominal Energy", Keyword: "NominalEnergy", VR: "DS", VM: "1"},
+	{Tag: 0x300A0681, Name: "Minimum Nominal Energy", Keyword: "MinimumNominalEnergy", VR: "DS", VM: "1"},
+	{Tag: 0x300A0682, Name: "Maximum Nominal Energy", Keyword: "MaximumNominalEnergy", VR: "DS", VM: "1"},
+	{Tag: 0x300A0683, Name: "Radiation Fluence Modifier Code Sequence", Keyword: "RadiationFluenceModifierCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300A0684, Name: "Energy Unit Code Sequence", Keyword: "EnergyUnitCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300A0685, Name: "Number of Radiation GenerationModes", Keyword: "NumberOfRadiationGenerationModes", VR: "US", VM: "1"},
+	{Tag: 0x300A0686, Name: "Patient Support Devices Sequence", Keyword: "PatientSupportDevicesSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300A0687, Name: "Number of Patient Support Devices", Keyword: "NumberOfPatientSupportDevices", VR: "US", VM: "1"},
+	{Tag: 0x300A0688, Name: "RT Beam Modifier Definition Distance", Keyword: "RTBeamModifierDefinitionDistance", VR: "FD", VM: "1"},
+	{Tag: 0x300A0689, Name: "Beam Area Limit Sequence", Keyword: "BeamAreaLimitSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300A068A, Name: "Referenced RT Prescription Sequence", Keyword: "ReferencedRTPrescriptionSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300A068B, Name: "Dose Value Interpretation", Keyword: "DoseValueInterpretation", VR: "CS", VM: "1"},
+	{Tag: 0x300A0700, Name: "Treatment Session UID", Keyword: "TreatmentSessionUID", VR: "UI", VM: "1"},
+	{Tag: 0x300A0701, Name: "RT Radiation Usage", Keyword: "RTRadiationUsage", VR: "CS", VM: "1"},
+	{Tag: 0x300A0702, Name: "Referenced RT Radiation Set Sequence", Keyword: "ReferencedRTRadiationSetSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300A0703, Name: "Referenced RT Radiation Record Sequence", Keyword: "ReferencedRTRadiationRecordSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300A0704, Name: "RT Radiation Set Delivery Number", Keyword: "RTRadiationSetDeliveryNumber", VR: "US", VM: "1"},
+	{Tag: 0x300A0705, Name: "Clinical Fraction Number", Keyword: "ClinicalFractionNumber", VR: "US", VM: "1"},
+	{Tag: 0x300A0706, Name: "RT Treatment Fraction Completion Status", Keyword: "RTTreatmentFractionCompletionStatus", VR: "CS", VM: "1"},
+	{Tag: 0x300A0707, Name: "RT Radiation Set Usage", Keyword: "RTRadiationSetUsage", VR: "CS", VM: "1"},
+	{Tag: 0x300A0708, Name: "Treatment Delivery Continuation Flag", Keyword: "TreatmentDeliveryContinuationFlag", VR: "CS", VM: "1"},
+	{Tag: 0x300A0709, Name: "Treatment Record Content Origin", Keyword: "TreatmentRecordContentOrigin", VR: "CS", VM: "1"},
+	{Tag: 0x300A0714, Name: "RT Treatment Termination Status", Keyword: "RTTreatmentTerminationStatus", VR: "CS", VM: "1"},
+	{Tag: 0x300A0715, Name: "RT Treatment Termination Reason Code Sequence", Keyword: "RTTreatmentTerminationReasonCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300A0716, Name: "Machine-Specific Treatment Termination Code Sequence", Keyword: "MachineSpecificTreatmentTerminationCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300A0722, Name: "RT Radiation Salvage Record Control Point Sequence", Keyword: "RTRadiationSalvageRecordControlPointSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300A0723, Name: "Starting Meterset Value Known Flag", Keyword: "StartingMetersetValueKnownFlag", VR: "CS", VM: "1"},
+	{Tag: 0x300A0730, Name: "Treatment Termination Description", Keyword: "TreatmentTerminationDescription", VR: "ST", VM: "1"},
+	{Tag: 0x300A0731, Name: "Treatment Tolerance Violation Sequence", Keyword: "TreatmentToleranceViolationSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300A0732, Name: "Treatment Tolerance Violation Category", Keyword: "TreatmentToleranceViolationCategory", VR: "CS", VM: "1"},
+	{Tag: 0x300A0733, Name: "Treatment Tolerance Violation Attribute Sequence", Keyword: "TreatmentToleranceViolationAttributeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300A0734, Name: "Treatment Tolerance Violation Description", Keyword: "TreatmentToleranceViolationDescription", VR: "ST", VM: "1"},
+	{Tag: 0x300A0735, Name: "Treatment Tolerance Violation Identification", Keyword: "TreatmentToleranceViolationIdentification", VR: "ST", VM: "1"},
+	{Tag: 0x300A0736, Name: "Treatment Tolerance Violation DateTime", Keyword: "TreatmentToleranceViolationDateTime", VR: "DT", VM: "1"},
+	{Tag: 0x300A073A, Name: "Recorded RT Control Point DateTime", Keyword: "RecordedRTControlPointDateTime", VR: "DT", VM: "1"},
+	{Tag: 0x300A073B, Name: "Referenced Radiation RT Control Point Index", Keyword: "ReferencedRadiationRTControlPointIndex", VR: "US", VM: "1"},
+	{Tag: 0x300A073E, Name: "Alternate Value Sequence", Keyword: "AlternateValueSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300A073F, Name: "Confirmation Sequence", Keyword: "ConfirmationSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300A0740, Name: "Interlock Sequence", Keyword: "InterlockSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300A0741, Name: "Interlock DateTime", Keyword: "InterlockDateTime", VR: "DT", VM: "1"},
+	{Tag: 0x300A0742, Name: "Interlock Description", Keyword: "InterlockDescription", VR: "ST", VM: "1"},
+	{Tag: 0x300A0743, Name: "Interlock Originating Device Sequence", Keyword: "InterlockOriginatingDeviceSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300A0744, Name: "Interlock Code Sequence", Keyword: "InterlockCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300A0745, Name: "Interlock Resolution Code Sequence", Keyword: "InterlockResolutionCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300A0746, Name: "Interlock Resolution User Sequence", Keyword: "InterlockResolutionUserSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300A0760, Name: "Override DateTime", Keyword: "OverrideDateTime", VR: "DT", VM: "1"},
+	{Tag: 0x300A0761, Name: "Treatment Tolerance Violation Type Code Sequence", Keyword: "TreatmentToleranceViolationTypeCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300A0762, Name: "Treatment Tolerance Violation Cause Code Sequence", Keyword: "TreatmentToleranceViolationCauseCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300A0772, Name: "Measured Meterset to Dose Mapping Sequence", Keyword: "MeasuredMetersetToDoseMappingSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300A0773, Name: "Referenced Expected In-Vivo Measurement Value Index", Keyword: "ReferencedExpectedInVivoMeasurementValueIndex", VR: "US", VM: "1"},
+	{Tag: 0x300A0774, Name: "Dose Measurement Device Code Sequence", Keyword: "DoseMeasurementDeviceCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300A0780, Name: "Additional Parameter Recording Instance Sequence", Keyword: "AdditionalParameterRecordingInstanceSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300A0783, Name: "Interlock Origin Description", Keyword: "InterlockOriginDescription", VR: "ST", VM: "1"},
+	{Tag: 0x300A0784, Name: "RT Patient Position Scope Sequence", Keyword: "RTPatientPositionScopeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300A0785, Name: "Referenced Treatment Position Group UID", Keyword: "ReferencedTreatmentPositionGroupUID", VR: "UI", VM: "1"},
+	{Tag: 0x300A0786, Name: "Radiation Order Index", Keyword: "RadiationOrderIndex", VR: "US", VM: "1"},
+	{Tag: 0x300A0787, Name: "Omitted Radiation Sequence", Keyword: "OmittedRadiationSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300A0788, Name: "Reason for Omission Code Sequence", Keyword: "ReasonForOmissionCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300A0789, Name: "RT Delivery Start Patient Position Sequence", Keyword: "RTDeliveryStartPatientPositionSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300A078A, Name: "RT Treatment Preparation Patient Position Sequence", Keyword: "RTTreatmentPreparationPatientPositionSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300A078B, Name: "Referenced RT Treatment Preparation Sequence", Keyword: "ReferencedRTTreatmentPreparationSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300A078C, Name: "Referenced Patient Setup Photo Sequence", Keyword: "ReferencedPatientSetupPhotoSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300A078D, Name: "Patient Treatment Preparation Method Code Sequence", Keyword: "PatientTreatmentPreparationMethodCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300A078E, Name: "Patient Treatment Preparation Procedure Parameter Description", Keyword: "PatientTreatmentPreparationProcedureParameterDescription", VR: "LT", VM: "1"},
+	{Tag: 0x300A078F, Name: "Patient Treatment Preparation Device Sequence", Keyword: "PatientTreatmentPreparationDeviceSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300A0790, Name: "Patient Treatment Preparation Procedure Sequence", Keyword: "PatientTreatmentPreparationProcedureSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300A0791, Name: "Patient Treatment Preparation Procedure Code Sequence", Keyword: "PatientTreatmentPreparationProcedureCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300A0792, Name: "Patient Treatment Preparation Method Description", Keyword: "PatientTreatmentPreparationMethodDescription", VR: "LT", VM: "1"},
+	{Tag: 0x300A0793, Name: "Patient Treatment Preparation Procedure Parameter Sequence", Keyword: "PatientTreatmentPreparationProcedureParameterSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300A0794, Name: "Patient Setup Photo Description", Keyword: "PatientSetupPhotoDescription", VR: "LT", VM: "1"},
+	{Tag: 0x300A0795, Name: "Patient Treatment Preparation Procedure Index", Keyword: "PatientTreatmentPreparationProcedureIndex", VR: "US", VM: "1"},
+	{Tag: 0x300A0796, Name: "Referenced Patient Setup Procedure Index", Keyword: "ReferencedPatientSetupProcedureIndex", VR: "US", VM: "1"},
+	{Tag: 0x300A0797, Name: "RT Radiation Task Sequence", Keyword: "RTRadiationTaskSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300A0798, Name: "RT Patient Position Displacement Sequence", Keyword: "RTPatientPositionDisplacementSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300A0799, Name: "RT Patient Position Sequence", Keyword: "RTPatientPositionSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300A079A, Name: "Displacement Reference Label", Keyword: "DisplacementReferenceLabel", VR: "LO", VM: "1"},
+	{Tag: 0x300A079B, Name: "Displacement Matrix", Keyword: "DisplacementMatrix", VR: "FD", VM: "16"},
+	{Tag: 0x300A079C, Name: "Patient Support Displacement Sequence", Keyword: "PatientSupportDisplacementSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300A079D, Name: "Displacement Reference Location Code Sequence", Keyword: "DisplacementReferenceLocationCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300A079E, Name: "RT Radiation Set Delivery Usage", Keyword: "RTRadiationSetDeliveryUsage", VR: "CS", VM: "1"},
+	{Tag: 0x300C0002, Name: "Referenced RT Plan Sequence", Keyword: "ReferencedRTPlanSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300C0004, Name: "Referenced Beam Sequence", Keyword: "ReferencedBeamSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300C0006, Name: "Referenced Beam Number", Keyword: "ReferencedBeamNumber", VR: "IS", VM: "1"},
+	{Tag: 0x300C0007, Name: "Referenced Reference Image Number", Keyword: "ReferencedReferenceImageNumber", VR: "IS", VM: "1"},
+	{Tag: 0x300C0008, Name: "Start Cumulative Meterset Weight", Keyword: "StartCumulativeMetersetWeight", VR: "DS", VM: "1"},
+	{Tag: 0x300C0009, Name: "End Cumulative Meterset Weight", Keyword: "EndCumulativeMetersetWeight", VR: "DS", VM: "1"},
+	{Tag: 0x300C000A, Name: "Referenced Brachy Application Setup Sequence", Keyword: "ReferencedBrachyApplicationSetupSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300C000C, Name: "Referenced Brachy Application Setup Number", Keyword: "ReferencedBrachyApplicationSetupNumber", VR: "IS", VM: "1"},
+	{Tag: 0x300C000E, Name: "Referenced Source Number", Keyword: "ReferencedSourceNumber", VR: "IS", VM: "1"},
+	{Tag: 0x300C0020, Name: "Referenced Fraction Group Sequence", Keyword: "ReferencedFractionGroupSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300C0022, Name: "Referenced Fraction Group Number", Keyword: "ReferencedFractionGroupNumber", VR: "IS", VM: "1"},
+	{Tag: 0x300C0040, Name: "Referenced Verification Image Sequence", Keyword: "ReferencedVerificationImageSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300C0042, Name: "Referenced Reference Image Sequence", Keyword: "ReferencedReferenceImageSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300C0050, Name: "Referenced Dose Reference Sequence", Keyword: "ReferencedDoseReferenceSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300C0051, Name: "Referenced Dose Reference Number", Keyword: "ReferencedDoseReferenceNumber", VR: "IS", VM: "1"},
+	{Tag: 0x300C0055, Name: "Brachy Referenced Dose Reference Sequence", Keyword: "BrachyReferencedDoseReferenceSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300C0060, Name: "Referenced Structure Set Sequence", Keyword: "ReferencedStructureSetSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300C006A, Name: "Referenced Patient Setup Number", Keyword: "ReferencedPatientSetupNumber", VR: "IS", VM: "1"},
+	{Tag: 0x300C0080, Name: "Referenced Dose Sequence", Keyword: "ReferencedDoseSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300C00A0, Name: "Referenced Tolerance Table Number", Keyword: "ReferencedToleranceTableNumber", VR: "IS", VM: "1"},
+	{Tag: 0x300C00B0, Name: "Referenced Bolus Sequence", Keyword: "ReferencedBolusSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300C00C0, Name: "Referenced Wedge Number", Keyword: "ReferencedWedgeNumber", VR: "IS", VM: "1"},
+	{Tag: 0x300C00D0, Name: "Referenced Compensator Number", Keyword: "ReferencedCompensatorNumber", VR: "IS", VM: "1"},
+	{Tag: 0x300C00E0, Name: "Referenced Block Number", Keyword: "ReferencedBlockNumber", VR: "IS", VM: "1"},
+	{Tag: 0x300C00F0, Name: "Referenced Control Point Index", Keyword: "ReferencedControlPointIndex", VR: "IS", VM: "1"},
+	{Tag: 0x300C00F2, Name: "Referenced Control Point Sequence", Keyword: "ReferencedControlPointSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300C00F4, Name: "Referenced Start Control Point Index", Keyword: "ReferencedStartControlPointIndex", VR: "IS", VM: "1"},
+	{Tag: 0x300C00F6, Name: "Referenced Stop Control Point Index", Keyword: "ReferencedStopControlPointIndex", VR: "IS", VM: "1"},
+	{Tag: 0x300C0100, Name: "Referenced Range Shifter Number", Keyword: "ReferencedRangeShifterNumber", VR: "IS", VM: "1"},
+	{Tag: 0x300C0102, Name: "Referenced Lateral Spreading Device Number", Keyword: "ReferencedLateralSpreadingDeviceNumber", VR: "IS", VM: "1"},
+	{Tag: 0x300C0104, Name: "Referenced Range Modulator Number", Keyword: "ReferencedRangeModulatorNumber", VR: "IS", VM: "1"},
+	{Tag: 0x300C0111, Name: "Omitted Beam Task Sequence", Keyword: "OmittedBeamTaskSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300C0112, Name: "Reason for Omission", Keyword: "ReasonForOmission", VR: "CS", VM: "1"},
+	{Tag: 0x300C0113, Name: "Reason for Omission Description", Keyword: "ReasonForOmissionDescription", VR: "LO", VM: "1"},
+	{Tag: 0x300C0114, Name: "Prescription Overview Sequence", Keyword: "PrescriptionOverviewSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300C0115, Name: "Total Prescription Dose", Keyword: "TotalPrescriptionDose", VR: "FL", VM: "1"},
+	{Tag: 0x300C0116, Name: "Plan Overview Sequence", Keyword: "PlanOverviewSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300C0117, Name: "Plan Overview Index", Keyword: "PlanOverviewIndex", VR: "US", VM: "1"},
+	{Tag: 0x300C0118, Name: "Referenced Plan Overview Index", Keyword: "ReferencedPlanOverviewIndex", VR: "US", VM: "1"},
+	{Tag: 0x300C0119, Name: "Number of Fractions Included", Keyword: "NumberOfFractionsIncluded", VR: "US", VM: "1"},
+	{Tag: 0x300C0120, Name: "Dose Calibration Conditions Sequence", Keyword: "DoseCalibrationConditionsSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300C0121, Name: "Absorbed Dose to Meterset Ratio", Keyword: "AbsorbedDoseToMetersetRatio", VR: "FD", VM: "1"},
+	{Tag: 0x300C0122, Name: "Delineated Radiation Field Size", Keyword: "DelineatedRadiationFieldSize", VR: "FD", VM: "2"},
+	{Tag: 0x300C0123, Name: "Dose Calibration Conditions Verified Flag", Keyword: "DoseCalibrationConditionsVerifiedFlag", VR: "CS", VM: "1"},
+	{Tag: 0x300C0124, Name: "Calibration Reference Point Depth", Keyword: "CalibrationReferencePointDepth", VR: "FD", VM: "1"},
+	{Tag: 0x300C0125, Name: "Gating Beam Hold Transition Sequence", Keyword: "GatingBeamHoldTransitionSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300C0126, Name: "Beam Hold Transition", Keyword: "BeamHoldTransition", VR: "CS", VM: "1"},
+	{Tag: 0x300C0127, Name: "Beam Hold Transition DateTime", Keyword: "BeamHoldTransitionDateTime", VR: "DT", VM: "1"},
+	{Tag: 0x300C0128, Name: "Beam Hold Originating Device Sequence", Keyword: "BeamHoldOriginatingDeviceSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x300C0129, Name: "Beam Hold Transition Trigger Source", Keyword: "BeamHoldTransitionTriggerSource", VR: "CS", VM: "1"},
+	{Tag: 0x300E0002, Name: "Approval Status", Keyword: "ApprovalStatus", VR: "CS", VM: "1"},
+	{Tag: 0x300E0004, Name: "Review Date", Keyword: "ReviewDate", VR: "DA", VM: "1"},
+	{Tag: 0x300E0005, Name: "Review Time", Keyword: "ReviewTime", VR: "TM", VM: "1"},
+	{Tag: 0x300E0008, Name: "Reviewer Name", Keyword: "ReviewerName", VR: "PN", VM: "1"},
+	{Tag: 0x30100001, Name: "Radiobiological Dose Effect Sequence", Keyword: "RadiobiologicalDoseEffectSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x30100002, Name: "Radiobiological Dose Effect Flag", Keyword: "RadiobiologicalDoseEffectFlag", VR: "CS", VM: "1"},
+	{Tag: 0x30100003, Name: "Effective Dose Calculation Method Category Code Sequence", Keyword: "EffectiveDoseCalculationMethodCategoryCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x30100004, Name: "Effective Dose Calculation Method Code Sequence", Keyword: "EffectiveDoseCalculationMethodCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x30100005, Name: "Effective Dose Calculation Method Description", Keyword: "EffectiveDoseCalculationMethodDescription", VR: "LO", VM: "1"},
+	{Tag: 0x30100006, Name: "Conceptual Volume UID", Keyword: "ConceptualVolumeUID", VR: "UI", VM: "1"},
+	{Tag: 0x30100007, Name: "Originating SOP Instance Reference Sequence", Keyword: "OriginatingSOPInstanceReferenceSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x30100008, Name: "Conceptual Volume Constituent Sequence", Keyword: "ConceptualVolumeConstituentSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x30100009, Name: "Equivalent Conceptual Volume Instance Reference Sequence", Keyword: "EquivalentConceptualVolumeInstanceReferenceSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x3010000A, Name: "Equivalent Conceptual Volumes Sequence", Keyword: "EquivalentConceptualVolumesSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x3010000B, Name: "Referenced Conceptual Volume UID", Keyword: "ReferencedConceptualVolumeUID", VR: "UI", VM: "1"},
+	{Tag: 0x3010000C, Name: "Conceptual Volume Combination Expression", Keyword: "ConceptualVolumeCombinationExpression", VR: "UT", VM: "1"},
+	{Tag: 0x3010000D, Name: "Conceptual Volume Constituent Index", Keyword: "ConceptualVolumeConstituentIndex", VR: "US", VM: "1"},
+	{Tag: 0x3010000E, Name: "Conceptual Volume Combination Flag", Keyword: "ConceptualVolumeCombinationFlag", VR: "CS", VM: "1"},
+	{Tag: 0x3010000F, Name: "Conceptual Volume Combination Description", Keyword: "ConceptualVolumeCombinationDescription", VR: "ST", VM: "1"},
+	{Tag: 0x30100010, Name: "Conceptual Volume Segmentation Defined Flag", Keyword: "ConceptualVolumeSegmentationDefinedFlag", VR: "CS", VM: "1"},
+	{Tag: 0x30100011, Name: "Conceptual Volume Segmentation Reference Sequence", Keyword: "ConceptualVolumeSegmentationReferenceSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x30100012, Name: "Conceptual Volume Constituent Segmentation Reference Sequence", Keyword: "ConceptualVolumeConstituentSegmentationReferenceSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x30100013, Name: "Constituent Conceptual Volume UID", Keyword: "ConstituentConceptualVolumeUID", VR: "UI", VM: "1"},
+	{Tag: 0x30100014, Name: "Derivation Conceptual Volume Sequence", Keyword: "DerivationConceptualVolumeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x30100015, Name: "Source Conceptual Volume UID", Keyword: "SourceConceptualVolumeUID", VR: "UI", VM: "1"},
+	{Tag: 0x30100016, Name: "Conceptual Volume Derivation Algorithm Sequence", Keyword: "ConceptualVolumeDerivationAlgorithmSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x30100017, Name: "Conceptual Volume Description", Keyword: "ConceptualVolumeDescription", VR: "ST", VM: "1"},
+	{Tag: 0x30100018, Name: "Source Conceptual Volume Sequence", Keyword: "SourceConceptualVolumeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x30100019, Name: "Author Identification Sequence", Keyword: "AuthorIdentificationSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x3010001A, Name: "Manufacturer's Model Version", Keyword: "ManufacturerModelVersion", VR: "LO", VM: "1"},
+	{Tag: 0x3010001B, Name: "Device Alternate Identifier", Keyword: "DeviceAlternateIdentifier", VR: "UC", VM: "1"},
+	{Tag: 0x3010001C, Name: "Device Alternate Identifier Type", Keyword: "DeviceAlternateIdentifierType", VR: "CS", VM: "1"},
+	{Tag: 0x3010001D, Name: "Device Alternate Identifier Format", Keyword: "DeviceAlternateIdentifierFormat", VR: "LT", VM: "1"},
+	{Tag: 0x3010001E, Name: "Segmentation Creation Template Label", Keyword: "SegmentationCreationTemplateLabel", VR: "LO", VM: "1"},
+	{Tag: 0x3010001F, Name: "Segmentation Template UID", Keyword: "SegmentationTemplateUID", VR: "UI", VM: "1"},
+	{Tag: 0x30100020, Name: "Referenced Segment Reference Index", Keyword: "ReferencedSegmentReferenceIndex", VR: "US", VM: "1"},
+	{Tag: 0x30100021, Name: "Segment Reference Sequence", Keyword: "SegmentReferenceSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x30100022, Name: "Segment Reference Index", Keyword: "SegmentReferenceIndex", VR: "US", VM: "1"},
+	{Tag: 0x30100023, Name: "Direct Segment Reference Sequence", Keyword: "DirectSegmentReferenceSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x30100024, Name: "Combination Segment Reference Sequence", Keyword: "CombinationSegmentReferenceSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x30100025, Name: "Conceptual Volume Sequence", Keyword: "ConceptualVolumeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x30100026, Name: "Segmented RT Accessory Device Sequence", Keyword: "SegmentedRTAccessoryDeviceSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x30100027, Name: "Segment Characteristics Sequence", Keyword: "SegmentCharacteristicsSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x30100028, Name: "Related Segment Characteristics Sequence", Keyword: "RelatedSegmentCharacteristicsSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x30100029, Name: "Segment Characteristics Precedence", Keyword: "SegmentCharacteristicsPrecedence", VR: "US", VM: "1"},
+	{Tag: 0x3010002A, Name: "RT Segment Annotation Sequence", Keyword: "RTSegmentAnnotationSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x3010002B, Name: "Segment Annotation Category Code Sequence", Keyword: "SegmentAnnotationCategoryCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x3010002C, Name: "Segment Annotation Type Code Sequence", Keyword: "SegmentAnnotationTypeCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x3010002D, Name: "Device Label", Keyword: "DeviceLabel", VR: "LO", VM: "1"},
+	{Tag: 0x3010002E, Name: "Device Type Code Sequence", Keyword: "DeviceTypeCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x3010002F, Name: "Segment Annotation Type Modifier Code Sequence", Keyword: "SegmentAnnotationTypeModifierCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x30100030, Name: "Patient Equipment Relationship Code Sequence", Keyword: "PatientEquipmentRelationshipCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x30100031, Name: "Referenced Fiducials UID", Keyword: "ReferencedFiducialsUID", VR: "UI", VM: "1"},
+	{Tag: 0x30100032, Name: "Patient Treatment Orientation Sequence", Keyword: "PatientTreatmentOrientationSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x30100033, Name: "User Content Label", Keyword: "UserContentLabel", VR: "SH", VM: "1"},
+	{Tag: 0x30100034, Name: "User Content Long Label", Keyword: "UserContentLongLabel", VR: "LO", VM: "1"},
+	{Tag: 0x30100035, Name: "Entity Label", Keyword: "EntityLabel", VR: "SH", VM: "1"},
+	{Tag: 0x30100036, Name: "Entity Name", Keyword: "EntityName", VR: "LO", VM: "1"},
+	{Tag: 0x30100037, Name: "Entity Description", Keyword: "EntityDescription", VR: "ST", VM: "1"},
+	{Tag: 0x30100038, Name: "Entity Long Label", Keyword: "EntityLongLabel", VR: "LO", VM: "1"},
+	{Tag: 0x30100039, Name: "Device Index", Keyword: "DeviceIndex", VR: "US", VM: "1"},
+	{Tag: 0x3010003A, Name: "RT Treatment Phase Index", Keyword: "RTTreatmentPhaseIndex", VR: "US", VM: "1"},
+	{Tag: 0x3010003B, Name: "RT Treatment Phase UID", Keyword: "RTTreatmentPhaseUID", VR: "UI", VM: "1"},
+	{Tag: 0x3010003C, Name: "RT Prescription Index", Keyword: "RTPrescriptionIndex", VR: "US", VM: "1"},
+	{Tag: 0x3010003D, Name: "RT Segment Annotation Index", Keyword: "RTSegmentAnnotationIndex", VR: "US", VM: "1"},
+	{Tag: 0x3010003E, Name: "Basis RT Treatment Phase Index", Keyword: "BasisRTTreatmentPhaseIndex", VR: "US", VM: "1"},
+	{Tag: 0x3010003F, Name: "Related RT Treatment Phase Index", Keyword: "RelatedRTTreatmentPhaseIndex", VR: "US", VM: "1"},
+	{Tag: 0x30100040, Name: "Referenced RT Treatment Phase Index", Keyword: "ReferencedRTTreatmentPhaseIndex", VR: "US", VM: "1"},
+	{Tag: 0x30100041, Name: "Referenced RT Prescription Index", Keyword: "ReferencedRTPrescriptionIndex", VR: "US", VM: "1"},
+	{Tag: 0x30100042, Name: "Referenced Parent RT Prescription Index", Keyword: "ReferencedParentRTPrescriptionIndex", VR: "US", VM: "1"},
+	{Tag: 0x30100043, Name: "Manufacturer's Device Identifier", Keyword: "ManufacturerDeviceIdentifier", VR: "ST", VM: "1"},
+	{Tag: 0x30100044, Name: "Instance-Level Referenced Performed Procedure Step Sequence", Keyword: "InstanceLevelReferencedPerformedProcedureStepSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x30100045, Name: "RT Treatment Phase Intent Presence Flag", Keyword: "RTTreatmentPhaseIntentPresenceFlag", VR: "CS", VM: "1"},
+	{Tag: 0x30100046, Name: "Radiotherapy Treatment Type", Keyword: "RadiotherapyTreatmentType", VR: "CS", VM: "1"},
+	{Tag: 0x30100047, Name: "Teletherapy Radiation Type", Keyword: "TeletherapyRadiationType", VR: "CS", VM: "1-n"},
+	{Tag: 0x30100048, Name: "Brachytherapy Source Type", Keyword: "BrachytherapySourceType", VR: "CS", VM: "1-n"},
+	{Tag: 0x30100049, Name: "Referenced RT Treatment Phase Sequence", Keyword: "ReferencedRTTreatmentPhaseSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x3010004A, Name: "Referenced Direct Segment Instance Sequence", Keyword: "ReferencedDirectSegmentInstanceSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x3010004B, Name: "Intended RT Treatment Phase Sequence", Keyword: "IntendedRTTreatmentPhaseSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x3010004C, Name: "Intended Phase Start Date", Keyword: "IntendedPhaseStartDate", VR: "DA", VM: "1"},
+	{Tag: 0x3010004D, Name: "Intended Phase End Date", Keyword: "IntendedPhaseEndDate", VR: "DA", VM: "1"},
+	{Tag: 0x3010004E, Name: "RT Treatment Phase Interval Sequence", Keyword: "RTTreatmentPhaseIntervalSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x3010004F, Name: "Temporal Relationship Interval Anchor", Keyword: "TemporalRelationshipIntervalAnchor", VR: "CS", VM: "1"},
+	{Tag: 0x30100050, Name: "Minimum Number of Interval Days", Keyword: "MinimumNumberOfIntervalDays", VR: "FD", VM: "1"},
+	{Tag: 0x30100051, Name: "Maximum Number of Interval Days", Keyword: "MaximumNumberOfIntervalDays", VR: "FD", VM: "1"},
+	{Tag: 0x30100052, Name: "Pertinent SOP Classes in Study", Keyword: "PertinentSOPClassesInStudy", VR: "UI", VM: "1-n"},
+	{Tag: 0x30100053, Name: "Pertinent SOP Classes in Series", Keyword: "PertinentSOPClassesInSeries", VR: "UI", VM: "1-n"},
+	{Tag: 0x30100054, Name: "RT Prescription Label", Keyword: "RTPrescriptionLabel", VR: "LO", VM: "1"},
+	{Tag: 0x30100055, Name: "RT Physician Intent Predecessor Sequence", Keyword: "RTPhysicianIntentPredecessorSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x30100056, Name: "RT Treatment Approach Label", Keyword: "RTTreatmentApproachLabel", VR: "LO", VM: "1"},
+	{Tag: 0x30100057, Name: "RT Physician Intent Sequence", Keyword: "RTPhysicianIntentSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x30100058, Name: "RT Physician Intent Index", Keyword: "RTPhysicianIntentIndex", VR: "US", VM: "1"},
+	{Tag: 0x30100059, Name: "RT Treatment Intent Type", Keyword: "RTTreatmentIntentType", VR: "CS", VM: "1"},
+	{Tag: 0x3010005A, Name: "RT Physician Intent Narrative", Keyword: "RTPhysicianIntentNarrative", VR: "UT", VM: "1"},
+	{Tag: 0x3010005B, Name: "RT Protocol Code Sequence", Keyword: "RTProtocolCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x3010005C, Name: "Reason for Superseding", Keyword: "ReasonForSuperseding", VR: "ST", VM: "1"},
+	{Tag: 0x3010005D, Name: "RT Diagnosis Code Sequence", Keyword: "RTDiagnosisCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x3010005E, Name: "Referenced RT Physician Intent Index", Keyword: "ReferencedRTPhysicianIntentIndex", VR: "US", VM: "1"},
+	{Tag: 0x3010005F, Name: "RT Physician Intent Input Instance Sequence", Keyword: "RTPhysicianIntentInputInstanceSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x30100060, Name: "RT Anatomic Prescription Sequence", Keyword: "RTAnatomicPrescriptionSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x30100061, Name: "Prior Treatment Dose Description", Keyword: "PriorTreatmentDoseDescription", VR: "UT", VM: "1"},
+	{Tag: 0x30100062, Name: "Prior Treatment Reference Sequence", Keyword: "PriorTreatmentReferenceSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x30100063, Name: "Dosimetric Objective Evaluation Scope", Keyword: "DosimetricObjectiveEvaluationScope", VR: "CS", VM: "1"},
+	{Tag: 0x30100064, Name: "Therapeutic Role Category Code Sequence", Keyword: "TherapeuticRoleCategoryCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x30100065, Name: "Therapeutic Role Type Code Sequence", Keyword: "TherapeuticRoleTypeCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x30100066, Name: "Conceptual Volume Optimization Precedence", Keyword: "ConceptualVolumeOptimizationPrecedence", VR: "US", VM: "1"},
+	{Tag: 0x30100067, Name: "Conceptual Volume Category Code Sequence", Keyword: "ConceptualVolumeCategoryCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x30100068, Name: "Conceptual Volume Blocking Constraint", Keyword: "ConceptualVolumeBlockingConstraint", VR: "CS", VM: "1"},
+	{Tag: 0x30100069, Name: "Conceptual Volume Type Code Sequence", Keyword: "ConceptualVolumeTypeCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x3010006A, Name: "Conceptual Volume Type Modifier Code Sequence", Keyword: "ConceptualVolumeTypeModifierCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x3010006B, Name: "RT Prescription Sequence", Keyword: "RTPrescriptionSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x3010006C, Name: "Dosimetric Objective Sequence", Keyword: "DosimetricObjectiveSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x3010006D, Name: "Dosimetric Objective Type Code Sequence", Keyword: "DosimetricObjectiveTypeCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x3010006E, Name: "Dosimetric Objective UID", Keyword: "DosimetricObjectiveUID", VR: "UI", VM: "1"},
+	{Tag: 0x3010006F, Name: "Referenced Dosimetric Objective UID", Keyword: "ReferencedDosimetricObjectiveUID", VR: "UI", VM: "1"},
+	{Tag: 0x30100070, Name: "Dosimetric Objective Parameter Sequence", Keyword: "DosimetricObjectiveParameterSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x30100071, Name: "Referenced Dosimetric Objectives Sequence", Keyword: "ReferencedDosimetricObjectivesSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x30100073, Name: "Absolute Dosimetric Objective Flag", Keyword: "AbsoluteDosimetricObjectiveFlag", VR: "CS", VM: "1"},
+	{Tag: 0x30100074, Name: "Dosimetric Objective Weight", Keyword: "DosimetricObjectiveWeight", VR: "FD", VM: "1"},
+	{Tag: 0x30100075, Name: "Dosimetric Objective Purpose", Keyword: "DosimetricObjectivePurpose", VR: "CS", VM: "1"},
+	{Tag: 0x30100076, Name: "Planning Input Information Sequence", Keyword: "PlanningInputInformationSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x30100077, Name: "Treatment Site", Keyword: "TreatmentSite", VR: "LO", VM: "1"},
+	{Tag: 0x30100078, Name: "Treatment Site Code Sequence", Keyword: "TreatmentSiteCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x30100079, Name: "Fraction Pattern Sequence", Keyword: "FractionPatternSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x3010007A, Name: "Treatment Technique Notes", Keyword: "TreatmentTechniqueNotes", VR: "UT", VM: "1"},
+	{Tag: 0x3010007B, Name: "Prescription Notes", Keyword: "PrescriptionNotes", VR: "UT", VM: "1"},
+	{Tag: 0x3010007C, Name: "Number of Interval Fractions", Keyword: "NumberOfIntervalFractions", VR: "IS", VM: "1"},
+	{Tag: 0x3010007D, Name: "Number of Fractions", Keyword: "NumberOfFractions", VR: "US", VM: "1"},
+	{Tag: 0x3010007E, Name: "Intended Delivery Duration", Keyword: "IntendedDeliveryDuration", VR: "US", VM: "1"},
+	{Tag: 0x3010007F, Name: "Fractionation Notes", Keyword: "FractionationNotes", VR: "UT", VM: "1"},
+	{Tag: 0x30100080, Name: "RT Treatment Technique Code Sequence", Keyword: "RTTreatmentTechniqueCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x30100081, Name: "Prescription Notes Sequence", Keyword: "PrescriptionNotesSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x30100082, Name: "Fraction-Based Relationship Sequence", Keyword: "FractionBasedRelationshipSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x30100083, Name: "Fraction-Based Relationship Interval Anchor", Keyword: "FractionBasedRelationshipIntervalAnchor", VR: "CS", VM: "1"},
+	{Tag: 0x30100084, Name: "Minimum Hours between Fractions", Keyword: "MinimumHoursBetweenFractions", VR: "FD", VM: "1"},
+	{Tag: 0x30100085, Name: "Intended Fraction Start Time", Keyword: "IntendedFractionStartTime", VR: "TM", VM: "1-n"},
+	{Tag: 0x30100086, Name: "Intended Start Day of Week", Keyword: "IntendedStartDayOfWeek", VR: "LT", VM: "1"},
+	{Tag: 0x30100087, Name: "Weekday Fraction Pattern Sequence", Keyword: "WeekdayFractionPatternSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x30100088, Name: "Delivery Time Structure Code Sequence", Keyword: "DeliveryTimeStructureCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x30100089, Name: "Treatment Site Modifier Code Sequence", Keyword: "TreatmentSiteModifierCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x30100090, Name: "Robotic Base Location Indicator", Keyword: "RoboticBaseLocationIndicator", VR: "CS", VM: "1", Retired: true},
+	{Tag: 0x30100091, Name: "Robotic Path Node Set Code Sequence", Keyword: "RoboticPathNodeSetCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x30100092, Name: "Robotic Node Identifier", Keyword: "RoboticNodeIdentifier", VR: "UL", VM: "1"},
+	{Tag: 0x30100093, Name: "RT Treatment Source Coordinates", Keyword: "RTTreatmentSourceCoordinates", VR: "FD", VM: "3"},
+	{Tag: 0x30100094, Name: "Radiation Source Coordinate SystemYaw Angle", Keyword: "RadiationSourceCoordinateSystemYawAngle", VR: "FD", VM: "1"},
+	{Tag: 0x30100095, Name: "Radiation Source Coordinate SystemRoll Angle", Keyword: "RadiationSourceCoordinateSystemRollAngle", VR: "FD", VM: "1"},
+	{Tag: 0x30100096, Name: "Radiation Source Coordinate System Pitch Angle", Keyword: "RadiationSourceCoordinateSystemPitchAngle", VR: "FD", VM: "1"},
+	{Tag: 0x30100097, Name: "Robotic Path Control Point Sequence", Keyword: "RoboticPathControlPointSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x30100098, Name: "Tomotherapeutic Control Point Sequence", Keyword: "TomotherapeuticControlPointSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x30100099, Name: "Tomotherapeutic Leaf Open Durations", Keyword: "TomotherapeuticLeafOpenDurations", VR: "FD", VM: "1-n"},
+	{Tag: 0x3010009A, Name: "Tomotherapeutic Leaf Initial Closed Durations", Keyword: "TomotherapeuticLeafInitialClosedDurations", VR: "FD", VM: "1-n"},
+	{Tag: 0x301000A0, Name: "Conceptual Volume Identification Sequence", Keyword: "ConceptualVolumeIdentificationSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x40000010, Name: "Arbitrary", Keyword: "Arbitrary", VR: "LT", VM: "1", Retired: true},
+	{Tag: 0x40004000, Name: "Text Comments", Keyword: "TextComments", VR: "LT", VM: "1", Retired: true},
+	{Tag: 0x40080040, Name: "Results ID", Keyword: "ResultsID", VR: "SH", VM: "1", Retired: true},
+	{Tag: 0x40080042, Name: "Results ID Issuer", Keyword: "ResultsIDIssuer", VR: "LO", VM: "1", Retired: true},
+	{Tag: 0x40080050, Name: "Referenced Interpretation Sequence", Keyword: "ReferencedInterpretationSequence", VR: "SQ", VM: "1", Retired: true},
+	{Tag: 0x400800FF, Name: "Report Production Status (Trial)", Keyword: "ReportProductionStatusTrial", VR: "CS", VM: "1", Retired: true},
+	{Tag: 0x40080100, Name: "Interpretation Recorded Date", Keyword: "InterpretationRecordedDate", VR: "DA", VM: "1", Retired: true},
+	{Tag: 0x40080101, Name: "Interpretation Recorded Time", Keyword: "InterpretationRecordedTime", VR: "TM", VM: "1", Retired: true},
+	{Tag: 0x40080102, Name: "Interpretation Recorder", Keyword: "InterpretationRecorder", VR: "PN", VM: "1", Retired: true},
+	{Tag: 0x40080103, Name: "Reference to Recorded Sound", Keyword: "ReferenceToRecordedSound", VR: "LO", VM: "1", Retired: true},
+	{Tag: 0x40080108, Name: "Interpretation Transcription Date", Keyword: "InterpretationTranscriptionDate", VR: "DA", VM: "1", Retired: true},
+	{Tag: 0x40080109, Name: "Interpretation Transcription Time", Keyword: "InterpretationTranscriptionTime", VR: "TM", VM: "1", Retired: true},
+	{Tag: 0x4008010A, Name: "Interpretation Transcriber", Keyword: "InterpretationTranscriber", VR: "PN", VM: "1", Retired: true},
+	{Tag: 0x4008010B, Name: "Interpretation Text", Keyword: "InterpretationText", VR: "ST", VM: "1", Retired: true},
+	{Tag: 0x4008010C, Name: "Interpretation Author", Keyword: "InterpretationAuthor", VR: "PN", VM: "1", Retired: true},
+	{Tag: 0x40080111, Name: "Interpretation Approver Sequence", Keyword: "InterpretationApproverSequence", VR: "SQ", VM: "1", Retired: true},
+	{Tag: 0x40080112, Name: "Interpretation Approval Date", Keyword: "InterpretationApprovalDate", VR: "DA", VM: "1", Retired: true},
+	{Tag: 0x40080113, Name: "Interpretation Approval Time", Keyword: "InterpretationApprovalTime", VR: "TM", VM: "1", Retired: true},
+	{Tag: 0x40080114, Name: "Physician Approving Interpretation", Keyword: "PhysicianApprovingInterpretation", VR: "PN", VM: "1", Retired: true},
+	{Tag: 0x40080115, Name: "Interpretation Diagnosis Description", Keyword: "InterpretationDiagnosisDescription", VR: "LT", VM: "1", Retired: true},
+	{Tag: 0x40080117, Name: "Interpretation Diagnosis Code Sequence", Keyword: "InterpretationDiagnosisCodeSequence", VR: "SQ", VM: "1", Retired: true},
+	{Tag: 0x40080118, Name: "Results Distribution List Sequence", Keyword: "ResultsDistributionListSequence", VR: "SQ", VM: "1", Retired: true},
+	{Tag: 0x40080119, Name: "Distribution Name", Keyword: "DistributionName", VR: "PN", VM: "1", Retired: true},
+	{Tag: 0x4008011A, Name: "Distribution Address", Keyword: "DistributionAddress", VR: "LO", VM: "1", Retired: true},
+	{Tag: 0x40080200, Name: "Interpretation ID", Keyword: "InterpretationID", VR: "SH", VM: "1", Retired: true},
+	{Tag: 0x40080202, Name: "Interpretation ID Issuer", Keyword: "InterpretationIDIssuer", VR: "LO", VM: "1", Retired: true},
+	{Tag: 0x40080210, Name: "Interpretation Type ID", Keyword: "InterpretationTypeID", VR: "CS", VM: "1", Retired: true},
+	{Tag: 0x40080212, Name: "Interpretation Status ID", Keyword: "InterpretationStatusID", VR: "CS", VM: "1", Retired: true},
+	{Tag: 0x40080300, Name: "Impressions", Keyword: "Impressions", VR: "ST", VM: "1", Retired: true},
+	{Tag: 0x40084000, Name: "Results Comments", Keyword: "ResultsComments", VR: "ST", VM: "1", Retired: true},
+	{Tag: 0x40100001, Name: "Low Energy Detectors", Keyword: "LowEnergyDetectors", VR: "CS", VM: "1"},
+	{Tag: 0x40100002, Name: "High Energy Detectors", Keyword: "HighEnergyDetectors", VR: "CS", VM: "1"},
+	{Tag: 0x40100004, Name: "Detector Geometry Sequence", Keyword: "DetectorGeometrySequence", VR: "SQ", VM: "1"},
+	{Tag: 0x40101001, Name: "Threat ROI Voxel Sequence", Keyword: "ThreatROIVoxelSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x40101004, Name: "Threat ROI Base", Keyword: "ThreatROIBase", VR: "FL", VM: "3"},
+	{Tag: 0x40101005, Name: "Threat ROI Extents", Keyword: "ThreatROIExtents", VR: "FL", VM: "3"},
+	{Tag: 0x40101006, Name: "Threat ROI Bitmap", Keyword: "ThreatROIBitmap", VR: "OB", VM: "1"},
+	{Tag: 0x40101007, Name: "Route Segment ID", Keyword: "RouteSegmentID", VR: "SH", VM: "1"},
+	{Tag: 0x40101008, Name: "Gantry Type", Keyword: "GantryType", VR: "CS", VM: "1"},
+	{Tag: 0x40101009, Name: "OOI Owner Type", Keyword: "OOIOwnerType", VR: "CS", VM: "1"},
+	{Tag: 0x4010100A, Name: "Route Segment Sequence", Keyword: "RouteSegmentSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x40101010, Name: "Potential Threat Object ID", Keyword: "PotentialThreatObjectID", VR: "US", VM: "1"},
+	{Tag: 0x40101011, Name: "Threat Sequence", Keyword: "ThreatSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x40101012, Name: "Threat Category", Keyword: "ThreatCategory", VR: "CS", VM: "1"},
+	{Tag: 0x40101013, Name: "Threat Category Description", Keyword: "ThreatCategoryDescription", VR: "LT", VM: "1"},
+	{Tag: 0x40101014, Name: "ATD Ability Assessment", Keyword: "ATDAbilityAssessment", VR: "CS", VM: "1"},
+	{Tag: 0x40101015, Name: "ATD Assessment Flag", Keyword: "ATDAssessmentFlag", VR: "CS", VM: "1"},
+	{Tag: 0x40101016, Name: "ATD Assessment Probability", Keyword: "ATDAssessmentProbability", VR: "FL", VM: "1"},
+	{Tag: 0x40101017, Name: "Mass", Keyword: "Mass", VR: "FL", VM: "1"},
+	{Tag: 0x40101018, Name: "Density", Keyword: "Density", VR: "FL", VM: "1"},
+	{Tag: 0x40101019, Name: "Z Effective", Keyword: "ZEffective", VR: "FL", VM: "1"},
+	{Tag: 0x4010101A, Name: "Boarding Pass ID", Keyword: "BoardingPassID", VR: "SH", VM: "1"},
+	{Tag: 0x4010101B, Name: "Center of Mass", Keyword: "CenterOfMass", VR: "FL", VM: "3"},
+	{Tag: 0x4010101C, Name: "Center of PTO", Keyword: "CenterOfPTO", VR: "FL", VM: "3"},
+	{Tag: 0x4010101D, Name: "Bounding Polygon", Keyword: "BoundingPolygon", VR: "FL", VM: "6-n"},
+	{Tag: 0x4010101E, Name: "Route Segment Start Location ID", Keyword: "RouteSegmentStartLocationID", VR: "SH", VM: "1"},
+	{Tag: 0x4010101F, Name: "Route Segment End Location ID", Keyword: "RouteSegmentEndLocationID", VR: "SH", VM: "1"},
+	{Tag: 0x40101020, Name: "Route Segment Location ID Type", Keyword: "RouteSegmentLocationIDType", VR: "CS", VM: "1"},
+	{Tag: 0x40101021, Name: "Abort Reason", Keyword: "AbortReason", VR: "CS", VM: "1-n"},
+	{Tag: 0x40101023, Name: "Volume of PTO", Keyword: "VolumeOfPTO", VR: "FL", VM: "1"},
+	{Tag: 0x40101024, Name: "Abort Flag", Keyword: "AbortFlag", VR: "CS", VM: "1"},
+	{Tag: 0x40101025, Name: "Route Segment Start Time", Keyword: "RouteSegmentStartTime", VR: "DT", VM: "1"},
+	{Tag: 0x40101026, Name: "Route Segment End Time", Keyword: "RouteSegmentEndTime", VR: "DT", VM: "1"},
+	{Tag: 0x40101027, Name: "TDR Type", Keyword: "TDRType", VR: "CS", VM: "1"},
+	{Tag: 0x40101028, Name: "International Route Segment", Keyword: "InternationalRouteSegment", VR: "CS", VM: "1"},
+	{Tag: 0x40101029, Name: "Threat Detection Algorithm and Version", Keyword: "ThreatDetectionAlgorithmAndVersion", VR: "LO", VM: "1-n"},
+	{Tag: 0x4010102A, Name: "Assigned Location", Keyword: "AssignedLocation", VR: "SH", VM: "1"},
+	{Tag: 0x4010102B, Name: "Alarm Decision Time", Keyword: "AlarmDecisionTime", VR: "DT", VM: "1"},
+	{Tag: 0x40101031, Name: "Alarm Decision", Keyword: "AlarmDecision", VR: "CS", VM: "1"},
+	{Tag: 0x40101033, Name: "Number of Total Objects", Keyword: "NumberOfTotalObjects", VR: "US", VM: "1"},
+	{Tag: 0x40101034, Name: "Number of Alarm Objects", Keyword: "NumberOfAlarmObjects", VR: "US", VM: "1"},
+	{Tag: 0x40101037, Name: "PTO Representation Sequence", Keyword: "PTORepresentationSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x40101038, Name: "ATD Assessment Sequence", Keyword: "ATDAssessmentSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x40101039, Name: "TIP Type", Keyword: "TIPType", VR: "CS", VM: "1"},
+	{Tag: 0x4010103A, Name: "DICOS Version", Keyword: "DICOSVersion", VR: "CS", VM: "1"},
+	{Tag: 0x40101041, Name: "OOI Owner Creation Time", Keyword: "OOIOwnerCreationTime", VR: "DT", VM: "1"},
+	{Tag: 0x40101042, Name: "OOI Type", Keyword: "OOIType", VR: "CS", VM: "1"},
+	{Tag: 0x40101043, Name: "OOI Size", Keyword: "OOISize", VR: "FL", VM: "3"},
+	{Tag: 0x40101044, Name: "Acquisition Status", Keyword: "AcquisitionStatus", VR: "CS", VM: "1"},
+	{Tag: 0x40101045, Name: "Basis Materials Code Sequence", Keyword: "BasisMaterialsCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x40101046, Name: "Phantom Type", Keyword: "PhantomType", VR: "CS", VM: "1"},
+	{Tag: 0x40101047, Name: "OOI Owner Sequence", Keyword: "OOIOwnerSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x40101048, Name: "Scan Type", Keyword: "ScanType", VR: "CS", VM: "1"},
+	{Tag: 0x40101051, Name: "Itinerary ID", Keyword: "ItineraryID", VR: "LO", VM: "1"},
+	{Tag: 0x40101052, Name: "Itinerary ID Type", Keyword: "ItineraryIDType", VR: "SH", VM: "1"},
+	{Tag: 0x40101053, Name: "Itinerary ID Assigning Authority", Keyword: "ItineraryIDAssigningAuthority", VR: "LO", VM: "1"},
+	{Tag: 0x40101054, Name: "Route ID", Keyword: "RouteID", VR: "SH", VM: "1"},
+	{Tag: 0x40101055, Name: "Route ID Assigning Authority", Keyword: "RouteIDAssigningAuthority", VR: "SH", VM: "1"},
+	{Tag: 0x40101056, Name: "Inbound Arrival Type", Keyword: "InboundArrivalType", VR: "CS", VM: "1"},
+	{Tag: 0x40101058, Name: "Carrier ID", Keyword: "CarrierID", VR: "SH", VM: "1"},
+	{Tag: 0x40101059, Name: "Carrier ID Assigning Authority", Keyword: "CarrierIDAssigningAuthority", VR: "CS", VM: "1"},
+	{Tag: 0x40101060, Name: "Source Orientation", Keyword: "SourceOrientation", VR: "FL", VM: "3"},
+	{Tag: 0x40101061, Name: "Source Position", Keyword: "SourcePosition", VR: "FL", VM: "3"},
+	{Tag: 0x40101062, Name: "Belt Height", Keyword: "BeltHeight", VR: "FL", VM: "1"},
+	{Tag: 0x40101064, Name: "Algorithm Routing Code Sequence", Keyword: "AlgorithmRoutingCodeSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x40101067, Name: "Transport Classification", Keyword: "TransportClassification", VR: "CS", VM: "1"},
+	{Tag: 0x40101068, Name: "OOI Type Descriptor", Keyword: "OOITypeDescriptor", VR: "LT", VM: "1"},
+	{Tag: 0x40101069, Name: "Total Processing Time", Keyword: "TotalProcessingTime", VR: "FL", VM: "1"},
+	{Tag: 0x4010106C, Name: "Detector Calibration Data", Keyword: "DetectorCalibrationData", VR: "OB", VM: "1"},
+	{Tag: 0x4010106D, Name: "Additional Screening Performed", Keyword: "AdditionalScreeningPerformed", VR: "CS", VM: "1"},
+	{Tag: 0x4010106E, Name: "Additional Inspection Selection Criteria", Keyword: "AdditionalInspectionSelectionCriteria", VR: "CS", VM: "1"},
+	{Tag: 0x4010106F, Name: "Additional Inspection Method Sequence", Keyword: "AdditionalInspectionMethodSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x40101070, Name: "AIT Device Type", Keyword: "AITDeviceType", VR: "CS", VM: "1"},
+	{Tag: 0x40101071, Name: "QR Measurements Sequence", Keyword: "QRMeasurementsSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x40101072, Name: "Target Material Sequence", Keyword: "TargetMaterialSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x40101073, Name: "SNR Threshold", Keyword: "SNRThreshold", VR: "FD", VM: "1"},
+	{Tag: 0x40101075, Name: "Image Scale Representation", Keyword: "ImageScaleRepresentation", VR: "DS", VM: "1"},
+	{Tag: 0x40101076, Name: "Referenced PTO Sequence", Keyword: "ReferencedPTOSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x40101077, Name: "Referenced TDR Instance Sequence", Keyword: "ReferencedTDRInstanceSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x40101078, Name: "PTO Location Description", Keyword: "PTOLocationDescription", VR: "ST", VM: "1"},
+	{Tag: 0x40101079, Name: "Anomaly Locator Indicator Sequence", Keyword: "AnomalyLocatorIndicatorSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x4010107A, Name: "Anomaly Locator Indicator", Keyword: "AnomalyLocatorIndicator", VR: "FL", VM: "3"},
+	{Tag: 0x4010107B, Name: "PTO Region Sequence", Keyword: "PTORegionSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x4010107C, Name: "Inspection Selection Criteria", Keyword: "InspectionSelectionCriteria", VR: "CS", VM: "1"},
+	{Tag: 0x4010107D, Name: "Secondary Inspection Method Sequence", Keyword: "SecondaryInspectionMethodSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x4010107E, Name: "PRCS to RCS Orientation", Keyword: "PRCSToRCSOrientation", VR: "DS", VM: "6"},
+	{Tag: 0x4FFE0001, Name: "MAC Parameters Sequence", Keyword: "MACParametersSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x52009229, Name: "Shared Functional Groups Sequence", Keyword: "SharedFunctionalGroupsSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x52009230, Name: "Per-Frame Functional Groups Sequence", Keyword: "PerFrameFunctionalGroupsSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x54000100, Name: "Waveform Sequence", Keyword: "WaveformSequence", VR: "SQ", VM: "1"},
+	{Tag: 0x54000110, Name: "Channel Minimum Value", Keyword: "ChannelMinimumValue", VR: "OB or OW", VM: "1"},
+	{Tag: 0x54000112, Name: "Channel Maximum Value", Keyword: "ChannelMaximumValue", VR: "OB or OW", VM: "1"},
+	{Tag: 0x54001004, Name: "Waveform Bits Allocated", Keyword: "WaveformBitsAllocated", VR: "US", VM: "1"},
+	{Tag: 0x54001006, Name: "Waveform Sample Interpretation", Keyword: "WaveformSampleInterpretation", VR: "CS", VM: "1"},
+	{Tag: 0x5400100A, Name: "Waveform Padding Value", Keyword: "WaveformPaddingValue", VR: "OB or OW", VM: "1"},
+	{Tag: 0x54001010, Name: "Waveform Data", Keyword: "WaveformData", VR: "OB or OW", VM: "1"},
+	{Tag: 0x56000010, Name: "First Order Phase Correction Angle", Keyword: "FirstOrderPhaseCorrectionAngle", VR: "OF", VM: "1"},
+	{Tag: 0x56000020, Name: "Spectroscopy Data", Keyword: "SpectroscopyData", VR: "OF", VM: "1"},
+	{Tag: 0x7FE00001, Name: "Extended Offset Table", Keyword: "ExtendedOffsetTable", VR: "OV", VM: "1"},
+	{Tag: 0x7FE00002, Name: "Extended Offset Table Lengths", Keyword: "ExtendedOffsetTableLengths", VR: "OV", VM: "1"},
+	{Tag: 0x7FE00003, Name: "Encapsulated Pixel Data Value Total Length", Keyword: "EncapsulatedPixelDataValueTotalLength", VR: "UV", VM: "1"},
+	{Tag: 0x7FE00008, Name: "Float Pixel Data", Keyword: "FloatPixelData", VR: "OF", VM: "1"},
+	{Tag: 0x7FE00009, Name: "Double Float Pixel Data", Keyword: "DoubleFloatPixelData", VR: "OD", VM: "1"},
+	{Tag: 0x7FE00010, Name: "Pixel Data", Keyword: "PixelData", VR: "OB or OW", VM: "1"},
+	{Tag: 0x7FE00020, Name: "Coefficients SDVN", Keyword: "CoefficientsSDVN", VR: "OW", VM: "1", Retired: true},
+	{Tag: 0x7FE00030, Name: "Coefficients SDHN", Keyword: "CoefficientsSDHN", VR: "OW", VM: "1", Retired: true},
+	{Tag: 0x7FE00040, Name: "Coefficients SDDN", Keyword: "CoefficientsSDDN", VR: "OW", VM: "1", Retired: true},
+	{Tag: 0xFFFAFFFA, Name: "Digital Signatures Sequence", Keyword: "DigitalSignaturesSequence", VR: "SQ", VM: "1"},
+	{Tag: 0xFFFCFFFC, Name: "Data Set Trailing Padding", Keyword: "DataSetTrailingPadding", VR: "OB", VM: "1"},
+}
+
+// repeatingElements are the public data elements of repeating groups and tag ranges
+var repeatingElements = []repeatingElement{
+	{mask: 0xFFFFFF00, Element: Element{Tag: 0x00203100, Name: "Source Image IDs", Keyword: "SourceImageIDs", VR: "CS", VM: "1-n", Retired: true}},
+	{mask: 0xFFFFFF0F, Element: Element{Tag: 0x00280400, Name: "Rows For Nth Order Coefficients", Keyword: "RowsForNthOrderCoefficients", VR: "US", VM: "1", Retired: true}},
+	{mask: 0xFFFFFF0F, Element: Element{Tag: 0x00280401, Name: "Columns For Nth Order Coefficients", Keyword: "ColumnsForNthOrderCoefficients", VR: "US", VM: "1", Retired: true}},
+	{mask: 0xFFFFFF0F, Element: Element{Tag: 0x00280402, Name: "Coefficient Coding", Keyword: "CoefficientCoding", VR: "LO", VM: "1-n", Retired: true}},
+	{mask: 0xFFFFFF0F, Element: Element{Tag: 0x00280403, Name: "Coefficient Coding Pointers", Keyword: "CoefficientCodingPointers", VR: "AT", VM: "1-n", Retired: true}},
+	{mask: 0xFFFFFF0F, Element: Element{Tag: 0x00280800, Name: "Code Label", Keyword: "CodeLabel", VR: "CS", VM: "1-n", Retired: true}},
+	{mask: 0xFFFFFF0F, Element: Element{Tag: 0x00280802, Name: "Number of Tables", Keyword: "NumberOfTables", VR: "US", VM: "1", Retired: true}},
+	{mask: 0xFFFFFF0F, Element: Element{Tag: 0x00280803, Name: "Code Table Location", Keyword: "CodeTableLocation", VR: "AT", VM: "1-n", Retired: true}},
+	{mask: 0xFFFFFF0F, Element: Element{Tag: 0x00280804, Name: "Bits For Code Word", Keyword: "BitsForCodeWord", VR: "US", VM: "1", Retired: true}},
+	{mask: 0xFFFFFF0F, Element: Element{Tag: 0x00280808, Name: "Image Data Location", Keyword: "ImageDataLocation", VR: "AT", VM: "1-n", Retired: true}},
+	{mask: 0xFFFF000F, Element: Element{Tag: 0x10000000, Name: "Escape Triplet", Keyword: "EscapeTriplet", VR: "US", VM: "3", Retired: true}},
+	{mask: 0xFFFF000F, Element: Element{Tag: 0x10000001, Name: "Run Length Triplet", Keyword: "RunLengthTriplet", VR: "US", VM: "3", Retired: true}},
+	{mask: 0xFFFF000F, Element: Element{Tag: 0x10000002, Name: "Huffman Table Size", Keyword: "HuffmanTableSize", VR: "US", VM: "1", Retired: true}},
+	{mask: 0xFFFF000F, Element: Element{Tag: 0x10000003, Name: "Huffman Table Triplet", Keyword: "HuffmanTableTriplet", VR: "US", VM: "3", Retired: true}},
+	{mask: 0xFFFF000F, Element: Element{Tag: 0x10000004, Name: "Shift Table Size", Keyword: "ShiftTableSize", VR: "US", VM: "1", Retired: true}},
+	{mask: 0xFFFF000F, Element: Element{Tag: 0x10000005, Name: "Shift Table Triplet", Keyword: "ShiftTableTriplet", VR: "US", VM: "3", Retired: true}},
+	{mask: 0xFFFF0000, Element: Element{Tag: 0x10100000, Name: "Zonal Map", Keyword: "ZonalMap", VR: "US", VM: "1-n", Retired: true}},
+	{mask: 0xFF00FFFF, Element: Element{Tag: 0x50000005, Name: "Curve Dimensions", Keyword: "CurveDimensions", VR: "US", VM: "1", Retired: true}},
+	{mask: 0xFF00FFFF, Element: Element{Tag: 0x50000010, Name: "Number of Points", Keyword: "NumberOfPoints", VR: "US", VM: "1", Retired: true}},
+	{mask: 0xFF00FFFF, Element: Element{Tag: 0x50000020, Name: "Type of Data", Keyword: "TypeOfData", VR: "CS", VM: "1", Retired: true}},
+	{mask: 0xFF00FFFF, Element: Element{Tag: 0x50000022, Name: "Curve Description", Keyword: "CurveDescription", VR: "LO", VM: "1", Retired: true}},
+	{mask: 0xFF00FFFF, Element: Element{Tag: 0x50000030, Name: "Axis Units", Keyword: "AxisUnits", VR: "SH", VM: "1-n", Retired: true}},
+	{mask: 0xFF00FFFF, Element: Element{Tag: 0x50000040, Name: "Axis Labels", Keyword: "AxisLabels", VR: "SH", VM: "1-n", Retired: true}},
+	{mask: 0xFF00FFFF, Element: Element{Tag: 0x50000103, Name: "Data Value Representation", Keyword: "DataValueRepresentation", VR: "US", VM: "1", Retired: true}},
+	{mask: 0xFF00FFFF, Element: Element{Tag: 0x50000104, Name: "Minimum Coordinate Value", Keyword: "MinimumCoordinateValue", VR: "US", VM: "1-n", Retired: true}},
+	{mask: 0xFF00FFFF, Element: Element{Tag: 0x50000105, Name: "Maximum Coordinate Value", Keyword: "MaximumCoordinateValue", VR: "US", VM: "1-n", Retired: true}},
+	{mask: 0xFF00FFFF, Element: Element{Tag: 0x50000106, Name: "Curve Range", Keyword: "CurveRange", VR: "SH", VM: "1-n", Retired: true}},
+	{mask: 0xFF00FFFF, Element: Element{Tag: 0x50000110, Name: "Curve Data Descriptor", Keyword: "CurveDataDescriptor", VR: "US", VM: "1-n", Retired: true}},
+	{mask: 0xFF00FFFF, Element: Element{Tag: 0x50000112, Name: "Coordinate Start Value", Keyword: "CoordinateStartValue", VR: "US", VM: "1-n", Retired: true}},
+	{mask: 0xFF00FFFF, Element: Element{Tag: 0x50000114, Name: "Coordinate Step Value", Keyword: "CoordinateStepValue", VR: "US", VM: "1-n", Retired: true}},
+	{mask: 0xFF00FFFF, Element: Element{Tag: 0x50001001, Name: "Curve Activation Layer", Keyword: "CurveActivationLayer", VR: "CS", VM: "1", Retired: true}},
+	{mask: 0xFF00FFFF, Element: Element{Tag: 0x50002000, Name: "Audio Type", Keyword: "AudioType", VR: "US", VM: "1", Retired: true}},
+	{mask: 0xFF00FFFF, Element: Element{Tag: 0x50002002, Name: "Audio Sample Format", Keyword: "AudioSampleFormat", VR: "US", VM: "1", Retired: true}},
+	{mask: 0xFF00FFFF, Element: Element{Tag: 0x50002004, Name: "Number of Channels", Keyword: "NumberOfChannels", VR: "US", VM: "1", Retired: true}},
+	{mask: 0xFF00FFFF, Element: Element{Tag: 0x50002006, Name: "Number of Samples", Keyword: "NumberOfSamples", VR: "UL", VM: "1", Retired: true}},
+	{mask: 0xFF00FFFF, Element: Element{Tag: 0x50002008, Name: "Sample Rate", Keyword: "SampleRate", VR: "UL", VM: "1", Retired: true}},
+	{mask: 0xFF00FFFF, Element: Element{Tag: 0x5000200A, Name: "Total Time", Keyword: "TotalTime", VR: "UL", VM: "1", Retired: true}},
+	{mask: 0xFF00FFFF, Element: Element{Tag: 0x5000200C, Name: "Audio Sample Data", Keyword: "AudioSampleData", VR: "OB or OW", VM: "1", Retired: true}},
+	{mask: 0xFF00FFFF, Element: Element{Tag: 0x5000200E, Name: "Audio Comments", Keyword: "AudioComments", VR: "LT", VM: "1", Retired: true}},
+	{mask: 0xFF00FFFF, Element: Element{Tag: 0x50002500, Name: "Curve Label", Keyword: "CurveLabel", VR: "LO", VM: "1", Retired: true}},
+	{mask: 0xFF00FFFF, Element: Element{Tag: 0x50002600, Name: "Curve Referenced Overlay Sequence", Keyword: "CurveReferencedOverlaySequence", VR: "SQ", VM: "1", Retired: true}},
+	{mask: 0xFF00FFFF, Element: Element{Tag: 0x50002610, Name: "Curve Referenced Overlay Group", Keyword: "CurveReferencedOverlayGroup", VR: "US", VM: "1", Retired: true}},
+	{mask: 0xFF00FFFF, Element: Element{Tag: 0x50003000, Name: "Curve Data", Keyword: "CurveData", VR: "OB or OW", VM: "1", Retired: true}},
+	{mask: 0xFF00FFFF, Element: Element{Tag: 0x60000010, Name: "Overlay Rows", Keyword: "OverlayRows", VR: "US", VM: "1"}},
+	{mask: 0xFF00FFFF, Element: Element{Tag: 0x60000011, Name: "Overlay Columns", Keyword: "OverlayColumns", VR: "US", VM: "1"}},
+	{mask: 0xFF00FFFF, Element: Element{Tag: 0x60000012, Name: "Overlay Planes", Keyword: "OverlayPlanes", VR: "US", VM: "1", Retired: true}},
+	{mask: 0xFF00FFFF, Element: Element{Tag: 0x60000015, Name: "Number of Frames in Overlay", Keyword: "NumberOfFramesInOverlay", VR: "IS", VM: "1"}},
+	{mask: 0xFF00FFFF, Element: Element{Tag: 0x60000022, Name: "Overlay Description", Keyword: "OverlayDescription", VR: "LO", VM: "1"}},
+	{mask: 0xFF00FFFF, Element: Element{Tag: 0x60000040, Name: "Overlay Type", Keyword: "OverlayType", VR: "CS", VM: "1"}},
+	{mask: 0xFF00FFFF, Element: Element{Tag: 0x60000045, Name: "Overlay Subtype", Keyword: "OverlaySubtype", VR: "LO", VM: "1"}},
+	{mask: 0xFF00FFFF, Element: Element{Tag: 0x60000050, Name: "Overlay Origin", Keyword: "OverlayOrigin", VR: "SS", VM: "2"}},
+	{mask: 0xFF00FFFF, Element: Element{Tag: 0x60000051, Name: "Image Frame Origin", Keyword: "ImageFrameOrigin", VR: "US", VM: "1"}},
+	{mask: 0xFF00FFFF, Element: Element{Tag: 0x60000052, Name: "Overlay Plane Origin", Keyword: "OverlayPlaneOrigin", VR: "US", VM: "1", Retired: true}},
+	{mask: 0xFF00FFFF, Element: Element{Tag: 0x60000060, Name: "Overlay Compression Code", Keyword: "OverlayCompressionCode", VR: "CS", VM: "1", Retired: true}},
+	{mask: 0xFF00FFFF, Element: Element{Tag: 0x60000061, Name: "Overlay Compression Originator", Keyword: "OverlayCompressionOriginator", VR: "SH", VM: "1", Retired: true}},
+	{mask: 0xFF00FFFF, Element: Element{Tag: 0x60000062, Name: "Overlay Compression Label", Keyword: "OverlayCompressionLabel", VR: "SH", VM: "1", Retired: true}},
+	{mask: 0xFF00FFFF, Element: Element{Tag: 0x60000063, Name: "Overlay Compression Description", Keyword: "OverlayCompressionDescription", VR: "CS", VM: "1", Retired: true}},
+	{mask: 0xFF00FFFF, Element: Element{Tag: 0x60000066, Name: "Overlay Compression Step Pointers", Keyword: "OverlayCompressionStepPointers", VR: "AT", VM: "1-n", Retired: true}},
+	{mask: 0xFF00FFFF, Element: Element{Tag: 0x60000068, Name: "Overlay Repeat Interval", Keyword: "OverlayRepeatInterval", VR: "US", VM: "1", Retired: true}},
+	{mask: 0xFF00FFFF, Element: Element{Tag: 0x60000069, Name: "Overlay Bits Grouped", Keyword: "OverlayBitsGrouped", VR: "US", VM: "1", Retired: true}},
+	{mask: 0xFF00FFFF, Element: Element{Tag: 0x60000100, Name: "Overlay Bits Allocated", Keyword: "OverlayBitsAllocated", VR: "US", VM: "1"}},
+	{mask: 0xFF00FFFF, Element: Element{Tag: 0x60000102, Name: "Overlay Bit Position", Keyword: "OverlayBitPosition", VR: "US", VM: "1"}},
+	{mask: 0xFF00FFFF, Element: Element{Tag: 0x60000110, Name: "Overlay Format", Keyword: "OverlayFormat", VR: "CS", VM: "1", Retired: true}},
+	{mask: 0xFF00FFFF, Element: Element{Tag: 0x60000200, Name: "Overlay Location", Keyword: "OverlayLocation", VR: "US", VM: "1", Retired: true}},
+	{mask: 0xFF00FFFF, Element: Element{Tag: 0x60000800, Name: "Overlay Code Label", Keyword: "OverlayCodeLabel", VR: "CS", VM: "1-n", Retired: true}},
+	{mask: 0xFF00FFFF, Element: Element{Tag: 0x60000802, Name: "Overlay Number of Tables", Keyword: "OverlayNumberOfTables", VR: "US", VM: "1", Retired: true}},
+	{mask: 0xFF00FFFF, Element: Element{Tag: 0x60000803, Name: "Overlay Code Table Location", Keyword: "OverlayCodeTableLocation", VR: "AT", VM: "1-n", Retired: true}},
+	{mask: 0xFF00FFFF, Element: Element{Tag: 0x60000804, Name: "Overlay Bits For Code Word", Keyword: "OverlayBitsForCodeWord", VR: "US", VM: "1", Retired: true}},
+	{mask: 0xFF00FFFF, Element: Element{Tag: 0x60001001, Name: "Overlay Activation Layer", Keyword: "OverlayActivationLayer", VR: "CS", VM: "1"}},
+	{mask: 0xFF00FFFF, Element: Element{Tag: 0x60001100, Name: "Overlay Descriptor - Gray", Keyword: "OverlayDescriptorGray", VR: "US", VM: "1", Retired: true}},
+	{mask: 0xFF00FFFF, Element: Element{Tag: 0x60001101, Name: "Overlay Descriptor - Red", Keyword: "OverlayDescriptorRed", VR: "US", VM: "1", Retired: true}},
+	{mask: 0xFF00FFFF, Element: Element{Tag: 0x60001102, Name: "Overlay Descriptor - Green", Keyword: "OverlayDescriptorGreen", VR: "US", VM: "1", Retired: true}},
+	{mask: 0xFF00FFFF, Element: Element{Tag: 0x60001103, Name: "Overlay Descriptor - Blue", Keyword: "OverlayDescriptorBlue", VR: "US", VM: "1", Retired: true}},
+	{mask: 0xFF00FFFF, Element: Element{Tag: 0x60001200, Name: "Overlays - Gray", Keyword: "OverlaysGray", VR: "US", VM: "1-n", Retired: true}},
+	{mask: 0xFF00FFFF, Element: Element{Tag: 0x60001201, Name: "Overlays - Red", Keyword: "OverlaysRed", VR: "US", VM: "1-n", Retired: true}},
+	{mask: 0xFF00FFFF, Element: Element{Tag: 0x60001202, Name: "Overlays - Green", Keyword: "OverlaysGreen", VR: "US", VM: "1-n", Retired: true}},
+	{mask: 0xFF00FFFF, Element: Element{Tag: 0x60001203, Name: "Overlays - Blue", Keyword: "OverlaysBlue", VR: "US", VM: "1-n", Retired: true}},
+	{mask: 0xFF00FFFF, Element: Element{Tag: 0x60001301, Name: "ROI Area", Keyword: "ROIArea", VR: "IS", VM: "1"}},
+	{mask: 0xFF00FFFF, Element: Element{Tag: 0x60001302, Name: "ROI Mean", Keyword: "ROIMean", VR: "DS", VM: "1"}},
+	{mask: 0xFF00FFFF, Element: Element{Tag: 0x60001303, Name: "ROI Standard Deviation", Keyword: "ROIStandardDeviation", VR: "DS", VM: "1"}},
+	{mask: 0xFF00FFFF, Element: Element{Tag: 0x60001500, Name: "Overlay Label", Keyword: "OverlayLabel", VR: "LO", VM: "1"}},
+	{mask: 0xFF00FFFF, Element: Element{Tag: 0x60003000, Name: "Overlay Data", Keyword: "OverlayData", VR: "OB or OW", VM: "1"}},
+	{mask: 0xFF00FFFF, Element: Element{Tag: 0x60004000, Name: "Overlay Comments", Keyword: "OverlayComments", VR: "LT", VM: "1", Retired: true}},
+	{mask: 0xFF00FFFF, Element: Element{Tag: 0x7F000010, Name: "Variable Pixel Data", Keyword: "VariablePixelData", VR: "OB or OW", VM: "1", Retired: true}},
+	{mask: 0xFF00FFFF, Element: Element{Tag: 0x7F000011, Name: "Variable Next Data Group", Keyword: "VariableNextDataGroup", VR: "US", VM: "1", Retired: true}},
+	{mask: 0xFF00FFFF, Element: Element{Tag: 0x7F000020, Name: "Variable Coefficients SDVN", Keyword: "VariableCoefficientsSDVN", VR: "OW", VM: "1", Retired: true}},
+	{mask: 0xFF00FFFF, Element: Element{Tag: 0x7F000030, Name: "Variable Coefficients SDHN", Keyword: "VariableCoefficientsSDHN", VR: "OW", VM: "1", Retired: true}},
+	{mask: 0xFF00FFFF, Element: Element{Tag: 0x7F000040, Name: "Variable Coefficients SDDN", Keyword: "VariableCoefficientsSDDN", VR: "OW", VM: "1", Retired: true}},
+}
+
+// uids is the registry of UIDs
+var uids = []UID{
+	{Value: "1.2.840.10008.1.1", Name: "Verification SOP Class", Keyword: "Verification", Type: "SOP Class"},
+	{Value: "1.2.840.10008.1.2", Name: "Implicit VR Little Endian", Keyword: "ImplicitVRLittleEndian", Type: "Transfer Syntax"},
+	{Value: "1.2.840.10008.1.2.1", Name: "Explicit VR Little Endian", Keyword: "ExplicitVRLittleEndian", Type: "Transfer Syntax"},
+	{Value: "1.2.840.10008.1.2.1.98", Name: "Encapsulated Uncompressed Explicit VR Little Endian", Keyword: "EncapsulatedUncompressedExplicitVRLittleEndian", Type: "Transfer Syntax"},
+	{Value: "1.2.840.10008.1.2.1.99", Name: "Deflated Explicit VR Little Endian", Keyword: "DeflatedExplicitVRLittleEndian", Type: "Transfer Syntax"},
+	{Value: "1.2.840.10008.1.2.2", Name: "Explicit VR Big Endian (Retired)", Keyword: "ExplicitVRBigEndian", Type: "Transfer Syntax", Retired: true},
+	{Value: "1.2.840.10008.1.2.4.50", Name: "JPEG Baseline (Process 1)", Keyword: "JPEGBaseline8Bit", Type: "Transfer Syntax"},
+	{Value: "1.2.840.10008.1.2.4.51", Name: "JPEG Extended (Process 2 & 4)", Keyword: "JPEGExtended12Bit", Type: "Transfer Syntax"},
+	{Value: "1.2.840.10008.1.2.4.52", Name: "JPEG Extended (Process 3 & 5) (Retired)", Keyword: "JPEGExtended35", Type: "Transfer Syntax", Retired: true},
+	{Value: "1.2.840.10008.1.2.4.53", Name: "JPEG Spectral Selection, Non-Hierarchical (Process 6 & 8) (Retired)", Keyword: "JPEGSpectralSelectionNonHierarchical68", Type: "Transfer Syntax", Retired: true},
+	{Value: "1.2.840.10008.1.2.4.54", Name: "JPEG Spectral Selection, Non-Hierarchical (Process 7 & 9) (Retired)", Keyword: "JPEGSpectralSelectionNonHierarchical79", Type: "Transfer Syntax", Retired: true},
+	{Value: "1.2.840.10008.1.2.4.55", Name: "JPEG Full Progression, Non-Hierarchical (Process 10 & 12) (Retired)", Keyword: "JPEGFullProgressionNonHierarchical1012", Type: "Transfer Syntax", Retired: true},
+	{Value: "1.2.840.10008.1.2.4.56", Name: "JPEG Full Progression, Non-Hierarchical (Process 11 & 13) (Retired)", Keyword: "JPEGFullProgressionNonHierarchical1113", Type: "Transfer Syntax", Retired: true},
+	{Value: "1.2.840.10008.1.2.4.57", Name: "JPEG Lossless, Non-Hierarchical (Process 14)", Keyword: "JPEGLossless", Type: "Transfer Syntax"},
+	{Value: "1.2.840.10008.1.2.4.58", Name: "JPEG Lossless, Non-Hierarchical (Process 15) (Retired)", Keyword: "JPEGLosslessNonHierarchical15", Type: "Transfer Syntax", Retired: true},
+	{Value: "1.2.840.10008.1.2.4.59", Name: "JPEG Extended, Hierarchical (Process 16 & 18) (Retired)", Keyword: "JPEGExtendedHierarchical1618", Type: "Transfer Syntax", Retired: true},
+	{Value: "1.2.840.10008.1.2.4.60", Name: "JPEG Extended, Hierarchical (Process 17 & 19) (Retired)", Keyword: "JPEGExtendedHierarchical1719", Type: "Transfer Syntax", Retired: true},
+	{Value: "1.2.840.10008.1.2.4.61", Name: "JPEG Spectral Selection, Hierarchical (Process 20 & 22) (Retired)", Keyword: "JPEGSpectralSelectionHierarchical2022", Type: "Transfer Syntax", Retired: true},
+	{Value: "1.2.840.10008.1.2.4.62", Name: "JPEG Spectral Selection, Hierarchical (Process 21 & 23) (Retired)", Keyword: "JPEGSpectralSelectionHierarchical2123", Type: "Transfer Syntax", Retired: true},
+	{Value: "1.2.840.10008.1.2.4.63", Name: "JPEG Full Progression, Hierarchical (Process 24 & 26) (Retired)", Keyword: "JPEGFullProgressionHierarchical2426", Type: "Transfer Syntax", Retired: true},
+	{Value: "1.2.840.10008.1.2.4.64", Name: "JPEG Full Progression, Hierarchical (Process 25 & 27) (Retired)", Keyword: "JPEGFullProgressionHierarchical2527", Type: "Transfer Syntax", Retired: true},
+	{Value: "1.2.840.10008.1.2.4.65", Name: "JPEG Lossless, Hierarchical (Process 28) (Retired)", Keyword: "JPEGLosslessHierarchical28", Type: "Transfer Syntax", Retired: true},
+	{Value: "1.2.840.10008.1.2.4.66", Name: "JPEG Lossless, Hierarchical (Process 29) (Retired)", Keyword: "JPEGLosslessHierarchical29", Type: "Transfer Syntax", Retired: true},
+	{Value: "1.2.840.10008.1.2.4.70", Name: "JPEG Lossless, Non-Hierarchical, First-Order Prediction (Process 14 [Selection Value 1])", Keyword: "JPEGLosslessSV1", Type: "Transfer Syntax"},
+	{Value: "1.2.840.10008.1.2.4.80", Name: "JPEG-LS Lossless Image Compression", Keyword: "JPEGLSLossless", Type: "Transfer Syntax"},
+	{Value: "1.2.840.10008.1.2.4.81", Name: "JPEG-LS Lossy (Near-Lossless) Image Compression", Keyword: "JPEGLSNearLossless", Type: "Transfer Syntax"},
+	{Value: "1.2.840.10008.1.2.4.90", Name: "JPEG 2000 Image Compression (Lossless Only)", Keyword: "JPEG2000Lossless", Type: "Transfer Syntax"},
+	{Value: "1.2.840.10008.1.2.4.91", Name: "JPEG 2000 Image Compression", Keyword: "JPEG2000", Type: "Transfer Syntax"},
+	{Value: "1.2.840.10008.1.2.4.92", Name: "JPEG 2000 Part 2 Multi-component Image Compression (Lossless Only)", Keyword: "JPEG2000MCLossless", Type: "Transfer Syntax"},
+	{Value: "1.2.840.10008.1.2.4.93", Name: "JPEG 2000 Part 2 Multi-component Image Compression", Keyword: "JPEG2000MC", Type: "Transfer Syntax"},
+	{Value: "1.2.840.10008.1.2.4.94", Name: "JPIP Referenced", Keyword: "JPIPReferenced", Type: "Transfer Syntax"},
+	{Value: "1.2.840.10008.1.2.4.95", Name: "JPIP Referenced Deflate", Keyword: "JPIPReferencedDeflate", Type: "Transfer Syntax"},
+	{Value: "1.2.840.10008.1.2.4.100", Name: "MPEG2 Main Profile / Main Level", Keyword: "MPEG2MPML", Type: "Transfer Syntax"},
+	{Value: "1.2.840.10008.1.2.4.101", Name: "MPEG2 Main Profile / High Level", Keyword: "MPEG2MPHL", Type: "Transfer Syntax"},
+	{Value: "1.2.840.10008.1.2.4.102", Name: "MPEG-4 AVC/H.264 High Profile / Level 4.1", Keyword: "MPEG4HP41", Type: "Transfer Syntax"},
+	{Value: "1.2.840.10008.1.2.4.103", Name: "MPEG-4 AVC/H.264 BD-compatible High Profile / Level 4.1", Keyword: "MPEG4HP41BD", Type: "Transfer Syntax"},
+	{Value: "1.2.840.10008.1.2.4.104", Name: "MPEG-4 AVC/H.264 High Profile / Level 4.2 For 2D Video", Keyword: "MPEG4HP422D", Type: "Transfer Syntax"},
+	{Value: "1.2.840.10008.1.2.4.105", Name: "MPEG-4 AVC/H.264 High Profile / Level 4.2 For 3D Video", Keyword: "MPEG4HP423D", Type: "Transfer Syntax"},
+	{Value: "1.2.840.10008.1.2.4.106", Name: "MPEG-4 AVC/H.264 Stereo High Profile / Level 4.2", Keyword: "MPEG4HP42STEREO", Type: "Transfer Syntax"},
+	{Value: "1.2.840.10008.1.2.4.107", Name: "HEVC/H.265 Main Profile / Level 5.1", Keyword: "HEVCMP51", Type: "Transfer Syntax"},
+	{Value: "1.2.840.10008.1.2.4.108", Name: "HEVC/H.265 Main 10 Profile / Level 5.1", Keyword: "HEVCM10P51", Type: "Transfer Syntax"},
+	{Value: "1.2.840.10008.1.2.4.201", Name: "High-Throughput JPEG 2000 Image Compression (Lossless Only)", Keyword: "HTJ2KLossless", Type: "Transfer Syntax"},
+	{Value: "1.2.840.10008.1.2.4.202", Name: "High-Throughput JPEG 2000 with RPCL Options Image Compression (Lossless Only)", Keyword: "HTJ2KLosslessRPCL", Type: "Transfer Syntax"},
+	{Value: "1.2.840.10008.1.2.4.203", Name: "High-Throughput JPEG 2000 Image Compression", Keyword: "HTJ2K", Type: "Transfer Syntax"},
+	{Value: "1.2.840.10008.1.2.4.204", Name: "JPIP HTJ2K Referenced", Keyword: "JPIPHTJ2KReferenced", Type: "Transfer Syntax"},
+	{Value: "1.2.840.10008.1.2.4.205", Name: "JPIP HTJ2K Referenced Deflate", Keyword: "JPIPHTJ2KReferencedDeflate", Type: "Transfer Syntax"},
+	{Value: "1.2.840.10008.1.2.5", Name: "RLE Lossless", Keyword: "RLELossless", Type: "Transfer Syntax"},
+	{Value: "1.2.840.10008.1.2.6.1", Name: "RFC 2557 MIME encapsulation (Retired)", Keyword: "RFC2557MIMEEncapsulation", Type: "Transfer Syntax", Retired: true},
+	{Value: "1.2.840.10008.1.2.6.2", Name: "XML Encoding (Retired)", Keyword: "XMLEncoding", Type: "Transfer Syntax", Retired: true},
+	{Value: "1.2.840.10008.1.2.7.1", Name: "SMPTE ST 2110-20 Uncompressed Progressive Active Video", Keyword: "SMPTEST211020UncompressedProgressiveActiveVideo", Type: "Transfer Syntax"},
+	{Value: "1.2.840.10008.1.2.7.2", Name: "SMPTE ST 2110-20 Uncompressed Interlaced Active Video", Keyword: "SMPTEST211020UncompressedInterlacedActiveVideo", Type: "Transfer Syntax"},
+	{Value: "1.2.840.10008.1.2.7.3", Name: "SMPTE ST 2110-30 PCM Digital Audio", Keyword: "SMPTEST211030PCMDigitalAudio", Type: "Transfer Syntax"},
+	{Value: "1.2.840.10008.1.3.10", Name: "Media Storage Directory Storage", Keyword: "MediaStorageDirectoryStorage", Type: "SOP Class"},
+	{Value: "1.2.840.10008.5.1.4.1.1.1", Name: "Computed Radiography Image Storage", Keyword: "ComputedRadiographyImageStorage", Type: "SOP Class"},
+	{Value: "1.2.840.10008.5.1.4.1.1.1.1", Name: "Digital X-Ray Image Storage - For Presentation", Keyword: "DigitalXRayImageStorageForPresentation", Type: "SOP Class"},
+	{Value: "1.2.840.10008.5.1.4.1.1.1.1.1", Name: "Digital X-Ray Image Storage - For Processing", Keyword: "DigitalXRayImageStorageForProcessing", Type: "SOP Class"},
+	{Value: "1.2.840.10008.5.1.4.1.1.1.2", Name: "Digital Mammography X-Ray Image Storage - For Presentation", Keyword: "DigitalMammographyXRayImageStorageForPresentation", Type: "SOP Class"},
+	{Value: "1.2.840.10008.5.1.4.1.1.1.2.1", Name: "Digital Mammography X-Ray Image Storage - For Processing", Keyword: "DigitalMammographyXRayImageStorageForProcessing", Type: "SOP Class"},
+	{Value: "1.2.840.10008.5.1.4.1.1.1.3", Name: "Digital Intra-Oral X-Ray Image Storage - For Presentation", Keyword: "DigitalIntraOralXRayImageStorageForPresentation", Type: "SOP Class"},
+	{Value: "1.2.840.10008.5.1.4.1.1.1.3.1", Name: "Digital Intra-Oral X-Ray Image Storage - For Processing", Keyword: "DigitalIntraOralXRayImageStorageForProcessing", Type: "SOP Class"},
+	{Value: "1.2.840.10008.5.1.4.1.1.2", Name: "CT Image Storage", Keyword: "CTImageStorage", Type: "SOP Class"},
+	{Value: "1.2.840.10008.5.1.4.1.1.2.1", Name: "Enhanced CT Image Storage", Keyword: "EnhancedCTImageStorage", Type: "SOP Class"},
+	{Value: "1.2.840.10008.5.1.4.1.1.3.1", Name: "Ultrasound Multi-frame Image Storage", Keyword: "UltrasoundMultiFrameImageStorage", Type: "SOP Class"},
+	{Value: "1.2.840.10008.5.1.4.1.1.4", Name: "MR Image Storage", Keyword: "MRImageStorage", Type: "SOP Class"},
+	{Value: "1.2.840.10008.5.1.4.1.1.4.1", Name: "Enhanced MR Image Storage", Keyword: "EnhancedMRImageStorage", Type: "SOP Class"},
+	{Value: "1.2.840.10008.5.1.4.1.1.4.2", Name: "MR Spectroscopy Storage", Keyword: "MRSpectroscopyStorage", Type: "SOP Class"},
+	{Value: "1.2.840.10008.5.1.4.1.1.4.3", Name: "Enhanced MR Color Image Storage", Keyword: "EnhancedMRColorImageStorage", Type: "SOP Class"},
+	{Value: "1.2.840.10008.5.1.4.1.1.6.1", Name: "Ultrasound Image Storage", Keyword: "UltrasoundImageStorage", Type: "SOP Class"},
+	{Value: "1.2.840.10008.5.1.4.1.1.6.2", Name: "Enhanced US Volume Storage", Keyword: "EnhancedUSVolumeStorage", Type: "SOP Class"},
+	{Value: "1.2.840.10008.5.1.4.1.1.7", Name: "Secondary Capture Image Storage", Keyword: "SecondaryCaptureImageStorage", Type: "SOP Class"},
+	{Value: "1.2.840.10008.5.1.4.1.1.7.1", Name: "Multi-frame Single Bit Secondary Capture Image Storage", Keyword: "MultiFrameSingleBitSecondaryCaptureImageStorage", Type: "SOP Class"},
+	{Value: "1.2.840.10008.5.1.4.1.1.7.2", Name: "Multi-frame Grayscale Byte Secondary Capture Image Storage", Keyword: "MultiFrameGrayscaleByteSecondaryCaptureImageStorage", Type: "SOP Class"},
+	{Value: "1.2.840.10008.5.1.4.1.1.7.3", Name: "Multi-frame Grayscale Word Secondary Capture Image Storage", Keyword: "MultiFrameGrayscaleWordSecondaryCaptureImageStorage", Type: "SOP Class"},
+	{Value: "1.2.840.10008.5.1.4.1.1.7.4", Name: "Multi-frame True Color Secondary Capture Image Storage", Keyword: "MultiFrameTrueColorSecondaryCaptureImageStorage", Type: "SOP Class"},
+	{Value: "1.2.840.10008.5.1.4.1.1.9.1.1", Name: "12-lead ECG Waveform Storage", Keyword: "TwelveLeadECGWaveformStorage", Type: "SOP Class"},
+	{Value: "1.2.840.10008.5.1.4.1.1.9.1.2", Name: "General ECG Waveform Storage", Keyword: "GeneralECGWaveformStorage", Type: "SOP Class"},
+	{Value: "1.2.840.10008.5.1.4.1.1.11.1", Name: "Grayscale Softcopy Presentation State Storage", Keyword: "GrayscaleSoftcopyPresentationStateStorage", Type: "SOP Class"},
+	{Value: "1.2.840.10008.5.1.4.1.1.11.2", Name: "Color Softcopy Presentation State Storage", Keyword: "ColorSoftcopyPresentationStateStorage", Type: "SOP Class"},
+	{Value: "1.2.840.10008.5.1.4.1.1.12.1", Name: "X-Ray Angiographic Image Storage", Keyword: "XRayAngiographicImageStorage", Type: "SOP Class"},
+	{Value: "1.2.840.10008.5.1.4.1.1.12.1.1", Name: "Enhanced XA Image Storage", Keyword: "EnhancedXAImageStorage", Type: "SOP Class"},
+	{Value: "1.2.840.10008.5.1.4.1.1.12.2", Name: "X-Ray Radiofluoroscopic Image Storage", Keyword: "XRayRadiofluoroscopicImageStorage", Type: "SOP Class"},
+	{Value: "1.2.840.10008.5.1.4.1.1.12.2.1", Name: "Enhanced XRF Image Storage", Keyword: "EnhancedXRFImageStorage", Type: "SOP Class"},
+	{Value: "1.2.840.10008.5.1.4.1.1.13.1.1", Name: "X-Ray 3D Angiographic Image Storage", Keyword: "XRay3DAngiographicImageStorage", Type: "SOP Class"},
+	{Value: "1.2.840.10008.5.1.4.1.1.13.1.3", Name: "Breast Tomosynthesis Image Storage", Keyword: "BreastTomosynthesisImageStorage", Type: "SOP Class"},
+	{Value: "1.2.840.10008.5.1.4.1.1.20", Name: "Nuclear Medicine Image Storage", Keyword: "NuclearMedicineImageStorage", Type: "SOP Class"},
+	{Value: "1.2.840.10008.5.1.4.1.1.30", Name: "Parametric Map Storage", Keyword: "ParametricMapStorage", Type: "SOP Class"},
+	{Value: "1.2.840.10008.5.1.4.1.1.66", Name: "Raw Data Storage", Keyword: "RawDataStorage", Type: "SOP Class"},
+	{Value: "1.2.840.10008.5.1.4.1.1.66.1", Name: "Spatial Registration Storage", Keyword: "SpatialRegistrationStorage", Type: "SOP Class"},
+	{Value: "1.2.840.10008.5.1.4.1.1.66.4", Name: "Segmentation Storage", Keyword: "SegmentationStorage", Type: "SOP Class"},
+	{Value: "1.2.840.10008.5.1.4.1.1.77.1.1", Name: "VL Endoscopic Image Storage", Keyword: "VLEndoscopicImageStorage", Type: "SOP Class"},
+	{Value: "1.2.840.10008.5.1.4.1.1.77.1.2", Name: "VL Microscopic Image Storage", Keyword: "VLMicroscopicImageStorage", Type: "SOP Class"},
+	{Value: "1.2.840.10008.5.1.4.1.1.77.1.3", Name: "VL Slide-Coordinates Microscopic Image Storage", Keyword: "VLSlideCoordinatesMicroscopicImageStorage", Type: "SOP Class"},
+	{Value: "1.2.840.10008.5.1.4.1.1.77.1.4", Name: "VL Photographic Image Storage", Keyword: "VLPhotographicImageStorage", Type: "SOP Class"},
+	{Value: "1.2.840.10008.5.1.4.1.1.77.1.5.1", Name: "Ophthalmic Photography 8 Bit Image Storage", Keyword: "OphthalmicPhotography8BitImageStorage", Type: "SOP Class"},
+	{Value: "1.2.840.10008.5.1.4.1.1.77.1.5.4", Name: "Ophthalmic Tomography Image Storage", Keyword: "OphthalmicTomographyImageStorage", Type: "SOP Class"},
+	{Value: "1.2.840.10008.5.1.4.1.1.77.1.6", Name: "VL Whole Slide Microscopy Image Storage", Keyword: "VLWholeSlideMicroscopyImageStorage", Type: "SOP Class"},
+	{Value: "1.2.840.10008.5.1.4.1.1.88.11", Name: "Basic Text SR Storage", Keyword: "BasicTextSRStorage", Type: "SOP Class"},
+	{Value: "1.2.840.10008.5.1.4.1.1.88.22", Name: "Enhanced SR Storage", Keyword: "EnhancedSRStorage", Type: "SOP Class"},
+	{Value: "1.2.840.10008.5.1.4.1.1.88.33", Name: "Comprehensive SR Storage", Keyword: "ComprehensiveSRStorage", Type: "SOP Class"},
+	{Value: "1.2.840.10008.5.1.4.1.1.88.34", Name: "Comprehensive 3D SR Storage", Keyword: "Comprehensive3DSRStorage", Type: "SOP Class"},
+	{Value: "1.2.840.10008.5.1.4.1.1.88.50", Name: "Mammography CAD SR Storage", Keyword: "MammographyCADSRStorage", Type: "SOP Class"},
+	{Value: "1.2.840.10008.5.1.4.1.1.88.59", Name: "Key Object Selection Document Storage", Keyword: "KeyObjectSelectionDocumentStorage", Type: "SOP Class"},
+	{Value: "1.2.840.10008.5.1.4.1.1.88.67", Name: "X-Ray Radiation Dose SR Storage", Keyword: "XRayRadiationDoseSRStorage", Type: "SOP Class"},
+	{Value: "1.2.840.10008.5.1.4.1.1.104.1", Name: "Encapsulated PDF Storage", Keyword: "EncapsulatedPDFStorage", Type: "SOP Class"},
+	{Value: "1.2.840.10008.5.1.4.1.1.104.2", Name: "Encapsulated CDA Storage", Keyword: "EncapsulatedCDAStorage", Type: "SOP Class"},
+	{Value: "1.2.840.10008.5.1.4.1.1.128", Name: "Positron Emission Tomography Image Storage", Keyword: "PositronEmissionTomographyImageStorage", Type: "SOP Class"},
+	{Value: "1.2.840.10008.5.1.4.1.1.130", Name: "Enhanced PET Image Storage", Keyword: "EnhancedPETImageStorage", Type: "SOP Class"},
+	{Value: "1.2.840.10008.5.1.4.1.1.481.1", Name: "RT Image Storage", Keyword: "RTImageStorage", Type: "SOP Class"},
+	{Value: "1.2.840.10008.5.1.4.1.1.481.2", Name: "RT Dose Storage", Keyword: "RTDoseStorage", Type: "SOP Class"},
+	{Value: "1.2.840.10008.5.1.4.1.1.481.3", Name: "RT Structure Set Storage", Keyword: "RTStructureSetStorage", Type: "SOP Class"},
+	{Value: "1.2.840.10008.5.1.4.1.1.481.5", Name: "RT Plan Storage", Keyword: "RTPlanStorage", Type: "SOP Class"},
+	{Value: "1.2.840.10008.5.1.4.1.1.481.8", Name: "RT Ion Plan Storage", Keyword: "RTIonPlanStorage", Type: "SOP Class"},
+}
@@ -0,0 +1,70 @@
+package dictionary_test
+
+import (
+	"testing"
+
+	"gitlab.com/medical-research/dicom-deidentifier/dicom/dictionary"
+)
+
+func TestLookup(t *testing.T) {
+	tests := []struct {
+		tag     uint32
+		keyword string
+		name    string
+		vr      string
+		retired bool
+	}{
+		{0x00100010, "PatientName", "Patient's Name", "PN", false},
+		{0x00280106, "SmallestImagePixelValue", "Smallest Image Pixel Value", "US or SS", false},
+		{0x60020010, "OverlayRows", "Overlay Rows", "US", false},
+		{0x501E3000, "CurveData", "Curve Data", "OB or OW", true},
+		{0x00186011, "SequenceOfUltrasoundRegions", "Sequence of Ultrasound Regions", "SQ", false},
+		{0x300A0002, "RTPlanLabel", "RT Plan Label", "SH", false},
+	}
+	for _, tt := range tests {
+		e, ok := dictionary.Lookup(tt.tag)
+		if !ok {
+			t.Errorf("Lookup(%08X) not found", tt.tag)
+			continue
+		}
+		if e.Tag != tt.tag || e.Keyword != tt.keyword || e.Name != tt.name || e.VR != tt.vr || e.Retired != tt.retired {
+			t.Errorf("Lookup(%08X) = %+v", tt.tag, e)
+		}
+		if k, ok := dictionary.LookupKeyword(tt.keyword); !ok || k.Tag&0xFF00FFFF != tt.tag&0xFF00FFFF {
+			t.Errorf("LookupKeyword(%q) = %08X, %v", tt.keyword, k.Tag, ok)
+		}
+	}
+	if _, ok := dictionary.Lookup(0x00091001); ok {
+		t.Errorf("Lookup() found a private tag")
+	}
+
+	uid, ok := dictionary.LookupUID("1.2.840.10008.5.1.4.1.1.2")
+	if !ok || uid.Keyword != "CTImageStorage" || uid.Type != dictionary.SOPClass {
+		t.Errorf("LookupUID() = %+v, %v", uid, ok)
+	}
+	if uid, ok := dictionary.LookupUIDKeyword("ExplicitVRBigEndian"); !ok || !uid.Retired || uid.Type != dictionary.TransferSyntax {
+		t.Errorf("LookupUIDKeyword() = %+v, %v", uid, ok)
+	}
+	if uid, ok := dictionary.LookupUID("1.2.840.10008.1.2.4.94"); !ok || uid.Keyword != "JPIPReferenced" || uid.Type != dictionary.TransferSyntax {
+		t.Errorf("LookupUID() = %+v, %v", uid, ok)
+	}
+}
+
+// TestLookup_Edition checks that the dictionary was generated from the standard and covers the
+// modules of IODs the de-identifier has no specific handling for
+func TestLookup_Edition(t *testing.T) {
+	if dictionary.Edition == "" {
+		t.Fatalf("dictionary was not generated from PS3.6, run go generate")
+	}
+	for _, tag := range []uint32{
+		0x00186012, // Region Spatial Format
+		0x00189004, // Content Qualification
+		0x00540016, // Radiopharmaceutical Information Sequence
+		0x30060020, // Structure Set ROI Sequence
+		0x300A00B0, // Beam Sequence
+	} {
+		if _, ok := dictionary.Lookup(tag); !ok {
+			t.Errorf("Lookup(%08X) not found in PS3.6 %s", tag, dictionary.Edition)
+		}
+	}
+}
@@ -0,0 +1,357 @@
+// Command dictgen maintains the data dictionary and the UID registry of PS3.6.
+//
+// The registry is kept as CSV data in the dictionary package and compiled into Go tables.
+//
+// Usage:
+//
+//	dictgen -elements elements.csv -uids uids.csv -out dictionary_table.go
+//	dictgen -xml part06.xml -elements elements.csv -uids uids.csv -out dictionary_table.go
+//
+// With -xml, the registries of data elements (Tables 6-1, 7-1, 8-1 and 9-1) and the registry
+// of UIDs (Table A-1) are extracted from the DocBook source of PS3.6, given as a file or a URL,
+// and replace the CSV data. The Go tables are always generated from the CSV data.
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/xml"
+	"flag"
+	"fmt"
+	"go/format"
+	"html"
+	"io"
+	"net/http"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// elementTables are the xml:ids of the registries of data elements in PS3.6
+var elementTables = map[string]bool{
+	"table_6-1": true,
+	"table_7-1": true,
+	"table_8-1": true,
+	"table_9-1": true,
+}
+
+// uidTable is the xml:id of the registry of UIDs in PS3.6
+const uidTable = "table_A-1"
+
+// editionPrefix starts the line of the CSV data recording the edition of the standard
+const editionPrefix = "# edition "
+
+var (
+	// tagPattern matches the tags of the registry, the digits of repeating tags being written "x"
+	tagPattern = regexp.MustCompile(`^\(([0-9A-Fx]{4}),([0-9A-Fx]{4})\)$`)
+
+	// editionPattern matches the edition in the subtitle of the standard, e.g. "DICOM PS3.6 2024b"
+	editionPattern = regexp.MustCompile(`\b(\d{4}[a-z])\b`)
+
+	// markup matches the elements within a cell
+	markup = regexp.MustCompile(`<[^>]*>`)
+)
+
+// registry holds the data elements and UIDs of an edition of the standard as CSV records
+type registry struct {
+	edition  string
+	elements [][]string
+	uids     [][]string
+}
+
+// elementHeader and uidHeader are the headers of the CSV data
+var (
+	elementHeader = []string{"tag", "name", "keyword", "vr", "vm", "retired"}
+	uidHeader     = []string{"uid", "name", "keyword", "type", "retired"}
+)
+
+func main() {
+	if err := run(os.Args[1:]); err != nil {
+		fmt.Fprintln(os.Stderr, "dictgen:", err)
+		os.Exit(1)
+	}
+}
+
+// run parses the command line, refreshes the CSV data when a source is given and generates the Go tables
+func run(args []string) error {
+	fs := flag.NewFlagSet("dictgen", flag.ContinueOnError)
+	source := fs.String("xml", "", "DocBook source of PS3.6, as a file or URL")
+	elements := fs.String("elements", "elements.csv", "CSV data elements")
+	uids := fs.String("uids", "uids.csv", "CSV UIDs")
+	out := fs.String("out", "dictionary_table.go", "generated Go file")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	var reg *registry
+	if *source != "" {
+		var err error
+		if reg, err = readStandard(*source); err != nil {
+			return err
+		}
+		fmt.Printf("PS3.6 %s: %d data elements, %d UIDs\n", reg.edition, len(reg.elements), len(reg.uids))
+		if err := writeCSVFile(*elements, reg.edition, elementHeader, reg.elements); err != nil {
+			return err
+		}
+		if err := writeCSVFile(*uids, reg.edition, uidHeader, reg.uids); err != nil {
+			return err
+		}
+	} else {
+		reg = &registry{}
+		var err error
+		if reg.edition, reg.elements, err = readCSVFile(*elements, elementHeader); err != nil {
+			return err
+		}
+		if _, reg.uids, err = readCSVFile(*uids, uidHeader); err != nil {
+			return err
+		}
+	}
+
+	src, err := generate(reg)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(*out, src, 0644)
+}
+
+// readCSVFile reads CSV data with the given header, the edition being given by an optional first line
+func readCSVFile(path string, header []string) (string, [][]string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", nil, err
+	}
+	defer f.Close()
+
+	var edition string
+	br := bufio.NewReader(f)
+	if b, err := br.Peek(len(editionPrefix)); err == nil && string(b) == editionPrefix {
+		line, err := br.ReadString('\n')
+		if err != nil {
+			return "", nil, err
+		}
+		edition = strings.TrimSpace(strings.TrimPrefix(line, editionPrefix))
+	}
+	records, err := csv.NewReader(br).ReadAll()
+	if err != nil {
+		return "", nil, fmt.Errorf("%s: %v", path, err)
+	} else if len(records) == 0 || strings.Join(records[0], ",") != strings.Join(header, ",") {
+		return "", nil, fmt.Errorf("%s: unexpected header, want %s", path, strings.Join(header, ","))
+	}
+	return edition, records[1:], nil
+}
+
+// writeCSVFile writes CSV data with the given header
+func writeCSVFile(path, edition string, header []string, records [][]string) error {
+	var buf bytes.Buffer
+	if edition != "" {
+		fmt.Fprintf(&buf, "%s%s\n", editionPrefix, edition)
+	}
+	w := csv.NewWriter(&buf)
+	w.Write(header)
+	w.WriteAll(records)
+	if err := w.Error(); err != nil {
+		return err
+	}
+	return os.WriteFile(path, buf.Bytes(), 0644)
+}
+
+// docbookCell is a th or td cell of a DocBook table
+type docbookCell struct {
+	XMLName xml.Name
+	Inner   string `xml:",innerxml"`
+}
+
+// text returns the text of the cell with its markup and zero width spaces removed and spaces collapsed
+func (c *docbookCell) text() string {
+	s := html.UnescapeString(markup.ReplaceAllString(c.Inner, " "))
+	s = strings.ReplaceAll(s, "\u200b", "")
+	return strings.Join(strings.Fields(s), " ")
+}
+
+// docbookTable is the body of a DocBook table
+type docbookTable struct {
+	Body []struct {
+		Cells []*docbookCell `xml:",any"`
+	} `xml:"tbody>tr"`
+}
+
+// readStandard reads the registries from the DocBook source of PS3.6
+func readStandard(source string) (*registry, error) {
+	var r io.Reader
+	if strings.HasPrefix(source, "http://") || strings.HasPrefix(source, "https://") {
+		resp, err := http.Get(source)
+		if err != nil {
+			return nil, err
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			return nil, fmt.Errorf("%s: %s", source, resp.Status)
+		}
+		r = resp.Body
+	} else {
+		f, err := os.Open(source)
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+		r = f
+	}
+	reg, err := parseStandard(r)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", source, err)
+	}
+	return reg, nil
+}
+
+// parseStandard parses the DocBook source of PS3.6
+func parseStandard(r io.Reader) (*registry, error) {
+	reg := &registry{}
+	dec := xml.NewDecoder(r)
+	for {
+		tok, err := dec.Token()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+		start, ok := tok.(xml.StartElement)
+		if !ok {
+			continue
+		}
+
+		switch id := attr(start, "id"); {
+		case start.Name.Local == "subtitle" && reg.edition == "":
+			var subtitle string
+			if err := dec.DecodeElement(&subtitle, &start); err != nil {
+				return nil, err
+			}
+			if m := editionPattern.FindStringSubmatch(subtitle); m != nil {
+				reg.edition = m[1]
+			}
+
+		case start.Name.Local == "table" && (elementTables[id] || id == uidTable):
+			var dt docbookTable
+			if err := dec.DecodeElement(&dt, &start); err != nil {
+				return nil, err
+			}
+			for _, tr := range dt.Body {
+				cells := make([]string, len(tr.Cells))
+				for i, c := range tr.Cells {
+					cells[i] = c.text()
+				}
+				if id == uidTable {
+					reg.addUID(cells)
+				} else {
+					reg.addElement(cells)
+				}
+			}
+		}
+	}
+
+	switch {
+	case reg.edition == "":
+		return nil, fmt.Errorf("edition not found")
+	case len(reg.elements) == 0:
+		return nil, fmt.Errorf("registry of data elements not found")
+	case len(reg.uids) == 0:
+		return nil, fmt.Errorf("registry of UIDs not found")
+	}
+	sort.Slice(reg.elements, func(i, j int) bool { return reg.elements[i][0] < reg.elements[j][0] })
+	return reg, nil
+}
+
+// attr returns the value of the attribute of an element with the given local name
+func attr(start xml.StartElement, name string) string {
+	for _, a := range start.Attr {
+		if a.Name.Local == name {
+			return a.Value
+		}
+	}
+	return ""
+}
+
+// addElement adds a row of a registry of data elements: tag, name, keyword, VR, VM and an
+// optional note starting with "RET" for retired elements. Rows without a tag are skipped.
+func (reg *registry) addElement(cells []string) {
+	if len(cells) < 5 {
+		return
+	}
+	// repeating digits are written in lower case, e.g. "(60xx,3000)"
+	tag := strings.ReplaceAll(strings.ToUpper(cells[0]), "X", "x")
+	if !tagPattern.MatchString(tag) {
+		return
+	}
+	retired := ""
+	if len(cells) > 5 && strings.HasPrefix(cells[5], "RET") {
+		retired = "Y"
+	}
+	reg.elements = append(reg.elements, []string{tag, cells[1], cells[2], cells[3], cells[4], retired})
+}
+
+// addUID adds a row of the registry of UIDs: value, name, keyword and type
+func (reg *registry) addUID(cells []string) {
+	if len(cells) < 4 || cells[0] == "" {
+		return
+	}
+	retired := ""
+	if strings.Contains(cells[1], "(Retired)") {
+		retired = "Y"
+	}
+	reg.uids = append(reg.uids, []string{cells[0], cells[1], cells[2], cells[3], retired})
+}
+
+// generate returns the Go source of the tables of the dictionary package
+func generate(reg *registry) ([]byte, error) {
+	var exact, repeating bytes.Buffer
+	for _, e := range reg.elements {
+		m := tagPattern.FindStringSubmatch(e[0])
+		if m == nil {
+			return nil, fmt.Errorf("invalid tag %q", e[0])
+		}
+		digits := strings.ReplaceAll(m[1]+m[2], "x", "0")
+		if _, err := strconv.ParseUint(digits, 16, 32); err != nil {
+			return nil, fmt.Errorf("invalid tag %q", e[0])
+		}
+		entry := fmt.Sprintf("Tag: 0x%s, Name: %q, Keyword: %q, VR: %q, VM: %q", digits, e[1], e[2], e[3], e[4])
+		if e[5] != "" {
+			entry += ", Retired: true"
+		}
+
+		if !strings.Contains(e[0], "x") {
+			fmt.Fprintf(&exact, "{%s},\n", entry)
+			continue
+		}
+		mask := strings.Map(func(r rune) rune {
+			if r == 'x' {
+				return '0'
+			}
+			return 'F'
+		}, m[1]+m[2])
+		fmt.Fprintf(&repeating, "{mask: 0x%s, Element: Element{%s}},\n", mask, entry)
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by dictgen from elements.csv and uids.csv; DO NOT EDIT.\n\n")
+	fmt.Fprintf(&buf, "package dictionary\n\n")
+	fmt.Fprintf(&buf, "// Edition is the edition of PS3.6 the dictionary was generated from.\n")
+	fmt.Fprintf(&buf, "// It is empty when the dictionary was not generated from the standard.\n")
+	fmt.Fprintf(&buf, "const Edition = %q\n\n", reg.edition)
+	fmt.Fprintf(&buf, "// elements are the public data elements with a single tag\n")
+	fmt.Fprintf(&buf, "var elements = []Element{\n%s}\n\n", exact.String())
+	fmt.Fprintf(&buf, "// repeatingElements are the public data elements of repeating groups and tag ranges\n")
+	fmt.Fprintf(&buf, "var repeatingElements = []repeatingElement{\n%s}\n\n", repeating.String())
+	fmt.Fprintf(&buf, "// uids is the registry of UIDs\n")
+	fmt.Fprintf(&buf, "var uids = []UID{\n")
+	for _, u := range reg.uids {
+		fmt.Fprintf(&buf, "{Value: %q, Name: %q, Keyword: %q, Type: %q", u[0], u[1], u[2], u[3])
+		if u[4] != "" {
+			fmt.Fprintf(&buf, ", Retired: true")
+		}
+		fmt.Fprintf(&buf, "},\n")
+	}
+	fmt.Fprintf(&buf, "}\n")
+	return format.Source(buf.Bytes())
+}
@@ -0,0 +1,248 @@
+package dicom
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+)
+
+// jsonElement is an attribute of the DICOM JSON model of PS3.18 Annex F
+type jsonElement struct {
+	VR           string            `json:"vr"`
+	Value        []json.RawMessage `json:"Value,omitempty"`
+	InlineBinary string            `json:"InlineBinary,omitempty"`
+	BulkDataURI  string            `json:"BulkDataURI,omitempty"`
+}
+
+// jsonNumber matches the values of IS and DS elements that are valid JSON numbers as they are
+var jsonNumber = regexp.MustCompile(`^-?(0|[1-9][0-9]*)(\.[0-9]+)?([eE][+-]?[0-9]+)?$`)
+
+// EncodeJSON returns the DICOM JSON model of a dataset, as defined in PS3.18 Annex F. Binary
+// values are given as InlineBinary, or by BulkDataURI when bulk gives them a URI.
+func EncodeJSON(ds *Dataset, bulk *BulkData) ([]byte, error) {
+	obj, err := jsonDataset(ds, "", bulk)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(obj)
+}
+
+// jsonDataset returns the JSON object of a dataset found at prefix, keyed by tag
+func jsonDataset(ds *Dataset, prefix string, bulk *BulkData) (map[string]*jsonElement, error) {
+	obj := make(map[string]*jsonElement, len(ds.Elements))
+	for _, e := range ds.Elements {
+		if e.Tag.IsGroupLength() {
+			continue
+		}
+		je, err := jsonAttribute(e, modelPath(prefix, e.Tag), bulk)
+		if err != nil {
+			return nil, err
+		}
+		obj[fmt.Sprintf("%08X", uint32(e.Tag))] = je
+	}
+	return obj, nil
+}
+
+// jsonAttribute returns the JSON model of an element found at path
+func jsonAttribute(e *Element, path string, bulk *BulkData) (*jsonElement, error) {
+	vr := e.VR
+	if vr == "" {
+		vr = LookupVR(e.Tag)
+	}
+	je := &jsonElement{VR: vr}
+	if e.IsEncapsulated() {
+		je.VR = OB
+	}
+
+	switch {
+	case vr == SQ:
+		for i, item := range e.Items {
+			obj, err := jsonDataset(item, fmt.Sprintf("%s[%d]", path, i), bulk)
+			if err != nil {
+				return nil, err
+			}
+			if err := je.add(obj); err != nil {
+				return nil, err
+			}
+		}
+
+	case isBinaryVR(je.VR):
+		b := binaryValue(e)
+		if len(b) == 0 {
+			break
+		}
+		if uri := bulk.uri(path, e, len(b)); uri != "" {
+			je.BulkDataURI = uri
+		} else {
+			je.InlineBinary = base64.StdEncoding.EncodeToString(b)
+		}
+
+	default:
+		values, err := modelStrings(&Element{Tag: e.Tag, VR: vr, Value: e.Value})
+		if err != nil {
+			return nil, err
+		}
+		for _, v := range values {
+			if err := je.add(jsonValue(vr, v)); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return je, nil
+}
+
+// add appends a value to the Value array of an attribute
+func (je *jsonElement) add(v interface{}) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	je.Value = append(je.Value, b)
+	return nil
+}
+
+// jsonValue returns a single value as given in the JSON model: numbers for numeric VRs, an
+// object of component groups for person names and null for empty values
+func jsonValue(vr, v string) interface{} {
+	switch {
+	case v == "":
+		return nil
+	case vr == PN:
+		name := map[string]string{}
+		for i, group := range splitPersonName(v) {
+			if group != "" {
+				name[personNameGroups[i]] = group
+			}
+		}
+		return name
+	case vr == IS || vr == DS:
+		if jsonNumber.MatchString(v) {
+			return json.Number(v)
+		}
+		if f, err := strconv.ParseFloat(v, 64); err == nil {
+			return json.Number(strconv.FormatFloat(f, 'g', -1, 64))
+		}
+		// values that are not numbers are kept as strings
+		return v
+	case vr == US || vr == SS || vr == UL || vr == SL || vr == FL || vr == FD || vr == SV || vr == UV:
+		return json.Number(v)
+	}
+	return v
+}
+
+// DecodeJSON reads a dataset from its DICOM JSON model. Values given by BulkDataURI are loaded
+// through bulk, or left empty when it does not load them.
+func DecodeJSON(b []byte, bulk *BulkData) (*Dataset, error) {
+	var obj map[string]*jsonElement
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+	if err := dec.Decode(&obj); err != nil {
+		return nil, fmt.Errorf("invalid dicom json: %v", err)
+	}
+	return datasetFromJSON(obj, bulk)
+}
+
+// datasetFromJSON returns the dataset of a JSON object
+func datasetFromJSON(obj map[string]*jsonElement, bulk *BulkData) (*Dataset, error) {
+	ds := &Dataset{}
+	for key, je := range obj {
+		tag, err := ParseTag(key)
+		if err != nil {
+			return nil, err
+		}
+		if je == nil || je.VR == "" {
+			return nil, fmt.Errorf("%s: missing vr", describeTag(tag))
+		}
+		e, err := elementFromJSON(tag, je, bulk)
+		if err != nil {
+			return nil, err
+		}
+		ds.Set(e)
+	}
+	return ds, nil
+}
+
+// elementFromJSON returns the element of a JSON attribute
+func elementFromJSON(tag Tag, je *jsonElement, bulk *BulkData) (*Element, error) {
+	e := &Element{Tag: tag, VR: je.VR}
+	switch {
+	case je.VR == SQ:
+		e.Items = []*Dataset{}
+		for _, raw := range je.Value {
+			var obj map[string]*jsonElement
+			dec := json.NewDecoder(bytes.NewReader(raw))
+			dec.UseNumber()
+			if err := dec.Decode(&obj); err != nil {
+				return nil, fmt.Errorf("%s: invalid item: %v", describeTag(tag), err)
+			}
+			item, err := datasetFromJSON(obj, bulk)
+			if err != nil {
+				return nil, err
+			}
+			e.Items = append(e.Items, item)
+		}
+
+	case je.BulkDataURI != "":
+		b, err := bulk.load(je.BulkDataURI)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", describeTag(tag), err)
+		}
+		if err := setBinaryValue(e, b); err != nil {
+			return nil, err
+		}
+
+	case je.InlineBinary != "":
+		b, err := base64.StdEncoding.DecodeString(je.InlineBinary)
+		if err != nil {
+			return nil, fmt.Errorf("%s: invalid InlineBinary: %v", describeTag(tag), err)
+		}
+		if err := setBinaryValue(e, b); err != nil {
+			return nil, err
+		}
+
+	default:
+		values := make([]string, len(je.Value))
+		for i, raw := range je.Value {
+			v, err := stringFromJSON(je.VR, raw)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %v", describeTag(tag), err)
+			}
+			values[i] = v
+		}
+		if err := setModelStrings(e, values); err != nil {
+			return nil, err
+		}
+	}
+	return e, nil
+}
+
+// stringFromJSON returns a single value of the JSON model as text
+func stringFromJSON(vr string, raw json.RawMessage) (string, error) {
+	var v interface{}
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.UseNumber()
+	if err := dec.Decode(&v); err != nil {
+		return "", err
+	}
+	switch v := v.(type) {
+	case nil:
+		return "", nil
+	case string:
+		return v, nil
+	case json.Number:
+		return v.String(), nil
+	case map[string]interface{}:
+		if vr != PN {
+			return "", fmt.Errorf("unexpected object value for VR %s", vr)
+		}
+		groups := make([]string, len(personNameGroups))
+		for i, g := range personNameGroups {
+			groups[i], _ = v[g].(string)
+		}
+		return joinPersonName(groups), nil
+	}
+	return "", fmt.Errorf("unexpected value %s for VR %s", raw, vr)
+}
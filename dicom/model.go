@@ -0,0 +1,225 @@
+package dicom
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// BulkData decides how binary values are given in the DICOM JSON and Native DICOM XML models:
+// inline as base64, or by a URI the value can be retrieved from
+type BulkData struct {
+	// Threshold is the length in bytes above which values are given by URI. Values are always
+	// given inline when it is zero or URI is nil.
+	Threshold int
+
+	// URI returns the URI of the value of an element, path locating it as in Walk, e.g.
+	// "(7FE0,0010)". Returning "" gives the value inline.
+	URI func(path string, e *Element) string
+
+	// Load returns the value a URI refers to when decoding. Elements given by URI are left
+	// empty when it is nil.
+	Load func(uri string) ([]byte, error)
+}
+
+// uri returns the URI a binary value is given by, or "" to give it inline
+func (b *BulkData) uri(path string, e *Element, length int) string {
+	if b == nil || b.URI == nil || b.Threshold <= 0 || length <= b.Threshold {
+		return ""
+	}
+	return b.URI(path, e)
+}
+
+// load returns the value a URI refers to, or nil if values given by URI are not loaded
+func (b *BulkData) load(uri string) ([]byte, error) {
+	if b == nil || b.Load == nil {
+		return nil, nil
+	}
+	v, err := b.Load(uri)
+	if err != nil {
+		return nil, fmt.Errorf("bulk data %s: %v", uri, err)
+	}
+	return v, nil
+}
+
+// isBinaryVR reports whether values of the VR are given as InlineBinary or by URI in the models
+func isBinaryVR(vr string) bool {
+	switch vr {
+	case OB, OD, OF, OL, OV, OW, UN:
+		return true
+	}
+	return false
+}
+
+// modelPath returns the path of an element of a dataset found at prefix, as built by Walk
+func modelPath(prefix string, tag Tag) string {
+	if prefix == "" {
+		return tag.String()
+	}
+	return prefix + "." + tag.String()
+}
+
+// binaryValue returns the value of a binary element. Encapsulated pixel data is given as its
+// fragment items followed by the sequence delimitation item, as encoded in PS3.5.
+func binaryValue(e *Element) []byte {
+	if !e.IsEncapsulated() {
+		return e.Value
+	}
+	enc := &encoder{}
+	for _, f := range e.Fragments {
+		f = pad(f, 0x00)
+		enc.tag(Item)
+		enc.uint32(uint32(len(f)))
+		enc.buf.Write(f)
+	}
+	enc.tag(SequenceDelimitationItem)
+	enc.uint32(0)
+	return enc.buf.Bytes()
+}
+
+// setBinaryValue sets the value of a binary element, reading the fragments of encapsulated pixel data
+func setBinaryValue(e *Element, b []byte) error {
+	if e.Tag == PixelData && len(b) >= 8 && NewTag(binary.LittleEndian.Uint16(b), binary.LittleEndian.Uint16(b[2:])) == Item {
+		fragments, err := (&parser{buf: b}).readFragments()
+		if err != nil {
+			return fmt.Errorf("%s: %v", describeTag(e.Tag), err)
+		}
+		e.Fragments = fragments
+		return nil
+	}
+	e.Value = b
+	return nil
+}
+
+// modelStrings returns the values of a non binary, non sequence element as text: numbers in
+// decimal and attribute tags as "ggggeeee"
+func modelStrings(e *Element) ([]string, error) {
+	if IsTextVR(e.VR) {
+		return e.Strings(), nil
+	}
+
+	var values []string
+	switch e.VR {
+	case US, SS, UL, SL:
+		ints, err := e.Ints()
+		if err != nil {
+			return nil, err
+		}
+		for _, v := range ints {
+			values = append(values, strconv.FormatInt(v, 10))
+		}
+	case SV:
+		for i := 0; i+8 <= len(e.Value); i += 8 {
+			values = append(values, strconv.FormatInt(int64(binary.LittleEndian.Uint64(e.Value[i:])), 10))
+		}
+	case UV:
+		for i := 0; i+8 <= len(e.Value); i += 8 {
+			values = append(values, strconv.FormatUint(binary.LittleEndian.Uint64(e.Value[i:]), 10))
+		}
+	case FL, FD:
+		floats, err := e.Floats()
+		if err != nil {
+			return nil, err
+		}
+		bits := 64
+		if e.VR == FL {
+			bits = 32
+		}
+		for _, v := range floats {
+			if math.IsNaN(v) || math.IsInf(v, 0) {
+				return nil, fmt.Errorf("%s: %v cannot be represented", describeTag(e.Tag), v)
+			}
+			values = append(values, strconv.FormatFloat(v, 'g', -1, bits))
+		}
+	case AT:
+		for i := 0; i+4 <= len(e.Value); i += 4 {
+			t := NewTag(binary.LittleEndian.Uint16(e.Value[i:]), binary.LittleEndian.Uint16(e.Value[i+2:]))
+			values = append(values, fmt.Sprintf("%08X", uint32(t)))
+		}
+	default:
+		return nil, fmt.Errorf("%s: unsupported VR %s", describeTag(e.Tag), e.VR)
+	}
+	return values, nil
+}
+
+// setModelStrings sets the value of a non binary, non sequence element from its values as text
+func setModelStrings(e *Element, values []string) error {
+	if IsTextVR(e.VR) {
+		e.SetStrings(values...)
+		return nil
+	}
+
+	size := map[string]int{US: 2, SS: 2, UL: 4, SL: 4, FL: 4, FD: 8, SV: 8, UV: 8, AT: 4}[e.VR]
+	if size == 0 {
+		return fmt.Errorf("%s: unsupported VR %s", describeTag(e.Tag), e.VR)
+	}
+	e.Value = make([]byte, 0, size*len(values))
+	for _, s := range values {
+		b := make([]byte, size)
+		var err error
+		switch e.VR {
+		case US, UL, UV:
+			var v uint64
+			if v, err = strconv.ParseUint(s, 10, size*8); err == nil {
+				putUint(b, v)
+			}
+		case SS, SL, SV:
+			var v int64
+			if v, err = strconv.ParseInt(s, 10, size*8); err == nil {
+				putUint(b, uint64(v))
+			}
+		case FL:
+			var v float64
+			if v, err = strconv.ParseFloat(s, 32); err == nil {
+				binary.LittleEndian.PutUint32(b, math.Float32bits(float32(v)))
+			}
+		case FD:
+			var v float64
+			if v, err = strconv.ParseFloat(s, 64); err == nil {
+				binary.LittleEndian.PutUint64(b, math.Float64bits(v))
+			}
+		case AT:
+			var t Tag
+			if t, err = ParseTag(s); err == nil {
+				binary.LittleEndian.PutUint16(b, t.Group())
+				binary.LittleEndian.PutUint16(b[2:], t.Element())
+			}
+		}
+		if err != nil {
+			return fmt.Errorf("%s: invalid %s value %q", describeTag(e.Tag), e.VR, s)
+		}
+		e.Value = append(e.Value, b...)
+	}
+	return nil
+}
+
+// putUint writes v little endian into b, whose length is 2, 4 or 8
+func putUint(b []byte, v uint64) {
+	switch len(b) {
+	case 2:
+		binary.LittleEndian.PutUint16(b, uint16(v))
+	case 4:
+		binary.LittleEndian.PutUint32(b, uint32(v))
+	default:
+		binary.LittleEndian.PutUint64(b, v)
+	}
+}
+
+// personNameGroups are the component groups of a person name, separated by "=" in its value
+var personNameGroups = []string{"Alphabetic", "Ideographic", "Phonetic"}
+
+// splitPersonName returns the component groups of a person name, empty groups being ""
+func splitPersonName(name string) []string {
+	groups := strings.SplitN(name, "=", len(personNameGroups))
+	for len(groups) < len(personNameGroups) {
+		groups = append(groups, "")
+	}
+	return groups
+}
+
+// joinPersonName returns the value of a person name from its component groups, trailing empty groups being dropped
+func joinPersonName(groups []string) string {
+	return strings.TrimRight(strings.Join(groups, "="), "=")
+}
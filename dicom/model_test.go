@@ -0,0 +1,159 @@
+package dicom_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"gitlab.com/medical-research/dicom-deidentifier/dicom"
+)
+
+// newTestModelDataset returns a dataset with a value of each kind found in the models
+func newTestModelDataset() *dicom.Dataset {
+	return dicom.NewDataset(
+		dicom.NewElement(dicom.ImageType, dicom.CS, "ORIGINAL", "PRIMARY", "AXIAL"),
+		dicom.NewElement(dicom.SOPInstanceUID, dicom.UI, "1.2.3.4.5"),
+		dicom.NewElement(dicom.PatientName, dicom.PN, "Doe^John^^Dr=ドウ^ジョン"),
+		dicom.NewElement(dicom.Tag(0x00200032), dicom.DS, "-12.5", "0", "1e3"),
+		dicom.NewElement(dicom.Tag(0x00090010), dicom.LO, "ACME 1.1"),
+		dicom.NewElement(dicom.Tag(0x00091001), dicom.LO, "private"),
+		dicom.NewSequence(dicom.Tag(0x00081115),
+			dicom.NewDataset(dicom.NewElement(dicom.SeriesInstanceUID, dicom.UI, "1.2.3.4")),
+			dicom.NewDataset(dicom.NewElement(dicom.SeriesInstanceUID, dicom.UI, "1.2.3.5")),
+		),
+		&dicom.Element{Tag: dicom.Tag(0x00209165), VR: dicom.AT, Value: []byte{0x20, 0x00, 0x32, 0x00}},
+		&dicom.Element{Tag: dicom.Rows, VR: dicom.US, Value: []byte{0x00, 0x02}},
+		&dicom.Element{Tag: dicom.Tag(0x00189327), VR: dicom.FD, Value: []byte{0, 0, 0, 0, 0, 0, 0xF8, 0x3F}},
+		&dicom.Element{Tag: dicom.PixelData, VR: dicom.OW, Value: []byte{1, 2, 3, 4, 5, 6, 7, 8}},
+	)
+}
+
+func TestModels_RoundTrip(t *testing.T) {
+	blobs := map[string][]byte{}
+	bulk := &dicom.BulkData{
+		Threshold: 4,
+		URI: func(path string, e *dicom.Element) string {
+			uri := "bulk/" + path
+			blobs[uri] = e.Value
+			return uri
+		},
+		Load: func(uri string) ([]byte, error) {
+			if b, ok := blobs[uri]; ok {
+				return b, nil
+			}
+			return nil, fmt.Errorf("not found")
+		},
+	}
+
+	tests := []struct {
+		name   string
+		encode func(*dicom.Dataset, *dicom.BulkData) ([]byte, error)
+		decode func([]byte, *dicom.BulkData) (*dicom.Dataset, error)
+		bulk   *dicom.BulkData
+		inline string
+	}{
+		{name: "json inline", encode: dicom.EncodeJSON, decode: dicom.DecodeJSON, inline: `"InlineBinary":"AQIDBAUGBwg="`},
+		{name: "json bulk data", encode: dicom.EncodeJSON, decode: dicom.DecodeJSON, bulk: bulk, inline: `"BulkDataURI":"bulk/(7FE0,0010)"`},
+		{name: "xml inline", encode: dicom.EncodeXML, decode: dicom.DecodeXML, inline: `<InlineBinary>AQIDBAUGBwg=</InlineBinary>`},
+		{name: "xml bulk data", encode: dicom.EncodeXML, decode: dicom.DecodeXML, bulk: bulk, inline: `<BulkData uri="bulk/(7FE0,0010)"></BulkData>`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			want := newTestModelDataset()
+			b, err := tt.encode(want, tt.bulk)
+			if err != nil {
+				t.Fatalf("encode error = %v", err)
+			}
+			if !bytes.Contains(b, []byte(tt.inline)) {
+				t.Errorf("encoded pixel data is not %s:\n%s", tt.inline, b)
+			}
+			got, err := tt.decode(b, tt.bulk)
+			if err != nil {
+				t.Fatalf("decode error = %v", err)
+			}
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("decoded dataset differs:\ngot  %v\nwant %v", got, want)
+			}
+		})
+	}
+}
+
+func TestEncodeJSON(t *testing.T) {
+	b, err := dicom.EncodeJSON(newTestModelDataset(), nil)
+	if err != nil {
+		t.Fatalf("EncodeJSON() error = %v", err)
+	}
+	var obj map[string]struct {
+		VR    string
+		Value []interface{}
+	}
+	if err := json.Unmarshal(b, &obj); err != nil {
+		t.Fatalf("invalid json: %v", err)
+	}
+
+	tests := []struct {
+		tag  string
+		vr   string
+		want []interface{}
+	}{
+		{tag: "00080008", vr: "CS", want: []interface{}{"ORIGINAL", "PRIMARY", "AXIAL"}},
+		{tag: "00100010", vr: "PN", want: []interface{}{map[string]interface{}{"Alphabetic": "Doe^John^^Dr", "Ideographic": "ドウ^ジョン"}}},
+		{tag: "00200032", vr: "DS", want: []interface{}{-12.5, 0.0, 1000.0}},
+		{tag: "00209165", vr: "AT", want: []interface{}{"00200032"}},
+		{tag: "00280010", vr: "US", want: []interface{}{512.0}},
+		{tag: "00189327", vr: "FD", want: []interface{}{1.5}},
+	}
+	for _, tt := range tests {
+		got, ok := obj[tt.tag]
+		if !ok {
+			t.Errorf("%s missing", tt.tag)
+			continue
+		}
+		if got.VR != tt.vr || !reflect.DeepEqual(got.Value, tt.want) {
+			t.Errorf("%s = %s %v, want %s %v", tt.tag, got.VR, got.Value, tt.vr, tt.want)
+		}
+	}
+}
+
+func TestEncodeXML(t *testing.T) {
+	b, err := dicom.EncodeXML(newTestModelDataset(), nil)
+	if err != nil {
+		t.Fatalf("EncodeXML() error = %v", err)
+	}
+	for _, want := range []string{
+		`<NativeDicomModel xmlns="http://dicom.nema.org/PS3.19/models/NativeDICOM">`,
+		`<DicomAttribute tag="00100010" vr="PN" keyword="PatientName">`,
+		`<FamilyName>Doe</FamilyName>`,
+		`<NamePrefix>Dr</NamePrefix>`,
+		`<DicomAttribute tag="00091001" vr="LO" privateCreator="ACME 1.1">`,
+		`<Value number="3">AXIAL</Value>`,
+		`<Item number="2">`,
+	} {
+		if !strings.Contains(string(b), want) {
+			t.Errorf("EncodeXML() is missing %s:\n%s", want, b)
+		}
+	}
+}
+
+func TestDecodeJSON_Invalid(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+	}{
+		{name: "not an object", data: `[]`},
+		{name: "invalid tag", data: `{"0010":{"vr":"PN"}}`},
+		{name: "missing vr", data: `{"00100010":{}}`},
+		{name: "invalid number", data: `{"00280010":{"vr":"US","Value":["rows"]}}`},
+		{name: "invalid base64", data: `{"7FE00010":{"vr":"OW","InlineBinary":"!"}}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := dicom.DecodeJSON([]byte(tt.data), nil); err == nil {
+				t.Errorf("DecodeJSON() expected an error")
+			}
+		})
+	}
+}
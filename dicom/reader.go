@@ -0,0 +1,373 @@
+package dicom
+
+import (
+	"bytes"
+	"compress/flate"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+)
+
+// Transfer syntaxes that change how the dataset itself is encoded
+const (
+	ImplicitVRLittleEndian         = "1.2.840.10008.1.2"
+	ExplicitVRLittleEndian         = "1.2.840.10008.1.2.1"
+	DeflatedExplicitVRLittleEndian = "1.2.840.10008.1.2.1.99"
+	ExplicitVRBigEndian            = "1.2.840.10008.1.2.2"
+)
+
+// JPEGBaseline8Bit is the transfer syntax of encapsulated baseline JPEG pixel data
+const JPEGBaseline8Bit = "1.2.840.10008.1.2.4.50"
+
+// undefinedLength marks sequences, items and encapsulated pixel data delimited by markers
+const undefinedLength = 0xFFFFFFFF
+
+// File represents a DICOM Part 10 file
+type File struct {
+	Preamble [128]byte
+
+	// Meta holds the group 0002 File Meta Information elements
+	Meta *Dataset
+
+	// Dataset holds the attributes of the stored instance
+	Dataset *Dataset
+
+	// CharacterSet holds the Specific Character Set the text values were encoded with when it is
+	// neither ASCII nor UTF-8. Read decodes those values to UTF-8 and Write encodes them back,
+	// clearing it converts the instance to ISO_IR 192.
+	CharacterSet []string
+}
+
+// TransferSyntax returns the transfer syntax UID recorded in the file meta information
+func (f *File) TransferSyntax() string {
+	return f.Meta.GetString(TransferSyntaxUID)
+}
+
+// ReadFile parses the DICOM Part 10 file at path
+func ReadFile(path string) (*File, error) {
+	fh, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer fh.Close()
+	return Read(fh)
+}
+
+// Read parses a DICOM Part 10 stream. Values are kept in their little endian
+// encoding; big endian transfer syntaxes are not supported. Text values are held in
+// UTF-8 whatever their Specific Character Set, see File.CharacterSet.
+func Read(r io.Reader) (*File, error) {
+	buf, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("could not read dicom: %v", err)
+	}
+	if len(buf) < 132 || string(buf[128:132]) != "DICM" {
+		return nil, fmt.Errorf("not a DICOM Part 10 file: missing DICM prefix")
+	}
+
+	f := &File{}
+	copy(f.Preamble[:], buf[:128])
+
+	p := &parser{buf: buf, pos: 132, explicit: true}
+	f.Meta, err = p.readMeta()
+	if err != nil {
+		return nil, err
+	}
+
+	ts := f.TransferSyntax()
+	switch ts {
+	case ImplicitVRLittleEndian:
+		p.explicit = false
+	case ExplicitVRBigEndian:
+		return nil, fmt.Errorf("unsupported transfer syntax %s", describeUID(ts))
+	case DeflatedExplicitVRLittleEndian:
+		inflated, err := ioutil.ReadAll(flate.NewReader(bytes.NewReader(buf[p.pos:])))
+		if err != nil {
+			return nil, fmt.Errorf("could not inflate dataset: %v", err)
+		}
+		p = &parser{buf: inflated, explicit: true}
+	}
+
+	f.Dataset, err = p.readDataset(len(p.buf))
+	if err != nil {
+		return nil, err
+	}
+	f.CharacterSet = decodeText(f.Dataset, nil)
+	return f, nil
+}
+
+// ReadDataset parses a bare dataset encoded with the given transfer syntax. Text values
+// are converted to UTF-8 as by Read.
+func ReadDataset(buf []byte, transferSyntax string) (*Dataset, error) {
+	if transferSyntax == ExplicitVRBigEndian {
+		return nil, fmt.Errorf("unsupported transfer syntax %s", describeUID(transferSyntax))
+	}
+	p := &parser{buf: buf, explicit: transferSyntax != ImplicitVRLittleEndian}
+	ds, err := p.readDataset(len(buf))
+	if err != nil {
+		return nil, err
+	}
+	decodeText(ds, nil)
+	return ds, nil
+}
+
+// parser decodes little endian datasets held in memory
+type parser struct {
+	buf      []byte
+	pos      int
+	explicit bool
+}
+
+func (p *parser) errorf(format string, args ...interface{}) error {
+	return fmt.Errorf("offset %d: %s", p.pos, fmt.Sprintf(format, args...))
+}
+
+func (p *parser) need(n int) error {
+	if n < 0 || p.pos+n > len(p.buf) {
+		return p.errorf("unexpected end of data")
+	}
+	return nil
+}
+
+func (p *parser) uint16() (uint16, error) {
+	if err := p.need(2); err != nil {
+		return 0, err
+	}
+	v := binary.LittleEndian.Uint16(p.buf[p.pos:])
+	p.pos += 2
+	return v, nil
+}
+
+func (p *parser) uint32() (uint32, error) {
+	if err := p.need(4); err != nil {
+		return 0, err
+	}
+	v := binary.LittleEndian.Uint32(p.buf[p.pos:])
+	p.pos += 4
+	return v, nil
+}
+
+func (p *parser) tag() (Tag, error) {
+	g, err := p.uint16()
+	if err != nil {
+		return 0, err
+	}
+	e, err := p.uint16()
+	if err != nil {
+		return 0, err
+	}
+	return NewTag(g, e), nil
+}
+
+func (p *parser) bytes(n int) ([]byte, error) {
+	if err := p.need(n); err != nil {
+		return nil, err
+	}
+	b := p.buf[p.pos : p.pos+n]
+	p.pos += n
+	return b, nil
+}
+
+// readMeta reads the explicit VR little endian group 0002 elements
+func (p *parser) readMeta() (*Dataset, error) {
+	meta := &Dataset{}
+	for p.pos+4 <= len(p.buf) && binary.LittleEndian.Uint16(p.buf[p.pos:]) == 0x0002 {
+		e, err := p.readElement()
+		if err != nil {
+			return nil, fmt.Errorf("file meta information: %v", err)
+		}
+		meta.Set(e)
+	}
+	if meta.Get(TransferSyntaxUID) == nil {
+		return nil, fmt.Errorf("file meta information: missing transfer syntax UID")
+	}
+	return meta, nil
+}
+
+// readDataset reads elements until end is reached or an item delimiter is found
+func (p *parser) readDataset(end int) (*Dataset, error) {
+	ds := &Dataset{}
+	for p.pos < end {
+		if p.pos+4 <= len(p.buf) && binary.LittleEndian.Uint32(p.buf[p.pos:]) == 0xE00DFFFE {
+			p.pos += 8
+			return ds, nil
+		}
+		e, err := p.readElement()
+		if err != nil {
+			return nil, err
+		}
+		// trailing padding is not part of the instance
+		if e.Tag == Tag(0xFFFCFFFC) {
+			continue
+		}
+		ds.Elements = append(ds.Elements, e)
+	}
+	return ds, nil
+}
+
+// readElement reads a single element
+func (p *parser) readElement() (*Element, error) {
+	tag, err := p.tag()
+	if err != nil {
+		return nil, err
+	}
+
+	var vr string
+	var length uint32
+	if p.explicit && tag.Group() != 0xFFFE {
+		b, err := p.bytes(2)
+		if err != nil {
+			return nil, err
+		}
+		vr = string(b)
+		if hasLongLength(vr) {
+			if _, err := p.bytes(2); err != nil {
+				return nil, err
+			}
+			if length, err = p.uint32(); err != nil {
+				return nil, err
+			}
+		} else {
+			l, err := p.uint16()
+			if err != nil {
+				return nil, err
+			}
+			length = uint32(l)
+		}
+		if !IsKnownVR(vr) {
+			vr = UN
+		}
+	} else {
+		vr = LookupVR(tag)
+		if length, err = p.uint32(); err != nil {
+			return nil, err
+		}
+	}
+
+	e := &Element{Tag: tag, VR: vr}
+	switch {
+	case tag == PixelData && length == undefinedLength:
+		e.Fragments, err = p.readFragments()
+		return e, err
+
+	case vr == SQ || (vr == UN && length == undefinedLength):
+		// sequences of unknown VR are always encoded as implicit VR little endian
+		explicit := p.explicit
+		if vr == UN {
+			p.explicit = false
+		}
+		e.VR = SQ
+		e.Items, err = p.readItems(length)
+		p.explicit = explicit
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", describeTag(tag), err)
+		}
+		return e, nil
+
+	case length == undefinedLength:
+		return nil, p.errorf("%s: undefined length for VR %s", describeTag(tag), vr)
+	}
+
+	if e.Value, err = p.bytes(int(length)); err != nil {
+		return nil, fmt.Errorf("%s: %v", describeTag(tag), err)
+	}
+	if vr == UN {
+		if items, ok := readUnknownItems(e.Value); ok {
+			e.VR, e.Value, e.Items = SQ, nil, items
+		}
+	}
+	return e, nil
+}
+
+// readUnknownItems parses a defined length UN value that starts with an item tag as the
+// implicit VR items of a sequence. Values that do not parse to exactly their own length
+// are left as UN.
+func readUnknownItems(value []byte) ([]*Dataset, bool) {
+	if len(value) < 8 || binary.LittleEndian.Uint32(value) != 0xE000FFFE {
+		return nil, false
+	}
+	sub := &parser{buf: value}
+	items, err := sub.readItems(uint32(len(value)))
+	if err != nil || sub.pos != len(value) {
+		return nil, false
+	}
+	return items, true
+}
+
+// readItems reads the items of a sequence
+func (p *parser) readItems(length uint32) ([]*Dataset, error) {
+	items := []*Dataset{}
+	end := len(p.buf)
+	if length != undefinedLength {
+		if err := p.need(int(length)); err != nil {
+			return nil, err
+		}
+		end = p.pos + int(length)
+	}
+
+	for p.pos < end {
+		tag, err := p.tag()
+		if err != nil {
+			return nil, err
+		}
+		itemLength, err := p.uint32()
+		if err != nil {
+			return nil, err
+		}
+
+		switch tag {
+		case SequenceDelimitationItem:
+			return items, nil
+		case Item:
+		default:
+			return nil, p.errorf("expected item, found %s", tag)
+		}
+
+		itemEnd := len(p.buf)
+		if itemLength != undefinedLength {
+			if err := p.need(int(itemLength)); err != nil {
+				return nil, err
+			}
+			itemEnd = p.pos + int(itemLength)
+		}
+		item, err := p.readDataset(itemEnd)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+
+	if length == undefinedLength {
+		return nil, p.errorf("missing sequence delimitation item")
+	}
+	return items, nil
+}
+
+// readFragments reads the items of encapsulated pixel data
+func (p *parser) readFragments() ([][]byte, error) {
+	fragments := [][]byte{}
+	for {
+		tag, err := p.tag()
+		if err != nil {
+			return nil, err
+		}
+		length, err := p.uint32()
+		if err != nil {
+			return nil, err
+		}
+		switch tag {
+		case SequenceDelimitationItem:
+			return fragments, nil
+		case Item:
+		default:
+			return nil, p.errorf("expected pixel data fragment, found %s", tag)
+		}
+		b, err := p.bytes(int(length))
+		if err != nil {
+			return nil, err
+		}
+		fragments = append(fragments, b)
+	}
+}
@@ -0,0 +1,181 @@
+package dicom
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Tag represents a DICOM attribute tag, the group number in the high 16 bits and
+// the element number in the low 16 bits, e.g. 0x00100010 for Patient's Name
+type Tag uint32
+
+// NewTag returns the tag with the given group and element numbers
+func NewTag(group, element uint16) Tag {
+	return Tag(uint32(group)<<16 | uint32(element))
+}
+
+// Group returns the group number of the tag
+func (t Tag) Group() uint16 {
+	return uint16(t >> 16)
+}
+
+// Element returns the element number of the tag
+func (t Tag) Element() uint16 {
+	return uint16(t)
+}
+
+// String returns the tag in the conventional "(gggg,eeee)" notation
+func (t Tag) String() string {
+	return fmt.Sprintf("(%04X,%04X)", t.Group(), t.Element())
+}
+
+// IsPrivate reports whether the tag belongs to an odd (private) group
+func (t Tag) IsPrivate() bool {
+	return t.Group()%2 == 1
+}
+
+// IsPrivateCreator reports whether the tag reserves a block of private elements
+func (t Tag) IsPrivateCreator() bool {
+	return t.IsPrivate() && t.Element() >= 0x0010 && t.Element() <= 0x00FF
+}
+
+// IsGroupLength reports whether the tag is a (gggg,0000) group length element
+func (t Tag) IsGroupLength() bool {
+	return t.Element() == 0x0000
+}
+
+// ParseTag parses a tag written as "(gggg,eeee)", "gggg,eeee" or "ggggeeee"
+func ParseTag(s string) (Tag, error) {
+	v := strings.TrimSpace(s)
+	v = strings.TrimPrefix(v, "(")
+	v = strings.TrimSuffix(v, ")")
+	v = strings.Replace(v, ",", "", 1)
+	if len(v) != 8 {
+		return 0, fmt.Errorf("invalid tag %q", s)
+	}
+	n, err := strconv.ParseUint(v, 16, 32)
+	if err != nil {
+		return 0, fmt.Errorf("invalid tag %q: %v", s, err)
+	}
+	return Tag(n), nil
+}
+
+// Item, delimitation and file meta tags used while reading and writing
+var (
+	Item                     = Tag(0xFFFEE000)
+	ItemDelimitationItem     = Tag(0xFFFEE00D)
+	SequenceDelimitationItem = Tag(0xFFFEE0DD)
+
+	FileMetaInformationGroupLength = Tag(0x00020000)
+	FileMetaInformationVersion     = Tag(0x00020001)
+	MediaStorageSOPClassUID        = Tag(0x00020002)
+	MediaStorageSOPInstanceUID     = Tag(0x00020003)
+	TransferSyntaxUID              = Tag(0x00020010)
+	ImplementationClassUID         = Tag(0x00020012)
+	ImplementationVersionName      = Tag(0x00020013)
+	SourceApplicationEntityTitle   = Tag(0x00020016)
+)
+
+// Attributes referred to by name elsewhere in the project
+var (
+	SpecificCharacterSet      = Tag(0x00080005)
+	ImageType                 = Tag(0x00080008)
+	InstanceCreationDate      = Tag(0x00080012)
+	InstanceCreationTime      = Tag(0x00080013)
+	SOPClassUID               = Tag(0x00080016)
+	SOPInstanceUID            = Tag(0x00080018)
+	StudyDate                 = Tag(0x00080020)
+	SeriesDate                = Tag(0x00080021)
+	AcquisitionDate           = Tag(0x00080022)
+	ContentDate               = Tag(0x00080023)
+	AcquisitionDateTime       = Tag(0x0008002A)
+	StudyTime                 = Tag(0x00080030)
+	SeriesTime                = Tag(0x00080031)
+	AcquisitionTime           = Tag(0x00080032)
+	ContentTime               = Tag(0x00080033)
+	AccessionNumber           = Tag(0x00080050)
+	Modality                  = Tag(0x00080060)
+	Manufacturer              = Tag(0x00080070)
+	InstitutionName           = Tag(0x00080080)
+	InstitutionAddress        = Tag(0x00080081)
+	ReferringPhysicianName    = Tag(0x00080090)
+	CodeValue                 = Tag(0x00080100)
+	CodingSchemeDesignator    = Tag(0x00080102)
+	CodeMeaning               = Tag(0x00080104)
+	CodingSchemeUID           = Tag(0x0008010C)
+	StationName               = Tag(0x00081010)
+	StudyDescription          = Tag(0x00081030)
+	SeriesDescription         = Tag(0x0008103E)
+	ReferencedSOPClassUID     = Tag(0x00081150)
+	ReferencedSOPInstanceUID  = Tag(0x00081155)
+	DerivationDescription     = Tag(0x00082111)
+	PatientName               = Tag(0x00100010)
+	PatientID                 = Tag(0x00100020)
+	IssuerOfPatientID         = Tag(0x00100021)
+	PatientBirthDate          = Tag(0x00100030)
+	PatientSex                = Tag(0x00100040)
+	OtherPatientIDs           = Tag(0x00101000)
+	OtherPatientNames         = Tag(0x00101001)
+	PatientAge                = Tag(0x00101010)
+	PatientSize               = Tag(0x00101020)
+	PatientWeight             = Tag(0x00101030)
+	AdditionalPatientHistory  = Tag(0x001021B0)
+	PatientComments           = Tag(0x00104000)
+	ClinicalTrialSponsorName  = Tag(0x00120010)
+	ClinicalTrialProtocolID   = Tag(0x00120020)
+	ClinicalTrialProtocolName = Tag(0x00120021)
+	ClinicalTrialSiteID       = Tag(0x00120030)
+	ClinicalTrialSiteName     = Tag(0x00120031)
+	ClinicalTrialSubjectID    = Tag(0x00120040)
+	PatientIdentityRemoved    = Tag(0x00120062)
+	DeidentificationMethod    = Tag(0x00120063)
+	DeviceSerialNumber        = Tag(0x00181000)
+	ProtocolName              = Tag(0x00181030)
+	StudyInstanceUID          = Tag(0x0020000D)
+	SeriesInstanceUID         = Tag(0x0020000E)
+	StudyID                   = Tag(0x00200010)
+	FrameOfReferenceUID       = Tag(0x00200052)
+	SynchronizationFrameOfRef = Tag(0x00200200)
+	ImageComments             = Tag(0x00204000)
+	SamplesPerPixel           = Tag(0x00280002)
+	PhotometricInterpretation = Tag(0x00280004)
+	PlanarConfiguration       = Tag(0x00280006)
+	NumberOfFrames            = Tag(0x00280008)
+	Rows                      = Tag(0x00280010)
+	Columns                   = Tag(0x00280011)
+	BitsAllocated             = Tag(0x00280100)
+	BitsStored                = Tag(0x00280101)
+	HighBit                   = Tag(0x00280102)
+	PixelRepresentation       = Tag(0x00280103)
+	PixelData                 = Tag(0x7FE00010)
+
+	DeidentificationMethodCodeSequence = Tag(0x00120064)
+	EncryptedAttributesSequence        = Tag(0x04000500)
+	EncryptedContentTransferSyntaxUID  = Tag(0x04000510)
+	EncryptedContent                   = Tag(0x04000520)
+	ModifiedAttributesSequence         = Tag(0x04000550)
+)
+
+// Attributes of the multi-frame functional groups, PS3.3 C.7.6.16
+var (
+	FrameAcquisitionDateTime         = Tag(0x00189074)
+	FrameReferenceDateTime           = Tag(0x00189151)
+	FrameContentSequence             = Tag(0x00209111)
+	SharedFunctionalGroupsSequence   = Tag(0x52009229)
+	PerFrameFunctionalGroupsSequence = Tag(0x52009230)
+)
+
+// Attributes of the SR content items, PS3.3 C.17.3
+var (
+	ValueType               = Tag(0x0040A040)
+	ConceptNameCodeSequence = Tag(0x0040A043)
+	DateTime                = Tag(0x0040A120)
+	Date                    = Tag(0x0040A121)
+	Time                    = Tag(0x0040A122)
+	PersonName              = Tag(0x0040A123)
+	UID                     = Tag(0x0040A124)
+	TextValue               = Tag(0x0040A160)
+	ConceptCodeSequence     = Tag(0x0040A168)
+	ContentSequence         = Tag(0x0040A730)
+)
@@ -0,0 +1,126 @@
+package dicom
+
+// Value representations defined in PS3.5 section 6.2
+const (
+	AE = "AE"
+	AS = "AS"
+	AT = "AT"
+	CS = "CS"
+	DA = "DA"
+	DS = "DS"
+	DT = "DT"
+	FD = "FD"
+	FL = "FL"
+	IS = "IS"
+	LO = "LO"
+	LT = "LT"
+	OB = "OB"
+	OD = "OD"
+	OF = "OF"
+	OL = "OL"
+	OV = "OV"
+	OW = "OW"
+	PN = "PN"
+	SH = "SH"
+	SL = "SL"
+	SQ = "SQ"
+	SS = "SS"
+	ST = "ST"
+	SV = "SV"
+	TM = "TM"
+	UC = "UC"
+	UI = "UI"
+	UL = "UL"
+	UN = "UN"
+	UR = "UR"
+	US = "US"
+	UT = "UT"
+	UV = "UV"
+)
+
+// vrInfo describes how values of a VR are encoded
+type vrInfo struct {
+	// longLength is set for VRs that use a reserved field and a 32 bit length in explicit VR
+	longLength bool
+	// text is set for VRs whose value is a character string
+	text bool
+	// multiValued is set for string VRs that use backslash as a value delimiter
+	multiValued bool
+	// pad is the byte used to pad values to an even length
+	pad byte
+	// specificCharset is set for the text VRs encoded with the Specific Character Set rather than
+	// the default character repertoire
+	specificCharset bool
+}
+
+var vrs = map[string]vrInfo{
+	AE: {text: true, multiValued: true, pad: ' '},
+	AS: {text: true, multiValued: true, pad: ' '},
+	AT: {},
+	CS: {text: true, multiValued: true, pad: ' '},
+	DA: {text: true, multiValued: true, pad: ' '},
+	DS: {text: true, multiValued: true, pad: ' '},
+	DT: {text: true, multiValued: true, pad: ' '},
+	FD: {},
+	FL: {},
+	IS: {text: true, multiValued: true, pad: ' '},
+	LO: {text: true, multiValued: true, pad: ' ', specificCharset: true},
+	LT: {text: true, pad: ' ', specificCharset: true},
+	OB: {longLength: true},
+	OD: {longLength: true},
+	OF: {longLength: true},
+	OL: {longLength: true},
+	OV: {longLength: true},
+	OW: {longLength: true},
+	PN: {text: true, multiValued: true, pad: ' ', specificCharset: true},
+	SH: {text: true, multiValued: true, pad: ' ', specificCharset: true},
+	SL: {},
+	SQ: {longLength: true},
+	SS: {},
+	ST: {text: true, pad: ' ', specificCharset: true},
+	SV: {longLength: true},
+	TM: {text: true, multiValued: true, pad: ' '},
+	UC: {longLength: true, text: true, multiValued: true, pad: ' ', specificCharset: true},
+	UI: {text: true, multiValued: true, pad: 0x00},
+	UL: {},
+	UN: {longLength: true},
+	UR: {longLength: true, text: true, pad: ' '},
+	US: {},
+	UT: {longLength: true, text: true, pad: ' ', specificCharset: true},
+	UV: {longLength: true},
+}
+
+// IsKnownVR reports whether vr is one of the value representations defined by the standard
+func IsKnownVR(vr string) bool {
+	_, ok := vrs[vr]
+	return ok
+}
+
+// IsTextVR reports whether values of the VR are character strings
+func IsTextVR(vr string) bool {
+	return vrs[vr].text
+}
+
+// IsStringListVR reports whether values of the VR are backslash delimited character strings
+func IsStringListVR(vr string) bool {
+	return vrs[vr].multiValued
+}
+
+// usesSpecificCharacterSet reports whether values of the VR are encoded with the Specific Character Set
+func usesSpecificCharacterSet(vr string) bool {
+	return vrs[vr].specificCharset
+}
+
+// hasLongLength reports whether the explicit VR encoding of vr uses a 32 bit length field
+func hasLongLength(vr string) bool {
+	info, ok := vrs[vr]
+	if !ok {
+		return true
+	}
+	return info.longLength
+}
+
+// padByte returns the byte used to pad values of the VR to an even length
+func padByte(vr string) byte {
+	return vrs[vr].pad
+}
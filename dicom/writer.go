@@ -0,0 +1,195 @@
+package dicom
+
+import (
+	"bytes"
+	"compress/flate"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"os"
+)
+
+// WriteFile writes f to path as a DICOM Part 10 file
+func WriteFile(path string, f *File) error {
+	fh, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := Write(fh, f); err != nil {
+		fh.Close()
+		return err
+	}
+	return fh.Close()
+}
+
+// Write encodes f as a DICOM Part 10 stream using the transfer syntax recorded in
+// its file meta information. The meta information group length is recomputed.
+// Text values are encoded in f.CharacterSet, or kept in UTF-8 with a Specific Character
+// Set of ISO_IR 192 when it is empty or cannot represent them.
+func Write(w io.Writer, f *File) error {
+	ts := f.TransferSyntax()
+	if ts == ExplicitVRBigEndian {
+		return fmt.Errorf("unsupported transfer syntax %s", describeUID(ts))
+	}
+
+	meta := &encoder{explicit: true}
+	for _, e := range f.Meta.Elements {
+		if e.Tag == FileMetaInformationGroupLength {
+			continue
+		}
+		meta.element(e)
+	}
+	groupLength := make([]byte, 4)
+	binary.LittleEndian.PutUint32(groupLength, uint32(meta.buf.Len()))
+
+	head := &encoder{explicit: true}
+	head.buf.Write(f.Preamble[:])
+	head.buf.WriteString("DICM")
+	head.element(&Element{Tag: FileMetaInformationGroupLength, VR: UL, Value: groupLength})
+	if _, err := w.Write(head.buf.Bytes()); err != nil {
+		return err
+	}
+	if _, err := w.Write(meta.buf.Bytes()); err != nil {
+		return err
+	}
+
+	body, err := EncodeDataset(f.Dataset, ts)
+	if err != nil {
+		return err
+	}
+	if len(f.CharacterSet) > 0 {
+		if cs, err := newCharacterSet(f.CharacterSet); err == nil {
+			enc := &encoder{explicit: ts != ImplicitVRLittleEndian, charset: cs}
+			if enc.dataset(f.Dataset); enc.err == nil {
+				body = enc.buf.Bytes()
+			}
+		}
+	}
+	if ts != DeflatedExplicitVRLittleEndian {
+		_, err = w.Write(body)
+		return err
+	}
+
+	fw, err := flate.NewWriter(w, flate.DefaultCompression)
+	if err != nil {
+		return err
+	}
+	if _, err := fw.Write(body); err != nil {
+		return err
+	}
+	return fw.Close()
+}
+
+// EncodeDataset encodes a bare dataset using the given transfer syntax. Deflated
+// transfer syntaxes are encoded uncompressed; compression is applied by Write.
+func EncodeDataset(ds *Dataset, transferSyntax string) ([]byte, error) {
+	if transferSyntax == ExplicitVRBigEndian {
+		return nil, fmt.Errorf("unsupported transfer syntax %s", describeUID(transferSyntax))
+	}
+	enc := &encoder{explicit: transferSyntax != ImplicitVRLittleEndian}
+	enc.dataset(ds)
+	return enc.buf.Bytes(), nil
+}
+
+// encoder encodes elements in little endian byte order
+type encoder struct {
+	buf      bytes.Buffer
+	explicit bool
+
+	// charset encodes text values held in UTF-8, the first value it cannot encode is kept in err
+	charset *characterSet
+	err     error
+}
+
+func (enc *encoder) uint16(v uint16) {
+	b := make([]byte, 2)
+	binary.LittleEndian.PutUint16(b, v)
+	enc.buf.Write(b)
+}
+
+func (enc *encoder) uint32(v uint32) {
+	b := make([]byte, 4)
+	binary.LittleEndian.PutUint32(b, v)
+	enc.buf.Write(b)
+}
+
+func (enc *encoder) tag(t Tag) {
+	enc.uint16(t.Group())
+	enc.uint16(t.Element())
+}
+
+// header writes the tag, VR and length of an element
+func (enc *encoder) header(t Tag, vr string, length uint32) {
+	enc.tag(t)
+	if !enc.explicit {
+		enc.uint32(length)
+		return
+	}
+	enc.buf.WriteString(vr)
+	if hasLongLength(vr) {
+		enc.uint16(0)
+		enc.uint32(length)
+		return
+	}
+	enc.uint16(uint16(length))
+}
+
+func (enc *encoder) dataset(ds *Dataset) {
+	for _, e := range ds.Elements {
+		// group lengths outside the file meta information are retired and easily left stale
+		if e.Tag.IsGroupLength() {
+			continue
+		}
+		enc.element(e)
+	}
+}
+
+func (enc *encoder) element(e *Element) {
+	vr := e.VR
+	if vr == "" {
+		vr = LookupVR(e.Tag)
+	}
+
+	switch {
+	case vr == SQ:
+		enc.header(e.Tag, SQ, undefinedLength)
+		for _, item := range e.Items {
+			enc.tag(Item)
+			enc.uint32(undefinedLength)
+			enc.dataset(item)
+			enc.tag(ItemDelimitationItem)
+			enc.uint32(0)
+		}
+		enc.tag(SequenceDelimitationItem)
+		enc.uint32(0)
+		return
+
+	case e.IsEncapsulated():
+		enc.header(e.Tag, OB, undefinedLength)
+		for _, f := range e.Fragments {
+			f = pad(f, 0x00)
+			enc.tag(Item)
+			enc.uint32(uint32(len(f)))
+			enc.buf.Write(f)
+		}
+		enc.tag(SequenceDelimitationItem)
+		enc.uint32(0)
+		return
+	}
+
+	value := e.Value
+	if enc.charset != nil && enc.err == nil {
+		if value, enc.err = encodeText(enc.charset, e); enc.err != nil {
+			return
+		}
+	}
+	if len(value)%2 == 1 {
+		value = append(append([]byte{}, value...), padByte(vr))
+	}
+	// values too long for a 16 bit length can only be carried as UN
+	if enc.explicit && !hasLongLength(vr) && len(value) > 0xFFFF {
+		vr = UN
+	}
+	enc.header(e.Tag, vr, uint32(len(value)))
+	enc.buf.Write(value)
+}
@@ -0,0 +1,272 @@
+package dicom
+
+import (
+	"encoding/base64"
+	"encoding/xml"
+	"fmt"
+	"strings"
+)
+
+// nativeDicomNamespace is the namespace of the Native DICOM Model of PS3.19
+const nativeDicomNamespace = "http://dicom.nema.org/PS3.19/models/NativeDICOM"
+
+// xmlModel is the document of the Native DICOM Model of PS3.19 Annex A
+type xmlModel struct {
+	XMLName    xml.Name        `xml:"NativeDicomModel"`
+	Namespace  string          `xml:"xmlns,attr,omitempty"`
+	Attributes []*xmlAttribute `xml:"DicomAttribute"`
+}
+
+// xmlAttribute is a single attribute of the Native DICOM Model
+type xmlAttribute struct {
+	Tag            string           `xml:"tag,attr"`
+	VR             string           `xml:"vr,attr"`
+	Keyword        string           `xml:"keyword,attr,omitempty"`
+	PrivateCreator string           `xml:"privateCreator,attr,omitempty"`
+	Values         []*xmlValue      `xml:"Value"`
+	PersonNames    []*xmlPersonName `xml:"PersonName"`
+	Items          []*xmlItem       `xml:"Item"`
+	BulkData       *xmlBulkData     `xml:"BulkData"`
+	InlineBinary   string           `xml:"InlineBinary,omitempty"`
+}
+
+// xmlValue is a single value, numbered from 1
+type xmlValue struct {
+	Number int    `xml:"number,attr"`
+	Value  string `xml:",chardata"`
+}
+
+// xmlPersonName is a single person name value, numbered from 1
+type xmlPersonName struct {
+	Number      int                `xml:"number,attr"`
+	Alphabetic  *xmlNameComponents `xml:"Alphabetic"`
+	Ideographic *xmlNameComponents `xml:"Ideographic"`
+	Phonetic    *xmlNameComponents `xml:"Phonetic"`
+}
+
+// xmlNameComponents are the components of a person name group, separated by "^" in its value
+type xmlNameComponents struct {
+	FamilyName string `xml:"FamilyName,omitempty"`
+	GivenName  string `xml:"GivenName,omitempty"`
+	MiddleName string `xml:"MiddleName,omitempty"`
+	NamePrefix string `xml:"NamePrefix,omitempty"`
+	NameSuffix string `xml:"NameSuffix,omitempty"`
+}
+
+// xmlItem is a single item of a sequence, numbered from 1
+type xmlItem struct {
+	Number     int             `xml:"number,attr"`
+	Attributes []*xmlAttribute `xml:"DicomAttribute"`
+}
+
+// xmlBulkData refers to a value given by URI
+type xmlBulkData struct {
+	URI string `xml:"uri,attr"`
+}
+
+// EncodeXML returns the Native DICOM Model of a dataset, as defined in PS3.19 Annex A. Binary
+// values are given as InlineBinary, or by BulkData when bulk gives them a URI.
+func EncodeXML(ds *Dataset, bulk *BulkData) ([]byte, error) {
+	attributes, err := xmlDataset(ds, "", bulk)
+	if err != nil {
+		return nil, err
+	}
+	b, err := xml.MarshalIndent(&xmlModel{Namespace: nativeDicomNamespace, Attributes: attributes}, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(xml.Header), b...), nil
+}
+
+// xmlDataset returns the attributes of a dataset found at prefix
+func xmlDataset(ds *Dataset, prefix string, bulk *BulkData) ([]*xmlAttribute, error) {
+	attributes := make([]*xmlAttribute, 0, len(ds.Elements))
+	for _, e := range ds.Elements {
+		if e.Tag.IsGroupLength() {
+			continue
+		}
+		a, err := xmlElement(ds, e, modelPath(prefix, e.Tag), bulk)
+		if err != nil {
+			return nil, err
+		}
+		attributes = append(attributes, a)
+	}
+	return attributes, nil
+}
+
+// xmlElement returns the Native DICOM Model of an element of parent found at path
+func xmlElement(parent *Dataset, e *Element, path string, bulk *BulkData) (*xmlAttribute, error) {
+	vr := e.VR
+	if vr == "" {
+		vr = LookupVR(e.Tag)
+	}
+	a := &xmlAttribute{Tag: fmt.Sprintf("%08X", uint32(e.Tag)), VR: vr}
+	if e.IsEncapsulated() {
+		a.VR = OB
+	}
+	if e.Tag.IsPrivate() {
+		a.PrivateCreator = parent.PrivateCreator(e.Tag)
+	} else if entry, ok := Lookup(e.Tag); ok {
+		a.Keyword = entry.Keyword
+	}
+
+	switch {
+	case vr == SQ:
+		for i, item := range e.Items {
+			attributes, err := xmlDataset(item, fmt.Sprintf("%s[%d]", path, i), bulk)
+			if err != nil {
+				return nil, err
+			}
+			a.Items = append(a.Items, &xmlItem{Number: i + 1, Attributes: attributes})
+		}
+
+	case isBinaryVR(a.VR):
+		b := binaryValue(e)
+		if len(b) == 0 {
+			break
+		}
+		if uri := bulk.uri(path, e, len(b)); uri != "" {
+			a.BulkData = &xmlBulkData{URI: uri}
+		} else {
+			a.InlineBinary = base64.StdEncoding.EncodeToString(b)
+		}
+
+	default:
+		values, err := modelStrings(&Element{Tag: e.Tag, VR: vr, Value: e.Value})
+		if err != nil {
+			return nil, err
+		}
+		for i, v := range values {
+			// empty values are left out, the numbers of the others keeping their position
+			if v == "" {
+				continue
+			}
+			if vr == PN {
+				a.PersonNames = append(a.PersonNames, xmlName(i+1, v))
+			} else {
+				a.Values = append(a.Values, &xmlValue{Number: i + 1, Value: v})
+			}
+		}
+	}
+	return a, nil
+}
+
+// xmlName returns a person name value with the given number
+func xmlName(number int, v string) *xmlPersonName {
+	name := &xmlPersonName{Number: number}
+	groups := []**xmlNameComponents{&name.Alphabetic, &name.Ideographic, &name.Phonetic}
+	for i, group := range splitPersonName(v) {
+		if group == "" {
+			continue
+		}
+		c := strings.SplitN(group, "^", 5)
+		for len(c) < 5 {
+			c = append(c, "")
+		}
+		*groups[i] = &xmlNameComponents{FamilyName: c[0], GivenName: c[1], MiddleName: c[2], NamePrefix: c[3], NameSuffix: c[4]}
+	}
+	return name
+}
+
+// value returns the value of a person name group, trailing empty components being dropped
+func (c *xmlNameComponents) value() string {
+	if c == nil {
+		return ""
+	}
+	return strings.TrimRight(strings.Join([]string{c.FamilyName, c.GivenName, c.MiddleName, c.NamePrefix, c.NameSuffix}, "^"), "^")
+}
+
+// DecodeXML reads a dataset from its Native DICOM Model. Values given by BulkData are loaded
+// through bulk, or left empty when it does not load them.
+func DecodeXML(b []byte, bulk *BulkData) (*Dataset, error) {
+	var model xmlModel
+	if err := xml.Unmarshal(b, &model); err != nil {
+		return nil, fmt.Errorf("invalid native dicom xml: %v", err)
+	}
+	return datasetFromXML(model.Attributes, bulk)
+}
+
+// datasetFromXML returns the dataset of a list of attributes
+func datasetFromXML(attributes []*xmlAttribute, bulk *BulkData) (*Dataset, error) {
+	ds := &Dataset{}
+	for _, a := range attributes {
+		tag, err := ParseTag(a.Tag)
+		if err != nil {
+			return nil, err
+		}
+		if a.VR == "" {
+			return nil, fmt.Errorf("%s: missing vr", describeTag(tag))
+		}
+		e, err := elementFromXML(tag, a, bulk)
+		if err != nil {
+			return nil, err
+		}
+		ds.Set(e)
+	}
+	return ds, nil
+}
+
+// elementFromXML returns the element of an attribute of the Native DICOM Model
+func elementFromXML(tag Tag, a *xmlAttribute, bulk *BulkData) (*Element, error) {
+	e := &Element{Tag: tag, VR: a.VR}
+	switch {
+	case a.VR == SQ:
+		e.Items = make([]*Dataset, len(a.Items))
+		for _, item := range a.Items {
+			if item.Number < 1 || item.Number > len(a.Items) || e.Items[item.Number-1] != nil {
+				return nil, fmt.Errorf("%s: invalid item number %d", describeTag(tag), item.Number)
+			}
+			ds, err := datasetFromXML(item.Attributes, bulk)
+			if err != nil {
+				return nil, err
+			}
+			e.Items[item.Number-1] = ds
+		}
+
+	case a.BulkData != nil:
+		b, err := bulk.load(a.BulkData.URI)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", describeTag(tag), err)
+		}
+		if err := setBinaryValue(e, b); err != nil {
+			return nil, err
+		}
+
+	case a.InlineBinary != "":
+		b, err := base64.StdEncoding.DecodeString(strings.TrimSpace(a.InlineBinary))
+		if err != nil {
+			return nil, fmt.Errorf("%s: invalid InlineBinary: %v", describeTag(tag), err)
+		}
+		if err := setBinaryValue(e, b); err != nil {
+			return nil, err
+		}
+
+	default:
+		// values are placed by number, numbers left out being empty values
+		var values []string
+		set := func(number int, v string) error {
+			if number < 1 {
+				return fmt.Errorf("%s: invalid value number %d", describeTag(tag), number)
+			}
+			for len(values) < number {
+				values = append(values, "")
+			}
+			values[number-1] = v
+			return nil
+		}
+		for _, v := range a.Values {
+			if err := set(v.Number, v.Value); err != nil {
+				return nil, err
+			}
+		}
+		for _, n := range a.PersonNames {
+			if err := set(n.Number, joinPersonName([]string{n.Alphabetic.value(), n.Ideographic.value(), n.Phonetic.value()})); err != nil {
+				return nil, err
+			}
+		}
+		if err := setModelStrings(e, values); err != nil {
+			return nil, err
+		}
+	}
+	return e, nil
+}
@@ -25,11 +25,28 @@ type DicomStoreService interface {
 	GetDicomStoreList(ctx context.Context) ([]*DicomStore, error)
 
 	// Strips the P.I.I(Personally Identifiable Information) embedded in the dicom instances
+	// using the options of the profile supplied
 	// Deidentified dicom instances will be stored in the destinationDicomStoreProvided
-	DeidentifyDicomStore(ctx context.Context, sourceDicomStore, destinationDicomStore *DicomStore) error
-
-	// Imports Dicom Instances from GCS
-	ImportDICOMInstance(dicomStoreID, contentURI string) error
+	// Findings of the de-identification, such as unknown private creators, are added to report,
+	// which may be nil.
+	DeidentifyDicomStore(ctx context.Context, sourceDicomStore, destinationDicomStore *DicomStore, profile *Profile, report *JobReport) error
+
+	// Scans every de-identified instance of the destination store for identifiers of the source
+	// store instances left behind by the profile, adding the findings to report, and records the outcome.
+	// The equivalence classes of the instances over the quasi-identifiers of the profile are computed
+	// in the same pass and attached to report, records below k being reported rather than an error.
+	// Returns ErrResidualPHI when a finding is critical, the destination store then cannot be exported.
+	VerifyDicomStore(ctx context.Context, sourceDicomStore, destinationDicomStore *DicomStore, profile *Profile, report *JobReport) error
+
+	// Catalogues the attributes, private creators, SOP classes and modalities of every instance
+	// of a store, with samples of the values hashed or truncated
+	ProfileDicomStore(ctx context.Context, dicomStore *DicomStore) (*DatasetProfile, error)
+
+	// Imports Dicom Instances from GCS, returning once the import is done
+	// The store is recorded as unverified until it is verified again
+	ImportDICOMInstance(ctx context.Context, dicomStoreID, contentURI string) error
 	// Exports Dicom Instances to GCS
-	ExportDICOMInstance(dicomStoreID, gcsDestination string) error
+	// Returns ErrUnverifiedStore for stores without a verification on record since they were last
+	// written to, and ErrResidualPHI for stores whose verification found critical issues
+	ExportDICOMInstance(ctx context.Context, dicomStoreID, gcsDestination string) error
 }
@@ -19,6 +19,10 @@ const (
 	EUNAUTHORIZED   = "unauthorized"
 )
 
+// ErrInstanceExcluded is returned for instances the profile leaves out of the output altogether,
+// such as the label images of slides. Nothing is written for them.
+var ErrInstanceExcluded = Errorf(ECONFLICT, "instance is excluded by the profile")
+
 // Error represents an application-specific error. Application errors can be
 // unwrapped by the caller to extract out the code & message.
 //
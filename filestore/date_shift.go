@@ -0,0 +1,85 @@
+package filestore
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+
+	dcmd "gitlab.com/medical-research/dicom-deidentifier"
+)
+
+// dateShiftsTable is the table holding the date shift of every patient seen
+const dateShiftsTable = "date_shifts"
+
+// Ensure service implements interface.
+var _ dcmd.DateShiftService = (*DateShiftService)(nil)
+
+// DateShiftService represents a service for persisting per-patient date shifts
+type DateShiftService struct {
+	db *DB
+
+	mu     sync.Mutex
+	loaded bool
+	shifts map[string]*dcmd.DateShift
+}
+
+// NewDateShiftService returns a new instance of DateShiftService
+func NewDateShiftService(db *DB) *DateShiftService {
+	return &DateShiftService{
+		db:     db,
+		shifts: map[string]*dcmd.DateShift{},
+	}
+}
+
+// FindDateShift finds the date shift recorded for a patient
+func (s *DateShiftService) FindDateShift(ctx context.Context, patientKey string) (*dcmd.DateShift, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.load(); err != nil {
+		return nil, err
+	}
+	shift, ok := s.shifts[patientKey]
+	if !ok {
+		return nil, dcmd.Errorf(dcmd.ENOTFOUND, "patient has no date shift")
+	}
+	return shift, nil
+}
+
+// CreateDateShift records the date shift of a new patient
+func (s *DateShiftService) CreateDateShift(ctx context.Context, shift *dcmd.DateShift) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.load(); err != nil {
+		return err
+	}
+	if _, ok := s.shifts[shift.PatientKey]; ok {
+		return dcmd.Errorf(dcmd.ECONFLICT, "patient already has a date shift")
+	}
+	if err := s.db.append(dateShiftsTable, shift); err != nil {
+		return err
+	}
+	s.shifts[shift.PatientKey] = shift
+	return nil
+}
+
+// load reads the table into memory the first time it is needed
+func (s *DateShiftService) load() error {
+	if s.loaded {
+		return nil
+	}
+	err := s.db.scan(dateShiftsTable, func(record []byte) error {
+		shift := &dcmd.DateShift{}
+		if err := json.Unmarshal(record, shift); err != nil {
+			return err
+		}
+		s.shifts[shift.PatientKey] = shift
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	s.loaded = true
+	return nil
+}
@@ -0,0 +1,93 @@
+package filestore
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// DB represents a set of append-only tables stored as JSON lines in a local directory
+// Each table is read once on first use and kept in memory by the service that owns it
+type DB struct {
+	mu sync.Mutex
+
+	// Dir is the directory holding the table files
+	Dir string
+}
+
+// NewDB returns a new instance of DB stored in dir
+func NewDB(dir string) *DB {
+	return &DB{Dir: dir}
+}
+
+// Open creates the table directory if it does not exist
+func (db *DB) Open() error {
+	if db.Dir == "" {
+		return fmt.Errorf("data directory required")
+	}
+	if err := os.MkdirAll(db.Dir, 0700); err != nil {
+		return fmt.Errorf("could not create data directory: %v", err)
+	}
+	return nil
+}
+
+// Close releases the database. Records are written as they are appended so there is nothing to flush.
+func (db *DB) Close() error {
+	return nil
+}
+
+// path returns the file holding table
+func (db *DB) path(table string) string {
+	return filepath.Join(db.Dir, table+".jsonl")
+}
+
+// append writes a single record to the end of table
+func (db *DB) append(table string, record interface{}) error {
+	b, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	f, err := os.OpenFile(db.path(table), os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0600)
+	if err != nil {
+		return fmt.Errorf("could not open table %s: %v", table, err)
+	}
+	if _, err := f.Write(append(b, '\n')); err != nil {
+		f.Close()
+		return fmt.Errorf("could not write table %s: %v", table, err)
+	}
+	return f.Close()
+}
+
+// scan calls fn with every record of table in the order they were appended
+// A table that has never been written to holds no records
+func (db *DB) scan(table string, fn func(record []byte) error) error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	f, err := os.Open(db.path(table))
+	if os.IsNotExist(err) {
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("could not open table %s: %v", table, err)
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 64*1024), 16*1024*1024)
+	for scanner.Scan() {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+		if err := fn(scanner.Bytes()); err != nil {
+			return fmt.Errorf("table %s: %v", table, err)
+		}
+	}
+	return scanner.Err()
+}
@@ -0,0 +1,214 @@
+package filestore
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	dcmd "gitlab.com/medical-research/dicom-deidentifier"
+)
+
+// pseudonymsTable is the table linking patients to the pseudonyms they were given
+const pseudonymsTable = "pseudonyms"
+
+// Ensure service implements interface.
+var _ dcmd.PseudonymService = (*PseudonymService)(nil)
+
+// PseudonymService represents a service for persisting the linkage between patients and pseudonyms
+// The original identifiers are sealed before they are written, so the table on disk
+// cannot be read without the linkage key
+type PseudonymService struct {
+	db     *DB
+	sealer *sealer
+
+	mu     sync.Mutex
+	loaded bool
+	// records indexed by project and patient key, and by project and pseudonym
+	byPatient   map[string]*pseudonymRecord
+	byPseudonym map[string]*pseudonymRecord
+	counts      map[string]int
+}
+
+// pseudonymRecord is the on-disk form of a dcmd.Pseudonym
+type pseudonymRecord struct {
+	Project    string    `json:"project"`
+	PatientKey string    `json:"patient-key"`
+	Pseudonym  string    `json:"pseudonym"`
+	Identity   []byte    `json:"identity"`
+	CreatedAt  time.Time `json:"created-at"`
+}
+
+// identity holds the original identifiers sealed in a pseudonymRecord
+type identity struct {
+	Issuer    string `json:"issuer"`
+	PatientID string `json:"patient-id"`
+}
+
+// NewPseudonymService returns a new instance of PseudonymService sealing identifiers with
+// key, which must be a 16, 24 or 32 byte AES key
+func NewPseudonymService(db *DB, key []byte) (*PseudonymService, error) {
+	sealer, err := newSealer(key)
+	if err != nil {
+		return nil, err
+	}
+	return &PseudonymService{
+		db:          db,
+		sealer:      sealer,
+		byPatient:   map[string]*pseudonymRecord{},
+		byPseudonym: map[string]*pseudonymRecord{},
+		counts:      map[string]int{},
+	}, nil
+}
+
+// FindPseudonym finds the pseudonym given to a patient, without the original identifiers
+func (s *PseudonymService) FindPseudonym(ctx context.Context, project, patientKey string) (*dcmd.Pseudonym, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.load(); err != nil {
+		return nil, err
+	}
+	record, ok := s.byPatient[project+"\x00"+patientKey]
+	if !ok {
+		return nil, dcmd.Errorf(dcmd.ENOTFOUND, "patient has no pseudonym")
+	}
+	return &dcmd.Pseudonym{
+		Project:    record.Project,
+		PatientKey: record.PatientKey,
+		Pseudonym:  record.Pseudonym,
+		CreatedAt:  record.CreatedAt,
+	}, nil
+}
+
+// ReidentifyPseudonym finds the patient behind a pseudonym, including the original identifiers
+func (s *PseudonymService) ReidentifyPseudonym(ctx context.Context, project, pseudonym string) (*dcmd.Pseudonym, error) {
+	if !dcmd.HasRole(ctx, dcmd.ReidentificationRole) {
+		return nil, dcmd.Errorf(dcmd.EUNAUTHORIZED, "re-identification is not allowed")
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.load(); err != nil {
+		return nil, err
+	}
+	record, ok := s.byPseudonym[project+"\x00"+pseudonym]
+	if !ok {
+		return nil, dcmd.Errorf(dcmd.ENOTFOUND, "pseudonym not found")
+	}
+
+	id, err := s.open(record)
+	if err != nil {
+		return nil, err
+	}
+	return &dcmd.Pseudonym{
+		Project:    record.Project,
+		PatientKey: record.PatientKey,
+		Pseudonym:  record.Pseudonym,
+		Issuer:     id.Issuer,
+		PatientID:  id.PatientID,
+		CreatedAt:  record.CreatedAt,
+	}, nil
+}
+
+// CountPseudonyms returns the number of pseudonyms given within a project
+func (s *PseudonymService) CountPseudonyms(ctx context.Context, project string) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.load(); err != nil {
+		return 0, err
+	}
+	return s.counts[project], nil
+}
+
+// CreatePseudonym records the pseudonym of a new patient
+func (s *PseudonymService) CreatePseudonym(ctx context.Context, pseudonym *dcmd.Pseudonym) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.load(); err != nil {
+		return err
+	}
+	if _, ok := s.byPatient[pseudonym.Project+"\x00"+pseudonym.PatientKey]; ok {
+		return dcmd.Errorf(dcmd.ECONFLICT, "patient already has a pseudonym")
+	}
+	if _, ok := s.byPseudonym[pseudonym.Project+"\x00"+pseudonym.Pseudonym]; ok {
+		return dcmd.Errorf(dcmd.ECONFLICT, "pseudonym already given")
+	}
+
+	record, err := s.seal(pseudonym)
+	if err != nil {
+		return err
+	}
+	if err := s.db.append(pseudonymsTable, record); err != nil {
+		return err
+	}
+	s.index(record)
+	return nil
+}
+
+// seal returns the record of a pseudonym with its original identifiers encrypted
+func (s *PseudonymService) seal(pseudonym *dcmd.Pseudonym) (*pseudonymRecord, error) {
+	plaintext, err := json.Marshal(&identity{Issuer: pseudonym.Issuer, PatientID: pseudonym.PatientID})
+	if err != nil {
+		return nil, err
+	}
+	record := &pseudonymRecord{
+		Project:    pseudonym.Project,
+		PatientKey: pseudonym.PatientKey,
+		Pseudonym:  pseudonym.Pseudonym,
+		CreatedAt:  pseudonym.CreatedAt,
+	}
+	// the record's keys are bound as additional data so identities cannot be swapped between records
+	if record.Identity, err = s.sealer.seal(plaintext, record.additionalData()); err != nil {
+		return nil, err
+	}
+	return record, nil
+}
+
+// open decrypts the original identifiers of a record
+func (s *PseudonymService) open(record *pseudonymRecord) (*identity, error) {
+	plaintext, err := s.sealer.open(record.Identity, record.additionalData())
+	if err != nil {
+		return nil, err
+	}
+	id := &identity{}
+	if err := json.Unmarshal(plaintext, id); err != nil {
+		return nil, err
+	}
+	return id, nil
+}
+
+// additionalData returns the authenticated data binding a sealed identity to its record
+func (r *pseudonymRecord) additionalData() []byte {
+	return []byte(r.Project + "\x00" + r.PatientKey + "\x00" + r.Pseudonym)
+}
+
+// index adds a record to the in-memory indexes
+func (s *PseudonymService) index(record *pseudonymRecord) {
+	s.byPatient[record.Project+"\x00"+record.PatientKey] = record
+	s.byPseudonym[record.Project+"\x00"+record.Pseudonym] = record
+	s.counts[record.Project]++
+}
+
+// load reads the table into memory the first time it is needed
+func (s *PseudonymService) load() error {
+	if s.loaded {
+		return nil
+	}
+	err := s.db.scan(pseudonymsTable, func(b []byte) error {
+		record := &pseudonymRecord{}
+		if err := json.Unmarshal(b, record); err != nil {
+			return err
+		}
+		s.index(record)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	s.loaded = true
+	return nil
+}
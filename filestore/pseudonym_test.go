@@ -0,0 +1,105 @@
+package filestore_test
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+	"time"
+
+	dcmd "gitlab.com/medical-research/dicom-deidentifier"
+	"gitlab.com/medical-research/dicom-deidentifier/filestore"
+)
+
+func TestPseudonymService(t *testing.T) {
+	db := mustOpenDB(t)
+	ctx := context.Background()
+	key := bytes.Repeat([]byte{7}, 32)
+
+	s, err := filestore.NewPseudonymService(db, key)
+	if err != nil {
+		t.Fatalf("NewPseudonymService() error = %v", err)
+	}
+	pseudonym := &dcmd.Pseudonym{
+		Project:    "project-a",
+		PatientKey: "key-1",
+		Pseudonym:  "SUBJ000001",
+		Issuer:     "HOSPITAL",
+		PatientID:  "MRN0001",
+		CreatedAt:  time.Now(),
+	}
+	if err := s.CreatePseudonym(ctx, pseudonym); err != nil {
+		t.Fatalf("CreatePseudonym() error = %v", err)
+	}
+	if err := s.CreatePseudonym(ctx, pseudonym); dcmd.ErrorCode(err) != dcmd.ECONFLICT {
+		t.Errorf("CreatePseudonym() duplicate error = %v, want %v", err, dcmd.ECONFLICT)
+	}
+
+	// the original identifiers are never written in clear
+	b, err := ioutil.ReadFile(filepath.Join(db.Dir, "pseudonyms.jsonl"))
+	if err != nil {
+		t.Fatalf("could not read table: %v", err)
+	}
+	if bytes.Contains(b, []byte("MRN0001")) {
+		t.Errorf("linkage table holds the patient id in clear")
+	}
+
+	// a fresh service reads the linkage back from disk
+	reloaded, err := filestore.NewPseudonymService(db, key)
+	if err != nil {
+		t.Fatalf("NewPseudonymService() error = %v", err)
+	}
+	found, err := reloaded.FindPseudonym(ctx, "project-a", "key-1")
+	if err != nil {
+		t.Fatalf("FindPseudonym() error = %v", err)
+	}
+	if found.Pseudonym != "SUBJ000001" || found.PatientID != "" {
+		t.Errorf("FindPseudonym() = %+v, want pseudonym without identifiers", found)
+	}
+	if n, _ := reloaded.CountPseudonyms(ctx, "project-a"); n != 1 {
+		t.Errorf("CountPseudonyms() = %d, want 1", n)
+	}
+
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		project  string
+		wantCode string
+	}{
+		{
+			name:     "without role",
+			ctx:      ctx,
+			project:  "project-a",
+			wantCode: dcmd.EUNAUTHORIZED,
+		},
+		{
+			name:    "with re-identification role",
+			ctx:     dcmd.NewContextWithRoles(ctx, dcmd.ReidentificationRole),
+			project: "project-a",
+		},
+		{
+			name:     "pseudonym of another project",
+			ctx:      dcmd.NewContextWithRoles(ctx, dcmd.ReidentificationRole),
+			project:  "project-b",
+			wantCode: dcmd.ENOTFOUND,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := reloaded.ReidentifyPseudonym(tt.ctx, tt.project, "SUBJ000001")
+			if code := dcmd.ErrorCode(err); code != tt.wantCode {
+				t.Fatalf("ReidentifyPseudonym() error = %v, want %v", err, tt.wantCode)
+			}
+			if err == nil && (got.Issuer != "HOSPITAL" || got.PatientID != "MRN0001") {
+				t.Errorf("ReidentifyPseudonym() = %+v, want original identifiers", got)
+			}
+		})
+	}
+
+	// a different key cannot open the sealed identifiers
+	other, _ := filestore.NewPseudonymService(db, bytes.Repeat([]byte{8}, 32))
+	if _, err := other.ReidentifyPseudonym(dcmd.NewContextWithRoles(ctx, dcmd.ReidentificationRole), "project-a", "SUBJ000001"); err == nil {
+		t.Errorf("ReidentifyPseudonym() with wrong key succeeded")
+	}
+}
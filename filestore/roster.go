@@ -0,0 +1,153 @@
+package filestore
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"sync"
+	"time"
+
+	dcmd "gitlab.com/medical-research/dicom-deidentifier"
+)
+
+// rostersTable is the table holding every version of the enrolment rosters
+const rostersTable = "rosters"
+
+// Ensure service implements interface.
+var _ dcmd.RosterService = (*RosterService)(nil)
+
+// RosterService represents a service for persisting versioned enrolment rosters
+// Roster entries map hospital IDs to subject IDs and are sealed like the pseudonym linkage table
+type RosterService struct {
+	db     *DB
+	sealer *sealer
+
+	mu      sync.Mutex
+	loaded  bool
+	rosters map[string][]*rosterRecord
+}
+
+// rosterRecord is the on-disk form of a dcmd.Roster
+type rosterRecord struct {
+	Project      string    `json:"project"`
+	Version      int       `json:"version"`
+	SponsorName  string    `json:"sponsor-name"`
+	ProtocolID   string    `json:"protocol-id"`
+	ProtocolName string    `json:"protocol-name,omitempty"`
+	Entries      []byte    `json:"entries"`
+	CreatedAt    time.Time `json:"created-at"`
+}
+
+// NewRosterService returns a new instance of RosterService sealing entries with key
+func NewRosterService(db *DB, key []byte) (*RosterService, error) {
+	sealer, err := newSealer(key)
+	if err != nil {
+		return nil, err
+	}
+	return &RosterService{
+		db:      db,
+		sealer:  sealer,
+		rosters: map[string][]*rosterRecord{},
+	}, nil
+}
+
+// FindRoster finds a version of the roster of a project, or the latest version if version is 0
+func (s *RosterService) FindRoster(ctx context.Context, project string, version int) (*dcmd.Roster, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.load(); err != nil {
+		return nil, err
+	}
+	versions := s.rosters[project]
+	if version == 0 {
+		version = len(versions)
+	}
+	if version < 1 || version > len(versions) {
+		return nil, dcmd.Errorf(dcmd.ENOTFOUND, "roster not found")
+	}
+	record := versions[version-1]
+
+	plaintext, err := s.sealer.open(record.Entries, record.additionalData())
+	if err != nil {
+		return nil, err
+	}
+	roster := &dcmd.Roster{
+		Project:      record.Project,
+		Version:      record.Version,
+		SponsorName:  record.SponsorName,
+		ProtocolID:   record.ProtocolID,
+		ProtocolName: record.ProtocolName,
+		CreatedAt:    record.CreatedAt,
+	}
+	if err := json.Unmarshal(plaintext, &roster.Entries); err != nil {
+		return nil, err
+	}
+	return roster, nil
+}
+
+// CreateRoster records a new version of the roster of a project
+func (s *RosterService) CreateRoster(ctx context.Context, roster *dcmd.Roster) error {
+	// a roster decides which patient a subject id stands for, so it is guarded like re-identification
+	if !dcmd.HasRole(ctx, dcmd.ReidentificationRole) {
+		return dcmd.Errorf(dcmd.EUNAUTHORIZED, "storing rosters is not allowed")
+	}
+	if err := roster.Validate(); err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.load(); err != nil {
+		return err
+	}
+
+	record := &rosterRecord{
+		Project:      roster.Project,
+		Version:      len(s.rosters[roster.Project]) + 1,
+		SponsorName:  roster.SponsorName,
+		ProtocolID:   roster.ProtocolID,
+		ProtocolName: roster.ProtocolName,
+		CreatedAt:    time.Now(),
+	}
+	plaintext, err := json.Marshal(roster.Entries)
+	if err != nil {
+		return err
+	}
+	if record.Entries, err = s.sealer.seal(plaintext, record.additionalData()); err != nil {
+		return err
+	}
+	if err := s.db.append(rostersTable, record); err != nil {
+		return err
+	}
+	s.rosters[record.Project] = append(s.rosters[record.Project], record)
+
+	roster.Version, roster.CreatedAt = record.Version, record.CreatedAt
+	return nil
+}
+
+// additionalData returns the authenticated data binding sealed entries to their roster version
+func (r *rosterRecord) additionalData() []byte {
+	return []byte(r.Project + "\x00" + strconv.Itoa(r.Version))
+}
+
+// load reads the table into memory the first time it is needed
+func (s *RosterService) load() error {
+	if s.loaded {
+		return nil
+	}
+	err := s.db.scan(rostersTable, func(b []byte) error {
+		record := &rosterRecord{}
+		if err := json.Unmarshal(b, record); err != nil {
+			return err
+		}
+		s.rosters[record.Project] = append(s.rosters[record.Project], record)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	s.loaded = true
+	return nil
+}
@@ -0,0 +1,95 @@
+package filestore_test
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	dcmd "gitlab.com/medical-research/dicom-deidentifier"
+	"gitlab.com/medical-research/dicom-deidentifier/filestore"
+)
+
+func TestRosterService(t *testing.T) {
+	db := mustOpenDB(t)
+	ctx := context.Background()
+	key := bytes.Repeat([]byte{7}, 32)
+
+	s, err := filestore.NewRosterService(db, key)
+	if err != nil {
+		t.Fatalf("NewRosterService() error = %v", err)
+	}
+	unauthorised := &dcmd.Roster{Project: "trial", Entries: []*dcmd.RosterEntry{{PatientID: "MRN0001", SubjectID: "TRIAL-999"}}}
+	if err := s.CreateRoster(ctx, unauthorised); dcmd.ErrorCode(err) != dcmd.EUNAUTHORIZED {
+		t.Fatalf("CreateRoster() without role error = %v, want %s", err, dcmd.EUNAUTHORIZED)
+	}
+
+	authorised := dcmd.NewContextWithRoles(ctx, dcmd.ReidentificationRole)
+	for _, subjectID := range []string{"TRIAL-001", "TRIAL-101"} {
+		roster := &dcmd.Roster{
+			Project:     "trial",
+			SponsorName: "ACME",
+			ProtocolID:  "P-1",
+			Entries:     []*dcmd.RosterEntry{{PatientID: "MRN0001", SubjectID: subjectID}},
+		}
+		if err := s.CreateRoster(authorised, roster); err != nil {
+			t.Fatalf("CreateRoster() error = %v", err)
+		}
+	}
+
+	b, err := ioutil.ReadFile(filepath.Join(db.Dir, "rosters.jsonl"))
+	if err != nil {
+		t.Fatalf("could not read table: %v", err)
+	}
+	if bytes.Contains(b, []byte("MRN0001")) {
+		t.Errorf("roster table holds the patient id in clear")
+	}
+
+	tests := []struct {
+		name     string
+		project  string
+		version  int
+		want     string
+		wantCode string
+	}{
+		{
+			name:    "latest version",
+			project: "trial",
+			want:    "TRIAL-101",
+		},
+		{
+			name:    "pinned version",
+			project: "trial",
+			version: 1,
+			want:    "TRIAL-001",
+		},
+		{
+			name:     "unknown version",
+			project:  "trial",
+			version:  3,
+			wantCode: dcmd.ENOTFOUND,
+		},
+		{
+			name:     "project without roster",
+			project:  "other",
+			wantCode: dcmd.ENOTFOUND,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// a fresh service reads the rosters back from disk
+			reloaded, err := filestore.NewRosterService(db, key)
+			if err != nil {
+				t.Fatalf("NewRosterService() error = %v", err)
+			}
+			got, err := reloaded.FindRoster(ctx, tt.project, tt.version)
+			if code := dcmd.ErrorCode(err); code != tt.wantCode {
+				t.Fatalf("FindRoster() error = %v, want %v", err, tt.wantCode)
+			}
+			if err == nil && got.Entries[0].SubjectID != tt.want {
+				t.Errorf("FindRoster() subject id = %v, want %v", got.Entries[0].SubjectID, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,51 @@
+package filestore
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"fmt"
+	"io"
+
+	dcmd "gitlab.com/medical-research/dicom-deidentifier"
+)
+
+// sealer encrypts the parts of records that identify patients before they are written
+type sealer struct {
+	aead cipher.AEAD
+}
+
+// newSealer returns a sealer using key, which must be a 16, 24 or 32 byte AES key
+func newSealer(key []byte) (*sealer, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, dcmd.Errorf(dcmd.EINVALID, "invalid linkage key: %v", err)
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	return &sealer{aead: aead}, nil
+}
+
+// seal encrypts plaintext with AES-GCM, binding it to additionalData
+func (s *sealer) seal(plaintext, additionalData []byte) ([]byte, error) {
+	nonce := make([]byte, s.aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, fmt.Errorf("could not generate nonce: %v", err)
+	}
+	return s.aead.Seal(nonce, nonce, plaintext, additionalData), nil
+}
+
+// open decrypts a value returned by seal
+func (s *sealer) open(sealed, additionalData []byte) ([]byte, error) {
+	n := s.aead.NonceSize()
+	if len(sealed) < n {
+		return nil, fmt.Errorf("sealed value is truncated")
+	}
+	plaintext, err := s.aead.Open(nil, sealed[:n], sealed[n:], additionalData)
+	if err != nil {
+		return nil, fmt.Errorf("could not decrypt sealed value: %v", err)
+	}
+	return plaintext, nil
+}
@@ -0,0 +1,90 @@
+package filestore
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+
+	dcmd "gitlab.com/medical-research/dicom-deidentifier"
+)
+
+// uidMappingsTable is the table holding every UID mapping ever created
+const uidMappingsTable = "uid_mappings"
+
+// Ensure service implements interface.
+var _ dcmd.UIDMappingService = (*UIDMappingService)(nil)
+
+// UIDMappingService represents a service for persisting UID mappings
+type UIDMappingService struct {
+	db *DB
+
+	mu       sync.Mutex
+	loaded   bool
+	mappings map[string]*dcmd.UIDMapping
+}
+
+// NewUIDMappingService returns a new instance of UIDMappingService
+func NewUIDMappingService(db *DB) *UIDMappingService {
+	return &UIDMappingService{
+		db:       db,
+		mappings: map[string]*dcmd.UIDMapping{},
+	}
+}
+
+// FindUIDMapping finds the mapping recorded for an original UID
+func (s *UIDMappingService) FindUIDMapping(ctx context.Context, namespace, originalUID string) (*dcmd.UIDMapping, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.load(); err != nil {
+		return nil, err
+	}
+	m, ok := s.mappings[uidMappingKey(namespace, originalUID)]
+	if !ok {
+		return nil, dcmd.Errorf(dcmd.ENOTFOUND, "uid %s has not been mapped", originalUID)
+	}
+	return m, nil
+}
+
+// CreateUIDMapping records a new mapping
+func (s *UIDMappingService) CreateUIDMapping(ctx context.Context, mapping *dcmd.UIDMapping) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.load(); err != nil {
+		return err
+	}
+	key := uidMappingKey(mapping.Namespace, mapping.OriginalUID)
+	if _, ok := s.mappings[key]; ok {
+		return dcmd.Errorf(dcmd.ECONFLICT, "uid %s has already been mapped", mapping.OriginalUID)
+	}
+	if err := s.db.append(uidMappingsTable, mapping); err != nil {
+		return err
+	}
+	s.mappings[key] = mapping
+	return nil
+}
+
+// load reads the table into memory the first time it is needed
+func (s *UIDMappingService) load() error {
+	if s.loaded {
+		return nil
+	}
+	err := s.db.scan(uidMappingsTable, func(record []byte) error {
+		m := &dcmd.UIDMapping{}
+		if err := json.Unmarshal(record, m); err != nil {
+			return err
+		}
+		s.mappings[uidMappingKey(m.Namespace, m.OriginalUID)] = m
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	s.loaded = true
+	return nil
+}
+
+func uidMappingKey(namespace, uid string) string {
+	return namespace + "\x00" + uid
+}
@@ -0,0 +1,79 @@
+package filestore_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	dcmd "gitlab.com/medical-research/dicom-deidentifier"
+	"gitlab.com/medical-research/dicom-deidentifier/filestore"
+)
+
+// mustOpenDB returns an open DB in a temporary directory
+func mustOpenDB(t *testing.T) *filestore.DB {
+	t.Helper()
+	db := filestore.NewDB(t.TempDir())
+	if err := db.Open(); err != nil {
+		t.Fatalf("Open() error = %v", err)
+	}
+	return db
+}
+
+func TestUIDMappingService(t *testing.T) {
+	db := mustOpenDB(t)
+	ctx := context.Background()
+
+	s := filestore.NewUIDMappingService(db)
+	mapping := &dcmd.UIDMapping{
+		Namespace:      "project-a",
+		OriginalUID:    "1.2.3",
+		ReplacementUID: "2.25.1",
+		CreatedAt:      time.Now(),
+	}
+	if err := s.CreateUIDMapping(ctx, mapping); err != nil {
+		t.Fatalf("CreateUIDMapping() error = %v", err)
+	}
+	if err := s.CreateUIDMapping(ctx, mapping); dcmd.ErrorCode(err) != dcmd.ECONFLICT {
+		t.Errorf("CreateUIDMapping() duplicate error = %v, want %v", err, dcmd.ECONFLICT)
+	}
+
+	tests := []struct {
+		name      string
+		namespace string
+		uid       string
+		want      string
+		wantCode  string
+	}{
+		{
+			name:      "mapped uid",
+			namespace: "project-a",
+			uid:       "1.2.3",
+			want:      "2.25.1",
+		},
+		{
+			name:      "uid mapped in another namespace",
+			namespace: "project-b",
+			uid:       "1.2.3",
+			wantCode:  dcmd.ENOTFOUND,
+		},
+		{
+			name:      "unmapped uid",
+			namespace: "project-a",
+			uid:       "1.2.4",
+			wantCode:  dcmd.ENOTFOUND,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// a fresh service reads the mappings back from disk
+			reloaded := filestore.NewUIDMappingService(db)
+			got, err := reloaded.FindUIDMapping(ctx, tt.namespace, tt.uid)
+			if code := dcmd.ErrorCode(err); code != tt.wantCode {
+				t.Fatalf("FindUIDMapping() error = %v, want %v", err, tt.wantCode)
+			}
+			if err == nil && got.ReplacementUID != tt.want {
+				t.Errorf("FindUIDMapping() = %v, want %v", got.ReplacementUID, tt.want)
+			}
+		})
+	}
+}
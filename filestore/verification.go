@@ -0,0 +1,86 @@
+package filestore
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+
+	dcmd "gitlab.com/medical-research/dicom-deidentifier"
+)
+
+// verificationsTable is the table holding every verification of the de-identified stores
+const verificationsTable = "store_verifications"
+
+// Ensure service implements interface.
+var _ dcmd.VerificationService = (*VerificationService)(nil)
+
+// VerificationService represents a service for persisting the verification of de-identified stores.
+// Every verification is appended, the latest of a store replacing the earlier ones when loaded.
+type VerificationService struct {
+	db *DB
+
+	mu            sync.Mutex
+	loaded        bool
+	verifications map[string]*dcmd.StoreVerification
+}
+
+// NewVerificationService returns a new instance of VerificationService
+func NewVerificationService(db *DB) *VerificationService {
+	return &VerificationService{
+		db:            db,
+		verifications: map[string]*dcmd.StoreVerification{},
+	}
+}
+
+// FindStoreVerification finds the latest verification recorded for a store
+func (s *VerificationService) FindStoreVerification(ctx context.Context, storeID string) (*dcmd.StoreVerification, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.load(); err != nil {
+		return nil, err
+	}
+	verification, ok := s.verifications[storeID]
+	if !ok {
+		return nil, dcmd.Errorf(dcmd.ENOTFOUND, "store has not been verified")
+	}
+	return verification, nil
+}
+
+// CreateStoreVerification records the verification of a store, replacing the one recorded before
+func (s *VerificationService) CreateStoreVerification(ctx context.Context, verification *dcmd.StoreVerification) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if verification.StoreID == "" {
+		return dcmd.Errorf(dcmd.EINVALID, "store id required")
+	}
+	if err := s.load(); err != nil {
+		return err
+	}
+	if err := s.db.append(verificationsTable, verification); err != nil {
+		return err
+	}
+	s.verifications[verification.StoreID] = verification
+	return nil
+}
+
+// load reads the table into memory the first time it is needed
+func (s *VerificationService) load() error {
+	if s.loaded {
+		return nil
+	}
+	err := s.db.scan(verificationsTable, func(record []byte) error {
+		verification := &dcmd.StoreVerification{}
+		if err := json.Unmarshal(record, verification); err != nil {
+			return err
+		}
+		s.verifications[verification.StoreID] = verification
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	s.loaded = true
+	return nil
+}
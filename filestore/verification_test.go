@@ -0,0 +1,37 @@
+package filestore_test
+
+import (
+	"context"
+	"testing"
+
+	dcmd "gitlab.com/medical-research/dicom-deidentifier"
+	"gitlab.com/medical-research/dicom-deidentifier/filestore"
+)
+
+func TestVerificationService(t *testing.T) {
+	db := mustOpenDB(t)
+	ctx := context.Background()
+
+	s := filestore.NewVerificationService(db)
+	if _, err := s.FindStoreVerification(ctx, "dst"); dcmd.ErrorCode(err) != dcmd.ENOTFOUND {
+		t.Fatalf("FindStoreVerification() error = %v, want ENOTFOUND", err)
+	}
+	for _, v := range []*dcmd.StoreVerification{
+		{StoreID: "dst", Verified: true, CriticalFindings: 2},
+		{StoreID: "dst", Verified: true},
+		{StoreID: "other"},
+	} {
+		if err := s.CreateStoreVerification(ctx, v); err != nil {
+			t.Fatalf("CreateStoreVerification() error = %v", err)
+		}
+	}
+
+	// the latest verification of a store is found once the table is read again
+	v, err := filestore.NewVerificationService(db).FindStoreVerification(ctx, "dst")
+	if err != nil {
+		t.Fatalf("FindStoreVerification() error = %v", err)
+	}
+	if !v.Passed() {
+		t.Errorf("Passed() = false, want the latest verification")
+	}
+}
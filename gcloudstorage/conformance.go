@@ -0,0 +1,78 @@
+package gcpcloudstorage
+
+import (
+	"context"
+	"fmt"
+
+	"cloud.google.com/go/storage"
+	dcmd "gitlab.com/medical-research/dicom-deidentifier"
+	"gitlab.com/medical-research/dicom-deidentifier/conformance"
+	"google.golang.org/api/iterator"
+)
+
+// ValidateBucket checks the conformance of every object under a prefix of a bucket
+func (s *CloudStorageService) ValidateBucket(ctx context.Context, bucket *dcmd.CloudStorageBucket, prefix string) (*dcmd.UploadValidation, error) {
+	validation := &dcmd.UploadValidation{}
+
+	b := s.GCloudStorage.Client.Bucket(bucket.Name)
+	it := b.Objects(ctx, &storage.Query{Prefix: prefix})
+	for {
+		attrs, err := it.Next()
+		if err == iterator.Done {
+			break
+		} else if err != nil {
+			return nil, fmt.Errorf("Bucket(%q).Objects: %v", bucket.Name, err)
+		}
+
+		r, err := b.Object(attrs.Name).Generation(attrs.Generation).NewReader(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("Object(%q).NewReader: %v", attrs.Name, err)
+		}
+		report, err := conformance.Validate(attrs.Name, r)
+		r.Close()
+		if err != nil {
+			return nil, err
+		}
+		validation.Add(report)
+		if !report.Rejected() {
+			if validation.Generations == nil {
+				validation.Generations = map[string]int64{}
+			}
+			validation.Generations[attrs.Name] = attrs.Generation
+		}
+	}
+	return validation, nil
+}
+
+// CopyObjects copies objects, each at its generation, to the same names under prefix.
+// Copies are made by the storage service without the objects being downloaded.
+func (s *CloudStorageService) CopyObjects(ctx context.Context, bucket *dcmd.CloudStorageBucket, objects []*dcmd.CloudStorageObject, prefix string) error {
+	b := s.GCloudStorage.Client.Bucket(bucket.Name)
+	for _, object := range objects {
+		src := b.Object(object.Name)
+		if object.Generation != 0 {
+			src = src.Generation(object.Generation)
+		}
+		if _, err := b.Object(prefix + object.Name).CopierFrom(src).Run(ctx); err != nil {
+			return fmt.Errorf("Object(%q).CopierFrom: %v", object.Name, err)
+		}
+	}
+	return nil
+}
+
+// DeleteObjects deletes every object under a prefix of a bucket
+func (s *CloudStorageService) DeleteObjects(ctx context.Context, bucket *dcmd.CloudStorageBucket, prefix string) error {
+	b := s.GCloudStorage.Client.Bucket(bucket.Name)
+	it := b.Objects(ctx, &storage.Query{Prefix: prefix})
+	for {
+		attrs, err := it.Next()
+		if err == iterator.Done {
+			return nil
+		} else if err != nil {
+			return fmt.Errorf("Bucket(%q).Objects: %v", bucket.Name, err)
+		}
+		if err := b.Object(attrs.Name).Delete(ctx); err != nil && err != storage.ErrObjectNotExist {
+			return fmt.Errorf("Object(%q).Delete: %v", attrs.Name, err)
+		}
+	}
+}
@@ -0,0 +1,44 @@
+package gcpcloudstorage
+
+import (
+	"context"
+	"fmt"
+
+	"cloud.google.com/go/storage"
+	dcmd "gitlab.com/medical-research/dicom-deidentifier"
+	"gitlab.com/medical-research/dicom-deidentifier/deid"
+	"gitlab.com/medical-research/dicom-deidentifier/dicom"
+	"google.golang.org/api/iterator"
+)
+
+// ProfileBucket catalogues the attributes of every DICOM instance under a prefix of a bucket
+func (s *CloudStorageService) ProfileBucket(ctx context.Context, bucket *dcmd.CloudStorageBucket, prefix string) (*dcmd.DatasetProfile, error) {
+	profiler, err := deid.NewDatasetProfiler()
+	if err != nil {
+		return nil, err
+	}
+
+	b := s.GCloudStorage.Client.Bucket(bucket.Name)
+	it := b.Objects(ctx, &storage.Query{Prefix: prefix})
+	for {
+		attrs, err := it.Next()
+		if err == iterator.Done {
+			break
+		} else if err != nil {
+			return nil, fmt.Errorf("Bucket(%q).Objects: %v", bucket.Name, err)
+		}
+
+		r, err := b.Object(attrs.Name).NewReader(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("Object(%q).NewReader: %v", attrs.Name, err)
+		}
+		f, err := dicom.Read(r)
+		r.Close()
+		if err != nil {
+			profiler.AddSkipped()
+			continue
+		}
+		profiler.Add(f.Dataset)
+	}
+	return profiler.Profile(), nil
+}
@@ -0,0 +1,31 @@
+package healthcare
+
+import (
+	"context"
+	"fmt"
+
+	dcmd "gitlab.com/medical-research/dicom-deidentifier"
+	"gitlab.com/medical-research/dicom-deidentifier/deid"
+)
+
+// ProfileDicomStore catalogues the attributes of every instance of a store
+func (s *DicomStoreService) ProfileDicomStore(ctx context.Context, dicomStore *dcmd.DicomStore) (*dcmd.DatasetProfile, error) {
+	profiler, err := deid.NewDatasetProfiler()
+	if err != nil {
+		return nil, err
+	}
+
+	parent := fmt.Sprintf("%s/dicomStores/%s", s.GoogleDicomAPI.Dataset.Name, dicomStore.StoreID)
+	refs, err := s.searchInstances(ctx, parent)
+	if err != nil {
+		return nil, err
+	}
+	for _, ref := range refs {
+		f, err := s.retrieveInstance(ctx, parent, ref)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", ref.dicomWebPath(), err)
+		}
+		profiler.Add(f.Dataset)
+	}
+	return profiler.Profile(), nil
+}
@@ -15,6 +15,9 @@ var _ dcmd.DicomService = (*DicomService)(nil)
 // DicomService represents a service for managing Dicoms
 type DicomService struct {
 	dicomAPI *GoogleDicomAPI
+
+	// VerificationService records the stores written to as unverified, see DicomStoreService
+	VerificationService dcmd.VerificationService
 }
 
 // NewDicomService returns a new instance of DicomService
@@ -26,6 +29,9 @@ func NewDicomService(dicomAPI *GoogleDicomAPI) *DicomService {
 
 // CreateDicomInstances creates dicom instances in the cloud within special abstractions called dicomStores
 func (s *DicomService) CreateDicomInstances(ctx context.Context, dicomStore dcmd.DicomStore, dicoms ...dcmd.Dicom) error {
+	if err := recordWrite(ctx, s.VerificationService, dicomStore.StoreID); err != nil {
+		return err
+	}
 
 	for _, dicom := range dicoms {
 		dicomData, err := ioutil.ReadFile(dicom.Path)
@@ -1,11 +1,13 @@
 package healthcare
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"time"
 
 	dcmd "gitlab.com/medical-research/dicom-deidentifier"
+	"gitlab.com/medical-research/dicom-deidentifier/deid"
 	"google.golang.org/api/healthcare/v1"
 )
 
@@ -15,6 +17,23 @@ var _ dcmd.DicomStoreService = (*DicomStoreService)(nil)
 // DicomStoreService represents a service for managing DicomStores
 type DicomStoreService struct {
 	GoogleDicomAPI *GoogleDicomAPI
+
+	// VerificationService records the verification of de-identified stores. Stores are only
+	// exported once a verification without critical findings is on record.
+	VerificationService dcmd.VerificationService
+
+	// DateShiftCryptoKey is the base64 encoded AES key the Healthcare API derives per-patient
+	// date shifts from. Its offsets differ from those of the local engine, profiles retaining
+	// modified dates are left to the local engine when it is not set.
+	DateShiftCryptoKey string
+
+	// DeidentificationService de-identifies instances locally for profiles with options the
+	// Healthcare API cannot apply. Such profiles are refused when it is not set.
+	DeidentificationService dcmd.DeidentificationService
+
+	// SafePrivateDictionary lists the private attributes the local engine retains, which the
+	// verification of its output then allows
+	SafePrivateDictionary *deid.SafePrivateDictionary
 }
 
 // NewDicomStoreService returns a new instance of DicomStoreService
@@ -80,20 +99,23 @@ func (s *DicomStoreService) GetDicomStoreList(ctx context.Context) ([]*dcmd.Dico
 
 // DeidentifyDicomStore Strips the P.I.I(Personally Identifiable Information) embedded in the dicom instances
 // Deidentified dicom instances will be stored in the destinationDicomStoreProvided
-func (s *DicomStoreService) DeidentifyDicomStore(ctx context.Context, sourceDicomStore, destinationDicomStore *dcmd.DicomStore) error {
+// Profiles with options the Healthcare API cannot apply are de-identified by the local engine.
+func (s *DicomStoreService) DeidentifyDicomStore(ctx context.Context, sourceDicomStore, destinationDicomStore *dcmd.DicomStore, profile *dcmd.Profile, report *dcmd.JobReport) error {
+
+	config, err := s.deidentifyConfig(profile)
+	if dcmd.ErrorCode(err) == dcmd.ENOTIMPLEMENTED && s.DeidentificationService != nil {
+		return s.deidentifyLocally(ctx, sourceDicomStore, destinationDicomStore, profile, report)
+	} else if err != nil {
+		return err
+	}
+	if err := recordWrite(ctx, s.VerificationService, destinationDicomStore.StoreID); err != nil {
+		return err
+	}
 
 	datasetsService := s.GoogleDicomAPI.HealthcareService.Projects.Locations.Datasets.DicomStores
-
 	req := &healthcare.DeidentifyDicomStoreRequest{
 		DestinationStore: fmt.Sprintf("%s/dicomStores/%s", s.GoogleDicomAPI.Dataset.Name, destinationDicomStore.StoreID),
-		Config: &healthcare.DeidentifyConfig{
-			Dicom: &healthcare.DicomConfig{
-				FilterProfile: "MINIMAL_KEEP_LIST_PROFILE",
-			},
-			Image: &healthcare.ImageConfig{
-				TextRedactionMode: "REDACT_SENSITIVE_TEXT",
-			},
-		},
+		Config:           config,
 	}
 
 	sourceName := fmt.Sprintf("%s/dicomStores/%s", s.GoogleDicomAPI.Dataset.Name, sourceDicomStore.StoreID)
@@ -102,24 +124,134 @@ func (s *DicomStoreService) DeidentifyDicomStore(ctx context.Context, sourceDico
 		return fmt.Errorf("Deidentify: %v", err)
 	}
 
-	// Wait for the deidentification operation to finish.
-	operationService := s.GoogleDicomAPI.HealthcareService.Projects.Locations.Datasets.Operations
-	for {
-		op, err := operationService.Get(resp.Name).Do()
+	if err := s.waitOperation(ctx, resp.Name); err != nil {
+		return fmt.Errorf("deidentify %v", err)
+	}
+	fmt.Printf("Created de-identified dataset %s from %s\n", resp.Name, sourceName)
+
+	// the Healthcare API does not record how instances were de-identified, so it is stamped afterwards
+	method := "Cloud Healthcare API " + config.Dicom.FilterProfile
+	if err := s.stampProvenance(ctx, destinationDicomStore, profile, method); err != nil {
+		return fmt.Errorf("could not record de-identification provenance: %v", err)
+	}
+	return nil
+}
+
+// deidentifyLocally de-identifies every instance of the source store with the local engine and
+// stores it in the destination store. Instances the profile excludes are left out, any other
+// failure stops the job, leaving the destination store unverified.
+func (s *DicomStoreService) deidentifyLocally(ctx context.Context, sourceDicomStore, destinationDicomStore *dcmd.DicomStore, profile *dcmd.Profile, report *dcmd.JobReport) error {
+	if err := recordWrite(ctx, s.VerificationService, destinationDicomStore.StoreID); err != nil {
+		return err
+	}
+
+	source := fmt.Sprintf("%s/dicomStores/%s", s.GoogleDicomAPI.Dataset.Name, sourceDicomStore.StoreID)
+	destination := fmt.Sprintf("%s/dicomStores/%s", s.GoogleDicomAPI.Dataset.Name, destinationDicomStore.StoreID)
+	refs, err := s.searchInstances(ctx, source)
+	if err != nil {
+		return err
+	}
+	for _, ref := range refs {
+		data, err := s.retrieveInstanceData(ctx, source, ref)
 		if err != nil {
-			return fmt.Errorf("operationService.Get: %v", err)
+			return fmt.Errorf("%s: %v", ref.dicomWebPath(), err)
 		}
-		if !op.Done {
-			time.Sleep(1 * time.Second)
+
+		// the local engine records its own provenance, nothing is stamped afterwards
+		var buf bytes.Buffer
+		if err := s.DeidentificationService.DeidentifyInstance(ctx, profile, report, bytes.NewReader(data), &buf); err == dcmd.ErrInstanceExcluded {
 			continue
+		} else if code := dcmd.ErrorCode(err); code != "" && code != dcmd.EINTERNAL {
+			// instances refused by the profile, such as patients missing from the roster, keep their code
+			return dcmd.Errorf(code, "%s: %s", ref.dicomWebPath(), dcmd.ErrorMessage(err))
+		} else if err != nil {
+			return fmt.Errorf("%s: %v", ref.dicomWebPath(), err)
+		}
+
+		if err := s.storeInstance(ctx, destination, buf.Bytes()); err != nil {
+			return fmt.Errorf("%s: %v", ref.dicomWebPath(), err)
+		}
+	}
+	fmt.Printf("Created de-identified dataset %s from %s with the local engine\n", destination, source)
+	return nil
+}
+
+// deidentifyConfig translates the options of a profile into a Healthcare API de-identification config
+func (s *DicomStoreService) deidentifyConfig(profile *dcmd.Profile) (*healthcare.DeidentifyConfig, error) {
+	config := &healthcare.DeidentifyConfig{
+		Dicom: &healthcare.DicomConfig{
+			FilterProfile: "MINIMAL_KEEP_LIST_PROFILE",
+		},
+		Image: &healthcare.ImageConfig{
+			TextRedactionMode: "REDACT_SENSITIVE_TEXT",
+		},
+	}
+
+	if profile.HasOption(dcmd.PseudonymizePatientIDOption) || profile.HasOption(dcmd.RosterSubjectIDsOption) {
+		// the linkage table and rosters are local, the Healthcare API cannot give the recorded IDs
+		return nil, dcmd.Errorf(dcmd.ENOTIMPLEMENTED, "subject ids require the local de-identification engine")
+	}
+
+	if profile.HasOption(dcmd.EncryptOriginalAttributesOption) {
+		// the original values never reach the Healthcare API output, so they cannot be encrypted into it
+		return nil, dcmd.Errorf(dcmd.ENOTIMPLEMENTED, "encrypted attributes require the local de-identification engine")
+	}
+
+	if profile.HasOption(dcmd.RetainSafePrivateOption) {
+		// the Healthcare API removes every private attribute, it has no notion of private creators
+		return nil, dcmd.Errorf(dcmd.ENOTIMPLEMENTED, "safe private attributes require the local de-identification engine")
+	}
+
+	if profile.HasOption(dcmd.RemoveOverlaysOption) || profile.HasOption(dcmd.BurnInOverlaysOption) || profile.HasOption(dcmd.ScrubAnnotationsOption) {
+		// the Healthcare API keeps or drops attributes by tag, it cannot reach into pixel data or repeating groups
+		return nil, dcmd.Errorf(dcmd.ENOTIMPLEMENTED, "overlay and annotation options require the local de-identification engine")
+	}
+
+	if profile.HasOption(dcmd.RemoveSlideLabelsOption) || profile.HasOption(dcmd.BlankSlideLabelsOption) || profile.HasOption(dcmd.BlankMacroLabelOption) {
+		// slide label images are recognised by their Image Type, which the Healthcare API cannot act on
+		return nil, dcmd.Errorf(dcmd.ENOTIMPLEMENTED, "slide label options require the local de-identification engine")
+	}
+
+	if profile.Generalisation != nil {
+		// the Healthcare API can only keep, remove or redact attributes, not coarsen their values
+		return nil, dcmd.Errorf(dcmd.ENOTIMPLEMENTED, "generalisation requires the local de-identification engine")
+	}
+
+	if len(profile.Rules) > 0 {
+		// the Healthcare API has no replace or increment actions and applies its own defaults to groups
+		return nil, dcmd.Errorf(dcmd.ENOTIMPLEMENTED, "attribute rules require the local de-identification engine")
+	}
+
+	if profile.HasOption(dcmd.ConvertToUTF8Option) {
+		// the Healthcare API writes text in the character set it was stored in
+		return nil, dcmd.Errorf(dcmd.ENOTIMPLEMENTED, "character set conversion requires the local de-identification engine")
+	}
+
+	if profile.HasOption(dcmd.CleanDescriptorsOption) {
+		// the minimal keep list drops descriptors, tag contents are inspected and redacted instead.
+		// Staff dictionaries and custom patterns only apply to the local engine.
+		config.Dicom.FilterProfile = "DEIDENTIFY_TAG_CONTENTS"
+	}
+
+	if profile.HasOption(dcmd.RetainLongitudinalModifiedDatesOption) {
+		if s.DateShiftCryptoKey == "" {
+			return nil, dcmd.Errorf(dcmd.ENOTIMPLEMENTED, "date shifting by the Healthcare API is not configured")
 		}
-		if op.Error != nil {
-			return fmt.Errorf("deidentify operation error: %v", *op.Error)
+		// the minimal keep list drops dates altogether, tag contents are transformed instead
+		config.Dicom.FilterProfile = "DEIDENTIFY_TAG_CONTENTS"
+		config.Text = &healthcare.TextConfig{
+			Transformations: []*healthcare.InfoTypeTransformation{
+				{
+					InfoTypes: []string{"DATE"},
+					DateShiftConfig: &healthcare.DateShiftConfig{
+						CryptoKey: s.DateShiftCryptoKey,
+					},
+				},
+			},
 		}
-		fmt.Printf("Created de-identified dataset %s from %s\n", resp.Name, sourceName)
-		return nil
 	}
 
+	return config, nil
 }
 
 // ExportDICOMInstance exports DICOM objects to GCS.
@@ -127,8 +259,13 @@ func (s *DicomStoreService) DeidentifyDicomStore(ctx context.Context, sourceDico
 // Write to a Cloud Storage bucket or directory, rather than an object,
 // because the Cloud Healthcare API creates one .dcm file for each DICOM object.
 // If the command specifies a directory that does not exist, the directory is created.
+//
+// Only stores whose latest verification passed are exported, see VerifyDicomStore.
 
-func (s *DicomStoreService) ExportDICOMInstance(dicomStoreID, gcsDestination string) error {
+func (s *DicomStoreService) ExportDICOMInstance(ctx context.Context, dicomStoreID, gcsDestination string) error {
+	if err := s.checkVerified(ctx, dicomStoreID); err != nil {
+		return err
+	}
 
 	storesService := s.GoogleDicomAPI.HealthcareService.Projects.Locations.Datasets.DicomStores
 
@@ -141,7 +278,7 @@ func (s *DicomStoreService) ExportDICOMInstance(dicomStoreID, gcsDestination str
 	datasetPath := s.GoogleDicomAPI.Dataset.Name
 	name := fmt.Sprintf("%s/dicomStores/%s", datasetPath, dicomStoreID)
 
-	lro, err := storesService.Export(name, req).Do()
+	lro, err := storesService.Export(name, req).Context(ctx).Do()
 	if err != nil {
 		return fmt.Errorf("Export: %v", err)
 	}
@@ -174,8 +311,14 @@ func (s *DicomStoreService) ExportDICOMInstance(dicomStoreID, gcsDestination str
 //  - Use ? to match 1 character.
 //   		For example, gs://BUCKET/DIRECTORY/Example?.dcm
 // 						-> matches Example1.dcm but does not match Example.dcm or Example01.dcm.
+//
+// The import is waited for, so the objects can be removed once it returns.
+
+func (s *DicomStoreService) ImportDICOMInstance(ctx context.Context, dicomStoreID, contentURI string) error {
+	if err := recordWrite(ctx, s.VerificationService, dicomStoreID); err != nil {
+		return err
+	}
 
-func (s *DicomStoreService) ImportDICOMInstance(dicomStoreID, contentURI string) error {
 	storesService := s.GoogleDicomAPI.HealthcareService.Projects.Locations.Datasets.DicomStores
 
 	req := &healthcare.ImportDicomDataRequest{
@@ -186,11 +329,36 @@ func (s *DicomStoreService) ImportDICOMInstance(dicomStoreID, contentURI string)
 	datasetPath := s.GoogleDicomAPI.Dataset.Name
 	name := fmt.Sprintf("%s/dicomStores/%s", datasetPath, dicomStoreID)
 
-	lro, err := storesService.Import(name, req).Do()
+	lro, err := storesService.Import(name, req).Context(ctx).Do()
 	if err != nil {
 		return fmt.Errorf("Import: %v", err)
 	}
 
 	fmt.Printf("Import to DICOM store started. Operation: %q\n", lro.Name)
+	if err := s.waitOperation(ctx, lro.Name); err != nil {
+		return fmt.Errorf("import %v", err)
+	}
 	return nil
 }
+
+// waitOperation polls a long-running operation until it is done
+func (s *DicomStoreService) waitOperation(ctx context.Context, name string) error {
+	operationService := s.GoogleDicomAPI.HealthcareService.Projects.Locations.Datasets.Operations
+	for {
+		op, err := operationService.Get(name).Context(ctx).Do()
+		if err != nil {
+			return fmt.Errorf("operationService.Get: %v", err)
+		}
+		if op.Done {
+			if op.Error != nil {
+				return fmt.Errorf("operation error: %v", *op.Error)
+			}
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(1 * time.Second):
+		}
+	}
+}
@@ -0,0 +1,213 @@
+package healthcare_test
+
+import (
+	"context"
+	"testing"
+
+	dcmd "gitlab.com/medical-research/dicom-deidentifier"
+	"gitlab.com/medical-research/dicom-deidentifier/deid"
+	"gitlab.com/medical-research/dicom-deidentifier/dicom"
+	"gitlab.com/medical-research/dicom-deidentifier/healthcare"
+	"gitlab.com/medical-research/dicom-deidentifier/mock"
+)
+
+// Options the Healthcare API cannot apply the way the local engine does are refused before the
+// API is called when no local engine is configured, so no API client is needed.
+func TestDicomStoreService_DeidentifyDicomStore_LocalOnlyOptions(t *testing.T) {
+	tests := []struct {
+		name   string
+		option string
+	}{
+		{name: "date shifting", option: dcmd.RetainLongitudinalModifiedDatesOption},
+		{name: "pseudonyms", option: dcmd.PseudonymizePatientIDOption},
+		{name: "safe private", option: dcmd.RetainSafePrivateOption},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := healthcare.NewDicomStoreService(nil)
+			profile := &dcmd.Profile{Name: "trial", Options: []string{tt.option}}
+			err := s.DeidentifyDicomStore(context.Background(), &dcmd.DicomStore{StoreID: "src"}, &dcmd.DicomStore{StoreID: "dst"}, profile, nil)
+			if dcmd.ErrorCode(err) != dcmd.ENOTIMPLEMENTED {
+				t.Errorf("DeidentifyDicomStore() error = %v, want %s", err, dcmd.ENOTIMPLEMENTED)
+			}
+		})
+	}
+}
+
+// Profiles with options the Healthcare API cannot apply are run instance by instance by the local
+// engine, its findings reaching the report of the job.
+func TestDicomStoreService_DeidentifyDicomStore_LocalEngine(t *testing.T) {
+	api, fake := newTestAPI(t)
+	f := newTestFile("1.2.3.4.5")
+	f.Dataset.Set(dicom.NewElement(dicom.Tag(0x00090010), dicom.LO, "ACME 1.1"))
+	f.Dataset.Set(dicom.NewElement(dicom.Tag(0x00091001), dicom.LO, "MRN0001"))
+	fake.Add(t, "src", f)
+
+	s := healthcare.NewDicomStoreService(api)
+	s.DeidentificationService = newTestEngine(t)
+	profile := &dcmd.Profile{Name: "trial", Options: []string{dcmd.RetainSafePrivateOption}}
+	report := dcmd.NewJobReport()
+	if err := s.DeidentifyDicomStore(context.Background(), &dcmd.DicomStore{StoreID: "src"}, &dcmd.DicomStore{StoreID: "dst"}, profile, report); err != nil {
+		t.Fatalf("DeidentifyDicomStore() error = %v", err)
+	}
+
+	files := fake.Instances(t, "dst")
+	if len(files) != 1 {
+		t.Fatalf("%d instances in the destination, want 1", len(files))
+	}
+	if got := files[0].Dataset.GetString(dicom.PatientName); got != "" {
+		t.Errorf("PatientName = %q, want removed", got)
+	}
+	if got := files[0].Dataset.GetString(dicom.Tag(0x00091001)); got != "" {
+		t.Errorf("private attribute of an unknown creator = %q, want removed", got)
+	}
+	if got := files[0].Dataset.GetString(dicom.PatientIdentityRemoved); got != "YES" {
+		t.Errorf("PatientIdentityRemoved = %q, want YES", got)
+	}
+	if report.Instances != 1 {
+		t.Errorf("Instances = %d, want 1", report.Instances)
+	}
+	if got := report.UnknownPrivateCreators["ACME 1.1"]; got != 1 {
+		t.Errorf("UnknownPrivateCreators[ACME 1.1] = %d, want 1", got)
+	}
+}
+
+// newTestEngine returns a local de-identification engine remapping UIDs with a test key
+func newTestEngine(t *testing.T) *deid.DeidentificationService {
+	remapper, err := deid.NewHashUIDRemapper("1.2.826.0.1.3680043.10.999", []byte("secret"))
+	if err != nil {
+		t.Fatalf("NewHashUIDRemapper() error = %v", err)
+	}
+	return deid.NewDeidentificationService(remapper)
+}
+
+// Generalised quasi-identifiers and aggregated ages are counted in the report of the job
+func TestDicomStoreService_DeidentifyDicomStore_Generalisation(t *testing.T) {
+	api, fake := newTestAPI(t)
+	for uid, age := range map[string]string{"1.2.3.4.5": "042Y", "1.2.3.4.6": "093Y"} {
+		f := newTestFile(uid)
+		f.Dataset.Set(dicom.NewElement(dicom.PatientAge, dicom.AS, age))
+		fake.Add(t, "src", f)
+	}
+
+	s := healthcare.NewDicomStoreService(api)
+	s.DeidentificationService = newTestEngine(t)
+	profile := &dcmd.Profile{Name: "trial", Generalisation: &dcmd.Generalisation{AgeBucket: 5}}
+	report := dcmd.NewJobReport()
+	if err := s.DeidentifyDicomStore(context.Background(), &dcmd.DicomStore{StoreID: "src"}, &dcmd.DicomStore{StoreID: "dst"}, profile, report); err != nil {
+		t.Fatalf("DeidentifyDicomStore() error = %v", err)
+	}
+
+	ages := map[string]bool{}
+	for _, f := range fake.Instances(t, "dst") {
+		ages[f.Dataset.GetString(dicom.PatientAge)] = true
+	}
+	if !ages["040Y"] || !ages["090Y"] {
+		t.Errorf("PatientAge values = %v, want 040Y and 090Y", ages)
+	}
+	if got := report.Generalised["PatientAge"]; got != 2 {
+		t.Errorf("Generalised[PatientAge] = %d, want 2", got)
+	}
+	if report.AggregatedAges != 1 {
+		t.Errorf("AggregatedAges = %d, want 1", report.AggregatedAges)
+	}
+}
+
+// Subject ids of the roster are given by the local engine, patients missing from the roster
+// stopping the job
+func TestDicomStoreService_DeidentifyDicomStore_Roster(t *testing.T) {
+	tests := []struct {
+		name      string
+		patientID string
+		want      string
+		wantCode  string
+	}{
+		{name: "enrolled", patientID: "P1", want: "TRIAL-001"},
+		{name: "not enrolled", patientID: "P2", wantCode: dcmd.EINVALID},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			api, fake := newTestAPI(t)
+			f := newTestFile("1.2.3.4.5")
+			f.Dataset.SetString(dicom.PatientID, tt.patientID)
+			fake.Add(t, "src", f)
+
+			engine := newTestEngine(t)
+			engine.RosterService = &mock.RosterService{
+				FindRosterFn: func(ctx context.Context, project string, version int) (*dcmd.Roster, error) {
+					return &dcmd.Roster{Project: project, Version: 1, Entries: []*dcmd.RosterEntry{{PatientID: "P1", SubjectID: "TRIAL-001"}}}, nil
+				},
+			}
+			s := healthcare.NewDicomStoreService(api)
+			s.DeidentificationService = engine
+			profile := &dcmd.Profile{Name: "trial", Project: "trial", Options: []string{dcmd.RosterSubjectIDsOption}}
+			err := s.DeidentifyDicomStore(context.Background(), &dcmd.DicomStore{StoreID: "src"}, &dcmd.DicomStore{StoreID: "dst"}, profile, dcmd.NewJobReport())
+			if code := dcmd.ErrorCode(err); code != tt.wantCode {
+				t.Fatalf("DeidentifyDicomStore() error = %v, want %q", err, tt.wantCode)
+			}
+
+			files := fake.Instances(t, "dst")
+			if tt.wantCode != "" {
+				if len(files) != 0 {
+					t.Errorf("%d instances in the destination, want none", len(files))
+				}
+				return
+			}
+			if len(files) != 1 {
+				t.Fatalf("%d instances in the destination, want 1", len(files))
+			}
+			if got := files[0].Dataset.GetString(dicom.PatientID); got != tt.want {
+				t.Errorf("PatientID = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+// Attribute rules of the profile are applied by the local engine
+func TestDicomStoreService_DeidentifyDicomStore_Rules(t *testing.T) {
+	api, fake := newTestAPI(t)
+	f := newTestFile("1.2.3.4.5")
+	f.Dataset.Set(dicom.NewElement(dicom.InstitutionName, dicom.LO, "General Hospital"))
+	fake.Add(t, "src", f)
+
+	s := healthcare.NewDicomStoreService(api)
+	s.DeidentificationService = newTestEngine(t)
+	profile := &dcmd.Profile{Name: "trial", Rules: []*dcmd.AttributeRule{
+		{Tag: "InstitutionName", Action: dcmd.RuleReplace, Value: "Site 12"},
+	}}
+	if err := s.DeidentifyDicomStore(context.Background(), &dcmd.DicomStore{StoreID: "src"}, &dcmd.DicomStore{StoreID: "dst"}, profile, dcmd.NewJobReport()); err != nil {
+		t.Fatalf("DeidentifyDicomStore() error = %v", err)
+	}
+
+	files := fake.Instances(t, "dst")
+	if len(files) != 1 {
+		t.Fatalf("%d instances in the destination, want 1", len(files))
+	}
+	if got := files[0].Dataset.GetString(dicom.InstitutionName); got != "Site 12" {
+		t.Errorf("InstitutionName = %q, want %q", got, "Site 12")
+	}
+}
+
+// Dates are shifted by the Healthcare API when it is given a key of its own
+func TestDicomStoreService_DeidentifyDicomStore_DateShift(t *testing.T) {
+	api, fake := newTestAPI(t)
+	fake.Add(t, "src", newTestFile("1.2.3.4.5"))
+
+	s := healthcare.NewDicomStoreService(api)
+	s.DateShiftCryptoKey = "AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA="
+	profile := &dcmd.Profile{Name: "trial", Options: []string{dcmd.RetainLongitudinalModifiedDatesOption}}
+	if err := s.DeidentifyDicomStore(context.Background(), &dcmd.DicomStore{StoreID: "src"}, &dcmd.DicomStore{StoreID: "dst"}, profile, nil); err != nil {
+		t.Fatalf("DeidentifyDicomStore() error = %v", err)
+	}
+
+	if fake.Config == nil || fake.Config.Text == nil || len(fake.Config.Text.Transformations) != 1 {
+		t.Fatalf("config = %+v, want a date transformation", fake.Config)
+	}
+	shift := fake.Config.Text.Transformations[0].DateShiftConfig
+	if shift == nil || shift.CryptoKey != s.DateShiftCryptoKey {
+		t.Errorf("DateShiftConfig = %+v, want the configured key", shift)
+	}
+	if got := fake.Config.Dicom.FilterProfile; got != "DEIDENTIFY_TAG_CONTENTS" {
+		t.Errorf("FilterProfile = %q, want DEIDENTIFY_TAG_CONTENTS", got)
+	}
+}
@@ -3,9 +3,12 @@ package healthcare
 import (
 	"context"
 	"fmt"
+	"net/http"
 
 	dcmd "gitlab.com/medical-research/dicom-deidentifier"
+	"golang.org/x/oauth2/google"
 	"google.golang.org/api/healthcare/v1"
+	"google.golang.org/api/option"
 )
 
 // constants and defaults
@@ -20,6 +23,10 @@ type GoogleDicomAPI struct {
 	HealthcareService *healthcare.Service
 	StoreService      *healthcare.ProjectsLocationsDatasetsDicomStoresService
 	Dataset           *healthcare.Dataset
+
+	// HTTPClient is the authenticated client used by the HealthcareService. DICOMweb requests
+	// the generated client cannot express, such as paged searches, are sent with it directly.
+	HTTPClient *http.Client
 }
 
 // NewDicomAPI returns a new instance of DicomAPI
@@ -31,7 +38,12 @@ func NewDicomAPI(ctx context.Context) (*GoogleDicomAPI, error) {
 
 	datasetName := fmt.Sprintf("projects/%s/locations/%s/datasets/%s", p, l, d)
 
-	healthcareService, err := healthcare.NewService(ctx)
+	httpClient, err := google.DefaultClient(ctx, healthcare.CloudPlatformScope)
+	if err != nil {
+		return nil, fmt.Errorf("google.DefaultClient: %v", err)
+	}
+
+	healthcareService, err := healthcare.NewService(ctx, option.WithHTTPClient(httpClient))
 	if err != nil {
 		return nil, fmt.Errorf("healthcare.NewService: %v", err)
 	}
@@ -41,6 +53,7 @@ func NewDicomAPI(ctx context.Context) (*GoogleDicomAPI, error) {
 	dicomAPI := &GoogleDicomAPI{
 		HealthcareService: healthcareService,
 		StoreService:      dicomStoreService,
+		HTTPClient:        httpClient,
 
 		Dataset: &healthcare.Dataset{
 			Name: datasetName,
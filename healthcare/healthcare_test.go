@@ -0,0 +1,193 @@
+package healthcare_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"gitlab.com/medical-research/dicom-deidentifier/dicom"
+	"gitlab.com/medical-research/dicom-deidentifier/healthcare"
+	healthcareapi "google.golang.org/api/healthcare/v1"
+	"google.golang.org/api/option"
+)
+
+// testDataset is the dataset of the fake Healthcare API
+const testDataset = "projects/p/locations/l/datasets/d"
+
+// fakeAPI is an in-memory Healthcare API holding instances per store. De-identification copies the
+// source store to the destination unchanged, as scanning and stamping is what is tested.
+type fakeAPI struct {
+	mu     sync.Mutex
+	stores map[string]map[string][]byte
+
+	// FailStores is the number of stores to refuse before accepting them again
+	FailStores int
+
+	// Config is the config of the last de-identification requested
+	Config *healthcareapi.DeidentifyConfig
+}
+
+// newTestAPI returns a GoogleDicomAPI backed by a fake
+func newTestAPI(t *testing.T) (*healthcare.GoogleDicomAPI, *fakeAPI) {
+	fake := &fakeAPI{stores: map[string]map[string][]byte{}}
+	srv := httptest.NewServer(fake)
+	t.Cleanup(srv.Close)
+
+	service, err := healthcareapi.NewService(context.Background(), option.WithEndpoint(srv.URL+"/"), option.WithHTTPClient(srv.Client()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &healthcare.GoogleDicomAPI{
+		HealthcareService: service,
+		StoreService:      service.Projects.Locations.Datasets.DicomStores,
+		Dataset:           &healthcareapi.Dataset{Name: testDataset},
+		HTTPClient:        srv.Client(),
+	}, fake
+}
+
+// Add stores an instance in a store of the fake
+func (f *fakeAPI) Add(t *testing.T, store string, file *dicom.File) {
+	var buf bytes.Buffer
+	if err := dicom.Write(&buf, file); err != nil {
+		t.Fatal(err)
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.put(store, buf.Bytes())
+}
+
+// Remove removes every instance of a store of the fake
+func (f *fakeAPI) Remove(store string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	delete(f.stores, store)
+}
+
+// Instances returns the instances of a store of the fake
+func (f *fakeAPI) Instances(t *testing.T, store string) []*dicom.File {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	var files []*dicom.File
+	for _, data := range f.stores[store] {
+		file, err := dicom.Read(bytes.NewReader(data))
+		if err != nil {
+			t.Fatal(err)
+		}
+		files = append(files, file)
+	}
+	return files
+}
+
+// put stores the encoding of an instance by SOP Instance UID, returning false if it already exists
+func (f *fakeAPI) put(store string, data []byte) bool {
+	file, err := dicom.Read(bytes.NewReader(data))
+	if err != nil {
+		return false
+	}
+	uid := file.Dataset.GetString(dicom.SOPInstanceUID)
+	if f.stores[store] == nil {
+		f.stores[store] = map[string][]byte{}
+	}
+	if _, ok := f.stores[store][uid]; ok {
+		return false
+	}
+	f.stores[store][uid] = data
+	return true
+}
+
+func (f *fakeAPI) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	path := strings.TrimPrefix(r.URL.Path, "/v1/"+testDataset+"/")
+	switch {
+	case strings.HasPrefix(path, "operations/"):
+		json.NewEncoder(w).Encode(map[string]interface{}{"name": path, "done": true})
+
+	case strings.HasSuffix(path, ":deidentify"):
+		var req healthcareapi.DeidentifyDicomStoreRequest
+		json.NewDecoder(r.Body).Decode(&req)
+		f.Config = req.Config
+		source := strings.TrimSuffix(strings.TrimPrefix(path, "dicomStores/"), ":deidentify")
+		destination := strings.TrimPrefix(req.DestinationStore, testDataset+"/dicomStores/")
+		for _, data := range f.stores[source] {
+			f.put(destination, data)
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{"name": testDataset + "/operations/1"})
+
+	case strings.HasSuffix(path, ":export") || strings.HasSuffix(path, ":import"):
+		json.NewEncoder(w).Encode(map[string]interface{}{"name": testDataset + "/operations/2"})
+
+	case strings.HasSuffix(path, "/dicomWeb/instances"):
+		store := strings.TrimSuffix(strings.TrimPrefix(path, "dicomStores/"), "/dicomWeb/instances")
+		var page []map[string]interface{}
+		if r.URL.Query().Get("offset") == "0" {
+			for _, data := range f.stores[store] {
+				file, _ := dicom.Read(bytes.NewReader(data))
+				attrs := map[string]interface{}{}
+				for tag, key := range map[dicom.Tag]string{dicom.StudyInstanceUID: "0020000D", dicom.SeriesInstanceUID: "0020000E", dicom.SOPInstanceUID: "00080018"} {
+					attrs[key] = map[string]interface{}{"vr": "UI", "Value": []string{file.Dataset.GetString(tag)}}
+				}
+				page = append(page, attrs)
+			}
+		}
+		json.NewEncoder(w).Encode(page)
+
+	case strings.HasSuffix(path, "/dicomWeb/studies") && r.Method == "POST":
+		store := strings.TrimSuffix(strings.TrimPrefix(path, "dicomStores/"), "/dicomWeb/studies")
+		data, _ := ioutil.ReadAll(r.Body)
+		if f.FailStores > 0 {
+			f.FailStores--
+			http.Error(w, "store refused", http.StatusInternalServerError)
+			return
+		}
+		if !f.put(store, data) {
+			http.Error(w, "instance exists", http.StatusConflict)
+			return
+		}
+		w.Write([]byte("{}"))
+
+	case strings.Contains(path, "/dicomWeb/studies/"):
+		parts := strings.Split(path, "/")
+		store, uid := parts[1], parts[len(parts)-1]
+		data, ok := f.stores[store][uid]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		if r.Method == "DELETE" {
+			delete(f.stores[store], uid)
+			w.Write([]byte("{}"))
+			return
+		}
+		w.Write(data)
+
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+// newTestFile returns an instance of patient P1 with the given SOP Instance UID
+func newTestFile(uid string) *dicom.File {
+	return &dicom.File{
+		Meta: dicom.NewDataset(
+			dicom.NewElement(dicom.MediaStorageSOPClassUID, dicom.UI, "1.2.840.10008.5.1.4.1.1.7"),
+			dicom.NewElement(dicom.MediaStorageSOPInstanceUID, dicom.UI, uid),
+			dicom.NewElement(dicom.TransferSyntaxUID, dicom.UI, dicom.ExplicitVRLittleEndian),
+		),
+		Dataset: dicom.NewDataset(
+			dicom.NewElement(dicom.SOPClassUID, dicom.UI, "1.2.840.10008.5.1.4.1.1.7"),
+			dicom.NewElement(dicom.SOPInstanceUID, dicom.UI, uid),
+			dicom.NewElement(dicom.PatientName, dicom.PN, "Doe^John"),
+			dicom.NewElement(dicom.PatientID, dicom.LO, "P1"),
+			dicom.NewElement(dicom.StudyInstanceUID, dicom.UI, "1.2.3"),
+			dicom.NewElement(dicom.SeriesInstanceUID, dicom.UI, "1.2.3.4"),
+		),
+	}
+}
@@ -0,0 +1,35 @@
+package healthcare_test
+
+import (
+	"context"
+	"testing"
+
+	dcmd "gitlab.com/medical-research/dicom-deidentifier"
+	"gitlab.com/medical-research/dicom-deidentifier/filestore"
+	"gitlab.com/medical-research/dicom-deidentifier/healthcare"
+)
+
+func TestDicomStoreService_VerifyDicomStore_KAnonymity(t *testing.T) {
+	api, fake := newTestAPI(t)
+	fake.Add(t, "dst", newTestFile("2.25.1"))
+	fake.Add(t, "dst", newTestFile("2.25.2"))
+	db := filestore.NewDB(t.TempDir())
+	if err := db.Open(); err != nil {
+		t.Fatal(err)
+	}
+
+	s := healthcare.NewDicomStoreService(api)
+	s.VerificationService = filestore.NewVerificationService(db)
+	report := dcmd.NewJobReport()
+	profile := &dcmd.Profile{Name: "trial", KAnonymity: &dcmd.KAnonymity{K: 2}}
+	if err := s.VerifyDicomStore(context.Background(), &dcmd.DicomStore{StoreID: "src"}, &dcmd.DicomStore{StoreID: "dst"}, profile, report); err != nil {
+		t.Fatalf("VerifyDicomStore() error = %v", err)
+	}
+	if report.KAnonymity == nil {
+		t.Fatal("no k-anonymity report attached")
+	}
+	// both instances belong to the same study of a single patient
+	if report.KAnonymity.Records != 1 || len(report.KAnonymity.AtRisk) != 1 {
+		t.Errorf("Records = %d, AtRisk = %d, want 1, 1", report.KAnonymity.Records, len(report.KAnonymity.AtRisk))
+	}
+}
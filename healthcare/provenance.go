@@ -0,0 +1,193 @@
+package healthcare
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+
+	dcmd "gitlab.com/medical-research/dicom-deidentifier"
+	"gitlab.com/medical-research/dicom-deidentifier/deid"
+	"gitlab.com/medical-research/dicom-deidentifier/dicom"
+)
+
+// searchPageSize is the number of instances requested per DICOMweb search
+const searchPageSize = 1000
+
+// instanceRef identifies a single instance of a dicom store
+type instanceRef struct {
+	StudyInstanceUID  string
+	SeriesInstanceUID string
+	SOPInstanceUID    string
+}
+
+// dicomWebPath returns the DICOMweb path of the instance
+func (ref *instanceRef) dicomWebPath() string {
+	return fmt.Sprintf("studies/%s/series/%s/instances/%s", ref.StudyInstanceUID, ref.SeriesInstanceUID, ref.SOPInstanceUID)
+}
+
+// stampProvenance records the profile used in every instance of a store de-identified by the Healthcare API.
+// The API cannot edit instances in place, so each instance is retrieved, stamped, deleted and stored again.
+func (s *DicomStoreService) stampProvenance(ctx context.Context, dicomStore *dcmd.DicomStore, profile *dcmd.Profile, method string) error {
+	parent := fmt.Sprintf("%s/dicomStores/%s", s.GoogleDicomAPI.Dataset.Name, dicomStore.StoreID)
+
+	// every instance is listed before any is stored again, as storing changes the search order
+	refs, err := s.searchInstances(ctx, parent)
+	if err != nil {
+		return err
+	}
+	for _, ref := range refs {
+		if err := s.stampInstance(ctx, parent, ref, profile, method); err != nil {
+			return fmt.Errorf("%s: %v", ref.dicomWebPath(), err)
+		}
+	}
+	return nil
+}
+
+// searchInstances lists every instance of a store, page by page
+func (s *DicomStoreService) searchInstances(ctx context.Context, parent string) ([]*instanceRef, error) {
+	var refs []*instanceRef
+	for offset := 0; ; offset += searchPageSize {
+		url := fmt.Sprintf("%sv1/%s/dicomWeb/instances?limit=%d&offset=%d",
+			s.GoogleDicomAPI.HealthcareService.BasePath, parent, searchPageSize, offset)
+		req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("Accept", "application/dicom+json")
+
+		body, err := s.do(req)
+		if err != nil {
+			return nil, fmt.Errorf("SearchForInstances: %v", err)
+		}
+
+		var page []map[string]struct {
+			Value []string `json:"Value"`
+		}
+		if len(body) > 0 {
+			if err := json.Unmarshal(body, &page); err != nil {
+				return nil, fmt.Errorf("SearchForInstances: %v", err)
+			}
+		}
+		for _, attrs := range page {
+			ref := &instanceRef{}
+			for tag, v := range map[string]*string{
+				"0020000D": &ref.StudyInstanceUID,
+				"0020000E": &ref.SeriesInstanceUID,
+				"00080018": &ref.SOPInstanceUID,
+			} {
+				if values := attrs[tag].Value; len(values) > 0 {
+					*v = values[0]
+				}
+			}
+			refs = append(refs, ref)
+		}
+
+		if len(page) < searchPageSize {
+			return refs, nil
+		}
+	}
+}
+
+// stampInstance records the profile used in a single instance and replaces it in the store.
+// An instance cannot be stored over itself, so the original is deleted first and stored back
+// when the stamped copy is refused, the store never losing the instance.
+func (s *DicomStoreService) stampInstance(ctx context.Context, parent string, ref *instanceRef, profile *dcmd.Profile, method string) error {
+	instances := s.GoogleDicomAPI.StoreService.Studies.Series.Instances
+
+	original, err := s.retrieveInstanceData(ctx, parent, ref)
+	if err != nil {
+		return err
+	}
+	f, err := dicom.Read(bytes.NewReader(original))
+	if err != nil {
+		return fmt.Errorf("could not read instance: %v", err)
+	}
+	deid.StampProvenance(f.Dataset, profile, method)
+
+	var buf bytes.Buffer
+	if err := dicom.Write(&buf, f); err != nil {
+		return fmt.Errorf("could not encode instance: %v", err)
+	}
+
+	if _, err := instances.Delete(parent, ref.dicomWebPath()).Context(ctx).Do(); err != nil {
+		return fmt.Errorf("DeleteInstance: %v", err)
+	}
+
+	if err := s.storeInstance(ctx, parent, buf.Bytes()); err != nil {
+		if restoreErr := s.storeInstance(ctx, parent, original); restoreErr != nil {
+			return fmt.Errorf("%v, the original instance could not be restored: %v", err, restoreErr)
+		}
+		return err
+	}
+	return nil
+}
+
+// storeInstance stores the Part 10 encoding of a single instance
+func (s *DicomStoreService) storeInstance(ctx context.Context, parent string, data []byte) error {
+	store := s.GoogleDicomAPI.StoreService.StoreInstances(parent, "studies", bytes.NewReader(data))
+	store.Header().Set("Content-Type", "application/dicom")
+	resp, err := store.Context(ctx).Do()
+	if err != nil {
+		return fmt.Errorf("StoreInstances: %v", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode > 299 {
+		b, _ := ioutil.ReadAll(resp.Body)
+		return fmt.Errorf("StoreInstances: status %d %s: %s", resp.StatusCode, resp.Status, b)
+	}
+	return nil
+}
+
+// retrieveInstance retrieves a single instance of a store in its stored transfer syntax
+func (s *DicomStoreService) retrieveInstance(ctx context.Context, parent string, ref *instanceRef) (*dicom.File, error) {
+	data, err := s.retrieveInstanceData(ctx, parent, ref)
+	if err != nil {
+		return nil, err
+	}
+	f, err := dicom.Read(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("could not read instance: %v", err)
+	}
+	return f, nil
+}
+
+// retrieveInstanceData retrieves the Part 10 encoding of a single instance as it is stored
+func (s *DicomStoreService) retrieveInstanceData(ctx context.Context, parent string, ref *instanceRef) ([]byte, error) {
+	call := s.GoogleDicomAPI.StoreService.Studies.Series.Instances.RetrieveInstance(parent, ref.dicomWebPath())
+	call.Header().Set("Accept", "application/dicom; transfer-syntax=*")
+	resp, err := call.Context(ctx).Do()
+	if err != nil {
+		return nil, fmt.Errorf("RetrieveInstance: %v", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode > 299 {
+		return nil, fmt.Errorf("RetrieveInstance: status %d %s", resp.StatusCode, resp.Status)
+	}
+
+	data, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("could not read instance: %v", err)
+	}
+	return data, nil
+}
+
+// do sends a request with the authenticated client and returns the response body
+func (s *DicomStoreService) do(req *http.Request) ([]byte, error) {
+	resp, err := s.GoogleDicomAPI.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("could not read response: %v", err)
+	}
+	if resp.StatusCode > 299 {
+		return nil, fmt.Errorf("status %d %s: %s", resp.StatusCode, resp.Status, body)
+	}
+	return body, nil
+}
@@ -0,0 +1,54 @@
+package healthcare_test
+
+import (
+	"context"
+	"testing"
+
+	dcmd "gitlab.com/medical-research/dicom-deidentifier"
+	"gitlab.com/medical-research/dicom-deidentifier/dicom"
+	"gitlab.com/medical-research/dicom-deidentifier/healthcare"
+)
+
+func TestDicomStoreService_DeidentifyDicomStore_Provenance(t *testing.T) {
+	tests := []struct {
+		name       string
+		failStores int
+		wantErr    bool
+		stamped    bool
+	}{
+		{name: "stamped", stamped: true},
+		{name: "failed store restores the original", failStores: 1, wantErr: true},
+		{name: "failed restore", failStores: 2, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			api, fake := newTestAPI(t)
+			fake.Add(t, "src", newTestFile("1.2.3.4.5"))
+			fake.FailStores = tt.failStores
+
+			s := healthcare.NewDicomStoreService(api)
+			profile := &dcmd.Profile{Name: "trial"}
+			err := s.DeidentifyDicomStore(context.Background(), &dcmd.DicomStore{StoreID: "src"}, &dcmd.DicomStore{StoreID: "dst"}, profile, nil)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("DeidentifyDicomStore() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			files := fake.Instances(t, "dst")
+			if tt.failStores > 1 {
+				// nothing is left to keep once the restore itself is refused
+				return
+			}
+			if len(files) != 1 {
+				t.Fatalf("%d instances in the destination, want 1", len(files))
+			}
+			stamped := files[0].Dataset.GetString(dicom.PatientIdentityRemoved) == "YES"
+			if stamped != tt.stamped {
+				t.Errorf("stamped = %v, want %v", stamped, tt.stamped)
+			}
+			// the codes of CID 7050 stand for the local engine, the Healthcare API is only described
+			if files[0].Dataset.Get(dicom.DeidentificationMethodCodeSequence) != nil {
+				t.Errorf("DeidentificationMethodCodeSequence recorded for the Healthcare API")
+			}
+		})
+	}
+}
@@ -0,0 +1,128 @@
+package healthcare
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	dcmd "gitlab.com/medical-research/dicom-deidentifier"
+	"gitlab.com/medical-research/dicom-deidentifier/deid"
+	"gitlab.com/medical-research/dicom-deidentifier/dicom"
+)
+
+// tagContentsVRs are the value representations whose contents the Healthcare API inspects and
+// redacts when its filter profile is DEIDENTIFY_TAG_CONTENTS, the attributes themselves being kept
+var tagContentsVRs = map[string]bool{
+	dicom.AE: true, dicom.LO: true, dicom.LT: true, dicom.PN: true, dicom.SH: true, dicom.ST: true,
+	dicom.UC: true, dicom.UT: true, dicom.DA: true, dicom.DT: true, dicom.AS: true,
+}
+
+// VerifyDicomStore scans every de-identified instance of the destination store for identifiers of
+// the source store left behind by the profile, and records the outcome. The destination cannot be
+// exported while critical findings remain, a later verification without any lifting the block.
+// The equivalence classes of the destination are computed in the same pass, each instance only
+// being retrieved once.
+func (s *DicomStoreService) VerifyDicomStore(ctx context.Context, sourceDicomStore, destinationDicomStore *dcmd.DicomStore, profile *dcmd.Profile, report *dcmd.JobReport) error {
+	if s.VerificationService == nil {
+		return dcmd.Errorf(dcmd.EINTERNAL, "store verifications cannot be recorded")
+	}
+	assessor, err := deid.NewKAnonymityAssessor(profile.KAnonymity)
+	if err != nil {
+		return err
+	}
+
+	source := deid.NewSourceIdentifiers()
+	sourceParent := fmt.Sprintf("%s/dicomStores/%s", s.GoogleDicomAPI.Dataset.Name, sourceDicomStore.StoreID)
+	refs, err := s.searchInstances(ctx, sourceParent)
+	if err != nil {
+		return err
+	}
+	for _, ref := range refs {
+		f, err := s.retrieveInstance(ctx, sourceParent, ref)
+		if err != nil {
+			return fmt.Errorf("%s: %v", ref.dicomWebPath(), err)
+		}
+		source.Add(f.Dataset)
+	}
+
+	// the Healthcare API removes every private attribute, only the local engine retains safe ones
+	var scanner *deid.ResidualPHIScanner
+	if config, err := s.deidentifyConfig(profile); err != nil {
+		scanner = deid.NewResidualPHIScanner(profile, source, s.SafePrivateDictionary)
+	} else {
+		scanner = deid.NewResidualPHIScanner(profile, source, nil)
+		if config.Dicom.FilterProfile == "DEIDENTIFY_TAG_CONTENTS" {
+			scanner.RedactedVRs = tagContentsVRs
+		}
+	}
+	destinationParent := fmt.Sprintf("%s/dicomStores/%s", s.GoogleDicomAPI.Dataset.Name, destinationDicomStore.StoreID)
+	if refs, err = s.searchInstances(ctx, destinationParent); err != nil {
+		return err
+	}
+	critical := 0
+	for _, ref := range refs {
+		f, err := s.retrieveInstance(ctx, destinationParent, ref)
+		if err != nil {
+			return fmt.Errorf("%s: %v", ref.dicomWebPath(), err)
+		}
+		findings := scanner.Scan(f.Dataset)
+		for _, finding := range findings {
+			if finding.Severity == dcmd.SeverityCritical {
+				critical++
+			}
+		}
+		report.AddPHIFindings(findings...)
+		assessor.Add(f.Dataset)
+	}
+	report.SetKAnonymity(assessor.Report())
+
+	verification := &dcmd.StoreVerification{
+		StoreID:          destinationDicomStore.StoreID,
+		Verified:         true,
+		CriticalFindings: critical,
+		CreatedAt:        time.Now(),
+	}
+	if err := s.VerificationService.CreateStoreVerification(ctx, verification); err != nil {
+		return err
+	}
+	if !verification.Passed() {
+		return dcmd.ErrResidualPHI
+	}
+	return nil
+}
+
+// recordWrite records a store as unverified before instances are written to it, so a verification
+// made before the write no longer allows the store to be exported
+func recordWrite(ctx context.Context, verifications dcmd.VerificationService, storeID string) error {
+	if verifications == nil {
+		return nil
+	}
+	verification, err := verifications.FindStoreVerification(ctx, storeID)
+	if dcmd.ErrorCode(err) == dcmd.ENOTFOUND {
+		// a store never verified cannot be exported anyway
+		return nil
+	} else if err != nil {
+		return err
+	} else if !verification.Verified {
+		return nil
+	}
+	return verifications.CreateStoreVerification(ctx, &dcmd.StoreVerification{StoreID: storeID, CreatedAt: time.Now()})
+}
+
+// checkVerified returns an error unless the latest verification of a store passed
+func (s *DicomStoreService) checkVerified(ctx context.Context, storeID string) error {
+	if s.VerificationService == nil {
+		return dcmd.ErrUnverifiedStore
+	}
+	verification, err := s.VerificationService.FindStoreVerification(ctx, storeID)
+	if dcmd.ErrorCode(err) == dcmd.ENOTFOUND {
+		return dcmd.ErrUnverifiedStore
+	} else if err != nil {
+		return err
+	} else if !verification.Verified {
+		return dcmd.ErrUnverifiedStore
+	} else if !verification.Passed() {
+		return dcmd.ErrResidualPHI
+	}
+	return nil
+}
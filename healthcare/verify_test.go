@@ -0,0 +1,146 @@
+package healthcare_test
+
+import (
+	"context"
+	"testing"
+
+	dcmd "gitlab.com/medical-research/dicom-deidentifier"
+	"gitlab.com/medical-research/dicom-deidentifier/dicom"
+	"gitlab.com/medical-research/dicom-deidentifier/filestore"
+	"gitlab.com/medical-research/dicom-deidentifier/healthcare"
+)
+
+func TestDicomStoreService_ExportDICOMInstance_Verification(t *testing.T) {
+	ctx := context.Background()
+	api, fake := newTestAPI(t)
+	db := filestore.NewDB(t.TempDir())
+	if err := db.Open(); err != nil {
+		t.Fatal(err)
+	}
+	s := healthcare.NewDicomStoreService(api)
+	s.VerificationService = filestore.NewVerificationService(db)
+
+	source, destination := &dcmd.DicomStore{StoreID: "src"}, &dcmd.DicomStore{StoreID: "dst"}
+	profile := &dcmd.Profile{Name: "trial"}
+	fake.Add(t, "src", newTestFile("1.2.3.4.5"))
+
+	if err := s.ExportDICOMInstance(ctx, "dst", "gs://bucket/"); err != dcmd.ErrUnverifiedStore {
+		t.Fatalf("ExportDICOMInstance() before verification error = %v, want ErrUnverifiedStore", err)
+	}
+
+	// the fake copies instances unchanged, leaving the patient name behind
+	if err := s.DeidentifyDicomStore(ctx, source, destination, profile, nil); err != nil {
+		t.Fatalf("DeidentifyDicomStore() error = %v", err)
+	}
+	report := dcmd.NewJobReport()
+	if err := s.VerifyDicomStore(ctx, source, destination, profile, report); err != dcmd.ErrResidualPHI {
+		t.Fatalf("VerifyDicomStore() error = %v, want ErrResidualPHI", err)
+	}
+	if report.CriticalPHIFindings() == 0 {
+		t.Errorf("no critical findings reported")
+	}
+	if err := s.ExportDICOMInstance(ctx, "dst", "gs://bucket/"); err != dcmd.ErrResidualPHI {
+		t.Fatalf("ExportDICOMInstance() after failed verification error = %v, want ErrResidualPHI", err)
+	}
+
+	// a verification of a clean store on record allows the export
+	fake.Remove("dst")
+	clean := newTestFile("2.25.1")
+	clean.Dataset.Remove(dicom.PatientName)
+	clean.Dataset.Remove(dicom.PatientID)
+	clean.Dataset.SetString(dicom.StudyInstanceUID, "2.25.2")
+	clean.Dataset.SetString(dicom.SeriesInstanceUID, "2.25.3")
+	fake.Add(t, "dst", clean)
+	if err := s.VerifyDicomStore(ctx, source, destination, profile, dcmd.NewJobReport()); err != nil {
+		t.Fatalf("VerifyDicomStore() error = %v", err)
+	}
+	if err := s.ExportDICOMInstance(ctx, "dst", "gs://bucket/"); err != nil {
+		t.Fatalf("ExportDICOMInstance() after verification error = %v", err)
+	}
+
+	// writing to the store again requires another verification
+	if err := s.ImportDICOMInstance(ctx, "dst", "gs://bucket/**"); err != nil {
+		t.Fatalf("ImportDICOMInstance() error = %v", err)
+	}
+	if err := s.ExportDICOMInstance(ctx, "dst", "gs://bucket/"); err != dcmd.ErrUnverifiedStore {
+		t.Fatalf("ExportDICOMInstance() after import error = %v, want ErrUnverifiedStore", err)
+	}
+}
+
+// Inspecting tag contents keeps attributes the Basic Profile removes, only their identifiers being
+// redacted, which is not a finding for profiles the Healthcare API applies that way
+func TestDicomStoreService_VerifyDicomStore_TagContents(t *testing.T) {
+	tests := []struct {
+		name    string
+		profile *dcmd.Profile
+		wantErr error
+	}{
+		{name: "tag contents inspected", profile: &dcmd.Profile{Name: "clean", Options: []string{dcmd.CleanDescriptorsOption}}},
+		{name: "minimal keep list", profile: &dcmd.Profile{Name: "basic"}, wantErr: dcmd.ErrResidualPHI},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			api, fake := newTestAPI(t)
+			db := filestore.NewDB(t.TempDir())
+			if err := db.Open(); err != nil {
+				t.Fatal(err)
+			}
+			s := healthcare.NewDicomStoreService(api)
+			s.VerificationService = filestore.NewVerificationService(db)
+
+			original := newTestFile("1.2.3.4.5")
+			original.Dataset.Set(dicom.NewElement(dicom.InstitutionName, dicom.LO, "General Hospital"))
+			original.Dataset.Set(dicom.NewElement(dicom.Tag(0x00080081), dicom.ST, "1 High Street"))
+			fake.Add(t, "src", original)
+
+			redacted := newTestFile("2.25.1")
+			redacted.Dataset.SetString(dicom.StudyInstanceUID, "2.25.2")
+			redacted.Dataset.SetString(dicom.SeriesInstanceUID, "2.25.3")
+			redacted.Dataset.SetString(dicom.PatientName, "[PERSON_NAME]")
+			redacted.Dataset.SetString(dicom.PatientID, "[MEDICAL_RECORD_NUMBER]")
+			redacted.Dataset.Set(dicom.NewElement(dicom.InstitutionName, dicom.LO, "[LOCATION]"))
+			redacted.Dataset.Set(dicom.NewElement(dicom.Tag(0x00080081), dicom.ST, "[STREET_ADDRESS]")) // Institution Address
+			fake.Add(t, "dst", redacted)
+
+			report := dcmd.NewJobReport()
+			err := s.VerifyDicomStore(ctx, &dcmd.DicomStore{StoreID: "src"}, &dcmd.DicomStore{StoreID: "dst"}, tt.profile, report)
+			if err != tt.wantErr {
+				t.Fatalf("VerifyDicomStore() error = %v, want %v, findings %v", err, tt.wantErr, report.ResidualPHI)
+			}
+		})
+	}
+}
+
+// Safe private attributes retained by the local engine pass the verification of its output
+func TestDicomStoreService_VerifyDicomStore_LocalEngine(t *testing.T) {
+	ctx := context.Background()
+	api, fake := newTestAPI(t)
+	db := filestore.NewDB(t.TempDir())
+	if err := db.Open(); err != nil {
+		t.Fatal(err)
+	}
+	engine := newTestEngine(t)
+	s := healthcare.NewDicomStoreService(api)
+	s.VerificationService = filestore.NewVerificationService(db)
+	s.DeidentificationService = engine
+	s.SafePrivateDictionary = engine.SafePrivateDictionary
+
+	f := newTestFile("1.2.3.4.5")
+	f.Dataset.Set(dicom.NewElement(dicom.Tag(0x00190010), dicom.LO, "SIEMENS MR HEADER"))
+	f.Dataset.Set(dicom.NewElement(dicom.Tag(0x0019100C), dicom.IS, "1000"))
+	fake.Add(t, "src", f)
+
+	source, destination := &dcmd.DicomStore{StoreID: "src"}, &dcmd.DicomStore{StoreID: "dst"}
+	profile := &dcmd.Profile{Name: "trial", Options: []string{dcmd.RetainSafePrivateOption}}
+	report := dcmd.NewJobReport()
+	if err := s.DeidentifyDicomStore(ctx, source, destination, profile, report); err != nil {
+		t.Fatalf("DeidentifyDicomStore() error = %v", err)
+	}
+	if got := fake.Instances(t, "dst")[0].Dataset.GetString(dicom.Tag(0x0019100C)); got != "1000" {
+		t.Fatalf("safe private attribute = %q, want 1000", got)
+	}
+	if err := s.VerifyDicomStore(ctx, source, destination, profile, report); err != nil {
+		t.Fatalf("VerifyDicomStore() error = %v, findings %v", err, report.ResidualPHI)
+	}
+}
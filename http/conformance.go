@@ -0,0 +1,109 @@
+package http
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gorilla/mux"
+	dcmd "gitlab.com/medical-research/dicom-deidentifier"
+	"gitlab.com/medical-research/dicom-deidentifier/conformance"
+)
+
+// handleValidateInstance handles the "POST /metadata/validate?name={name}" route.
+// The body is a DICOM Part 10 file, the response is the report of its conformance. Files that
+// cannot be parsed are reported with a critical issue rather than as an error.
+func (s *Server) handleValidateInstance(w http.ResponseWriter, r *http.Request) {
+	report, err := conformance.Validate(r.URL.Query().Get("name"), http.MaxBytesReader(w, r.Body, maxMetadataInstanceSize))
+	if err != nil {
+		Error(w, r, dcmd.Errorf(dcmd.EINVALID, "%v", err))
+		return
+	}
+
+	WriteJSONResponse(w, report, http.StatusOK)
+}
+
+// handleValidateBucket handles the "GET /buckets/{bucket}/validate?prefix={prefix}" route.
+// The response counts the objects under the prefix and reports those rejected or flagged.
+func (s *Server) handleValidateBucket(w http.ResponseWriter, r *http.Request) {
+	bucket := &dcmd.CloudStorageBucket{Name: mux.Vars(r)["bucket"]}
+	validation, err := s.CloudStorageService.ValidateBucket(r.Context(), bucket, r.URL.Query().Get("prefix"))
+	if err != nil {
+		Error(w, r, err)
+		return
+	}
+
+	WriteJSONResponse(w, validation, http.StatusOK)
+}
+
+// importBatchSize is the number of objects imported by a single import into a store
+const importBatchSize = 1000
+
+// importStagingPrefix holds the copies of the objects of the imports in progress
+const importStagingPrefix = ".dicom-import/"
+
+// handleImportBucket handles the "POST /dicom-stores/{store}/import?bucket={bucket}&prefix={prefix}&strict={bool}" route.
+// The objects under the prefix are validated, then those not rejected are imported into the store
+// in batches, each batch being copied under a staging prefix of the bucket and imported as a whole.
+// With strict set, any rejected object blocks the whole import. The response is the validation,
+// with a 409 status when nothing was imported.
+func (s *Server) handleImportBucket(w http.ResponseWriter, r *http.Request) {
+	store := mux.Vars(r)["store"]
+	q := r.URL.Query()
+	if q.Get("bucket") == "" {
+		Error(w, r, dcmd.Errorf(dcmd.EINVALID, "bucket required"))
+		return
+	}
+	bucket := &dcmd.CloudStorageBucket{Name: q.Get("bucket")}
+	prefix := q.Get("prefix")
+
+	validation, err := s.CloudStorageService.ValidateBucket(r.Context(), bucket, prefix)
+	if err != nil {
+		Error(w, r, err)
+		return
+	}
+	if validation.Files == 0 {
+		Error(w, r, dcmd.Errorf(dcmd.ENOTFOUND, "no objects under gs://%s/%s", bucket.Name, prefix))
+		return
+	}
+	if len(validation.Accepted) == 0 || (validation.Rejected > 0 && q.Get("strict") == "true") {
+		LogError(r, dcmd.ErrNonConformant)
+		WriteJSONResponse(w, validation, http.StatusConflict)
+		return
+	}
+
+	// exactly the objects validated are imported, in the generation validated
+	objects := make([]*dcmd.CloudStorageObject, len(validation.Accepted))
+	for i, name := range validation.Accepted {
+		objects[i] = &dcmd.CloudStorageObject{Name: name, Generation: validation.Generations[name]}
+	}
+	staging := fmt.Sprintf("%s%s-%d/", importStagingPrefix, store, time.Now().UnixNano())
+	for i := 0; i < len(objects); i += importBatchSize {
+		end := i + importBatchSize
+		if end > len(objects) {
+			end = len(objects)
+		}
+		batch := fmt.Sprintf("%s%d/", staging, i/importBatchSize)
+		if err := s.importObjects(r.Context(), store, bucket, objects[i:end], batch); err != nil {
+			Error(w, r, err)
+			return
+		}
+	}
+
+	WriteJSONResponse(w, validation, http.StatusOK)
+}
+
+// importObjects copies a batch of objects under a staging prefix and imports it into the store.
+// The copies are removed once the import is done, whether it succeeded or not.
+func (s *Server) importObjects(ctx context.Context, store string, bucket *dcmd.CloudStorageBucket, objects []*dcmd.CloudStorageObject, prefix string) (err error) {
+	defer func() {
+		if deleteErr := s.CloudStorageService.DeleteObjects(ctx, bucket, prefix); deleteErr != nil && err == nil {
+			err = deleteErr
+		}
+	}()
+	if err := s.CloudStorageService.CopyObjects(ctx, bucket, objects, prefix); err != nil {
+		return err
+	}
+	return s.DicomStoreService.ImportDICOMInstance(ctx, store, "gs://"+bucket.Name+"/"+prefix+"**")
+}
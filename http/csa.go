@@ -0,0 +1,24 @@
+package http
+
+import (
+	"net/http"
+
+	dcmd "gitlab.com/medical-research/dicom-deidentifier"
+	"gitlab.com/medical-research/dicom-deidentifier/dicom"
+)
+
+// maxMetadataInstanceSize bounds the instances accepted by the metadata routes
+const maxMetadataInstanceSize = 512 << 20
+
+// handleGetCSAHeaders handles the "POST /metadata/csa" route.
+// The body is a DICOM Part 10 instance, the response holds its Siemens CSA image and series
+// headers keyed by "image" and "series". Instances without CSA headers give an empty object.
+func (s *Server) handleGetCSAHeaders(w http.ResponseWriter, r *http.Request) {
+	f, err := dicom.Read(http.MaxBytesReader(w, r.Body, maxMetadataInstanceSize))
+	if err != nil {
+		Error(w, r, dcmd.Errorf(dcmd.EINVALID, "invalid dicom instance: %v", err))
+		return
+	}
+
+	WriteJSONResponse(w, dicom.CSAHeaders(f.Dataset), http.StatusOK)
+}
@@ -0,0 +1,30 @@
+package http
+
+import (
+	"net/http"
+
+	dcmd "gitlab.com/medical-research/dicom-deidentifier"
+	"gitlab.com/medical-research/dicom-deidentifier/ctp"
+)
+
+// maxScriptSize bounds the scripts accepted by the import routes
+const maxScriptSize = 4 << 20
+
+// handleImportCTPScript handles the "POST /profiles/ctp?name={name}" route.
+// The body is an RSNA CTP DicomAnonymizer script, the response holds the profile translated from
+// it and the constructs of the script the profile does not reproduce. The profile is not saved.
+func (s *Server) handleImportCTPScript(w http.ResponseWriter, r *http.Request) {
+	name := r.URL.Query().Get("name")
+	if name == "" {
+		Error(w, r, dcmd.Errorf(dcmd.EINVALID, "profile name required"))
+		return
+	}
+
+	imported, err := ctp.ReadScript(name, http.MaxBytesReader(w, r.Body, maxScriptSize))
+	if err != nil {
+		Error(w, r, err)
+		return
+	}
+
+	WriteJSONResponse(w, imported, http.StatusOK)
+}
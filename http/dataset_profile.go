@@ -0,0 +1,44 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+	dcmd "gitlab.com/medical-research/dicom-deidentifier"
+)
+
+// handleProfileDicomStore handles the "GET /dicom-stores/{store}/profile" route.
+// The catalogue is returned as CSV when the request accepts "text/csv" and as JSON otherwise.
+func (s *Server) handleProfileDicomStore(w http.ResponseWriter, r *http.Request) {
+	profile, err := s.DicomStoreService.ProfileDicomStore(r.Context(), &dcmd.DicomStore{StoreID: mux.Vars(r)["store"]})
+	if err != nil {
+		Error(w, r, err)
+		return
+	}
+	writeDatasetProfile(w, r, profile)
+}
+
+// handleProfileBucket handles the "GET /buckets/{bucket}/profile?prefix={prefix}" route.
+// The catalogue is returned as CSV when the request accepts "text/csv" and as JSON otherwise.
+func (s *Server) handleProfileBucket(w http.ResponseWriter, r *http.Request) {
+	bucket := &dcmd.CloudStorageBucket{Name: mux.Vars(r)["bucket"]}
+	profile, err := s.CloudStorageService.ProfileBucket(r.Context(), bucket, r.URL.Query().Get("prefix"))
+	if err != nil {
+		Error(w, r, err)
+		return
+	}
+	writeDatasetProfile(w, r, profile)
+}
+
+// writeDatasetProfile writes a catalogue in the format accepted by the request
+func writeDatasetProfile(w http.ResponseWriter, r *http.Request, profile *dcmd.DatasetProfile) {
+	if r.Header.Get("Accept") != "text/csv" {
+		WriteJSONResponse(w, profile, http.StatusOK)
+		return
+	}
+	w.Header().Set("Content-Type", "text/csv")
+	w.Header().Set("Content-Disposition", `attachment; filename="profile.csv"`)
+	if err := profile.WriteCSV(w); err != nil {
+		LogError(r, err)
+	}
+}
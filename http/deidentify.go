@@ -0,0 +1,53 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+	dcmd "gitlab.com/medical-research/dicom-deidentifier"
+)
+
+// handleDeidentifyDicomStore handles the "POST /dicom-stores/{store}/deidentify?destination={store}&profile={name}&export={uri}" route.
+// The store is de-identified into the destination store, which is then verified for residual PHI,
+// assessed for k-anonymity and exported to the gs:// uri when one is given. The response is the
+// report of the job, with a 409 status when critical findings blocked the export.
+func (s *Server) handleDeidentifyDicomStore(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	if q.Get("destination") == "" {
+		Error(w, r, dcmd.Errorf(dcmd.EINVALID, "destination store required"))
+		return
+	}
+	profile, ok := s.Profiles[q.Get("profile")]
+	if !ok {
+		Error(w, r, dcmd.Errorf(dcmd.ENOTFOUND, "profile %q not found", q.Get("profile")))
+		return
+	}
+	source := &dcmd.DicomStore{StoreID: mux.Vars(r)["store"]}
+	destination := &dcmd.DicomStore{StoreID: q.Get("destination")}
+
+	// the report is created first, the de-identification itself adding to it
+	report := dcmd.NewJobReport()
+	if err := s.DicomStoreService.DeidentifyDicomStore(r.Context(), source, destination, profile, report); err != nil {
+		Error(w, r, err)
+		return
+	}
+
+	// the risk of the cohort is reported whatever the verification found, as both are reviewed together
+	if err := s.DicomStoreService.VerifyDicomStore(r.Context(), source, destination, profile, report); err == dcmd.ErrResidualPHI {
+		LogError(r, err)
+		WriteJSONResponse(w, report, http.StatusConflict)
+		return
+	} else if err != nil {
+		Error(w, r, err)
+		return
+	}
+
+	if uri := q.Get("export"); uri != "" {
+		if err := s.DicomStoreService.ExportDICOMInstance(r.Context(), destination.StoreID, uri); err != nil {
+			Error(w, r, err)
+			return
+		}
+	}
+
+	WriteJSONResponse(w, report, http.StatusOK)
+}
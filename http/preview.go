@@ -0,0 +1,31 @@
+package http
+
+import (
+	"net/http"
+
+	dcmd "gitlab.com/medical-research/dicom-deidentifier"
+)
+
+// handlePreviewDeidentification handles the "POST /deidentify/preview?profile={name}" route.
+// The body is a DICOM Part 10 instance, the response lists what the profile changes in every
+// element with the old and new values. Nothing is persisted.
+func (s *Server) handlePreviewDeidentification(w http.ResponseWriter, r *http.Request) {
+	name := r.URL.Query().Get("profile")
+	if name == "" {
+		Error(w, r, dcmd.Errorf(dcmd.EINVALID, "profile required"))
+		return
+	}
+	profile, ok := s.Profiles[name]
+	if !ok {
+		Error(w, r, dcmd.Errorf(dcmd.ENOTFOUND, "profile %q not found", name))
+		return
+	}
+
+	preview, err := s.DeidentificationService.PreviewInstance(r.Context(), profile, http.MaxBytesReader(w, r.Body, maxMetadataInstanceSize))
+	if err != nil {
+		Error(w, r, err)
+		return
+	}
+
+	WriteJSONResponse(w, preview, http.StatusOK)
+}
@@ -0,0 +1,26 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+	dcmd "gitlab.com/medical-research/dicom-deidentifier"
+)
+
+// handleReidentifyPseudonym handles the "GET /projects/{project}/pseudonyms/{pseudonym}" route.
+// Only callers granted the re-identification role can read the patient behind a pseudonym.
+func (s *Server) handleReidentifyPseudonym(w http.ResponseWriter, r *http.Request) {
+	if s.PseudonymService == nil {
+		Error(w, r, dcmd.Errorf(dcmd.ENOTIMPLEMENTED, "pseudonymisation is not configured"))
+		return
+	}
+
+	vars := mux.Vars(r)
+	pseudonym, err := s.PseudonymService.ReidentifyPseudonym(r.Context(), vars["project"], vars["pseudonym"])
+	if err != nil {
+		Error(w, r, err)
+		return
+	}
+
+	WriteJSONResponse(w, pseudonym, http.StatusOK)
+}
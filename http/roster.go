@@ -0,0 +1,58 @@
+package http
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gorilla/mux"
+	dcmd "gitlab.com/medical-research/dicom-deidentifier"
+)
+
+// RosterResponse describes an uploaded roster without echoing the hospital IDs it holds
+type RosterResponse struct {
+	Project   string    `json:"project"`
+	Version   int       `json:"version"`
+	Entries   int       `json:"entries"`
+	CreatedAt time.Time `json:"created-at"`
+}
+
+// handleCreateRoster handles the "POST /projects/{project}/rosters" route.
+// The body is the roster CSV, the trial attributes shared by every subject are passed as
+// the sponsor-name, protocol-id and protocol-name query parameters.
+// Only callers granted the re-identification role can store a roster.
+func (s *Server) handleCreateRoster(w http.ResponseWriter, r *http.Request) {
+	if s.RosterService == nil {
+		Error(w, r, dcmd.Errorf(dcmd.ENOTIMPLEMENTED, "enrolment rosters are not configured"))
+		return
+	}
+	if !dcmd.HasRole(r.Context(), dcmd.ReidentificationRole) {
+		Error(w, r, dcmd.Errorf(dcmd.EUNAUTHORIZED, "storing rosters is not allowed"))
+		return
+	}
+
+	entries, err := dcmd.ReadRosterEntries(r.Body)
+	if err != nil {
+		Error(w, r, err)
+		return
+	}
+
+	query := r.URL.Query()
+	roster := &dcmd.Roster{
+		Project:      mux.Vars(r)["project"],
+		SponsorName:  query.Get("sponsor-name"),
+		ProtocolID:   query.Get("protocol-id"),
+		ProtocolName: query.Get("protocol-name"),
+		Entries:      entries,
+	}
+	if err := s.RosterService.CreateRoster(r.Context(), roster); err != nil {
+		Error(w, r, err)
+		return
+	}
+
+	WriteJSONResponse(w, &RosterResponse{
+		Project:   roster.Project,
+		Version:   roster.Version,
+		Entries:   len(roster.Entries),
+		CreatedAt: roster.CreatedAt,
+	}, http.StatusCreated)
+}
@@ -3,11 +3,13 @@ package http
 import (
 	"compress/gzip"
 	"context"
+	"crypto/subtle"
 	"fmt"
 	"log"
 	"net"
 	"net/http"
 	"os"
+	"strings"
 
 	"time"
 
@@ -76,6 +78,17 @@ type Server struct {
 	DicomService      dcmd.DicomService
 
 	CloudStorageService dcmd.CloudStorageService
+
+	DeidentificationService dcmd.DeidentificationService
+	PseudonymService        dcmd.PseudonymService
+	RosterService           dcmd.RosterService
+
+	// Profiles are the de-identification profiles available by name
+	Profiles map[string]*dcmd.Profile
+
+	// ReidentificationToken is the bearer token granting the re-identification role.
+	// Re-identification is disabled when it is empty.
+	ReidentificationToken string
 }
 
 // NewServer returns a new instance of Server.
@@ -103,18 +116,30 @@ func NewServer() *Server {
 		handlers.AllowedMethods([]string{"OPTIONS", "GET", "POST"}),
 	)(h)
 	h = handlers.CombinedLoggingHandler(os.Stdout, h)
-	h = handlers.ContentTypeHandler(h, "application/json")
+	h = handlers.ContentTypeHandler(h, "application/json", "text/csv", "application/dicom", "application/xml", "text/xml")
 
 	s.server.Handler = h
 
 	// Setup a base router that excludes asset handling.
 	router := s.router.PathPrefix("/").Subrouter()
 	router.Use(trackMetrics)
+	router.Use(s.authenticate)
 
 	// Authenticated Routes
 	router.HandleFunc("/get_presigned_url", s.handleGetPresignedBucketURL).Methods("POST")
 	router.HandleFunc("/start_anonymisation", s.handleStartAnonymisation).Methods("POST")
 	router.HandleFunc("/ws-start-deidentification", s.wsStartDeidentification)
+	router.HandleFunc("/projects/{project}/pseudonyms/{pseudonym}", s.handleReidentifyPseudonym).Methods("GET")
+	router.HandleFunc("/projects/{project}/rosters", s.handleCreateRoster).Methods("POST")
+	router.HandleFunc("/metadata/csa", s.handleGetCSAHeaders).Methods("POST")
+	router.HandleFunc("/metadata/validate", s.handleValidateInstance).Methods("POST")
+	router.HandleFunc("/deidentify/preview", s.handlePreviewDeidentification).Methods("POST")
+	router.HandleFunc("/dicom-stores/{store}/profile", s.handleProfileDicomStore).Methods("GET")
+	router.HandleFunc("/buckets/{bucket}/profile", s.handleProfileBucket).Methods("GET")
+	router.HandleFunc("/buckets/{bucket}/validate", s.handleValidateBucket).Methods("GET")
+	router.HandleFunc("/dicom-stores/{store}/import", s.handleImportBucket).Methods("POST")
+	router.HandleFunc("/dicom-stores/{store}/deidentify", s.handleDeidentifyDicomStore).Methods("POST")
+	router.HandleFunc("/profiles/ctp", s.handleImportCTPScript).Methods("POST")
 
 	return s
 }
@@ -206,6 +231,17 @@ func trackMetrics(next http.Handler) http.Handler {
 	})
 }
 
+// authenticate is middleware granting roles to callers presenting the matching bearer token.
+func (s *Server) authenticate(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if s.ReidentificationToken != "" && subtle.ConstantTimeCompare([]byte(token), []byte(s.ReidentificationToken)) == 1 {
+			r = r.WithContext(dcmd.NewContextWithRoles(r.Context(), dcmd.ReidentificationRole))
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
 // requestPathTemplate returns the route path template for r.
 func requestPathTemplate(r *http.Request) string {
 	route := mux.CurrentRoute(r)
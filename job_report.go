@@ -0,0 +1,128 @@
+package dicomdeidentifier
+
+import "sync"
+
+// JobReport summarises what de-identification found across the instances of a single job,
+// so operators can review decisions that need attention. It is safe for concurrent use.
+type JobReport struct {
+	mu sync.Mutex
+
+	// Instances is the number of instances de-identified
+	Instances int `json:"instances"`
+
+	// Excluded is the number of instances the profile left out of the output
+	Excluded int `json:"excluded,omitempty"`
+
+	// UnknownPrivateCreators counts the private elements removed per private creator missing
+	// from the safe private dictionary
+	UnknownPrivateCreators map[string]int `json:"unknown-private-creators,omitempty"`
+
+	// Generalised counts the quasi-identifiers kept in a coarsened form per attribute keyword
+	Generalised map[string]int `json:"generalised,omitempty"`
+
+	// AggregatedAges is the number of instances whose patient was older than the maximum age kept
+	AggregatedAges int `json:"aggregated-ages,omitempty"`
+
+	// ResidualPHI holds the findings of the verification of the de-identified instances
+	ResidualPHI []*PHIFinding `json:"residual-phi,omitempty"`
+
+	// KAnonymity holds the re-identification risk report of the de-identified cohort
+	KAnonymity *KAnonymityReport `json:"k-anonymity,omitempty"`
+}
+
+// NewJobReport returns a new instance of JobReport
+func NewJobReport() *JobReport {
+	return &JobReport{}
+}
+
+// AddInstance records that an instance was de-identified. A nil report records nothing.
+func (r *JobReport) AddInstance() {
+	if r == nil {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.Instances++
+}
+
+// AddExcludedInstance records that an instance was left out of the output
+func (r *JobReport) AddExcludedInstance() {
+	if r == nil {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.Excluded++
+}
+
+// AddUnknownPrivateCreator records a private element removed because its creator is unknown
+func (r *JobReport) AddUnknownPrivateCreator(creator string) {
+	if r == nil {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.UnknownPrivateCreators == nil {
+		r.UnknownPrivateCreators = map[string]int{}
+	}
+	r.UnknownPrivateCreators[creator]++
+}
+
+// AddGeneralised records that an attribute was kept in a coarsened form
+func (r *JobReport) AddGeneralised(keyword string) {
+	if r == nil {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.Generalised == nil {
+		r.Generalised = map[string]int{}
+	}
+	r.Generalised[keyword]++
+}
+
+// AddAggregatedAge records that the age of a patient was aggregated with all older ages
+func (r *JobReport) AddAggregatedAge() {
+	if r == nil {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.AggregatedAges++
+}
+
+// AddPHIFindings records the findings of the verification of a de-identified instance
+func (r *JobReport) AddPHIFindings(findings ...*PHIFinding) {
+	if r == nil {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.ResidualPHI = append(r.ResidualPHI, findings...)
+}
+
+// CriticalPHIFindings returns the number of critical residual PHI findings, which block the export
+func (r *JobReport) CriticalPHIFindings() int {
+	if r == nil {
+		return 0
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	n := 0
+	for _, f := range r.ResidualPHI {
+		if f.Severity == SeverityCritical {
+			n++
+		}
+	}
+	return n
+}
+
+// SetKAnonymity attaches the re-identification risk report of the de-identified cohort
+func (r *JobReport) SetKAnonymity(k *KAnonymityReport) {
+	if r == nil {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.KAnonymity = k
+}
@@ -0,0 +1,75 @@
+package dicomdeidentifier
+
+// DefaultK is the smallest equivalence class accepted when the profile does not give one
+const DefaultK = 5
+
+// DefaultQuasiIdentifiers are the attributes, by keyword, that together may single out a patient
+// in a de-identified cohort when the profile does not list them
+var DefaultQuasiIdentifiers = []string{"PatientSex", "PatientAge", "Modality", "InstitutionName", "StudyDate"}
+
+// KAnonymity configures the re-identification risk report of a de-identified cohort
+type KAnonymity struct {
+	// K is the number of patients every combination of quasi-identifier values must be shared by
+	K int `json:"k,omitempty" yaml:"k,omitempty"`
+
+	// QuasiIdentifiers are the keywords of the attributes combined into equivalence classes
+	QuasiIdentifiers []string `json:"quasi-identifiers,omitempty" yaml:"quasi-identifiers,omitempty"`
+}
+
+// KOrDefault returns the smallest equivalence class accepted
+func (k *KAnonymity) KOrDefault() int {
+	if k != nil && k.K > 0 {
+		return k.K
+	}
+	return DefaultK
+}
+
+// QuasiIdentifiersOrDefault returns the attributes combined into equivalence classes
+func (k *KAnonymity) QuasiIdentifiersOrDefault() []string {
+	if k != nil && len(k.QuasiIdentifiers) > 0 {
+		return k.QuasiIdentifiers
+	}
+	return DefaultQuasiIdentifiers
+}
+
+// KAnonymityReport represents the equivalence classes of a de-identified cohort. Records are
+// studies, and the size of a class is the number of distinct patients its studies belong to.
+type KAnonymityReport struct {
+	K                int      `json:"k"`
+	QuasiIdentifiers []string `json:"quasi-identifiers"`
+
+	// ClassesByStudy is set when studies could not be linked to their patients, as some have no
+	// Patient ID. The size of a class is then its number of studies, which overstates the size
+	// of classes holding several studies of one patient.
+	ClassesByStudy bool `json:"classes-by-study,omitempty"`
+
+	Records       int `json:"records"`
+	Classes       int `json:"classes"`
+	SmallestClass int `json:"smallest-class"`
+
+	// AtRisk are the records of the classes smaller than K
+	AtRisk []*RiskRecord `json:"at-risk,omitempty"`
+
+	// Suggestions are the attributes whose generalisation leaves the fewest records at risk, best first
+	Suggestions []*GeneralisationSuggestion `json:"suggestions,omitempty"`
+}
+
+// RiskRecord represents a study whose combination of quasi-identifier values is shared by fewer than K patients
+type RiskRecord struct {
+	StudyInstanceUID string `json:"study-instance-uid"`
+	ClassSize        int    `json:"class-size"`
+
+	// Values are the quasi-identifier values of the study keyed by keyword
+	Values map[string]string `json:"values"`
+}
+
+// GeneralisationSuggestion represents the effect of coarsening a single quasi-identifier
+type GeneralisationSuggestion struct {
+	Attribute string `json:"attribute"`
+
+	// Method describes the coarsening, e.g. "10 year age ranges" or "remove"
+	Method string `json:"method"`
+
+	// RecordsAtRisk is the number of records still below K once the attribute is coarsened
+	RecordsAtRisk int `json:"records-at-risk"`
+}
@@ -0,0 +1,34 @@
+package mock
+
+import (
+	"context"
+
+	dcmd "gitlab.com/medical-research/dicom-deidentifier"
+)
+
+var _ dcmd.DateShiftService = (*DateShiftService)(nil)
+
+// DateShiftService represents a mock of dcmd.DateShiftService
+type DateShiftService struct {
+	FindDateShiftFn   func(ctx context.Context, patientKey string) (*dcmd.DateShift, error)
+	CreateDateShiftFn func(ctx context.Context, shift *dcmd.DateShift) error
+}
+
+func (s *DateShiftService) FindDateShift(ctx context.Context, patientKey string) (*dcmd.DateShift, error) {
+	return s.FindDateShiftFn(ctx, patientKey)
+}
+
+func (s *DateShiftService) CreateDateShift(ctx context.Context, shift *dcmd.DateShift) error {
+	return s.CreateDateShiftFn(ctx, shift)
+}
+
+var _ dcmd.DateShifter = (*DateShifter)(nil)
+
+// DateShifter represents a mock of dcmd.DateShifter
+type DateShifter struct {
+	PatientDateShiftFn func(ctx context.Context, issuer, patientID string) (int, error)
+}
+
+func (s *DateShifter) PatientDateShift(ctx context.Context, issuer, patientID string) (int, error) {
+	return s.PatientDateShiftFn(ctx, issuer, patientID)
+}
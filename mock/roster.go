@@ -0,0 +1,23 @@
+package mock
+
+import (
+	"context"
+
+	dcmd "gitlab.com/medical-research/dicom-deidentifier"
+)
+
+var _ dcmd.RosterService = (*RosterService)(nil)
+
+// RosterService represents a mock of dcmd.RosterService
+type RosterService struct {
+	FindRosterFn   func(ctx context.Context, project string, version int) (*dcmd.Roster, error)
+	CreateRosterFn func(ctx context.Context, roster *dcmd.Roster) error
+}
+
+func (s *RosterService) FindRoster(ctx context.Context, project string, version int) (*dcmd.Roster, error) {
+	return s.FindRosterFn(ctx, project, version)
+}
+
+func (s *RosterService) CreateRoster(ctx context.Context, roster *dcmd.Roster) error {
+	return s.CreateRosterFn(ctx, roster)
+}
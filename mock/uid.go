@@ -0,0 +1,23 @@
+package mock
+
+import (
+	"context"
+
+	dcmd "gitlab.com/medical-research/dicom-deidentifier"
+)
+
+var _ dcmd.UIDMappingService = (*UIDMappingService)(nil)
+
+// UIDMappingService represents a mock of dcmd.UIDMappingService
+type UIDMappingService struct {
+	FindUIDMappingFn   func(ctx context.Context, namespace, originalUID string) (*dcmd.UIDMapping, error)
+	CreateUIDMappingFn func(ctx context.Context, mapping *dcmd.UIDMapping) error
+}
+
+func (s *UIDMappingService) FindUIDMapping(ctx context.Context, namespace, originalUID string) (*dcmd.UIDMapping, error) {
+	return s.FindUIDMappingFn(ctx, namespace, originalUID)
+}
+
+func (s *UIDMappingService) CreateUIDMapping(ctx context.Context, mapping *dcmd.UIDMapping) error {
+	return s.CreateUIDMappingFn(ctx, mapping)
+}
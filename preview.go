@@ -0,0 +1,79 @@
+package dicomdeidentifier
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+)
+
+// Changes made to a single element by a profile
+const (
+	ElementKept     = "kept"
+	ElementRemoved  = "removed"
+	ElementReplaced = "replaced"
+	ElementShifted  = "shifted"
+	ElementRemapped = "remapped"
+	ElementAdded    = "added"
+)
+
+// NewContextWithDryRun returns a new context in which nothing is persisted: UIDs, pseudonyms and
+// date shifts given to new patients are computed as usual but not recorded
+func NewContextWithDryRun(ctx context.Context) context.Context {
+	return context.WithValue(ctx, dryRunContextKey, true)
+}
+
+// IsDryRun reports whether the side effects of ctx must not be persisted
+func IsDryRun(ctx context.Context) bool {
+	dryRun, _ := ctx.Value(dryRunContextKey).(bool)
+	return dryRun
+}
+
+// Preview represents what a profile changes in a single instance, without anything being persisted
+type Preview struct {
+	Profile     string `json:"profile"`
+	ProfileHash string `json:"profile-hash"`
+
+	// Excluded is set when the profile leaves the instance out of the output, Changes is then empty
+	Excluded bool `json:"excluded,omitempty"`
+
+	// Changes lists every element of the instance and every element the profile added, in path order
+	Changes []*ElementChange `json:"changes"`
+
+	// Report holds what de-identification of the instance found
+	Report *JobReport `json:"report"`
+}
+
+// ElementChange represents the change made by a profile to a single element
+type ElementChange struct {
+	// Path is the path of the element, e.g. "(0008,1030)" or "(0008,1115)[0].(0008,1155)"
+	Path    string `json:"path"`
+	Keyword string `json:"keyword"`
+	Name    string `json:"name,omitempty"`
+	VR      string `json:"vr"`
+
+	// Change is one of ElementKept, ElementRemoved, ElementReplaced, ElementShifted, ElementRemapped or ElementAdded
+	Change string `json:"change"`
+
+	// Binary values are described by their length rather than given
+	OldValue string `json:"old-value,omitempty"`
+	NewValue string `json:"new-value,omitempty"`
+}
+
+// ReadProfiles reads a JSON array of profiles and returns them keyed by name.
+// Returns EINVALID if a profile has no name or two profiles share one.
+func ReadProfiles(r io.Reader) (map[string]*Profile, error) {
+	var list []*Profile
+	if err := json.NewDecoder(r).Decode(&list); err != nil {
+		return nil, Errorf(EINVALID, "invalid profiles: %v", err)
+	}
+	profiles := map[string]*Profile{}
+	for _, p := range list {
+		if p.Name == "" {
+			return nil, Errorf(EINVALID, "profile name required")
+		} else if profiles[p.Name] != nil {
+			return nil, Errorf(EINVALID, "duplicate profile %q", p.Name)
+		}
+		profiles[p.Name] = p
+	}
+	return profiles, nil
+}
@@ -0,0 +1,232 @@
+package dicomdeidentifier
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"sort"
+)
+
+// Options of the PS3.15 Basic Application Level Confidentiality Profile
+// that can be applied on top of the basic profile
+const (
+	// UIDs are kept instead of being remapped
+	RetainUIDsOption = "retain-uids"
+
+	// Free-text descriptors are kept with the identifiers they contain replaced by placeholders
+	CleanDescriptorsOption = "clean-descriptors"
+
+	// Dates and times are shifted by a secret offset per patient, preserving intervals
+	RetainLongitudinalModifiedDatesOption = "retain-longitudinal-modified-dates"
+
+	// PatientID and PatientName are replaced by the patient's pseudonym within the project
+	PseudonymizePatientIDOption = "pseudonymize-patient-id"
+
+	// PatientID and PatientName are replaced by the subject ID on the project's enrolment roster
+	// and the Clinical Trial Subject module is filled in. Patients not on the roster are rejected.
+	RosterSubjectIDsOption = "roster-subject-ids"
+
+	// The original values of the attributes the profile removed or modified are kept in the
+	// Encrypted Attributes Sequence, readable only by the holders of the recipient certificates
+	EncryptOriginalAttributesOption = "encrypt-original-attributes"
+
+	// Private attributes listed as safe for their private creator are kept, all others are removed.
+	// Creators not listed at all are recorded in the job report.
+	RetainSafePrivateOption = "retain-safe-private"
+
+	// Overlay planes (60xx) are removed entirely, including overlays embedded in the pixel data.
+	// Curves (50xx) are always removed.
+	RemoveOverlaysOption = "remove-overlays"
+
+	// Overlay planes are burnt into native monochrome pixel data and then removed
+	BurnInOverlaysOption = "burn-in-overlays"
+
+	// Text annotations of presentation states are kept with the identifiers they contain replaced
+	ScrubAnnotationsOption = "scrub-annotations"
+
+	// The label images of whole slide microscopy instances are left out of the output
+	RemoveSlideLabelsOption = "remove-slide-labels"
+
+	// The label images of whole slide microscopy instances are kept with blank pixel data
+	BlankSlideLabelsOption = "blank-slide-labels"
+
+	// The slide label region of whole slide microscopy overview (macro) images is blanked, see MacroLabelRegion
+	BlankMacroLabelOption = "blank-macro-label"
+
+	// Text is written in UTF-8 (ISO_IR 192) rather than in the character set the instance was received in
+	ConvertToUTF8Option = "convert-to-utf8"
+)
+
+// Profile represents a de-identification profile applied to DICOM instances
+// Every profile applies the PS3.15 Basic Application Level Confidentiality Profile
+// together with the options listed
+type Profile struct {
+	Name string `json:"name" yaml:"name"`
+
+	// Project scopes the pseudonyms given to patients
+	Project string `json:"project,omitempty" yaml:"project,omitempty"`
+
+	// RosterVersion pins the version of the project's roster used, the latest is used when it is 0
+	RosterVersion int `json:"roster-version,omitempty" yaml:"roster-version,omitempty"`
+
+	// MacroLabelRegion is the region of overview images holding the slide label. The default
+	// of the de-identification engine is used when it is nil.
+	MacroLabelRegion *Region `json:"macro-label-region,omitempty" yaml:"macro-label-region,omitempty"`
+
+	// Generalisation keeps quasi-identifiers in a coarsened form rather than removing them
+	Generalisation *Generalisation `json:"generalisation,omitempty" yaml:"generalisation,omitempty"`
+
+	// KAnonymity configures the re-identification risk report of the output, the defaults are used when it is nil
+	KAnonymity *KAnonymity `json:"k-anonymity,omitempty" yaml:"k-anonymity,omitempty"`
+
+	// Rules override the action of the basic profile and of the options for the attributes they match.
+	// They are tried in order, the first rule whose selector and conditions match being applied.
+	Rules []*AttributeRule `json:"rules,omitempty" yaml:"rules,omitempty"`
+
+	// Variables are substituted for "{name}" in the values of replace rules, "{project}" giving the Project
+	Variables map[string]string `json:"variables,omitempty" yaml:"variables,omitempty"`
+
+	Options []string `json:"options,omitempty" yaml:"options,omitempty"`
+}
+
+// Region is a rectangle of an image given in fractions of its width and height,
+// measured from the top left corner
+type Region struct {
+	Left   float64 `json:"left" yaml:"left"`
+	Top    float64 `json:"top" yaml:"top"`
+	Width  float64 `json:"width" yaml:"width"`
+	Height float64 `json:"height" yaml:"height"`
+}
+
+// Actions of attribute rules
+const (
+	RuleKeep          = "keep"
+	RuleRemove        = "remove"
+	RuleEmpty         = "empty"
+	RuleDummy         = "dummy"
+	RuleRemapUID      = "remap-uid"
+	RuleIncrementDate = "increment-date"
+	RuleReplace       = "replace"
+
+	// RuleHash replaces text values by a keyed hash salted with the project
+	RuleHash = "hash"
+
+	// RuleShiftDate shifts dates by the secret offset of the patient, like RetainLongitudinalModifiedDatesOption
+	RuleShiftDate = "shift"
+
+	// RuleGeneralise coarsens values: ages to ranges of Value years, dates to their "year" or
+	// "month" and numbers to a multiple of Value
+	RuleGeneralise = "generalise"
+)
+
+// AttributeRule sets the action taken on the attributes matching Tag, whatever the basic profile
+// and the options of the profile would do with them
+type AttributeRule struct {
+	// Tag is a keyword, a tag such as "(0010,0010)" or "00100010", or a whole group such as "(0018,xxxx)".
+	// Private attributes are selected with PrivateCreator and the element within the block, e.g. "(0009,xx01)".
+	Tag            string `json:"tag" yaml:"tag"`
+	PrivateCreator string `json:"private-creator,omitempty" yaml:"private-creator,omitempty"`
+
+	Action string `json:"action" yaml:"action"`
+
+	// Value is the replacement of RuleReplace, the number of days of RuleIncrementDate and the
+	// precision of RuleGeneralise
+	Value string `json:"value,omitempty" yaml:"value,omitempty"`
+
+	// If are conditions on the values of other attributes of the instance, all of which must hold
+	If []*RuleCondition `json:"if,omitempty" yaml:"if,omitempty"`
+}
+
+// RuleCondition is a condition on the original value of a top level attribute, given by keyword or
+// tag. Exactly one of the comparisons is set, multi-valued attributes matching if any value does.
+type RuleCondition struct {
+	Tag string `json:"tag" yaml:"tag"`
+
+	Equals    string   `json:"equals,omitempty" yaml:"equals,omitempty"`
+	NotEquals string   `json:"not-equals,omitempty" yaml:"not-equals,omitempty"`
+	In        []string `json:"in,omitempty" yaml:"in,omitempty"`
+
+	// Matches is a regular expression the value must match
+	Matches string `json:"matches,omitempty" yaml:"matches,omitempty"`
+
+	// Present tests whether the attribute is in the instance at all
+	Present *bool `json:"present,omitempty" yaml:"present,omitempty"`
+}
+
+// DefaultMaxAge is the oldest age kept as it is, HIPAA Safe Harbor requiring older ages to be aggregated
+const DefaultMaxAge = 89
+
+// Generalisation describes how quasi-identifiers the basic profile removes are kept in a coarsened
+// form instead. Attributes whose rule is not set are removed as usual.
+type Generalisation struct {
+	// AgeBucket is the width in years of the ranges Patient's Age is reduced to, e.g. 5 keeps
+	// "042Y" as "040Y". Ages are only kept when it is set, 1 keeping them in whole years.
+	AgeBucket int `json:"age-bucket,omitempty" yaml:"age-bucket,omitempty"`
+
+	// MaxAge is the oldest age kept, older patients being recorded as MaxAge+1, which reads as
+	// that age or older. DefaultMaxAge is used when it is 0.
+	MaxAge int `json:"max-age,omitempty" yaml:"max-age,omitempty"`
+
+	// DeriveAge computes Patient's Age from Patient's Birth Date and Study Date when the instance has none
+	DeriveAge bool `json:"derive-age,omitempty" yaml:"derive-age,omitempty"`
+
+	// BirthYear keeps Patient's Birth Date reduced to the first of January of its year. The birth
+	// date of patients older than MaxAge is removed, as their year would give their age away.
+	BirthYear bool `json:"birth-year,omitempty" yaml:"birth-year,omitempty"`
+
+	// SizeStep and WeightStep round Patient's Size in metres and Patient's Weight in kilograms to
+	// a multiple of the step. They are only kept when their step is set.
+	SizeStep   float64 `json:"size-step,omitempty" yaml:"size-step,omitempty"`
+	WeightStep float64 `json:"weight-step,omitempty" yaml:"weight-step,omitempty"`
+}
+
+// MaxAgeOrDefault returns the oldest age kept
+func (g *Generalisation) MaxAgeOrDefault() int {
+	if g.MaxAge > 0 {
+		return g.MaxAge
+	}
+	return DefaultMaxAge
+}
+
+// HasOption reports whether the profile applies the given option
+func (p *Profile) HasOption(option string) bool {
+	for _, o := range p.Options {
+		if o == option {
+			return true
+		}
+	}
+	return false
+}
+
+// Hash returns a digest identifying the exact profile used, recorded in every de-identified instance
+// so auditors can tell how it was processed. Options are hashed in order so their order is irrelevant.
+// Profiles holding NaN or infinite numbers cannot be encoded and have no hash, they fail validation.
+func (p *Profile) Hash() string {
+	c := *p
+	c.Options = append([]string(nil), p.Options...)
+	sort.Strings(c.Options)
+
+	b, err := json.Marshal(&c)
+	if err != nil {
+		return ""
+	}
+	sum := sha256.Sum256(b)
+
+	// truncated to 128 bits so the hash fits in a LO value
+	return hex.EncodeToString(sum[:16])
+}
+
+// DeidentificationService is an impentable interface that de-identifies single DICOM instances locally
+type DeidentificationService interface {
+
+	// Reads a DICOM Part 10 instance from r, applies the profile and writes the
+	// de-identified instance to w. Findings are added to report, which may be nil.
+	// Nothing is written for instances the profile excludes, ErrInstanceExcluded is returned.
+	DeidentifyInstance(ctx context.Context, profile *Profile, report *JobReport, r io.Reader, w io.Writer) error
+
+	// Reads a DICOM Part 10 instance from r and returns what the profile changes in every element.
+	// Nothing is persisted, new patients are not given UIDs, pseudonyms or date shifts for good.
+	PreviewInstance(ctx context.Context, profile *Profile, r io.Reader) (*Preview, error)
+}
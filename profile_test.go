@@ -0,0 +1,31 @@
+package dicomdeidentifier_test
+
+import (
+	"math"
+	"testing"
+
+	dcmd "gitlab.com/medical-research/dicom-deidentifier"
+)
+
+func TestProfile_Hash(t *testing.T) {
+	a := &dcmd.Profile{Name: "trial", Options: []string{dcmd.RetainUIDsOption, dcmd.RetainLongitudinalModifiedDatesOption}}
+	b := &dcmd.Profile{Name: "trial", Options: []string{dcmd.RetainLongitudinalModifiedDatesOption, dcmd.RetainUIDsOption}}
+	c := &dcmd.Profile{Name: "trial", Options: []string{dcmd.RetainUIDsOption}}
+
+	if a.Hash() != b.Hash() {
+		t.Errorf("Hash() differs when only the order of options differs")
+	}
+	if a.Hash() == c.Hash() {
+		t.Errorf("Hash() is the same for profiles with different options")
+	}
+	if len(a.Hash()) != 32 {
+		t.Errorf("Hash() = %q, want 32 hex digits", a.Hash())
+	}
+	if a.Options[0] != dcmd.RetainUIDsOption {
+		t.Errorf("Hash() reordered the options of the profile")
+	}
+	nan := &dcmd.Profile{Name: "trial", Generalisation: &dcmd.Generalisation{SizeStep: math.NaN()}}
+	if h := nan.Hash(); h != "" {
+		t.Errorf("Hash() = %q for a profile that cannot be encoded", h)
+	}
+}
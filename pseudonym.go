@@ -0,0 +1,50 @@
+package dicomdeidentifier
+
+import (
+	"context"
+	"time"
+)
+
+// Pseudonym represents the research subject ID given to a patient within a project
+type Pseudonym struct {
+	Project string `json:"project"`
+
+	// PatientKey is a keyed hash of the patient's issuer and ID used to find the pseudonym of a patient
+	PatientKey string `json:"patient-key"`
+	Pseudonym  string `json:"pseudonym"`
+
+	// The original identifiers, only returned to callers with the ReidentificationRole
+	Issuer    string `json:"issuer,omitempty"`
+	PatientID string `json:"patient-id,omitempty"`
+
+	CreatedAt time.Time `json:"created-at"`
+}
+
+// Pseudonymizer is an impentable interface that replaces patient IDs with stable pseudonyms
+// Every upload for the same patient within a project must be given the same pseudonym so
+// studies uploaded weeks apart can still be linked
+type Pseudonymizer interface {
+
+	// Returns the pseudonym of the patient within the project
+	Pseudonymize(ctx context.Context, project, issuer, patientID string) (string, error)
+}
+
+// PseudonymService is an impentable interface for persisting the linkage between patients and pseudonyms
+type PseudonymService interface {
+
+	// Finds the pseudonym given to a patient, without the original identifiers
+	// Returns ENOTFOUND if the patient has not been seen yet
+	FindPseudonym(ctx context.Context, project, patientKey string) (*Pseudonym, error)
+
+	// Finds the patient behind a pseudonym, including the original identifiers
+	// Returns EUNAUTHORIZED unless the context carries the ReidentificationRole
+	// Returns ENOTFOUND if the pseudonym was never given
+	ReidentifyPseudonym(ctx context.Context, project, pseudonym string) (*Pseudonym, error)
+
+	// Returns the number of pseudonyms given within a project
+	CountPseudonyms(ctx context.Context, project string) (int, error)
+
+	// Records the pseudonym of a new patient
+	// Returns ECONFLICT if the patient already has a pseudonym or the pseudonym is taken
+	CreatePseudonym(ctx context.Context, pseudonym *Pseudonym) error
+}
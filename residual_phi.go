@@ -0,0 +1,78 @@
+package dicomdeidentifier
+
+import (
+	"context"
+	"time"
+)
+
+// Severities of residual PHI findings. Critical findings block the export of the output.
+const (
+	SeverityCritical = "critical"
+	SeverityWarning  = "warning"
+	SeverityInfo     = "info"
+)
+
+// Rules of the residual PHI scanner
+const (
+	// A name, ID or date of the source instances appears in a text element
+	SourceIdentifierRule = "source-identifier"
+
+	// An attribute the profile removes is still present
+	ForbiddenAttributeRule = "forbidden-attribute"
+
+	// A private attribute is not on the safe private allow list
+	PrivateAttributeRule = "private-attribute"
+
+	// A UID of the source instances was not remapped
+	SourceUIDRule = "source-uid"
+)
+
+// ErrResidualPHI is returned when critical findings block the export of de-identified instances
+var ErrResidualPHI = Errorf(ECONFLICT, "residual PHI found in de-identified instances, export blocked")
+
+// ErrUnverifiedStore is returned when a store is exported without a passing verification on record
+var ErrUnverifiedStore = Errorf(ECONFLICT, "de-identified store has not passed verification, export blocked")
+
+// StoreVerification records the outcome of the residual PHI verification of a de-identified store.
+// A store written to after its verification is recorded again as unverified.
+type StoreVerification struct {
+	StoreID string `json:"store-id"`
+
+	// Verified is false when the store was written to and has not been verified since
+	Verified bool `json:"verified"`
+
+	// CriticalFindings is the number of critical findings of the verification
+	CriticalFindings int `json:"critical-findings"`
+
+	CreatedAt time.Time `json:"created-at"`
+}
+
+// Passed reports whether the store was verified without any critical finding and can be exported
+func (v *StoreVerification) Passed() bool {
+	return v.Verified && v.CriticalFindings == 0
+}
+
+// VerificationService is an impentable interface for persisting the verification of de-identified stores
+type VerificationService interface {
+
+	// Finds the latest verification recorded for a store
+	// Returns ENOTFOUND if the store has never been verified or written to
+	FindStoreVerification(ctx context.Context, storeID string) (*StoreVerification, error)
+
+	// Records the verification of a store, replacing the one recorded before
+	CreateStoreVerification(ctx context.Context, verification *StoreVerification) error
+}
+
+// PHIFinding represents a possible identifier left in a de-identified instance
+type PHIFinding struct {
+	SOPInstanceUID string `json:"sop-instance-uid"`
+
+	// Path is the path of the element, e.g. "(0008,1030)" or "(0040,A730)[0].(0040,A160)"
+	Path string `json:"path"`
+
+	Rule     string `json:"rule"`
+	Severity string `json:"severity"`
+
+	// Message describes the finding without repeating the identifier found
+	Message string `json:"message"`
+}
@@ -0,0 +1,36 @@
+package dicomdeidentifier
+
+import "context"
+
+// Roles granted to the caller of a request
+const (
+	// ReidentificationRole allows reading the original identifiers behind pseudonyms
+	ReidentificationRole = "reidentification"
+)
+
+// contextKey represents an internal key for adding context fields
+type contextKey int
+
+const (
+	// rolesContextKey stores the roles granted to the caller
+	rolesContextKey = contextKey(iota + 1)
+
+	// dryRunContextKey marks requests whose side effects must not be persisted
+	dryRunContextKey
+)
+
+// NewContextWithRoles returns a new context with the roles granted to the caller
+func NewContextWithRoles(ctx context.Context, roles ...string) context.Context {
+	return context.WithValue(ctx, rolesContextKey, roles)
+}
+
+// HasRole reports whether the role was granted to the caller of ctx
+func HasRole(ctx context.Context, role string) bool {
+	roles, _ := ctx.Value(rolesContextKey).([]string)
+	for _, r := range roles {
+		if r == role {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,153 @@
+package dicomdeidentifier
+
+import (
+	"context"
+	"encoding/csv"
+	"io"
+	"strings"
+	"time"
+)
+
+// maxLOLength is the longest value a LO attribute, such as the Clinical Trial Subject ID, can hold
+const maxLOLength = 64
+
+// Roster represents a version of the enrolment roster of a clinical trial
+// Every roster maps the hospital IDs of the enrolled patients to their trial subject IDs
+type Roster struct {
+	Project string `json:"project"`
+
+	// Version is given when the roster is uploaded, starting at 1 for each project
+	Version int `json:"version"`
+
+	// Attributes of the Clinical Trial Subject module shared by every enrolled patient
+	SponsorName  string `json:"sponsor-name"`
+	ProtocolID   string `json:"protocol-id"`
+	ProtocolName string `json:"protocol-name,omitempty"`
+
+	Entries   []*RosterEntry `json:"entries"`
+	CreatedAt time.Time      `json:"created-at"`
+}
+
+// RosterEntry represents a single enrolled patient
+type RosterEntry struct {
+	// Issuer is optional, entries without one match the patient ID of any issuer
+	Issuer    string `json:"issuer,omitempty"`
+	PatientID string `json:"patient-id"`
+
+	SubjectID string `json:"subject-id"`
+	SiteID    string `json:"site-id,omitempty"`
+	SiteName  string `json:"site-name,omitempty"`
+}
+
+// Validate returns an error if the roster contains invalid fields
+func (r *Roster) Validate() error {
+	if r.Project == "" {
+		return Errorf(EINVALID, "roster project required")
+	} else if r.SponsorName == "" {
+		return Errorf(EINVALID, "roster sponsor name required")
+	} else if r.ProtocolID == "" {
+		return Errorf(EINVALID, "roster protocol id required")
+	} else if len(r.Entries) == 0 {
+		return Errorf(EINVALID, "roster has no entries")
+	}
+	for _, v := range []string{r.SponsorName, r.ProtocolID, r.ProtocolName} {
+		if len(v) > maxLOLength {
+			return Errorf(EINVALID, "roster value %q is longer than %d characters", v, maxLOLength)
+		}
+	}
+
+	patients, subjects := map[string]bool{}, map[string]bool{}
+	for i, e := range r.Entries {
+		if e.PatientID == "" || e.SubjectID == "" {
+			return Errorf(EINVALID, "roster entry %d: patient id and subject id required", i+1)
+		}
+		for _, v := range []string{e.Issuer, e.PatientID, e.SubjectID, e.SiteID, e.SiteName} {
+			if len(v) > maxLOLength {
+				return Errorf(EINVALID, "roster entry %d: value is longer than %d characters", i+1, maxLOLength)
+			}
+		}
+		if patients[e.Issuer+"\x00"+e.PatientID] {
+			return Errorf(EINVALID, "roster entry %d: patient is listed twice", i+1)
+		} else if subjects[e.SubjectID] {
+			return Errorf(EINVALID, "roster entry %d: subject id %q is given twice", i+1, e.SubjectID)
+		}
+		patients[e.Issuer+"\x00"+e.PatientID], subjects[e.SubjectID] = true, true
+	}
+	return nil
+}
+
+// FindEntry returns the entry of an enrolled patient, or nil if the patient is not on the roster
+// An entry naming the issuer is preferred over one matching the patient ID alone
+func (r *Roster) FindEntry(issuer, patientID string) *RosterEntry {
+	var match *RosterEntry
+	for _, e := range r.Entries {
+		if e.PatientID != patientID {
+			continue
+		}
+		if e.Issuer == issuer && issuer != "" {
+			return e
+		} else if e.Issuer == "" {
+			match = e
+		}
+	}
+	return match
+}
+
+// ReadRosterEntries parses a roster CSV. The header row names the columns: patient_id and
+// subject_id are required, issuer, site_id and site_name are optional.
+func ReadRosterEntries(r io.Reader) ([]*RosterEntry, error) {
+	cr := csv.NewReader(r)
+	cr.TrimLeadingSpace = true
+
+	header, err := cr.Read()
+	if err == io.EOF {
+		return nil, Errorf(EINVALID, "roster is empty")
+	} else if err != nil {
+		return nil, Errorf(EINVALID, "invalid roster: %v", err)
+	}
+	columns := map[string]int{}
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(strings.TrimPrefix(name, "\uFEFF")))] = i
+	}
+	for _, name := range []string{"patient_id", "subject_id"} {
+		if _, ok := columns[name]; !ok {
+			return nil, Errorf(EINVALID, "roster has no %s column", name)
+		}
+	}
+
+	var entries []*RosterEntry
+	for {
+		record, err := cr.Read()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, Errorf(EINVALID, "invalid roster: %v", err)
+		}
+		column := func(name string) string {
+			if i, ok := columns[name]; ok && i < len(record) {
+				return strings.TrimSpace(record[i])
+			}
+			return ""
+		}
+		entries = append(entries, &RosterEntry{
+			Issuer:    column("issuer"),
+			PatientID: column("patient_id"),
+			SubjectID: column("subject_id"),
+			SiteID:    column("site_id"),
+			SiteName:  column("site_name"),
+		})
+	}
+	return entries, nil
+}
+
+// RosterService is an impentable interface for persisting the versions of enrolment rosters
+type RosterService interface {
+
+	// Finds a version of the roster of a project, or the latest version if version is 0
+	// Returns ENOTFOUND if the project has no such roster
+	FindRoster(ctx context.Context, project string, version int) (*Roster, error)
+
+	// Records a new version of the roster of a project, setting its version
+	// Returns EUNAUTHORIZED unless the context carries the ReidentificationRole
+	CreateRoster(ctx context.Context, roster *Roster) error
+}
@@ -0,0 +1,98 @@
+package dicomdeidentifier_test
+
+import (
+	"strings"
+	"testing"
+
+	dcmd "gitlab.com/medical-research/dicom-deidentifier"
+)
+
+func TestReadRosterEntries(t *testing.T) {
+	tests := []struct {
+		name     string
+		csv      string
+		want     int
+		wantCode string
+	}{
+		{
+			name: "required and optional columns",
+			csv:  "patient_id,subject_id,site_id,site_name\nMRN0001,TRIAL-001,01,General Hospital\nMRN0002,TRIAL-002,01,General Hospital\n",
+			want: 2,
+		},
+		{
+			name: "columns in any order and case",
+			csv:  "Subject_ID, Issuer, Patient_ID\nTRIAL-001, HOSPITAL, MRN0001\n",
+			want: 1,
+		},
+		{
+			name:     "missing subject id column",
+			csv:      "patient_id,site_id\nMRN0001,01\n",
+			wantCode: dcmd.EINVALID,
+		},
+		{
+			name:     "empty roster",
+			csv:      "",
+			wantCode: dcmd.EINVALID,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := dcmd.ReadRosterEntries(strings.NewReader(tt.csv))
+			if code := dcmd.ErrorCode(err); code != tt.wantCode {
+				t.Fatalf("ReadRosterEntries() error = %v, want %v", err, tt.wantCode)
+			}
+			if len(got) != tt.want {
+				t.Errorf("ReadRosterEntries() = %d entries, want %d", len(got), tt.want)
+			}
+			for _, e := range got {
+				if e.PatientID == "" || e.SubjectID == "" {
+					t.Errorf("ReadRosterEntries() entry = %+v, want patient and subject ids", e)
+				}
+			}
+		})
+	}
+}
+
+func TestRoster_Validate(t *testing.T) {
+	newRoster := func(entries ...*dcmd.RosterEntry) *dcmd.Roster {
+		return &dcmd.Roster{Project: "trial", SponsorName: "ACME", ProtocolID: "P-1", Entries: entries}
+	}
+	tests := []struct {
+		name    string
+		roster  *dcmd.Roster
+		wantErr bool
+	}{
+		{
+			name:   "valid",
+			roster: newRoster(&dcmd.RosterEntry{PatientID: "MRN0001", SubjectID: "S1"}),
+		},
+		{
+			name: "patient listed twice",
+			roster: newRoster(
+				&dcmd.RosterEntry{PatientID: "MRN0001", SubjectID: "S1"},
+				&dcmd.RosterEntry{PatientID: "MRN0001", SubjectID: "S2"},
+			),
+			wantErr: true,
+		},
+		{
+			name: "subject id given twice",
+			roster: newRoster(
+				&dcmd.RosterEntry{PatientID: "MRN0001", SubjectID: "S1"},
+				&dcmd.RosterEntry{PatientID: "MRN0002", SubjectID: "S1"},
+			),
+			wantErr: true,
+		},
+		{
+			name:    "no entries",
+			roster:  newRoster(),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.roster.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
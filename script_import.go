@@ -0,0 +1,18 @@
+package dicomdeidentifier
+
+// ScriptImport represents a profile translated from the script of another de-identification tool
+type ScriptImport struct {
+	Profile *Profile `json:"profile"`
+
+	// Issues are the constructs of the script the profile does not reproduce exactly
+	Issues []*ScriptIssue `json:"issues,omitempty"`
+}
+
+// ScriptIssue represents a construct of an imported script that is unsupported or only approximated
+type ScriptIssue struct {
+	// Element is the attribute the construct applies to, empty for settings of the whole script
+	Element string `json:"element,omitempty"`
+
+	Construct string `json:"construct"`
+	Message   string `json:"message"`
+}
@@ -0,0 +1,36 @@
+package dicomdeidentifier
+
+import (
+	"context"
+	"time"
+)
+
+// UIDMapping represents the replacement chosen for a single original DICOM UID
+type UIDMapping struct {
+	// Namespace keeps the mappings of different projects apart
+	Namespace      string    `json:"namespace"`
+	OriginalUID    string    `json:"original-uid"`
+	ReplacementUID string    `json:"replacement-uid"`
+	CreatedAt      time.Time `json:"created-at"`
+}
+
+// UIDRemapper is an impentable interface that replaces DICOM UIDs consistently
+// The same original UID must always be replaced by the same new UID so that references
+// between instances (e.g. Referenced SOP Instance UIDs) still resolve after de-identification
+type UIDRemapper interface {
+
+	// Returns the replacement for an original UID
+	RemapUID(ctx context.Context, uid string) (string, error)
+}
+
+// UIDMappingService is an impentable interface for persisting UID mappings
+type UIDMappingService interface {
+
+	// Finds the mapping recorded for an original UID
+	// Returns ENOTFOUND if the UID has not been mapped yet
+	FindUIDMapping(ctx context.Context, namespace, originalUID string) (*UIDMapping, error)
+
+	// Records a new mapping
+	// Returns ECONFLICT if the original UID has already been mapped
+	CreateUIDMapping(ctx context.Context, mapping *UIDMapping) error
+}